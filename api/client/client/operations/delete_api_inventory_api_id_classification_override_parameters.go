@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideParams creates a new DeleteAPIInventoryAPIIDClassificationOverrideParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteAPIInventoryAPIIDClassificationOverrideParams() *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithTimeout creates a new DeleteAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a timeout on a request.
+func NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithTimeout(timeout time.Duration) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithContext creates a new DeleteAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a context for a request.
+func NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithContext(ctx context.Context) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithHTTPClient creates a new DeleteAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteAPIInventoryAPIIDClassificationOverrideParamsWithHTTPClient(client *http.Client) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteAPIInventoryAPIIDClassificationOverrideParams contains all the parameters to send to the API endpoint
+   for the delete API inventory API ID classification override operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete API inventory API ID classification override params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WithDefaults() *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete API inventory API ID classification override params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WithTimeout(timeout time.Duration) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WithContext(ctx context.Context) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WithHTTPClient(client *http.Client) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WithAPIID(aPIID uint32) *DeleteAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the delete API inventory API ID classification override params
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteAPIInventoryAPIIDClassificationOverrideReader is a Reader for the DeleteAPIInventoryAPIIDClassificationOverride structure.
+type DeleteAPIInventoryAPIIDClassificationOverrideReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteAPIInventoryAPIIDClassificationOverrideOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteAPIInventoryAPIIDClassificationOverrideDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideOK creates a DeleteAPIInventoryAPIIDClassificationOverrideOK with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideOK() *DeleteAPIInventoryAPIIDClassificationOverrideOK {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideOK{}
+}
+
+/* DeleteAPIInventoryAPIIDClassificationOverrideOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideOK struct {
+	Payload interface{}
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) Error() string {
+	return fmt.Sprintf("[DELETE /apiInventory/{apiId}/classificationOverride][%d] deleteApiInventoryApiIdClassificationOverrideOK  %+v", 200, o.Payload)
+}
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) GetPayload() interface{} {
+	return o.Payload
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound creates a DeleteAPIInventoryAPIIDClassificationOverrideNotFound with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound() *DeleteAPIInventoryAPIIDClassificationOverrideNotFound {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideNotFound{}
+}
+
+/* DeleteAPIInventoryAPIIDClassificationOverrideNotFound describes a response with status code 404, with default header values.
+
+API not found
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /apiInventory/{apiId}/classificationOverride][%d] deleteApiInventoryApiIdClassificationOverrideNotFound  %+v", 404, o.Payload)
+}
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideDefault creates a DeleteAPIInventoryAPIIDClassificationOverrideDefault with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideDefault(code int) *DeleteAPIInventoryAPIIDClassificationOverrideDefault {
+	return &DeleteAPIInventoryAPIIDClassificationOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteAPIInventoryAPIIDClassificationOverrideDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete API inventory API ID classification override default response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) Error() string {
+	return fmt.Sprintf("[DELETE /apiInventory/{apiId}/classificationOverride][%d] DeleteAPIInventoryAPIIDClassificationOverride default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
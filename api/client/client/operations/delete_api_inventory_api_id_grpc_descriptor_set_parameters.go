@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParams creates a new DeleteAPIInventoryAPIIDGrpcDescriptorSetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParams() *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithTimeout creates a new DeleteAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a timeout on a request.
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithTimeout(timeout time.Duration) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithContext creates a new DeleteAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a context for a request.
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithContext(ctx context.Context) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithHTTPClient creates a new DeleteAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParamsWithHTTPClient(client *http.Client) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteAPIInventoryAPIIDGrpcDescriptorSetParams contains all the parameters to send to the API endpoint
+   for the delete API inventory API ID grpc descriptor set operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete API inventory API ID grpc descriptor set params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WithDefaults() *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete API inventory API ID grpc descriptor set params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WithTimeout(timeout time.Duration) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WithContext(ctx context.Context) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WithHTTPClient(client *http.Client) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WithAPIID(aPIID uint32) *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the delete API inventory API ID grpc descriptor set params
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
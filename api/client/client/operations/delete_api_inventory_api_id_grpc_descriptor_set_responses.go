@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteAPIInventoryAPIIDGrpcDescriptorSetReader is a Reader for the DeleteAPIInventoryAPIIDGrpcDescriptorSet structure.
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK creates a DeleteAPIInventoryAPIIDGrpcDescriptorSetOK with default headers values
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK() *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetOK{}
+}
+
+/* DeleteAPIInventoryAPIIDGrpcDescriptorSetOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetOK struct {
+	Payload interface{}
+}
+
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) Error() string {
+	return fmt.Sprintf("[DELETE /apiInventory/{apiId}/grpc/descriptorSet][%d] deleteApiInventoryApiIdGrpcDescriptorSetOK  %+v", 200, o.Payload)
+}
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) GetPayload() interface{} {
+	return o.Payload
+}
+
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault creates a DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault with default headers values
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault(code int) *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete API inventory API ID grpc descriptor set default response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) Error() string {
+	return fmt.Sprintf("[DELETE /apiInventory/{apiId}/grpc/descriptorSet][%d] DeleteAPIInventoryAPIIDGrpcDescriptorSet default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
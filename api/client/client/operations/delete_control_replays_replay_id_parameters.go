@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteControlReplaysReplayIDParams creates a new DeleteControlReplaysReplayIDParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteControlReplaysReplayIDParams() *DeleteControlReplaysReplayIDParams {
+	return &DeleteControlReplaysReplayIDParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteControlReplaysReplayIDParamsWithTimeout creates a new DeleteControlReplaysReplayIDParams object
+// with the ability to set a timeout on a request.
+func NewDeleteControlReplaysReplayIDParamsWithTimeout(timeout time.Duration) *DeleteControlReplaysReplayIDParams {
+	return &DeleteControlReplaysReplayIDParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteControlReplaysReplayIDParamsWithContext creates a new DeleteControlReplaysReplayIDParams object
+// with the ability to set a context for a request.
+func NewDeleteControlReplaysReplayIDParamsWithContext(ctx context.Context) *DeleteControlReplaysReplayIDParams {
+	return &DeleteControlReplaysReplayIDParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteControlReplaysReplayIDParamsWithHTTPClient creates a new DeleteControlReplaysReplayIDParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteControlReplaysReplayIDParamsWithHTTPClient(client *http.Client) *DeleteControlReplaysReplayIDParams {
+	return &DeleteControlReplaysReplayIDParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteControlReplaysReplayIDParams contains all the parameters to send to the API endpoint
+   for the delete control replays replay ID operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteControlReplaysReplayIDParams struct {
+
+	// ReplayID.
+	//
+	// Format: uint32
+	ReplayID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete control replays replay ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlReplaysReplayIDParams) WithDefaults() *DeleteControlReplaysReplayIDParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete control replays replay ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlReplaysReplayIDParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) WithTimeout(timeout time.Duration) *DeleteControlReplaysReplayIDParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) WithContext(ctx context.Context) *DeleteControlReplaysReplayIDParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) WithHTTPClient(client *http.Client) *DeleteControlReplaysReplayIDParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithReplayID adds the replayID to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) WithReplayID(replayID uint32) *DeleteControlReplaysReplayIDParams {
+	o.SetReplayID(replayID)
+	return o
+}
+
+// SetReplayID adds the replayId to the delete control replays replay ID params
+func (o *DeleteControlReplaysReplayIDParams) SetReplayID(replayID uint32) {
+	o.ReplayID = replayID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteControlReplaysReplayIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param replayId
+	if err := r.SetPathParam("replayId", swag.FormatUint32(o.ReplayID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteControlReplaysReplayIDReader is a Reader for the DeleteControlReplaysReplayID structure.
+type DeleteControlReplaysReplayIDReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteControlReplaysReplayIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeleteControlReplaysReplayIDOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteControlReplaysReplayIDNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteControlReplaysReplayIDDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteControlReplaysReplayIDOK creates a DeleteControlReplaysReplayIDOK with default headers values
+func NewDeleteControlReplaysReplayIDOK() *DeleteControlReplaysReplayIDOK {
+	return &DeleteControlReplaysReplayIDOK{}
+}
+
+/* DeleteControlReplaysReplayIDOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type DeleteControlReplaysReplayIDOK struct {
+	Payload *models.Replay
+}
+
+func (o *DeleteControlReplaysReplayIDOK) Error() string {
+	return fmt.Sprintf("[DELETE /control/replays/{replayId}][%d] deleteControlReplaysReplayIdOK  %+v", 200, o.Payload)
+}
+func (o *DeleteControlReplaysReplayIDOK) GetPayload() *models.Replay {
+	return o.Payload
+}
+
+func (o *DeleteControlReplaysReplayIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Replay)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteControlReplaysReplayIDNotFound creates a DeleteControlReplaysReplayIDNotFound with default headers values
+func NewDeleteControlReplaysReplayIDNotFound() *DeleteControlReplaysReplayIDNotFound {
+	return &DeleteControlReplaysReplayIDNotFound{}
+}
+
+/* DeleteControlReplaysReplayIDNotFound describes a response with status code 404, with default header values.
+
+Replay not found
+*/
+type DeleteControlReplaysReplayIDNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *DeleteControlReplaysReplayIDNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /control/replays/{replayId}][%d] deleteControlReplaysReplayIdNotFound  %+v", 404, o.Payload)
+}
+func (o *DeleteControlReplaysReplayIDNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlReplaysReplayIDNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteControlReplaysReplayIDDefault creates a DeleteControlReplaysReplayIDDefault with default headers values
+func NewDeleteControlReplaysReplayIDDefault(code int) *DeleteControlReplaysReplayIDDefault {
+	return &DeleteControlReplaysReplayIDDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteControlReplaysReplayIDDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteControlReplaysReplayIDDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete control replays replay ID default response
+func (o *DeleteControlReplaysReplayIDDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteControlReplaysReplayIDDefault) Error() string {
+	return fmt.Sprintf("[DELETE /control/replays/{replayId}][%d] DeleteControlReplaysReplayID default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteControlReplaysReplayIDDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlReplaysReplayIDDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteControlSamplingRatesParams creates a new DeleteControlSamplingRatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteControlSamplingRatesParams() *DeleteControlSamplingRatesParams {
+	return &DeleteControlSamplingRatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteControlSamplingRatesParamsWithTimeout creates a new DeleteControlSamplingRatesParams object
+// with the ability to set a timeout on a request.
+func NewDeleteControlSamplingRatesParamsWithTimeout(timeout time.Duration) *DeleteControlSamplingRatesParams {
+	return &DeleteControlSamplingRatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteControlSamplingRatesParamsWithContext creates a new DeleteControlSamplingRatesParams object
+// with the ability to set a context for a request.
+func NewDeleteControlSamplingRatesParamsWithContext(ctx context.Context) *DeleteControlSamplingRatesParams {
+	return &DeleteControlSamplingRatesParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteControlSamplingRatesParamsWithHTTPClient creates a new DeleteControlSamplingRatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteControlSamplingRatesParamsWithHTTPClient(client *http.Client) *DeleteControlSamplingRatesParams {
+	return &DeleteControlSamplingRatesParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteControlSamplingRatesParams contains all the parameters to send to the API endpoint
+   for the delete control sampling rates operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteControlSamplingRatesParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	// Component.
+	Component string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlSamplingRatesParams) WithDefaults() *DeleteControlSamplingRatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlSamplingRatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) WithTimeout(timeout time.Duration) *DeleteControlSamplingRatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) WithContext(ctx context.Context) *DeleteControlSamplingRatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) WithHTTPClient(client *http.Client) *DeleteControlSamplingRatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) WithAPIID(aPIID uint32) *DeleteControlSamplingRatesParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithComponent adds the component to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) WithComponent(component string) *DeleteControlSamplingRatesParams {
+	o.SetComponent(component)
+	return o
+}
+
+// SetComponent adds the component to the delete control sampling rates params
+func (o *DeleteControlSamplingRatesParams) SetComponent(component string) {
+	o.Component = component
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteControlSamplingRatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param apiId
+	qrAPIID := o.APIID
+	qAPIID := swag.FormatUint32(qrAPIID)
+	if qAPIID != "" {
+
+		if err := r.SetQueryParam("apiId", qAPIID); err != nil {
+			return err
+		}
+	}
+
+	// query param component
+	qrComponent := o.Component
+	qComponent := qrComponent
+	if qComponent != "" {
+
+		if err := r.SetQueryParam("component", qComponent); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteControlSamplingRatesReader is a Reader for the DeleteControlSamplingRates structure.
+type DeleteControlSamplingRatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteControlSamplingRatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteControlSamplingRatesNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteControlSamplingRatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteControlSamplingRatesNoContent creates a DeleteControlSamplingRatesNoContent with default headers values
+func NewDeleteControlSamplingRatesNoContent() *DeleteControlSamplingRatesNoContent {
+	return &DeleteControlSamplingRatesNoContent{}
+}
+
+/* DeleteControlSamplingRatesNoContent describes a response with status code 204, with default header values.
+
+Success
+*/
+type DeleteControlSamplingRatesNoContent struct {
+}
+
+func (o *DeleteControlSamplingRatesNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /control/samplingRates][%d] deleteControlSamplingRatesNoContent ", 204)
+}
+
+func (o *DeleteControlSamplingRatesNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteControlSamplingRatesDefault creates a DeleteControlSamplingRatesDefault with default headers values
+func NewDeleteControlSamplingRatesDefault(code int) *DeleteControlSamplingRatesDefault {
+	return &DeleteControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteControlSamplingRatesDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteControlSamplingRatesDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete control sampling rates default response
+func (o *DeleteControlSamplingRatesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteControlSamplingRatesDefault) Error() string {
+	return fmt.Sprintf("[DELETE /control/samplingRates][%d] DeleteControlSamplingRates default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteControlSamplingRatesDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlSamplingRatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
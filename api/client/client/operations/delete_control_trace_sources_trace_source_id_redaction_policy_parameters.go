@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams creates a new DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout creates a new DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a timeout on a request.
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout(timeout time.Duration) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext creates a new DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a context for a request.
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext(ctx context.Context) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient creates a new DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient(client *http.Client) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams contains all the parameters to send to the API endpoint
+   for the delete control trace sources trace source ID redaction policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams struct {
+
+	/* TraceSourceID.
+
+	   Trace Source ID
+
+	   Format: uuid
+	*/
+	TraceSourceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithDefaults() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTimeout(timeout time.Duration) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithContext(ctx context.Context) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithHTTPClient(client *http.Client) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTraceSourceID adds the traceSourceID to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTraceSourceID(traceSourceID strfmt.UUID) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTraceSourceID(traceSourceID)
+	return o
+}
+
+// SetTraceSourceID adds the traceSourceId to the delete control trace sources trace source ID redaction policy params
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTraceSourceID(traceSourceID strfmt.UUID) {
+	o.TraceSourceID = traceSourceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param traceSourceId
+	if err := r.SetPathParam("traceSourceId", o.TraceSourceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
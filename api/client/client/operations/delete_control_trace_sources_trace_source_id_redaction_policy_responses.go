@@ -0,0 +1,142 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyReader is a Reader for the DeleteControlTraceSourcesTraceSourceIDRedactionPolicy structure.
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent creates a DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent{}
+}
+
+/* DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent describes a response with status code 204, with default header values.
+
+Success
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent struct {
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /control/traceSources/{traceSourceId}/redactionPolicy][%d] deleteControlTraceSourcesTraceSourceIdRedactionPolicyNoContent ", 204)
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates a DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+/* DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound describes a response with status code 404, with default header values.
+
+Trace Source not found
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) Error() string {
+	return fmt.Sprintf("[DELETE /control/traceSources/{traceSourceId}/redactionPolicy][%d] deleteControlTraceSourcesTraceSourceIdRedactionPolicyNotFound  %+v", 404, o.Payload)
+}
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates a DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete control trace sources trace source ID redaction policy default response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Error() string {
+	return fmt.Sprintf("[DELETE /control/traceSources/{traceSourceId}/redactionPolicy][%d] DeleteControlTraceSourcesTraceSourceIDRedactionPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
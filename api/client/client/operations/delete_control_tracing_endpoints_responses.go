@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteControlTracingEndpointsReader is a Reader for the DeleteControlTracingEndpoints structure.
+type DeleteControlTracingEndpointsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteControlTracingEndpointsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteControlTracingEndpointsNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteControlTracingEndpointsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteControlTracingEndpointsNoContent creates a DeleteControlTracingEndpointsNoContent with default headers values
+func NewDeleteControlTracingEndpointsNoContent() *DeleteControlTracingEndpointsNoContent {
+	return &DeleteControlTracingEndpointsNoContent{}
+}
+
+/* DeleteControlTracingEndpointsNoContent describes a response with status code 204, with default header values.
+
+Success
+*/
+type DeleteControlTracingEndpointsNoContent struct {
+}
+
+func (o *DeleteControlTracingEndpointsNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /control/tracingEndpoints][%d] deleteControlTracingEndpointsNoContent ", 204)
+}
+
+func (o *DeleteControlTracingEndpointsNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteControlTracingEndpointsDefault creates a DeleteControlTracingEndpointsDefault with default headers values
+func NewDeleteControlTracingEndpointsDefault(code int) *DeleteControlTracingEndpointsDefault {
+	return &DeleteControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteControlTracingEndpointsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete control tracing endpoints default response
+func (o *DeleteControlTracingEndpointsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteControlTracingEndpointsDefault) Error() string {
+	return fmt.Sprintf("[DELETE /control/tracingEndpoints][%d] DeleteControlTracingEndpoints default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteControlTracingEndpointsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlTracingEndpointsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewDeleteControlTracingSessionsParams creates a new DeleteControlTracingSessionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteControlTracingSessionsParams() *DeleteControlTracingSessionsParams {
+	return &DeleteControlTracingSessionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteControlTracingSessionsParamsWithTimeout creates a new DeleteControlTracingSessionsParams object
+// with the ability to set a timeout on a request.
+func NewDeleteControlTracingSessionsParamsWithTimeout(timeout time.Duration) *DeleteControlTracingSessionsParams {
+	return &DeleteControlTracingSessionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteControlTracingSessionsParamsWithContext creates a new DeleteControlTracingSessionsParams object
+// with the ability to set a context for a request.
+func NewDeleteControlTracingSessionsParamsWithContext(ctx context.Context) *DeleteControlTracingSessionsParams {
+	return &DeleteControlTracingSessionsParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteControlTracingSessionsParamsWithHTTPClient creates a new DeleteControlTracingSessionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteControlTracingSessionsParamsWithHTTPClient(client *http.Client) *DeleteControlTracingSessionsParams {
+	return &DeleteControlTracingSessionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteControlTracingSessionsParams contains all the parameters to send to the API endpoint
+   for the delete control tracing sessions operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteControlTracingSessionsParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	// Component.
+	Component string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlTracingSessionsParams) WithDefaults() *DeleteControlTracingSessionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteControlTracingSessionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) WithTimeout(timeout time.Duration) *DeleteControlTracingSessionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) WithContext(ctx context.Context) *DeleteControlTracingSessionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) WithHTTPClient(client *http.Client) *DeleteControlTracingSessionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) WithAPIID(aPIID uint32) *DeleteControlTracingSessionsParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithComponent adds the component to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) WithComponent(component string) *DeleteControlTracingSessionsParams {
+	o.SetComponent(component)
+	return o
+}
+
+// SetComponent adds the component to the delete control tracing sessions params
+func (o *DeleteControlTracingSessionsParams) SetComponent(component string) {
+	o.Component = component
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteControlTracingSessionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// query param apiId
+	qrAPIID := o.APIID
+	qAPIID := swag.FormatUint32(qrAPIID)
+	if qAPIID != "" {
+
+		if err := r.SetQueryParam("apiId", qAPIID); err != nil {
+			return err
+		}
+	}
+
+	// query param component
+	qrComponent := o.Component
+	qComponent := qrComponent
+	if qComponent != "" {
+
+		if err := r.SetQueryParam("component", qComponent); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
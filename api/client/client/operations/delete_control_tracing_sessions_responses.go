@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// DeleteControlTracingSessionsReader is a Reader for the DeleteControlTracingSessions structure.
+type DeleteControlTracingSessionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteControlTracingSessionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteControlTracingSessionsNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteControlTracingSessionsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteControlTracingSessionsNoContent creates a DeleteControlTracingSessionsNoContent with default headers values
+func NewDeleteControlTracingSessionsNoContent() *DeleteControlTracingSessionsNoContent {
+	return &DeleteControlTracingSessionsNoContent{}
+}
+
+/* DeleteControlTracingSessionsNoContent describes a response with status code 204, with default header values.
+
+Success
+*/
+type DeleteControlTracingSessionsNoContent struct {
+}
+
+func (o *DeleteControlTracingSessionsNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /control/tracingSessions][%d] deleteControlTracingSessionsNoContent ", 204)
+}
+
+func (o *DeleteControlTracingSessionsNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteControlTracingSessionsDefault creates a DeleteControlTracingSessionsDefault with default headers values
+func NewDeleteControlTracingSessionsDefault(code int) *DeleteControlTracingSessionsDefault {
+	return &DeleteControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteControlTracingSessionsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type DeleteControlTracingSessionsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the delete control tracing sessions default response
+func (o *DeleteControlTracingSessionsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteControlTracingSessionsDefault) Error() string {
+	return fmt.Sprintf("[DELETE /control/tracingSessions][%d] DeleteControlTracingSessions default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteControlTracingSessionsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *DeleteControlTracingSessionsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
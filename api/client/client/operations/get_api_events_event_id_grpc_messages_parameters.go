@@ -0,0 +1,152 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIEventsEventIDGrpcMessagesParams creates a new GetAPIEventsEventIDGrpcMessagesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAPIEventsEventIDGrpcMessagesParams() *GetAPIEventsEventIDGrpcMessagesParams {
+	return &GetAPIEventsEventIDGrpcMessagesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesParamsWithTimeout creates a new GetAPIEventsEventIDGrpcMessagesParams object
+// with the ability to set a timeout on a request.
+func NewGetAPIEventsEventIDGrpcMessagesParamsWithTimeout(timeout time.Duration) *GetAPIEventsEventIDGrpcMessagesParams {
+	return &GetAPIEventsEventIDGrpcMessagesParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesParamsWithContext creates a new GetAPIEventsEventIDGrpcMessagesParams object
+// with the ability to set a context for a request.
+func NewGetAPIEventsEventIDGrpcMessagesParamsWithContext(ctx context.Context) *GetAPIEventsEventIDGrpcMessagesParams {
+	return &GetAPIEventsEventIDGrpcMessagesParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesParamsWithHTTPClient creates a new GetAPIEventsEventIDGrpcMessagesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAPIEventsEventIDGrpcMessagesParamsWithHTTPClient(client *http.Client) *GetAPIEventsEventIDGrpcMessagesParams {
+	return &GetAPIEventsEventIDGrpcMessagesParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetAPIEventsEventIDGrpcMessagesParams contains all the parameters to send to the API endpoint
+   for the get API events event ID grpc messages operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetAPIEventsEventIDGrpcMessagesParams struct {
+
+	/* EventID.
+
+	   API event ID
+
+	   Format: uint32
+	*/
+	EventID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get API events event ID grpc messages params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WithDefaults() *GetAPIEventsEventIDGrpcMessagesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get API events event ID grpc messages params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIEventsEventIDGrpcMessagesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WithTimeout(timeout time.Duration) *GetAPIEventsEventIDGrpcMessagesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WithContext(ctx context.Context) *GetAPIEventsEventIDGrpcMessagesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WithHTTPClient(client *http.Client) *GetAPIEventsEventIDGrpcMessagesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithEventID adds the eventID to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WithEventID(eventID uint32) *GetAPIEventsEventIDGrpcMessagesParams {
+	o.SetEventID(eventID)
+	return o
+}
+
+// SetEventID adds the eventId to the get API events event ID grpc messages params
+func (o *GetAPIEventsEventIDGrpcMessagesParams) SetEventID(eventID uint32) {
+	o.EventID = eventID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAPIEventsEventIDGrpcMessagesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param eventId
+	if err := r.SetPathParam("eventId", swag.FormatUint32(o.EventID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
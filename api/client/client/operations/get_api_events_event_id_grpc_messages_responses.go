@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetAPIEventsEventIDGrpcMessagesReader is a Reader for the GetAPIEventsEventIDGrpcMessages structure.
+type GetAPIEventsEventIDGrpcMessagesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAPIEventsEventIDGrpcMessagesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAPIEventsEventIDGrpcMessagesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetAPIEventsEventIDGrpcMessagesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetAPIEventsEventIDGrpcMessagesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesOK creates a GetAPIEventsEventIDGrpcMessagesOK with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesOK() *GetAPIEventsEventIDGrpcMessagesOK {
+	return &GetAPIEventsEventIDGrpcMessagesOK{}
+}
+
+/* GetAPIEventsEventIDGrpcMessagesOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetAPIEventsEventIDGrpcMessagesOK struct {
+	Payload *models.GrpcMessages
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesOK) Error() string {
+	return fmt.Sprintf("[GET /apiEvents/{eventId}/grpcMessages][%d] getApiEventsEventIdGrpcMessagesOK  %+v", 200, o.Payload)
+}
+func (o *GetAPIEventsEventIDGrpcMessagesOK) GetPayload() *models.GrpcMessages {
+	return o.Payload
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GrpcMessages)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesNotFound creates a GetAPIEventsEventIDGrpcMessagesNotFound with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesNotFound() *GetAPIEventsEventIDGrpcMessagesNotFound {
+	return &GetAPIEventsEventIDGrpcMessagesNotFound{}
+}
+
+/* GetAPIEventsEventIDGrpcMessagesNotFound describes a response with status code 404, with default header values.
+
+The messages of the event were not decoded
+*/
+type GetAPIEventsEventIDGrpcMessagesNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) Error() string {
+	return fmt.Sprintf("[GET /apiEvents/{eventId}/grpcMessages][%d] getApiEventsEventIdGrpcMessagesNotFound  %+v", 404, o.Payload)
+}
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesDefault creates a GetAPIEventsEventIDGrpcMessagesDefault with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesDefault(code int) *GetAPIEventsEventIDGrpcMessagesDefault {
+	return &GetAPIEventsEventIDGrpcMessagesDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetAPIEventsEventIDGrpcMessagesDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetAPIEventsEventIDGrpcMessagesDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get API events event ID grpc messages default response
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) Error() string {
+	return fmt.Sprintf("[GET /apiEvents/{eventId}/grpcMessages][%d] GetAPIEventsEventIDGrpcMessages default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
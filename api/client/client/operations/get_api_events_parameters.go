@@ -94,6 +94,24 @@ type GetAPIEventsParams struct {
 	*/
 	EndTime strfmt.DateTime
 
+	// GraphqlOperationNameIs.
+	GraphqlOperationNameIs []string
+
+	// GraphqlOperationTypeIs.
+	GraphqlOperationTypeIs []string
+
+	/* GraphqlRootFieldIs.
+
+	   Events selecting any of the root fields
+	*/
+	GraphqlRootFieldIs []string
+
+	// GrpcMethodIs.
+	GrpcMethodIs []string
+
+	// GrpcServiceIs.
+	GrpcServiceIs []string
+
 	// HasSpecDiffIs.
 	HasSpecDiffIs *bool
 
@@ -346,6 +364,61 @@ func (o *GetAPIEventsParams) SetEndTime(endTime strfmt.DateTime) {
 	o.EndTime = endTime
 }
 
+// WithGraphqlOperationNameIs adds the graphqlOperationNameIs to the get API events params
+func (o *GetAPIEventsParams) WithGraphqlOperationNameIs(graphqlOperationNameIs []string) *GetAPIEventsParams {
+	o.SetGraphqlOperationNameIs(graphqlOperationNameIs)
+	return o
+}
+
+// SetGraphqlOperationNameIs adds the graphqlOperationNameIs to the get API events params
+func (o *GetAPIEventsParams) SetGraphqlOperationNameIs(graphqlOperationNameIs []string) {
+	o.GraphqlOperationNameIs = graphqlOperationNameIs
+}
+
+// WithGraphqlOperationTypeIs adds the graphqlOperationTypeIs to the get API events params
+func (o *GetAPIEventsParams) WithGraphqlOperationTypeIs(graphqlOperationTypeIs []string) *GetAPIEventsParams {
+	o.SetGraphqlOperationTypeIs(graphqlOperationTypeIs)
+	return o
+}
+
+// SetGraphqlOperationTypeIs adds the graphqlOperationTypeIs to the get API events params
+func (o *GetAPIEventsParams) SetGraphqlOperationTypeIs(graphqlOperationTypeIs []string) {
+	o.GraphqlOperationTypeIs = graphqlOperationTypeIs
+}
+
+// WithGraphqlRootFieldIs adds the graphqlRootFieldIs to the get API events params
+func (o *GetAPIEventsParams) WithGraphqlRootFieldIs(graphqlRootFieldIs []string) *GetAPIEventsParams {
+	o.SetGraphqlRootFieldIs(graphqlRootFieldIs)
+	return o
+}
+
+// SetGraphqlRootFieldIs adds the graphqlRootFieldIs to the get API events params
+func (o *GetAPIEventsParams) SetGraphqlRootFieldIs(graphqlRootFieldIs []string) {
+	o.GraphqlRootFieldIs = graphqlRootFieldIs
+}
+
+// WithGrpcMethodIs adds the grpcMethodIs to the get API events params
+func (o *GetAPIEventsParams) WithGrpcMethodIs(grpcMethodIs []string) *GetAPIEventsParams {
+	o.SetGrpcMethodIs(grpcMethodIs)
+	return o
+}
+
+// SetGrpcMethodIs adds the grpcMethodIs to the get API events params
+func (o *GetAPIEventsParams) SetGrpcMethodIs(grpcMethodIs []string) {
+	o.GrpcMethodIs = grpcMethodIs
+}
+
+// WithGrpcServiceIs adds the grpcServiceIs to the get API events params
+func (o *GetAPIEventsParams) WithGrpcServiceIs(grpcServiceIs []string) *GetAPIEventsParams {
+	o.SetGrpcServiceIs(grpcServiceIs)
+	return o
+}
+
+// SetGrpcServiceIs adds the grpcServiceIs to the get API events params
+func (o *GetAPIEventsParams) SetGrpcServiceIs(grpcServiceIs []string) {
+	o.GrpcServiceIs = grpcServiceIs
+}
+
 // WithHasSpecDiffIs adds the hasSpecDiffIs to the get API events params
 func (o *GetAPIEventsParams) WithHasSpecDiffIs(hasSpecDiffIs *bool) *GetAPIEventsParams {
 	o.SetHasSpecDiffIs(hasSpecDiffIs)
@@ -722,6 +795,61 @@ func (o *GetAPIEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 		}
 	}
 
+	if o.GraphqlOperationNameIs != nil {
+
+		// binding items for graphqlOperationName[is]
+		joinedGraphqlOperationNameIs := o.bindParamGraphqlOperationNameIs(reg)
+
+		// query array param graphqlOperationName[is]
+		if err := r.SetQueryParam("graphqlOperationName[is]", joinedGraphqlOperationNameIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.GraphqlOperationTypeIs != nil {
+
+		// binding items for graphqlOperationType[is]
+		joinedGraphqlOperationTypeIs := o.bindParamGraphqlOperationTypeIs(reg)
+
+		// query array param graphqlOperationType[is]
+		if err := r.SetQueryParam("graphqlOperationType[is]", joinedGraphqlOperationTypeIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.GraphqlRootFieldIs != nil {
+
+		// binding items for graphqlRootField[is]
+		joinedGraphqlRootFieldIs := o.bindParamGraphqlRootFieldIs(reg)
+
+		// query array param graphqlRootField[is]
+		if err := r.SetQueryParam("graphqlRootField[is]", joinedGraphqlRootFieldIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.GrpcMethodIs != nil {
+
+		// binding items for grpcMethod[is]
+		joinedGrpcMethodIs := o.bindParamGrpcMethodIs(reg)
+
+		// query array param grpcMethod[is]
+		if err := r.SetQueryParam("grpcMethod[is]", joinedGrpcMethodIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.GrpcServiceIs != nil {
+
+		// binding items for grpcService[is]
+		joinedGrpcServiceIs := o.bindParamGrpcServiceIs(reg)
+
+		// query array param grpcService[is]
+		if err := r.SetQueryParam("grpcService[is]", joinedGrpcServiceIs...); err != nil {
+			return err
+		}
+	}
+
 	if o.HasSpecDiffIs != nil {
 
 		// query param hasSpecDiff[is]
@@ -1148,6 +1276,91 @@ func (o *GetAPIEventsParams) bindParamDestinationPortIs(formats strfmt.Registry)
 	return destinationPortIsIS
 }
 
+// bindParamGetAPIEvents binds the parameter graphqlOperationName[is]
+func (o *GetAPIEventsParams) bindParamGraphqlOperationNameIs(formats strfmt.Registry) []string {
+	graphqlOperationNameIsIR := o.GraphqlOperationNameIs
+
+	var graphqlOperationNameIsIC []string
+	for _, graphqlOperationNameIsIIR := range graphqlOperationNameIsIR { // explode []string
+
+		graphqlOperationNameIsIIV := graphqlOperationNameIsIIR // string as string
+		graphqlOperationNameIsIC = append(graphqlOperationNameIsIC, graphqlOperationNameIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	graphqlOperationNameIsIS := swag.JoinByFormat(graphqlOperationNameIsIC, "")
+
+	return graphqlOperationNameIsIS
+}
+
+// bindParamGetAPIEvents binds the parameter graphqlOperationType[is]
+func (o *GetAPIEventsParams) bindParamGraphqlOperationTypeIs(formats strfmt.Registry) []string {
+	graphqlOperationTypeIsIR := o.GraphqlOperationTypeIs
+
+	var graphqlOperationTypeIsIC []string
+	for _, graphqlOperationTypeIsIIR := range graphqlOperationTypeIsIR { // explode []string
+
+		graphqlOperationTypeIsIIV := graphqlOperationTypeIsIIR // string as string
+		graphqlOperationTypeIsIC = append(graphqlOperationTypeIsIC, graphqlOperationTypeIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	graphqlOperationTypeIsIS := swag.JoinByFormat(graphqlOperationTypeIsIC, "")
+
+	return graphqlOperationTypeIsIS
+}
+
+// bindParamGetAPIEvents binds the parameter graphqlRootField[is]
+func (o *GetAPIEventsParams) bindParamGraphqlRootFieldIs(formats strfmt.Registry) []string {
+	graphqlRootFieldIsIR := o.GraphqlRootFieldIs
+
+	var graphqlRootFieldIsIC []string
+	for _, graphqlRootFieldIsIIR := range graphqlRootFieldIsIR { // explode []string
+
+		graphqlRootFieldIsIIV := graphqlRootFieldIsIIR // string as string
+		graphqlRootFieldIsIC = append(graphqlRootFieldIsIC, graphqlRootFieldIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	graphqlRootFieldIsIS := swag.JoinByFormat(graphqlRootFieldIsIC, "")
+
+	return graphqlRootFieldIsIS
+}
+
+// bindParamGetAPIEvents binds the parameter grpcMethod[is]
+func (o *GetAPIEventsParams) bindParamGrpcMethodIs(formats strfmt.Registry) []string {
+	grpcMethodIsIR := o.GrpcMethodIs
+
+	var grpcMethodIsIC []string
+	for _, grpcMethodIsIIR := range grpcMethodIsIR { // explode []string
+
+		grpcMethodIsIIV := grpcMethodIsIIR // string as string
+		grpcMethodIsIC = append(grpcMethodIsIC, grpcMethodIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	grpcMethodIsIS := swag.JoinByFormat(grpcMethodIsIC, "")
+
+	return grpcMethodIsIS
+}
+
+// bindParamGetAPIEvents binds the parameter grpcService[is]
+func (o *GetAPIEventsParams) bindParamGrpcServiceIs(formats strfmt.Registry) []string {
+	grpcServiceIsIR := o.GrpcServiceIs
+
+	var grpcServiceIsIC []string
+	for _, grpcServiceIsIIR := range grpcServiceIsIR { // explode []string
+
+		grpcServiceIsIIV := grpcServiceIsIIR // string as string
+		grpcServiceIsIC = append(grpcServiceIsIC, grpcServiceIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	grpcServiceIsIS := swag.JoinByFormat(grpcServiceIsIC, "")
+
+	return grpcServiceIsIS
+}
+
 // bindParamGetAPIEvents binds the parameter method[is]
 func (o *GetAPIEventsParams) bindParamMethodIs(formats strfmt.Registry) []string {
 	methodIsIR := o.MethodIs
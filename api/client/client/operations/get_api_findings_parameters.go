@@ -0,0 +1,566 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIFindingsParams creates a new GetAPIFindingsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAPIFindingsParams() *GetAPIFindingsParams {
+	return &GetAPIFindingsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAPIFindingsParamsWithTimeout creates a new GetAPIFindingsParams object
+// with the ability to set a timeout on a request.
+func NewGetAPIFindingsParamsWithTimeout(timeout time.Duration) *GetAPIFindingsParams {
+	return &GetAPIFindingsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAPIFindingsParamsWithContext creates a new GetAPIFindingsParams object
+// with the ability to set a context for a request.
+func NewGetAPIFindingsParamsWithContext(ctx context.Context) *GetAPIFindingsParams {
+	return &GetAPIFindingsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAPIFindingsParamsWithHTTPClient creates a new GetAPIFindingsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAPIFindingsParamsWithHTTPClient(client *http.Client) *GetAPIFindingsParams {
+	return &GetAPIFindingsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetAPIFindingsParams contains all the parameters to send to the API endpoint
+   for the get API findings operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetAPIFindingsParams struct {
+
+	// APIInfoIDIs.
+	//
+	// Format: uint32
+	APIInfoIDIs *uint32
+
+	/* EndTime.
+
+	   Only the findings seen until this time are returned
+
+	   Format: date-time
+	*/
+	EndTime *strfmt.DateTime
+
+	// ModuleNameIs.
+	ModuleNameIs []string
+
+	/* Page.
+
+	   Page number of the query
+	*/
+	Page int64
+
+	/* PageSize.
+
+	   Maximum items to return
+	*/
+	PageSize int64
+
+	// SeverityIs.
+	SeverityIs []string
+
+	/* SortDir.
+
+	   Sorting direction
+
+	   Default: "ASC"
+	*/
+	SortDir *string
+
+	/* SortKey.
+
+	   Sort key
+	*/
+	SortKey string
+
+	/* SpecLocationIs.
+
+	   Location of the finding in the provided or reconstructed spec
+	*/
+	SpecLocationIs []string
+
+	/* SpecLocationStart.
+
+	   Prefix of the location of the finding in the provided or reconstructed spec
+	*/
+	SpecLocationStart *string
+
+	/* StartTime.
+
+	   Only the findings seen since this time are returned
+
+	   Format: date-time
+	*/
+	StartTime *strfmt.DateTime
+
+	// TypeIs.
+	TypeIs []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get API findings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIFindingsParams) WithDefaults() *GetAPIFindingsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get API findings params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIFindingsParams) SetDefaults() {
+	var (
+		sortDirDefault = string("ASC")
+	)
+
+	val := GetAPIFindingsParams{
+		SortDir: &sortDirDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get API findings params
+func (o *GetAPIFindingsParams) WithTimeout(timeout time.Duration) *GetAPIFindingsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get API findings params
+func (o *GetAPIFindingsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get API findings params
+func (o *GetAPIFindingsParams) WithContext(ctx context.Context) *GetAPIFindingsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get API findings params
+func (o *GetAPIFindingsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get API findings params
+func (o *GetAPIFindingsParams) WithHTTPClient(client *http.Client) *GetAPIFindingsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get API findings params
+func (o *GetAPIFindingsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIInfoIDIs adds the aPIInfoIDIs to the get API findings params
+func (o *GetAPIFindingsParams) WithAPIInfoIDIs(aPIInfoIDIs *uint32) *GetAPIFindingsParams {
+	o.SetAPIInfoIDIs(aPIInfoIDIs)
+	return o
+}
+
+// SetAPIInfoIDIs adds the apiInfoIdIs to the get API findings params
+func (o *GetAPIFindingsParams) SetAPIInfoIDIs(aPIInfoIDIs *uint32) {
+	o.APIInfoIDIs = aPIInfoIDIs
+}
+
+// WithEndTime adds the endTime to the get API findings params
+func (o *GetAPIFindingsParams) WithEndTime(endTime *strfmt.DateTime) *GetAPIFindingsParams {
+	o.SetEndTime(endTime)
+	return o
+}
+
+// SetEndTime adds the endTime to the get API findings params
+func (o *GetAPIFindingsParams) SetEndTime(endTime *strfmt.DateTime) {
+	o.EndTime = endTime
+}
+
+// WithModuleNameIs adds the moduleNameIs to the get API findings params
+func (o *GetAPIFindingsParams) WithModuleNameIs(moduleNameIs []string) *GetAPIFindingsParams {
+	o.SetModuleNameIs(moduleNameIs)
+	return o
+}
+
+// SetModuleNameIs adds the moduleNameIs to the get API findings params
+func (o *GetAPIFindingsParams) SetModuleNameIs(moduleNameIs []string) {
+	o.ModuleNameIs = moduleNameIs
+}
+
+// WithPage adds the page to the get API findings params
+func (o *GetAPIFindingsParams) WithPage(page int64) *GetAPIFindingsParams {
+	o.SetPage(page)
+	return o
+}
+
+// SetPage adds the page to the get API findings params
+func (o *GetAPIFindingsParams) SetPage(page int64) {
+	o.Page = page
+}
+
+// WithPageSize adds the pageSize to the get API findings params
+func (o *GetAPIFindingsParams) WithPageSize(pageSize int64) *GetAPIFindingsParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the get API findings params
+func (o *GetAPIFindingsParams) SetPageSize(pageSize int64) {
+	o.PageSize = pageSize
+}
+
+// WithSeverityIs adds the severityIs to the get API findings params
+func (o *GetAPIFindingsParams) WithSeverityIs(severityIs []string) *GetAPIFindingsParams {
+	o.SetSeverityIs(severityIs)
+	return o
+}
+
+// SetSeverityIs adds the severityIs to the get API findings params
+func (o *GetAPIFindingsParams) SetSeverityIs(severityIs []string) {
+	o.SeverityIs = severityIs
+}
+
+// WithSortDir adds the sortDir to the get API findings params
+func (o *GetAPIFindingsParams) WithSortDir(sortDir *string) *GetAPIFindingsParams {
+	o.SetSortDir(sortDir)
+	return o
+}
+
+// SetSortDir adds the sortDir to the get API findings params
+func (o *GetAPIFindingsParams) SetSortDir(sortDir *string) {
+	o.SortDir = sortDir
+}
+
+// WithSortKey adds the sortKey to the get API findings params
+func (o *GetAPIFindingsParams) WithSortKey(sortKey string) *GetAPIFindingsParams {
+	o.SetSortKey(sortKey)
+	return o
+}
+
+// SetSortKey adds the sortKey to the get API findings params
+func (o *GetAPIFindingsParams) SetSortKey(sortKey string) {
+	o.SortKey = sortKey
+}
+
+// WithSpecLocationIs adds the specLocationIs to the get API findings params
+func (o *GetAPIFindingsParams) WithSpecLocationIs(specLocationIs []string) *GetAPIFindingsParams {
+	o.SetSpecLocationIs(specLocationIs)
+	return o
+}
+
+// SetSpecLocationIs adds the specLocationIs to the get API findings params
+func (o *GetAPIFindingsParams) SetSpecLocationIs(specLocationIs []string) {
+	o.SpecLocationIs = specLocationIs
+}
+
+// WithSpecLocationStart adds the specLocationStart to the get API findings params
+func (o *GetAPIFindingsParams) WithSpecLocationStart(specLocationStart *string) *GetAPIFindingsParams {
+	o.SetSpecLocationStart(specLocationStart)
+	return o
+}
+
+// SetSpecLocationStart adds the specLocationStart to the get API findings params
+func (o *GetAPIFindingsParams) SetSpecLocationStart(specLocationStart *string) {
+	o.SpecLocationStart = specLocationStart
+}
+
+// WithStartTime adds the startTime to the get API findings params
+func (o *GetAPIFindingsParams) WithStartTime(startTime *strfmt.DateTime) *GetAPIFindingsParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the get API findings params
+func (o *GetAPIFindingsParams) SetStartTime(startTime *strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
+// WithTypeIs adds the typeIs to the get API findings params
+func (o *GetAPIFindingsParams) WithTypeIs(typeIs []string) *GetAPIFindingsParams {
+	o.SetTypeIs(typeIs)
+	return o
+}
+
+// SetTypeIs adds the typeIs to the get API findings params
+func (o *GetAPIFindingsParams) SetTypeIs(typeIs []string) {
+	o.TypeIs = typeIs
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAPIFindingsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.APIInfoIDIs != nil {
+
+		// query param apiInfoId[is]
+		var qrAPIInfoIDIs uint32
+
+		if o.APIInfoIDIs != nil {
+			qrAPIInfoIDIs = *o.APIInfoIDIs
+		}
+		qAPIInfoIDIs := swag.FormatUint32(qrAPIInfoIDIs)
+		if qAPIInfoIDIs != "" {
+
+			if err := r.SetQueryParam("apiInfoId[is]", qAPIInfoIDIs); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.EndTime != nil {
+
+		// query param endTime
+		var qrEndTime strfmt.DateTime
+
+		if o.EndTime != nil {
+			qrEndTime = *o.EndTime
+		}
+		qEndTime := qrEndTime.String()
+		if qEndTime != "" {
+
+			if err := r.SetQueryParam("endTime", qEndTime); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ModuleNameIs != nil {
+
+		// binding items for moduleName[is]
+		joinedModuleNameIs := o.bindParamModuleNameIs(reg)
+
+		// query array param moduleName[is]
+		if err := r.SetQueryParam("moduleName[is]", joinedModuleNameIs...); err != nil {
+			return err
+		}
+	}
+
+	// query param page
+	qrPage := o.Page
+	qPage := swag.FormatInt64(qrPage)
+	if qPage != "" {
+
+		if err := r.SetQueryParam("page", qPage); err != nil {
+			return err
+		}
+	}
+
+	// query param pageSize
+	qrPageSize := o.PageSize
+	qPageSize := swag.FormatInt64(qrPageSize)
+	if qPageSize != "" {
+
+		if err := r.SetQueryParam("pageSize", qPageSize); err != nil {
+			return err
+		}
+	}
+
+	if o.SeverityIs != nil {
+
+		// binding items for severity[is]
+		joinedSeverityIs := o.bindParamSeverityIs(reg)
+
+		// query array param severity[is]
+		if err := r.SetQueryParam("severity[is]", joinedSeverityIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.SortDir != nil {
+
+		// query param sortDir
+		var qrSortDir string
+
+		if o.SortDir != nil {
+			qrSortDir = *o.SortDir
+		}
+		qSortDir := qrSortDir
+		if qSortDir != "" {
+
+			if err := r.SetQueryParam("sortDir", qSortDir); err != nil {
+				return err
+			}
+		}
+	}
+
+	// query param sortKey
+	qrSortKey := o.SortKey
+	qSortKey := qrSortKey
+	if qSortKey != "" {
+
+		if err := r.SetQueryParam("sortKey", qSortKey); err != nil {
+			return err
+		}
+	}
+
+	if o.SpecLocationIs != nil {
+
+		// binding items for specLocation[is]
+		joinedSpecLocationIs := o.bindParamSpecLocationIs(reg)
+
+		// query array param specLocation[is]
+		if err := r.SetQueryParam("specLocation[is]", joinedSpecLocationIs...); err != nil {
+			return err
+		}
+	}
+
+	if o.SpecLocationStart != nil {
+
+		// query param specLocation[start]
+		var qrSpecLocationStart string
+
+		if o.SpecLocationStart != nil {
+			qrSpecLocationStart = *o.SpecLocationStart
+		}
+		qSpecLocationStart := qrSpecLocationStart
+		if qSpecLocationStart != "" {
+
+			if err := r.SetQueryParam("specLocation[start]", qSpecLocationStart); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.StartTime != nil {
+
+		// query param startTime
+		var qrStartTime strfmt.DateTime
+
+		if o.StartTime != nil {
+			qrStartTime = *o.StartTime
+		}
+		qStartTime := qrStartTime.String()
+		if qStartTime != "" {
+
+			if err := r.SetQueryParam("startTime", qStartTime); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TypeIs != nil {
+
+		// binding items for type[is]
+		joinedTypeIs := o.bindParamTypeIs(reg)
+
+		// query array param type[is]
+		if err := r.SetQueryParam("type[is]", joinedTypeIs...); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamGetAPIFindings binds the parameter moduleName[is]
+func (o *GetAPIFindingsParams) bindParamModuleNameIs(formats strfmt.Registry) []string {
+	moduleNameIsIR := o.ModuleNameIs
+
+	var moduleNameIsIC []string
+	for _, moduleNameIsIIR := range moduleNameIsIR { // explode []string
+
+		moduleNameIsIIV := moduleNameIsIIR // string as string
+		moduleNameIsIC = append(moduleNameIsIC, moduleNameIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	moduleNameIsIS := swag.JoinByFormat(moduleNameIsIC, "")
+
+	return moduleNameIsIS
+}
+
+// bindParamGetAPIFindings binds the parameter severity[is]
+func (o *GetAPIFindingsParams) bindParamSeverityIs(formats strfmt.Registry) []string {
+	severityIsIR := o.SeverityIs
+
+	var severityIsIC []string
+	for _, severityIsIIR := range severityIsIR { // explode []string
+
+		severityIsIIV := severityIsIIR // string as string
+		severityIsIC = append(severityIsIC, severityIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	severityIsIS := swag.JoinByFormat(severityIsIC, "")
+
+	return severityIsIS
+}
+
+// bindParamGetAPIFindings binds the parameter specLocation[is]
+func (o *GetAPIFindingsParams) bindParamSpecLocationIs(formats strfmt.Registry) []string {
+	specLocationIsIR := o.SpecLocationIs
+
+	var specLocationIsIC []string
+	for _, specLocationIsIIR := range specLocationIsIR { // explode []string
+
+		specLocationIsIIV := specLocationIsIIR // string as string
+		specLocationIsIC = append(specLocationIsIC, specLocationIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	specLocationIsIS := swag.JoinByFormat(specLocationIsIC, "")
+
+	return specLocationIsIS
+}
+
+// bindParamGetAPIFindings binds the parameter type[is]
+func (o *GetAPIFindingsParams) bindParamTypeIs(formats strfmt.Registry) []string {
+	typeIsIR := o.TypeIs
+
+	var typeIsIC []string
+	for _, typeIsIIR := range typeIsIR { // explode []string
+
+		typeIsIIV := typeIsIIR // string as string
+		typeIsIC = append(typeIsIC, typeIsIIV)
+	}
+
+	// items.CollectionFormat: ""
+	typeIsIS := swag.JoinByFormat(typeIsIC, "")
+
+	return typeIsIS
+}
@@ -0,0 +1,234 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetAPIFindingsReader is a Reader for the GetAPIFindings structure.
+type GetAPIFindingsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAPIFindingsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAPIFindingsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetAPIFindingsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAPIFindingsOK creates a GetAPIFindingsOK with default headers values
+func NewGetAPIFindingsOK() *GetAPIFindingsOK {
+	return &GetAPIFindingsOK{}
+}
+
+/* GetAPIFindingsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetAPIFindingsOK struct {
+	Payload *GetAPIFindingsOKBody
+}
+
+func (o *GetAPIFindingsOK) Error() string {
+	return fmt.Sprintf("[GET /apiFindings][%d] getApiFindingsOK  %+v", 200, o.Payload)
+}
+func (o *GetAPIFindingsOK) GetPayload() *GetAPIFindingsOKBody {
+	return o.Payload
+}
+
+func (o *GetAPIFindingsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(GetAPIFindingsOKBody)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIFindingsDefault creates a GetAPIFindingsDefault with default headers values
+func NewGetAPIFindingsDefault(code int) *GetAPIFindingsDefault {
+	return &GetAPIFindingsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetAPIFindingsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetAPIFindingsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get API findings default response
+func (o *GetAPIFindingsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAPIFindingsDefault) Error() string {
+	return fmt.Sprintf("[GET /apiFindings][%d] GetAPIFindings default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetAPIFindingsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIFindingsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*GetAPIFindingsOKBody get API findings o k body
+swagger:model GetAPIFindingsOKBody
+*/
+type GetAPIFindingsOKBody struct {
+
+	// List of filtered findings in the given page. List length must be lower or equal to pageSize
+	Items []*models.APIFinding `json:"items"`
+
+	// Total filtered findings count
+	// Required: true
+	Total *int64 `json:"total"`
+}
+
+// Validate validates this get API findings o k body
+func (o *GetAPIFindingsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateItems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) validateItems(formats strfmt.Registry) error {
+	if swag.IsZero(o.Items) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Items); i++ {
+		if swag.IsZero(o.Items[i]) { // not required
+			continue
+		}
+
+		if o.Items[i] != nil {
+			if err := o.Items[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getApiFindingsOK" + "." + "items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("getApiFindingsOK"+"."+"total", "body", o.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get API findings o k body based on the context it is used
+func (o *GetAPIFindingsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateItems(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) contextValidateItems(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Items); i++ {
+
+		if o.Items[i] != nil {
+			if err := o.Items[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getApiFindingsOK" + "." + "items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetAPIFindingsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetAPIFindingsOKBody) UnmarshalBinary(b []byte) error {
+	var res GetAPIFindingsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsParams creates a new GetAPIInventoryAPIIDGraphqlOperationsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAPIInventoryAPIIDGraphqlOperationsParams() *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	return &GetAPIInventoryAPIIDGraphqlOperationsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithTimeout creates a new GetAPIInventoryAPIIDGraphqlOperationsParams object
+// with the ability to set a timeout on a request.
+func NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	return &GetAPIInventoryAPIIDGraphqlOperationsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithContext creates a new GetAPIInventoryAPIIDGraphqlOperationsParams object
+// with the ability to set a context for a request.
+func NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithContext(ctx context.Context) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	return &GetAPIInventoryAPIIDGraphqlOperationsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithHTTPClient creates a new GetAPIInventoryAPIIDGraphqlOperationsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAPIInventoryAPIIDGraphqlOperationsParamsWithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	return &GetAPIInventoryAPIIDGraphqlOperationsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetAPIInventoryAPIIDGraphqlOperationsParams contains all the parameters to send to the API endpoint
+   for the get API inventory API ID graphql operations operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetAPIInventoryAPIIDGraphqlOperationsParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	/* EndTime.
+
+	   End time of the query
+
+	   Format: date-time
+	*/
+	EndTime strfmt.DateTime
+
+	/* StartTime.
+
+	   Start time of the query
+
+	   Format: date-time
+	*/
+	StartTime strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get API inventory API ID graphql operations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithDefaults() *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get API inventory API ID graphql operations params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithContext(ctx context.Context) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithAPIID(aPIID uint32) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithEndTime adds the endTime to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithEndTime(endTime strfmt.DateTime) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetEndTime(endTime)
+	return o
+}
+
+// SetEndTime adds the endTime to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetEndTime(endTime strfmt.DateTime) {
+	o.EndTime = endTime
+}
+
+// WithStartTime adds the startTime to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WithStartTime(startTime strfmt.DateTime) *GetAPIInventoryAPIIDGraphqlOperationsParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the get API inventory API ID graphql operations params
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) SetStartTime(startTime strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAPIInventoryAPIIDGraphqlOperationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+
+	// query param endTime
+	qrEndTime := o.EndTime
+	qEndTime := qrEndTime.String()
+	if qEndTime != "" {
+
+		if err := r.SetQueryParam("endTime", qEndTime); err != nil {
+			return err
+		}
+	}
+
+	// query param startTime
+	qrStartTime := o.StartTime
+	qStartTime := qrStartTime.String()
+	if qStartTime != "" {
+
+		if err := r.SetQueryParam("startTime", qStartTime); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
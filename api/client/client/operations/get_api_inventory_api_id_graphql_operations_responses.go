@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetAPIInventoryAPIIDGraphqlOperationsReader is a Reader for the GetAPIInventoryAPIIDGraphqlOperations structure.
+type GetAPIInventoryAPIIDGraphqlOperationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAPIInventoryAPIIDGraphqlOperationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAPIInventoryAPIIDGraphqlOperationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetAPIInventoryAPIIDGraphqlOperationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsOK creates a GetAPIInventoryAPIIDGraphqlOperationsOK with default headers values
+func NewGetAPIInventoryAPIIDGraphqlOperationsOK() *GetAPIInventoryAPIIDGraphqlOperationsOK {
+	return &GetAPIInventoryAPIIDGraphqlOperationsOK{}
+}
+
+/* GetAPIInventoryAPIIDGraphqlOperationsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetAPIInventoryAPIIDGraphqlOperationsOK struct {
+	Payload []*models.GraphQLOperationUsage
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/graphql/operations][%d] getApiInventoryApiIdGraphqlOperationsOK  %+v", 200, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) GetPayload() []*models.GraphQLOperationUsage {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsDefault creates a GetAPIInventoryAPIIDGraphqlOperationsDefault with default headers values
+func NewGetAPIInventoryAPIIDGraphqlOperationsDefault(code int) *GetAPIInventoryAPIIDGraphqlOperationsDefault {
+	return &GetAPIInventoryAPIIDGraphqlOperationsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetAPIInventoryAPIIDGraphqlOperationsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetAPIInventoryAPIIDGraphqlOperationsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get API inventory API ID graphql operations default response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/graphql/operations][%d] GetAPIInventoryAPIIDGraphqlOperations default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
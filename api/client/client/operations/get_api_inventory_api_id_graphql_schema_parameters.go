@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaParams creates a new GetAPIInventoryAPIIDGraphqlSchemaParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAPIInventoryAPIIDGraphqlSchemaParams() *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	return &GetAPIInventoryAPIIDGraphqlSchemaParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithTimeout creates a new GetAPIInventoryAPIIDGraphqlSchemaParams object
+// with the ability to set a timeout on a request.
+func NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	return &GetAPIInventoryAPIIDGraphqlSchemaParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithContext creates a new GetAPIInventoryAPIIDGraphqlSchemaParams object
+// with the ability to set a context for a request.
+func NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithContext(ctx context.Context) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	return &GetAPIInventoryAPIIDGraphqlSchemaParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithHTTPClient creates a new GetAPIInventoryAPIIDGraphqlSchemaParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAPIInventoryAPIIDGraphqlSchemaParamsWithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	return &GetAPIInventoryAPIIDGraphqlSchemaParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetAPIInventoryAPIIDGraphqlSchemaParams contains all the parameters to send to the API endpoint
+   for the get API inventory API ID graphql schema operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get API inventory API ID graphql schema params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WithDefaults() *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get API inventory API ID graphql schema params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WithContext(ctx context.Context) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WithAPIID(aPIID uint32) *GetAPIInventoryAPIIDGraphqlSchemaParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the get API inventory API ID graphql schema params
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAPIInventoryAPIIDGraphqlSchemaParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetAPIInventoryAPIIDGraphqlSchemaReader is a Reader for the GetAPIInventoryAPIIDGraphqlSchema structure.
+type GetAPIInventoryAPIIDGraphqlSchemaReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAPIInventoryAPIIDGraphqlSchemaReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAPIInventoryAPIIDGraphqlSchemaOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetAPIInventoryAPIIDGraphqlSchemaNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetAPIInventoryAPIIDGraphqlSchemaDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaOK creates a GetAPIInventoryAPIIDGraphqlSchemaOK with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaOK() *GetAPIInventoryAPIIDGraphqlSchemaOK {
+	return &GetAPIInventoryAPIIDGraphqlSchemaOK{}
+}
+
+/* GetAPIInventoryAPIIDGraphqlSchemaOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaOK struct {
+	Payload *models.GraphQLSchema
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/graphql/schema][%d] getApiInventoryApiIdGraphqlSchemaOK  %+v", 200, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) GetPayload() *models.GraphQLSchema {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GraphQLSchema)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaNotFound creates a GetAPIInventoryAPIIDGraphqlSchemaNotFound with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaNotFound() *GetAPIInventoryAPIIDGraphqlSchemaNotFound {
+	return &GetAPIInventoryAPIIDGraphqlSchemaNotFound{}
+}
+
+/* GetAPIInventoryAPIIDGraphqlSchemaNotFound describes a response with status code 404, with default header values.
+
+No GraphQL traffic for this API
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/graphql/schema][%d] getApiInventoryApiIdGraphqlSchemaNotFound  %+v", 404, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaDefault creates a GetAPIInventoryAPIIDGraphqlSchemaDefault with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaDefault(code int) *GetAPIInventoryAPIIDGraphqlSchemaDefault {
+	return &GetAPIInventoryAPIIDGraphqlSchemaDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetAPIInventoryAPIIDGraphqlSchemaDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get API inventory API ID graphql schema default response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/graphql/schema][%d] GetAPIInventoryAPIIDGraphqlSchema default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,207 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIInventoryAPIIDGrpcMethodsParams creates a new GetAPIInventoryAPIIDGrpcMethodsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetAPIInventoryAPIIDGrpcMethodsParams() *GetAPIInventoryAPIIDGrpcMethodsParams {
+	return &GetAPIInventoryAPIIDGrpcMethodsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsParamsWithTimeout creates a new GetAPIInventoryAPIIDGrpcMethodsParams object
+// with the ability to set a timeout on a request.
+func NewGetAPIInventoryAPIIDGrpcMethodsParamsWithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	return &GetAPIInventoryAPIIDGrpcMethodsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsParamsWithContext creates a new GetAPIInventoryAPIIDGrpcMethodsParams object
+// with the ability to set a context for a request.
+func NewGetAPIInventoryAPIIDGrpcMethodsParamsWithContext(ctx context.Context) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	return &GetAPIInventoryAPIIDGrpcMethodsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsParamsWithHTTPClient creates a new GetAPIInventoryAPIIDGrpcMethodsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetAPIInventoryAPIIDGrpcMethodsParamsWithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	return &GetAPIInventoryAPIIDGrpcMethodsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetAPIInventoryAPIIDGrpcMethodsParams contains all the parameters to send to the API endpoint
+   for the get API inventory API ID grpc methods operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetAPIInventoryAPIIDGrpcMethodsParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	/* EndTime.
+
+	   End time of the query
+
+	   Format: date-time
+	*/
+	EndTime strfmt.DateTime
+
+	/* StartTime.
+
+	   Start time of the query
+
+	   Format: date-time
+	*/
+	StartTime strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get API inventory API ID grpc methods params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithDefaults() *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get API inventory API ID grpc methods params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithTimeout(timeout time.Duration) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithContext(ctx context.Context) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithHTTPClient(client *http.Client) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithAPIID(aPIID uint32) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithEndTime adds the endTime to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithEndTime(endTime strfmt.DateTime) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetEndTime(endTime)
+	return o
+}
+
+// SetEndTime adds the endTime to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetEndTime(endTime strfmt.DateTime) {
+	o.EndTime = endTime
+}
+
+// WithStartTime adds the startTime to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WithStartTime(startTime strfmt.DateTime) *GetAPIInventoryAPIIDGrpcMethodsParams {
+	o.SetStartTime(startTime)
+	return o
+}
+
+// SetStartTime adds the startTime to the get API inventory API ID grpc methods params
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) SetStartTime(startTime strfmt.DateTime) {
+	o.StartTime = startTime
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+
+	// query param endTime
+	qrEndTime := o.EndTime
+	qEndTime := qrEndTime.String()
+	if qEndTime != "" {
+
+		if err := r.SetQueryParam("endTime", qEndTime); err != nil {
+			return err
+		}
+	}
+
+	// query param startTime
+	qrStartTime := o.StartTime
+	qStartTime := qrStartTime.String()
+	if qStartTime != "" {
+
+		if err := r.SetQueryParam("startTime", qStartTime); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
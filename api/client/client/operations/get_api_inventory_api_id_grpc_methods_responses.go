@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetAPIInventoryAPIIDGrpcMethodsReader is a Reader for the GetAPIInventoryAPIIDGrpcMethods structure.
+type GetAPIInventoryAPIIDGrpcMethodsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetAPIInventoryAPIIDGrpcMethodsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetAPIInventoryAPIIDGrpcMethodsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetAPIInventoryAPIIDGrpcMethodsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsOK creates a GetAPIInventoryAPIIDGrpcMethodsOK with default headers values
+func NewGetAPIInventoryAPIIDGrpcMethodsOK() *GetAPIInventoryAPIIDGrpcMethodsOK {
+	return &GetAPIInventoryAPIIDGrpcMethodsOK{}
+}
+
+/* GetAPIInventoryAPIIDGrpcMethodsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetAPIInventoryAPIIDGrpcMethodsOK struct {
+	Payload []*models.GrpcMethodUsage
+}
+
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/grpc/methods][%d] getApiInventoryApiIdGrpcMethodsOK  %+v", 200, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) GetPayload() []*models.GrpcMethodUsage {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsDefault creates a GetAPIInventoryAPIIDGrpcMethodsDefault with default headers values
+func NewGetAPIInventoryAPIIDGrpcMethodsDefault(code int) *GetAPIInventoryAPIIDGrpcMethodsDefault {
+	return &GetAPIInventoryAPIIDGrpcMethodsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetAPIInventoryAPIIDGrpcMethodsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetAPIInventoryAPIIDGrpcMethodsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get API inventory API ID grpc methods default response
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) Error() string {
+	return fmt.Sprintf("[GET /apiInventory/{apiId}/grpc/methods][%d] GetAPIInventoryAPIIDGrpcMethods default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetControlModulesParams creates a new GetControlModulesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlModulesParams() *GetControlModulesParams {
+	return &GetControlModulesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlModulesParamsWithTimeout creates a new GetControlModulesParams object
+// with the ability to set a timeout on a request.
+func NewGetControlModulesParamsWithTimeout(timeout time.Duration) *GetControlModulesParams {
+	return &GetControlModulesParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlModulesParamsWithContext creates a new GetControlModulesParams object
+// with the ability to set a context for a request.
+func NewGetControlModulesParamsWithContext(ctx context.Context) *GetControlModulesParams {
+	return &GetControlModulesParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlModulesParamsWithHTTPClient creates a new GetControlModulesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlModulesParamsWithHTTPClient(client *http.Client) *GetControlModulesParams {
+	return &GetControlModulesParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlModulesParams contains all the parameters to send to the API endpoint
+   for the get control modules operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlModulesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control modules params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlModulesParams) WithDefaults() *GetControlModulesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control modules params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlModulesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control modules params
+func (o *GetControlModulesParams) WithTimeout(timeout time.Duration) *GetControlModulesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control modules params
+func (o *GetControlModulesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control modules params
+func (o *GetControlModulesParams) WithContext(ctx context.Context) *GetControlModulesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control modules params
+func (o *GetControlModulesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control modules params
+func (o *GetControlModulesParams) WithHTTPClient(client *http.Client) *GetControlModulesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control modules params
+func (o *GetControlModulesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlModulesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
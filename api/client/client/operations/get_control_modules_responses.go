@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlModulesReader is a Reader for the GetControlModules structure.
+type GetControlModulesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlModulesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlModulesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlModulesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlModulesOK creates a GetControlModulesOK with default headers values
+func NewGetControlModulesOK() *GetControlModulesOK {
+	return &GetControlModulesOK{}
+}
+
+/* GetControlModulesOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlModulesOK struct {
+	Payload []*models.ModuleStatus
+}
+
+func (o *GetControlModulesOK) Error() string {
+	return fmt.Sprintf("[GET /control/modules][%d] getControlModulesOK  %+v", 200, o.Payload)
+}
+func (o *GetControlModulesOK) GetPayload() []*models.ModuleStatus {
+	return o.Payload
+}
+
+func (o *GetControlModulesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlModulesDefault creates a GetControlModulesDefault with default headers values
+func NewGetControlModulesDefault(code int) *GetControlModulesDefault {
+	return &GetControlModulesDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlModulesDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlModulesDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control modules default response
+func (o *GetControlModulesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlModulesDefault) Error() string {
+	return fmt.Sprintf("[GET /control/modules][%d] GetControlModules default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlModulesDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlModulesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetControlRedactionPolicyParams creates a new GetControlRedactionPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlRedactionPolicyParams() *GetControlRedactionPolicyParams {
+	return &GetControlRedactionPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlRedactionPolicyParamsWithTimeout creates a new GetControlRedactionPolicyParams object
+// with the ability to set a timeout on a request.
+func NewGetControlRedactionPolicyParamsWithTimeout(timeout time.Duration) *GetControlRedactionPolicyParams {
+	return &GetControlRedactionPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlRedactionPolicyParamsWithContext creates a new GetControlRedactionPolicyParams object
+// with the ability to set a context for a request.
+func NewGetControlRedactionPolicyParamsWithContext(ctx context.Context) *GetControlRedactionPolicyParams {
+	return &GetControlRedactionPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlRedactionPolicyParamsWithHTTPClient creates a new GetControlRedactionPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlRedactionPolicyParamsWithHTTPClient(client *http.Client) *GetControlRedactionPolicyParams {
+	return &GetControlRedactionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlRedactionPolicyParams contains all the parameters to send to the API endpoint
+   for the get control redaction policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlRedactionPolicyParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlRedactionPolicyParams) WithDefaults() *GetControlRedactionPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlRedactionPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) WithTimeout(timeout time.Duration) *GetControlRedactionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) WithContext(ctx context.Context) *GetControlRedactionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) WithHTTPClient(client *http.Client) *GetControlRedactionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control redaction policy params
+func (o *GetControlRedactionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlRedactionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlRedactionPolicyReader is a Reader for the GetControlRedactionPolicy structure.
+type GetControlRedactionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlRedactionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlRedactionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlRedactionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlRedactionPolicyOK creates a GetControlRedactionPolicyOK with default headers values
+func NewGetControlRedactionPolicyOK() *GetControlRedactionPolicyOK {
+	return &GetControlRedactionPolicyOK{}
+}
+
+/* GetControlRedactionPolicyOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlRedactionPolicyOK struct {
+	Payload *models.RedactionPolicy
+}
+
+func (o *GetControlRedactionPolicyOK) Error() string {
+	return fmt.Sprintf("[GET /control/redactionPolicy][%d] getControlRedactionPolicyOK  %+v", 200, o.Payload)
+}
+func (o *GetControlRedactionPolicyOK) GetPayload() *models.RedactionPolicy {
+	return o.Payload
+}
+
+func (o *GetControlRedactionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RedactionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlRedactionPolicyDefault creates a GetControlRedactionPolicyDefault with default headers values
+func NewGetControlRedactionPolicyDefault(code int) *GetControlRedactionPolicyDefault {
+	return &GetControlRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlRedactionPolicyDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlRedactionPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control redaction policy default response
+func (o *GetControlRedactionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlRedactionPolicyDefault) Error() string {
+	return fmt.Sprintf("[GET /control/redactionPolicy][%d] GetControlRedactionPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlRedactionPolicyDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlRedactionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
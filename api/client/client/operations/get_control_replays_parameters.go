@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetControlReplaysParams creates a new GetControlReplaysParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlReplaysParams() *GetControlReplaysParams {
+	return &GetControlReplaysParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlReplaysParamsWithTimeout creates a new GetControlReplaysParams object
+// with the ability to set a timeout on a request.
+func NewGetControlReplaysParamsWithTimeout(timeout time.Duration) *GetControlReplaysParams {
+	return &GetControlReplaysParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlReplaysParamsWithContext creates a new GetControlReplaysParams object
+// with the ability to set a context for a request.
+func NewGetControlReplaysParamsWithContext(ctx context.Context) *GetControlReplaysParams {
+	return &GetControlReplaysParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlReplaysParamsWithHTTPClient creates a new GetControlReplaysParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlReplaysParamsWithHTTPClient(client *http.Client) *GetControlReplaysParams {
+	return &GetControlReplaysParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlReplaysParams contains all the parameters to send to the API endpoint
+   for the get control replays operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlReplaysParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control replays params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlReplaysParams) WithDefaults() *GetControlReplaysParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control replays params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlReplaysParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control replays params
+func (o *GetControlReplaysParams) WithTimeout(timeout time.Duration) *GetControlReplaysParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control replays params
+func (o *GetControlReplaysParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control replays params
+func (o *GetControlReplaysParams) WithContext(ctx context.Context) *GetControlReplaysParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control replays params
+func (o *GetControlReplaysParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control replays params
+func (o *GetControlReplaysParams) WithHTTPClient(client *http.Client) *GetControlReplaysParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control replays params
+func (o *GetControlReplaysParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlReplaysParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetControlReplaysReplayIDParams creates a new GetControlReplaysReplayIDParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlReplaysReplayIDParams() *GetControlReplaysReplayIDParams {
+	return &GetControlReplaysReplayIDParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlReplaysReplayIDParamsWithTimeout creates a new GetControlReplaysReplayIDParams object
+// with the ability to set a timeout on a request.
+func NewGetControlReplaysReplayIDParamsWithTimeout(timeout time.Duration) *GetControlReplaysReplayIDParams {
+	return &GetControlReplaysReplayIDParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlReplaysReplayIDParamsWithContext creates a new GetControlReplaysReplayIDParams object
+// with the ability to set a context for a request.
+func NewGetControlReplaysReplayIDParamsWithContext(ctx context.Context) *GetControlReplaysReplayIDParams {
+	return &GetControlReplaysReplayIDParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlReplaysReplayIDParamsWithHTTPClient creates a new GetControlReplaysReplayIDParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlReplaysReplayIDParamsWithHTTPClient(client *http.Client) *GetControlReplaysReplayIDParams {
+	return &GetControlReplaysReplayIDParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlReplaysReplayIDParams contains all the parameters to send to the API endpoint
+   for the get control replays replay ID operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlReplaysReplayIDParams struct {
+
+	// ReplayID.
+	//
+	// Format: uint32
+	ReplayID uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control replays replay ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlReplaysReplayIDParams) WithDefaults() *GetControlReplaysReplayIDParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control replays replay ID params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlReplaysReplayIDParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) WithTimeout(timeout time.Duration) *GetControlReplaysReplayIDParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) WithContext(ctx context.Context) *GetControlReplaysReplayIDParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) WithHTTPClient(client *http.Client) *GetControlReplaysReplayIDParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithReplayID adds the replayID to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) WithReplayID(replayID uint32) *GetControlReplaysReplayIDParams {
+	o.SetReplayID(replayID)
+	return o
+}
+
+// SetReplayID adds the replayId to the get control replays replay ID params
+func (o *GetControlReplaysReplayIDParams) SetReplayID(replayID uint32) {
+	o.ReplayID = replayID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlReplaysReplayIDParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param replayId
+	if err := r.SetPathParam("replayId", swag.FormatUint32(o.ReplayID)); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
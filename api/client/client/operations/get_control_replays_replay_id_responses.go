@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlReplaysReplayIDReader is a Reader for the GetControlReplaysReplayID structure.
+type GetControlReplaysReplayIDReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlReplaysReplayIDReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlReplaysReplayIDOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetControlReplaysReplayIDNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetControlReplaysReplayIDDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlReplaysReplayIDOK creates a GetControlReplaysReplayIDOK with default headers values
+func NewGetControlReplaysReplayIDOK() *GetControlReplaysReplayIDOK {
+	return &GetControlReplaysReplayIDOK{}
+}
+
+/* GetControlReplaysReplayIDOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlReplaysReplayIDOK struct {
+	Payload *models.Replay
+}
+
+func (o *GetControlReplaysReplayIDOK) Error() string {
+	return fmt.Sprintf("[GET /control/replays/{replayId}][%d] getControlReplaysReplayIdOK  %+v", 200, o.Payload)
+}
+func (o *GetControlReplaysReplayIDOK) GetPayload() *models.Replay {
+	return o.Payload
+}
+
+func (o *GetControlReplaysReplayIDOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Replay)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlReplaysReplayIDNotFound creates a GetControlReplaysReplayIDNotFound with default headers values
+func NewGetControlReplaysReplayIDNotFound() *GetControlReplaysReplayIDNotFound {
+	return &GetControlReplaysReplayIDNotFound{}
+}
+
+/* GetControlReplaysReplayIDNotFound describes a response with status code 404, with default header values.
+
+Replay not found
+*/
+type GetControlReplaysReplayIDNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *GetControlReplaysReplayIDNotFound) Error() string {
+	return fmt.Sprintf("[GET /control/replays/{replayId}][%d] getControlReplaysReplayIdNotFound  %+v", 404, o.Payload)
+}
+func (o *GetControlReplaysReplayIDNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlReplaysReplayIDNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlReplaysReplayIDDefault creates a GetControlReplaysReplayIDDefault with default headers values
+func NewGetControlReplaysReplayIDDefault(code int) *GetControlReplaysReplayIDDefault {
+	return &GetControlReplaysReplayIDDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlReplaysReplayIDDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlReplaysReplayIDDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control replays replay ID default response
+func (o *GetControlReplaysReplayIDDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlReplaysReplayIDDefault) Error() string {
+	return fmt.Sprintf("[GET /control/replays/{replayId}][%d] GetControlReplaysReplayID default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlReplaysReplayIDDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlReplaysReplayIDDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlReplaysReader is a Reader for the GetControlReplays structure.
+type GetControlReplaysReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlReplaysReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlReplaysOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlReplaysDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlReplaysOK creates a GetControlReplaysOK with default headers values
+func NewGetControlReplaysOK() *GetControlReplaysOK {
+	return &GetControlReplaysOK{}
+}
+
+/* GetControlReplaysOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlReplaysOK struct {
+	Payload []*models.Replay
+}
+
+func (o *GetControlReplaysOK) Error() string {
+	return fmt.Sprintf("[GET /control/replays][%d] getControlReplaysOK  %+v", 200, o.Payload)
+}
+func (o *GetControlReplaysOK) GetPayload() []*models.Replay {
+	return o.Payload
+}
+
+func (o *GetControlReplaysOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlReplaysDefault creates a GetControlReplaysDefault with default headers values
+func NewGetControlReplaysDefault(code int) *GetControlReplaysDefault {
+	return &GetControlReplaysDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlReplaysDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlReplaysDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control replays default response
+func (o *GetControlReplaysDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlReplaysDefault) Error() string {
+	return fmt.Sprintf("[GET /control/replays][%d] GetControlReplays default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlReplaysDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlReplaysDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
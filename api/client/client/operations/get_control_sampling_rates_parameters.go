@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetControlSamplingRatesParams creates a new GetControlSamplingRatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlSamplingRatesParams() *GetControlSamplingRatesParams {
+	return &GetControlSamplingRatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlSamplingRatesParamsWithTimeout creates a new GetControlSamplingRatesParams object
+// with the ability to set a timeout on a request.
+func NewGetControlSamplingRatesParamsWithTimeout(timeout time.Duration) *GetControlSamplingRatesParams {
+	return &GetControlSamplingRatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlSamplingRatesParamsWithContext creates a new GetControlSamplingRatesParams object
+// with the ability to set a context for a request.
+func NewGetControlSamplingRatesParamsWithContext(ctx context.Context) *GetControlSamplingRatesParams {
+	return &GetControlSamplingRatesParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlSamplingRatesParamsWithHTTPClient creates a new GetControlSamplingRatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlSamplingRatesParamsWithHTTPClient(client *http.Client) *GetControlSamplingRatesParams {
+	return &GetControlSamplingRatesParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlSamplingRatesParams contains all the parameters to send to the API endpoint
+   for the get control sampling rates operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlSamplingRatesParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlSamplingRatesParams) WithDefaults() *GetControlSamplingRatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlSamplingRatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) WithTimeout(timeout time.Duration) *GetControlSamplingRatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) WithContext(ctx context.Context) *GetControlSamplingRatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) WithHTTPClient(client *http.Client) *GetControlSamplingRatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control sampling rates params
+func (o *GetControlSamplingRatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlSamplingRatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlSamplingRatesReader is a Reader for the GetControlSamplingRates structure.
+type GetControlSamplingRatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlSamplingRatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlSamplingRatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlSamplingRatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlSamplingRatesOK creates a GetControlSamplingRatesOK with default headers values
+func NewGetControlSamplingRatesOK() *GetControlSamplingRatesOK {
+	return &GetControlSamplingRatesOK{}
+}
+
+/* GetControlSamplingRatesOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlSamplingRatesOK struct {
+	Payload []*models.SamplingRate
+}
+
+func (o *GetControlSamplingRatesOK) Error() string {
+	return fmt.Sprintf("[GET /control/samplingRates][%d] getControlSamplingRatesOK  %+v", 200, o.Payload)
+}
+func (o *GetControlSamplingRatesOK) GetPayload() []*models.SamplingRate {
+	return o.Payload
+}
+
+func (o *GetControlSamplingRatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlSamplingRatesDefault creates a GetControlSamplingRatesDefault with default headers values
+func NewGetControlSamplingRatesDefault(code int) *GetControlSamplingRatesDefault {
+	return &GetControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlSamplingRatesDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlSamplingRatesDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control sampling rates default response
+func (o *GetControlSamplingRatesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlSamplingRatesDefault) Error() string {
+	return fmt.Sprintf("[GET /control/samplingRates][%d] GetControlSamplingRates default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlSamplingRatesDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlSamplingRatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParams creates a new GetControlTraceSourcesTraceSourceIDRedactionPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParams() *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout creates a new GetControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a timeout on a request.
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout(timeout time.Duration) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext creates a new GetControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a context for a request.
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext(ctx context.Context) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient creates a new GetControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient(client *http.Client) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlTraceSourcesTraceSourceIDRedactionPolicyParams contains all the parameters to send to the API endpoint
+   for the get control trace sources trace source ID redaction policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyParams struct {
+
+	/* TraceSourceID.
+
+	   Trace Source ID
+
+	   Format: uuid
+	*/
+	TraceSourceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithDefaults() *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTimeout(timeout time.Duration) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithContext(ctx context.Context) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithHTTPClient(client *http.Client) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithTraceSourceID adds the traceSourceID to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTraceSourceID(traceSourceID strfmt.UUID) *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTraceSourceID(traceSourceID)
+	return o
+}
+
+// SetTraceSourceID adds the traceSourceId to the get control trace sources trace source ID redaction policy params
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTraceSourceID(traceSourceID strfmt.UUID) {
+	o.TraceSourceID = traceSourceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param traceSourceId
+	if err := r.SetPathParam("traceSourceId", o.TraceSourceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
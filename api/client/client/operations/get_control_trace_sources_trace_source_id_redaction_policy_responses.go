@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlTraceSourcesTraceSourceIDRedactionPolicyReader is a Reader for the GetControlTraceSourcesTraceSourceIDRedactionPolicy structure.
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK creates a GetControlTraceSourcesTraceSourceIDRedactionPolicyOK with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK() *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyOK{}
+}
+
+/* GetControlTraceSourcesTraceSourceIDRedactionPolicyOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyOK struct {
+	Payload *models.RedactionPolicy
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) Error() string {
+	return fmt.Sprintf("[GET /control/traceSources/{traceSourceId}/redactionPolicy][%d] getControlTraceSourcesTraceSourceIdRedactionPolicyOK  %+v", 200, o.Payload)
+}
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) GetPayload() *models.RedactionPolicy {
+	return o.Payload
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RedactionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates a GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+/* GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound describes a response with status code 404, with default header values.
+
+Trace Source not found or without its own redaction policy
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) Error() string {
+	return fmt.Sprintf("[GET /control/traceSources/{traceSourceId}/redactionPolicy][%d] getControlTraceSourcesTraceSourceIdRedactionPolicyNotFound  %+v", 404, o.Payload)
+}
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates a GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control trace sources trace source ID redaction policy default response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Error() string {
+	return fmt.Sprintf("[GET /control/traceSources/{traceSourceId}/redactionPolicy][%d] GetControlTraceSourcesTraceSourceIDRedactionPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetControlTracingEndpointsParams creates a new GetControlTracingEndpointsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlTracingEndpointsParams() *GetControlTracingEndpointsParams {
+	return &GetControlTracingEndpointsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlTracingEndpointsParamsWithTimeout creates a new GetControlTracingEndpointsParams object
+// with the ability to set a timeout on a request.
+func NewGetControlTracingEndpointsParamsWithTimeout(timeout time.Duration) *GetControlTracingEndpointsParams {
+	return &GetControlTracingEndpointsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlTracingEndpointsParamsWithContext creates a new GetControlTracingEndpointsParams object
+// with the ability to set a context for a request.
+func NewGetControlTracingEndpointsParamsWithContext(ctx context.Context) *GetControlTracingEndpointsParams {
+	return &GetControlTracingEndpointsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlTracingEndpointsParamsWithHTTPClient creates a new GetControlTracingEndpointsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlTracingEndpointsParamsWithHTTPClient(client *http.Client) *GetControlTracingEndpointsParams {
+	return &GetControlTracingEndpointsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlTracingEndpointsParams contains all the parameters to send to the API endpoint
+   for the get control tracing endpoints operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlTracingEndpointsParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID *uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control tracing endpoints params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTracingEndpointsParams) WithDefaults() *GetControlTracingEndpointsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control tracing endpoints params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTracingEndpointsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) WithTimeout(timeout time.Duration) *GetControlTracingEndpointsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) WithContext(ctx context.Context) *GetControlTracingEndpointsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) WithHTTPClient(client *http.Client) *GetControlTracingEndpointsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) WithAPIID(aPIID *uint32) *GetControlTracingEndpointsParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the get control tracing endpoints params
+func (o *GetControlTracingEndpointsParams) SetAPIID(aPIID *uint32) {
+	o.APIID = aPIID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlTracingEndpointsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.APIID != nil {
+
+		// query param apiId
+		var qrAPIID uint32
+
+		if o.APIID != nil {
+			qrAPIID = *o.APIID
+		}
+		qAPIID := swag.FormatUint32(qrAPIID)
+		if qAPIID != "" {
+
+			if err := r.SetQueryParam("apiId", qAPIID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlTracingEndpointsReader is a Reader for the GetControlTracingEndpoints structure.
+type GetControlTracingEndpointsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlTracingEndpointsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlTracingEndpointsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlTracingEndpointsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlTracingEndpointsOK creates a GetControlTracingEndpointsOK with default headers values
+func NewGetControlTracingEndpointsOK() *GetControlTracingEndpointsOK {
+	return &GetControlTracingEndpointsOK{}
+}
+
+/* GetControlTracingEndpointsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlTracingEndpointsOK struct {
+	Payload []*models.TracingEndpoints
+}
+
+func (o *GetControlTracingEndpointsOK) Error() string {
+	return fmt.Sprintf("[GET /control/tracingEndpoints][%d] getControlTracingEndpointsOK  %+v", 200, o.Payload)
+}
+func (o *GetControlTracingEndpointsOK) GetPayload() []*models.TracingEndpoints {
+	return o.Payload
+}
+
+func (o *GetControlTracingEndpointsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlTracingEndpointsDefault creates a GetControlTracingEndpointsDefault with default headers values
+func NewGetControlTracingEndpointsDefault(code int) *GetControlTracingEndpointsDefault {
+	return &GetControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlTracingEndpointsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control tracing endpoints default response
+func (o *GetControlTracingEndpointsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlTracingEndpointsDefault) Error() string {
+	return fmt.Sprintf("[GET /control/tracingEndpoints][%d] GetControlTracingEndpoints default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlTracingEndpointsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlTracingEndpointsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
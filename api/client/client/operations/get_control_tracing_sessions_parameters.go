@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetControlTracingSessionsParams creates a new GetControlTracingSessionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetControlTracingSessionsParams() *GetControlTracingSessionsParams {
+	return &GetControlTracingSessionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetControlTracingSessionsParamsWithTimeout creates a new GetControlTracingSessionsParams object
+// with the ability to set a timeout on a request.
+func NewGetControlTracingSessionsParamsWithTimeout(timeout time.Duration) *GetControlTracingSessionsParams {
+	return &GetControlTracingSessionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetControlTracingSessionsParamsWithContext creates a new GetControlTracingSessionsParams object
+// with the ability to set a context for a request.
+func NewGetControlTracingSessionsParamsWithContext(ctx context.Context) *GetControlTracingSessionsParams {
+	return &GetControlTracingSessionsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetControlTracingSessionsParamsWithHTTPClient creates a new GetControlTracingSessionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetControlTracingSessionsParamsWithHTTPClient(client *http.Client) *GetControlTracingSessionsParams {
+	return &GetControlTracingSessionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetControlTracingSessionsParams contains all the parameters to send to the API endpoint
+   for the get control tracing sessions operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetControlTracingSessionsParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID *uint32
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTracingSessionsParams) WithDefaults() *GetControlTracingSessionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetControlTracingSessionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) WithTimeout(timeout time.Duration) *GetControlTracingSessionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) WithContext(ctx context.Context) *GetControlTracingSessionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) WithHTTPClient(client *http.Client) *GetControlTracingSessionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) WithAPIID(aPIID *uint32) *GetControlTracingSessionsParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the get control tracing sessions params
+func (o *GetControlTracingSessionsParams) SetAPIID(aPIID *uint32) {
+	o.APIID = aPIID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetControlTracingSessionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.APIID != nil {
+
+		// query param apiId
+		var qrAPIID uint32
+
+		if o.APIID != nil {
+			qrAPIID = *o.APIID
+		}
+		qAPIID := swag.FormatUint32(qrAPIID)
+		if qAPIID != "" {
+
+			if err := r.SetQueryParam("apiId", qAPIID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// GetControlTracingSessionsReader is a Reader for the GetControlTracingSessions structure.
+type GetControlTracingSessionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetControlTracingSessionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetControlTracingSessionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetControlTracingSessionsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetControlTracingSessionsOK creates a GetControlTracingSessionsOK with default headers values
+func NewGetControlTracingSessionsOK() *GetControlTracingSessionsOK {
+	return &GetControlTracingSessionsOK{}
+}
+
+/* GetControlTracingSessionsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetControlTracingSessionsOK struct {
+	Payload []*models.TracingSession
+}
+
+func (o *GetControlTracingSessionsOK) Error() string {
+	return fmt.Sprintf("[GET /control/tracingSessions][%d] getControlTracingSessionsOK  %+v", 200, o.Payload)
+}
+func (o *GetControlTracingSessionsOK) GetPayload() []*models.TracingSession {
+	return o.Payload
+}
+
+func (o *GetControlTracingSessionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetControlTracingSessionsDefault creates a GetControlTracingSessionsDefault with default headers values
+func NewGetControlTracingSessionsDefault(code int) *GetControlTracingSessionsDefault {
+	return &GetControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetControlTracingSessionsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetControlTracingSessionsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get control tracing sessions default response
+func (o *GetControlTracingSessionsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetControlTracingSessionsDefault) Error() string {
+	return fmt.Sprintf("[GET /control/tracingSessions][%d] GetControlTracingSessions default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetControlTracingSessionsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetControlTracingSessionsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
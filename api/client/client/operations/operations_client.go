@@ -30,20 +30,38 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	DeleteAPIInventoryAPIIDClassificationOverride(params *DeleteAPIInventoryAPIIDClassificationOverrideParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDClassificationOverrideOK, error)
+
+	DeleteAPIInventoryAPIIDGrpcDescriptorSet(params *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDGrpcDescriptorSetOK, error)
+
 	DeleteAPIInventoryAPIIDSpecsProvidedSpec(params *DeleteAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsProvidedSpecOK, error)
 
 	DeleteAPIInventoryAPIIDSpecsReconstructedSpec(params *DeleteAPIInventoryAPIIDSpecsReconstructedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsReconstructedSpecOK, error)
 
+	DeleteControlReplaysReplayID(params *DeleteControlReplaysReplayIDParams, opts ...ClientOption) (*DeleteControlReplaysReplayIDOK, error)
+
+	DeleteControlSamplingRates(params *DeleteControlSamplingRatesParams, opts ...ClientOption) (*DeleteControlSamplingRatesNoContent, error)
+
 	DeleteControlTraceSourcesTraceSourceID(params *DeleteControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*DeleteControlTraceSourcesTraceSourceIDNoContent, error)
 
+	DeleteControlTraceSourcesTraceSourceIDRedactionPolicy(params *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent, error)
+
+	DeleteControlTracingEndpoints(params *DeleteControlTracingEndpointsParams, opts ...ClientOption) (*DeleteControlTracingEndpointsNoContent, error)
+
+	DeleteControlTracingSessions(params *DeleteControlTracingSessionsParams, opts ...ClientOption) (*DeleteControlTracingSessionsNoContent, error)
+
 	GetAPIEvents(params *GetAPIEventsParams, opts ...ClientOption) (*GetAPIEventsOK, error)
 
 	GetAPIEventsEventID(params *GetAPIEventsEventIDParams, opts ...ClientOption) (*GetAPIEventsEventIDOK, error)
 
+	GetAPIEventsEventIDGrpcMessages(params *GetAPIEventsEventIDGrpcMessagesParams, opts ...ClientOption) (*GetAPIEventsEventIDGrpcMessagesOK, error)
+
 	GetAPIEventsEventIDProvidedSpecDiff(params *GetAPIEventsEventIDProvidedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDProvidedSpecDiffOK, error)
 
 	GetAPIEventsEventIDReconstructedSpecDiff(params *GetAPIEventsEventIDReconstructedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDReconstructedSpecDiffOK, error)
 
+	GetAPIFindings(params *GetAPIFindingsParams, opts ...ClientOption) (*GetAPIFindingsOK, error)
+
 	GetAPIInventory(params *GetAPIInventoryParams, opts ...ClientOption) (*GetAPIInventoryOK, error)
 
 	GetAPIInventoryAPIIDAPIInfo(params *GetAPIInventoryAPIIDAPIInfoParams, opts ...ClientOption) (*GetAPIInventoryAPIIDAPIInfoOK, error)
@@ -52,6 +70,12 @@ type ClientService interface {
 
 	GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID(params *GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams, opts ...ClientOption) (*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDOK, error)
 
+	GetAPIInventoryAPIIDGraphqlOperations(params *GetAPIInventoryAPIIDGraphqlOperationsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGraphqlOperationsOK, error)
+
+	GetAPIInventoryAPIIDGraphqlSchema(params *GetAPIInventoryAPIIDGraphqlSchemaParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGraphqlSchemaOK, error)
+
+	GetAPIInventoryAPIIDGrpcMethods(params *GetAPIInventoryAPIIDGrpcMethodsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGrpcMethodsOK, error)
+
 	GetAPIInventoryAPIIDProvidedSwaggerJSON(params *GetAPIInventoryAPIIDProvidedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDProvidedSwaggerJSONOK, error)
 
 	GetAPIInventoryAPIIDReconstructedSwaggerJSON(params *GetAPIInventoryAPIIDReconstructedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDReconstructedSwaggerJSONOK, error)
@@ -62,10 +86,26 @@ type ClientService interface {
 
 	GetAPIUsageHitCount(params *GetAPIUsageHitCountParams, opts ...ClientOption) (*GetAPIUsageHitCountOK, error)
 
+	GetControlModules(params *GetControlModulesParams, opts ...ClientOption) (*GetControlModulesOK, error)
+
+	GetControlRedactionPolicy(params *GetControlRedactionPolicyParams, opts ...ClientOption) (*GetControlRedactionPolicyOK, error)
+
+	GetControlReplays(params *GetControlReplaysParams, opts ...ClientOption) (*GetControlReplaysOK, error)
+
+	GetControlReplaysReplayID(params *GetControlReplaysReplayIDParams, opts ...ClientOption) (*GetControlReplaysReplayIDOK, error)
+
+	GetControlSamplingRates(params *GetControlSamplingRatesParams, opts ...ClientOption) (*GetControlSamplingRatesOK, error)
+
 	GetControlTraceSources(params *GetControlTraceSourcesParams, opts ...ClientOption) (*GetControlTraceSourcesOK, error)
 
 	GetControlTraceSourcesTraceSourceID(params *GetControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*GetControlTraceSourcesTraceSourceIDOK, error)
 
+	GetControlTraceSourcesTraceSourceIDRedactionPolicy(params *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*GetControlTraceSourcesTraceSourceIDRedactionPolicyOK, error)
+
+	GetControlTracingEndpoints(params *GetControlTracingEndpointsParams, opts ...ClientOption) (*GetControlTracingEndpointsOK, error)
+
+	GetControlTracingSessions(params *GetControlTracingSessionsParams, opts ...ClientOption) (*GetControlTracingSessionsOK, error)
+
 	GetDashboardAPIUsage(params *GetDashboardAPIUsageParams, opts ...ClientOption) (*GetDashboardAPIUsageOK, error)
 
 	GetDashboardAPIUsageLatestDiffs(params *GetDashboardAPIUsageLatestDiffsParams, opts ...ClientOption) (*GetDashboardAPIUsageLatestDiffsOK, error)
@@ -78,32 +118,52 @@ type ClientService interface {
 
 	PostAPIInventoryReviewIDApprovedReview(params *PostAPIInventoryReviewIDApprovedReviewParams, opts ...ClientOption) (*PostAPIInventoryReviewIDApprovedReviewOK, error)
 
+	PostControlHarImport(params *PostControlHarImportParams, opts ...ClientOption) (*PostControlHarImportOK, error)
+
 	PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAPIsParams, opts ...ClientOption) (*PostControlNewDiscoveredAPIsOK, error)
 
+	PostControlReplays(params *PostControlReplaysParams, opts ...ClientOption) (*PostControlReplaysCreated, error)
+
 	PostControlTraceSources(params *PostControlTraceSourcesParams, opts ...ClientOption) (*PostControlTraceSourcesCreated, error)
 
+	PutAPIInventoryAPIIDClassificationOverride(params *PutAPIInventoryAPIIDClassificationOverrideParams, opts ...ClientOption) (*PutAPIInventoryAPIIDClassificationOverrideOK, error)
+
+	PutAPIInventoryAPIIDGrpcDescriptorSet(params *PutAPIInventoryAPIIDGrpcDescriptorSetParams, opts ...ClientOption) (*PutAPIInventoryAPIIDGrpcDescriptorSetOK, error)
+
 	PutAPIInventoryAPIIDSpecsProvidedSpec(params *PutAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*PutAPIInventoryAPIIDSpecsProvidedSpecCreated, error)
 
+	PutControlModulesModuleName(params *PutControlModulesModuleNameParams, opts ...ClientOption) (*PutControlModulesModuleNameOK, error)
+
+	PutControlRedactionPolicy(params *PutControlRedactionPolicyParams, opts ...ClientOption) (*PutControlRedactionPolicyOK, error)
+
+	PutControlSamplingRates(params *PutControlSamplingRatesParams, opts ...ClientOption) (*PutControlSamplingRatesOK, error)
+
+	PutControlTraceSourcesTraceSourceIDRedactionPolicy(params *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*PutControlTraceSourcesTraceSourceIDRedactionPolicyOK, error)
+
+	PutControlTracingEndpoints(params *PutControlTracingEndpointsParams, opts ...ClientOption) (*PutControlTracingEndpointsOK, error)
+
+	PutControlTracingSessions(params *PutControlTracingSessionsParams, opts ...ClientOption) (*PutControlTracingSessionsOK, error)
+
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-  DeleteAPIInventoryAPIIDSpecsProvidedSpec unsets a provided spec for a specific API
+  DeleteAPIInventoryAPIIDClassificationOverride classifies the next traffic of an API by the media type of its responses again
 */
-func (a *Client) DeleteAPIInventoryAPIIDSpecsProvidedSpec(params *DeleteAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsProvidedSpecOK, error) {
+func (a *Client) DeleteAPIInventoryAPIIDClassificationOverride(params *DeleteAPIInventoryAPIIDClassificationOverrideParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDClassificationOverrideOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeleteAPIInventoryAPIIDSpecsProvidedSpecParams()
+		params = NewDeleteAPIInventoryAPIIDClassificationOverrideParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "DeleteAPIInventoryAPIIDSpecsProvidedSpec",
+		ID:                 "DeleteAPIInventoryAPIIDClassificationOverride",
 		Method:             "DELETE",
-		PathPattern:        "/apiInventory/{apiId}/specs/providedSpec",
+		PathPattern:        "/apiInventory/{apiId}/classificationOverride",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &DeleteAPIInventoryAPIIDSpecsProvidedSpecReader{formats: a.formats},
+		Reader:             &DeleteAPIInventoryAPIIDClassificationOverrideReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -115,32 +175,32 @@ func (a *Client) DeleteAPIInventoryAPIIDSpecsProvidedSpec(params *DeleteAPIInven
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeleteAPIInventoryAPIIDSpecsProvidedSpecOK)
+	success, ok := result.(*DeleteAPIInventoryAPIIDClassificationOverrideOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDSpecsProvidedSpecDefault)
+	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDClassificationOverrideDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  DeleteAPIInventoryAPIIDSpecsReconstructedSpec unsets a reconstructed spec for a specific API
+  DeleteAPIInventoryAPIIDGrpcDescriptorSet unsets the protobuf descriptor set of a g RPC API
 */
-func (a *Client) DeleteAPIInventoryAPIIDSpecsReconstructedSpec(params *DeleteAPIInventoryAPIIDSpecsReconstructedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsReconstructedSpecOK, error) {
+func (a *Client) DeleteAPIInventoryAPIIDGrpcDescriptorSet(params *DeleteAPIInventoryAPIIDGrpcDescriptorSetParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDGrpcDescriptorSetOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeleteAPIInventoryAPIIDSpecsReconstructedSpecParams()
+		params = NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "DeleteAPIInventoryAPIIDSpecsReconstructedSpec",
+		ID:                 "DeleteAPIInventoryAPIIDGrpcDescriptorSet",
 		Method:             "DELETE",
-		PathPattern:        "/apiInventory/{apiId}/specs/reconstructedSpec",
+		PathPattern:        "/apiInventory/{apiId}/grpc/descriptorSet",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &DeleteAPIInventoryAPIIDSpecsReconstructedSpecReader{formats: a.formats},
+		Reader:             &DeleteAPIInventoryAPIIDGrpcDescriptorSetReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -152,32 +212,32 @@ func (a *Client) DeleteAPIInventoryAPIIDSpecsReconstructedSpec(params *DeleteAPI
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeleteAPIInventoryAPIIDSpecsReconstructedSpecOK)
+	success, ok := result.(*DeleteAPIInventoryAPIIDGrpcDescriptorSetOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDSpecsReconstructedSpecDefault)
+	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  DeleteControlTraceSourcesTraceSourceID deletes a trace source
+  DeleteAPIInventoryAPIIDSpecsProvidedSpec unsets a provided spec for a specific API
 */
-func (a *Client) DeleteControlTraceSourcesTraceSourceID(params *DeleteControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*DeleteControlTraceSourcesTraceSourceIDNoContent, error) {
+func (a *Client) DeleteAPIInventoryAPIIDSpecsProvidedSpec(params *DeleteAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsProvidedSpecOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeleteControlTraceSourcesTraceSourceIDParams()
+		params = NewDeleteAPIInventoryAPIIDSpecsProvidedSpecParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "DeleteControlTraceSourcesTraceSourceID",
+		ID:                 "DeleteAPIInventoryAPIIDSpecsProvidedSpec",
 		Method:             "DELETE",
-		PathPattern:        "/control/traceSources/{traceSourceId}",
+		PathPattern:        "/apiInventory/{apiId}/specs/providedSpec",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &DeleteControlTraceSourcesTraceSourceIDReader{formats: a.formats},
+		Reader:             &DeleteAPIInventoryAPIIDSpecsProvidedSpecReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -189,32 +249,32 @@ func (a *Client) DeleteControlTraceSourcesTraceSourceID(params *DeleteControlTra
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeleteControlTraceSourcesTraceSourceIDNoContent)
+	success, ok := result.(*DeleteAPIInventoryAPIIDSpecsProvidedSpecOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*DeleteControlTraceSourcesTraceSourceIDDefault)
+	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDSpecsProvidedSpecDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIEvents gets API events
+  DeleteAPIInventoryAPIIDSpecsReconstructedSpec unsets a reconstructed spec for a specific API
 */
-func (a *Client) GetAPIEvents(params *GetAPIEventsParams, opts ...ClientOption) (*GetAPIEventsOK, error) {
+func (a *Client) DeleteAPIInventoryAPIIDSpecsReconstructedSpec(params *DeleteAPIInventoryAPIIDSpecsReconstructedSpecParams, opts ...ClientOption) (*DeleteAPIInventoryAPIIDSpecsReconstructedSpecOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIEventsParams()
+		params = NewDeleteAPIInventoryAPIIDSpecsReconstructedSpecParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIEvents",
-		Method:             "GET",
-		PathPattern:        "/apiEvents",
+		ID:                 "DeleteAPIInventoryAPIIDSpecsReconstructedSpec",
+		Method:             "DELETE",
+		PathPattern:        "/apiInventory/{apiId}/specs/reconstructedSpec",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIEventsReader{formats: a.formats},
+		Reader:             &DeleteAPIInventoryAPIIDSpecsReconstructedSpecReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -226,32 +286,32 @@ func (a *Client) GetAPIEvents(params *GetAPIEventsParams, opts ...ClientOption)
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIEventsOK)
+	success, ok := result.(*DeleteAPIInventoryAPIIDSpecsReconstructedSpecOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIEventsDefault)
+	unexpectedSuccess := result.(*DeleteAPIInventoryAPIIDSpecsReconstructedSpecDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIEventsEventID gets API event
+  DeleteControlReplaysReplayID cancels a replay
 */
-func (a *Client) GetAPIEventsEventID(params *GetAPIEventsEventIDParams, opts ...ClientOption) (*GetAPIEventsEventIDOK, error) {
+func (a *Client) DeleteControlReplaysReplayID(params *DeleteControlReplaysReplayIDParams, opts ...ClientOption) (*DeleteControlReplaysReplayIDOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIEventsEventIDParams()
+		params = NewDeleteControlReplaysReplayIDParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIEventsEventID",
-		Method:             "GET",
-		PathPattern:        "/apiEvents/{eventId}",
+		ID:                 "DeleteControlReplaysReplayID",
+		Method:             "DELETE",
+		PathPattern:        "/control/replays/{replayId}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIEventsEventIDReader{formats: a.formats},
+		Reader:             &DeleteControlReplaysReplayIDReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -263,32 +323,32 @@ func (a *Client) GetAPIEventsEventID(params *GetAPIEventsEventIDParams, opts ...
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIEventsEventIDOK)
+	success, ok := result.(*DeleteControlReplaysReplayIDOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIEventsEventIDDefault)
+	unexpectedSuccess := result.(*DeleteControlReplaysReplayIDDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIEventsEventIDProvidedSpecDiff gets API event provided spec diff
+  DeleteControlSamplingRates deletes the sampling rate of an API for a component all its traces are kept again
 */
-func (a *Client) GetAPIEventsEventIDProvidedSpecDiff(params *GetAPIEventsEventIDProvidedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDProvidedSpecDiffOK, error) {
+func (a *Client) DeleteControlSamplingRates(params *DeleteControlSamplingRatesParams, opts ...ClientOption) (*DeleteControlSamplingRatesNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIEventsEventIDProvidedSpecDiffParams()
+		params = NewDeleteControlSamplingRatesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIEventsEventIDProvidedSpecDiff",
-		Method:             "GET",
-		PathPattern:        "/apiEvents/{eventId}/providedSpecDiff",
+		ID:                 "DeleteControlSamplingRates",
+		Method:             "DELETE",
+		PathPattern:        "/control/samplingRates",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIEventsEventIDProvidedSpecDiffReader{formats: a.formats},
+		Reader:             &DeleteControlSamplingRatesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -300,32 +360,32 @@ func (a *Client) GetAPIEventsEventIDProvidedSpecDiff(params *GetAPIEventsEventID
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIEventsEventIDProvidedSpecDiffOK)
+	success, ok := result.(*DeleteControlSamplingRatesNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIEventsEventIDProvidedSpecDiffDefault)
+	unexpectedSuccess := result.(*DeleteControlSamplingRatesDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIEventsEventIDReconstructedSpecDiff gets API event reconstructed spec diff
+  DeleteControlTraceSourcesTraceSourceID deletes a trace source
 */
-func (a *Client) GetAPIEventsEventIDReconstructedSpecDiff(params *GetAPIEventsEventIDReconstructedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDReconstructedSpecDiffOK, error) {
+func (a *Client) DeleteControlTraceSourcesTraceSourceID(params *DeleteControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*DeleteControlTraceSourcesTraceSourceIDNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIEventsEventIDReconstructedSpecDiffParams()
+		params = NewDeleteControlTraceSourcesTraceSourceIDParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIEventsEventIDReconstructedSpecDiff",
-		Method:             "GET",
-		PathPattern:        "/apiEvents/{eventId}/reconstructedSpecDiff",
+		ID:                 "DeleteControlTraceSourcesTraceSourceID",
+		Method:             "DELETE",
+		PathPattern:        "/control/traceSources/{traceSourceId}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIEventsEventIDReconstructedSpecDiffReader{formats: a.formats},
+		Reader:             &DeleteControlTraceSourcesTraceSourceIDReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -337,32 +397,32 @@ func (a *Client) GetAPIEventsEventIDReconstructedSpecDiff(params *GetAPIEventsEv
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIEventsEventIDReconstructedSpecDiffOK)
+	success, ok := result.(*DeleteControlTraceSourcesTraceSourceIDNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIEventsEventIDReconstructedSpecDiffDefault)
+	unexpectedSuccess := result.(*DeleteControlTraceSourcesTraceSourceIDDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventory gets API inventory
+  DeleteControlTraceSourcesTraceSourceIDRedactionPolicy deletes the redaction policy of a trace source the default policy applies again
 */
-func (a *Client) GetAPIInventory(params *GetAPIInventoryParams, opts ...ClientOption) (*GetAPIInventoryOK, error) {
+func (a *Client) DeleteControlTraceSourcesTraceSourceIDRedactionPolicy(params *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryParams()
+		params = NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventory",
-		Method:             "GET",
-		PathPattern:        "/apiInventory",
+		ID:                 "DeleteControlTraceSourcesTraceSourceIDRedactionPolicy",
+		Method:             "DELETE",
+		PathPattern:        "/control/traceSources/{traceSourceId}/redactionPolicy",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryReader{formats: a.formats},
+		Reader:             &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -374,32 +434,32 @@ func (a *Client) GetAPIInventory(params *GetAPIInventoryParams, opts ...ClientOp
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryOK)
+	success, ok := result.(*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryDefault)
+	unexpectedSuccess := result.(*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDAPIInfo gets api info from api Id
+  DeleteControlTracingEndpoints removes the restriction of the tracing of an API for a component all its endpoints are traced again
 */
-func (a *Client) GetAPIInventoryAPIIDAPIInfo(params *GetAPIInventoryAPIIDAPIInfoParams, opts ...ClientOption) (*GetAPIInventoryAPIIDAPIInfoOK, error) {
+func (a *Client) DeleteControlTracingEndpoints(params *DeleteControlTracingEndpointsParams, opts ...ClientOption) (*DeleteControlTracingEndpointsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDAPIInfoParams()
+		params = NewDeleteControlTracingEndpointsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDAPIInfo",
-		Method:             "GET",
-		PathPattern:        "/apiInventory/{apiId}/apiInfo",
+		ID:                 "DeleteControlTracingEndpoints",
+		Method:             "DELETE",
+		PathPattern:        "/control/tracingEndpoints",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDAPIInfoReader{formats: a.formats},
+		Reader:             &DeleteControlTracingEndpointsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -411,32 +471,32 @@ func (a *Client) GetAPIInventoryAPIIDAPIInfo(params *GetAPIInventoryAPIIDAPIInfo
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDAPIInfoOK)
+	success, ok := result.(*DeleteControlTracingEndpointsNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDAPIInfoDefault)
+	unexpectedSuccess := result.(*DeleteControlTracingEndpointsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDFromHostAndPort gets api Id from host and port
+  DeleteControlTracingSessions stops a tracing session
 */
-func (a *Client) GetAPIInventoryAPIIDFromHostAndPort(params *GetAPIInventoryAPIIDFromHostAndPortParams, opts ...ClientOption) (*GetAPIInventoryAPIIDFromHostAndPortOK, error) {
+func (a *Client) DeleteControlTracingSessions(params *DeleteControlTracingSessionsParams, opts ...ClientOption) (*DeleteControlTracingSessionsNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDFromHostAndPortParams()
+		params = NewDeleteControlTracingSessionsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDFromHostAndPort",
-		Method:             "GET",
-		PathPattern:        "/apiInventory/apiId/fromHostAndPort",
+		ID:                 "DeleteControlTracingSessions",
+		Method:             "DELETE",
+		PathPattern:        "/control/tracingSessions",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDFromHostAndPortReader{formats: a.formats},
+		Reader:             &DeleteControlTracingSessionsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -448,32 +508,32 @@ func (a *Client) GetAPIInventoryAPIIDFromHostAndPort(params *GetAPIInventoryAPII
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDFromHostAndPortOK)
+	success, ok := result.(*DeleteControlTracingSessionsNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDFromHostAndPortDefault)
+	unexpectedSuccess := result.(*DeleteControlTracingSessionsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID gets api Id from host and port and trace source ID
+  GetAPIEvents gets API events
 */
-func (a *Client) GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID(params *GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams, opts ...ClientOption) (*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDOK, error) {
+func (a *Client) GetAPIEvents(params *GetAPIEventsParams, opts ...ClientOption) (*GetAPIEventsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams()
+		params = NewGetAPIEventsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID",
+		ID:                 "GetAPIEvents",
 		Method:             "GET",
-		PathPattern:        "/apiInventory/apiId/fromHostAndPortAndTraceSourceID",
+		PathPattern:        "/apiEvents",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDReader{formats: a.formats},
+		Reader:             &GetAPIEventsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -485,32 +545,32 @@ func (a *Client) GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID(params *Get
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDOK)
+	success, ok := result.(*GetAPIEventsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDDefault)
+	unexpectedSuccess := result.(*GetAPIEventsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDProvidedSwaggerJSON gets provided API spec json file
+  GetAPIEventsEventID gets API event
 */
-func (a *Client) GetAPIInventoryAPIIDProvidedSwaggerJSON(params *GetAPIInventoryAPIIDProvidedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDProvidedSwaggerJSONOK, error) {
+func (a *Client) GetAPIEventsEventID(params *GetAPIEventsEventIDParams, opts ...ClientOption) (*GetAPIEventsEventIDOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDProvidedSwaggerJSONParams()
+		params = NewGetAPIEventsEventIDParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDProvidedSwaggerJSON",
+		ID:                 "GetAPIEventsEventID",
 		Method:             "GET",
-		PathPattern:        "/apiInventory/{apiId}/provided_swagger.json",
+		PathPattern:        "/apiEvents/{eventId}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDProvidedSwaggerJSONReader{formats: a.formats},
+		Reader:             &GetAPIEventsEventIDReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -522,32 +582,32 @@ func (a *Client) GetAPIInventoryAPIIDProvidedSwaggerJSON(params *GetAPIInventory
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDProvidedSwaggerJSONOK)
+	success, ok := result.(*GetAPIEventsEventIDOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDProvidedSwaggerJSONDefault)
+	unexpectedSuccess := result.(*GetAPIEventsEventIDDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDReconstructedSwaggerJSON gets reconstructed API spec json file
+  GetAPIEventsEventIDGrpcMessages gets the decoded g RPC messages of an API event
 */
-func (a *Client) GetAPIInventoryAPIIDReconstructedSwaggerJSON(params *GetAPIInventoryAPIIDReconstructedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDReconstructedSwaggerJSONOK, error) {
+func (a *Client) GetAPIEventsEventIDGrpcMessages(params *GetAPIEventsEventIDGrpcMessagesParams, opts ...ClientOption) (*GetAPIEventsEventIDGrpcMessagesOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDReconstructedSwaggerJSONParams()
+		params = NewGetAPIEventsEventIDGrpcMessagesParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDReconstructedSwaggerJSON",
+		ID:                 "GetAPIEventsEventIDGrpcMessages",
 		Method:             "GET",
-		PathPattern:        "/apiInventory/{apiId}/reconstructed_swagger.json",
+		PathPattern:        "/apiEvents/{eventId}/grpcMessages",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDReconstructedSwaggerJSONReader{formats: a.formats},
+		Reader:             &GetAPIEventsEventIDGrpcMessagesReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -559,32 +619,32 @@ func (a *Client) GetAPIInventoryAPIIDReconstructedSwaggerJSON(params *GetAPIInve
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDReconstructedSwaggerJSONOK)
+	success, ok := result.(*GetAPIEventsEventIDGrpcMessagesOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDReconstructedSwaggerJSONDefault)
+	unexpectedSuccess := result.(*GetAPIEventsEventIDGrpcMessagesDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDSpecs gets provided and reconstructed open api specs for a specific API
+  GetAPIEventsEventIDProvidedSpecDiff gets API event provided spec diff
 */
-func (a *Client) GetAPIInventoryAPIIDSpecs(params *GetAPIInventoryAPIIDSpecsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDSpecsOK, error) {
+func (a *Client) GetAPIEventsEventIDProvidedSpecDiff(params *GetAPIEventsEventIDProvidedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDProvidedSpecDiffOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDSpecsParams()
+		params = NewGetAPIEventsEventIDProvidedSpecDiffParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDSpecs",
+		ID:                 "GetAPIEventsEventIDProvidedSpecDiff",
 		Method:             "GET",
-		PathPattern:        "/apiInventory/{apiId}/specs",
+		PathPattern:        "/apiEvents/{eventId}/providedSpecDiff",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDSpecsReader{formats: a.formats},
+		Reader:             &GetAPIEventsEventIDProvidedSpecDiffReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -596,32 +656,32 @@ func (a *Client) GetAPIInventoryAPIIDSpecs(params *GetAPIInventoryAPIIDSpecsPara
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDSpecsOK)
+	success, ok := result.(*GetAPIEventsEventIDProvidedSpecDiffOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDSpecsDefault)
+	unexpectedSuccess := result.(*GetAPIEventsEventIDProvidedSpecDiffDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIInventoryAPIIDSuggestedReview gets reconstructed spec for review
+  GetAPIEventsEventIDReconstructedSpecDiff gets API event reconstructed spec diff
 */
-func (a *Client) GetAPIInventoryAPIIDSuggestedReview(params *GetAPIInventoryAPIIDSuggestedReviewParams, opts ...ClientOption) (*GetAPIInventoryAPIIDSuggestedReviewOK, error) {
+func (a *Client) GetAPIEventsEventIDReconstructedSpecDiff(params *GetAPIEventsEventIDReconstructedSpecDiffParams, opts ...ClientOption) (*GetAPIEventsEventIDReconstructedSpecDiffOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIInventoryAPIIDSuggestedReviewParams()
+		params = NewGetAPIEventsEventIDReconstructedSpecDiffParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIInventoryAPIIDSuggestedReview",
+		ID:                 "GetAPIEventsEventIDReconstructedSpecDiff",
 		Method:             "GET",
-		PathPattern:        "/apiInventory/{apiId}/suggestedReview",
+		PathPattern:        "/apiEvents/{eventId}/reconstructedSpecDiff",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIInventoryAPIIDSuggestedReviewReader{formats: a.formats},
+		Reader:             &GetAPIEventsEventIDReconstructedSpecDiffReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -633,32 +693,34 @@ func (a *Client) GetAPIInventoryAPIIDSuggestedReview(params *GetAPIInventoryAPII
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIInventoryAPIIDSuggestedReviewOK)
+	success, ok := result.(*GetAPIEventsEventIDReconstructedSpecDiffOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIInventoryAPIIDSuggestedReviewDefault)
+	unexpectedSuccess := result.(*GetAPIEventsEventIDReconstructedSpecDiffDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAPIUsageHitCount gets a hit count within a selected timeframe for the filtered API events
+  GetAPIFindings gets the API findings of all the modules
+
+  The findings notified by the modules, each notification of a module replaces its findings on the API
 */
-func (a *Client) GetAPIUsageHitCount(params *GetAPIUsageHitCountParams, opts ...ClientOption) (*GetAPIUsageHitCountOK, error) {
+func (a *Client) GetAPIFindings(params *GetAPIFindingsParams, opts ...ClientOption) (*GetAPIFindingsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAPIUsageHitCountParams()
+		params = NewGetAPIFindingsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetAPIUsageHitCount",
+		ID:                 "GetAPIFindings",
 		Method:             "GET",
-		PathPattern:        "/apiUsage/hitCount",
+		PathPattern:        "/apiFindings",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAPIUsageHitCountReader{formats: a.formats},
+		Reader:             &GetAPIFindingsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -670,32 +732,32 @@ func (a *Client) GetAPIUsageHitCount(params *GetAPIUsageHitCountParams, opts ...
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAPIUsageHitCountOK)
+	success, ok := result.(*GetAPIFindingsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAPIUsageHitCountDefault)
+	unexpectedSuccess := result.(*GetAPIFindingsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetControlTraceSources lists of configured trace sources
+  GetAPIInventory gets API inventory
 */
-func (a *Client) GetControlTraceSources(params *GetControlTraceSourcesParams, opts ...ClientOption) (*GetControlTraceSourcesOK, error) {
+func (a *Client) GetAPIInventory(params *GetAPIInventoryParams, opts ...ClientOption) (*GetAPIInventoryOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetControlTraceSourcesParams()
+		params = NewGetAPIInventoryParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetControlTraceSources",
+		ID:                 "GetAPIInventory",
 		Method:             "GET",
-		PathPattern:        "/control/traceSources",
+		PathPattern:        "/apiInventory",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetControlTraceSourcesReader{formats: a.formats},
+		Reader:             &GetAPIInventoryReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -707,32 +769,32 @@ func (a *Client) GetControlTraceSources(params *GetControlTraceSourcesParams, op
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetControlTraceSourcesOK)
+	success, ok := result.(*GetAPIInventoryOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetControlTraceSourcesDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetControlTraceSourcesTraceSourceID gets trace source information
+  GetAPIInventoryAPIIDAPIInfo gets api info from api Id
 */
-func (a *Client) GetControlTraceSourcesTraceSourceID(params *GetControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*GetControlTraceSourcesTraceSourceIDOK, error) {
+func (a *Client) GetAPIInventoryAPIIDAPIInfo(params *GetAPIInventoryAPIIDAPIInfoParams, opts ...ClientOption) (*GetAPIInventoryAPIIDAPIInfoOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetControlTraceSourcesTraceSourceIDParams()
+		params = NewGetAPIInventoryAPIIDAPIInfoParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetControlTraceSourcesTraceSourceID",
+		ID:                 "GetAPIInventoryAPIIDAPIInfo",
 		Method:             "GET",
-		PathPattern:        "/control/traceSources/{traceSourceId}",
+		PathPattern:        "/apiInventory/{apiId}/apiInfo",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetControlTraceSourcesTraceSourceIDReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDAPIInfoReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -744,32 +806,32 @@ func (a *Client) GetControlTraceSourcesTraceSourceID(params *GetControlTraceSour
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetControlTraceSourcesTraceSourceIDOK)
+	success, ok := result.(*GetAPIInventoryAPIIDAPIInfoOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetControlTraceSourcesTraceSourceIDDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDAPIInfoDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetDashboardAPIUsage gets API usage
+  GetAPIInventoryAPIIDFromHostAndPort gets api Id from host and port
 */
-func (a *Client) GetDashboardAPIUsage(params *GetDashboardAPIUsageParams, opts ...ClientOption) (*GetDashboardAPIUsageOK, error) {
+func (a *Client) GetAPIInventoryAPIIDFromHostAndPort(params *GetAPIInventoryAPIIDFromHostAndPortParams, opts ...ClientOption) (*GetAPIInventoryAPIIDFromHostAndPortOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetDashboardAPIUsageParams()
+		params = NewGetAPIInventoryAPIIDFromHostAndPortParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetDashboardAPIUsage",
+		ID:                 "GetAPIInventoryAPIIDFromHostAndPort",
 		Method:             "GET",
-		PathPattern:        "/dashboard/apiUsage",
+		PathPattern:        "/apiInventory/apiId/fromHostAndPort",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetDashboardAPIUsageReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDFromHostAndPortReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -781,32 +843,32 @@ func (a *Client) GetDashboardAPIUsage(params *GetDashboardAPIUsageParams, opts .
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetDashboardAPIUsageOK)
+	success, ok := result.(*GetAPIInventoryAPIIDFromHostAndPortOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetDashboardAPIUsageDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDFromHostAndPortDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetDashboardAPIUsageLatestDiffs gets latest spec diffs
+  GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID gets api Id from host and port and trace source ID
 */
-func (a *Client) GetDashboardAPIUsageLatestDiffs(params *GetDashboardAPIUsageLatestDiffsParams, opts ...ClientOption) (*GetDashboardAPIUsageLatestDiffsOK, error) {
+func (a *Client) GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID(params *GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams, opts ...ClientOption) (*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetDashboardAPIUsageLatestDiffsParams()
+		params = NewGetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetDashboardAPIUsageLatestDiffs",
+		ID:                 "GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID",
 		Method:             "GET",
-		PathPattern:        "/dashboard/apiUsage/latestDiffs",
+		PathPattern:        "/apiInventory/apiId/fromHostAndPortAndTraceSourceID",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetDashboardAPIUsageLatestDiffsReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -818,32 +880,32 @@ func (a *Client) GetDashboardAPIUsageLatestDiffs(params *GetDashboardAPIUsageLat
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetDashboardAPIUsageLatestDiffsOK)
+	success, ok := result.(*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetDashboardAPIUsageLatestDiffsDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetDashboardAPIUsageMostUsed gets most used a p is
+  GetAPIInventoryAPIIDGraphqlOperations gets the usage of the graph q l operations of an API within a selected timeframe
 */
-func (a *Client) GetDashboardAPIUsageMostUsed(params *GetDashboardAPIUsageMostUsedParams, opts ...ClientOption) (*GetDashboardAPIUsageMostUsedOK, error) {
+func (a *Client) GetAPIInventoryAPIIDGraphqlOperations(params *GetAPIInventoryAPIIDGraphqlOperationsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGraphqlOperationsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetDashboardAPIUsageMostUsedParams()
+		params = NewGetAPIInventoryAPIIDGraphqlOperationsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetDashboardAPIUsageMostUsed",
+		ID:                 "GetAPIInventoryAPIIDGraphqlOperations",
 		Method:             "GET",
-		PathPattern:        "/dashboard/apiUsage/mostUsed",
+		PathPattern:        "/apiInventory/{apiId}/graphql/operations",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetDashboardAPIUsageMostUsedReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDGraphqlOperationsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -855,32 +917,32 @@ func (a *Client) GetDashboardAPIUsageMostUsed(params *GetDashboardAPIUsageMostUs
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetDashboardAPIUsageMostUsedOK)
+	success, ok := result.(*GetAPIInventoryAPIIDGraphqlOperationsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetDashboardAPIUsageMostUsedDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDGraphqlOperationsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetFeatures gets the list of API clarity features and for each feature the list of API hosts in the form host port the feature requires to get trace for
+  GetAPIInventoryAPIIDGraphqlSchema gets the graph q l schema reconstructed from the traffic of an API
 */
-func (a *Client) GetFeatures(params *GetFeaturesParams, opts ...ClientOption) (*GetFeaturesOK, error) {
+func (a *Client) GetAPIInventoryAPIIDGraphqlSchema(params *GetAPIInventoryAPIIDGraphqlSchemaParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGraphqlSchemaOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetFeaturesParams()
+		params = NewGetAPIInventoryAPIIDGraphqlSchemaParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "GetFeatures",
+		ID:                 "GetAPIInventoryAPIIDGraphqlSchema",
 		Method:             "GET",
-		PathPattern:        "/features",
+		PathPattern:        "/apiInventory/{apiId}/graphql/schema",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetFeaturesReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDGraphqlSchemaReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -892,33 +954,34 @@ func (a *Client) GetFeatures(params *GetFeaturesParams, opts ...ClientOption) (*
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetFeaturesOK)
+	success, ok := result.(*GetAPIInventoryAPIIDGraphqlSchemaOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
-	msg := fmt.Sprintf("unexpected success response for GetFeatures: API contract not enforced by server. Client expected to get an error, but got: %T", result)
-	panic(msg)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDGraphqlSchemaDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  PostAPIInventory creates API inventory item
+  GetAPIInventoryAPIIDGrpcMethods gets the g RPC methods of an API and their usage within a selected timeframe
+
+  The methods of the uploaded descriptor set that were not called are included with a zero count
 */
-func (a *Client) PostAPIInventory(params *PostAPIInventoryParams, opts ...ClientOption) (*PostAPIInventoryOK, error) {
+func (a *Client) GetAPIInventoryAPIIDGrpcMethods(params *GetAPIInventoryAPIIDGrpcMethodsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDGrpcMethodsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewPostAPIInventoryParams()
+		params = NewGetAPIInventoryAPIIDGrpcMethodsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "PostAPIInventory",
-		Method:             "POST",
-		PathPattern:        "/apiInventory",
+		ID:                 "GetAPIInventoryAPIIDGrpcMethods",
+		Method:             "GET",
+		PathPattern:        "/apiInventory/{apiId}/grpc/methods",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &PostAPIInventoryReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDGrpcMethodsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -930,32 +993,32 @@ func (a *Client) PostAPIInventory(params *PostAPIInventoryParams, opts ...Client
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*PostAPIInventoryOK)
+	success, ok := result.(*GetAPIInventoryAPIIDGrpcMethodsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*PostAPIInventoryDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDGrpcMethodsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  PostAPIInventoryReviewIDApprovedReview applies the approved review to create the reconstructed spec
+  GetAPIInventoryAPIIDProvidedSwaggerJSON gets provided API spec json file
 */
-func (a *Client) PostAPIInventoryReviewIDApprovedReview(params *PostAPIInventoryReviewIDApprovedReviewParams, opts ...ClientOption) (*PostAPIInventoryReviewIDApprovedReviewOK, error) {
+func (a *Client) GetAPIInventoryAPIIDProvidedSwaggerJSON(params *GetAPIInventoryAPIIDProvidedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDProvidedSwaggerJSONOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewPostAPIInventoryReviewIDApprovedReviewParams()
+		params = NewGetAPIInventoryAPIIDProvidedSwaggerJSONParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "PostAPIInventoryReviewIDApprovedReview",
-		Method:             "POST",
-		PathPattern:        "/apiInventory/{reviewId}/approvedReview",
+		ID:                 "GetAPIInventoryAPIIDProvidedSwaggerJSON",
+		Method:             "GET",
+		PathPattern:        "/apiInventory/{apiId}/provided_swagger.json",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &PostAPIInventoryReviewIDApprovedReviewReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDProvidedSwaggerJSONReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -967,34 +1030,69 @@ func (a *Client) PostAPIInventoryReviewIDApprovedReview(params *PostAPIInventory
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*PostAPIInventoryReviewIDApprovedReviewOK)
+	success, ok := result.(*GetAPIInventoryAPIIDProvidedSwaggerJSONOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*PostAPIInventoryReviewIDApprovedReviewDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDProvidedSwaggerJSONDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  PostControlNewDiscoveredAPIs allows a client to notify API clarity about new a p is
+  GetAPIInventoryAPIIDReconstructedSwaggerJSON gets reconstructed API spec json file
+*/
+func (a *Client) GetAPIInventoryAPIIDReconstructedSwaggerJSON(params *GetAPIInventoryAPIIDReconstructedSwaggerJSONParams, opts ...ClientOption) (*GetAPIInventoryAPIIDReconstructedSwaggerJSONOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAPIInventoryAPIIDReconstructedSwaggerJSONParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetAPIInventoryAPIIDReconstructedSwaggerJSON",
+		Method:             "GET",
+		PathPattern:        "/apiInventory/{apiId}/reconstructed_swagger.json",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAPIInventoryAPIIDReconstructedSwaggerJSONReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
 
-  This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAPIInventoryAPIIDReconstructedSwaggerJSONOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDReconstructedSwaggerJSONDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetAPIInventoryAPIIDSpecs gets provided and reconstructed open api specs for a specific API
 */
-func (a *Client) PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAPIsParams, opts ...ClientOption) (*PostControlNewDiscoveredAPIsOK, error) {
+func (a *Client) GetAPIInventoryAPIIDSpecs(params *GetAPIInventoryAPIIDSpecsParams, opts ...ClientOption) (*GetAPIInventoryAPIIDSpecsOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewPostControlNewDiscoveredAPIsParams()
+		params = NewGetAPIInventoryAPIIDSpecsParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "PostControlNewDiscoveredAPIs",
-		Method:             "POST",
-		PathPattern:        "/control/newDiscoveredAPIs",
+		ID:                 "GetAPIInventoryAPIIDSpecs",
+		Method:             "GET",
+		PathPattern:        "/apiInventory/{apiId}/specs",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &PostControlNewDiscoveredAPIsReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDSpecsReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -1006,32 +1104,32 @@ func (a *Client) PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAP
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*PostControlNewDiscoveredAPIsOK)
+	success, ok := result.(*GetAPIInventoryAPIIDSpecsOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*PostControlNewDiscoveredAPIsDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDSpecsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  PostControlTraceSources creates a new trace source
+  GetAPIInventoryAPIIDSuggestedReview gets reconstructed spec for review
 */
-func (a *Client) PostControlTraceSources(params *PostControlTraceSourcesParams, opts ...ClientOption) (*PostControlTraceSourcesCreated, error) {
+func (a *Client) GetAPIInventoryAPIIDSuggestedReview(params *GetAPIInventoryAPIIDSuggestedReviewParams, opts ...ClientOption) (*GetAPIInventoryAPIIDSuggestedReviewOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewPostControlTraceSourcesParams()
+		params = NewGetAPIInventoryAPIIDSuggestedReviewParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "PostControlTraceSources",
-		Method:             "POST",
-		PathPattern:        "/control/traceSources",
+		ID:                 "GetAPIInventoryAPIIDSuggestedReview",
+		Method:             "GET",
+		PathPattern:        "/apiInventory/{apiId}/suggestedReview",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &PostControlTraceSourcesReader{formats: a.formats},
+		Reader:             &GetAPIInventoryAPIIDSuggestedReviewReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -1043,32 +1141,32 @@ func (a *Client) PostControlTraceSources(params *PostControlTraceSourcesParams,
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*PostControlTraceSourcesCreated)
+	success, ok := result.(*GetAPIInventoryAPIIDSuggestedReviewOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*PostControlTraceSourcesDefault)
+	unexpectedSuccess := result.(*GetAPIInventoryAPIIDSuggestedReviewDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  PutAPIInventoryAPIIDSpecsProvidedSpec adds or edit a spec for a specific API
+  GetAPIUsageHitCount gets a hit count within a selected timeframe for the filtered API events
 */
-func (a *Client) PutAPIInventoryAPIIDSpecsProvidedSpec(params *PutAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*PutAPIInventoryAPIIDSpecsProvidedSpecCreated, error) {
+func (a *Client) GetAPIUsageHitCount(params *GetAPIUsageHitCountParams, opts ...ClientOption) (*GetAPIUsageHitCountOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewPutAPIInventoryAPIIDSpecsProvidedSpecParams()
+		params = NewGetAPIUsageHitCountParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "PutAPIInventoryAPIIDSpecsProvidedSpec",
-		Method:             "PUT",
-		PathPattern:        "/apiInventory/{apiId}/specs/providedSpec",
+		ID:                 "GetAPIUsageHitCount",
+		Method:             "GET",
+		PathPattern:        "/apiUsage/hitCount",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &PutAPIInventoryAPIIDSpecsProvidedSpecReader{formats: a.formats},
+		Reader:             &GetAPIUsageHitCountReader{formats: a.formats},
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -1080,12 +1178,1092 @@ func (a *Client) PutAPIInventoryAPIIDSpecsProvidedSpec(params *PutAPIInventoryAP
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*PutAPIInventoryAPIIDSpecsProvidedSpecCreated)
+	success, ok := result.(*GetAPIUsageHitCountOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*PutAPIInventoryAPIIDSpecsProvidedSpecDefault)
+	unexpectedSuccess := result.(*GetAPIUsageHitCountDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlModules gets the list of modules with their status
+*/
+func (a *Client) GetControlModules(params *GetControlModulesParams, opts ...ClientOption) (*GetControlModulesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlModulesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlModules",
+		Method:             "GET",
+		PathPattern:        "/control/modules",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlModulesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlModulesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlModulesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlRedactionPolicy gets the default redaction policy
+
+  The default policy applies to the trace sources without their own policy
+*/
+func (a *Client) GetControlRedactionPolicy(params *GetControlRedactionPolicyParams, opts ...ClientOption) (*GetControlRedactionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlRedactionPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlRedactionPolicy",
+		Method:             "GET",
+		PathPattern:        "/control/redactionPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlRedactionPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlRedactionPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlRedactionPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlReplays gets the replays of stored events through modules
+*/
+func (a *Client) GetControlReplays(params *GetControlReplaysParams, opts ...ClientOption) (*GetControlReplaysOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlReplaysParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlReplays",
+		Method:             "GET",
+		PathPattern:        "/control/replays",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlReplaysReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlReplaysOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlReplaysDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlReplaysReplayID gets the progress of a replay
+*/
+func (a *Client) GetControlReplaysReplayID(params *GetControlReplaysReplayIDParams, opts ...ClientOption) (*GetControlReplaysReplayIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlReplaysReplayIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlReplaysReplayID",
+		Method:             "GET",
+		PathPattern:        "/control/replays/{replayId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlReplaysReplayIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlReplaysReplayIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlReplaysReplayIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlSamplingRates lists the sampling rates of the a p is
+*/
+func (a *Client) GetControlSamplingRates(params *GetControlSamplingRatesParams, opts ...ClientOption) (*GetControlSamplingRatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlSamplingRatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlSamplingRates",
+		Method:             "GET",
+		PathPattern:        "/control/samplingRates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlSamplingRatesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlSamplingRatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlSamplingRatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlTraceSources lists of configured trace sources
+*/
+func (a *Client) GetControlTraceSources(params *GetControlTraceSourcesParams, opts ...ClientOption) (*GetControlTraceSourcesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlTraceSourcesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlTraceSources",
+		Method:             "GET",
+		PathPattern:        "/control/traceSources",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlTraceSourcesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlTraceSourcesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlTraceSourcesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlTraceSourcesTraceSourceID gets trace source information
+*/
+func (a *Client) GetControlTraceSourcesTraceSourceID(params *GetControlTraceSourcesTraceSourceIDParams, opts ...ClientOption) (*GetControlTraceSourcesTraceSourceIDOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlTraceSourcesTraceSourceIDParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlTraceSourcesTraceSourceID",
+		Method:             "GET",
+		PathPattern:        "/control/traceSources/{traceSourceId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlTraceSourcesTraceSourceIDReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlTraceSourcesTraceSourceIDOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlTraceSourcesTraceSourceIDDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlTraceSourcesTraceSourceIDRedactionPolicy gets the redaction policy of a trace source
+*/
+func (a *Client) GetControlTraceSourcesTraceSourceIDRedactionPolicy(params *GetControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*GetControlTraceSourcesTraceSourceIDRedactionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlTraceSourcesTraceSourceIDRedactionPolicy",
+		Method:             "GET",
+		PathPattern:        "/control/traceSources/{traceSourceId}/redactionPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlTraceSourcesTraceSourceIDRedactionPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlTraceSourcesTraceSourceIDRedactionPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlTracingEndpoints lists the endpoints the tracing of the a p is is restricted to
+*/
+func (a *Client) GetControlTracingEndpoints(params *GetControlTracingEndpointsParams, opts ...ClientOption) (*GetControlTracingEndpointsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlTracingEndpointsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlTracingEndpoints",
+		Method:             "GET",
+		PathPattern:        "/control/tracingEndpoints",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlTracingEndpointsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlTracingEndpointsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlTracingEndpointsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetControlTracingSessions lists the active tracing sessions of the modules
+*/
+func (a *Client) GetControlTracingSessions(params *GetControlTracingSessionsParams, opts ...ClientOption) (*GetControlTracingSessionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetControlTracingSessionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetControlTracingSessions",
+		Method:             "GET",
+		PathPattern:        "/control/tracingSessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetControlTracingSessionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetControlTracingSessionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetControlTracingSessionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetDashboardAPIUsage gets API usage
+*/
+func (a *Client) GetDashboardAPIUsage(params *GetDashboardAPIUsageParams, opts ...ClientOption) (*GetDashboardAPIUsageOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDashboardAPIUsageParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetDashboardAPIUsage",
+		Method:             "GET",
+		PathPattern:        "/dashboard/apiUsage",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDashboardAPIUsageReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDashboardAPIUsageOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDashboardAPIUsageDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetDashboardAPIUsageLatestDiffs gets latest spec diffs
+*/
+func (a *Client) GetDashboardAPIUsageLatestDiffs(params *GetDashboardAPIUsageLatestDiffsParams, opts ...ClientOption) (*GetDashboardAPIUsageLatestDiffsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDashboardAPIUsageLatestDiffsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetDashboardAPIUsageLatestDiffs",
+		Method:             "GET",
+		PathPattern:        "/dashboard/apiUsage/latestDiffs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDashboardAPIUsageLatestDiffsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDashboardAPIUsageLatestDiffsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDashboardAPIUsageLatestDiffsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetDashboardAPIUsageMostUsed gets most used a p is
+*/
+func (a *Client) GetDashboardAPIUsageMostUsed(params *GetDashboardAPIUsageMostUsedParams, opts ...ClientOption) (*GetDashboardAPIUsageMostUsedOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetDashboardAPIUsageMostUsedParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetDashboardAPIUsageMostUsed",
+		Method:             "GET",
+		PathPattern:        "/dashboard/apiUsage/mostUsed",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetDashboardAPIUsageMostUsedReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetDashboardAPIUsageMostUsedOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetDashboardAPIUsageMostUsedDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetFeatures gets the list of API clarity features and for each feature the list of API hosts in the form host port the feature requires to get trace for
+*/
+func (a *Client) GetFeatures(params *GetFeaturesParams, opts ...ClientOption) (*GetFeaturesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetFeaturesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetFeatures",
+		Method:             "GET",
+		PathPattern:        "/features",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetFeaturesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetFeaturesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	// safeguard: normally, absent a default response, unknown success responses return an error above: so this is a codegen issue
+	msg := fmt.Sprintf("unexpected success response for GetFeatures: API contract not enforced by server. Client expected to get an error, but got: %T", result)
+	panic(msg)
+}
+
+/*
+  PostAPIInventory creates API inventory item
+*/
+func (a *Client) PostAPIInventory(params *PostAPIInventoryParams, opts ...ClientOption) (*PostAPIInventoryOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAPIInventoryParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostAPIInventory",
+		Method:             "POST",
+		PathPattern:        "/apiInventory",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostAPIInventoryReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostAPIInventoryOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostAPIInventoryDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PostAPIInventoryReviewIDApprovedReview applies the approved review to create the reconstructed spec
+*/
+func (a *Client) PostAPIInventoryReviewIDApprovedReview(params *PostAPIInventoryReviewIDApprovedReviewParams, opts ...ClientOption) (*PostAPIInventoryReviewIDApprovedReviewOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostAPIInventoryReviewIDApprovedReviewParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostAPIInventoryReviewIDApprovedReview",
+		Method:             "POST",
+		PathPattern:        "/apiInventory/{reviewId}/approvedReview",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostAPIInventoryReviewIDApprovedReviewReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostAPIInventoryReviewIDApprovedReviewOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostAPIInventoryReviewIDApprovedReviewDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PostControlHarImport imports the traffic of a h a r archive
+
+  Each entry of the HTTP Archive is handled like a trace received from a trace source, populating the API inventory, the reconstructed specs and the modules findings.
+*/
+func (a *Client) PostControlHarImport(params *PostControlHarImportParams, opts ...ClientOption) (*PostControlHarImportOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostControlHarImportParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostControlHarImport",
+		Method:             "POST",
+		PathPattern:        "/control/harImport",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostControlHarImportReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostControlHarImportOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostControlHarImportDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PostControlNewDiscoveredAPIs allows a client to notify API clarity about new a p is
+
+  This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.
+*/
+func (a *Client) PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAPIsParams, opts ...ClientOption) (*PostControlNewDiscoveredAPIsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostControlNewDiscoveredAPIsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostControlNewDiscoveredAPIs",
+		Method:             "POST",
+		PathPattern:        "/control/newDiscoveredAPIs",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostControlNewDiscoveredAPIsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostControlNewDiscoveredAPIsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostControlNewDiscoveredAPIsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PostControlReplays replays the stored events of an API through a module
+*/
+func (a *Client) PostControlReplays(params *PostControlReplaysParams, opts ...ClientOption) (*PostControlReplaysCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostControlReplaysParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostControlReplays",
+		Method:             "POST",
+		PathPattern:        "/control/replays",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostControlReplaysReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostControlReplaysCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostControlReplaysDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PostControlTraceSources creates a new trace source
+*/
+func (a *Client) PostControlTraceSources(params *PostControlTraceSourcesParams, opts ...ClientOption) (*PostControlTraceSourcesCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostControlTraceSourcesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostControlTraceSources",
+		Method:             "POST",
+		PathPattern:        "/control/traceSources",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostControlTraceSourcesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostControlTraceSourcesCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostControlTraceSourcesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutAPIInventoryAPIIDClassificationOverride forces the classification of the next traffic of an API as API learned and analyzed or non API
+*/
+func (a *Client) PutAPIInventoryAPIIDClassificationOverride(params *PutAPIInventoryAPIIDClassificationOverrideParams, opts ...ClientOption) (*PutAPIInventoryAPIIDClassificationOverrideOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutAPIInventoryAPIIDClassificationOverrideParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutAPIInventoryAPIIDClassificationOverride",
+		Method:             "PUT",
+		PathPattern:        "/apiInventory/{apiId}/classificationOverride",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutAPIInventoryAPIIDClassificationOverrideReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutAPIInventoryAPIIDClassificationOverrideOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutAPIInventoryAPIIDClassificationOverrideDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutAPIInventoryAPIIDGrpcDescriptorSet sets the protobuf descriptor set of a g RPC API used to decode the messages of its next events
+*/
+func (a *Client) PutAPIInventoryAPIIDGrpcDescriptorSet(params *PutAPIInventoryAPIIDGrpcDescriptorSetParams, opts ...ClientOption) (*PutAPIInventoryAPIIDGrpcDescriptorSetOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutAPIInventoryAPIIDGrpcDescriptorSetParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutAPIInventoryAPIIDGrpcDescriptorSet",
+		Method:             "PUT",
+		PathPattern:        "/apiInventory/{apiId}/grpc/descriptorSet",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutAPIInventoryAPIIDGrpcDescriptorSetReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutAPIInventoryAPIIDGrpcDescriptorSetOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutAPIInventoryAPIIDGrpcDescriptorSetDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutAPIInventoryAPIIDSpecsProvidedSpec adds or edit a spec for a specific API
+*/
+func (a *Client) PutAPIInventoryAPIIDSpecsProvidedSpec(params *PutAPIInventoryAPIIDSpecsProvidedSpecParams, opts ...ClientOption) (*PutAPIInventoryAPIIDSpecsProvidedSpecCreated, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutAPIInventoryAPIIDSpecsProvidedSpecParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutAPIInventoryAPIIDSpecsProvidedSpec",
+		Method:             "PUT",
+		PathPattern:        "/apiInventory/{apiId}/specs/providedSpec",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutAPIInventoryAPIIDSpecsProvidedSpecReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutAPIInventoryAPIIDSpecsProvidedSpecCreated)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutAPIInventoryAPIIDSpecsProvidedSpecDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlModulesModuleName enables or disable a module
+*/
+func (a *Client) PutControlModulesModuleName(params *PutControlModulesModuleNameParams, opts ...ClientOption) (*PutControlModulesModuleNameOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlModulesModuleNameParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlModulesModuleName",
+		Method:             "PUT",
+		PathPattern:        "/control/modules/{moduleName}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlModulesModuleNameReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlModulesModuleNameOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlModulesModuleNameDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlRedactionPolicy sets the default redaction policy
+*/
+func (a *Client) PutControlRedactionPolicy(params *PutControlRedactionPolicyParams, opts ...ClientOption) (*PutControlRedactionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlRedactionPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlRedactionPolicy",
+		Method:             "PUT",
+		PathPattern:        "/control/redactionPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlRedactionPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlRedactionPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlRedactionPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlSamplingRates sets the sampling rate of an API for a component
+*/
+func (a *Client) PutControlSamplingRates(params *PutControlSamplingRatesParams, opts ...ClientOption) (*PutControlSamplingRatesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlSamplingRatesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlSamplingRates",
+		Method:             "PUT",
+		PathPattern:        "/control/samplingRates",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlSamplingRatesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlSamplingRatesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlSamplingRatesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlTraceSourcesTraceSourceIDRedactionPolicy sets the redaction policy of a trace source
+*/
+func (a *Client) PutControlTraceSourcesTraceSourceIDRedactionPolicy(params *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams, opts ...ClientOption) (*PutControlTraceSourcesTraceSourceIDRedactionPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlTraceSourcesTraceSourceIDRedactionPolicy",
+		Method:             "PUT",
+		PathPattern:        "/control/traceSources/{traceSourceId}/redactionPolicy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlTraceSourcesTraceSourceIDRedactionPolicyReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlTraceSourcesTraceSourceIDRedactionPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlTracingEndpoints restricts the tracing of an API for a component to endpoints of its spec
+*/
+func (a *Client) PutControlTracingEndpoints(params *PutControlTracingEndpointsParams, opts ...ClientOption) (*PutControlTracingEndpointsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlTracingEndpointsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlTracingEndpoints",
+		Method:             "PUT",
+		PathPattern:        "/control/tracingEndpoints",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlTracingEndpointsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlTracingEndpointsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlTracingEndpointsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  PutControlTracingSessions sets the limits of an active tracing session and restart it
+*/
+func (a *Client) PutControlTracingSessions(params *PutControlTracingSessionsParams, opts ...ClientOption) (*PutControlTracingSessionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPutControlTracingSessionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PutControlTracingSessions",
+		Method:             "PUT",
+		PathPattern:        "/control/tracingSessions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PutControlTracingSessionsReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PutControlTracingSessionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PutControlTracingSessionsDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
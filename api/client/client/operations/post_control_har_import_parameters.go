@@ -0,0 +1,185 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewPostControlHarImportParams creates a new PostControlHarImportParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostControlHarImportParams() *PostControlHarImportParams {
+	return &PostControlHarImportParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostControlHarImportParamsWithTimeout creates a new PostControlHarImportParams object
+// with the ability to set a timeout on a request.
+func NewPostControlHarImportParamsWithTimeout(timeout time.Duration) *PostControlHarImportParams {
+	return &PostControlHarImportParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostControlHarImportParamsWithContext creates a new PostControlHarImportParams object
+// with the ability to set a context for a request.
+func NewPostControlHarImportParamsWithContext(ctx context.Context) *PostControlHarImportParams {
+	return &PostControlHarImportParams{
+		Context: ctx,
+	}
+}
+
+// NewPostControlHarImportParamsWithHTTPClient creates a new PostControlHarImportParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostControlHarImportParamsWithHTTPClient(client *http.Client) *PostControlHarImportParams {
+	return &PostControlHarImportParams{
+		HTTPClient: client,
+	}
+}
+
+/* PostControlHarImportParams contains all the parameters to send to the API endpoint
+   for the post control har import operation.
+
+   Typically these are written to a http.Request.
+*/
+type PostControlHarImportParams struct {
+
+	/* Body.
+
+	   HAR 1.2 archive
+	*/
+	Body interface{}
+
+	/* TraceSourceID.
+
+	   Trace Source under which the traffic is imported. If not set, the traffic is imported under the default trace source of APIClarity
+
+	   Format: uuid
+	*/
+	TraceSourceID *strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post control har import params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostControlHarImportParams) WithDefaults() *PostControlHarImportParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post control har import params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostControlHarImportParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post control har import params
+func (o *PostControlHarImportParams) WithTimeout(timeout time.Duration) *PostControlHarImportParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post control har import params
+func (o *PostControlHarImportParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post control har import params
+func (o *PostControlHarImportParams) WithContext(ctx context.Context) *PostControlHarImportParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post control har import params
+func (o *PostControlHarImportParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post control har import params
+func (o *PostControlHarImportParams) WithHTTPClient(client *http.Client) *PostControlHarImportParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post control har import params
+func (o *PostControlHarImportParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the post control har import params
+func (o *PostControlHarImportParams) WithBody(body interface{}) *PostControlHarImportParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the post control har import params
+func (o *PostControlHarImportParams) SetBody(body interface{}) {
+	o.Body = body
+}
+
+// WithTraceSourceID adds the traceSourceID to the post control har import params
+func (o *PostControlHarImportParams) WithTraceSourceID(traceSourceID *strfmt.UUID) *PostControlHarImportParams {
+	o.SetTraceSourceID(traceSourceID)
+	return o
+}
+
+// SetTraceSourceID adds the traceSourceId to the post control har import params
+func (o *PostControlHarImportParams) SetTraceSourceID(traceSourceID *strfmt.UUID) {
+	o.TraceSourceID = traceSourceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostControlHarImportParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if o.TraceSourceID != nil {
+
+		// query param traceSourceId
+		var qrTraceSourceID strfmt.UUID
+
+		if o.TraceSourceID != nil {
+			qrTraceSourceID = *o.TraceSourceID
+		}
+		qTraceSourceID := qrTraceSourceID.String()
+		if qTraceSourceID != "" {
+
+			if err := r.SetQueryParam("traceSourceId", qTraceSourceID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PostControlHarImportReader is a Reader for the PostControlHarImport structure.
+type PostControlHarImportReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostControlHarImportReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPostControlHarImportOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPostControlHarImportBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPostControlHarImportNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostControlHarImportDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostControlHarImportOK creates a PostControlHarImportOK with default headers values
+func NewPostControlHarImportOK() *PostControlHarImportOK {
+	return &PostControlHarImportOK{}
+}
+
+/* PostControlHarImportOK describes a response with status code 200, with default header values.
+
+Import result
+*/
+type PostControlHarImportOK struct {
+	Payload *models.HarImportResult
+}
+
+func (o *PostControlHarImportOK) Error() string {
+	return fmt.Sprintf("[POST /control/harImport][%d] postControlHarImportOK  %+v", 200, o.Payload)
+}
+func (o *PostControlHarImportOK) GetPayload() *models.HarImportResult {
+	return o.Payload
+}
+
+func (o *PostControlHarImportOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.HarImportResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlHarImportBadRequest creates a PostControlHarImportBadRequest with default headers values
+func NewPostControlHarImportBadRequest() *PostControlHarImportBadRequest {
+	return &PostControlHarImportBadRequest{}
+}
+
+/* PostControlHarImportBadRequest describes a response with status code 400, with default header values.
+
+Invalid HAR archive
+*/
+type PostControlHarImportBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PostControlHarImportBadRequest) Error() string {
+	return fmt.Sprintf("[POST /control/harImport][%d] postControlHarImportBadRequest  %+v", 400, o.Payload)
+}
+func (o *PostControlHarImportBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlHarImportBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlHarImportNotFound creates a PostControlHarImportNotFound with default headers values
+func NewPostControlHarImportNotFound() *PostControlHarImportNotFound {
+	return &PostControlHarImportNotFound{}
+}
+
+/* PostControlHarImportNotFound describes a response with status code 404, with default header values.
+
+Trace Source not found
+*/
+type PostControlHarImportNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PostControlHarImportNotFound) Error() string {
+	return fmt.Sprintf("[POST /control/harImport][%d] postControlHarImportNotFound  %+v", 404, o.Payload)
+}
+func (o *PostControlHarImportNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlHarImportNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlHarImportDefault creates a PostControlHarImportDefault with default headers values
+func NewPostControlHarImportDefault(code int) *PostControlHarImportDefault {
+	return &PostControlHarImportDefault{
+		_statusCode: code,
+	}
+}
+
+/* PostControlHarImportDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PostControlHarImportDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the post control har import default response
+func (o *PostControlHarImportDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostControlHarImportDefault) Error() string {
+	return fmt.Sprintf("[POST /control/harImport][%d] PostControlHarImport default  %+v", o._statusCode, o.Payload)
+}
+func (o *PostControlHarImportDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlHarImportDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPostControlReplaysParams creates a new PostControlReplaysParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPostControlReplaysParams() *PostControlReplaysParams {
+	return &PostControlReplaysParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPostControlReplaysParamsWithTimeout creates a new PostControlReplaysParams object
+// with the ability to set a timeout on a request.
+func NewPostControlReplaysParamsWithTimeout(timeout time.Duration) *PostControlReplaysParams {
+	return &PostControlReplaysParams{
+		timeout: timeout,
+	}
+}
+
+// NewPostControlReplaysParamsWithContext creates a new PostControlReplaysParams object
+// with the ability to set a context for a request.
+func NewPostControlReplaysParamsWithContext(ctx context.Context) *PostControlReplaysParams {
+	return &PostControlReplaysParams{
+		Context: ctx,
+	}
+}
+
+// NewPostControlReplaysParamsWithHTTPClient creates a new PostControlReplaysParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPostControlReplaysParamsWithHTTPClient(client *http.Client) *PostControlReplaysParams {
+	return &PostControlReplaysParams{
+		HTTPClient: client,
+	}
+}
+
+/* PostControlReplaysParams contains all the parameters to send to the API endpoint
+   for the post control replays operation.
+
+   Typically these are written to a http.Request.
+*/
+type PostControlReplaysParams struct {
+
+	// Body.
+	Body *models.ReplayRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the post control replays params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostControlReplaysParams) WithDefaults() *PostControlReplaysParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the post control replays params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PostControlReplaysParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the post control replays params
+func (o *PostControlReplaysParams) WithTimeout(timeout time.Duration) *PostControlReplaysParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the post control replays params
+func (o *PostControlReplaysParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the post control replays params
+func (o *PostControlReplaysParams) WithContext(ctx context.Context) *PostControlReplaysParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the post control replays params
+func (o *PostControlReplaysParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the post control replays params
+func (o *PostControlReplaysParams) WithHTTPClient(client *http.Client) *PostControlReplaysParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the post control replays params
+func (o *PostControlReplaysParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the post control replays params
+func (o *PostControlReplaysParams) WithBody(body *models.ReplayRequest) *PostControlReplaysParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the post control replays params
+func (o *PostControlReplaysParams) SetBody(body *models.ReplayRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PostControlReplaysParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
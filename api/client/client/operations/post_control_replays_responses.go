@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PostControlReplaysReader is a Reader for the PostControlReplays structure.
+type PostControlReplaysReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PostControlReplaysReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewPostControlReplaysCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPostControlReplaysBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPostControlReplaysNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPostControlReplaysDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPostControlReplaysCreated creates a PostControlReplaysCreated with default headers values
+func NewPostControlReplaysCreated() *PostControlReplaysCreated {
+	return &PostControlReplaysCreated{}
+}
+
+/* PostControlReplaysCreated describes a response with status code 201, with default header values.
+
+Replay started
+*/
+type PostControlReplaysCreated struct {
+	Payload *models.Replay
+}
+
+func (o *PostControlReplaysCreated) Error() string {
+	return fmt.Sprintf("[POST /control/replays][%d] postControlReplaysCreated  %+v", 201, o.Payload)
+}
+func (o *PostControlReplaysCreated) GetPayload() *models.Replay {
+	return o.Payload
+}
+
+func (o *PostControlReplaysCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Replay)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlReplaysBadRequest creates a PostControlReplaysBadRequest with default headers values
+func NewPostControlReplaysBadRequest() *PostControlReplaysBadRequest {
+	return &PostControlReplaysBadRequest{}
+}
+
+/* PostControlReplaysBadRequest describes a response with status code 400, with default header values.
+
+Invalid replay
+*/
+type PostControlReplaysBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PostControlReplaysBadRequest) Error() string {
+	return fmt.Sprintf("[POST /control/replays][%d] postControlReplaysBadRequest  %+v", 400, o.Payload)
+}
+func (o *PostControlReplaysBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlReplaysBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlReplaysNotFound creates a PostControlReplaysNotFound with default headers values
+func NewPostControlReplaysNotFound() *PostControlReplaysNotFound {
+	return &PostControlReplaysNotFound{}
+}
+
+/* PostControlReplaysNotFound describes a response with status code 404, with default header values.
+
+Module not found
+*/
+type PostControlReplaysNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PostControlReplaysNotFound) Error() string {
+	return fmt.Sprintf("[POST /control/replays][%d] postControlReplaysNotFound  %+v", 404, o.Payload)
+}
+func (o *PostControlReplaysNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlReplaysNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPostControlReplaysDefault creates a PostControlReplaysDefault with default headers values
+func NewPostControlReplaysDefault(code int) *PostControlReplaysDefault {
+	return &PostControlReplaysDefault{
+		_statusCode: code,
+	}
+}
+
+/* PostControlReplaysDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PostControlReplaysDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the post control replays default response
+func (o *PostControlReplaysDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PostControlReplaysDefault) Error() string {
+	return fmt.Sprintf("[POST /control/replays][%d] PostControlReplays default  %+v", o._statusCode, o.Payload)
+}
+func (o *PostControlReplaysDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostControlReplaysDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutAPIInventoryAPIIDClassificationOverrideParams creates a new PutAPIInventoryAPIIDClassificationOverrideParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutAPIInventoryAPIIDClassificationOverrideParams() *PutAPIInventoryAPIIDClassificationOverrideParams {
+	return &PutAPIInventoryAPIIDClassificationOverrideParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideParamsWithTimeout creates a new PutAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a timeout on a request.
+func NewPutAPIInventoryAPIIDClassificationOverrideParamsWithTimeout(timeout time.Duration) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	return &PutAPIInventoryAPIIDClassificationOverrideParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideParamsWithContext creates a new PutAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a context for a request.
+func NewPutAPIInventoryAPIIDClassificationOverrideParamsWithContext(ctx context.Context) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	return &PutAPIInventoryAPIIDClassificationOverrideParams{
+		Context: ctx,
+	}
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideParamsWithHTTPClient creates a new PutAPIInventoryAPIIDClassificationOverrideParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutAPIInventoryAPIIDClassificationOverrideParamsWithHTTPClient(client *http.Client) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	return &PutAPIInventoryAPIIDClassificationOverrideParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutAPIInventoryAPIIDClassificationOverrideParams contains all the parameters to send to the API endpoint
+   for the put API inventory API ID classification override operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutAPIInventoryAPIIDClassificationOverrideParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	// Body.
+	Body *models.APIClassificationOverride
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put API inventory API ID classification override params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithDefaults() *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put API inventory API ID classification override params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithTimeout(timeout time.Duration) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithContext(ctx context.Context) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithHTTPClient(client *http.Client) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithAPIID(aPIID uint32) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithBody adds the body to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WithBody(body *models.APIClassificationOverride) *PutAPIInventoryAPIIDClassificationOverrideParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put API inventory API ID classification override params
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) SetBody(body *models.APIClassificationOverride) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutAPIInventoryAPIIDClassificationOverrideParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,151 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutAPIInventoryAPIIDClassificationOverrideReader is a Reader for the PutAPIInventoryAPIIDClassificationOverride structure.
+type PutAPIInventoryAPIIDClassificationOverrideReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutAPIInventoryAPIIDClassificationOverrideReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutAPIInventoryAPIIDClassificationOverrideOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPutAPIInventoryAPIIDClassificationOverrideNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutAPIInventoryAPIIDClassificationOverrideDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideOK creates a PutAPIInventoryAPIIDClassificationOverrideOK with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideOK() *PutAPIInventoryAPIIDClassificationOverrideOK {
+	return &PutAPIInventoryAPIIDClassificationOverrideOK{}
+}
+
+/* PutAPIInventoryAPIIDClassificationOverrideOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutAPIInventoryAPIIDClassificationOverrideOK struct {
+	Payload interface{}
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/classificationOverride][%d] putApiInventoryApiIdClassificationOverrideOK  %+v", 200, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) GetPayload() interface{} {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideNotFound creates a PutAPIInventoryAPIIDClassificationOverrideNotFound with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideNotFound() *PutAPIInventoryAPIIDClassificationOverrideNotFound {
+	return &PutAPIInventoryAPIIDClassificationOverrideNotFound{}
+}
+
+/* PutAPIInventoryAPIIDClassificationOverrideNotFound describes a response with status code 404, with default header values.
+
+API not found
+*/
+type PutAPIInventoryAPIIDClassificationOverrideNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/classificationOverride][%d] putApiInventoryApiIdClassificationOverrideNotFound  %+v", 404, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideDefault creates a PutAPIInventoryAPIIDClassificationOverrideDefault with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideDefault(code int) *PutAPIInventoryAPIIDClassificationOverrideDefault {
+	return &PutAPIInventoryAPIIDClassificationOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutAPIInventoryAPIIDClassificationOverrideDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutAPIInventoryAPIIDClassificationOverrideDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put API inventory API ID classification override default response
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/classificationOverride][%d] PutAPIInventoryAPIIDClassificationOverride default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetParams creates a new PutAPIInventoryAPIIDGrpcDescriptorSetParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetParams() *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithTimeout creates a new PutAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a timeout on a request.
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithTimeout(timeout time.Duration) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithContext creates a new PutAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a context for a request.
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithContext(ctx context.Context) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetParams{
+		Context: ctx,
+	}
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithHTTPClient creates a new PutAPIInventoryAPIIDGrpcDescriptorSetParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetParamsWithHTTPClient(client *http.Client) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutAPIInventoryAPIIDGrpcDescriptorSetParams contains all the parameters to send to the API endpoint
+   for the put API inventory API ID grpc descriptor set operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetParams struct {
+
+	// APIID.
+	//
+	// Format: uint32
+	APIID uint32
+
+	// Body.
+	Body *models.GrpcDescriptorSet
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put API inventory API ID grpc descriptor set params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithDefaults() *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put API inventory API ID grpc descriptor set params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithTimeout(timeout time.Duration) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithContext(ctx context.Context) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithHTTPClient(client *http.Client) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAPIID adds the aPIID to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithAPIID(aPIID uint32) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetAPIID(aPIID)
+	return o
+}
+
+// SetAPIID adds the apiId to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetAPIID(aPIID uint32) {
+	o.APIID = aPIID
+}
+
+// WithBody adds the body to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WithBody(body *models.GrpcDescriptorSet) *PutAPIInventoryAPIIDGrpcDescriptorSetParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put API inventory API ID grpc descriptor set params
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) SetBody(body *models.GrpcDescriptorSet) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param apiId
+	if err := r.SetPathParam("apiId", swag.FormatUint32(o.APIID)); err != nil {
+		return err
+	}
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
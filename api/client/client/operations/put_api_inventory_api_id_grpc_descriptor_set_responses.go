@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutAPIInventoryAPIIDGrpcDescriptorSetReader is a Reader for the PutAPIInventoryAPIIDGrpcDescriptorSet structure.
+type PutAPIInventoryAPIIDGrpcDescriptorSetReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutAPIInventoryAPIIDGrpcDescriptorSetOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetOK creates a PutAPIInventoryAPIIDGrpcDescriptorSetOK with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetOK() *PutAPIInventoryAPIIDGrpcDescriptorSetOK {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetOK{}
+}
+
+/* PutAPIInventoryAPIIDGrpcDescriptorSetOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetOK struct {
+	Payload *models.GrpcServices
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/grpc/descriptorSet][%d] putApiInventoryApiIdGrpcDescriptorSetOK  %+v", 200, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) GetPayload() *models.GrpcServices {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.GrpcServices)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest creates a PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest() *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest{}
+}
+
+/* PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest describes a response with status code 400, with default header values.
+
+Invalid descriptor set
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/grpc/descriptorSet][%d] putApiInventoryApiIdGrpcDescriptorSetBadRequest  %+v", 400, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault creates a PutAPIInventoryAPIIDGrpcDescriptorSetDefault with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault(code int) *PutAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutAPIInventoryAPIIDGrpcDescriptorSetDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put API inventory API ID grpc descriptor set default response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) Error() string {
+	return fmt.Sprintf("[PUT /apiInventory/{apiId}/grpc/descriptorSet][%d] PutAPIInventoryAPIIDGrpcDescriptorSet default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
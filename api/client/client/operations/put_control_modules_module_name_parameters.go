@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlModulesModuleNameParams creates a new PutControlModulesModuleNameParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlModulesModuleNameParams() *PutControlModulesModuleNameParams {
+	return &PutControlModulesModuleNameParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlModulesModuleNameParamsWithTimeout creates a new PutControlModulesModuleNameParams object
+// with the ability to set a timeout on a request.
+func NewPutControlModulesModuleNameParamsWithTimeout(timeout time.Duration) *PutControlModulesModuleNameParams {
+	return &PutControlModulesModuleNameParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlModulesModuleNameParamsWithContext creates a new PutControlModulesModuleNameParams object
+// with the ability to set a context for a request.
+func NewPutControlModulesModuleNameParamsWithContext(ctx context.Context) *PutControlModulesModuleNameParams {
+	return &PutControlModulesModuleNameParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlModulesModuleNameParamsWithHTTPClient creates a new PutControlModulesModuleNameParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlModulesModuleNameParamsWithHTTPClient(client *http.Client) *PutControlModulesModuleNameParams {
+	return &PutControlModulesModuleNameParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlModulesModuleNameParams contains all the parameters to send to the API endpoint
+   for the put control modules module name operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlModulesModuleNameParams struct {
+
+	// Body.
+	Body *models.ModuleState
+
+	// ModuleName.
+	ModuleName string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control modules module name params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlModulesModuleNameParams) WithDefaults() *PutControlModulesModuleNameParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control modules module name params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlModulesModuleNameParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) WithTimeout(timeout time.Duration) *PutControlModulesModuleNameParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) WithContext(ctx context.Context) *PutControlModulesModuleNameParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) WithHTTPClient(client *http.Client) *PutControlModulesModuleNameParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) WithBody(body *models.ModuleState) *PutControlModulesModuleNameParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) SetBody(body *models.ModuleState) {
+	o.Body = body
+}
+
+// WithModuleName adds the moduleName to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) WithModuleName(moduleName string) *PutControlModulesModuleNameParams {
+	o.SetModuleName(moduleName)
+	return o
+}
+
+// SetModuleName adds the moduleName to the put control modules module name params
+func (o *PutControlModulesModuleNameParams) SetModuleName(moduleName string) {
+	o.ModuleName = moduleName
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlModulesModuleNameParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param moduleName
+	if err := r.SetPathParam("moduleName", o.ModuleName); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
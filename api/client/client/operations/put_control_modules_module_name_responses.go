@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlModulesModuleNameReader is a Reader for the PutControlModulesModuleName structure.
+type PutControlModulesModuleNameReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlModulesModuleNameReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlModulesModuleNameOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPutControlModulesModuleNameNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlModulesModuleNameDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlModulesModuleNameOK creates a PutControlModulesModuleNameOK with default headers values
+func NewPutControlModulesModuleNameOK() *PutControlModulesModuleNameOK {
+	return &PutControlModulesModuleNameOK{}
+}
+
+/* PutControlModulesModuleNameOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlModulesModuleNameOK struct {
+	Payload *models.ModuleStatus
+}
+
+func (o *PutControlModulesModuleNameOK) Error() string {
+	return fmt.Sprintf("[PUT /control/modules/{moduleName}][%d] putControlModulesModuleNameOK  %+v", 200, o.Payload)
+}
+func (o *PutControlModulesModuleNameOK) GetPayload() *models.ModuleStatus {
+	return o.Payload
+}
+
+func (o *PutControlModulesModuleNameOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ModuleStatus)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlModulesModuleNameNotFound creates a PutControlModulesModuleNameNotFound with default headers values
+func NewPutControlModulesModuleNameNotFound() *PutControlModulesModuleNameNotFound {
+	return &PutControlModulesModuleNameNotFound{}
+}
+
+/* PutControlModulesModuleNameNotFound describes a response with status code 404, with default header values.
+
+Module not found
+*/
+type PutControlModulesModuleNameNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlModulesModuleNameNotFound) Error() string {
+	return fmt.Sprintf("[PUT /control/modules/{moduleName}][%d] putControlModulesModuleNameNotFound  %+v", 404, o.Payload)
+}
+func (o *PutControlModulesModuleNameNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlModulesModuleNameNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlModulesModuleNameDefault creates a PutControlModulesModuleNameDefault with default headers values
+func NewPutControlModulesModuleNameDefault(code int) *PutControlModulesModuleNameDefault {
+	return &PutControlModulesModuleNameDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlModulesModuleNameDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlModulesModuleNameDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control modules module name default response
+func (o *PutControlModulesModuleNameDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlModulesModuleNameDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/modules/{moduleName}][%d] PutControlModulesModuleName default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlModulesModuleNameDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlModulesModuleNameDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlRedactionPolicyParams creates a new PutControlRedactionPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlRedactionPolicyParams() *PutControlRedactionPolicyParams {
+	return &PutControlRedactionPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlRedactionPolicyParamsWithTimeout creates a new PutControlRedactionPolicyParams object
+// with the ability to set a timeout on a request.
+func NewPutControlRedactionPolicyParamsWithTimeout(timeout time.Duration) *PutControlRedactionPolicyParams {
+	return &PutControlRedactionPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlRedactionPolicyParamsWithContext creates a new PutControlRedactionPolicyParams object
+// with the ability to set a context for a request.
+func NewPutControlRedactionPolicyParamsWithContext(ctx context.Context) *PutControlRedactionPolicyParams {
+	return &PutControlRedactionPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlRedactionPolicyParamsWithHTTPClient creates a new PutControlRedactionPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlRedactionPolicyParamsWithHTTPClient(client *http.Client) *PutControlRedactionPolicyParams {
+	return &PutControlRedactionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlRedactionPolicyParams contains all the parameters to send to the API endpoint
+   for the put control redaction policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlRedactionPolicyParams struct {
+
+	// Body.
+	Body *models.RedactionPolicy
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlRedactionPolicyParams) WithDefaults() *PutControlRedactionPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlRedactionPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) WithTimeout(timeout time.Duration) *PutControlRedactionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) WithContext(ctx context.Context) *PutControlRedactionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) WithHTTPClient(client *http.Client) *PutControlRedactionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) WithBody(body *models.RedactionPolicy) *PutControlRedactionPolicyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control redaction policy params
+func (o *PutControlRedactionPolicyParams) SetBody(body *models.RedactionPolicy) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlRedactionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
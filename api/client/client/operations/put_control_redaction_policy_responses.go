@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlRedactionPolicyReader is a Reader for the PutControlRedactionPolicy structure.
+type PutControlRedactionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlRedactionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlRedactionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPutControlRedactionPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlRedactionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlRedactionPolicyOK creates a PutControlRedactionPolicyOK with default headers values
+func NewPutControlRedactionPolicyOK() *PutControlRedactionPolicyOK {
+	return &PutControlRedactionPolicyOK{}
+}
+
+/* PutControlRedactionPolicyOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlRedactionPolicyOK struct {
+	Payload *models.RedactionPolicy
+}
+
+func (o *PutControlRedactionPolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /control/redactionPolicy][%d] putControlRedactionPolicyOK  %+v", 200, o.Payload)
+}
+func (o *PutControlRedactionPolicyOK) GetPayload() *models.RedactionPolicy {
+	return o.Payload
+}
+
+func (o *PutControlRedactionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RedactionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlRedactionPolicyBadRequest creates a PutControlRedactionPolicyBadRequest with default headers values
+func NewPutControlRedactionPolicyBadRequest() *PutControlRedactionPolicyBadRequest {
+	return &PutControlRedactionPolicyBadRequest{}
+}
+
+/* PutControlRedactionPolicyBadRequest describes a response with status code 400, with default header values.
+
+Invalid redaction policy
+*/
+type PutControlRedactionPolicyBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlRedactionPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /control/redactionPolicy][%d] putControlRedactionPolicyBadRequest  %+v", 400, o.Payload)
+}
+func (o *PutControlRedactionPolicyBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlRedactionPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlRedactionPolicyDefault creates a PutControlRedactionPolicyDefault with default headers values
+func NewPutControlRedactionPolicyDefault(code int) *PutControlRedactionPolicyDefault {
+	return &PutControlRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlRedactionPolicyDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlRedactionPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control redaction policy default response
+func (o *PutControlRedactionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlRedactionPolicyDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/redactionPolicy][%d] PutControlRedactionPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlRedactionPolicyDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlRedactionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
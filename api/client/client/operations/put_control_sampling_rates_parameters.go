@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlSamplingRatesParams creates a new PutControlSamplingRatesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlSamplingRatesParams() *PutControlSamplingRatesParams {
+	return &PutControlSamplingRatesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlSamplingRatesParamsWithTimeout creates a new PutControlSamplingRatesParams object
+// with the ability to set a timeout on a request.
+func NewPutControlSamplingRatesParamsWithTimeout(timeout time.Duration) *PutControlSamplingRatesParams {
+	return &PutControlSamplingRatesParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlSamplingRatesParamsWithContext creates a new PutControlSamplingRatesParams object
+// with the ability to set a context for a request.
+func NewPutControlSamplingRatesParamsWithContext(ctx context.Context) *PutControlSamplingRatesParams {
+	return &PutControlSamplingRatesParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlSamplingRatesParamsWithHTTPClient creates a new PutControlSamplingRatesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlSamplingRatesParamsWithHTTPClient(client *http.Client) *PutControlSamplingRatesParams {
+	return &PutControlSamplingRatesParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlSamplingRatesParams contains all the parameters to send to the API endpoint
+   for the put control sampling rates operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlSamplingRatesParams struct {
+
+	// Body.
+	Body *models.SamplingRate
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlSamplingRatesParams) WithDefaults() *PutControlSamplingRatesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control sampling rates params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlSamplingRatesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) WithTimeout(timeout time.Duration) *PutControlSamplingRatesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) WithContext(ctx context.Context) *PutControlSamplingRatesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) WithHTTPClient(client *http.Client) *PutControlSamplingRatesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) WithBody(body *models.SamplingRate) *PutControlSamplingRatesParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control sampling rates params
+func (o *PutControlSamplingRatesParams) SetBody(body *models.SamplingRate) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlSamplingRatesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
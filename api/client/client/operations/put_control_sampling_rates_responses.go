@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlSamplingRatesReader is a Reader for the PutControlSamplingRates structure.
+type PutControlSamplingRatesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlSamplingRatesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlSamplingRatesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPutControlSamplingRatesNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlSamplingRatesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlSamplingRatesOK creates a PutControlSamplingRatesOK with default headers values
+func NewPutControlSamplingRatesOK() *PutControlSamplingRatesOK {
+	return &PutControlSamplingRatesOK{}
+}
+
+/* PutControlSamplingRatesOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlSamplingRatesOK struct {
+	Payload *models.SamplingRate
+}
+
+func (o *PutControlSamplingRatesOK) Error() string {
+	return fmt.Sprintf("[PUT /control/samplingRates][%d] putControlSamplingRatesOK  %+v", 200, o.Payload)
+}
+func (o *PutControlSamplingRatesOK) GetPayload() *models.SamplingRate {
+	return o.Payload
+}
+
+func (o *PutControlSamplingRatesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.SamplingRate)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlSamplingRatesNotFound creates a PutControlSamplingRatesNotFound with default headers values
+func NewPutControlSamplingRatesNotFound() *PutControlSamplingRatesNotFound {
+	return &PutControlSamplingRatesNotFound{}
+}
+
+/* PutControlSamplingRatesNotFound describes a response with status code 404, with default header values.
+
+API not found
+*/
+type PutControlSamplingRatesNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlSamplingRatesNotFound) Error() string {
+	return fmt.Sprintf("[PUT /control/samplingRates][%d] putControlSamplingRatesNotFound  %+v", 404, o.Payload)
+}
+func (o *PutControlSamplingRatesNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlSamplingRatesNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlSamplingRatesDefault creates a PutControlSamplingRatesDefault with default headers values
+func NewPutControlSamplingRatesDefault(code int) *PutControlSamplingRatesDefault {
+	return &PutControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlSamplingRatesDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlSamplingRatesDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control sampling rates default response
+func (o *PutControlSamplingRatesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlSamplingRatesDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/samplingRates][%d] PutControlSamplingRates default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlSamplingRatesDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlSamplingRatesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParams creates a new PutControlTraceSourcesTraceSourceIDRedactionPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParams() *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout creates a new PutControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a timeout on a request.
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithTimeout(timeout time.Duration) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext creates a new PutControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a context for a request.
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithContext(ctx context.Context) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient creates a new PutControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParamsWithHTTPClient(client *http.Client) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicyParams contains all the parameters to send to the API endpoint
+   for the put control trace sources trace source ID redaction policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyParams struct {
+
+	// Body.
+	Body *models.RedactionPolicy
+
+	/* TraceSourceID.
+
+	   Trace Source ID
+
+	   Format: uuid
+	*/
+	TraceSourceID strfmt.UUID
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithDefaults() *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control trace sources trace source ID redaction policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTimeout(timeout time.Duration) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithContext(ctx context.Context) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithHTTPClient(client *http.Client) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithBody(body *models.RedactionPolicy) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetBody(body *models.RedactionPolicy) {
+	o.Body = body
+}
+
+// WithTraceSourceID adds the traceSourceID to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WithTraceSourceID(traceSourceID strfmt.UUID) *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+	o.SetTraceSourceID(traceSourceID)
+	return o
+}
+
+// SetTraceSourceID adds the traceSourceId to the put control trace sources trace source ID redaction policy params
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) SetTraceSourceID(traceSourceID strfmt.UUID) {
+	o.TraceSourceID = traceSourceID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param traceSourceId
+	if err := r.SetPathParam("traceSourceId", o.TraceSourceID.String()); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyReader is a Reader for the PutControlTraceSourcesTraceSourceIDRedactionPolicy structure.
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK creates a PutControlTraceSourcesTraceSourceIDRedactionPolicyOK with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK() *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyOK{}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicyOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyOK struct {
+	Payload *models.RedactionPolicy
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /control/traceSources/{traceSourceId}/redactionPolicy][%d] putControlTraceSourcesTraceSourceIdRedactionPolicyOK  %+v", 200, o.Payload)
+}
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) GetPayload() *models.RedactionPolicy {
+	return o.Payload
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.RedactionPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest creates a PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest() *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest{}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest describes a response with status code 400, with default header values.
+
+Invalid redaction policy
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /control/traceSources/{traceSourceId}/redactionPolicy][%d] putControlTraceSourcesTraceSourceIdRedactionPolicyBadRequest  %+v", 400, o.Payload)
+}
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates a PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound describes a response with status code 404, with default header values.
+
+Trace Source not found
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) Error() string {
+	return fmt.Sprintf("[PUT /control/traceSources/{traceSourceId}/redactionPolicy][%d] putControlTraceSourcesTraceSourceIdRedactionPolicyNotFound  %+v", 404, o.Payload)
+}
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates a PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control trace sources trace source ID redaction policy default response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/traceSources/{traceSourceId}/redactionPolicy][%d] PutControlTraceSourcesTraceSourceIDRedactionPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
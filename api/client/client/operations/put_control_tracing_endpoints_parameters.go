@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlTracingEndpointsParams creates a new PutControlTracingEndpointsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlTracingEndpointsParams() *PutControlTracingEndpointsParams {
+	return &PutControlTracingEndpointsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlTracingEndpointsParamsWithTimeout creates a new PutControlTracingEndpointsParams object
+// with the ability to set a timeout on a request.
+func NewPutControlTracingEndpointsParamsWithTimeout(timeout time.Duration) *PutControlTracingEndpointsParams {
+	return &PutControlTracingEndpointsParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlTracingEndpointsParamsWithContext creates a new PutControlTracingEndpointsParams object
+// with the ability to set a context for a request.
+func NewPutControlTracingEndpointsParamsWithContext(ctx context.Context) *PutControlTracingEndpointsParams {
+	return &PutControlTracingEndpointsParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlTracingEndpointsParamsWithHTTPClient creates a new PutControlTracingEndpointsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlTracingEndpointsParamsWithHTTPClient(client *http.Client) *PutControlTracingEndpointsParams {
+	return &PutControlTracingEndpointsParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlTracingEndpointsParams contains all the parameters to send to the API endpoint
+   for the put control tracing endpoints operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlTracingEndpointsParams struct {
+
+	// Body.
+	Body *models.TracingEndpoints
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control tracing endpoints params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTracingEndpointsParams) WithDefaults() *PutControlTracingEndpointsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control tracing endpoints params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTracingEndpointsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) WithTimeout(timeout time.Duration) *PutControlTracingEndpointsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) WithContext(ctx context.Context) *PutControlTracingEndpointsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) WithHTTPClient(client *http.Client) *PutControlTracingEndpointsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) WithBody(body *models.TracingEndpoints) *PutControlTracingEndpointsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control tracing endpoints params
+func (o *PutControlTracingEndpointsParams) SetBody(body *models.TracingEndpoints) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlTracingEndpointsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
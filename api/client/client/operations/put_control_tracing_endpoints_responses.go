@@ -0,0 +1,191 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlTracingEndpointsReader is a Reader for the PutControlTracingEndpoints structure.
+type PutControlTracingEndpointsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlTracingEndpointsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlTracingEndpointsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 400:
+		result := NewPutControlTracingEndpointsBadRequest()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	case 404:
+		result := NewPutControlTracingEndpointsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlTracingEndpointsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlTracingEndpointsOK creates a PutControlTracingEndpointsOK with default headers values
+func NewPutControlTracingEndpointsOK() *PutControlTracingEndpointsOK {
+	return &PutControlTracingEndpointsOK{}
+}
+
+/* PutControlTracingEndpointsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlTracingEndpointsOK struct {
+	Payload *models.TracingEndpoints
+}
+
+func (o *PutControlTracingEndpointsOK) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingEndpoints][%d] putControlTracingEndpointsOK  %+v", 200, o.Payload)
+}
+func (o *PutControlTracingEndpointsOK) GetPayload() *models.TracingEndpoints {
+	return o.Payload
+}
+
+func (o *PutControlTracingEndpointsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TracingEndpoints)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTracingEndpointsBadRequest creates a PutControlTracingEndpointsBadRequest with default headers values
+func NewPutControlTracingEndpointsBadRequest() *PutControlTracingEndpointsBadRequest {
+	return &PutControlTracingEndpointsBadRequest{}
+}
+
+/* PutControlTracingEndpointsBadRequest describes a response with status code 400, with default header values.
+
+Endpoint not found in the spec of the API
+*/
+type PutControlTracingEndpointsBadRequest struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlTracingEndpointsBadRequest) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingEndpoints][%d] putControlTracingEndpointsBadRequest  %+v", 400, o.Payload)
+}
+func (o *PutControlTracingEndpointsBadRequest) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTracingEndpointsBadRequest) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTracingEndpointsNotFound creates a PutControlTracingEndpointsNotFound with default headers values
+func NewPutControlTracingEndpointsNotFound() *PutControlTracingEndpointsNotFound {
+	return &PutControlTracingEndpointsNotFound{}
+}
+
+/* PutControlTracingEndpointsNotFound describes a response with status code 404, with default header values.
+
+API not found
+*/
+type PutControlTracingEndpointsNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlTracingEndpointsNotFound) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingEndpoints][%d] putControlTracingEndpointsNotFound  %+v", 404, o.Payload)
+}
+func (o *PutControlTracingEndpointsNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTracingEndpointsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTracingEndpointsDefault creates a PutControlTracingEndpointsDefault with default headers values
+func NewPutControlTracingEndpointsDefault(code int) *PutControlTracingEndpointsDefault {
+	return &PutControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlTracingEndpointsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control tracing endpoints default response
+func (o *PutControlTracingEndpointsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlTracingEndpointsDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingEndpoints][%d] PutControlTracingEndpoints default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlTracingEndpointsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTracingEndpointsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
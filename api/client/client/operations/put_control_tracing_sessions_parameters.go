@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// NewPutControlTracingSessionsParams creates a new PutControlTracingSessionsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewPutControlTracingSessionsParams() *PutControlTracingSessionsParams {
+	return &PutControlTracingSessionsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewPutControlTracingSessionsParamsWithTimeout creates a new PutControlTracingSessionsParams object
+// with the ability to set a timeout on a request.
+func NewPutControlTracingSessionsParamsWithTimeout(timeout time.Duration) *PutControlTracingSessionsParams {
+	return &PutControlTracingSessionsParams{
+		timeout: timeout,
+	}
+}
+
+// NewPutControlTracingSessionsParamsWithContext creates a new PutControlTracingSessionsParams object
+// with the ability to set a context for a request.
+func NewPutControlTracingSessionsParamsWithContext(ctx context.Context) *PutControlTracingSessionsParams {
+	return &PutControlTracingSessionsParams{
+		Context: ctx,
+	}
+}
+
+// NewPutControlTracingSessionsParamsWithHTTPClient creates a new PutControlTracingSessionsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewPutControlTracingSessionsParamsWithHTTPClient(client *http.Client) *PutControlTracingSessionsParams {
+	return &PutControlTracingSessionsParams{
+		HTTPClient: client,
+	}
+}
+
+/* PutControlTracingSessionsParams contains all the parameters to send to the API endpoint
+   for the put control tracing sessions operation.
+
+   Typically these are written to a http.Request.
+*/
+type PutControlTracingSessionsParams struct {
+
+	// Body.
+	Body *models.TracingSessionLimits
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the put control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTracingSessionsParams) WithDefaults() *PutControlTracingSessionsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the put control tracing sessions params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *PutControlTracingSessionsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) WithTimeout(timeout time.Duration) *PutControlTracingSessionsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) WithContext(ctx context.Context) *PutControlTracingSessionsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) WithHTTPClient(client *http.Client) *PutControlTracingSessionsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) WithBody(body *models.TracingSessionLimits) *PutControlTracingSessionsParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the put control tracing sessions params
+func (o *PutControlTracingSessionsParams) SetBody(body *models.TracingSessionLimits) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *PutControlTracingSessionsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
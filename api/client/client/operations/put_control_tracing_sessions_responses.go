@@ -0,0 +1,153 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/api/client/models"
+)
+
+// PutControlTracingSessionsReader is a Reader for the PutControlTracingSessions structure.
+type PutControlTracingSessionsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *PutControlTracingSessionsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewPutControlTracingSessionsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 404:
+		result := NewPutControlTracingSessionsNotFound()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewPutControlTracingSessionsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewPutControlTracingSessionsOK creates a PutControlTracingSessionsOK with default headers values
+func NewPutControlTracingSessionsOK() *PutControlTracingSessionsOK {
+	return &PutControlTracingSessionsOK{}
+}
+
+/* PutControlTracingSessionsOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type PutControlTracingSessionsOK struct {
+	Payload *models.TracingSession
+}
+
+func (o *PutControlTracingSessionsOK) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingSessions][%d] putControlTracingSessionsOK  %+v", 200, o.Payload)
+}
+func (o *PutControlTracingSessionsOK) GetPayload() *models.TracingSession {
+	return o.Payload
+}
+
+func (o *PutControlTracingSessionsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.TracingSession)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTracingSessionsNotFound creates a PutControlTracingSessionsNotFound with default headers values
+func NewPutControlTracingSessionsNotFound() *PutControlTracingSessionsNotFound {
+	return &PutControlTracingSessionsNotFound{}
+}
+
+/* PutControlTracingSessionsNotFound describes a response with status code 404, with default header values.
+
+Tracing session not found
+*/
+type PutControlTracingSessionsNotFound struct {
+	Payload *models.APIResponse
+}
+
+func (o *PutControlTracingSessionsNotFound) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingSessions][%d] putControlTracingSessionsNotFound  %+v", 404, o.Payload)
+}
+func (o *PutControlTracingSessionsNotFound) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTracingSessionsNotFound) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewPutControlTracingSessionsDefault creates a PutControlTracingSessionsDefault with default headers values
+func NewPutControlTracingSessionsDefault(code int) *PutControlTracingSessionsDefault {
+	return &PutControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+/* PutControlTracingSessionsDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type PutControlTracingSessionsDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the put control tracing sessions default response
+func (o *PutControlTracingSessionsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *PutControlTracingSessionsDefault) Error() string {
+	return fmt.Sprintf("[PUT /control/tracingSessions][%d] PutControlTracingSessions default  %+v", o._statusCode, o.Payload)
+}
+func (o *PutControlTracingSessionsDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PutControlTracingSessionsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// APIClassification Api classification
+//
+// swagger:model ApiClassification
+type APIClassification string
+
+func NewAPIClassification(value APIClassification) *APIClassification {
+	v := value
+	return &v
+}
+
+const (
+
+	// APIClassificationAPI captures enum value "API"
+	APIClassificationAPI APIClassification = "API"
+
+	// APIClassificationNONAPI captures enum value "NON_API"
+	APIClassificationNONAPI APIClassification = "NON_API"
+)
+
+// for schema
+var apiClassificationEnum []interface{}
+
+func init() {
+	var res []APIClassification
+	if err := json.Unmarshal([]byte(`["API","NON_API"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiClassificationEnum = append(apiClassificationEnum, v)
+	}
+}
+
+func (m APIClassification) validateAPIClassificationEnum(path, location string, value APIClassification) error {
+	if err := validate.EnumCase(path, location, value, apiClassificationEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this Api classification
+func (m APIClassification) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIClassificationEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this Api classification based on context it is used
+func (m APIClassification) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,254 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIFinding A finding of a module on an API
+//
+// swagger:model ApiFinding
+type APIFinding struct {
+
+	// Opaque JSON object of the module
+	AdditionalInfo interface{} `json:"additionalInfo,omitempty"`
+
+	// api info Id
+	// Required: true
+	APIInfoID *uint32 `json:"apiInfoId"`
+
+	// Name of the API
+	APIName string `json:"apiName,omitempty"`
+
+	// Human readable description of the finding
+	Description string `json:"description,omitempty"`
+
+	// First time the finding was notified
+	// Required: true
+	// Format: date-time
+	FirstSeen *strfmt.DateTime `json:"firstSeen"`
+
+	// id
+	// Required: true
+	ID *uint32 `json:"id"`
+
+	// Last time the finding was notified
+	// Required: true
+	// Format: date-time
+	LastSeen *strfmt.DateTime `json:"lastSeen"`
+
+	// Module which notified the finding
+	// Required: true
+	ModuleName *string `json:"moduleName"`
+
+	// Human readable name of the finding
+	// Required: true
+	Name *string `json:"name"`
+
+	// JSON pointer to the location of the finding in the provided spec
+	ProvidedSpecLocation string `json:"providedSpecLocation,omitempty"`
+
+	// JSON pointer to the location of the finding in the reconstructed spec
+	ReconstructedSpecLocation string `json:"reconstructedSpecLocation,omitempty"`
+
+	// severity
+	// Required: true
+	Severity *FindingSeverity `json:"severity"`
+
+	// Name of the module which created this finding
+	Source string `json:"source,omitempty"`
+
+	// Type of the finding
+	// Required: true
+	Type *string `json:"type"`
+}
+
+// Validate validates this Api finding
+func (m *APIFinding) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIInfoID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFirstSeen(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastSeen(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateModuleName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSeverity(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIFinding) validateAPIInfoID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiInfoId", "body", m.APIInfoID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateFirstSeen(formats strfmt.Registry) error {
+
+	if err := validate.Required("firstSeen", "body", m.FirstSeen); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("firstSeen", "body", "date-time", m.FirstSeen.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateLastSeen(formats strfmt.Registry) error {
+
+	if err := validate.Required("lastSeen", "body", m.LastSeen); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("lastSeen", "body", "date-time", m.LastSeen.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateModuleName(formats strfmt.Registry) error {
+
+	if err := validate.Required("moduleName", "body", m.ModuleName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateSeverity(formats strfmt.Registry) error {
+
+	if err := validate.Required("severity", "body", m.Severity); err != nil {
+		return err
+	}
+
+	if err := validate.Required("severity", "body", m.Severity); err != nil {
+		return err
+	}
+
+	if m.Severity != nil {
+		if err := m.Severity.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("severity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIFinding) validateType(formats strfmt.Registry) error {
+
+	if err := validate.Required("type", "body", m.Type); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this Api finding based on the context it is used
+func (m *APIFinding) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSeverity(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIFinding) contextValidateSeverity(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Severity != nil {
+		if err := m.Severity.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("severity")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIFinding) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIFinding) UnmarshalBinary(b []byte) error {
+	var res APIFinding
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
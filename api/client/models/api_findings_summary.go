@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// APIFindingsSummary Count of the findings of an API by severity
+//
+// swagger:model ApiFindingsSummary
+type APIFindingsSummary struct {
+
+	// critical
+	Critical int64 `json:"critical,omitempty"`
+
+	// high
+	High int64 `json:"high,omitempty"`
+
+	// info
+	Info int64 `json:"info,omitempty"`
+
+	// low
+	Low int64 `json:"low,omitempty"`
+
+	// medium
+	Medium int64 `json:"medium,omitempty"`
+
+	// total
+	Total int64 `json:"total,omitempty"`
+}
+
+// Validate validates this Api findings summary
+func (m *APIFindingsSummary) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this Api findings summary based on context it is used
+func (m *APIFindingsSummary) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIFindingsSummary) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIFindingsSummary) UnmarshalBinary(b []byte) error {
+	var res APIFindingsSummary
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
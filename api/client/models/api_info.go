@@ -19,9 +19,15 @@ import (
 // swagger:model ApiInfo
 type APIInfo struct {
 
+	// Classification forced on the traffic of the API, unset when it is classified by the media type of its responses
+	ClassificationOverride APIClassification `json:"classificationOverride,omitempty"`
+
 	// destination namespace
 	DestinationNamespace string `json:"destinationNamespace,omitempty"`
 
+	// findings summary
+	FindingsSummary *APIFindingsSummary `json:"findingsSummary,omitempty"`
+
 	// has provided spec
 	HasProvidedSpec *bool `json:"hasProvidedSpec,omitempty"`
 
@@ -40,12 +46,26 @@ type APIInfo struct {
 	// Trace Source ID which created this API. Null UUID 0 means it has been created by APIClarity (from the UI for example)
 	// Format: uuid
 	TraceSourceID strfmt.UUID `json:"traceSourceId,omitempty"`
+
+	// Trace source name
+	TraceSourceName string `json:"traceSourceName,omitempty"`
+
+	// Trace source type
+	TraceSourceType string `json:"traceSourceType,omitempty"`
 }
 
 // Validate validates this Api info
 func (m *APIInfo) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateClassificationOverride(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFindingsSummary(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTraceSourceID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -56,6 +76,38 @@ func (m *APIInfo) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIInfo) validateClassificationOverride(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClassificationOverride) { // not required
+		return nil
+	}
+
+	if err := m.ClassificationOverride.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("classificationOverride")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIInfo) validateFindingsSummary(formats strfmt.Registry) error {
+	if swag.IsZero(m.FindingsSummary) { // not required
+		return nil
+	}
+
+	if m.FindingsSummary != nil {
+		if err := m.FindingsSummary.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("findingsSummary")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *APIInfo) validateTraceSourceID(formats strfmt.Registry) error {
 	if swag.IsZero(m.TraceSourceID) { // not required
 		return nil
@@ -68,8 +120,47 @@ func (m *APIInfo) validateTraceSourceID(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this Api info based on context it is used
+// ContextValidate validate this Api info based on the context it is used
 func (m *APIInfo) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClassificationOverride(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateFindingsSummary(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIInfo) contextValidateClassificationOverride(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ClassificationOverride.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("classificationOverride")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIInfo) contextValidateFindingsSummary(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.FindingsSummary != nil {
+		if err := m.FindingsSummary.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("findingsSummary")
+			}
+			return err
+		}
+	}
+
 	return nil
 }
 
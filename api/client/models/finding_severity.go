@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// FindingSeverity Severity of a finding
+//
+// swagger:model FindingSeverity
+type FindingSeverity string
+
+func NewFindingSeverity(value FindingSeverity) *FindingSeverity {
+	v := value
+	return &v
+}
+
+const (
+
+	// FindingSeverityINFO captures enum value "INFO"
+	FindingSeverityINFO FindingSeverity = "INFO"
+
+	// FindingSeverityLOW captures enum value "LOW"
+	FindingSeverityLOW FindingSeverity = "LOW"
+
+	// FindingSeverityMEDIUM captures enum value "MEDIUM"
+	FindingSeverityMEDIUM FindingSeverity = "MEDIUM"
+
+	// FindingSeverityHIGH captures enum value "HIGH"
+	FindingSeverityHIGH FindingSeverity = "HIGH"
+
+	// FindingSeverityCRITICAL captures enum value "CRITICAL"
+	FindingSeverityCRITICAL FindingSeverity = "CRITICAL"
+)
+
+// for schema
+var findingSeverityEnum []interface{}
+
+func init() {
+	var res []FindingSeverity
+	if err := json.Unmarshal([]byte(`["INFO","LOW","MEDIUM","HIGH","CRITICAL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		findingSeverityEnum = append(findingSeverityEnum, v)
+	}
+}
+
+func (m FindingSeverity) validateFindingSeverityEnum(path, location string, value FindingSeverity) error {
+	if err := validate.EnumCase(path, location, value, findingSeverityEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this finding severity
+func (m FindingSeverity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateFindingSeverityEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this finding severity based on context it is used
+func (m FindingSeverity) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// GraphQLOperationType graph q l operation type
+//
+// swagger:model GraphQLOperationType
+type GraphQLOperationType string
+
+func NewGraphQLOperationType(value GraphQLOperationType) *GraphQLOperationType {
+	v := value
+	return &v
+}
+
+const (
+
+	// GraphQLOperationTypeQUERY captures enum value "QUERY"
+	GraphQLOperationTypeQUERY GraphQLOperationType = "QUERY"
+
+	// GraphQLOperationTypeMUTATION captures enum value "MUTATION"
+	GraphQLOperationTypeMUTATION GraphQLOperationType = "MUTATION"
+
+	// GraphQLOperationTypeSUBSCRIPTION captures enum value "SUBSCRIPTION"
+	GraphQLOperationTypeSUBSCRIPTION GraphQLOperationType = "SUBSCRIPTION"
+)
+
+// for schema
+var graphQLOperationTypeEnum []interface{}
+
+func init() {
+	var res []GraphQLOperationType
+	if err := json.Unmarshal([]byte(`["QUERY","MUTATION","SUBSCRIPTION"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		graphQLOperationTypeEnum = append(graphQLOperationTypeEnum, v)
+	}
+}
+
+func (m GraphQLOperationType) validateGraphQLOperationTypeEnum(path, location string, value GraphQLOperationType) error {
+	if err := validate.EnumCase(path, location, value, graphQLOperationTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this graph q l operation type
+func (m GraphQLOperationType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateGraphQLOperationTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this graph q l operation type based on context it is used
+func (m GraphQLOperationType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
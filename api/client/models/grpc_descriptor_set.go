@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GrpcDescriptorSet grpc descriptor set
+//
+// swagger:model GrpcDescriptorSet
+type GrpcDescriptorSet struct {
+
+	// Serialized FileDescriptorSet, with the imports included (protoc --include_imports --descriptor_set_out)
+	// Required: true
+	// Format: byte
+	DescriptorSet *strfmt.Base64 `json:"descriptorSet"`
+}
+
+// Validate validates this grpc descriptor set
+func (m *GrpcDescriptorSet) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDescriptorSet(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GrpcDescriptorSet) validateDescriptorSet(formats strfmt.Registry) error {
+
+	if err := validate.Required("descriptorSet", "body", m.DescriptorSet); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this grpc descriptor set based on context it is used
+func (m *GrpcDescriptorSet) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcDescriptorSet) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcDescriptorSet) UnmarshalBinary(b []byte) error {
+	var res GrpcDescriptorSet
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GrpcMethodUsage grpc method usage
+//
+// swagger:model GrpcMethodUsage
+type GrpcMethodUsage struct {
+
+	// Average size in bytes of the request messages of a call
+	AverageRequestSize int64 `json:"averageRequestSize,omitempty"`
+
+	// Average size in bytes of the response messages of a call
+	AverageResponseSize int64 `json:"averageResponseSize,omitempty"`
+
+	// client streaming
+	ClientStreaming bool `json:"clientStreaming,omitempty"`
+
+	// count
+	Count int64 `json:"count"`
+
+	// Number of calls with a non OK status
+	ErrorCount int64 `json:"errorCount"`
+
+	// last seen
+	// Format: date-time
+	LastSeen strfmt.DateTime `json:"lastSeen,omitempty"`
+
+	// method
+	Method string `json:"method,omitempty"`
+
+	// Request message type, known if a descriptor set was uploaded
+	RequestType string `json:"requestType,omitempty"`
+
+	// Response message type, known if a descriptor set was uploaded
+	ResponseType string `json:"responseType,omitempty"`
+
+	// server streaming
+	ServerStreaming bool `json:"serverStreaming,omitempty"`
+
+	// service
+	Service string `json:"service,omitempty"`
+}
+
+// Validate validates this grpc method usage
+func (m *GrpcMethodUsage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateLastSeen(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GrpcMethodUsage) validateLastSeen(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastSeen) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastSeen", "body", "date-time", m.LastSeen.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this grpc method usage based on context it is used
+func (m *GrpcMethodUsage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcMethodUsage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcMethodUsage) UnmarshalBinary(b []byte) error {
+	var res GrpcMethodUsage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GrpcServices grpc services
+//
+// swagger:model GrpcServices
+type GrpcServices struct {
+
+	// Fully qualified names of the services
+	// Required: true
+	Services []string `json:"services"`
+}
+
+// Validate validates this grpc services
+func (m *GrpcServices) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateServices(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GrpcServices) validateServices(formats strfmt.Registry) error {
+
+	if err := validate.Required("services", "body", m.Services); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this grpc services based on context it is used
+func (m *GrpcServices) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcServices) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcServices) UnmarshalBinary(b []byte) error {
+	var res GrpcServices
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,74 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// RedactionAction MASK replaces the redacted values with [REDACTED], HASH with their HMAC-SHA256 keyed with the redaction hash key of the deployment (hmac-sha256:<hex>) so equal values can still be correlated
+//
+// swagger:model RedactionAction
+type RedactionAction string
+
+func NewRedactionAction(value RedactionAction) *RedactionAction {
+	v := value
+	return &v
+}
+
+const (
+
+	// RedactionActionMASK captures enum value "MASK"
+	RedactionActionMASK RedactionAction = "MASK"
+
+	// RedactionActionHASH captures enum value "HASH"
+	RedactionActionHASH RedactionAction = "HASH"
+)
+
+// for schema
+var redactionActionEnum []interface{}
+
+func init() {
+	var res []RedactionAction
+	if err := json.Unmarshal([]byte(`["MASK","HASH"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		redactionActionEnum = append(redactionActionEnum, v)
+	}
+}
+
+func (m RedactionAction) validateRedactionActionEnum(path, location string, value RedactionAction) error {
+	if err := validate.EnumCase(path, location, value, redactionActionEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this redaction action
+func (m RedactionAction) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateRedactionActionEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this redaction action based on context it is used
+func (m RedactionAction) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
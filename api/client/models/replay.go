@@ -0,0 +1,298 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// Replay A replay of the stored events of an API through a module, only the events whose telemetry is retained are replayed
+//
+// swagger:model Replay
+type Replay struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// created at
+	// Required: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt"`
+
+	// end time
+	// Required: true
+	// Format: date-time
+	EndTime *strfmt.DateTime `json:"endTime"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// finished at
+	// Format: date-time
+	FinishedAt strfmt.DateTime `json:"finishedAt,omitempty"`
+
+	// id
+	// Required: true
+	ID *uint32 `json:"id"`
+
+	// module name
+	// Required: true
+	ModuleName *string `json:"moduleName"`
+
+	// The number of events replayed so far
+	// Required: true
+	Replayed *int64 `json:"replayed"`
+
+	// The number of events which could not be replayed
+	// Required: true
+	Skipped *int64 `json:"skipped"`
+
+	// start time
+	// Required: true
+	// Format: date-time
+	StartTime *strfmt.DateTime `json:"startTime"`
+
+	// status
+	// Required: true
+	Status *ReplayStatus `json:"status"`
+
+	// The number of events to replay
+	// Required: true
+	Total *int64 `json:"total"`
+}
+
+// Validate validates this replay
+func (m *Replay) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateFinishedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateModuleName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReplayed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSkipped(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Replay) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateCreatedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("endTime", "body", m.EndTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("endTime", "body", "date-time", m.EndTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateFinishedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.FinishedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("finishedAt", "body", "date-time", m.FinishedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateModuleName(formats strfmt.Registry) error {
+
+	if err := validate.Required("moduleName", "body", m.ModuleName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateReplayed(formats strfmt.Registry) error {
+
+	if err := validate.Required("replayed", "body", m.Replayed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateSkipped(formats strfmt.Registry) error {
+
+	if err := validate.Required("skipped", "body", m.Skipped); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTime", "body", m.StartTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("startTime", "body", "date-time", m.StartTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Replay) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	if m.Status != nil {
+		if err := m.Status.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Replay) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("total", "body", m.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this replay based on the context it is used
+func (m *Replay) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateStatus(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Replay) contextValidateStatus(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Status != nil {
+		if err := m.Status.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("status")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *Replay) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *Replay) UnmarshalBinary(b []byte) error {
+	var res Replay
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
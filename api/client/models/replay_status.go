@@ -0,0 +1,80 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ReplayStatus replay status
+//
+// swagger:model ReplayStatus
+type ReplayStatus string
+
+func NewReplayStatus(value ReplayStatus) *ReplayStatus {
+	v := value
+	return &v
+}
+
+const (
+
+	// ReplayStatusRUNNING captures enum value "RUNNING"
+	ReplayStatusRUNNING ReplayStatus = "RUNNING"
+
+	// ReplayStatusCOMPLETED captures enum value "COMPLETED"
+	ReplayStatusCOMPLETED ReplayStatus = "COMPLETED"
+
+	// ReplayStatusCANCELED captures enum value "CANCELED"
+	ReplayStatusCANCELED ReplayStatus = "CANCELED"
+
+	// ReplayStatusFAILED captures enum value "FAILED"
+	ReplayStatusFAILED ReplayStatus = "FAILED"
+)
+
+// for schema
+var replayStatusEnum []interface{}
+
+func init() {
+	var res []ReplayStatus
+	if err := json.Unmarshal([]byte(`["RUNNING","COMPLETED","CANCELED","FAILED"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		replayStatusEnum = append(replayStatusEnum, v)
+	}
+}
+
+func (m ReplayStatus) validateReplayStatusEnum(path, location string, value ReplayStatus) error {
+	if err := validate.EnumCase(path, location, value, replayStatusEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this replay status
+func (m ReplayStatus) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateReplayStatusEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this replay status based on context it is used
+func (m ReplayStatus) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
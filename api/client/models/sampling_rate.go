@@ -0,0 +1,135 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// SamplingRate Sampling of the traces of an API
+//
+// swagger:model SamplingRate
+type SamplingRate struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// '*' for the traces sent by the trace sources, sampled at the edge by the trace sources supporting it, or the name of a module for the events dispatched to it
+	// Required: true
+	Component *string `json:"component"`
+
+	// Maximum number of traces to keep per second, 0 for no limit
+	// Minimum: 0
+	MaxEventsPerSecond *float64 `json:"maxEventsPerSecond,omitempty"`
+
+	// Ratio of the traces to keep
+	// Required: true
+	// Maximum: 1
+	// Minimum: 0
+	Ratio *float64 `json:"ratio"`
+}
+
+// Validate validates this sampling rate
+func (m *SamplingRate) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateComponent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxEventsPerSecond(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRatio(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *SamplingRate) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SamplingRate) validateComponent(formats strfmt.Registry) error {
+
+	if err := validate.Required("component", "body", m.Component); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SamplingRate) validateMaxEventsPerSecond(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxEventsPerSecond) { // not required
+		return nil
+	}
+
+	if err := validate.Minimum("maxEventsPerSecond", "body", *m.MaxEventsPerSecond, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *SamplingRate) validateRatio(formats strfmt.Registry) error {
+
+	if err := validate.Required("ratio", "body", m.Ratio); err != nil {
+		return err
+	}
+
+	if err := validate.Minimum("ratio", "body", *m.Ratio, 0, false); err != nil {
+		return err
+	}
+
+	if err := validate.Maximum("ratio", "body", *m.Ratio, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this sampling rate based on context it is used
+func (m *SamplingRate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *SamplingRate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *SamplingRate) UnmarshalBinary(b []byte) error {
+	var res SamplingRate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,173 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TracingSession Tracing of an API enabled for a component, disabled automatically once its duration or its events budget has elapsed
+//
+// swagger:model TracingSession
+type TracingSession struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// api name
+	APIName string `json:"apiName,omitempty"`
+
+	// Name of the module the API is traced for
+	// Required: true
+	Component *string `json:"component"`
+
+	// Number of events dispatched to the component since the session started
+	// Required: true
+	EventsCount *int64 `json:"eventsCount"`
+
+	// Time at which the tracing is disabled, not set when the session has no duration
+	// Format: date-time
+	ExpiresAt strfmt.DateTime `json:"expiresAt,omitempty"`
+
+	// Number of events after which the tracing is disabled, 0 for no limit
+	// Required: true
+	MaxEvents *int64 `json:"maxEvents"`
+
+	// Number of events left before the tracing is disabled, not set when the session has no events budget
+	RemainingEvents int64 `json:"remainingEvents,omitempty"`
+
+	// Seconds left before the tracing is disabled, not set when the session has no duration
+	RemainingSeconds int64 `json:"remainingSeconds,omitempty"`
+
+	// started at
+	// Required: true
+	// Format: date-time
+	StartedAt *strfmt.DateTime `json:"startedAt"`
+}
+
+// Validate validates this tracing session
+func (m *TracingSession) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateComponent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEventsCount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpiresAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TracingSession) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSession) validateComponent(formats strfmt.Registry) error {
+
+	if err := validate.Required("component", "body", m.Component); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSession) validateEventsCount(formats strfmt.Registry) error {
+
+	if err := validate.Required("eventsCount", "body", m.EventsCount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSession) validateExpiresAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expiresAt", "body", "date-time", m.ExpiresAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSession) validateMaxEvents(formats strfmt.Registry) error {
+
+	if err := validate.Required("maxEvents", "body", m.MaxEvents); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSession) validateStartedAt(formats strfmt.Registry) error {
+
+	if err := validate.Required("startedAt", "body", m.StartedAt); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("startedAt", "body", "date-time", m.StartedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this tracing session based on context it is used
+func (m *TracingSession) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TracingSession) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TracingSession) UnmarshalBinary(b []byte) error {
+	var res TracingSession
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
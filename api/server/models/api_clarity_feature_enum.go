@@ -43,6 +43,9 @@ const (
 
 	// APIClarityFeatureEnumFuzzer captures enum value "fuzzer"
 	APIClarityFeatureEnumFuzzer APIClarityFeatureEnum = "fuzzer"
+
+	// APIClarityFeatureEnumCustomrules captures enum value "customrules"
+	APIClarityFeatureEnumCustomrules APIClarityFeatureEnum = "customrules"
 )
 
 // for schema
@@ -50,7 +53,7 @@ var apiClarityFeatureEnumEnum []interface{}
 
 func init() {
 	var res []APIClarityFeatureEnum
-	if err := json.Unmarshal([]byte(`["specreconstructor","specdiffs","traceanalyzer","bfla","spec_differ","fuzzer"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["specreconstructor","specdiffs","traceanalyzer","bfla","spec_differ","fuzzer","customrules"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
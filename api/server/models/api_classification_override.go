@@ -0,0 +1,107 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIClassificationOverride Api classification override
+//
+// swagger:model ApiClassificationOverride
+type APIClassificationOverride struct {
+
+	// classification
+	// Required: true
+	Classification *APIClassification `json:"classification"`
+}
+
+// Validate validates this Api classification override
+func (m *APIClassificationOverride) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClassification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIClassificationOverride) validateClassification(formats strfmt.Registry) error {
+
+	if err := validate.Required("classification", "body", m.Classification); err != nil {
+		return err
+	}
+
+	if err := validate.Required("classification", "body", m.Classification); err != nil {
+		return err
+	}
+
+	if m.Classification != nil {
+		if err := m.Classification.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("classification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this Api classification override based on the context it is used
+func (m *APIClassificationOverride) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClassification(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIClassificationOverride) contextValidateClassification(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Classification != nil {
+		if err := m.Classification.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("classification")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIClassificationOverride) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIClassificationOverride) UnmarshalBinary(b []byte) error {
+	var res APIClassificationOverride
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -35,6 +35,12 @@ type APIEvent struct {
 	// destination port
 	DestinationPort int64 `json:"destinationPort,omitempty"`
 
+	// graphql operation
+	GraphqlOperation *GraphQLOperation `json:"graphqlOperation,omitempty"`
+
+	// grpc call
+	GrpcCall *GrpcCall `json:"grpcCall,omitempty"`
+
 	// has provided spec diff
 	HasProvidedSpecDiff *bool `json:"hasProvidedSpecDiff,omitempty"`
 
@@ -86,6 +92,14 @@ func (m *APIEvent) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateGraphqlOperation(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateGrpcCall(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateMethod(formats); err != nil {
 		res = append(res, err)
 	}
@@ -147,6 +161,40 @@ func (m *APIEvent) validateAPIType(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *APIEvent) validateGraphqlOperation(formats strfmt.Registry) error {
+	if swag.IsZero(m.GraphqlOperation) { // not required
+		return nil
+	}
+
+	if m.GraphqlOperation != nil {
+		if err := m.GraphqlOperation.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("graphqlOperation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIEvent) validateGrpcCall(formats strfmt.Registry) error {
+	if swag.IsZero(m.GrpcCall) { // not required
+		return nil
+	}
+
+	if m.GrpcCall != nil {
+		if err := m.GrpcCall.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("grpcCall")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *APIEvent) validateMethod(formats strfmt.Registry) error {
 	if swag.IsZero(m.Method) { // not required
 		return nil
@@ -215,6 +263,14 @@ func (m *APIEvent) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateGraphqlOperation(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateGrpcCall(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateMethod(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -259,6 +315,34 @@ func (m *APIEvent) contextValidateAPIType(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *APIEvent) contextValidateGraphqlOperation(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.GraphqlOperation != nil {
+		if err := m.GraphqlOperation.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("graphqlOperation")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *APIEvent) contextValidateGrpcCall(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.GrpcCall != nil {
+		if err := m.GrpcCall.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("grpcCall")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *APIEvent) contextValidateMethod(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Method.ContextValidate(ctx, formats); err != nil {
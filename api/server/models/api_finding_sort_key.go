@@ -0,0 +1,86 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// APIFindingSortKey Api finding sort key
+//
+// swagger:model ApiFindingSortKey
+type APIFindingSortKey string
+
+func NewAPIFindingSortKey(value APIFindingSortKey) *APIFindingSortKey {
+	v := value
+	return &v
+}
+
+const (
+
+	// APIFindingSortKeySeverity captures enum value "severity"
+	APIFindingSortKeySeverity APIFindingSortKey = "severity"
+
+	// APIFindingSortKeyFirstSeen captures enum value "firstSeen"
+	APIFindingSortKeyFirstSeen APIFindingSortKey = "firstSeen"
+
+	// APIFindingSortKeyLastSeen captures enum value "lastSeen"
+	APIFindingSortKeyLastSeen APIFindingSortKey = "lastSeen"
+
+	// APIFindingSortKeyType captures enum value "type"
+	APIFindingSortKeyType APIFindingSortKey = "type"
+
+	// APIFindingSortKeyModuleName captures enum value "moduleName"
+	APIFindingSortKeyModuleName APIFindingSortKey = "moduleName"
+
+	// APIFindingSortKeyAPIInfoID captures enum value "apiInfoId"
+	APIFindingSortKeyAPIInfoID APIFindingSortKey = "apiInfoId"
+)
+
+// for schema
+var apiFindingSortKeyEnum []interface{}
+
+func init() {
+	var res []APIFindingSortKey
+	if err := json.Unmarshal([]byte(`["severity","firstSeen","lastSeen","type","moduleName","apiInfoId"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiFindingSortKeyEnum = append(apiFindingSortKeyEnum, v)
+	}
+}
+
+func (m APIFindingSortKey) validateAPIFindingSortKeyEnum(path, location string, value APIFindingSortKey) error {
+	if err := validate.EnumCase(path, location, value, apiFindingSortKeyEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this Api finding sort key
+func (m APIFindingSortKey) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPIFindingSortKeyEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this Api finding sort key based on context it is used
+func (m APIFindingSortKey) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
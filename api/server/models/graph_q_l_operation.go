@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GraphQLOperation GraphQL operation executed by an API event
+//
+// swagger:model GraphQLOperation
+type GraphQLOperation struct {
+
+	// Empty for anonymous operations
+	OperationName string `json:"operationName,omitempty"`
+
+	// operation type
+	OperationType GraphQLOperationType `json:"operationType,omitempty"`
+
+	// Sorted names of the selected root fields
+	RootFields []string `json:"rootFields"`
+}
+
+// Validate validates this graph q l operation
+func (m *GraphQLOperation) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateOperationType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GraphQLOperation) validateOperationType(formats strfmt.Registry) error {
+	if swag.IsZero(m.OperationType) { // not required
+		return nil
+	}
+
+	if err := m.OperationType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("operationType")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this graph q l operation based on the context it is used
+func (m *GraphQLOperation) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateOperationType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GraphQLOperation) contextValidateOperationType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.OperationType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("operationType")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GraphQLOperation) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GraphQLOperation) UnmarshalBinary(b []byte) error {
+	var res GraphQLOperation
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
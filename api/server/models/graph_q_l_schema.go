@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// GraphQLSchema graph q l schema
+//
+// swagger:model GraphQLSchema
+type GraphQLSchema struct {
+
+	// Reconstructed schema in the GraphQL schema definition language
+	// Required: true
+	Sdl *string `json:"sdl"`
+}
+
+// Validate validates this graph q l schema
+func (m *GraphQLSchema) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSdl(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *GraphQLSchema) validateSdl(formats strfmt.Registry) error {
+
+	if err := validate.Required("sdl", "body", m.Sdl); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this graph q l schema based on context it is used
+func (m *GraphQLSchema) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GraphQLSchema) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GraphQLSchema) UnmarshalBinary(b []byte) error {
+	var res GraphQLSchema
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
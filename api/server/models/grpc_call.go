@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GrpcCall gRPC call of an API event
+//
+// swagger:model GrpcCall
+type GrpcCall struct {
+
+	// method
+	Method string `json:"method,omitempty"`
+
+	// request messages count
+	RequestMessagesCount int64 `json:"requestMessagesCount,omitempty"`
+
+	// request messages size
+	RequestMessagesSize int64 `json:"requestMessagesSize,omitempty"`
+
+	// response messages count
+	ResponseMessagesCount int64 `json:"responseMessagesCount,omitempty"`
+
+	// response messages size
+	ResponseMessagesSize int64 `json:"responseMessagesSize,omitempty"`
+
+	// Fully qualified service name (package.Service)
+	Service string `json:"service,omitempty"`
+
+	// gRPC status code
+	Status int32 `json:"status"`
+
+	// status message
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// Validate validates this grpc call
+func (m *GrpcCall) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this grpc call based on context it is used
+func (m *GrpcCall) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcCall) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcCall) UnmarshalBinary(b []byte) error {
+	var res GrpcCall
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GrpcMessages gRPC messages of an API event decoded with the uploaded descriptor set, in JSON
+//
+// swagger:model GrpcMessages
+type GrpcMessages struct {
+
+	// JSON array of the request messages
+	RequestMessages string `json:"requestMessages,omitempty"`
+
+	// JSON array of the response messages
+	ResponseMessages string `json:"responseMessages,omitempty"`
+}
+
+// Validate validates this grpc messages
+func (m *GrpcMessages) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this grpc messages based on context it is used
+func (m *GrpcMessages) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcMessages) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcMessages) UnmarshalBinary(b []byte) error {
+	var res GrpcMessages
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// HarImportResult Result of a HAR archive import
+//
+// swagger:model HarImportResult
+type HarImportResult struct {
+
+	// Errors of the failed entries, the list may be truncated
+	Errors []string `json:"errors"`
+
+	// Number of entries that could not be converted or handled
+	// Required: true
+	Failed *int64 `json:"failed"`
+
+	// Number of entries that were handled
+	// Required: true
+	Imported *int64 `json:"imported"`
+}
+
+// Validate validates this har import result
+func (m *HarImportResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateFailed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateImported(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *HarImportResult) validateFailed(formats strfmt.Registry) error {
+
+	if err := validate.Required("failed", "body", m.Failed); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *HarImportResult) validateImported(formats strfmt.Registry) error {
+
+	if err := validate.Required("imported", "body", m.Imported); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this har import result based on context it is used
+func (m *HarImportResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HarImportResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HarImportResult) UnmarshalBinary(b []byte) error {
+	var res HarImportResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// RedactionPolicy Redaction applied to the traces before they are stored and dispatched to the modules
+//
+// swagger:model RedactionPolicy
+type RedactionPolicy struct {
+
+	// action
+	Action RedactionAction `json:"action,omitempty"`
+
+	// Body fields to redact, as JSON paths for JSON bodies ($.user.password, $.cards[*].number, $..token) or XML paths for XML bodies (/user/password, //token)
+	BodyFields []string `json:"bodyFields"`
+
+	// Names of the request and response headers to redact, case insensitive
+	Headers []string `json:"headers"`
+
+	// Regular expressions redacted in the path, query, header values and textual bodies
+	Patterns []string `json:"patterns"`
+}
+
+// Validate validates this redaction policy
+func (m *RedactionPolicy) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RedactionPolicy) validateAction(formats strfmt.Registry) error {
+	if swag.IsZero(m.Action) { // not required
+		return nil
+	}
+
+	if err := m.Action.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("action")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this redaction policy based on the context it is used
+func (m *RedactionPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAction(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *RedactionPolicy) contextValidateAction(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Action.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("action")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *RedactionPolicy) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *RedactionPolicy) UnmarshalBinary(b []byte) error {
+	var res RedactionPolicy
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,132 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReplayRequest The stored events of an API to replay through a module
+//
+// swagger:model ReplayRequest
+type ReplayRequest struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// end time
+	// Required: true
+	// Format: date-time
+	EndTime *strfmt.DateTime `json:"endTime"`
+
+	// module name
+	// Required: true
+	ModuleName *string `json:"moduleName"`
+
+	// start time
+	// Required: true
+	// Format: date-time
+	StartTime *strfmt.DateTime `json:"startTime"`
+}
+
+// Validate validates this replay request
+func (m *ReplayRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateModuleName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStartTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReplayRequest) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReplayRequest) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("endTime", "body", m.EndTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("endTime", "body", "date-time", m.EndTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReplayRequest) validateModuleName(formats strfmt.Registry) error {
+
+	if err := validate.Required("moduleName", "body", m.ModuleName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReplayRequest) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("startTime", "body", m.StartTime); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("startTime", "body", "date-time", m.StartTime.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this replay request based on context it is used
+func (m *ReplayRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReplayRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReplayRequest) UnmarshalBinary(b []byte) error {
+	var res ReplayRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,149 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TracingEndpoints Endpoints the tracing of an API is restricted to for a component, an endpoint without method matches all the methods
+//
+// swagger:model TracingEndpoints
+type TracingEndpoints struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// Name of the module the API is traced for
+	// Required: true
+	Component *string `json:"component"`
+
+	// endpoints
+	// Required: true
+	Endpoints []*TracingEndpoint `json:"endpoints"`
+}
+
+// Validate validates this tracing endpoints
+func (m *TracingEndpoints) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateComponent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEndpoints(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TracingEndpoints) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingEndpoints) validateComponent(formats strfmt.Registry) error {
+
+	if err := validate.Required("component", "body", m.Component); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingEndpoints) validateEndpoints(formats strfmt.Registry) error {
+
+	if err := validate.Required("endpoints", "body", m.Endpoints); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Endpoints); i++ {
+		if swag.IsZero(m.Endpoints[i]) { // not required
+			continue
+		}
+
+		if m.Endpoints[i] != nil {
+			if err := m.Endpoints[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("endpoints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this tracing endpoints based on the context it is used
+func (m *TracingEndpoints) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateEndpoints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TracingEndpoints) contextValidateEndpoints(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Endpoints); i++ {
+
+		if m.Endpoints[i] != nil {
+			if err := m.Endpoints[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("endpoints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TracingEndpoints) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TracingEndpoints) UnmarshalBinary(b []byte) error {
+	var res TracingEndpoints
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
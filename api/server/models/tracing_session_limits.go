@@ -0,0 +1,128 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// TracingSessionLimits Limits of a tracing session, they restart the session when they are set
+//
+// swagger:model TracingSessionLimits
+type TracingSessionLimits struct {
+
+	// api Id
+	// Required: true
+	APIID *uint32 `json:"apiId"`
+
+	// component
+	// Required: true
+	Component *string `json:"component"`
+
+	// Duration of the session, 0 for no limit
+	// Minimum: 0
+	DurationSeconds *int64 `json:"durationSeconds,omitempty"`
+
+	// Number of events after which the tracing is disabled, 0 for no limit
+	// Minimum: 0
+	MaxEvents *int64 `json:"maxEvents,omitempty"`
+}
+
+// Validate validates this tracing session limits
+func (m *TracingSessionLimits) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAPIID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateComponent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDurationSeconds(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMaxEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *TracingSessionLimits) validateAPIID(formats strfmt.Registry) error {
+
+	if err := validate.Required("apiId", "body", m.APIID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSessionLimits) validateComponent(formats strfmt.Registry) error {
+
+	if err := validate.Required("component", "body", m.Component); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSessionLimits) validateDurationSeconds(formats strfmt.Registry) error {
+	if swag.IsZero(m.DurationSeconds) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("durationSeconds", "body", *m.DurationSeconds, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *TracingSessionLimits) validateMaxEvents(formats strfmt.Registry) error {
+	if swag.IsZero(m.MaxEvents) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("maxEvents", "body", *m.MaxEvents, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this tracing session limits based on context it is used
+func (m *TracingSessionLimits) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TracingSessionLimits) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TracingSessionLimits) UnmarshalBinary(b []byte) error {
+	var res TracingSessionLimits
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -136,6 +136,21 @@ func init() {
           },
           {
             "$ref": "#/parameters/alertIsType"
+          },
+          {
+            "$ref": "#/parameters/graphqlOperationTypeIsFilter"
+          },
+          {
+            "$ref": "#/parameters/graphqlOperationNameIsFilter"
+          },
+          {
+            "$ref": "#/parameters/graphqlRootFieldIsFilter"
+          },
+          {
+            "$ref": "#/parameters/grpcServiceIsFilter"
+          },
+          {
+            "$ref": "#/parameters/grpcMethodIsFilter"
           }
         ],
         "responses": {
@@ -193,6 +208,38 @@ func init() {
         }
       }
     },
+    "/apiEvents/{eventId}/grpcMessages": {
+      "get": {
+        "summary": "Get the decoded gRPC messages of an API event",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "description": "API event ID",
+            "name": "eventId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/GrpcMessages"
+            }
+          },
+          "404": {
+            "description": "The messages of the event were not decoded",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
     "/apiEvents/{eventId}/providedSpecDiff": {
       "get": {
         "summary": "Get API event provided spec diff",
@@ -245,6 +292,77 @@ func init() {
         }
       }
     },
+    "/apiFindings": {
+      "get": {
+        "description": "The findings notified by the modules, each notification of a module replaces its findings on the API",
+        "summary": "Get the API findings of all the modules",
+        "parameters": [
+          {
+            "$ref": "#/parameters/page"
+          },
+          {
+            "$ref": "#/parameters/pageSize"
+          },
+          {
+            "$ref": "#/parameters/apiFindingSortKey"
+          },
+          {
+            "$ref": "#/parameters/sortDir"
+          },
+          {
+            "$ref": "#/parameters/apiInfoIdIsFilter"
+          },
+          {
+            "$ref": "#/parameters/findingSeverityIsFilter"
+          },
+          {
+            "$ref": "#/parameters/findingModuleIsFilter"
+          },
+          {
+            "$ref": "#/parameters/findingTypeIsFilter"
+          },
+          {
+            "$ref": "#/parameters/specLocationIsFilter"
+          },
+          {
+            "$ref": "#/parameters/specLocationStartsWithFilter"
+          },
+          {
+            "$ref": "#/parameters/findingsStartTime"
+          },
+          {
+            "$ref": "#/parameters/findingsEndTime"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "object",
+              "required": [
+                "total"
+              ],
+              "properties": {
+                "items": {
+                  "description": "List of filtered findings in the given page. List length must be lower or equal to pageSize",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ApiFinding"
+                  }
+                },
+                "total": {
+                  "description": "Total filtered findings count",
+                  "type": "integer"
+                }
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
     "/apiInventory": {
       "get": {
         "summary": "Get API inventory",
@@ -435,20 +553,58 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/provided_swagger.json": {
-      "get": {
-        "summary": "Get provided API spec json file",
+    "/apiInventory/{apiId}/classificationOverride": {
+      "put": {
+        "summary": "Force the classification of the next traffic of an API as API (learned and analyzed) or non-API",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ApiClassificationOverride"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "spec in json format",
-              "type": "object"
+              "$ref": "#/responses/Success"
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Classify the next traffic of an API by the media type of its responses again",
+        "parameters": [
+          {
+            "$ref": "#/parameters/apiId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/responses/Success"
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -457,20 +613,28 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/reconstructed_swagger.json": {
+    "/apiInventory/{apiId}/graphql/operations": {
       "get": {
-        "summary": "Get reconstructed API spec json file",
+        "summary": "Get the usage of the GraphQL operations of an API within a selected timeframe",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
+          },
+          {
+            "$ref": "#/parameters/startTime"
+          },
+          {
+            "$ref": "#/parameters/endTime"
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "spec in json format",
-              "type": "object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GraphQLOperationUsage"
+              }
             }
           },
           "default": {
@@ -479,9 +643,9 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/specs": {
+    "/apiInventory/{apiId}/graphql/schema": {
       "get": {
-        "summary": "Get provided and reconstructed open api specs for a specific API",
+        "summary": "Get the GraphQL schema reconstructed from the traffic of an API",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
@@ -491,7 +655,13 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/OpenApiSpecs"
+              "$ref": "#/definitions/GraphQLSchema"
+            }
+          },
+          "404": {
+            "description": "No GraphQL traffic for this API",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -500,9 +670,9 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/specs/providedSpec": {
+    "/apiInventory/{apiId}/grpc/descriptorSet": {
       "put": {
-        "summary": "Add or edit a spec for a specific API",
+        "summary": "Set the protobuf descriptor set of a gRPC API, used to decode the messages of its next events",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
@@ -512,22 +682,21 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "description": "Json or Yaml representing openapi spec V2",
-              "$ref": "#/definitions/rawSpec"
+              "$ref": "#/definitions/GrpcDescriptorSet"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/rawSpec"
+              "$ref": "#/definitions/GrpcServices"
             }
           },
           "400": {
-            "description": "Spec validation failure",
+            "description": "Invalid descriptor set",
             "schema": {
-              "type": "string"
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -536,7 +705,7 @@ func init() {
         }
       },
       "delete": {
-        "summary": "Unset a provided spec for a specific API",
+        "summary": "Unset the protobuf descriptor set of a gRPC API",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
@@ -555,19 +724,29 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/specs/reconstructedSpec": {
-      "delete": {
-        "summary": "Unset a reconstructed spec for a specific API",
+    "/apiInventory/{apiId}/grpc/methods": {
+      "get": {
+        "description": "The methods of the uploaded descriptor set that were not called are included with a zero count",
+        "summary": "Get the gRPC methods of an API and their usage within a selected timeframe",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
+          },
+          {
+            "$ref": "#/parameters/startTime"
+          },
+          {
+            "$ref": "#/parameters/endTime"
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/responses/Success"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GrpcMethodUsage"
+              }
             }
           },
           "default": {
@@ -576,9 +755,9 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/suggestedReview": {
+    "/apiInventory/{apiId}/provided_swagger.json": {
       "get": {
-        "summary": "Get reconstructed spec for review",
+        "summary": "Get provided API spec json file",
         "parameters": [
           {
             "$ref": "#/parameters/apiId"
@@ -588,7 +767,8 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/SuggestedReview"
+              "description": "spec in json format",
+              "type": "object"
             }
           },
           "default": {
@@ -597,28 +777,20 @@ func init() {
         }
       }
     },
-    "/apiInventory/{reviewId}/approvedReview": {
-      "post": {
-        "summary": "Apply the approved review to create the reconstructed spec",
+    "/apiInventory/{apiId}/reconstructed_swagger.json": {
+      "get": {
+        "summary": "Get reconstructed API spec json file",
         "parameters": [
           {
-            "$ref": "#/parameters/reviewId"
-          },
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "description": "Approved review",
-              "$ref": "#/definitions/ApprovedReview"
-            }
+            "$ref": "#/parameters/apiId"
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/responses/Success"
+              "description": "spec in json format",
+              "type": "object"
             }
           },
           "default": {
@@ -627,23 +799,171 @@ func init() {
         }
       }
     },
-    "/apiUsage/hitCount": {
+    "/apiInventory/{apiId}/specs": {
       "get": {
-        "summary": "Get a hit count within a selected timeframe for the filtered API events",
+        "summary": "Get provided and reconstructed open api specs for a specific API",
         "parameters": [
           {
-            "$ref": "#/parameters/startTime"
-          },
-          {
-            "$ref": "#/parameters/endTime"
+            "$ref": "#/parameters/apiId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/OpenApiSpecs"
+            }
           },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/specs/providedSpec": {
+      "put": {
+        "summary": "Add or edit a spec for a specific API",
+        "parameters": [
           {
-            "$ref": "#/parameters/showNonApi"
+            "$ref": "#/parameters/apiId"
           },
           {
-            "$ref": "#/parameters/methodIsFilter"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "Json or Yaml representing openapi spec V2",
+              "$ref": "#/definitions/rawSpec"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/rawSpec"
+            }
           },
-          {
+          "400": {
+            "description": "Spec validation failure",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Unset a provided spec for a specific API",
+        "parameters": [
+          {
+            "$ref": "#/parameters/apiId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/responses/Success"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/specs/reconstructedSpec": {
+      "delete": {
+        "summary": "Unset a reconstructed spec for a specific API",
+        "parameters": [
+          {
+            "$ref": "#/parameters/apiId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/responses/Success"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/suggestedReview": {
+      "get": {
+        "summary": "Get reconstructed spec for review",
+        "parameters": [
+          {
+            "$ref": "#/parameters/apiId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/SuggestedReview"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/apiInventory/{reviewId}/approvedReview": {
+      "post": {
+        "summary": "Apply the approved review to create the reconstructed spec",
+        "parameters": [
+          {
+            "$ref": "#/parameters/reviewId"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "Approved review",
+              "$ref": "#/definitions/ApprovedReview"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/responses/Success"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/apiUsage/hitCount": {
+      "get": {
+        "summary": "Get a hit count within a selected timeframe for the filtered API events",
+        "parameters": [
+          {
+            "$ref": "#/parameters/startTime"
+          },
+          {
+            "$ref": "#/parameters/endTime"
+          },
+          {
+            "$ref": "#/parameters/showNonApi"
+          },
+          {
+            "$ref": "#/parameters/methodIsFilter"
+          },
+          {
             "$ref": "#/parameters/providedPathIDIsFilter"
           },
           {
@@ -732,38 +1052,45 @@ func init() {
         }
       }
     },
-    "/control/newDiscoveredAPIs": {
+    "/control/harImport": {
       "post": {
-        "description": "This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.",
-        "summary": "Allows a client to notify APIClarity about new APIs.",
+        "description": "Each entry of the HTTP Archive is handled like a trace received from a trace source, populating the API inventory, the reconstructed specs and the modules findings.",
+        "summary": "Import the traffic of a HAR archive",
         "parameters": [
           {
-            "description": "List of new discovered APIs",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source under which the traffic is imported. If not set, the traffic is imported under the default trace source of APIClarity",
+            "name": "traceSourceId",
+            "in": "query"
+          },
+          {
+            "description": "HAR 1.2 archive",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "hosts"
-              ],
-              "properties": {
-                "hosts": {
-                  "description": "List of discovered APIs, format of hostname:port",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "type": "object"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Import result",
             "schema": {
-              "$ref": "#/responses/Success"
+              "$ref": "#/definitions/HarImportResult"
+            }
+          },
+          "400": {
+            "description": "Invalid HAR archive",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -772,25 +1099,16 @@ func init() {
         }
       }
     },
-    "/control/traceSources": {
+    "/control/modules": {
       "get": {
-        "summary": "List of configured trace sources",
+        "summary": "Get the list of modules with their status",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "type": "object",
-              "required": [
-                "trace_sources"
-              ],
-              "properties": {
-                "trace_sources": {
-                  "description": "List of trace sources",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/TraceSource"
-                  }
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ModuleStatus"
               }
             }
           },
@@ -798,25 +1116,38 @@ func init() {
             "$ref": "#/responses/UnknownError"
           }
         }
-      },
-      "post": {
-        "summary": "Create a new Trace Source",
+      }
+    },
+    "/control/modules/{moduleName}": {
+      "put": {
+        "summary": "Enable or disable a module",
         "parameters": [
+          {
+            "type": "string",
+            "name": "moduleName",
+            "in": "path",
+            "required": true
+          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "Create a new Trace Source",
-              "$ref": "#/definitions/TraceSource"
+              "$ref": "#/definitions/ModuleState"
             }
           }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/TraceSource"
+              "$ref": "#/definitions/ModuleStatus"
+            }
+          },
+          "404": {
+            "description": "Module not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -825,25 +1156,55 @@ func init() {
         }
       }
     },
-    "/control/traceSources/{traceSourceId}": {
-      "get": {
-        "summary": "Get Trace Source information",
+    "/control/newDiscoveredAPIs": {
+      "post": {
+        "description": "This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.",
+        "summary": "Allows a client to notify APIClarity about new APIs.",
         "parameters": [
           {
-            "$ref": "#/parameters/traceSourceId"
+            "description": "List of new discovered APIs",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "hosts"
+              ],
+              "properties": {
+                "hosts": {
+                  "description": "List of discovered APIs, format of hostname:port",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Trace Source information",
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/TraceSource"
+              "$ref": "#/responses/Success"
             }
           },
-          "404": {
-            "description": "Trace Source not found",
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/control/redactionPolicy": {
+      "get": {
+        "description": "The default policy applies to the trace sources without their own policy",
+        "summary": "Get the default redaction policy",
+        "responses": {
+          "200": {
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiResponse"
+              "$ref": "#/definitions/RedactionPolicy"
             }
           },
           "default": {
@@ -851,19 +1212,27 @@ func init() {
           }
         }
       },
-      "delete": {
-        "summary": "Delete a Trace Source",
+      "put": {
+        "summary": "Set the default redaction policy",
         "parameters": [
           {
-            "$ref": "#/parameters/traceSourceId"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
           }
         ],
         "responses": {
-          "204": {
-            "description": "Success"
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
           },
-          "404": {
-            "description": "Trace Source not found",
+          "400": {
+            "description": "Invalid redaction policy",
             "schema": {
               "$ref": "#/definitions/ApiResponse"
             }
@@ -874,41 +1243,105 @@ func init() {
         }
       }
     },
-    "/dashboard/apiUsage": {
+    "/control/replays": {
       "get": {
-        "summary": "Get API usage",
+        "summary": "Get the replays of stored events through modules",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Replay"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "post": {
+        "summary": "Replay the stored events of an API through a module",
         "parameters": [
           {
-            "$ref": "#/parameters/startTime"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ReplayRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Replay started",
+            "schema": {
+              "$ref": "#/definitions/Replay"
+            }
+          },
+          "400": {
+            "description": "Invalid replay",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Module not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
           },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/control/replays/{replayId}": {
+      "get": {
+        "summary": "Get the progress of a replay",
+        "parameters": [
           {
-            "$ref": "#/parameters/endTime"
+            "$ref": "#/parameters/replayId"
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiUsages"
+              "$ref": "#/definitions/Replay"
+            }
+          },
+          "404": {
+            "description": "Replay not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
             "$ref": "#/responses/UnknownError"
           }
         }
-      }
-    },
-    "/dashboard/apiUsage/latestDiffs": {
-      "get": {
-        "summary": "Get latest spec diffs",
+      },
+      "delete": {
+        "summary": "Cancel a replay",
+        "parameters": [
+          {
+            "$ref": "#/parameters/replayId"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/SpecDiffTime"
-              }
+              "$ref": "#/definitions/Replay"
+            }
+          },
+          "404": {
+            "description": "Replay not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -917,16 +1350,16 @@ func init() {
         }
       }
     },
-    "/dashboard/apiUsage/mostUsed": {
+    "/control/samplingRates": {
       "get": {
-        "summary": "Get most used APIs",
+        "summary": "List the sampling rates of the APIs",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/ApiCount"
+                "$ref": "#/definitions/SamplingRate"
               }
             }
           },
@@ -934,528 +1367,898 @@ func init() {
             "$ref": "#/responses/UnknownError"
           }
         }
+      },
+      "put": {
+        "summary": "Set the sampling rate of an API for a component",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SamplingRate"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/SamplingRate"
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Delete the sampling rate of an API for a component, all its traces are kept again",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "component",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
       }
     },
-    "/features": {
+    "/control/traceSources": {
       "get": {
-        "summary": "Get the list of APIClarity features and for each feature the list of API hosts (in the form 'host:port') the feature requires to get trace for",
+        "summary": "List of configured trace sources",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/APIClarityFeatureList"
+              "type": "object",
+              "required": [
+                "trace_sources"
+              ],
+              "properties": {
+                "trace_sources": {
+                  "description": "List of trace sources",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/TraceSource"
+                  }
+                }
+              }
             }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
           }
         }
-      }
-    }
-  },
-  "definitions": {
-    "APIClarityFeature": {
-      "description": "Description of APIClarity feature and the list of API hosts (Group by trace sources, in the form 'host:port') the feature requires to get trace for",
-      "type": "object",
-      "required": [
-        "featureName"
-      ],
-      "properties": {
-        "featureDescription": {
-          "description": "Short human readable description of the feature",
-          "type": "string"
-        },
-        "featureName": {
-          "$ref": "#/definitions/APIClarityFeatureEnum"
-        },
-        "hostsToTrace": {
-          "$ref": "#/definitions/HostsToTraceForComponent"
+      },
+      "post": {
+        "summary": "Create a new Trace Source",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "Create a new Trace Source",
+              "$ref": "#/definitions/TraceSource"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/TraceSource"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
         }
       }
     },
-    "APIClarityFeatureEnum": {
-      "description": "APIClarity Feature Name",
-      "type": "string",
-      "enum": [
-        "specreconstructor",
-        "specdiffs",
-        "traceanalyzer",
-        "bfla",
-        "spec_differ",
-        "fuzzer"
-      ]
-    },
-    "APIClarityFeatureList": {
-      "description": "List of APIClarity features and for each feature the list of API hosts (Group by trace sources, in the form 'host:port') the feature requires to get trace for",
-      "type": "object",
-      "properties": {
-        "features": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/APIClarityFeature"
+    "/control/traceSources/{traceSourceId}": {
+      "get": {
+        "summary": "Get Trace Source information",
+        "parameters": [
+          {
+            "$ref": "#/parameters/traceSourceId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Trace Source information",
+            "schema": {
+              "$ref": "#/definitions/TraceSource"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Delete a Trace Source",
+        "parameters": [
+          {
+            "$ref": "#/parameters/traceSourceId"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
           }
         }
       }
     },
-    "AlertSeverityEnum": {
-      "description": "Level of alert",
-      "type": "string",
-      "enum": [
-        "ALERT_INFO",
-        "ALERT_WARN",
-        "ALERT_CRITICAL"
-      ]
-    },
-    "ApiCount": {
-      "type": "object",
-      "properties": {
-        "apiHostName": {
-          "type": "string"
-        },
-        "apiInfoId": {
-          "description": "hold the relevant api info id",
-          "type": "integer",
-          "format": "uint32"
-        },
-        "apiPort": {
-          "type": "integer"
-        },
-        "apiType": {
-          "$ref": "#/definitions/ApiType"
-        },
-        "numCalls": {
-          "type": "integer"
+    "/control/traceSources/{traceSourceId}/redactionPolicy": {
+      "get": {
+        "summary": "Get the redaction policy of a Trace Source",
+        "parameters": [
+          {
+            "$ref": "#/parameters/traceSourceId"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found or without its own redaction policy",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
         }
-      }
-    },
-    "ApiEvent": {
-      "type": "object",
-      "properties": {
-        "alerts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ModuleAlert"
+      },
+      "put": {
+        "summary": "Set the redaction policy of a Trace Source",
+        "parameters": [
+          {
+            "$ref": "#/parameters/traceSourceId"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "400": {
+            "description": "Invalid redaction policy",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Delete the redaction policy of a Trace Source, the default policy applies again",
+        "parameters": [
+          {
+            "$ref": "#/parameters/traceSourceId"
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
           }
-        },
-        "apiInfoId": {
-          "description": "hold the relevant api spec info id",
-          "type": "integer",
-          "format": "uint32"
-        },
-        "apiType": {
-          "$ref": "#/definitions/ApiType"
-        },
-        "destinationIP": {
-          "type": "string"
-        },
-        "destinationPort": {
-          "type": "integer"
-        },
-        "hasProvidedSpecDiff": {
-          "type": "boolean",
-          "default": false
-        },
-        "hasReconstructedSpecDiff": {
-          "type": "boolean",
-          "default": false
-        },
-        "hostSpecName": {
-          "type": "string"
-        },
-        "id": {
-          "type": "integer",
-          "format": "uint32"
-        },
-        "method": {
-          "$ref": "#/definitions/HttpMethod"
-        },
-        "path": {
-          "type": "string"
-        },
-        "query": {
-          "type": "string"
-        },
-        "requestTime": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "sourceIP": {
-          "type": "string"
-        },
-        "specDiffType": {
-          "$ref": "#/definitions/DiffType"
-        },
-        "statusCode": {
-          "type": "integer"
-        },
-        "time": {
-          "type": "string",
-          "format": "date-time"
         }
       }
     },
-    "ApiEventPathAndMethods": {
-      "type": "object",
-      "properties": {
-        "methods": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/HttpMethod"
+    "/control/tracingEndpoints": {
+      "get": {
+        "summary": "List the endpoints the tracing of the APIs is restricted to",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/TracingEndpoints"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "put": {
+        "summary": "Restrict the tracing of an API for a component to endpoints of its spec",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TracingEndpoints"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/TracingEndpoints"
+            }
+          },
+          "400": {
+            "description": "Endpoint not found in the spec of the API",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Remove the restriction of the tracing of an API for a component, all its endpoints are traced again",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "component",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
           }
-        },
-        "path": {
-          "type": "string"
         }
       }
     },
-    "ApiEventSortKey": {
-      "type": "string",
-      "enum": [
-        "time",
-        "method",
-        "path",
-        "statusCode",
-        "sourceIP",
-        "destinationIP",
-        "destinationPort",
-        "specDiffType",
-        "hostSpecName",
-        "apiType"
-      ]
-    },
-    "ApiEventSpecDiff": {
-      "type": "object",
-      "required": [
-        "oldSpec",
-        "newSpec"
-      ],
-      "properties": {
-        "diffType": {
-          "$ref": "#/definitions/DiffType"
-        },
-        "newSpec": {
-          "description": "New spec json string",
-          "type": "string"
-        },
-        "oldSpec": {
-          "description": "Old spec json string",
-          "type": "string"
+    "/control/tracingSessions": {
+      "get": {
+        "summary": "List the active tracing sessions of the modules",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/TracingSession"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "put": {
+        "summary": "Set the limits of an active tracing session and restart it",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TracingSessionLimits"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/TracingSession"
+            }
+          },
+          "404": {
+            "description": "Tracing session not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      },
+      "delete": {
+        "summary": "Stop a tracing session",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "component",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
         }
       }
     },
-    "ApiInfo": {
-      "type": "object",
-      "properties": {
-        "destinationNamespace": {
-          "type": "string"
-        },
-        "hasProvidedSpec": {
-          "type": "boolean",
-          "default": false
-        },
-        "hasReconstructedSpec": {
-          "type": "boolean",
-          "default": false
-        },
-        "id": {
-          "type": "integer",
-          "format": "uint32"
-        },
-        "name": {
-          "description": "API name",
-          "type": "string"
-        },
-        "port": {
-          "type": "integer"
-        },
-        "traceSourceId": {
-          "description": "Trace Source ID which created this API. Null UUID 0 means it has been created by APIClarity (from the UI for example)",
-          "type": "string",
-          "format": "uuid"
+    "/dashboard/apiUsage": {
+      "get": {
+        "summary": "Get API usage",
+        "parameters": [
+          {
+            "$ref": "#/parameters/startTime"
+          },
+          {
+            "$ref": "#/parameters/endTime"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiUsages"
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
         }
       }
     },
-    "ApiInfoWithType": {
-      "type": "object",
-      "allOf": [
-        {
-          "$ref": "#/definitions/ApiInfo"
-        },
-        {
-          "properties": {
-            "apiType": {
-              "$ref": "#/definitions/ApiType"
+    "/dashboard/apiUsage/latestDiffs": {
+      "get": {
+        "summary": "Get latest spec diffs",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SpecDiffTime"
+              }
             }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
           }
         }
-      ]
+      }
     },
-    "ApiInventorySortKey": {
-      "type": "string",
-      "enum": [
-        "name",
-        "port",
-        "hasReconstructedSpec",
-        "hasProvidedSpec"
-      ]
+    "/dashboard/apiUsage/mostUsed": {
+      "get": {
+        "summary": "Get most used APIs",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ApiCount"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
     },
-    "ApiResponse": {
-      "description": "An object that is return in all cases of failures.",
+    "/features": {
+      "get": {
+        "summary": "Get the list of APIClarity features and for each feature the list of API hosts (in the form 'host:port') the feature requires to get trace for",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/APIClarityFeatureList"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "APIClarityFeature": {
+      "description": "Description of APIClarity feature and the list of API hosts (Group by trace sources, in the form 'host:port') the feature requires to get trace for",
       "type": "object",
+      "required": [
+        "featureName"
+      ],
       "properties": {
-        "message": {
+        "featureDescription": {
+          "description": "Short human readable description of the feature",
           "type": "string"
+        },
+        "featureName": {
+          "$ref": "#/definitions/APIClarityFeatureEnum"
+        },
+        "hostsToTrace": {
+          "$ref": "#/definitions/HostsToTraceForComponent"
         }
       }
     },
-    "ApiType": {
+    "APIClarityFeatureEnum": {
+      "description": "APIClarity Feature Name",
       "type": "string",
       "enum": [
-        "INTERNAL",
-        "EXTERNAL"
+        "specreconstructor",
+        "specdiffs",
+        "traceanalyzer",
+        "bfla",
+        "spec_differ",
+        "fuzzer",
+        "customrules"
       ]
     },
-    "ApiUsage": {
+    "APIClarityFeatureList": {
+      "description": "List of APIClarity features and for each feature the list of API hosts (Group by trace sources, in the form 'host:port') the feature requires to get trace for",
       "type": "object",
       "properties": {
-        "numOfCalls": {
-          "type": "integer",
-          "x-omitempty": false
-        },
-        "time": {
-          "type": "string",
-          "format": "date-time"
+        "features": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/APIClarityFeature"
+          }
         }
       }
     },
-    "ApiUsages": {
-      "type": "object",
-      "properties": {
-        "apisWithDiff": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ApiUsage"
-          }
-        },
-        "existingApis": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ApiUsage"
-          }
-        },
-        "newApis": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ApiUsage"
-          }
-        }
-      }
-    },
-    "ApprovedReview": {
-      "type": "object",
-      "properties": {
-        "oasVersion": {
-          "description": "OpenAPI specification version to use when saving the approved spec",
-          "type": "string",
-          "enum": [
-            "OASv2.0",
-            "OASv3.0"
-          ]
-        },
-        "reviewPathItems": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ReviewPathItem"
-          }
-        }
-      }
+    "AlertSeverityEnum": {
+      "description": "Level of alert",
+      "type": "string",
+      "enum": [
+        "ALERT_INFO",
+        "ALERT_WARN",
+        "ALERT_CRITICAL"
+      ]
     },
-    "DiffType": {
+    "ApiClassification": {
       "type": "string",
-      "default": "NO_DIFF",
       "enum": [
-        "ZOMBIE_DIFF",
-        "SHADOW_DIFF",
-        "GENERAL_DIFF",
-        "NO_DIFF"
+        "API",
+        "NON_API"
       ]
     },
-    "HitCount": {
+    "ApiClassificationOverride": {
       "type": "object",
+      "required": [
+        "classification"
+      ],
       "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64",
-          "x-omitempty": false
-        },
-        "time": {
-          "type": "string",
-          "format": "date-time"
+        "classification": {
+          "$ref": "#/definitions/ApiClassification"
         }
       }
     },
-    "HostsToTraceForComponent": {
-      "description": "List of trace sources for a component, with theirs list of API hosts to get trace for",
+    "ApiCount": {
       "type": "object",
       "properties": {
-        "component": {
-          "description": "Component name",
+        "apiHostName": {
           "type": "string"
         },
-        "traceSourcesHosts": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/HostsToTraceForTraceSource"
-          }
+        "apiInfoId": {
+          "description": "hold the relevant api info id",
+          "type": "integer",
+          "format": "uint32"
+        },
+        "apiPort": {
+          "type": "integer"
+        },
+        "apiType": {
+          "$ref": "#/definitions/ApiType"
+        },
+        "numCalls": {
+          "type": "integer"
         }
       }
     },
-    "HostsToTraceForTraceSource": {
-      "description": "list of API hosts (in the form 'host:port') managed by a traceSource",
+    "ApiEvent": {
       "type": "object",
       "properties": {
-        "hostsToTrace": {
-          "description": "List of hosts (in the form 'host:port')",
+        "alerts": {
           "type": "array",
           "items": {
-            "type": "string"
+            "$ref": "#/definitions/ModuleAlert"
           }
         },
-        "traceSourceID": {
-          "description": "ID of the trace source",
+        "apiInfoId": {
+          "description": "hold the relevant api spec info id",
           "type": "integer",
           "format": "uint32"
-        }
-      }
-    },
-    "HttpMethod": {
-      "type": "string",
-      "enum": [
-        "GET",
-        "HEAD",
-        "POST",
-        "PUT",
-        "DELETE",
-        "CONNECT",
-        "OPTIONS",
-        "TRACE",
-        "PATCH"
-      ]
-    },
-    "MethodAndPath": {
-      "type": "object",
-      "properties": {
+        },
+        "apiType": {
+          "$ref": "#/definitions/ApiType"
+        },
+        "destinationIP": {
+          "type": "string"
+        },
+        "destinationPort": {
+          "type": "integer"
+        },
+        "graphqlOperation": {
+          "$ref": "#/definitions/GraphQLOperation"
+        },
+        "grpcCall": {
+          "$ref": "#/definitions/GrpcCall"
+        },
+        "hasProvidedSpecDiff": {
+          "type": "boolean",
+          "default": false
+        },
+        "hasReconstructedSpecDiff": {
+          "type": "boolean",
+          "default": false
+        },
+        "hostSpecName": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
         "method": {
           "$ref": "#/definitions/HttpMethod"
         },
         "path": {
           "type": "string"
         },
-        "pathId": {
+        "query": {
+          "type": "string"
+        },
+        "requestTime": {
           "type": "string",
-          "format": "uuid"
+          "format": "date-time"
+        },
+        "sourceIP": {
+          "type": "string"
+        },
+        "specDiffType": {
+          "$ref": "#/definitions/DiffType"
+        },
+        "statusCode": {
+          "type": "integer"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "ModuleAlert": {
+    "ApiEventPathAndMethods": {
       "type": "object",
       "properties": {
-        "alert": {
-          "$ref": "#/definitions/AlertSeverityEnum"
-        },
-        "moduleName": {
-          "description": "Name of the module which created this alert",
-          "type": "string"
+        "methods": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HttpMethod"
+          }
         },
-        "reason": {
-          "description": "Optional description of reason of the alert",
+        "path": {
           "type": "string"
         }
       }
     },
-    "OASVersion": {
-      "description": "OpenAPI specification version",
+    "ApiEventSortKey": {
       "type": "string",
       "enum": [
-        "OASv2.0",
-        "OASv3.0"
+        "time",
+        "method",
+        "path",
+        "statusCode",
+        "sourceIP",
+        "destinationIP",
+        "destinationPort",
+        "specDiffType",
+        "hostSpecName",
+        "apiType"
       ]
     },
-    "OpenApiSpecs": {
-      "description": "An object representing the provided and reconstructed API specs",
+    "ApiEventSpecDiff": {
       "type": "object",
+      "required": [
+        "oldSpec",
+        "newSpec"
+      ],
       "properties": {
-        "providedSpec": {
-          "$ref": "#/definitions/SpecInfo"
+        "diffType": {
+          "$ref": "#/definitions/DiffType"
         },
-        "reconstructedSpec": {
-          "$ref": "#/definitions/SpecInfo"
-        }
-      }
-    },
-    "ReviewPathItem": {
-      "type": "object",
-      "properties": {
-        "apiEventsPaths": {
-          "description": "Group of api event paths (original) that suggestedPath is representing",
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/ApiEventPathAndMethods"
-          }
+        "newSpec": {
+          "description": "New spec json string",
+          "type": "string"
         },
-        "suggestedPath": {
-          "description": "Represents the parameterized path suggested by the engine",
+        "oldSpec": {
+          "description": "Old spec json string",
           "type": "string"
         }
       }
     },
-    "SpecDiffTime": {
+    "ApiFinding": {
+      "description": "A finding of a module on an API",
       "type": "object",
+      "required": [
+        "id",
+        "apiInfoId",
+        "moduleName",
+        "type",
+        "name",
+        "severity",
+        "firstSeen",
+        "lastSeen"
+      ],
       "properties": {
-        "apiEventId": {
+        "additionalInfo": {
+          "description": "Opaque JSON object of the module",
+          "type": "object"
+        },
+        "apiInfoId": {
           "type": "integer",
           "format": "uint32"
         },
-        "apiHostName": {
+        "apiName": {
+          "description": "Name of the API",
           "type": "string"
         },
-        "diffType": {
-          "$ref": "#/definitions/DiffType"
+        "description": {
+          "description": "Human readable description of the finding",
+          "type": "string"
         },
-        "time": {
+        "firstSeen": {
+          "description": "First time the finding was notified",
           "type": "string",
           "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "lastSeen": {
+          "description": "Last time the finding was notified",
+          "type": "string",
+          "format": "date-time"
+        },
+        "moduleName": {
+          "description": "Module which notified the finding",
+          "type": "string"
+        },
+        "name": {
+          "description": "Human readable name of the finding",
+          "type": "string"
+        },
+        "providedSpecLocation": {
+          "description": "JSON pointer to the location of the finding in the provided spec",
+          "type": "string"
+        },
+        "reconstructedSpecLocation": {
+          "description": "JSON pointer to the location of the finding in the reconstructed spec",
+          "type": "string"
+        },
+        "severity": {
+          "$ref": "#/definitions/FindingSeverity"
+        },
+        "source": {
+          "description": "Name of the module which created this finding",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of the finding",
+          "type": "string"
         }
       }
     },
-    "SpecInfo": {
-      "description": "An object containing info about a spec",
-      "type": "object",
-      "properties": {
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/SpecTag"
-          }
-        }
-      }
-    },
-    "SpecTag": {
+    "ApiFindingSortKey": {
+      "type": "string",
+      "enum": [
+        "severity",
+        "firstSeen",
+        "lastSeen",
+        "type",
+        "moduleName",
+        "apiInfoId"
+      ]
+    },
+    "ApiFindingsSummary": {
+      "description": "Count of the findings of an API by severity",
       "type": "object",
       "properties": {
-        "description": {
+        "critical": {
+          "type": "integer"
+        },
+        "high": {
+          "type": "integer"
+        },
+        "info": {
+          "type": "integer"
+        },
+        "low": {
+          "type": "integer"
+        },
+        "medium": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
+        }
+      }
+    },
+    "ApiInfo": {
+      "type": "object",
+      "properties": {
+        "classificationOverride": {
+          "description": "Classification forced on the traffic of the API, unset when it is classified by the media type of its responses",
+          "$ref": "#/definitions/ApiClassification"
+        },
+        "destinationNamespace": {
           "type": "string"
         },
-        "methodAndPathList": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/MethodAndPath"
-          }
+        "findingsSummary": {
+          "$ref": "#/definitions/ApiFindingsSummary"
+        },
+        "hasProvidedSpec": {
+          "type": "boolean",
+          "default": false
+        },
+        "hasReconstructedSpec": {
+          "type": "boolean",
+          "default": false
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
         },
         "name": {
+          "description": "API name",
+          "type": "string"
+        },
+        "port": {
+          "type": "integer"
+        },
+        "traceSourceId": {
+          "description": "Trace Source ID which created this API. Null UUID 0 means it has been created by APIClarity (from the UI for example)",
+          "type": "string",
+          "format": "uuid"
+        },
+        "traceSourceName": {
+          "description": "Trace source name",
+          "type": "string"
+        },
+        "traceSourceType": {
+          "description": "Trace source type",
           "type": "string"
         }
       }
     },
-    "SuccessResponse": {
-      "description": "An object that is return in cases of success that return nothing.",
+    "ApiInfoWithType": {
+      "type": "object",
+      "allOf": [
+        {
+          "$ref": "#/definitions/ApiInfo"
+        },
+        {
+          "properties": {
+            "apiType": {
+              "$ref": "#/definitions/ApiType"
+            }
+          }
+        }
+      ]
+    },
+    "ApiInventorySortKey": {
+      "type": "string",
+      "enum": [
+        "name",
+        "port",
+        "hasReconstructedSpec",
+        "hasProvidedSpec"
+      ]
+    },
+    "ApiResponse": {
+      "description": "An object that is return in all cases of failures.",
       "type": "object",
       "properties": {
         "message": {
@@ -1463,603 +2266,2953 @@ func init() {
         }
       }
     },
-    "SuggestedReview": {
+    "ApiType": {
+      "type": "string",
+      "enum": [
+        "INTERNAL",
+        "EXTERNAL"
+      ]
+    },
+    "ApiUsage": {
       "type": "object",
       "properties": {
-        "id": {
+        "numOfCalls": {
           "type": "integer",
-          "format": "uint32"
+          "x-omitempty": false
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "ApiUsages": {
+      "type": "object",
+      "properties": {
+        "apisWithDiff": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ApiUsage"
+          }
+        },
+        "existingApis": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ApiUsage"
+          }
+        },
+        "newApis": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ApiUsage"
+          }
+        }
+      }
+    },
+    "ApprovedReview": {
+      "type": "object",
+      "properties": {
+        "oasVersion": {
+          "description": "OpenAPI specification version to use when saving the approved spec",
+          "type": "string",
+          "enum": [
+            "OASv2.0",
+            "OASv3.0"
+          ]
         },
         "reviewPathItems": {
           "type": "array",
           "items": {
-            "description": "The suggested path items",
             "$ref": "#/definitions/ReviewPathItem"
           }
         }
       }
     },
-    "TraceSource": {
-      "description": "A Source which is sending traces to APIClarity",
+    "DiffType": {
+      "type": "string",
+      "default": "NO_DIFF",
+      "enum": [
+        "ZOMBIE_DIFF",
+        "SHADOW_DIFF",
+        "GENERAL_DIFF",
+        "NO_DIFF"
+      ]
+    },
+    "FindingSeverity": {
+      "description": "Severity of a finding",
+      "type": "string",
+      "enum": [
+        "INFO",
+        "LOW",
+        "MEDIUM",
+        "HIGH",
+        "CRITICAL"
+      ]
+    },
+    "GraphQLOperation": {
+      "description": "GraphQL operation executed by an API event",
       "type": "object",
-      "required": [
-        "name",
-        "type"
-      ],
       "properties": {
-        "description": {
-          "type": "string"
-        },
-        "id": {
-          "type": "integer"
-        },
-        "name": {
-          "description": "Unique name identifying a Trace Source",
-          "type": "string"
-        },
-        "token": {
+        "operationName": {
+          "description": "Empty for anonymous operations",
           "type": "string"
         },
-        "type": {
-          "$ref": "#/definitions/TraceSourceType"
+        "operationType": {
+          "$ref": "#/definitions/GraphQLOperationType"
         },
-        "uid": {
-          "type": "string",
-          "format": "uuid"
+        "rootFields": {
+          "description": "Sorted names of the selected root fields",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "TraceSourceType": {
+    "GraphQLOperationType": {
       "type": "string",
       "enum": [
-        "APIGEE_X",
-        "F5_BIG_IP",
-        "KONG_INTERNAL",
-        "TYK_INTERNAL"
+        "QUERY",
+        "MUTATION",
+        "SUBSCRIPTION"
       ]
     },
-    "rawSpec": {
-      "description": "spec in json or yaml format",
+    "GraphQLOperationUsage": {
       "type": "object",
       "properties": {
-        "createdAt": {
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSeen": {
           "type": "string",
           "format": "date-time"
         },
-        "rawSpec": {
-          "description": "spec in json or yaml format",
-          "type": "string"
+        "operation": {
+          "$ref": "#/definitions/GraphQLOperation"
         }
       }
-    }
-  },
-  "parameters": {
-    "alertIsFilter": {
-      "type": "array",
-      "items": {
-        "enum": [
-          "ALERT_INFO",
-          "ALERT_WARN",
-          "ALERT_CRITICAL"
-        ],
-        "type": "string"
-      },
-      "description": "Alert Kind [ALERT_INFO or ALERT_WARN]",
-      "name": "alert[is]",
-      "in": "query"
-    },
-    "alertIsType": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "alertType[is]",
-      "in": "query"
     },
-    "apiEventSortKey": {
-      "enum": [
-        "time",
-        "method",
-        "path",
-        "statusCode",
-        "sourceIP",
-        "destinationIP",
-        "destinationPort",
-        "specDiffType",
-        "hostSpecName",
-        "apiType"
+    "GraphQLSchema": {
+      "type": "object",
+      "required": [
+        "sdl"
       ],
-      "type": "string",
-      "description": "Sort key",
-      "name": "sortKey",
-      "in": "query",
-      "required": true
-    },
-    "apiId": {
-      "type": "integer",
-      "format": "uint32",
-      "name": "apiId",
-      "in": "path",
-      "required": true
-    },
-    "apiIdFilter": {
-      "type": "string",
-      "description": "api id to return",
-      "name": "apiId",
-      "in": "query"
+      "properties": {
+        "sdl": {
+          "description": "Reconstructed schema in the GraphQL schema definition language",
+          "type": "string"
+        }
+      }
     },
-    "apiInfoIdIsFilter": {
-      "type": "integer",
-      "format": "uint32",
-      "name": "apiInfoId[is]",
-      "in": "query"
+    "GrpcCall": {
+      "description": "gRPC call of an API event",
+      "type": "object",
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "requestMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "requestMessagesSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "service": {
+          "description": "Fully qualified service name (package.Service)",
+          "type": "string"
+        },
+        "status": {
+          "description": "gRPC status code",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "statusMessage": {
+          "type": "string"
+        }
+      }
     },
-    "apiInventorySortKey": {
-      "enum": [
-        "name",
-        "port",
-        "hasReconstructedSpec",
-        "hasProvidedSpec"
+    "GrpcDescriptorSet": {
+      "type": "object",
+      "required": [
+        "descriptorSet"
       ],
-      "type": "string",
-      "description": "Sort key",
-      "name": "sortKey",
-      "in": "query",
-      "required": true
-    },
-    "apiNameContainsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "name[contains]",
-      "in": "query"
-    },
-    "apiNameEndsWithFilter": {
-      "type": "string",
-      "name": "name[end]",
-      "in": "query"
-    },
-    "apiNameIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "name[is]",
-      "in": "query"
+      "properties": {
+        "descriptorSet": {
+          "description": "Serialized FileDescriptorSet, with the imports included (protoc --include_imports --descriptor_set_out)",
+          "type": "string",
+          "format": "byte"
+        }
+      }
     },
-    "apiNameIsNotFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "name[isNot]",
-      "in": "query"
+    "GrpcMessages": {
+      "description": "gRPC messages of an API event decoded with the uploaded descriptor set, in JSON",
+      "type": "object",
+      "properties": {
+        "requestMessages": {
+          "description": "JSON array of the request messages",
+          "type": "string"
+        },
+        "responseMessages": {
+          "description": "JSON array of the response messages",
+          "type": "string"
+        }
+      }
     },
-    "apiNameStartsWithFilter": {
-      "type": "string",
-      "name": "name[start]",
-      "in": "query"
+    "GrpcMethodUsage": {
+      "type": "object",
+      "properties": {
+        "averageRequestSize": {
+          "description": "Average size in bytes of the request messages of a call",
+          "type": "integer",
+          "format": "int64"
+        },
+        "averageResponseSize": {
+          "description": "Average size in bytes of the response messages of a call",
+          "type": "integer",
+          "format": "int64"
+        },
+        "clientStreaming": {
+          "type": "boolean"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "errorCount": {
+          "description": "Number of calls with a non OK status",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSeen": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "method": {
+          "type": "string"
+        },
+        "requestType": {
+          "description": "Request message type, known if a descriptor set was uploaded",
+          "type": "string"
+        },
+        "responseType": {
+          "description": "Response message type, known if a descriptor set was uploaded",
+          "type": "string"
+        },
+        "serverStreaming": {
+          "type": "boolean"
+        },
+        "service": {
+          "type": "string"
+        }
+      }
     },
-    "apiType": {
-      "enum": [
-        "INTERNAL",
-        "EXTERNAL"
+    "GrpcServices": {
+      "type": "object",
+      "required": [
+        "services"
       ],
-      "type": "string",
-      "description": "API type [INTERNAL or EXTERNAL]",
-      "name": "type",
-      "in": "query",
-      "required": true
+      "properties": {
+        "services": {
+          "description": "Fully qualified names of the services",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     },
-    "destinationIPIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "destinationIP[is]",
-      "in": "query"
+    "HarImportResult": {
+      "description": "Result of a HAR archive import",
+      "type": "object",
+      "required": [
+        "imported",
+        "failed"
+      ],
+      "properties": {
+        "errors": {
+          "description": "Errors of the failed entries, the list may be truncated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "Number of entries that could not be converted or handled",
+          "type": "integer"
+        },
+        "imported": {
+          "description": "Number of entries that were handled",
+          "type": "integer"
+        }
+      }
     },
-    "destinationIPIsNotFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "destinationIP[isNot]",
-      "in": "query"
+    "HitCount": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     },
-    "destinationPortIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "destinationPort[is]",
-      "in": "query"
+    "HostsToTraceForComponent": {
+      "description": "List of trace sources for a component, with theirs list of API hosts to get trace for",
+      "type": "object",
+      "properties": {
+        "component": {
+          "description": "Component name",
+          "type": "string"
+        },
+        "traceSourcesHosts": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HostsToTraceForTraceSource"
+          }
+        }
+      }
     },
-    "destinationPortIsNotFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "destinationPort[isNot]",
-      "in": "query"
+    "HostsToTraceForTraceSource": {
+      "description": "list of API hosts (in the form 'host:port') managed by a traceSource",
+      "type": "object",
+      "properties": {
+        "hostsToTrace": {
+          "description": "List of hosts (in the form 'host:port')",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "traceSourceID": {
+          "description": "ID of the trace source",
+          "type": "integer",
+          "format": "uint32"
+        }
+      }
     },
-    "endTime": {
+    "HttpMethod": {
       "type": "string",
-      "format": "date-time",
-      "description": "End time of the query",
-      "name": "endTime",
-      "in": "query",
-      "required": true
+      "enum": [
+        "GET",
+        "HEAD",
+        "POST",
+        "PUT",
+        "DELETE",
+        "CONNECT",
+        "OPTIONS",
+        "TRACE",
+        "PATCH"
+      ]
     },
-    "hasProvidedSpecFilter": {
-      "type": "boolean",
-      "name": "hasProvidedSpec[is]",
-      "in": "query"
-    },
-    "hasReconstructedSpecFilter": {
-      "type": "boolean",
-      "name": "hasReconstructedSpec[is]",
-      "in": "query"
-    },
-    "hasSpecDiffFilter": {
-      "type": "boolean",
-      "name": "hasSpecDiff[is]",
-      "in": "query"
+    "MethodAndPath": {
+      "type": "object",
+      "properties": {
+        "method": {
+          "$ref": "#/definitions/HttpMethod"
+        },
+        "path": {
+          "type": "string"
+        },
+        "pathId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
     },
-    "host": {
-      "type": "string",
-      "description": "api host name",
-      "name": "host",
-      "in": "query",
-      "required": true
+    "ModuleAlert": {
+      "type": "object",
+      "properties": {
+        "alert": {
+          "$ref": "#/definitions/AlertSeverityEnum"
+        },
+        "moduleName": {
+          "description": "Name of the module which created this alert",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Optional description of reason of the alert",
+          "type": "string"
+        }
+      }
     },
-    "methodIsFilter": {
-      "type": "array",
-      "items": {
-        "enum": [
-          "GET",
-          "HEAD",
-          "POST",
-          "PUT",
-          "DELETE",
-          "CONNECT",
-          "OPTIONS",
-          "TRACE",
-          "PATCH"
-        ],
-        "type": "string"
-      },
-      "name": "method[is]",
-      "in": "query"
+    "ModuleState": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
     },
-    "page": {
-      "type": "integer",
-      "description": "Page number of the query",
-      "name": "page",
-      "in": "query",
-      "required": true
+    "ModuleStatus": {
+      "description": "A module of APIClarity and whether it is enabled",
+      "type": "object",
+      "required": [
+        "name",
+        "enabled"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "enabled": {
+          "description": "A disabled module receives no trace and does not serve its API",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
     },
-    "pageSize": {
-      "maximum": 50,
-      "minimum": 1,
-      "type": "integer",
-      "description": "Maximum items to return",
-      "name": "pageSize",
-      "in": "query",
-      "required": true
+    "OASVersion": {
+      "description": "OpenAPI specification version",
+      "type": "string",
+      "enum": [
+        "OASv2.0",
+        "OASv3.0"
+      ]
     },
-    "pathContainsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "path[contains]",
-      "in": "query"
+    "OpenApiSpecs": {
+      "description": "An object representing the provided and reconstructed API specs",
+      "type": "object",
+      "properties": {
+        "providedSpec": {
+          "$ref": "#/definitions/SpecInfo"
+        },
+        "reconstructedSpec": {
+          "$ref": "#/definitions/SpecInfo"
+        }
+      }
     },
-    "pathEndsWithFilter": {
+    "RedactionAction": {
+      "description": "MASK replaces the redacted values with [REDACTED], HASH with their HMAC-SHA256 keyed with the redaction hash key of the deployment (hmac-sha256:\u003chex\u003e) so equal values can still be correlated",
       "type": "string",
-      "name": "path[end]",
-      "in": "query"
+      "enum": [
+        "MASK",
+        "HASH"
+      ]
     },
-    "pathIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "path[is]",
-      "in": "query"
+    "RedactionPolicy": {
+      "description": "Redaction applied to the traces before they are stored and dispatched to the modules",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/RedactionAction"
+        },
+        "bodyFields": {
+          "description": "Body fields to redact, as JSON paths for JSON bodies ($.user.password, $.cards[*].number, $..token) or XML paths for XML bodies (/user/password, //token)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "headers": {
+          "description": "Names of the request and response headers to redact, case insensitive",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "patterns": {
+          "description": "Regular expressions redacted in the path, query, header values and textual bodies",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
     },
-    "pathIsNotFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "path[isNot]",
-      "in": "query"
+    "Replay": {
+      "description": "A replay of the stored events of an API through a module, only the events whose telemetry is retained are replayed",
+      "type": "object",
+      "required": [
+        "id",
+        "moduleName",
+        "apiId",
+        "startTime",
+        "endTime",
+        "status",
+        "total",
+        "replayed",
+        "skipped",
+        "createdAt"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
+          "type": "string"
+        },
+        "finishedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "moduleName": {
+          "type": "string"
+        },
+        "replayed": {
+          "description": "The number of events replayed so far",
+          "type": "integer"
+        },
+        "skipped": {
+          "description": "The number of events which could not be replayed",
+          "type": "integer"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "$ref": "#/definitions/ReplayStatus"
+        },
+        "total": {
+          "description": "The number of events to replay",
+          "type": "integer"
+        }
+      }
     },
-    "pathStartsWithFilter": {
-      "type": "string",
-      "name": "path[start]",
-      "in": "query"
+    "ReplayRequest": {
+      "description": "The stored events of an API to replay through a module",
+      "type": "object",
+      "required": [
+        "moduleName",
+        "apiId",
+        "startTime",
+        "endTime"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "moduleName": {
+          "type": "string"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     },
-    "port": {
+    "ReplayStatus": {
       "type": "string",
-      "description": "api port",
-      "name": "port",
-      "in": "query",
-      "required": true
+      "enum": [
+        "RUNNING",
+        "COMPLETED",
+        "CANCELED",
+        "FAILED"
+      ]
     },
-    "portIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "port[is]",
-      "in": "query"
+    "ReviewPathItem": {
+      "type": "object",
+      "properties": {
+        "apiEventsPaths": {
+          "description": "Group of api event paths (original) that suggestedPath is representing",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/ApiEventPathAndMethods"
+          }
+        },
+        "suggestedPath": {
+          "description": "Represents the parameterized path suggested by the engine",
+          "type": "string"
+        }
+      }
     },
-    "portIsNotFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "port[isNot]",
-      "in": "query"
+    "SamplingRate": {
+      "description": "Sampling of the traces of an API",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "ratio"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "description": "'*' for the traces sent by the trace sources, sampled at the edge by the trace sources supporting it, or the name of a module for the events dispatched to it",
+          "type": "string"
+        },
+        "maxEventsPerSecond": {
+          "description": "Maximum number of traces to keep per second, 0 for no limit",
+          "type": "number",
+          "format": "double"
+        },
+        "ratio": {
+          "description": "Ratio of the traces to keep",
+          "type": "number",
+          "format": "double",
+          "maximum": 1
+        }
+      }
     },
-    "providedPathIDIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "providedPathID[is]",
-      "in": "query"
+    "SpecDiffTime": {
+      "type": "object",
+      "properties": {
+        "apiEventId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "apiHostName": {
+          "type": "string"
+        },
+        "diffType": {
+          "$ref": "#/definitions/DiffType"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
     },
-    "reconstructedPathIDIsFilter": {
-      "type": "array",
-      "items": {
-        "type": "string"
-      },
-      "name": "reconstructedPathID[is]",
-      "in": "query"
+    "SpecInfo": {
+      "description": "An object containing info about a spec",
+      "type": "object",
+      "properties": {
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/SpecTag"
+          }
+        }
+      }
     },
-    "reviewId": {
-      "type": "integer",
-      "format": "uint32",
-      "name": "reviewId",
-      "in": "path",
-      "required": true
+    "SpecTag": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "methodAndPathList": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/MethodAndPath"
+          }
+        },
+        "name": {
+          "type": "string"
+        }
+      }
     },
-    "showNonApi": {
-      "type": "boolean",
-      "name": "showNonApi",
-      "in": "query",
-      "required": true
+    "SuccessResponse": {
+      "description": "An object that is return in cases of success that return nothing.",
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
     },
-    "sortDir": {
-      "enum": [
-        "ASC",
-        "DESC"
+    "SuggestedReview": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "reviewPathItems": {
+          "type": "array",
+          "items": {
+            "description": "The suggested path items",
+            "$ref": "#/definitions/ReviewPathItem"
+          }
+        }
+      }
+    },
+    "TraceSource": {
+      "description": "A Source which is sending traces to APIClarity",
+      "type": "object",
+      "required": [
+        "name",
+        "type"
       ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer"
+        },
+        "name": {
+          "description": "Unique name identifying a Trace Source",
+          "type": "string"
+        },
+        "token": {
+          "type": "string"
+        },
+        "type": {
+          "$ref": "#/definitions/TraceSourceType"
+        },
+        "uid": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "TraceSourceType": {
       "type": "string",
-      "default": "ASC",
-      "description": "Sorting direction",
-      "name": "sortDir",
-      "in": "query"
+      "enum": [
+        "APIGEE_X",
+        "F5_BIG_IP",
+        "KONG_INTERNAL",
+        "TYK_INTERNAL"
+      ]
     },
-    "sourceIPIsFilter": {
+    "TracingEndpoint": {
+      "description": "Endpoint of the spec of an API",
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "method": {
+          "$ref": "#/definitions/HttpMethod"
+        },
+        "path": {
+          "description": "Path template of the spec of the API, e.g. /v1/carts/{cartId}/checkout",
+          "type": "string"
+        }
+      }
+    },
+    "TracingEndpoints": {
+      "description": "Endpoints the tracing of an API is restricted to for a component, an endpoint without method matches all the methods",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "endpoints"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "description": "Name of the module the API is traced for",
+          "type": "string"
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TracingEndpoint"
+          }
+        }
+      }
+    },
+    "TracingSession": {
+      "description": "Tracing of an API enabled for a component, disabled automatically once its duration or its events budget has elapsed",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "startedAt",
+        "maxEvents",
+        "eventsCount"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "apiName": {
+          "type": "string"
+        },
+        "component": {
+          "description": "Name of the module the API is traced for",
+          "type": "string"
+        },
+        "eventsCount": {
+          "description": "Number of events dispatched to the component since the session started",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiresAt": {
+          "description": "Time at which the tracing is disabled, not set when the session has no duration",
+          "type": "string",
+          "format": "date-time"
+        },
+        "maxEvents": {
+          "description": "Number of events after which the tracing is disabled, 0 for no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "remainingEvents": {
+          "description": "Number of events left before the tracing is disabled, not set when the session has no events budget",
+          "type": "integer",
+          "format": "int64"
+        },
+        "remainingSeconds": {
+          "description": "Seconds left before the tracing is disabled, not set when the session has no duration",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "TracingSessionLimits": {
+      "description": "Limits of a tracing session, they restart the session when they are set",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "type": "string"
+        },
+        "durationSeconds": {
+          "description": "Duration of the session, 0 for no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "maxEvents": {
+          "description": "Number of events after which the tracing is disabled, 0 for no limit",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "rawSpec": {
+      "description": "spec in json or yaml format",
+      "type": "object",
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "rawSpec": {
+          "description": "spec in json or yaml format",
+          "type": "string"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "alertIsFilter": {
       "type": "array",
       "items": {
+        "enum": [
+          "ALERT_INFO",
+          "ALERT_WARN",
+          "ALERT_CRITICAL"
+        ],
         "type": "string"
       },
-      "name": "sourceIP[is]",
+      "description": "Alert Kind [ALERT_INFO or ALERT_WARN]",
+      "name": "alert[is]",
       "in": "query"
     },
-    "sourceIPIsNotFilter": {
+    "alertIsType": {
       "type": "array",
       "items": {
         "type": "string"
       },
-      "name": "sourceIP[isNot]",
+      "name": "alertType[is]",
       "in": "query"
     },
-    "specContainsFilter": {
-      "type": "array",
+    "apiEventSortKey": {
+      "enum": [
+        "time",
+        "method",
+        "path",
+        "statusCode",
+        "sourceIP",
+        "destinationIP",
+        "destinationPort",
+        "specDiffType",
+        "hostSpecName",
+        "apiType"
+      ],
+      "type": "string",
+      "description": "Sort key",
+      "name": "sortKey",
+      "in": "query",
+      "required": true
+    },
+    "apiFindingSortKey": {
+      "enum": [
+        "severity",
+        "firstSeen",
+        "lastSeen",
+        "type",
+        "moduleName",
+        "apiInfoId"
+      ],
+      "type": "string",
+      "description": "Sort key",
+      "name": "sortKey",
+      "in": "query",
+      "required": true
+    },
+    "apiId": {
+      "type": "integer",
+      "format": "uint32",
+      "name": "apiId",
+      "in": "path",
+      "required": true
+    },
+    "apiIdFilter": {
+      "type": "string",
+      "description": "api id to return",
+      "name": "apiId",
+      "in": "query"
+    },
+    "apiInfoIdIsFilter": {
+      "type": "integer",
+      "format": "uint32",
+      "name": "apiInfoId[is]",
+      "in": "query"
+    },
+    "apiInventorySortKey": {
+      "enum": [
+        "name",
+        "port",
+        "hasReconstructedSpec",
+        "hasProvidedSpec"
+      ],
+      "type": "string",
+      "description": "Sort key",
+      "name": "sortKey",
+      "in": "query",
+      "required": true
+    },
+    "apiNameContainsFilter": {
+      "type": "array",
       "items": {
         "type": "string"
       },
-      "name": "spec[contains]",
+      "name": "name[contains]",
       "in": "query"
     },
-    "specDiffTypeIsFilter": {
+    "apiNameEndsWithFilter": {
+      "type": "string",
+      "name": "name[end]",
+      "in": "query"
+    },
+    "apiNameIsFilter": {
       "type": "array",
       "items": {
-        "enum": [
-          "ZOMBIE_DIFF",
-          "SHADOW_DIFF",
-          "GENERAL_DIFF",
-          "NO_DIFF"
-        ],
         "type": "string"
       },
-      "name": "specDiffType[is]",
+      "name": "name[is]",
       "in": "query"
     },
-    "specEndsWithFilter": {
+    "apiNameIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "name[isNot]",
+      "in": "query"
+    },
+    "apiNameStartsWithFilter": {
       "type": "string",
-      "name": "spec[end]",
+      "name": "name[start]",
       "in": "query"
     },
-    "specIsFilter": {
+    "apiType": {
+      "enum": [
+        "INTERNAL",
+        "EXTERNAL"
+      ],
+      "type": "string",
+      "description": "API type [INTERNAL or EXTERNAL]",
+      "name": "type",
+      "in": "query",
+      "required": true
+    },
+    "destinationIPIsFilter": {
       "type": "array",
       "items": {
         "type": "string"
       },
-      "name": "spec[is]",
+      "name": "destinationIP[is]",
       "in": "query"
     },
-    "specIsNotFilter": {
+    "destinationIPIsNotFilter": {
       "type": "array",
       "items": {
         "type": "string"
       },
-      "name": "spec[isNot]",
+      "name": "destinationIP[isNot]",
       "in": "query"
     },
-    "specStartsWithFilter": {
-      "type": "string",
-      "name": "spec[start]",
+    "destinationPortIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "destinationPort[is]",
       "in": "query"
     },
-    "startTime": {
+    "destinationPortIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "destinationPort[isNot]",
+      "in": "query"
+    },
+    "endTime": {
       "type": "string",
       "format": "date-time",
-      "description": "Start time of the query",
-      "name": "startTime",
+      "description": "End time of the query",
+      "name": "endTime",
       "in": "query",
       "required": true
     },
-    "statusCodeGteFilter": {
-      "type": "string",
-      "description": "greater than or equal",
-      "name": "statusCode[gte]",
+    "findingModuleIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "moduleName[is]",
       "in": "query"
     },
-    "statusCodeIsFilter": {
+    "findingSeverityIsFilter": {
       "type": "array",
       "items": {
+        "enum": [
+          "INFO",
+          "LOW",
+          "MEDIUM",
+          "HIGH",
+          "CRITICAL"
+        ],
         "type": "string"
       },
-      "name": "statusCode[is]",
+      "name": "severity[is]",
       "in": "query"
     },
-    "statusCodeIsNotFilter": {
+    "findingTypeIsFilter": {
       "type": "array",
       "items": {
         "type": "string"
       },
-      "name": "statusCode[isNot]",
+      "name": "type[is]",
       "in": "query"
     },
-    "statusCodeLteFilter": {
+    "findingsEndTime": {
       "type": "string",
-      "description": "less than or equal",
-      "name": "statusCode[lte]",
+      "format": "date-time",
+      "description": "Only the findings seen until this time are returned",
+      "name": "endTime",
       "in": "query"
     },
-    "traceSourceId": {
+    "findingsStartTime": {
       "type": "string",
-      "format": "uuid",
-      "description": "Trace Source ID",
-      "name": "traceSourceId",
-      "in": "path",
-      "required": true
+      "format": "date-time",
+      "description": "Only the findings seen since this time are returned",
+      "name": "startTime",
+      "in": "query"
     },
-    "traceSourceIdQuery": {
+    "graphqlOperationNameIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "graphqlOperationName[is]",
+      "in": "query"
+    },
+    "graphqlOperationTypeIsFilter": {
+      "type": "array",
+      "items": {
+        "enum": [
+          "QUERY",
+          "MUTATION",
+          "SUBSCRIPTION"
+        ],
+        "type": "string"
+      },
+      "name": "graphqlOperationType[is]",
+      "in": "query"
+    },
+    "graphqlRootFieldIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "description": "Events selecting any of the root fields",
+      "name": "graphqlRootField[is]",
+      "in": "query"
+    },
+    "grpcMethodIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "grpcMethod[is]",
+      "in": "query"
+    },
+    "grpcServiceIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "grpcService[is]",
+      "in": "query"
+    },
+    "hasProvidedSpecFilter": {
+      "type": "boolean",
+      "name": "hasProvidedSpec[is]",
+      "in": "query"
+    },
+    "hasReconstructedSpecFilter": {
+      "type": "boolean",
+      "name": "hasReconstructedSpec[is]",
+      "in": "query"
+    },
+    "hasSpecDiffFilter": {
+      "type": "boolean",
+      "name": "hasSpecDiff[is]",
+      "in": "query"
+    },
+    "host": {
       "type": "string",
-      "format": "uuid",
-      "description": "Trace Source ID",
-      "name": "traceSourceId",
+      "description": "api host name",
+      "name": "host",
       "in": "query",
       "required": true
-    }
-  },
-  "responses": {
-    "Success": {
-      "description": "success message",
-      "schema": {
-        "$ref": "#/definitions/SuccessResponse"
-      }
     },
-    "UnknownError": {
-      "description": "unknown error",
-      "schema": {
-        "$ref": "#/definitions/ApiResponse"
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "APIClarity APIs",
-    "version": "1.0.0"
-  },
-  "basePath": "/api",
-  "paths": {
-    "/apiEvents": {
-      "get": {
-        "summary": "Get API events",
+    "methodIsFilter": {
+      "type": "array",
+      "items": {
+        "enum": [
+          "GET",
+          "HEAD",
+          "POST",
+          "PUT",
+          "DELETE",
+          "CONNECT",
+          "OPTIONS",
+          "TRACE",
+          "PATCH"
+        ],
+        "type": "string"
+      },
+      "name": "method[is]",
+      "in": "query"
+    },
+    "page": {
+      "type": "integer",
+      "description": "Page number of the query",
+      "name": "page",
+      "in": "query",
+      "required": true
+    },
+    "pageSize": {
+      "maximum": 50,
+      "minimum": 1,
+      "type": "integer",
+      "description": "Maximum items to return",
+      "name": "pageSize",
+      "in": "query",
+      "required": true
+    },
+    "pathContainsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "path[contains]",
+      "in": "query"
+    },
+    "pathEndsWithFilter": {
+      "type": "string",
+      "name": "path[end]",
+      "in": "query"
+    },
+    "pathIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "path[is]",
+      "in": "query"
+    },
+    "pathIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "path[isNot]",
+      "in": "query"
+    },
+    "pathStartsWithFilter": {
+      "type": "string",
+      "name": "path[start]",
+      "in": "query"
+    },
+    "port": {
+      "type": "string",
+      "description": "api port",
+      "name": "port",
+      "in": "query",
+      "required": true
+    },
+    "portIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "port[is]",
+      "in": "query"
+    },
+    "portIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "port[isNot]",
+      "in": "query"
+    },
+    "providedPathIDIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "providedPathID[is]",
+      "in": "query"
+    },
+    "reconstructedPathIDIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "reconstructedPathID[is]",
+      "in": "query"
+    },
+    "replayId": {
+      "type": "integer",
+      "format": "uint32",
+      "name": "replayId",
+      "in": "path",
+      "required": true
+    },
+    "reviewId": {
+      "type": "integer",
+      "format": "uint32",
+      "name": "reviewId",
+      "in": "path",
+      "required": true
+    },
+    "showNonApi": {
+      "type": "boolean",
+      "name": "showNonApi",
+      "in": "query",
+      "required": true
+    },
+    "sortDir": {
+      "enum": [
+        "ASC",
+        "DESC"
+      ],
+      "type": "string",
+      "default": "ASC",
+      "description": "Sorting direction",
+      "name": "sortDir",
+      "in": "query"
+    },
+    "sourceIPIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "sourceIP[is]",
+      "in": "query"
+    },
+    "sourceIPIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "sourceIP[isNot]",
+      "in": "query"
+    },
+    "specContainsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "spec[contains]",
+      "in": "query"
+    },
+    "specDiffTypeIsFilter": {
+      "type": "array",
+      "items": {
+        "enum": [
+          "ZOMBIE_DIFF",
+          "SHADOW_DIFF",
+          "GENERAL_DIFF",
+          "NO_DIFF"
+        ],
+        "type": "string"
+      },
+      "name": "specDiffType[is]",
+      "in": "query"
+    },
+    "specEndsWithFilter": {
+      "type": "string",
+      "name": "spec[end]",
+      "in": "query"
+    },
+    "specIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "spec[is]",
+      "in": "query"
+    },
+    "specIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "spec[isNot]",
+      "in": "query"
+    },
+    "specLocationIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "description": "Location of the finding in the provided or reconstructed spec",
+      "name": "specLocation[is]",
+      "in": "query"
+    },
+    "specLocationStartsWithFilter": {
+      "type": "string",
+      "description": "Prefix of the location of the finding in the provided or reconstructed spec",
+      "name": "specLocation[start]",
+      "in": "query"
+    },
+    "specStartsWithFilter": {
+      "type": "string",
+      "name": "spec[start]",
+      "in": "query"
+    },
+    "startTime": {
+      "type": "string",
+      "format": "date-time",
+      "description": "Start time of the query",
+      "name": "startTime",
+      "in": "query",
+      "required": true
+    },
+    "statusCodeGteFilter": {
+      "type": "string",
+      "description": "greater than or equal",
+      "name": "statusCode[gte]",
+      "in": "query"
+    },
+    "statusCodeIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "statusCode[is]",
+      "in": "query"
+    },
+    "statusCodeIsNotFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "statusCode[isNot]",
+      "in": "query"
+    },
+    "statusCodeLteFilter": {
+      "type": "string",
+      "description": "less than or equal",
+      "name": "statusCode[lte]",
+      "in": "query"
+    },
+    "traceSourceId": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Trace Source ID",
+      "name": "traceSourceId",
+      "in": "path",
+      "required": true
+    },
+    "traceSourceIdQuery": {
+      "type": "string",
+      "format": "uuid",
+      "description": "Trace Source ID",
+      "name": "traceSourceId",
+      "in": "query",
+      "required": true
+    }
+  },
+  "responses": {
+    "Success": {
+      "description": "success message",
+      "schema": {
+        "$ref": "#/definitions/SuccessResponse"
+      }
+    },
+    "UnknownError": {
+      "description": "unknown error",
+      "schema": {
+        "$ref": "#/definitions/ApiResponse"
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "APIClarity APIs",
+    "version": "1.0.0"
+  },
+  "basePath": "/api",
+  "paths": {
+    "/apiEvents": {
+      "get": {
+        "summary": "Get API events",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start time of the query",
+            "name": "startTime",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End time of the query",
+            "name": "endTime",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Page number of the query",
+            "name": "page",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "description": "Maximum items to return",
+            "name": "pageSize",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "time",
+              "method",
+              "path",
+              "statusCode",
+              "sourceIP",
+              "destinationIP",
+              "destinationPort",
+              "specDiffType",
+              "hostSpecName",
+              "apiType"
+            ],
+            "type": "string",
+            "description": "Sort key",
+            "name": "sortKey",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "default": "ASC",
+            "description": "Sorting direction",
+            "name": "sortDir",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "showNonApi",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiInfoId[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "GET",
+                "HEAD",
+                "POST",
+                "PUT",
+                "DELETE",
+                "CONNECT",
+                "OPTIONS",
+                "TRACE",
+                "PATCH"
+              ],
+              "type": "string"
+            },
+            "name": "method[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "path[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "path[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "path[start]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "path[end]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "path[contains]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "statusCode[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "statusCode[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "greater than or equal",
+            "name": "statusCode[gte]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "less than or equal",
+            "name": "statusCode[lte]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "sourceIP[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "sourceIP[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "destinationIP[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "destinationIP[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "destinationPort[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "destinationPort[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "hasSpecDiff[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "ZOMBIE_DIFF",
+                "SHADOW_DIFF",
+                "GENERAL_DIFF",
+                "NO_DIFF"
+              ],
+              "type": "string"
+            },
+            "name": "specDiffType[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "spec[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "spec[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "spec[start]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "spec[end]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "spec[contains]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "ALERT_INFO",
+                "ALERT_WARN",
+                "ALERT_CRITICAL"
+              ],
+              "type": "string"
+            },
+            "description": "Alert Kind [ALERT_INFO or ALERT_WARN]",
+            "name": "alert[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "alertType[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "QUERY",
+                "MUTATION",
+                "SUBSCRIPTION"
+              ],
+              "type": "string"
+            },
+            "name": "graphqlOperationType[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "graphqlOperationName[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Events selecting any of the root fields",
+            "name": "graphqlRootField[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "grpcService[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "grpcMethod[is]",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "object",
+              "required": [
+                "total"
+              ],
+              "properties": {
+                "items": {
+                  "description": "List of API events in the given time range, filters and page. List length must be lower or equal to pageSize",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ApiEvent"
+                  }
+                },
+                "total": {
+                  "description": "Total events count in the given time range and filters",
+                  "type": "integer"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiEvents/{eventId}": {
+      "get": {
+        "summary": "Get API event",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "description": "API event ID",
+            "name": "eventId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiEvent"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiEvents/{eventId}/grpcMessages": {
+      "get": {
+        "summary": "Get the decoded gRPC messages of an API event",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "description": "API event ID",
+            "name": "eventId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/GrpcMessages"
+            }
+          },
+          "404": {
+            "description": "The messages of the event were not decoded",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiEvents/{eventId}/providedSpecDiff": {
+      "get": {
+        "summary": "Get API event provided spec diff",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "description": "API event ID",
+            "name": "eventId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiEventSpecDiff"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiEvents/{eventId}/reconstructedSpecDiff": {
+      "get": {
+        "summary": "Get API event reconstructed spec diff",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "description": "API event ID",
+            "name": "eventId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiEventSpecDiff"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiFindings": {
+      "get": {
+        "description": "The findings notified by the modules, each notification of a module replaces its findings on the API",
+        "summary": "Get the API findings of all the modules",
+        "parameters": [
+          {
+            "type": "integer",
+            "description": "Page number of the query",
+            "name": "page",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "description": "Maximum items to return",
+            "name": "pageSize",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "severity",
+              "firstSeen",
+              "lastSeen",
+              "type",
+              "moduleName",
+              "apiInfoId"
+            ],
+            "type": "string",
+            "description": "Sort key",
+            "name": "sortKey",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "default": "ASC",
+            "description": "Sorting direction",
+            "name": "sortDir",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiInfoId[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "INFO",
+                "LOW",
+                "MEDIUM",
+                "HIGH",
+                "CRITICAL"
+              ],
+              "type": "string"
+            },
+            "name": "severity[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "moduleName[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "type[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Location of the finding in the provided or reconstructed spec",
+            "name": "specLocation[is]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Prefix of the location of the finding in the provided or reconstructed spec",
+            "name": "specLocation[start]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the findings seen since this time are returned",
+            "name": "startTime",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Only the findings seen until this time are returned",
+            "name": "endTime",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "object",
+              "required": [
+                "total"
+              ],
+              "properties": {
+                "items": {
+                  "description": "List of filtered findings in the given page. List length must be lower or equal to pageSize",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ApiFinding"
+                  }
+                },
+                "total": {
+                  "description": "Total filtered findings count",
+                  "type": "integer"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory": {
+      "get": {
+        "summary": "Get API inventory",
+        "parameters": [
+          {
+            "enum": [
+              "INTERNAL",
+              "EXTERNAL"
+            ],
+            "type": "string",
+            "description": "API type [INTERNAL or EXTERNAL]",
+            "name": "type",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "description": "Page number of the query",
+            "name": "page",
+            "in": "query",
+            "required": true
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "description": "Maximum items to return",
+            "name": "pageSize",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "name",
+              "port",
+              "hasReconstructedSpec",
+              "hasProvidedSpec"
+            ],
+            "type": "string",
+            "description": "Sort key",
+            "name": "sortKey",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "default": "ASC",
+            "description": "Sorting direction",
+            "name": "sortDir",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "name[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "name[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "name[start]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "name[end]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "name[contains]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "port[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "port[isNot]",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "hasProvidedSpec[is]",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "hasReconstructedSpec[is]",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "api id to return",
+            "name": "apiId",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "object",
+              "required": [
+                "total"
+              ],
+              "properties": {
+                "items": {
+                  "description": "List of filtered APIs in the given page. List length must be lower or equal to pageSize",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ApiInfo"
+                  }
+                },
+                "total": {
+                  "description": "Total filtered APIs count",
+                  "type": "integer"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Create API inventory item",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/ApiInfoWithType"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiInfo"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/apiId/fromHostAndPort": {
+      "get": {
+        "summary": "Get apiId from host and port",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "api host name",
+            "name": "host",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "api port",
+            "name": "port",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "api id",
+              "type": "integer",
+              "format": "uint32"
+            }
+          },
+          "404": {
+            "description": "API ID Not Found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/apiId/fromHostAndPortAndTraceSourceID": {
+      "get": {
+        "summary": "Get apiId from host and port and Trace Source ID",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "api host name",
+            "name": "host",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "api port",
+            "name": "port",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "api id",
+              "type": "integer",
+              "format": "uint32"
+            }
+          },
+          "404": {
+            "description": "API ID Not Found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/apiInfo": {
+      "get": {
+        "summary": "Get api info from apiId",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/ApiInfoWithType"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/classificationOverride": {
+      "put": {
+        "summary": "Force the classification of the next traffic of an API as API (learned and analyzed) or non-API",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ApiClassificationOverride"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Classify the next traffic of an API by the media type of its responses again",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/graphql/operations": {
+      "get": {
+        "summary": "Get the usage of the GraphQL operations of an API within a selected timeframe",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start time of the query",
+            "name": "startTime",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End time of the query",
+            "name": "endTime",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GraphQLOperationUsage"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/graphql/schema": {
+      "get": {
+        "summary": "Get the GraphQL schema reconstructed from the traffic of an API",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/GraphQLSchema"
+            }
+          },
+          "404": {
+            "description": "No GraphQL traffic for this API",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/grpc/descriptorSet": {
+      "put": {
+        "summary": "Set the protobuf descriptor set of a gRPC API, used to decode the messages of its next events",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/GrpcDescriptorSet"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/GrpcServices"
+            }
+          },
+          "400": {
+            "description": "Invalid descriptor set",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Unset the protobuf descriptor set of a gRPC API",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/grpc/methods": {
+      "get": {
+        "description": "The methods of the uploaded descriptor set that were not called are included with a zero count",
+        "summary": "Get the gRPC methods of an API and their usage within a selected timeframe",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start time of the query",
+            "name": "startTime",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End time of the query",
+            "name": "endTime",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/GrpcMethodUsage"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/provided_swagger.json": {
+      "get": {
+        "summary": "Get provided API spec json file",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "spec in json format",
+              "type": "object"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/reconstructed_swagger.json": {
+      "get": {
+        "summary": "Get reconstructed API spec json file",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "spec in json format",
+              "type": "object"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/specs": {
+      "get": {
+        "summary": "Get provided and reconstructed open api specs for a specific API",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/OpenApiSpecs"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/specs/providedSpec": {
+      "put": {
+        "summary": "Add or edit a spec for a specific API",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "Json or Yaml representing openapi spec V2",
+              "$ref": "#/definitions/rawSpec"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/rawSpec"
+            }
+          },
+          "400": {
+            "description": "Spec validation failure",
+            "schema": {
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Unset a provided spec for a specific API",
         "parameters": [
           {
-            "type": "string",
-            "format": "date-time",
-            "description": "Start time of the query",
-            "name": "startTime",
-            "in": "query",
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
           },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/specs/reconstructedSpec": {
+      "delete": {
+        "summary": "Unset a reconstructed spec for a specific API",
+        "parameters": [
           {
-            "type": "string",
-            "format": "date-time",
-            "description": "End time of the query",
-            "name": "endTime",
-            "in": "query",
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
           },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{apiId}/suggestedReview": {
+      "get": {
+        "summary": "Get reconstructed spec for review",
+        "parameters": [
           {
             "type": "integer",
-            "description": "Page number of the query",
-            "name": "page",
-            "in": "query",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/SuggestedReview"
+            }
           },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiInventory/{reviewId}/approvedReview": {
+      "post": {
+        "summary": "Apply the approved review to create the reconstructed spec",
+        "parameters": [
           {
-            "maximum": 50,
-            "minimum": 1,
             "type": "integer",
-            "description": "Maximum items to return",
-            "name": "pageSize",
-            "in": "query",
+            "format": "uint32",
+            "name": "reviewId",
+            "in": "path",
             "required": true
           },
           {
-            "enum": [
-              "time",
-              "method",
-              "path",
-              "statusCode",
-              "sourceIP",
-              "destinationIP",
-              "destinationPort",
-              "specDiffType",
-              "hostSpecName",
-              "apiType"
-            ],
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "Approved review",
+              "$ref": "#/definitions/ApprovedReview"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/apiUsage/hitCount": {
+      "get": {
+        "summary": "Get a hit count within a selected timeframe for the filtered API events",
+        "parameters": [
+          {
             "type": "string",
-            "description": "Sort key",
-            "name": "sortKey",
+            "format": "date-time",
+            "description": "Start time of the query",
+            "name": "startTime",
             "in": "query",
             "required": true
           },
           {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
             "type": "string",
-            "default": "ASC",
-            "description": "Sorting direction",
-            "name": "sortDir",
-            "in": "query"
+            "format": "date-time",
+            "description": "End time of the query",
+            "name": "endTime",
+            "in": "query",
+            "required": true
           },
           {
             "type": "boolean",
@@ -2067,12 +5220,6 @@ func init() {
             "in": "query",
             "required": true
           },
-          {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiInfoId[is]",
-            "in": "query"
-          },
           {
             "type": "array",
             "items": {
@@ -2092,6 +5239,22 @@ func init() {
             "name": "method[is]",
             "in": "query"
           },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "providedPathID[is]",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "name": "reconstructedPathID[is]",
+            "in": "query"
+          },
           {
             "type": "array",
             "items": {
@@ -2254,50 +5417,87 @@ func init() {
             },
             "name": "spec[contains]",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/HitCount"
+              }
+            }
           },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/control/harImport": {
+      "post": {
+        "description": "Each entry of the HTTP Archive is handled like a trace received from a trace source, populating the API inventory, the reconstructed specs and the modules findings.",
+        "summary": "Import the traffic of a HAR archive",
+        "parameters": [
           {
-            "type": "array",
-            "items": {
-              "enum": [
-                "ALERT_INFO",
-                "ALERT_WARN",
-                "ALERT_CRITICAL"
-              ],
-              "type": "string"
-            },
-            "description": "Alert Kind [ALERT_INFO or ALERT_WARN]",
-            "name": "alert[is]",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source under which the traffic is imported. If not set, the traffic is imported under the default trace source of APIClarity",
+            "name": "traceSourceId",
             "in": "query"
           },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "alertType[is]",
-            "in": "query"
+          {
+            "description": "HAR 1.2 archive",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Import result",
+            "schema": {
+              "$ref": "#/definitions/HarImportResult"
+            }
+          },
+          "400": {
+            "description": "Invalid HAR archive",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
           }
-        ],
+        }
+      }
+    },
+    "/control/modules": {
+      "get": {
+        "summary": "Get the list of modules with their status",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "type": "object",
-              "required": [
-                "total"
-              ],
-              "properties": {
-                "items": {
-                  "description": "List of API events in the given time range, filters and page. List length must be lower or equal to pageSize",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/ApiEvent"
-                  }
-                },
-                "total": {
-                  "description": "Total events count in the given time range and filters",
-                  "type": "integer"
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/ModuleStatus"
               }
             }
           },
@@ -2310,24 +5510,36 @@ func init() {
         }
       }
     },
-    "/apiEvents/{eventId}": {
-      "get": {
-        "summary": "Get API event",
+    "/control/modules/{moduleName}": {
+      "put": {
+        "summary": "Enable or disable a module",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "description": "API event ID",
-            "name": "eventId",
+            "type": "string",
+            "name": "moduleName",
             "in": "path",
             "required": true
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/ModuleState"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiEvent"
+              "$ref": "#/definitions/ModuleStatus"
+            }
+          },
+          "404": {
+            "description": "Module not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2339,24 +5551,41 @@ func init() {
         }
       }
     },
-    "/apiEvents/{eventId}/providedSpecDiff": {
-      "get": {
-        "summary": "Get API event provided spec diff",
+    "/control/newDiscoveredAPIs": {
+      "post": {
+        "description": "This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.",
+        "summary": "Allows a client to notify APIClarity about new APIs.",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "description": "API event ID",
-            "name": "eventId",
-            "in": "path",
-            "required": true
+            "description": "List of new discovered APIs",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "hosts"
+              ],
+              "properties": {
+                "hosts": {
+                  "description": "List of discovered APIs, format of hostname:port",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiEventSpecDiff"
+              "description": "success message",
+              "schema": {
+                "$ref": "#/definitions/SuccessResponse"
+              }
             }
           },
           "default": {
@@ -2368,24 +5597,48 @@ func init() {
         }
       }
     },
-    "/apiEvents/{eventId}/reconstructedSpecDiff": {
+    "/control/redactionPolicy": {
       "get": {
-        "summary": "Get API event reconstructed spec diff",
+        "description": "The default policy applies to the trace sources without their own policy",
+        "summary": "Get the default redaction policy",
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      },
+      "put": {
+        "summary": "Set the default redaction policy",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "description": "API event ID",
-            "name": "eventId",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RedactionPolicy"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiEventSpecDiff"
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "400": {
+            "description": "Invalid redaction policy",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2397,148 +5650,16 @@ func init() {
         }
       }
     },
-    "/apiInventory": {
-      "get": {
-        "summary": "Get API inventory",
-        "parameters": [
-          {
-            "enum": [
-              "INTERNAL",
-              "EXTERNAL"
-            ],
-            "type": "string",
-            "description": "API type [INTERNAL or EXTERNAL]",
-            "name": "type",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "description": "Page number of the query",
-            "name": "page",
-            "in": "query",
-            "required": true
-          },
-          {
-            "maximum": 50,
-            "minimum": 1,
-            "type": "integer",
-            "description": "Maximum items to return",
-            "name": "pageSize",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "name",
-              "port",
-              "hasReconstructedSpec",
-              "hasProvidedSpec"
-            ],
-            "type": "string",
-            "description": "Sort key",
-            "name": "sortKey",
-            "in": "query",
-            "required": true
-          },
-          {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
-            "type": "string",
-            "default": "ASC",
-            "description": "Sorting direction",
-            "name": "sortDir",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "name[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "name[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "name[start]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "name[end]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "name[contains]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "port[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "port[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "hasProvidedSpec[is]",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "hasReconstructedSpec[is]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "api id to return",
-            "name": "apiId",
-            "in": "query"
-          }
-        ],
+    "/control/replays": {
+      "get": {
+        "summary": "Get the replays of stored events through modules",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "type": "object",
-              "required": [
-                "total"
-              ],
-              "properties": {
-                "items": {
-                  "description": "List of filtered APIs in the given page. List length must be lower or equal to pageSize",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/ApiInfo"
-                  }
-                },
-                "total": {
-                  "description": "Total filtered APIs count",
-                  "type": "integer"
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Replay"
               }
             }
           },
@@ -2551,21 +5672,34 @@ func init() {
         }
       },
       "post": {
-        "summary": "Create API inventory item",
+        "summary": "Replay the stored events of an API through a module",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/ApiInfoWithType"
+              "$ref": "#/definitions/ReplayRequest"
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "201": {
+            "description": "Replay started",
             "schema": {
-              "$ref": "#/definitions/ApiInfo"
+              "$ref": "#/definitions/Replay"
+            }
+          },
+          "400": {
+            "description": "Invalid replay",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Module not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2577,22 +5711,15 @@ func init() {
         }
       }
     },
-    "/apiInventory/apiId/fromHostAndPort": {
+    "/control/replays/{replayId}": {
       "get": {
-        "summary": "Get apiId from host and port",
+        "summary": "Get the progress of a replay",
         "parameters": [
           {
-            "type": "string",
-            "description": "api host name",
-            "name": "host",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "api port",
-            "name": "port",
-            "in": "query",
+            "type": "integer",
+            "format": "uint32",
+            "name": "replayId",
+            "in": "path",
             "required": true
           }
         ],
@@ -2600,13 +5727,11 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "api id",
-              "type": "integer",
-              "format": "uint32"
+              "$ref": "#/definitions/Replay"
             }
           },
           "404": {
-            "description": "API ID Not Found",
+            "description": "Replay not found",
             "schema": {
               "$ref": "#/definitions/ApiResponse"
             }
@@ -2618,32 +5743,15 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/apiId/fromHostAndPortAndTraceSourceID": {
-      "get": {
-        "summary": "Get apiId from host and port and Trace Source ID",
+      },
+      "delete": {
+        "summary": "Cancel a replay",
         "parameters": [
           {
-            "type": "string",
-            "description": "api host name",
-            "name": "host",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "description": "api port",
-            "name": "port",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "uuid",
-            "description": "Trace Source ID",
-            "name": "traceSourceId",
-            "in": "query",
+            "type": "integer",
+            "format": "uint32",
+            "name": "replayId",
+            "in": "path",
             "required": true
           }
         ],
@@ -2651,13 +5759,11 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "api id",
-              "type": "integer",
-              "format": "uint32"
+              "$ref": "#/definitions/Replay"
             }
           },
           "404": {
-            "description": "API ID Not Found",
+            "description": "Replay not found",
             "schema": {
               "$ref": "#/definitions/ApiResponse"
             }
@@ -2671,23 +5777,17 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/apiInfo": {
+    "/control/samplingRates": {
       "get": {
-        "summary": "Get api info from apiId",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List the sampling rates of the APIs",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/ApiInfoWithType"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/SamplingRate"
+              }
             }
           },
           "default": {
@@ -2697,26 +5797,30 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/{apiId}/provided_swagger.json": {
-      "get": {
-        "summary": "Get provided API spec json file",
+      },
+      "put": {
+        "summary": "Set the sampling rate of an API for a component",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/SamplingRate"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "spec in json format",
-              "type": "object"
+              "$ref": "#/definitions/SamplingRate"
+            }
+          },
+          "404": {
+            "description": "API not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2726,27 +5830,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/{apiId}/reconstructed_swagger.json": {
-      "get": {
-        "summary": "Get reconstructed API spec json file",
+      },
+      "delete": {
+        "summary": "Delete the sampling rate of an API for a component, all its traces are kept again",
         "parameters": [
           {
             "type": "integer",
             "format": "uint32",
             "name": "apiId",
-            "in": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "component",
+            "in": "query",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
-            "schema": {
-              "description": "spec in json format",
-              "type": "object"
-            }
+          "204": {
+            "description": "Success"
           },
           "default": {
             "description": "unknown error",
@@ -2757,23 +5861,26 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/specs": {
+    "/control/traceSources": {
       "get": {
-        "summary": "Get provided and reconstructed open api specs for a specific API",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
-            "in": "path",
-            "required": true
-          }
-        ],
+        "summary": "List of configured trace sources",
         "responses": {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/OpenApiSpecs"
+              "type": "object",
+              "required": [
+                "trace_sources"
+              ],
+              "properties": {
+                "trace_sources": {
+                  "description": "List of trace sources",
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/TraceSource"
+                  }
+                }
+              }
             }
           },
           "default": {
@@ -2783,26 +5890,17 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/{apiId}/specs/providedSpec": {
-      "put": {
-        "summary": "Add or edit a spec for a specific API",
+      },
+      "post": {
+        "summary": "Create a new Trace Source",
         "parameters": [
-          {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
-            "in": "path",
-            "required": true
-          },
           {
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "description": "Json or Yaml representing openapi spec V2",
-              "$ref": "#/definitions/rawSpec"
+              "description": "Create a new Trace Source",
+              "$ref": "#/definitions/TraceSource"
             }
           }
         ],
@@ -2810,13 +5908,7 @@ func init() {
           "201": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/rawSpec"
-            }
-          },
-          "400": {
-            "description": "Spec validation failure",
-            "schema": {
-              "type": "string"
+              "$ref": "#/definitions/TraceSource"
             }
           },
           "default": {
@@ -2826,26 +5918,32 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
-        "summary": "Unset a provided spec for a specific API",
+      }
+    },
+    "/control/traceSources/{traceSourceId}": {
+      "get": {
+        "summary": "Get Trace Source information",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "Success",
+            "description": "Trace Source information",
             "schema": {
-              "description": "success message",
-              "schema": {
-                "$ref": "#/definitions/SuccessResponse"
-              }
+              "$ref": "#/definitions/TraceSource"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2855,28 +5953,27 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/{apiId}/specs/reconstructedSpec": {
+      },
       "delete": {
-        "summary": "Unset a reconstructed spec for a specific API",
+        "summary": "Delete a Trace Source",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
-          "200": {
-            "description": "Success",
+          "204": {
+            "description": "Success"
+          },
+          "404": {
+            "description": "Trace Source not found",
             "schema": {
-              "description": "success message",
-              "schema": {
-                "$ref": "#/definitions/SuccessResponse"
-              }
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2888,14 +5985,15 @@ func init() {
         }
       }
     },
-    "/apiInventory/{apiId}/suggestedReview": {
+    "/control/traceSources/{traceSourceId}/redactionPolicy": {
       "get": {
-        "summary": "Get reconstructed spec for review",
+        "summary": "Get the redaction policy of a Trace Source",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "name": "apiId",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
             "in": "path",
             "required": true
           }
@@ -2904,7 +6002,13 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/SuggestedReview"
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found or without its own redaction policy",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2914,16 +6018,15 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiInventory/{reviewId}/approvedReview": {
-      "post": {
-        "summary": "Apply the approved review to create the reconstructed spec",
+      },
+      "put": {
+        "summary": "Set the redaction policy of a Trace Source",
         "parameters": [
           {
-            "type": "integer",
-            "format": "uint32",
-            "name": "reviewId",
+            "type": "string",
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
             "in": "path",
             "required": true
           },
@@ -2932,8 +6035,7 @@ func init() {
             "in": "body",
             "required": true,
             "schema": {
-              "description": "Approved review",
-              "$ref": "#/definitions/ApprovedReview"
+              "$ref": "#/definitions/RedactionPolicy"
             }
           }
         ],
@@ -2941,10 +6043,19 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "success message",
-              "schema": {
-                "$ref": "#/definitions/SuccessResponse"
-              }
+              "$ref": "#/definitions/RedactionPolicy"
+            }
+          },
+          "400": {
+            "description": "Invalid redaction policy",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          },
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -2954,230 +6065,46 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/apiUsage/hitCount": {
-      "get": {
-        "summary": "Get a hit count within a selected timeframe for the filtered API events",
+      },
+      "delete": {
+        "summary": "Delete the redaction policy of a Trace Source, the default policy applies again",
         "parameters": [
           {
             "type": "string",
-            "format": "date-time",
-            "description": "Start time of the query",
-            "name": "startTime",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "string",
-            "format": "date-time",
-            "description": "End time of the query",
-            "name": "endTime",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "boolean",
-            "name": "showNonApi",
-            "in": "query",
-            "required": true
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "GET",
-                "HEAD",
-                "POST",
-                "PUT",
-                "DELETE",
-                "CONNECT",
-                "OPTIONS",
-                "TRACE",
-                "PATCH"
-              ],
-              "type": "string"
-            },
-            "name": "method[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "providedPathID[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "reconstructedPathID[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "path[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "path[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "path[start]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "path[end]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "path[contains]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "statusCode[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "statusCode[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "greater than or equal",
-            "name": "statusCode[gte]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "less than or equal",
-            "name": "statusCode[lte]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "sourceIP[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "sourceIP[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "destinationIP[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "destinationIP[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "destinationPort[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "destinationPort[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "name": "hasSpecDiff[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "enum": [
-                "ZOMBIE_DIFF",
-                "SHADOW_DIFF",
-                "GENERAL_DIFF",
-                "NO_DIFF"
-              ],
-              "type": "string"
-            },
-            "name": "specDiffType[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "spec[is]",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "spec[isNot]",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "name": "spec[start]",
-            "in": "query"
+            "format": "uuid",
+            "description": "Trace Source ID",
+            "name": "traceSourceId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
           },
-          {
-            "type": "string",
-            "name": "spec[end]",
-            "in": "query"
+          "404": {
+            "description": "Trace Source not found",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
           },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/control/tracingEndpoints": {
+      "get": {
+        "summary": "List the endpoints the tracing of the APIs is restricted to",
+        "parameters": [
           {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "name": "spec[contains]",
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
             "in": "query"
           }
         ],
@@ -3187,7 +6114,7 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/HitCount"
+                "$ref": "#/definitions/TracingEndpoints"
               }
             }
           },
@@ -3198,32 +6125,16 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/control/newDiscoveredAPIs": {
-      "post": {
-        "description": "This allows a client (a gateway for example) to notify APIclarity about newly discovered APIs. If one of the APIs already exists, it is ignored.",
-        "summary": "Allows a client to notify APIClarity about new APIs.",
+      },
+      "put": {
+        "summary": "Restrict the tracing of an API for a component to endpoints of its spec",
         "parameters": [
           {
-            "description": "List of new discovered APIs",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "hosts"
-              ],
-              "properties": {
-                "hosts": {
-                  "description": "List of discovered APIs, format of hostname:port",
-                  "type": "array",
-                  "items": {
-                    "type": "string"
-                  }
-                }
-              }
+              "$ref": "#/definitions/TracingEndpoints"
             }
           }
         ],
@@ -3231,41 +6142,19 @@ func init() {
           "200": {
             "description": "Success",
             "schema": {
-              "description": "success message",
-              "schema": {
-                "$ref": "#/definitions/SuccessResponse"
-              }
+              "$ref": "#/definitions/TracingEndpoints"
             }
           },
-          "default": {
-            "description": "unknown error",
+          "400": {
+            "description": "Endpoint not found in the spec of the API",
             "schema": {
               "$ref": "#/definitions/ApiResponse"
             }
-          }
-        }
-      }
-    },
-    "/control/traceSources": {
-      "get": {
-        "summary": "List of configured trace sources",
-        "responses": {
-          "200": {
-            "description": "Success",
+          },
+          "404": {
+            "description": "API not found",
             "schema": {
-              "type": "object",
-              "required": [
-                "trace_sources"
-              ],
-              "properties": {
-                "trace_sources": {
-                  "description": "List of trace sources",
-                  "type": "array",
-                  "items": {
-                    "$ref": "#/definitions/TraceSource"
-                  }
-                }
-              }
+              "$ref": "#/definitions/ApiResponse"
             }
           },
           "default": {
@@ -3276,24 +6165,55 @@ func init() {
           }
         }
       },
-      "post": {
-        "summary": "Create a new Trace Source",
+      "delete": {
+        "summary": "Remove the restriction of the tracing of an API for a component, all its endpoints are traced again",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "component",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Success"
+          },
+          "default": {
+            "description": "unknown error",
             "schema": {
-              "description": "Create a new Trace Source",
-              "$ref": "#/definitions/TraceSource"
+              "$ref": "#/definitions/ApiResponse"
             }
           }
+        }
+      }
+    },
+    "/control/tracingSessions": {
+      "get": {
+        "summary": "List the active tracing sessions of the modules",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query"
+          }
         ],
         "responses": {
-          "201": {
+          "200": {
             "description": "Success",
             "schema": {
-              "$ref": "#/definitions/TraceSource"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/TracingSession"
+              }
             }
           },
           "default": {
@@ -3303,30 +6223,28 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/control/traceSources/{traceSourceId}": {
-      "get": {
-        "summary": "Get Trace Source information",
+      },
+      "put": {
+        "summary": "Set the limits of an active tracing session and restart it",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "description": "Trace Source ID",
-            "name": "traceSourceId",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/TracingSessionLimits"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Trace Source information",
+            "description": "Success",
             "schema": {
-              "$ref": "#/definitions/TraceSource"
+              "$ref": "#/definitions/TracingSession"
             }
           },
           "404": {
-            "description": "Trace Source not found",
+            "description": "Tracing session not found",
             "schema": {
               "$ref": "#/definitions/ApiResponse"
             }
@@ -3340,14 +6258,19 @@ func init() {
         }
       },
       "delete": {
-        "summary": "Delete a Trace Source",
+        "summary": "Stop a tracing session",
         "parameters": [
+          {
+            "type": "integer",
+            "format": "uint32",
+            "name": "apiId",
+            "in": "query",
+            "required": true
+          },
           {
             "type": "string",
-            "format": "uuid",
-            "description": "Trace Source ID",
-            "name": "traceSourceId",
-            "in": "path",
+            "name": "component",
+            "in": "query",
             "required": true
           }
         ],
@@ -3355,12 +6278,6 @@ func init() {
           "204": {
             "description": "Success"
           },
-          "404": {
-            "description": "Trace Source not found",
-            "schema": {
-              "$ref": "#/definitions/ApiResponse"
-            }
-          },
           "default": {
             "description": "unknown error",
             "schema": {
@@ -3494,7 +6411,8 @@ func init() {
         "traceanalyzer",
         "bfla",
         "spec_differ",
-        "fuzzer"
+        "fuzzer",
+        "customrules"
       ]
     },
     "APIClarityFeatureList": {
@@ -3518,6 +6436,24 @@ func init() {
         "ALERT_CRITICAL"
       ]
     },
+    "ApiClassification": {
+      "type": "string",
+      "enum": [
+        "API",
+        "NON_API"
+      ]
+    },
+    "ApiClassificationOverride": {
+      "type": "object",
+      "required": [
+        "classification"
+      ],
+      "properties": {
+        "classification": {
+          "$ref": "#/definitions/ApiClassification"
+        }
+      }
+    },
     "ApiCount": {
       "type": "object",
       "properties": {
@@ -3563,6 +6499,12 @@ func init() {
         "destinationPort": {
           "type": "integer"
         },
+        "graphqlOperation": {
+          "$ref": "#/definitions/GraphQLOperation"
+        },
+        "grpcCall": {
+          "$ref": "#/definitions/GrpcCall"
+        },
         "hasProvidedSpecDiff": {
           "type": "boolean",
           "default": false
@@ -3649,18 +6591,133 @@ func init() {
           "description": "New spec json string",
           "type": "string"
         },
-        "oldSpec": {
-          "description": "Old spec json string",
-          "type": "string"
+        "oldSpec": {
+          "description": "Old spec json string",
+          "type": "string"
+        }
+      }
+    },
+    "ApiFinding": {
+      "description": "A finding of a module on an API",
+      "type": "object",
+      "required": [
+        "id",
+        "apiInfoId",
+        "moduleName",
+        "type",
+        "name",
+        "severity",
+        "firstSeen",
+        "lastSeen"
+      ],
+      "properties": {
+        "additionalInfo": {
+          "description": "Opaque JSON object of the module",
+          "type": "object"
+        },
+        "apiInfoId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "apiName": {
+          "description": "Name of the API",
+          "type": "string"
+        },
+        "description": {
+          "description": "Human readable description of the finding",
+          "type": "string"
+        },
+        "firstSeen": {
+          "description": "First time the finding was notified",
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "lastSeen": {
+          "description": "Last time the finding was notified",
+          "type": "string",
+          "format": "date-time"
+        },
+        "moduleName": {
+          "description": "Module which notified the finding",
+          "type": "string"
+        },
+        "name": {
+          "description": "Human readable name of the finding",
+          "type": "string"
+        },
+        "providedSpecLocation": {
+          "description": "JSON pointer to the location of the finding in the provided spec",
+          "type": "string"
+        },
+        "reconstructedSpecLocation": {
+          "description": "JSON pointer to the location of the finding in the reconstructed spec",
+          "type": "string"
+        },
+        "severity": {
+          "$ref": "#/definitions/FindingSeverity"
+        },
+        "source": {
+          "description": "Name of the module which created this finding",
+          "type": "string"
+        },
+        "type": {
+          "description": "Type of the finding",
+          "type": "string"
+        }
+      }
+    },
+    "ApiFindingSortKey": {
+      "type": "string",
+      "enum": [
+        "severity",
+        "firstSeen",
+        "lastSeen",
+        "type",
+        "moduleName",
+        "apiInfoId"
+      ]
+    },
+    "ApiFindingsSummary": {
+      "description": "Count of the findings of an API by severity",
+      "type": "object",
+      "properties": {
+        "critical": {
+          "type": "integer"
+        },
+        "high": {
+          "type": "integer"
+        },
+        "info": {
+          "type": "integer"
+        },
+        "low": {
+          "type": "integer"
+        },
+        "medium": {
+          "type": "integer"
+        },
+        "total": {
+          "type": "integer"
         }
       }
     },
     "ApiInfo": {
       "type": "object",
       "properties": {
+        "classificationOverride": {
+          "description": "Classification forced on the traffic of the API, unset when it is classified by the media type of its responses",
+          "$ref": "#/definitions/ApiClassification"
+        },
         "destinationNamespace": {
           "type": "string"
         },
+        "findingsSummary": {
+          "$ref": "#/definitions/ApiFindingsSummary"
+        },
         "hasProvidedSpec": {
           "type": "boolean",
           "default": false
@@ -3684,6 +6741,14 @@ func init() {
           "description": "Trace Source ID which created this API. Null UUID 0 means it has been created by APIClarity (from the UI for example)",
           "type": "string",
           "format": "uuid"
+        },
+        "traceSourceName": {
+          "description": "Trace source name",
+          "type": "string"
+        },
+        "traceSourceType": {
+          "description": "Trace source type",
+          "type": "string"
         }
       }
     },
@@ -3792,6 +6857,229 @@ func init() {
         "NO_DIFF"
       ]
     },
+    "FindingSeverity": {
+      "description": "Severity of a finding",
+      "type": "string",
+      "enum": [
+        "INFO",
+        "LOW",
+        "MEDIUM",
+        "HIGH",
+        "CRITICAL"
+      ]
+    },
+    "GraphQLOperation": {
+      "description": "GraphQL operation executed by an API event",
+      "type": "object",
+      "properties": {
+        "operationName": {
+          "description": "Empty for anonymous operations",
+          "type": "string"
+        },
+        "operationType": {
+          "$ref": "#/definitions/GraphQLOperationType"
+        },
+        "rootFields": {
+          "description": "Sorted names of the selected root fields",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "GraphQLOperationType": {
+      "type": "string",
+      "enum": [
+        "QUERY",
+        "MUTATION",
+        "SUBSCRIPTION"
+      ]
+    },
+    "GraphQLOperationUsage": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSeen": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "operation": {
+          "$ref": "#/definitions/GraphQLOperation"
+        }
+      }
+    },
+    "GraphQLSchema": {
+      "type": "object",
+      "required": [
+        "sdl"
+      ],
+      "properties": {
+        "sdl": {
+          "description": "Reconstructed schema in the GraphQL schema definition language",
+          "type": "string"
+        }
+      }
+    },
+    "GrpcCall": {
+      "description": "gRPC call of an API event",
+      "type": "object",
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "requestMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "requestMessagesSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesSize": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "service": {
+          "description": "Fully qualified service name (package.Service)",
+          "type": "string"
+        },
+        "status": {
+          "description": "gRPC status code",
+          "type": "integer",
+          "format": "int32",
+          "x-omitempty": false
+        },
+        "statusMessage": {
+          "type": "string"
+        }
+      }
+    },
+    "GrpcDescriptorSet": {
+      "type": "object",
+      "required": [
+        "descriptorSet"
+      ],
+      "properties": {
+        "descriptorSet": {
+          "description": "Serialized FileDescriptorSet, with the imports included (protoc --include_imports --descriptor_set_out)",
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "GrpcMessages": {
+      "description": "gRPC messages of an API event decoded with the uploaded descriptor set, in JSON",
+      "type": "object",
+      "properties": {
+        "requestMessages": {
+          "description": "JSON array of the request messages",
+          "type": "string"
+        },
+        "responseMessages": {
+          "description": "JSON array of the response messages",
+          "type": "string"
+        }
+      }
+    },
+    "GrpcMethodUsage": {
+      "type": "object",
+      "properties": {
+        "averageRequestSize": {
+          "description": "Average size in bytes of the request messages of a call",
+          "type": "integer",
+          "format": "int64"
+        },
+        "averageResponseSize": {
+          "description": "Average size in bytes of the response messages of a call",
+          "type": "integer",
+          "format": "int64"
+        },
+        "clientStreaming": {
+          "type": "boolean"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "errorCount": {
+          "description": "Number of calls with a non OK status",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "lastSeen": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "method": {
+          "type": "string"
+        },
+        "requestType": {
+          "description": "Request message type, known if a descriptor set was uploaded",
+          "type": "string"
+        },
+        "responseType": {
+          "description": "Response message type, known if a descriptor set was uploaded",
+          "type": "string"
+        },
+        "serverStreaming": {
+          "type": "boolean"
+        },
+        "service": {
+          "type": "string"
+        }
+      }
+    },
+    "GrpcServices": {
+      "type": "object",
+      "required": [
+        "services"
+      ],
+      "properties": {
+        "services": {
+          "description": "Fully qualified names of the services",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "HarImportResult": {
+      "description": "Result of a HAR archive import",
+      "type": "object",
+      "required": [
+        "imported",
+        "failed"
+      ],
+      "properties": {
+        "errors": {
+          "description": "Errors of the failed entries, the list may be truncated",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "failed": {
+          "description": "Number of entries that could not be converted or handled",
+          "type": "integer"
+        },
+        "imported": {
+          "description": "Number of entries that were handled",
+          "type": "integer"
+        }
+      }
+    },
     "HitCount": {
       "type": "object",
       "properties": {
@@ -3840,71 +7128,239 @@ func init() {
         }
       }
     },
-    "HttpMethod": {
-      "type": "string",
-      "enum": [
-        "GET",
-        "HEAD",
-        "POST",
-        "PUT",
-        "DELETE",
-        "CONNECT",
-        "OPTIONS",
-        "TRACE",
-        "PATCH"
-      ]
-    },
-    "MethodAndPath": {
+    "HttpMethod": {
+      "type": "string",
+      "enum": [
+        "GET",
+        "HEAD",
+        "POST",
+        "PUT",
+        "DELETE",
+        "CONNECT",
+        "OPTIONS",
+        "TRACE",
+        "PATCH"
+      ]
+    },
+    "MethodAndPath": {
+      "type": "object",
+      "properties": {
+        "method": {
+          "$ref": "#/definitions/HttpMethod"
+        },
+        "path": {
+          "type": "string"
+        },
+        "pathId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "ModuleAlert": {
+      "type": "object",
+      "properties": {
+        "alert": {
+          "$ref": "#/definitions/AlertSeverityEnum"
+        },
+        "moduleName": {
+          "description": "Name of the module which created this alert",
+          "type": "string"
+        },
+        "reason": {
+          "description": "Optional description of reason of the alert",
+          "type": "string"
+        }
+      }
+    },
+    "ModuleState": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "ModuleStatus": {
+      "description": "A module of APIClarity and whether it is enabled",
+      "type": "object",
+      "required": [
+        "name",
+        "enabled"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "enabled": {
+          "description": "A disabled module receives no trace and does not serve its API",
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "OASVersion": {
+      "description": "OpenAPI specification version",
+      "type": "string",
+      "enum": [
+        "OASv2.0",
+        "OASv3.0"
+      ]
+    },
+    "OpenApiSpecs": {
+      "description": "An object representing the provided and reconstructed API specs",
+      "type": "object",
+      "properties": {
+        "providedSpec": {
+          "$ref": "#/definitions/SpecInfo"
+        },
+        "reconstructedSpec": {
+          "$ref": "#/definitions/SpecInfo"
+        }
+      }
+    },
+    "RedactionAction": {
+      "description": "MASK replaces the redacted values with [REDACTED], HASH with their HMAC-SHA256 keyed with the redaction hash key of the deployment (hmac-sha256:\u003chex\u003e) so equal values can still be correlated",
+      "type": "string",
+      "enum": [
+        "MASK",
+        "HASH"
+      ]
+    },
+    "RedactionPolicy": {
+      "description": "Redaction applied to the traces before they are stored and dispatched to the modules",
+      "type": "object",
+      "properties": {
+        "action": {
+          "$ref": "#/definitions/RedactionAction"
+        },
+        "bodyFields": {
+          "description": "Body fields to redact, as JSON paths for JSON bodies ($.user.password, $.cards[*].number, $..token) or XML paths for XML bodies (/user/password, //token)",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "headers": {
+          "description": "Names of the request and response headers to redact, case insensitive",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "patterns": {
+          "description": "Regular expressions redacted in the path, query, header values and textual bodies",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "Replay": {
+      "description": "A replay of the stored events of an API through a module, only the events whose telemetry is retained are replayed",
       "type": "object",
+      "required": [
+        "id",
+        "moduleName",
+        "apiId",
+        "startTime",
+        "endTime",
+        "status",
+        "total",
+        "replayed",
+        "skipped",
+        "createdAt"
+      ],
       "properties": {
-        "method": {
-          "$ref": "#/definitions/HttpMethod"
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
         },
-        "path": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "error": {
           "type": "string"
         },
-        "pathId": {
+        "finishedAt": {
           "type": "string",
-          "format": "uuid"
+          "format": "date-time"
+        },
+        "id": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "moduleName": {
+          "type": "string"
+        },
+        "replayed": {
+          "description": "The number of events replayed so far",
+          "type": "integer"
+        },
+        "skipped": {
+          "description": "The number of events which could not be replayed",
+          "type": "integer"
+        },
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "status": {
+          "$ref": "#/definitions/ReplayStatus"
+        },
+        "total": {
+          "description": "The number of events to replay",
+          "type": "integer"
         }
       }
     },
-    "ModuleAlert": {
+    "ReplayRequest": {
+      "description": "The stored events of an API to replay through a module",
       "type": "object",
+      "required": [
+        "moduleName",
+        "apiId",
+        "startTime",
+        "endTime"
+      ],
       "properties": {
-        "alert": {
-          "$ref": "#/definitions/AlertSeverityEnum"
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "endTime": {
+          "type": "string",
+          "format": "date-time"
         },
         "moduleName": {
-          "description": "Name of the module which created this alert",
           "type": "string"
         },
-        "reason": {
-          "description": "Optional description of reason of the alert",
-          "type": "string"
+        "startTime": {
+          "type": "string",
+          "format": "date-time"
         }
       }
     },
-    "OASVersion": {
-      "description": "OpenAPI specification version",
+    "ReplayStatus": {
       "type": "string",
       "enum": [
-        "OASv2.0",
-        "OASv3.0"
+        "RUNNING",
+        "COMPLETED",
+        "CANCELED",
+        "FAILED"
       ]
     },
-    "OpenApiSpecs": {
-      "description": "An object representing the provided and reconstructed API specs",
-      "type": "object",
-      "properties": {
-        "providedSpec": {
-          "$ref": "#/definitions/SpecInfo"
-        },
-        "reconstructedSpec": {
-          "$ref": "#/definitions/SpecInfo"
-        }
-      }
-    },
     "ReviewPathItem": {
       "type": "object",
       "properties": {
@@ -3921,6 +7377,38 @@ func init() {
         }
       }
     },
+    "SamplingRate": {
+      "description": "Sampling of the traces of an API",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "ratio"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "description": "'*' for the traces sent by the trace sources, sampled at the edge by the trace sources supporting it, or the name of a module for the events dispatched to it",
+          "type": "string"
+        },
+        "maxEventsPerSecond": {
+          "description": "Maximum number of traces to keep per second, 0 for no limit",
+          "type": "number",
+          "format": "double",
+          "minimum": 0
+        },
+        "ratio": {
+          "description": "Ratio of the traces to keep",
+          "type": "number",
+          "format": "double",
+          "maximum": 1,
+          "minimum": 0
+        }
+      }
+    },
     "SpecDiffTime": {
       "type": "object",
       "properties": {
@@ -4033,6 +7521,129 @@ func init() {
         "TYK_INTERNAL"
       ]
     },
+    "TracingEndpoint": {
+      "description": "Endpoint of the spec of an API",
+      "type": "object",
+      "required": [
+        "path"
+      ],
+      "properties": {
+        "method": {
+          "$ref": "#/definitions/HttpMethod"
+        },
+        "path": {
+          "description": "Path template of the spec of the API, e.g. /v1/carts/{cartId}/checkout",
+          "type": "string"
+        }
+      }
+    },
+    "TracingEndpoints": {
+      "description": "Endpoints the tracing of an API is restricted to for a component, an endpoint without method matches all the methods",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "endpoints"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "description": "Name of the module the API is traced for",
+          "type": "string"
+        },
+        "endpoints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TracingEndpoint"
+          }
+        }
+      }
+    },
+    "TracingSession": {
+      "description": "Tracing of an API enabled for a component, disabled automatically once its duration or its events budget has elapsed",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component",
+        "startedAt",
+        "maxEvents",
+        "eventsCount"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "apiName": {
+          "type": "string"
+        },
+        "component": {
+          "description": "Name of the module the API is traced for",
+          "type": "string"
+        },
+        "eventsCount": {
+          "description": "Number of events dispatched to the component since the session started",
+          "type": "integer",
+          "format": "int64"
+        },
+        "expiresAt": {
+          "description": "Time at which the tracing is disabled, not set when the session has no duration",
+          "type": "string",
+          "format": "date-time"
+        },
+        "maxEvents": {
+          "description": "Number of events after which the tracing is disabled, 0 for no limit",
+          "type": "integer",
+          "format": "int64"
+        },
+        "remainingEvents": {
+          "description": "Number of events left before the tracing is disabled, not set when the session has no events budget",
+          "type": "integer",
+          "format": "int64"
+        },
+        "remainingSeconds": {
+          "description": "Seconds left before the tracing is disabled, not set when the session has no duration",
+          "type": "integer",
+          "format": "int64"
+        },
+        "startedAt": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "TracingSessionLimits": {
+      "description": "Limits of a tracing session, they restart the session when they are set",
+      "type": "object",
+      "required": [
+        "apiId",
+        "component"
+      ],
+      "properties": {
+        "apiId": {
+          "type": "integer",
+          "format": "uint32"
+        },
+        "component": {
+          "type": "string"
+        },
+        "durationSeconds": {
+          "description": "Duration of the session, 0 for no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "maxEvents": {
+          "description": "Number of events after which the tracing is disabled, 0 for no limit",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        }
+      }
+    },
     "rawSpec": {
       "description": "spec in json or yaml format",
       "type": "object",
@@ -4090,6 +7701,21 @@ func init() {
       "in": "query",
       "required": true
     },
+    "apiFindingSortKey": {
+      "enum": [
+        "severity",
+        "firstSeen",
+        "lastSeen",
+        "type",
+        "moduleName",
+        "apiInfoId"
+      ],
+      "type": "string",
+      "description": "Sort key",
+      "name": "sortKey",
+      "in": "query",
+      "required": true
+    },
     "apiId": {
       "type": "integer",
       "format": "uint32",
@@ -4207,6 +7833,97 @@ func init() {
       "in": "query",
       "required": true
     },
+    "findingModuleIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "moduleName[is]",
+      "in": "query"
+    },
+    "findingSeverityIsFilter": {
+      "type": "array",
+      "items": {
+        "enum": [
+          "INFO",
+          "LOW",
+          "MEDIUM",
+          "HIGH",
+          "CRITICAL"
+        ],
+        "type": "string"
+      },
+      "name": "severity[is]",
+      "in": "query"
+    },
+    "findingTypeIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "type[is]",
+      "in": "query"
+    },
+    "findingsEndTime": {
+      "type": "string",
+      "format": "date-time",
+      "description": "Only the findings seen until this time are returned",
+      "name": "endTime",
+      "in": "query"
+    },
+    "findingsStartTime": {
+      "type": "string",
+      "format": "date-time",
+      "description": "Only the findings seen since this time are returned",
+      "name": "startTime",
+      "in": "query"
+    },
+    "graphqlOperationNameIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "graphqlOperationName[is]",
+      "in": "query"
+    },
+    "graphqlOperationTypeIsFilter": {
+      "type": "array",
+      "items": {
+        "enum": [
+          "QUERY",
+          "MUTATION",
+          "SUBSCRIPTION"
+        ],
+        "type": "string"
+      },
+      "name": "graphqlOperationType[is]",
+      "in": "query"
+    },
+    "graphqlRootFieldIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "description": "Events selecting any of the root fields",
+      "name": "graphqlRootField[is]",
+      "in": "query"
+    },
+    "grpcMethodIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "grpcMethod[is]",
+      "in": "query"
+    },
+    "grpcServiceIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "name": "grpcService[is]",
+      "in": "query"
+    },
     "hasProvidedSpecFilter": {
       "type": "boolean",
       "name": "hasProvidedSpec[is]",
@@ -4337,6 +8054,13 @@ func init() {
       "name": "reconstructedPathID[is]",
       "in": "query"
     },
+    "replayId": {
+      "type": "integer",
+      "format": "uint32",
+      "name": "replayId",
+      "in": "path",
+      "required": true
+    },
     "reviewId": {
       "type": "integer",
       "format": "uint32",
@@ -4420,6 +8144,21 @@ func init() {
       "name": "spec[isNot]",
       "in": "query"
     },
+    "specLocationIsFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "description": "Location of the finding in the provided or reconstructed spec",
+      "name": "specLocation[is]",
+      "in": "query"
+    },
+    "specLocationStartsWithFilter": {
+      "type": "string",
+      "description": "Prefix of the location of the finding in the provided or reconstructed spec",
+      "name": "specLocation[start]",
+      "in": "query"
+    },
     "specStartsWithFilter": {
       "type": "string",
       "name": "spec[start]",
@@ -42,27 +42,54 @@ func NewAPIClarityAPIsAPI(spec *loads.Document) *APIClarityAPIsAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		DeleteAPIInventoryAPIIDClassificationOverrideHandler: DeleteAPIInventoryAPIIDClassificationOverrideHandlerFunc(func(params DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteAPIInventoryAPIIDClassificationOverride has not yet been implemented")
+		}),
+		DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler: DeleteAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc(func(params DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteAPIInventoryAPIIDGrpcDescriptorSet has not yet been implemented")
+		}),
 		DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler: DeleteAPIInventoryAPIIDSpecsProvidedSpecHandlerFunc(func(params DeleteAPIInventoryAPIIDSpecsProvidedSpecParams) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteAPIInventoryAPIIDSpecsProvidedSpec has not yet been implemented")
 		}),
 		DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler: DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandlerFunc(func(params DeleteAPIInventoryAPIIDSpecsReconstructedSpecParams) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteAPIInventoryAPIIDSpecsReconstructedSpec has not yet been implemented")
 		}),
+		DeleteControlReplaysReplayIDHandler: DeleteControlReplaysReplayIDHandlerFunc(func(params DeleteControlReplaysReplayIDParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteControlReplaysReplayID has not yet been implemented")
+		}),
+		DeleteControlSamplingRatesHandler: DeleteControlSamplingRatesHandlerFunc(func(params DeleteControlSamplingRatesParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteControlSamplingRates has not yet been implemented")
+		}),
 		DeleteControlTraceSourcesTraceSourceIDHandler: DeleteControlTraceSourcesTraceSourceIDHandlerFunc(func(params DeleteControlTraceSourcesTraceSourceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteControlTraceSourcesTraceSourceID has not yet been implemented")
 		}),
+		DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler: DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteControlTraceSourcesTraceSourceIDRedactionPolicy has not yet been implemented")
+		}),
+		DeleteControlTracingEndpointsHandler: DeleteControlTracingEndpointsHandlerFunc(func(params DeleteControlTracingEndpointsParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteControlTracingEndpoints has not yet been implemented")
+		}),
+		DeleteControlTracingSessionsHandler: DeleteControlTracingSessionsHandlerFunc(func(params DeleteControlTracingSessionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteControlTracingSessions has not yet been implemented")
+		}),
 		GetAPIEventsHandler: GetAPIEventsHandlerFunc(func(params GetAPIEventsParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIEvents has not yet been implemented")
 		}),
 		GetAPIEventsEventIDHandler: GetAPIEventsEventIDHandlerFunc(func(params GetAPIEventsEventIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIEventsEventID has not yet been implemented")
 		}),
+		GetAPIEventsEventIDGrpcMessagesHandler: GetAPIEventsEventIDGrpcMessagesHandlerFunc(func(params GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIEventsEventIDGrpcMessages has not yet been implemented")
+		}),
 		GetAPIEventsEventIDProvidedSpecDiffHandler: GetAPIEventsEventIDProvidedSpecDiffHandlerFunc(func(params GetAPIEventsEventIDProvidedSpecDiffParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIEventsEventIDProvidedSpecDiff has not yet been implemented")
 		}),
 		GetAPIEventsEventIDReconstructedSpecDiffHandler: GetAPIEventsEventIDReconstructedSpecDiffHandlerFunc(func(params GetAPIEventsEventIDReconstructedSpecDiffParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIEventsEventIDReconstructedSpecDiff has not yet been implemented")
 		}),
+		GetAPIFindingsHandler: GetAPIFindingsHandlerFunc(func(params GetAPIFindingsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIFindings has not yet been implemented")
+		}),
 		GetAPIInventoryHandler: GetAPIInventoryHandlerFunc(func(params GetAPIInventoryParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIInventory has not yet been implemented")
 		}),
@@ -75,6 +102,15 @@ func NewAPIClarityAPIsAPI(spec *loads.Document) *APIClarityAPIsAPI {
 		GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler: GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandlerFunc(func(params GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceID has not yet been implemented")
 		}),
+		GetAPIInventoryAPIIDGraphqlOperationsHandler: GetAPIInventoryAPIIDGraphqlOperationsHandlerFunc(func(params GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIInventoryAPIIDGraphqlOperations has not yet been implemented")
+		}),
+		GetAPIInventoryAPIIDGraphqlSchemaHandler: GetAPIInventoryAPIIDGraphqlSchemaHandlerFunc(func(params GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIInventoryAPIIDGraphqlSchema has not yet been implemented")
+		}),
+		GetAPIInventoryAPIIDGrpcMethodsHandler: GetAPIInventoryAPIIDGrpcMethodsHandlerFunc(func(params GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIInventoryAPIIDGrpcMethods has not yet been implemented")
+		}),
 		GetAPIInventoryAPIIDProvidedSwaggerJSONHandler: GetAPIInventoryAPIIDProvidedSwaggerJSONHandlerFunc(func(params GetAPIInventoryAPIIDProvidedSwaggerJSONParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIInventoryAPIIDProvidedSwaggerJSON has not yet been implemented")
 		}),
@@ -90,12 +126,36 @@ func NewAPIClarityAPIsAPI(spec *loads.Document) *APIClarityAPIsAPI {
 		GetAPIUsageHitCountHandler: GetAPIUsageHitCountHandlerFunc(func(params GetAPIUsageHitCountParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAPIUsageHitCount has not yet been implemented")
 		}),
+		GetControlModulesHandler: GetControlModulesHandlerFunc(func(params GetControlModulesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlModules has not yet been implemented")
+		}),
+		GetControlRedactionPolicyHandler: GetControlRedactionPolicyHandlerFunc(func(params GetControlRedactionPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlRedactionPolicy has not yet been implemented")
+		}),
+		GetControlReplaysHandler: GetControlReplaysHandlerFunc(func(params GetControlReplaysParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlReplays has not yet been implemented")
+		}),
+		GetControlReplaysReplayIDHandler: GetControlReplaysReplayIDHandlerFunc(func(params GetControlReplaysReplayIDParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlReplaysReplayID has not yet been implemented")
+		}),
+		GetControlSamplingRatesHandler: GetControlSamplingRatesHandlerFunc(func(params GetControlSamplingRatesParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlSamplingRates has not yet been implemented")
+		}),
 		GetControlTraceSourcesHandler: GetControlTraceSourcesHandlerFunc(func(params GetControlTraceSourcesParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetControlTraceSources has not yet been implemented")
 		}),
 		GetControlTraceSourcesTraceSourceIDHandler: GetControlTraceSourcesTraceSourceIDHandlerFunc(func(params GetControlTraceSourcesTraceSourceIDParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetControlTraceSourcesTraceSourceID has not yet been implemented")
 		}),
+		GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler: GetControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlTraceSourcesTraceSourceIDRedactionPolicy has not yet been implemented")
+		}),
+		GetControlTracingEndpointsHandler: GetControlTracingEndpointsHandlerFunc(func(params GetControlTracingEndpointsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlTracingEndpoints has not yet been implemented")
+		}),
+		GetControlTracingSessionsHandler: GetControlTracingSessionsHandlerFunc(func(params GetControlTracingSessionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetControlTracingSessions has not yet been implemented")
+		}),
 		GetDashboardAPIUsageHandler: GetDashboardAPIUsageHandlerFunc(func(params GetDashboardAPIUsageParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetDashboardAPIUsage has not yet been implemented")
 		}),
@@ -114,15 +174,45 @@ func NewAPIClarityAPIsAPI(spec *loads.Document) *APIClarityAPIsAPI {
 		PostAPIInventoryReviewIDApprovedReviewHandler: PostAPIInventoryReviewIDApprovedReviewHandlerFunc(func(params PostAPIInventoryReviewIDApprovedReviewParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostAPIInventoryReviewIDApprovedReview has not yet been implemented")
 		}),
+		PostControlHarImportHandler: PostControlHarImportHandlerFunc(func(params PostControlHarImportParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostControlHarImport has not yet been implemented")
+		}),
 		PostControlNewDiscoveredAPIsHandler: PostControlNewDiscoveredAPIsHandlerFunc(func(params PostControlNewDiscoveredAPIsParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostControlNewDiscoveredAPIs has not yet been implemented")
 		}),
+		PostControlReplaysHandler: PostControlReplaysHandlerFunc(func(params PostControlReplaysParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostControlReplays has not yet been implemented")
+		}),
 		PostControlTraceSourcesHandler: PostControlTraceSourcesHandlerFunc(func(params PostControlTraceSourcesParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostControlTraceSources has not yet been implemented")
 		}),
+		PutAPIInventoryAPIIDClassificationOverrideHandler: PutAPIInventoryAPIIDClassificationOverrideHandlerFunc(func(params PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutAPIInventoryAPIIDClassificationOverride has not yet been implemented")
+		}),
+		PutAPIInventoryAPIIDGrpcDescriptorSetHandler: PutAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc(func(params PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutAPIInventoryAPIIDGrpcDescriptorSet has not yet been implemented")
+		}),
 		PutAPIInventoryAPIIDSpecsProvidedSpecHandler: PutAPIInventoryAPIIDSpecsProvidedSpecHandlerFunc(func(params PutAPIInventoryAPIIDSpecsProvidedSpecParams) middleware.Responder {
 			return middleware.NotImplemented("operation PutAPIInventoryAPIIDSpecsProvidedSpec has not yet been implemented")
 		}),
+		PutControlModulesModuleNameHandler: PutControlModulesModuleNameHandlerFunc(func(params PutControlModulesModuleNameParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlModulesModuleName has not yet been implemented")
+		}),
+		PutControlRedactionPolicyHandler: PutControlRedactionPolicyHandlerFunc(func(params PutControlRedactionPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlRedactionPolicy has not yet been implemented")
+		}),
+		PutControlSamplingRatesHandler: PutControlSamplingRatesHandlerFunc(func(params PutControlSamplingRatesParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlSamplingRates has not yet been implemented")
+		}),
+		PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler: PutControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlTraceSourcesTraceSourceIDRedactionPolicy has not yet been implemented")
+		}),
+		PutControlTracingEndpointsHandler: PutControlTracingEndpointsHandlerFunc(func(params PutControlTracingEndpointsParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlTracingEndpoints has not yet been implemented")
+		}),
+		PutControlTracingSessionsHandler: PutControlTracingSessionsHandlerFunc(func(params PutControlTracingSessionsParams) middleware.Responder {
+			return middleware.NotImplemented("operation PutControlTracingSessions has not yet been implemented")
+		}),
 	}
 }
 
@@ -159,20 +249,38 @@ type APIClarityAPIsAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// DeleteAPIInventoryAPIIDClassificationOverrideHandler sets the operation handler for the delete API inventory API ID classification override operation
+	DeleteAPIInventoryAPIIDClassificationOverrideHandler DeleteAPIInventoryAPIIDClassificationOverrideHandler
+	// DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler sets the operation handler for the delete API inventory API ID grpc descriptor set operation
+	DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler
 	// DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler sets the operation handler for the delete API inventory API ID specs provided spec operation
 	DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler
 	// DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler sets the operation handler for the delete API inventory API ID specs reconstructed spec operation
 	DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler
+	// DeleteControlReplaysReplayIDHandler sets the operation handler for the delete control replays replay ID operation
+	DeleteControlReplaysReplayIDHandler DeleteControlReplaysReplayIDHandler
+	// DeleteControlSamplingRatesHandler sets the operation handler for the delete control sampling rates operation
+	DeleteControlSamplingRatesHandler DeleteControlSamplingRatesHandler
 	// DeleteControlTraceSourcesTraceSourceIDHandler sets the operation handler for the delete control trace sources trace source ID operation
 	DeleteControlTraceSourcesTraceSourceIDHandler DeleteControlTraceSourcesTraceSourceIDHandler
+	// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler sets the operation handler for the delete control trace sources trace source ID redaction policy operation
+	DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+	// DeleteControlTracingEndpointsHandler sets the operation handler for the delete control tracing endpoints operation
+	DeleteControlTracingEndpointsHandler DeleteControlTracingEndpointsHandler
+	// DeleteControlTracingSessionsHandler sets the operation handler for the delete control tracing sessions operation
+	DeleteControlTracingSessionsHandler DeleteControlTracingSessionsHandler
 	// GetAPIEventsHandler sets the operation handler for the get API events operation
 	GetAPIEventsHandler GetAPIEventsHandler
 	// GetAPIEventsEventIDHandler sets the operation handler for the get API events event ID operation
 	GetAPIEventsEventIDHandler GetAPIEventsEventIDHandler
+	// GetAPIEventsEventIDGrpcMessagesHandler sets the operation handler for the get API events event ID grpc messages operation
+	GetAPIEventsEventIDGrpcMessagesHandler GetAPIEventsEventIDGrpcMessagesHandler
 	// GetAPIEventsEventIDProvidedSpecDiffHandler sets the operation handler for the get API events event ID provided spec diff operation
 	GetAPIEventsEventIDProvidedSpecDiffHandler GetAPIEventsEventIDProvidedSpecDiffHandler
 	// GetAPIEventsEventIDReconstructedSpecDiffHandler sets the operation handler for the get API events event ID reconstructed spec diff operation
 	GetAPIEventsEventIDReconstructedSpecDiffHandler GetAPIEventsEventIDReconstructedSpecDiffHandler
+	// GetAPIFindingsHandler sets the operation handler for the get API findings operation
+	GetAPIFindingsHandler GetAPIFindingsHandler
 	// GetAPIInventoryHandler sets the operation handler for the get API inventory operation
 	GetAPIInventoryHandler GetAPIInventoryHandler
 	// GetAPIInventoryAPIIDAPIInfoHandler sets the operation handler for the get API inventory API ID API info operation
@@ -181,6 +289,12 @@ type APIClarityAPIsAPI struct {
 	GetAPIInventoryAPIIDFromHostAndPortHandler GetAPIInventoryAPIIDFromHostAndPortHandler
 	// GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler sets the operation handler for the get API inventory API ID from host and port and trace source ID operation
 	GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler
+	// GetAPIInventoryAPIIDGraphqlOperationsHandler sets the operation handler for the get API inventory API ID graphql operations operation
+	GetAPIInventoryAPIIDGraphqlOperationsHandler GetAPIInventoryAPIIDGraphqlOperationsHandler
+	// GetAPIInventoryAPIIDGraphqlSchemaHandler sets the operation handler for the get API inventory API ID graphql schema operation
+	GetAPIInventoryAPIIDGraphqlSchemaHandler GetAPIInventoryAPIIDGraphqlSchemaHandler
+	// GetAPIInventoryAPIIDGrpcMethodsHandler sets the operation handler for the get API inventory API ID grpc methods operation
+	GetAPIInventoryAPIIDGrpcMethodsHandler GetAPIInventoryAPIIDGrpcMethodsHandler
 	// GetAPIInventoryAPIIDProvidedSwaggerJSONHandler sets the operation handler for the get API inventory API ID provided swagger JSON operation
 	GetAPIInventoryAPIIDProvidedSwaggerJSONHandler GetAPIInventoryAPIIDProvidedSwaggerJSONHandler
 	// GetAPIInventoryAPIIDReconstructedSwaggerJSONHandler sets the operation handler for the get API inventory API ID reconstructed swagger JSON operation
@@ -191,10 +305,26 @@ type APIClarityAPIsAPI struct {
 	GetAPIInventoryAPIIDSuggestedReviewHandler GetAPIInventoryAPIIDSuggestedReviewHandler
 	// GetAPIUsageHitCountHandler sets the operation handler for the get API usage hit count operation
 	GetAPIUsageHitCountHandler GetAPIUsageHitCountHandler
+	// GetControlModulesHandler sets the operation handler for the get control modules operation
+	GetControlModulesHandler GetControlModulesHandler
+	// GetControlRedactionPolicyHandler sets the operation handler for the get control redaction policy operation
+	GetControlRedactionPolicyHandler GetControlRedactionPolicyHandler
+	// GetControlReplaysHandler sets the operation handler for the get control replays operation
+	GetControlReplaysHandler GetControlReplaysHandler
+	// GetControlReplaysReplayIDHandler sets the operation handler for the get control replays replay ID operation
+	GetControlReplaysReplayIDHandler GetControlReplaysReplayIDHandler
+	// GetControlSamplingRatesHandler sets the operation handler for the get control sampling rates operation
+	GetControlSamplingRatesHandler GetControlSamplingRatesHandler
 	// GetControlTraceSourcesHandler sets the operation handler for the get control trace sources operation
 	GetControlTraceSourcesHandler GetControlTraceSourcesHandler
 	// GetControlTraceSourcesTraceSourceIDHandler sets the operation handler for the get control trace sources trace source ID operation
 	GetControlTraceSourcesTraceSourceIDHandler GetControlTraceSourcesTraceSourceIDHandler
+	// GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler sets the operation handler for the get control trace sources trace source ID redaction policy operation
+	GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+	// GetControlTracingEndpointsHandler sets the operation handler for the get control tracing endpoints operation
+	GetControlTracingEndpointsHandler GetControlTracingEndpointsHandler
+	// GetControlTracingSessionsHandler sets the operation handler for the get control tracing sessions operation
+	GetControlTracingSessionsHandler GetControlTracingSessionsHandler
 	// GetDashboardAPIUsageHandler sets the operation handler for the get dashboard API usage operation
 	GetDashboardAPIUsageHandler GetDashboardAPIUsageHandler
 	// GetDashboardAPIUsageLatestDiffsHandler sets the operation handler for the get dashboard API usage latest diffs operation
@@ -207,12 +337,32 @@ type APIClarityAPIsAPI struct {
 	PostAPIInventoryHandler PostAPIInventoryHandler
 	// PostAPIInventoryReviewIDApprovedReviewHandler sets the operation handler for the post API inventory review ID approved review operation
 	PostAPIInventoryReviewIDApprovedReviewHandler PostAPIInventoryReviewIDApprovedReviewHandler
+	// PostControlHarImportHandler sets the operation handler for the post control har import operation
+	PostControlHarImportHandler PostControlHarImportHandler
 	// PostControlNewDiscoveredAPIsHandler sets the operation handler for the post control new discovered a p is operation
 	PostControlNewDiscoveredAPIsHandler PostControlNewDiscoveredAPIsHandler
+	// PostControlReplaysHandler sets the operation handler for the post control replays operation
+	PostControlReplaysHandler PostControlReplaysHandler
 	// PostControlTraceSourcesHandler sets the operation handler for the post control trace sources operation
 	PostControlTraceSourcesHandler PostControlTraceSourcesHandler
+	// PutAPIInventoryAPIIDClassificationOverrideHandler sets the operation handler for the put API inventory API ID classification override operation
+	PutAPIInventoryAPIIDClassificationOverrideHandler PutAPIInventoryAPIIDClassificationOverrideHandler
+	// PutAPIInventoryAPIIDGrpcDescriptorSetHandler sets the operation handler for the put API inventory API ID grpc descriptor set operation
+	PutAPIInventoryAPIIDGrpcDescriptorSetHandler PutAPIInventoryAPIIDGrpcDescriptorSetHandler
 	// PutAPIInventoryAPIIDSpecsProvidedSpecHandler sets the operation handler for the put API inventory API ID specs provided spec operation
 	PutAPIInventoryAPIIDSpecsProvidedSpecHandler PutAPIInventoryAPIIDSpecsProvidedSpecHandler
+	// PutControlModulesModuleNameHandler sets the operation handler for the put control modules module name operation
+	PutControlModulesModuleNameHandler PutControlModulesModuleNameHandler
+	// PutControlRedactionPolicyHandler sets the operation handler for the put control redaction policy operation
+	PutControlRedactionPolicyHandler PutControlRedactionPolicyHandler
+	// PutControlSamplingRatesHandler sets the operation handler for the put control sampling rates operation
+	PutControlSamplingRatesHandler PutControlSamplingRatesHandler
+	// PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler sets the operation handler for the put control trace sources trace source ID redaction policy operation
+	PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+	// PutControlTracingEndpointsHandler sets the operation handler for the put control tracing endpoints operation
+	PutControlTracingEndpointsHandler PutControlTracingEndpointsHandler
+	// PutControlTracingSessionsHandler sets the operation handler for the put control tracing sessions operation
+	PutControlTracingSessionsHandler PutControlTracingSessionsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -290,27 +440,54 @@ func (o *APIClarityAPIsAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.DeleteAPIInventoryAPIIDClassificationOverrideHandler == nil {
+		unregistered = append(unregistered, "DeleteAPIInventoryAPIIDClassificationOverrideHandler")
+	}
+	if o.DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler == nil {
+		unregistered = append(unregistered, "DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler")
+	}
 	if o.DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler == nil {
 		unregistered = append(unregistered, "DeleteAPIInventoryAPIIDSpecsProvidedSpecHandler")
 	}
 	if o.DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler == nil {
 		unregistered = append(unregistered, "DeleteAPIInventoryAPIIDSpecsReconstructedSpecHandler")
 	}
+	if o.DeleteControlReplaysReplayIDHandler == nil {
+		unregistered = append(unregistered, "DeleteControlReplaysReplayIDHandler")
+	}
+	if o.DeleteControlSamplingRatesHandler == nil {
+		unregistered = append(unregistered, "DeleteControlSamplingRatesHandler")
+	}
 	if o.DeleteControlTraceSourcesTraceSourceIDHandler == nil {
 		unregistered = append(unregistered, "DeleteControlTraceSourcesTraceSourceIDHandler")
 	}
+	if o.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler == nil {
+		unregistered = append(unregistered, "DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler")
+	}
+	if o.DeleteControlTracingEndpointsHandler == nil {
+		unregistered = append(unregistered, "DeleteControlTracingEndpointsHandler")
+	}
+	if o.DeleteControlTracingSessionsHandler == nil {
+		unregistered = append(unregistered, "DeleteControlTracingSessionsHandler")
+	}
 	if o.GetAPIEventsHandler == nil {
 		unregistered = append(unregistered, "GetAPIEventsHandler")
 	}
 	if o.GetAPIEventsEventIDHandler == nil {
 		unregistered = append(unregistered, "GetAPIEventsEventIDHandler")
 	}
+	if o.GetAPIEventsEventIDGrpcMessagesHandler == nil {
+		unregistered = append(unregistered, "GetAPIEventsEventIDGrpcMessagesHandler")
+	}
 	if o.GetAPIEventsEventIDProvidedSpecDiffHandler == nil {
 		unregistered = append(unregistered, "GetAPIEventsEventIDProvidedSpecDiffHandler")
 	}
 	if o.GetAPIEventsEventIDReconstructedSpecDiffHandler == nil {
 		unregistered = append(unregistered, "GetAPIEventsEventIDReconstructedSpecDiffHandler")
 	}
+	if o.GetAPIFindingsHandler == nil {
+		unregistered = append(unregistered, "GetAPIFindingsHandler")
+	}
 	if o.GetAPIInventoryHandler == nil {
 		unregistered = append(unregistered, "GetAPIInventoryHandler")
 	}
@@ -323,6 +500,15 @@ func (o *APIClarityAPIsAPI) Validate() error {
 	if o.GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler == nil {
 		unregistered = append(unregistered, "GetAPIInventoryAPIIDFromHostAndPortAndTraceSourceIDHandler")
 	}
+	if o.GetAPIInventoryAPIIDGraphqlOperationsHandler == nil {
+		unregistered = append(unregistered, "GetAPIInventoryAPIIDGraphqlOperationsHandler")
+	}
+	if o.GetAPIInventoryAPIIDGraphqlSchemaHandler == nil {
+		unregistered = append(unregistered, "GetAPIInventoryAPIIDGraphqlSchemaHandler")
+	}
+	if o.GetAPIInventoryAPIIDGrpcMethodsHandler == nil {
+		unregistered = append(unregistered, "GetAPIInventoryAPIIDGrpcMethodsHandler")
+	}
 	if o.GetAPIInventoryAPIIDProvidedSwaggerJSONHandler == nil {
 		unregistered = append(unregistered, "GetAPIInventoryAPIIDProvidedSwaggerJSONHandler")
 	}
@@ -338,12 +524,36 @@ func (o *APIClarityAPIsAPI) Validate() error {
 	if o.GetAPIUsageHitCountHandler == nil {
 		unregistered = append(unregistered, "GetAPIUsageHitCountHandler")
 	}
+	if o.GetControlModulesHandler == nil {
+		unregistered = append(unregistered, "GetControlModulesHandler")
+	}
+	if o.GetControlRedactionPolicyHandler == nil {
+		unregistered = append(unregistered, "GetControlRedactionPolicyHandler")
+	}
+	if o.GetControlReplaysHandler == nil {
+		unregistered = append(unregistered, "GetControlReplaysHandler")
+	}
+	if o.GetControlReplaysReplayIDHandler == nil {
+		unregistered = append(unregistered, "GetControlReplaysReplayIDHandler")
+	}
+	if o.GetControlSamplingRatesHandler == nil {
+		unregistered = append(unregistered, "GetControlSamplingRatesHandler")
+	}
 	if o.GetControlTraceSourcesHandler == nil {
 		unregistered = append(unregistered, "GetControlTraceSourcesHandler")
 	}
 	if o.GetControlTraceSourcesTraceSourceIDHandler == nil {
 		unregistered = append(unregistered, "GetControlTraceSourcesTraceSourceIDHandler")
 	}
+	if o.GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler == nil {
+		unregistered = append(unregistered, "GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler")
+	}
+	if o.GetControlTracingEndpointsHandler == nil {
+		unregistered = append(unregistered, "GetControlTracingEndpointsHandler")
+	}
+	if o.GetControlTracingSessionsHandler == nil {
+		unregistered = append(unregistered, "GetControlTracingSessionsHandler")
+	}
 	if o.GetDashboardAPIUsageHandler == nil {
 		unregistered = append(unregistered, "GetDashboardAPIUsageHandler")
 	}
@@ -362,15 +572,45 @@ func (o *APIClarityAPIsAPI) Validate() error {
 	if o.PostAPIInventoryReviewIDApprovedReviewHandler == nil {
 		unregistered = append(unregistered, "PostAPIInventoryReviewIDApprovedReviewHandler")
 	}
+	if o.PostControlHarImportHandler == nil {
+		unregistered = append(unregistered, "PostControlHarImportHandler")
+	}
 	if o.PostControlNewDiscoveredAPIsHandler == nil {
 		unregistered = append(unregistered, "PostControlNewDiscoveredAPIsHandler")
 	}
+	if o.PostControlReplaysHandler == nil {
+		unregistered = append(unregistered, "PostControlReplaysHandler")
+	}
 	if o.PostControlTraceSourcesHandler == nil {
 		unregistered = append(unregistered, "PostControlTraceSourcesHandler")
 	}
+	if o.PutAPIInventoryAPIIDClassificationOverrideHandler == nil {
+		unregistered = append(unregistered, "PutAPIInventoryAPIIDClassificationOverrideHandler")
+	}
+	if o.PutAPIInventoryAPIIDGrpcDescriptorSetHandler == nil {
+		unregistered = append(unregistered, "PutAPIInventoryAPIIDGrpcDescriptorSetHandler")
+	}
 	if o.PutAPIInventoryAPIIDSpecsProvidedSpecHandler == nil {
 		unregistered = append(unregistered, "PutAPIInventoryAPIIDSpecsProvidedSpecHandler")
 	}
+	if o.PutControlModulesModuleNameHandler == nil {
+		unregistered = append(unregistered, "PutControlModulesModuleNameHandler")
+	}
+	if o.PutControlRedactionPolicyHandler == nil {
+		unregistered = append(unregistered, "PutControlRedactionPolicyHandler")
+	}
+	if o.PutControlSamplingRatesHandler == nil {
+		unregistered = append(unregistered, "PutControlSamplingRatesHandler")
+	}
+	if o.PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler == nil {
+		unregistered = append(unregistered, "PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler")
+	}
+	if o.PutControlTracingEndpointsHandler == nil {
+		unregistered = append(unregistered, "PutControlTracingEndpointsHandler")
+	}
+	if o.PutControlTracingSessionsHandler == nil {
+		unregistered = append(unregistered, "PutControlTracingSessionsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -459,6 +699,14 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apiInventory/{apiId}/classificationOverride"] = NewDeleteAPIInventoryAPIIDClassificationOverride(o.context, o.DeleteAPIInventoryAPIIDClassificationOverrideHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/apiInventory/{apiId}/grpc/descriptorSet"] = NewDeleteAPIInventoryAPIIDGrpcDescriptorSet(o.context, o.DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -470,7 +718,27 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/control/replays/{replayId}"] = NewDeleteControlReplaysReplayID(o.context, o.DeleteControlReplaysReplayIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/control/samplingRates"] = NewDeleteControlSamplingRates(o.context, o.DeleteControlSamplingRatesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/control/traceSources/{traceSourceId}"] = NewDeleteControlTraceSourcesTraceSourceID(o.context, o.DeleteControlTraceSourcesTraceSourceIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/control/traceSources/{traceSourceId}/redactionPolicy"] = NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicy(o.context, o.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/control/tracingEndpoints"] = NewDeleteControlTracingEndpoints(o.context, o.DeleteControlTracingEndpointsHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/control/tracingSessions"] = NewDeleteControlTracingSessions(o.context, o.DeleteControlTracingSessionsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -482,6 +750,10 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/apiEvents/{eventId}/grpcMessages"] = NewGetAPIEventsEventIDGrpcMessages(o.context, o.GetAPIEventsEventIDGrpcMessagesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/apiEvents/{eventId}/providedSpecDiff"] = NewGetAPIEventsEventIDProvidedSpecDiff(o.context, o.GetAPIEventsEventIDProvidedSpecDiffHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -490,6 +762,10 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/apiFindings"] = NewGetAPIFindings(o.context, o.GetAPIFindingsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/apiInventory"] = NewGetAPIInventory(o.context, o.GetAPIInventoryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -506,6 +782,18 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/apiInventory/{apiId}/graphql/operations"] = NewGetAPIInventoryAPIIDGraphqlOperations(o.context, o.GetAPIInventoryAPIIDGraphqlOperationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apiInventory/{apiId}/graphql/schema"] = NewGetAPIInventoryAPIIDGraphqlSchema(o.context, o.GetAPIInventoryAPIIDGraphqlSchemaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/apiInventory/{apiId}/grpc/methods"] = NewGetAPIInventoryAPIIDGrpcMethods(o.context, o.GetAPIInventoryAPIIDGrpcMethodsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/apiInventory/{apiId}/provided_swagger.json"] = NewGetAPIInventoryAPIIDProvidedSwaggerJSON(o.context, o.GetAPIInventoryAPIIDProvidedSwaggerJSONHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -526,6 +814,26 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/control/modules"] = NewGetControlModules(o.context, o.GetControlModulesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/redactionPolicy"] = NewGetControlRedactionPolicy(o.context, o.GetControlRedactionPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/replays"] = NewGetControlReplays(o.context, o.GetControlReplaysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/replays/{replayId}"] = NewGetControlReplaysReplayID(o.context, o.GetControlReplaysReplayIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/samplingRates"] = NewGetControlSamplingRates(o.context, o.GetControlSamplingRatesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/control/traceSources"] = NewGetControlTraceSources(o.context, o.GetControlTraceSourcesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -534,6 +842,18 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/control/traceSources/{traceSourceId}/redactionPolicy"] = NewGetControlTraceSourcesTraceSourceIDRedactionPolicy(o.context, o.GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/tracingEndpoints"] = NewGetControlTracingEndpoints(o.context, o.GetControlTracingEndpointsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/control/tracingSessions"] = NewGetControlTracingSessions(o.context, o.GetControlTracingSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/dashboard/apiUsage"] = NewGetDashboardAPIUsage(o.context, o.GetDashboardAPIUsageHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -558,15 +878,55 @@ func (o *APIClarityAPIsAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/control/harImport"] = NewPostControlHarImport(o.context, o.PostControlHarImportHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/control/newDiscoveredAPIs"] = NewPostControlNewDiscoveredAPIs(o.context, o.PostControlNewDiscoveredAPIsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/control/replays"] = NewPostControlReplays(o.context, o.PostControlReplaysHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/control/traceSources"] = NewPostControlTraceSources(o.context, o.PostControlTraceSourcesHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/apiInventory/{apiId}/classificationOverride"] = NewPutAPIInventoryAPIIDClassificationOverride(o.context, o.PutAPIInventoryAPIIDClassificationOverrideHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/apiInventory/{apiId}/grpc/descriptorSet"] = NewPutAPIInventoryAPIIDGrpcDescriptorSet(o.context, o.PutAPIInventoryAPIIDGrpcDescriptorSetHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/apiInventory/{apiId}/specs/providedSpec"] = NewPutAPIInventoryAPIIDSpecsProvidedSpec(o.context, o.PutAPIInventoryAPIIDSpecsProvidedSpecHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/modules/{moduleName}"] = NewPutControlModulesModuleName(o.context, o.PutControlModulesModuleNameHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/redactionPolicy"] = NewPutControlRedactionPolicy(o.context, o.PutControlRedactionPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/samplingRates"] = NewPutControlSamplingRates(o.context, o.PutControlSamplingRatesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/traceSources/{traceSourceId}/redactionPolicy"] = NewPutControlTraceSourcesTraceSourceIDRedactionPolicy(o.context, o.PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/tracingEndpoints"] = NewPutControlTracingEndpoints(o.context, o.PutControlTracingEndpointsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/control/tracingSessions"] = NewPutControlTracingSessions(o.context, o.PutControlTracingSessionsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
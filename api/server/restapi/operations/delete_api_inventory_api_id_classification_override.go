@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteAPIInventoryAPIIDClassificationOverrideHandlerFunc turns a function with the right signature into a delete API inventory API ID classification override handler
+type DeleteAPIInventoryAPIIDClassificationOverrideHandlerFunc func(DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteAPIInventoryAPIIDClassificationOverrideHandlerFunc) Handle(params DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteAPIInventoryAPIIDClassificationOverrideHandler interface for that can handle valid delete API inventory API ID classification override params
+type DeleteAPIInventoryAPIIDClassificationOverrideHandler interface {
+	Handle(DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverride creates a new http.Handler for the delete API inventory API ID classification override operation
+func NewDeleteAPIInventoryAPIIDClassificationOverride(ctx *middleware.Context, handler DeleteAPIInventoryAPIIDClassificationOverrideHandler) *DeleteAPIInventoryAPIIDClassificationOverride {
+	return &DeleteAPIInventoryAPIIDClassificationOverride{Context: ctx, Handler: handler}
+}
+
+/* DeleteAPIInventoryAPIIDClassificationOverride swagger:route DELETE /apiInventory/{apiId}/classificationOverride deleteApiInventoryApiIdClassificationOverride
+
+Classify the next traffic of an API by the media type of its responses again
+
+*/
+type DeleteAPIInventoryAPIIDClassificationOverride struct {
+	Context *middleware.Context
+	Handler DeleteAPIInventoryAPIIDClassificationOverrideHandler
+}
+
+func (o *DeleteAPIInventoryAPIIDClassificationOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteAPIInventoryAPIIDClassificationOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteAPIInventoryAPIIDClassificationOverrideOKCode is the HTTP code returned for type DeleteAPIInventoryAPIIDClassificationOverrideOK
+const DeleteAPIInventoryAPIIDClassificationOverrideOKCode int = 200
+
+/*DeleteAPIInventoryAPIIDClassificationOverrideOK Success
+
+swagger:response deleteApiInventoryApiIdClassificationOverrideOK
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload interface{} `json:"body,omitempty"`
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideOK creates DeleteAPIInventoryAPIIDClassificationOverrideOK with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideOK() *DeleteAPIInventoryAPIIDClassificationOverrideOK {
+
+	return &DeleteAPIInventoryAPIIDClassificationOverrideOK{}
+}
+
+// WithPayload adds the payload to the delete Api inventory Api Id classification override o k response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) WithPayload(payload interface{}) *DeleteAPIInventoryAPIIDClassificationOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Api inventory Api Id classification override o k response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) SetPayload(payload interface{}) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// DeleteAPIInventoryAPIIDClassificationOverrideNotFoundCode is the HTTP code returned for type DeleteAPIInventoryAPIIDClassificationOverrideNotFound
+const DeleteAPIInventoryAPIIDClassificationOverrideNotFoundCode int = 404
+
+/*DeleteAPIInventoryAPIIDClassificationOverrideNotFound API not found
+
+swagger:response deleteApiInventoryApiIdClassificationOverrideNotFound
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound creates DeleteAPIInventoryAPIIDClassificationOverrideNotFound with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound() *DeleteAPIInventoryAPIIDClassificationOverrideNotFound {
+
+	return &DeleteAPIInventoryAPIIDClassificationOverrideNotFound{}
+}
+
+// WithPayload adds the payload to the delete Api inventory Api Id classification override not found response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) WithPayload(payload *models.APIResponse) *DeleteAPIInventoryAPIIDClassificationOverrideNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Api inventory Api Id classification override not found response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteAPIInventoryAPIIDClassificationOverrideDefault unknown error
+
+swagger:response deleteApiInventoryApiIdClassificationOverrideDefault
+*/
+type DeleteAPIInventoryAPIIDClassificationOverrideDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteAPIInventoryAPIIDClassificationOverrideDefault creates DeleteAPIInventoryAPIIDClassificationOverrideDefault with default headers values
+func NewDeleteAPIInventoryAPIIDClassificationOverrideDefault(code int) *DeleteAPIInventoryAPIIDClassificationOverrideDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteAPIInventoryAPIIDClassificationOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete API inventory API ID classification override default response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) WithStatusCode(code int) *DeleteAPIInventoryAPIIDClassificationOverrideDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete API inventory API ID classification override default response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete API inventory API ID classification override default response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) WithPayload(payload *models.APIResponse) *DeleteAPIInventoryAPIIDClassificationOverrideDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete API inventory API ID classification override default response
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAPIInventoryAPIIDClassificationOverrideDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc turns a function with the right signature into a delete API inventory API ID grpc descriptor set handler
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc func(DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc) Handle(params DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler interface for that can handle valid delete API inventory API ID grpc descriptor set params
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler interface {
+	Handle(DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSet creates a new http.Handler for the delete API inventory API ID grpc descriptor set operation
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSet(ctx *middleware.Context, handler DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler) *DeleteAPIInventoryAPIIDGrpcDescriptorSet {
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSet{Context: ctx, Handler: handler}
+}
+
+/* DeleteAPIInventoryAPIIDGrpcDescriptorSet swagger:route DELETE /apiInventory/{apiId}/grpc/descriptorSet deleteApiInventoryApiIdGrpcDescriptorSet
+
+Unset the protobuf descriptor set of a gRPC API
+
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSet struct {
+	Context *middleware.Context
+	Handler DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler
+}
+
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteAPIInventoryAPIIDGrpcDescriptorSetParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteAPIInventoryAPIIDGrpcDescriptorSetOKCode is the HTTP code returned for type DeleteAPIInventoryAPIIDGrpcDescriptorSetOK
+const DeleteAPIInventoryAPIIDGrpcDescriptorSetOKCode int = 200
+
+/*DeleteAPIInventoryAPIIDGrpcDescriptorSetOK Success
+
+swagger:response deleteApiInventoryApiIdGrpcDescriptorSetOK
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload interface{} `json:"body,omitempty"`
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK creates DeleteAPIInventoryAPIIDGrpcDescriptorSetOK with default headers values
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK() *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK {
+
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetOK{}
+}
+
+// WithPayload adds the payload to the delete Api inventory Api Id grpc descriptor set o k response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) WithPayload(payload interface{}) *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete Api inventory Api Id grpc descriptor set o k response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) SetPayload(payload interface{}) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault unknown error
+
+swagger:response deleteApiInventoryApiIdGrpcDescriptorSetDefault
+*/
+type DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault creates DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault with default headers values
+func NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault(code int) *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete API inventory API ID grpc descriptor set default response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) WithStatusCode(code int) *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete API inventory API ID grpc descriptor set default response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete API inventory API ID grpc descriptor set default response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) WithPayload(payload *models.APIResponse) *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete API inventory API ID grpc descriptor set default response
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteAPIInventoryAPIIDGrpcDescriptorSetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteControlReplaysReplayIDHandlerFunc turns a function with the right signature into a delete control replays replay ID handler
+type DeleteControlReplaysReplayIDHandlerFunc func(DeleteControlReplaysReplayIDParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteControlReplaysReplayIDHandlerFunc) Handle(params DeleteControlReplaysReplayIDParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteControlReplaysReplayIDHandler interface for that can handle valid delete control replays replay ID params
+type DeleteControlReplaysReplayIDHandler interface {
+	Handle(DeleteControlReplaysReplayIDParams) middleware.Responder
+}
+
+// NewDeleteControlReplaysReplayID creates a new http.Handler for the delete control replays replay ID operation
+func NewDeleteControlReplaysReplayID(ctx *middleware.Context, handler DeleteControlReplaysReplayIDHandler) *DeleteControlReplaysReplayID {
+	return &DeleteControlReplaysReplayID{Context: ctx, Handler: handler}
+}
+
+/* DeleteControlReplaysReplayID swagger:route DELETE /control/replays/{replayId} deleteControlReplaysReplayId
+
+Cancel a replay
+
+*/
+type DeleteControlReplaysReplayID struct {
+	Context *middleware.Context
+	Handler DeleteControlReplaysReplayIDHandler
+}
+
+func (o *DeleteControlReplaysReplayID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteControlReplaysReplayIDParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteControlReplaysReplayIDOKCode is the HTTP code returned for type DeleteControlReplaysReplayIDOK
+const DeleteControlReplaysReplayIDOKCode int = 200
+
+/*DeleteControlReplaysReplayIDOK Success
+
+swagger:response deleteControlReplaysReplayIdOK
+*/
+type DeleteControlReplaysReplayIDOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Replay `json:"body,omitempty"`
+}
+
+// NewDeleteControlReplaysReplayIDOK creates DeleteControlReplaysReplayIDOK with default headers values
+func NewDeleteControlReplaysReplayIDOK() *DeleteControlReplaysReplayIDOK {
+
+	return &DeleteControlReplaysReplayIDOK{}
+}
+
+// WithPayload adds the payload to the delete control replays replay Id o k response
+func (o *DeleteControlReplaysReplayIDOK) WithPayload(payload *models.Replay) *DeleteControlReplaysReplayIDOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control replays replay Id o k response
+func (o *DeleteControlReplaysReplayIDOK) SetPayload(payload *models.Replay) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlReplaysReplayIDOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// DeleteControlReplaysReplayIDNotFoundCode is the HTTP code returned for type DeleteControlReplaysReplayIDNotFound
+const DeleteControlReplaysReplayIDNotFoundCode int = 404
+
+/*DeleteControlReplaysReplayIDNotFound Replay not found
+
+swagger:response deleteControlReplaysReplayIdNotFound
+*/
+type DeleteControlReplaysReplayIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlReplaysReplayIDNotFound creates DeleteControlReplaysReplayIDNotFound with default headers values
+func NewDeleteControlReplaysReplayIDNotFound() *DeleteControlReplaysReplayIDNotFound {
+
+	return &DeleteControlReplaysReplayIDNotFound{}
+}
+
+// WithPayload adds the payload to the delete control replays replay Id not found response
+func (o *DeleteControlReplaysReplayIDNotFound) WithPayload(payload *models.APIResponse) *DeleteControlReplaysReplayIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control replays replay Id not found response
+func (o *DeleteControlReplaysReplayIDNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlReplaysReplayIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteControlReplaysReplayIDDefault unknown error
+
+swagger:response deleteControlReplaysReplayIdDefault
+*/
+type DeleteControlReplaysReplayIDDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlReplaysReplayIDDefault creates DeleteControlReplaysReplayIDDefault with default headers values
+func NewDeleteControlReplaysReplayIDDefault(code int) *DeleteControlReplaysReplayIDDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteControlReplaysReplayIDDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete control replays replay ID default response
+func (o *DeleteControlReplaysReplayIDDefault) WithStatusCode(code int) *DeleteControlReplaysReplayIDDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete control replays replay ID default response
+func (o *DeleteControlReplaysReplayIDDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete control replays replay ID default response
+func (o *DeleteControlReplaysReplayIDDefault) WithPayload(payload *models.APIResponse) *DeleteControlReplaysReplayIDDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control replays replay ID default response
+func (o *DeleteControlReplaysReplayIDDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlReplaysReplayIDDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteControlSamplingRatesHandlerFunc turns a function with the right signature into a delete control sampling rates handler
+type DeleteControlSamplingRatesHandlerFunc func(DeleteControlSamplingRatesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteControlSamplingRatesHandlerFunc) Handle(params DeleteControlSamplingRatesParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteControlSamplingRatesHandler interface for that can handle valid delete control sampling rates params
+type DeleteControlSamplingRatesHandler interface {
+	Handle(DeleteControlSamplingRatesParams) middleware.Responder
+}
+
+// NewDeleteControlSamplingRates creates a new http.Handler for the delete control sampling rates operation
+func NewDeleteControlSamplingRates(ctx *middleware.Context, handler DeleteControlSamplingRatesHandler) *DeleteControlSamplingRates {
+	return &DeleteControlSamplingRates{Context: ctx, Handler: handler}
+}
+
+/* DeleteControlSamplingRates swagger:route DELETE /control/samplingRates deleteControlSamplingRates
+
+Delete the sampling rate of an API for a component, all its traces are kept again
+
+*/
+type DeleteControlSamplingRates struct {
+	Context *middleware.Context
+	Handler DeleteControlSamplingRatesHandler
+}
+
+func (o *DeleteControlSamplingRates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteControlSamplingRatesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteControlSamplingRatesNoContentCode is the HTTP code returned for type DeleteControlSamplingRatesNoContent
+const DeleteControlSamplingRatesNoContentCode int = 204
+
+/*DeleteControlSamplingRatesNoContent Success
+
+swagger:response deleteControlSamplingRatesNoContent
+*/
+type DeleteControlSamplingRatesNoContent struct {
+}
+
+// NewDeleteControlSamplingRatesNoContent creates DeleteControlSamplingRatesNoContent with default headers values
+func NewDeleteControlSamplingRatesNoContent() *DeleteControlSamplingRatesNoContent {
+
+	return &DeleteControlSamplingRatesNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteControlSamplingRatesNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteControlSamplingRatesDefault unknown error
+
+swagger:response deleteControlSamplingRatesDefault
+*/
+type DeleteControlSamplingRatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlSamplingRatesDefault creates DeleteControlSamplingRatesDefault with default headers values
+func NewDeleteControlSamplingRatesDefault(code int) *DeleteControlSamplingRatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete control sampling rates default response
+func (o *DeleteControlSamplingRatesDefault) WithStatusCode(code int) *DeleteControlSamplingRatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete control sampling rates default response
+func (o *DeleteControlSamplingRatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete control sampling rates default response
+func (o *DeleteControlSamplingRatesDefault) WithPayload(payload *models.APIResponse) *DeleteControlSamplingRatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control sampling rates default response
+func (o *DeleteControlSamplingRatesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlSamplingRatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
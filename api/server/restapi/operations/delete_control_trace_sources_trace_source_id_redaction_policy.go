@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc turns a function with the right signature into a delete control trace sources trace source ID redaction policy handler
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc func(DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc) Handle(params DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface for that can handle valid delete control trace sources trace source ID redaction policy params
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface {
+	Handle(DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicy creates a new http.Handler for the delete control trace sources trace source ID redaction policy operation
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicy(ctx *middleware.Context, handler DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicy {
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicy{Context: ctx, Handler: handler}
+}
+
+/* DeleteControlTraceSourcesTraceSourceIDRedactionPolicy swagger:route DELETE /control/traceSources/{traceSourceId}/redactionPolicy deleteControlTraceSourcesTraceSourceIdRedactionPolicy
+
+Delete the redaction policy of a Trace Source, the default policy applies again
+
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicy struct {
+	Context *middleware.Context
+	Handler DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+}
+
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
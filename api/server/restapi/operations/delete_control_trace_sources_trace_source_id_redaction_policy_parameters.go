@@ -0,0 +1,91 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams creates a new DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams() DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams {
+
+	return DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams{}
+}
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams contains all the bound params for the delete control trace sources trace source ID redaction policy operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeleteControlTraceSourcesTraceSourceIDRedactionPolicy
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Trace Source ID
+	  Required: true
+	  In: path
+	*/
+	TraceSourceID strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams() beforehand.
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rTraceSourceID, rhkTraceSourceID, _ := route.Params.GetOK("traceSourceId")
+	if err := o.bindTraceSourceID(rTraceSourceID, rhkTraceSourceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTraceSourceID binds and validates parameter TraceSourceID from path.
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) bindTraceSourceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("traceSourceId", "path", "strfmt.UUID", raw)
+	}
+	o.TraceSourceID = *(value.(*strfmt.UUID))
+
+	if err := o.validateTraceSourceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTraceSourceID carries on validations for parameter TraceSourceID
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) validateTraceSourceID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("traceSourceId", "path", "uuid", o.TraceSourceID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContentCode is the HTTP code returned for type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent
+const DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContentCode int = 204
+
+/*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent Success
+
+swagger:response deleteControlTraceSourcesTraceSourceIdRedactionPolicyNoContent
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent struct {
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent creates DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent {
+
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+// DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode is the HTTP code returned for type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound
+const DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode int = 404
+
+/*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound Trace Source not found
+
+swagger:response deleteControlTraceSourcesTraceSourceIdRedactionPolicyNotFound
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the delete control trace sources trace source Id redaction policy not found response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WithPayload(payload *models.APIResponse) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control trace sources trace source Id redaction policy not found response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault unknown error
+
+swagger:response deleteControlTraceSourcesTraceSourceIdRedactionPolicyDefault
+*/
+type DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete control trace sources trace source ID redaction policy default response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithStatusCode(code int) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete control trace sources trace source ID redaction policy default response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete control trace sources trace source ID redaction policy default response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithPayload(payload *models.APIResponse) *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control trace sources trace source ID redaction policy default response
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
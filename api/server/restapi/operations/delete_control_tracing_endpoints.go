@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteControlTracingEndpointsHandlerFunc turns a function with the right signature into a delete control tracing endpoints handler
+type DeleteControlTracingEndpointsHandlerFunc func(DeleteControlTracingEndpointsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteControlTracingEndpointsHandlerFunc) Handle(params DeleteControlTracingEndpointsParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteControlTracingEndpointsHandler interface for that can handle valid delete control tracing endpoints params
+type DeleteControlTracingEndpointsHandler interface {
+	Handle(DeleteControlTracingEndpointsParams) middleware.Responder
+}
+
+// NewDeleteControlTracingEndpoints creates a new http.Handler for the delete control tracing endpoints operation
+func NewDeleteControlTracingEndpoints(ctx *middleware.Context, handler DeleteControlTracingEndpointsHandler) *DeleteControlTracingEndpoints {
+	return &DeleteControlTracingEndpoints{Context: ctx, Handler: handler}
+}
+
+/* DeleteControlTracingEndpoints swagger:route DELETE /control/tracingEndpoints deleteControlTracingEndpoints
+
+Remove the restriction of the tracing of an API for a component, all its endpoints are traced again
+
+*/
+type DeleteControlTracingEndpoints struct {
+	Context *middleware.Context
+	Handler DeleteControlTracingEndpointsHandler
+}
+
+func (o *DeleteControlTracingEndpoints) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteControlTracingEndpointsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
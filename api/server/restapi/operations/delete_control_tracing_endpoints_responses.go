@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteControlTracingEndpointsNoContentCode is the HTTP code returned for type DeleteControlTracingEndpointsNoContent
+const DeleteControlTracingEndpointsNoContentCode int = 204
+
+/*DeleteControlTracingEndpointsNoContent Success
+
+swagger:response deleteControlTracingEndpointsNoContent
+*/
+type DeleteControlTracingEndpointsNoContent struct {
+}
+
+// NewDeleteControlTracingEndpointsNoContent creates DeleteControlTracingEndpointsNoContent with default headers values
+func NewDeleteControlTracingEndpointsNoContent() *DeleteControlTracingEndpointsNoContent {
+
+	return &DeleteControlTracingEndpointsNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTracingEndpointsNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteControlTracingEndpointsDefault unknown error
+
+swagger:response deleteControlTracingEndpointsDefault
+*/
+type DeleteControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlTracingEndpointsDefault creates DeleteControlTracingEndpointsDefault with default headers values
+func NewDeleteControlTracingEndpointsDefault(code int) *DeleteControlTracingEndpointsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete control tracing endpoints default response
+func (o *DeleteControlTracingEndpointsDefault) WithStatusCode(code int) *DeleteControlTracingEndpointsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete control tracing endpoints default response
+func (o *DeleteControlTracingEndpointsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete control tracing endpoints default response
+func (o *DeleteControlTracingEndpointsDefault) WithPayload(payload *models.APIResponse) *DeleteControlTracingEndpointsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control tracing endpoints default response
+func (o *DeleteControlTracingEndpointsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTracingEndpointsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
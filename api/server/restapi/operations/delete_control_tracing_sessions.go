@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// DeleteControlTracingSessionsHandlerFunc turns a function with the right signature into a delete control tracing sessions handler
+type DeleteControlTracingSessionsHandlerFunc func(DeleteControlTracingSessionsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteControlTracingSessionsHandlerFunc) Handle(params DeleteControlTracingSessionsParams) middleware.Responder {
+	return fn(params)
+}
+
+// DeleteControlTracingSessionsHandler interface for that can handle valid delete control tracing sessions params
+type DeleteControlTracingSessionsHandler interface {
+	Handle(DeleteControlTracingSessionsParams) middleware.Responder
+}
+
+// NewDeleteControlTracingSessions creates a new http.Handler for the delete control tracing sessions operation
+func NewDeleteControlTracingSessions(ctx *middleware.Context, handler DeleteControlTracingSessionsHandler) *DeleteControlTracingSessions {
+	return &DeleteControlTracingSessions{Context: ctx, Handler: handler}
+}
+
+/* DeleteControlTracingSessions swagger:route DELETE /control/tracingSessions deleteControlTracingSessions
+
+Stop a tracing session
+
+*/
+type DeleteControlTracingSessions struct {
+	Context *middleware.Context
+	Handler DeleteControlTracingSessionsHandler
+}
+
+func (o *DeleteControlTracingSessions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteControlTracingSessionsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
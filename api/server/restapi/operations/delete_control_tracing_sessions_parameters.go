@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewDeleteControlTracingSessionsParams creates a new DeleteControlTracingSessionsParams object
+//
+// There are no default values defined in the spec.
+func NewDeleteControlTracingSessionsParams() DeleteControlTracingSessionsParams {
+
+	return DeleteControlTracingSessionsParams{}
+}
+
+// DeleteControlTracingSessionsParams contains all the bound params for the delete control tracing sessions operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters DeleteControlTracingSessions
+type DeleteControlTracingSessionsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: query
+	*/
+	APIID uint32
+	/*
+	  Required: true
+	  In: query
+	*/
+	Component string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeleteControlTracingSessionsParams() beforehand.
+func (o *DeleteControlTracingSessionsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAPIID, qhkAPIID, _ := qs.GetOK("apiId")
+	if err := o.bindAPIID(qAPIID, qhkAPIID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qComponent, qhkComponent, _ := qs.GetOK("component")
+	if err := o.bindComponent(qComponent, qhkComponent, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAPIID binds and validates parameter APIID from query.
+func (o *DeleteControlTracingSessionsParams) bindAPIID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("apiId", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("apiId", "query", raw); err != nil {
+		return err
+	}
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("apiId", "query", "uint32", raw)
+	}
+	o.APIID = value
+
+	return nil
+}
+
+// bindComponent binds and validates parameter Component from query.
+func (o *DeleteControlTracingSessionsParams) bindComponent(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("component", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("component", "query", raw); err != nil {
+		return err
+	}
+	o.Component = raw
+
+	return nil
+}
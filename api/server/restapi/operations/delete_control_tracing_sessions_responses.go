@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// DeleteControlTracingSessionsNoContentCode is the HTTP code returned for type DeleteControlTracingSessionsNoContent
+const DeleteControlTracingSessionsNoContentCode int = 204
+
+/*DeleteControlTracingSessionsNoContent Success
+
+swagger:response deleteControlTracingSessionsNoContent
+*/
+type DeleteControlTracingSessionsNoContent struct {
+}
+
+// NewDeleteControlTracingSessionsNoContent creates DeleteControlTracingSessionsNoContent with default headers values
+func NewDeleteControlTracingSessionsNoContent() *DeleteControlTracingSessionsNoContent {
+
+	return &DeleteControlTracingSessionsNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTracingSessionsNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteControlTracingSessionsDefault unknown error
+
+swagger:response deleteControlTracingSessionsDefault
+*/
+type DeleteControlTracingSessionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewDeleteControlTracingSessionsDefault creates DeleteControlTracingSessionsDefault with default headers values
+func NewDeleteControlTracingSessionsDefault(code int) *DeleteControlTracingSessionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete control tracing sessions default response
+func (o *DeleteControlTracingSessionsDefault) WithStatusCode(code int) *DeleteControlTracingSessionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete control tracing sessions default response
+func (o *DeleteControlTracingSessionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete control tracing sessions default response
+func (o *DeleteControlTracingSessionsDefault) WithPayload(payload *models.APIResponse) *DeleteControlTracingSessionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete control tracing sessions default response
+func (o *DeleteControlTracingSessionsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteControlTracingSessionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAPIEventsEventIDGrpcMessagesHandlerFunc turns a function with the right signature into a get API events event ID grpc messages handler
+type GetAPIEventsEventIDGrpcMessagesHandlerFunc func(GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAPIEventsEventIDGrpcMessagesHandlerFunc) Handle(params GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAPIEventsEventIDGrpcMessagesHandler interface for that can handle valid get API events event ID grpc messages params
+type GetAPIEventsEventIDGrpcMessagesHandler interface {
+	Handle(GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder
+}
+
+// NewGetAPIEventsEventIDGrpcMessages creates a new http.Handler for the get API events event ID grpc messages operation
+func NewGetAPIEventsEventIDGrpcMessages(ctx *middleware.Context, handler GetAPIEventsEventIDGrpcMessagesHandler) *GetAPIEventsEventIDGrpcMessages {
+	return &GetAPIEventsEventIDGrpcMessages{Context: ctx, Handler: handler}
+}
+
+/* GetAPIEventsEventIDGrpcMessages swagger:route GET /apiEvents/{eventId}/grpcMessages getApiEventsEventIdGrpcMessages
+
+Get the decoded gRPC messages of an API event
+
+*/
+type GetAPIEventsEventIDGrpcMessages struct {
+	Context *middleware.Context
+	Handler GetAPIEventsEventIDGrpcMessagesHandler
+}
+
+func (o *GetAPIEventsEventIDGrpcMessages) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAPIEventsEventIDGrpcMessagesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetAPIEventsEventIDGrpcMessagesParams creates a new GetAPIEventsEventIDGrpcMessagesParams object
+//
+// There are no default values defined in the spec.
+func NewGetAPIEventsEventIDGrpcMessagesParams() GetAPIEventsEventIDGrpcMessagesParams {
+
+	return GetAPIEventsEventIDGrpcMessagesParams{}
+}
+
+// GetAPIEventsEventIDGrpcMessagesParams contains all the bound params for the get API events event ID grpc messages operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAPIEventsEventIDGrpcMessages
+type GetAPIEventsEventIDGrpcMessagesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*API event ID
+	  Required: true
+	  In: path
+	*/
+	EventID uint32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAPIEventsEventIDGrpcMessagesParams() beforehand.
+func (o *GetAPIEventsEventIDGrpcMessagesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rEventID, rhkEventID, _ := route.Params.GetOK("eventId")
+	if err := o.bindEventID(rEventID, rhkEventID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindEventID binds and validates parameter EventID from path.
+func (o *GetAPIEventsEventIDGrpcMessagesParams) bindEventID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("eventId", "path", "uint32", raw)
+	}
+	o.EventID = value
+
+	return nil
+}
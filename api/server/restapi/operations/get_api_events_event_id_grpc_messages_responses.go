@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIEventsEventIDGrpcMessagesOKCode is the HTTP code returned for type GetAPIEventsEventIDGrpcMessagesOK
+const GetAPIEventsEventIDGrpcMessagesOKCode int = 200
+
+/*GetAPIEventsEventIDGrpcMessagesOK Success
+
+swagger:response getApiEventsEventIdGrpcMessagesOK
+*/
+type GetAPIEventsEventIDGrpcMessagesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GrpcMessages `json:"body,omitempty"`
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesOK creates GetAPIEventsEventIDGrpcMessagesOK with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesOK() *GetAPIEventsEventIDGrpcMessagesOK {
+
+	return &GetAPIEventsEventIDGrpcMessagesOK{}
+}
+
+// WithPayload adds the payload to the get Api events event Id grpc messages o k response
+func (o *GetAPIEventsEventIDGrpcMessagesOK) WithPayload(payload *models.GrpcMessages) *GetAPIEventsEventIDGrpcMessagesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api events event Id grpc messages o k response
+func (o *GetAPIEventsEventIDGrpcMessagesOK) SetPayload(payload *models.GrpcMessages) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIEventsEventIDGrpcMessagesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAPIEventsEventIDGrpcMessagesNotFoundCode is the HTTP code returned for type GetAPIEventsEventIDGrpcMessagesNotFound
+const GetAPIEventsEventIDGrpcMessagesNotFoundCode int = 404
+
+/*GetAPIEventsEventIDGrpcMessagesNotFound The messages of the event were not decoded
+
+swagger:response getApiEventsEventIdGrpcMessagesNotFound
+*/
+type GetAPIEventsEventIDGrpcMessagesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesNotFound creates GetAPIEventsEventIDGrpcMessagesNotFound with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesNotFound() *GetAPIEventsEventIDGrpcMessagesNotFound {
+
+	return &GetAPIEventsEventIDGrpcMessagesNotFound{}
+}
+
+// WithPayload adds the payload to the get Api events event Id grpc messages not found response
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) WithPayload(payload *models.APIResponse) *GetAPIEventsEventIDGrpcMessagesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api events event Id grpc messages not found response
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIEventsEventIDGrpcMessagesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetAPIEventsEventIDGrpcMessagesDefault unknown error
+
+swagger:response getApiEventsEventIdGrpcMessagesDefault
+*/
+type GetAPIEventsEventIDGrpcMessagesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIEventsEventIDGrpcMessagesDefault creates GetAPIEventsEventIDGrpcMessagesDefault with default headers values
+func NewGetAPIEventsEventIDGrpcMessagesDefault(code int) *GetAPIEventsEventIDGrpcMessagesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAPIEventsEventIDGrpcMessagesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get API events event ID grpc messages default response
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) WithStatusCode(code int) *GetAPIEventsEventIDGrpcMessagesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get API events event ID grpc messages default response
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get API events event ID grpc messages default response
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) WithPayload(payload *models.APIResponse) *GetAPIEventsEventIDGrpcMessagesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get API events event ID grpc messages default response
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIEventsEventIDGrpcMessagesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
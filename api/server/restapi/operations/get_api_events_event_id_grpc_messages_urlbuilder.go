@@ -0,0 +1,101 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetAPIEventsEventIDGrpcMessagesURL generates an URL for the get API events event ID grpc messages operation
+type GetAPIEventsEventIDGrpcMessagesURL struct {
+	EventID uint32
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIEventsEventIDGrpcMessagesURL) WithBasePath(bp string) *GetAPIEventsEventIDGrpcMessagesURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIEventsEventIDGrpcMessagesURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetAPIEventsEventIDGrpcMessagesURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/apiEvents/{eventId}/grpcMessages"
+
+	eventID := swag.FormatUint32(o.EventID)
+	if eventID != "" {
+		_path = strings.Replace(_path, "{eventId}", eventID, -1)
+	} else {
+		return nil, errors.New("eventId is required on GetAPIEventsEventIDGrpcMessagesURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetAPIEventsEventIDGrpcMessagesURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetAPIEventsEventIDGrpcMessagesURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetAPIEventsEventIDGrpcMessagesURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetAPIEventsEventIDGrpcMessagesURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetAPIEventsEventIDGrpcMessagesURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetAPIEventsEventIDGrpcMessagesURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -77,6 +77,26 @@ type GetAPIEventsParams struct {
 	/*
 	  In: query
 	*/
+	GraphqlOperationNameIs []string
+	/*
+	  In: query
+	*/
+	GraphqlOperationTypeIs []string
+	/*Events selecting any of the root fields
+	  In: query
+	*/
+	GraphqlRootFieldIs []string
+	/*
+	  In: query
+	*/
+	GrpcMethodIs []string
+	/*
+	  In: query
+	*/
+	GrpcServiceIs []string
+	/*
+	  In: query
+	*/
 	HasSpecDiffIs *bool
 	/*
 	  In: query
@@ -235,6 +255,31 @@ func (o *GetAPIEventsParams) BindRequest(r *http.Request, route *middleware.Matc
 		res = append(res, err)
 	}
 
+	qGraphqlOperationNameIs, qhkGraphqlOperationNameIs, _ := qs.GetOK("graphqlOperationName[is]")
+	if err := o.bindGraphqlOperationNameIs(qGraphqlOperationNameIs, qhkGraphqlOperationNameIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGraphqlOperationTypeIs, qhkGraphqlOperationTypeIs, _ := qs.GetOK("graphqlOperationType[is]")
+	if err := o.bindGraphqlOperationTypeIs(qGraphqlOperationTypeIs, qhkGraphqlOperationTypeIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGraphqlRootFieldIs, qhkGraphqlRootFieldIs, _ := qs.GetOK("graphqlRootField[is]")
+	if err := o.bindGraphqlRootFieldIs(qGraphqlRootFieldIs, qhkGraphqlRootFieldIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGrpcMethodIs, qhkGrpcMethodIs, _ := qs.GetOK("grpcMethod[is]")
+	if err := o.bindGrpcMethodIs(qGrpcMethodIs, qhkGrpcMethodIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qGrpcServiceIs, qhkGrpcServiceIs, _ := qs.GetOK("grpcService[is]")
+	if err := o.bindGrpcServiceIs(qGrpcServiceIs, qhkGrpcServiceIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qHasSpecDiffIs, qhkHasSpecDiffIs, _ := qs.GetOK("hasSpecDiff[is]")
 	if err := o.bindHasSpecDiffIs(qHasSpecDiffIs, qhkHasSpecDiffIs, route.Formats); err != nil {
 		res = append(res, err)
@@ -594,6 +639,145 @@ func (o *GetAPIEventsParams) validateEndTime(formats strfmt.Registry) error {
 	return nil
 }
 
+// bindGraphqlOperationNameIs binds and validates array parameter GraphqlOperationNameIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIEventsParams) bindGraphqlOperationNameIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvGraphqlOperationNameIs string
+	if len(rawData) > 0 {
+		qvGraphqlOperationNameIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	graphqlOperationNameIsIC := swag.SplitByFormat(qvGraphqlOperationNameIs, "")
+	if len(graphqlOperationNameIsIC) == 0 {
+		return nil
+	}
+
+	var graphqlOperationNameIsIR []string
+	for _, graphqlOperationNameIsIV := range graphqlOperationNameIsIC {
+		graphqlOperationNameIsI := graphqlOperationNameIsIV
+
+		graphqlOperationNameIsIR = append(graphqlOperationNameIsIR, graphqlOperationNameIsI)
+	}
+
+	o.GraphqlOperationNameIs = graphqlOperationNameIsIR
+
+	return nil
+}
+
+// bindGraphqlOperationTypeIs binds and validates array parameter GraphqlOperationTypeIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIEventsParams) bindGraphqlOperationTypeIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvGraphqlOperationTypeIs string
+	if len(rawData) > 0 {
+		qvGraphqlOperationTypeIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	graphqlOperationTypeIsIC := swag.SplitByFormat(qvGraphqlOperationTypeIs, "")
+	if len(graphqlOperationTypeIsIC) == 0 {
+		return nil
+	}
+
+	var graphqlOperationTypeIsIR []string
+	for i, graphqlOperationTypeIsIV := range graphqlOperationTypeIsIC {
+		graphqlOperationTypeIsI := graphqlOperationTypeIsIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "graphqlOperationType[is]", i), "query", graphqlOperationTypeIsI, []interface{}{"QUERY", "MUTATION", "SUBSCRIPTION"}, true); err != nil {
+			return err
+		}
+
+		graphqlOperationTypeIsIR = append(graphqlOperationTypeIsIR, graphqlOperationTypeIsI)
+	}
+
+	o.GraphqlOperationTypeIs = graphqlOperationTypeIsIR
+
+	return nil
+}
+
+// bindGraphqlRootFieldIs binds and validates array parameter GraphqlRootFieldIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIEventsParams) bindGraphqlRootFieldIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvGraphqlRootFieldIs string
+	if len(rawData) > 0 {
+		qvGraphqlRootFieldIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	graphqlRootFieldIsIC := swag.SplitByFormat(qvGraphqlRootFieldIs, "")
+	if len(graphqlRootFieldIsIC) == 0 {
+		return nil
+	}
+
+	var graphqlRootFieldIsIR []string
+	for _, graphqlRootFieldIsIV := range graphqlRootFieldIsIC {
+		graphqlRootFieldIsI := graphqlRootFieldIsIV
+
+		graphqlRootFieldIsIR = append(graphqlRootFieldIsIR, graphqlRootFieldIsI)
+	}
+
+	o.GraphqlRootFieldIs = graphqlRootFieldIsIR
+
+	return nil
+}
+
+// bindGrpcMethodIs binds and validates array parameter GrpcMethodIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIEventsParams) bindGrpcMethodIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvGrpcMethodIs string
+	if len(rawData) > 0 {
+		qvGrpcMethodIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	grpcMethodIsIC := swag.SplitByFormat(qvGrpcMethodIs, "")
+	if len(grpcMethodIsIC) == 0 {
+		return nil
+	}
+
+	var grpcMethodIsIR []string
+	for _, grpcMethodIsIV := range grpcMethodIsIC {
+		grpcMethodIsI := grpcMethodIsIV
+
+		grpcMethodIsIR = append(grpcMethodIsIR, grpcMethodIsI)
+	}
+
+	o.GrpcMethodIs = grpcMethodIsIR
+
+	return nil
+}
+
+// bindGrpcServiceIs binds and validates array parameter GrpcServiceIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIEventsParams) bindGrpcServiceIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvGrpcServiceIs string
+	if len(rawData) > 0 {
+		qvGrpcServiceIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	grpcServiceIsIC := swag.SplitByFormat(qvGrpcServiceIs, "")
+	if len(grpcServiceIsIC) == 0 {
+		return nil
+	}
+
+	var grpcServiceIsIR []string
+	for _, grpcServiceIsIV := range grpcServiceIsIC {
+		grpcServiceIsI := grpcServiceIsIV
+
+		grpcServiceIsIR = append(grpcServiceIsIR, grpcServiceIsI)
+	}
+
+	o.GrpcServiceIs = grpcServiceIsIR
+
+	return nil
+}
+
 // bindHasSpecDiffIs binds and validates parameter HasSpecDiffIs from query.
 func (o *GetAPIEventsParams) bindHasSpecDiffIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
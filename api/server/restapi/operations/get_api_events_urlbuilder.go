@@ -16,39 +16,44 @@ import (
 
 // GetAPIEventsURL generates an URL for the get API events operation
 type GetAPIEventsURL struct {
-	AlertTypeIs          []string
-	AlertIs              []string
-	APIInfoIDIs          *uint32
-	DestinationIPIsNot   []string
-	DestinationIPIs      []string
-	DestinationPortIsNot []string
-	DestinationPortIs    []string
-	EndTime              strfmt.DateTime
-	HasSpecDiffIs        *bool
-	MethodIs             []string
-	Page                 int64
-	PageSize             int64
-	PathContains         []string
-	PathEnd              *string
-	PathIsNot            []string
-	PathIs               []string
-	PathStart            *string
-	ShowNonAPI           bool
-	SortDir              *string
-	SortKey              string
-	SourceIPIsNot        []string
-	SourceIPIs           []string
-	SpecDiffTypeIs       []string
-	SpecContains         []string
-	SpecEnd              *string
-	SpecIsNot            []string
-	SpecIs               []string
-	SpecStart            *string
-	StartTime            strfmt.DateTime
-	StatusCodeGte        *string
-	StatusCodeIsNot      []string
-	StatusCodeIs         []string
-	StatusCodeLte        *string
+	AlertTypeIs            []string
+	AlertIs                []string
+	APIInfoIDIs            *uint32
+	DestinationIPIsNot     []string
+	DestinationIPIs        []string
+	DestinationPortIsNot   []string
+	DestinationPortIs      []string
+	EndTime                strfmt.DateTime
+	GraphqlOperationNameIs []string
+	GraphqlOperationTypeIs []string
+	GraphqlRootFieldIs     []string
+	GrpcMethodIs           []string
+	GrpcServiceIs          []string
+	HasSpecDiffIs          *bool
+	MethodIs               []string
+	Page                   int64
+	PageSize               int64
+	PathContains           []string
+	PathEnd                *string
+	PathIsNot              []string
+	PathIs                 []string
+	PathStart              *string
+	ShowNonAPI             bool
+	SortDir                *string
+	SortKey                string
+	SourceIPIsNot          []string
+	SourceIPIs             []string
+	SpecDiffTypeIs         []string
+	SpecContains           []string
+	SpecEnd                *string
+	SpecIsNot              []string
+	SpecIs                 []string
+	SpecStart              *string
+	StartTime              strfmt.DateTime
+	StatusCodeGte          *string
+	StatusCodeIsNot        []string
+	StatusCodeIs           []string
+	StatusCodeLte          *string
 
 	_basePath string
 	// avoid unkeyed usage
@@ -199,6 +204,91 @@ func (o *GetAPIEventsURL) Build() (*url.URL, error) {
 		qs.Set("endTime", endTimeQ)
 	}
 
+	var graphqlOperationNameIsIR []string
+	for _, graphqlOperationNameIsI := range o.GraphqlOperationNameIs {
+		graphqlOperationNameIsIS := graphqlOperationNameIsI
+		if graphqlOperationNameIsIS != "" {
+			graphqlOperationNameIsIR = append(graphqlOperationNameIsIR, graphqlOperationNameIsIS)
+		}
+	}
+
+	graphqlOperationNameIs := swag.JoinByFormat(graphqlOperationNameIsIR, "")
+
+	if len(graphqlOperationNameIs) > 0 {
+		qsv := graphqlOperationNameIs[0]
+		if qsv != "" {
+			qs.Set("graphqlOperationName[is]", qsv)
+		}
+	}
+
+	var graphqlOperationTypeIsIR []string
+	for _, graphqlOperationTypeIsI := range o.GraphqlOperationTypeIs {
+		graphqlOperationTypeIsIS := graphqlOperationTypeIsI
+		if graphqlOperationTypeIsIS != "" {
+			graphqlOperationTypeIsIR = append(graphqlOperationTypeIsIR, graphqlOperationTypeIsIS)
+		}
+	}
+
+	graphqlOperationTypeIs := swag.JoinByFormat(graphqlOperationTypeIsIR, "")
+
+	if len(graphqlOperationTypeIs) > 0 {
+		qsv := graphqlOperationTypeIs[0]
+		if qsv != "" {
+			qs.Set("graphqlOperationType[is]", qsv)
+		}
+	}
+
+	var graphqlRootFieldIsIR []string
+	for _, graphqlRootFieldIsI := range o.GraphqlRootFieldIs {
+		graphqlRootFieldIsIS := graphqlRootFieldIsI
+		if graphqlRootFieldIsIS != "" {
+			graphqlRootFieldIsIR = append(graphqlRootFieldIsIR, graphqlRootFieldIsIS)
+		}
+	}
+
+	graphqlRootFieldIs := swag.JoinByFormat(graphqlRootFieldIsIR, "")
+
+	if len(graphqlRootFieldIs) > 0 {
+		qsv := graphqlRootFieldIs[0]
+		if qsv != "" {
+			qs.Set("graphqlRootField[is]", qsv)
+		}
+	}
+
+	var grpcMethodIsIR []string
+	for _, grpcMethodIsI := range o.GrpcMethodIs {
+		grpcMethodIsIS := grpcMethodIsI
+		if grpcMethodIsIS != "" {
+			grpcMethodIsIR = append(grpcMethodIsIR, grpcMethodIsIS)
+		}
+	}
+
+	grpcMethodIs := swag.JoinByFormat(grpcMethodIsIR, "")
+
+	if len(grpcMethodIs) > 0 {
+		qsv := grpcMethodIs[0]
+		if qsv != "" {
+			qs.Set("grpcMethod[is]", qsv)
+		}
+	}
+
+	var grpcServiceIsIR []string
+	for _, grpcServiceIsI := range o.GrpcServiceIs {
+		grpcServiceIsIS := grpcServiceIsI
+		if grpcServiceIsIS != "" {
+			grpcServiceIsIR = append(grpcServiceIsIR, grpcServiceIsIS)
+		}
+	}
+
+	grpcServiceIs := swag.JoinByFormat(grpcServiceIsIR, "")
+
+	if len(grpcServiceIs) > 0 {
+		qsv := grpcServiceIs[0]
+		if qsv != "" {
+			qs.Set("grpcService[is]", qsv)
+		}
+	}
+
 	var hasSpecDiffIsQ string
 	if o.HasSpecDiffIs != nil {
 		hasSpecDiffIsQ = swag.FormatBool(*o.HasSpecDiffIs)
@@ -0,0 +1,180 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIFindingsHandlerFunc turns a function with the right signature into a get API findings handler
+type GetAPIFindingsHandlerFunc func(GetAPIFindingsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAPIFindingsHandlerFunc) Handle(params GetAPIFindingsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAPIFindingsHandler interface for that can handle valid get API findings params
+type GetAPIFindingsHandler interface {
+	Handle(GetAPIFindingsParams) middleware.Responder
+}
+
+// NewGetAPIFindings creates a new http.Handler for the get API findings operation
+func NewGetAPIFindings(ctx *middleware.Context, handler GetAPIFindingsHandler) *GetAPIFindings {
+	return &GetAPIFindings{Context: ctx, Handler: handler}
+}
+
+/* GetAPIFindings swagger:route GET /apiFindings getApiFindings
+
+Get the API findings of all the modules
+
+The findings notified by the modules, each notification of a module replaces its findings on the API
+
+*/
+type GetAPIFindings struct {
+	Context *middleware.Context
+	Handler GetAPIFindingsHandler
+}
+
+func (o *GetAPIFindings) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAPIFindingsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// GetAPIFindingsOKBody get API findings o k body
+//
+// swagger:model GetAPIFindingsOKBody
+type GetAPIFindingsOKBody struct {
+
+	// List of filtered findings in the given page. List length must be lower or equal to pageSize
+	Items []*models.APIFinding `json:"items"`
+
+	// Total filtered findings count
+	// Required: true
+	Total *int64 `json:"total"`
+}
+
+// Validate validates this get API findings o k body
+func (o *GetAPIFindingsOKBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateItems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.validateTotal(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) validateItems(formats strfmt.Registry) error {
+	if swag.IsZero(o.Items) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(o.Items); i++ {
+		if swag.IsZero(o.Items[i]) { // not required
+			continue
+		}
+
+		if o.Items[i] != nil {
+			if err := o.Items[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getApiFindingsOK" + "." + "items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) validateTotal(formats strfmt.Registry) error {
+
+	if err := validate.Required("getApiFindingsOK"+"."+"total", "body", o.Total); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this get API findings o k body based on the context it is used
+func (o *GetAPIFindingsOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateItems(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *GetAPIFindingsOKBody) contextValidateItems(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(o.Items); i++ {
+
+		if o.Items[i] != nil {
+			if err := o.Items[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("getApiFindingsOK" + "." + "items" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *GetAPIFindingsOKBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *GetAPIFindingsOKBody) UnmarshalBinary(b []byte) error {
+	var res GetAPIFindingsOKBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
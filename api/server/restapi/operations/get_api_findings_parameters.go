@@ -0,0 +1,539 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetAPIFindingsParams creates a new GetAPIFindingsParams object
+// with the default values initialized.
+func NewGetAPIFindingsParams() GetAPIFindingsParams {
+
+	var (
+		// initialize parameters with default values
+
+		sortDirDefault = string("ASC")
+	)
+
+	return GetAPIFindingsParams{
+		SortDir: &sortDirDefault,
+	}
+}
+
+// GetAPIFindingsParams contains all the bound params for the get API findings operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAPIFindings
+type GetAPIFindingsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	APIInfoIDIs *uint32
+	/*Only the findings seen until this time are returned
+	  In: query
+	*/
+	EndTime *strfmt.DateTime
+	/*
+	  In: query
+	*/
+	ModuleNameIs []string
+	/*Page number of the query
+	  Required: true
+	  In: query
+	*/
+	Page int64
+	/*Maximum items to return
+	  Required: true
+	  Maximum: 50
+	  Minimum: 1
+	  In: query
+	*/
+	PageSize int64
+	/*
+	  In: query
+	*/
+	SeverityIs []string
+	/*Sorting direction
+	  In: query
+	  Default: "ASC"
+	*/
+	SortDir *string
+	/*Sort key
+	  Required: true
+	  In: query
+	*/
+	SortKey string
+	/*Location of the finding in the provided or reconstructed spec
+	  In: query
+	*/
+	SpecLocationIs []string
+	/*Prefix of the location of the finding in the provided or reconstructed spec
+	  In: query
+	*/
+	SpecLocationStart *string
+	/*Only the findings seen since this time are returned
+	  In: query
+	*/
+	StartTime *strfmt.DateTime
+	/*
+	  In: query
+	*/
+	TypeIs []string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAPIFindingsParams() beforehand.
+func (o *GetAPIFindingsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAPIInfoIDIs, qhkAPIInfoIDIs, _ := qs.GetOK("apiInfoId[is]")
+	if err := o.bindAPIInfoIDIs(qAPIInfoIDIs, qhkAPIInfoIDIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEndTime, qhkEndTime, _ := qs.GetOK("endTime")
+	if err := o.bindEndTime(qEndTime, qhkEndTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qModuleNameIs, qhkModuleNameIs, _ := qs.GetOK("moduleName[is]")
+	if err := o.bindModuleNameIs(qModuleNameIs, qhkModuleNameIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPage, qhkPage, _ := qs.GetOK("page")
+	if err := o.bindPage(qPage, qhkPage, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPageSize, qhkPageSize, _ := qs.GetOK("pageSize")
+	if err := o.bindPageSize(qPageSize, qhkPageSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSeverityIs, qhkSeverityIs, _ := qs.GetOK("severity[is]")
+	if err := o.bindSeverityIs(qSeverityIs, qhkSeverityIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortDir, qhkSortDir, _ := qs.GetOK("sortDir")
+	if err := o.bindSortDir(qSortDir, qhkSortDir, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSortKey, qhkSortKey, _ := qs.GetOK("sortKey")
+	if err := o.bindSortKey(qSortKey, qhkSortKey, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSpecLocationIs, qhkSpecLocationIs, _ := qs.GetOK("specLocation[is]")
+	if err := o.bindSpecLocationIs(qSpecLocationIs, qhkSpecLocationIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSpecLocationStart, qhkSpecLocationStart, _ := qs.GetOK("specLocation[start]")
+	if err := o.bindSpecLocationStart(qSpecLocationStart, qhkSpecLocationStart, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStartTime, qhkStartTime, _ := qs.GetOK("startTime")
+	if err := o.bindStartTime(qStartTime, qhkStartTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTypeIs, qhkTypeIs, _ := qs.GetOK("type[is]")
+	if err := o.bindTypeIs(qTypeIs, qhkTypeIs, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAPIInfoIDIs binds and validates parameter APIInfoIDIs from query.
+func (o *GetAPIFindingsParams) bindAPIInfoIDIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("apiInfoId[is]", "query", "uint32", raw)
+	}
+	o.APIInfoIDIs = &value
+
+	return nil
+}
+
+// bindEndTime binds and validates parameter EndTime from query.
+func (o *GetAPIFindingsParams) bindEndTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("endTime", "query", "strfmt.DateTime", raw)
+	}
+	o.EndTime = (value.(*strfmt.DateTime))
+
+	if err := o.validateEndTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEndTime carries on validations for parameter EndTime
+func (o *GetAPIFindingsParams) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("endTime", "query", "date-time", o.EndTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindModuleNameIs binds and validates array parameter ModuleNameIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIFindingsParams) bindModuleNameIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvModuleNameIs string
+	if len(rawData) > 0 {
+		qvModuleNameIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	moduleNameIsIC := swag.SplitByFormat(qvModuleNameIs, "")
+	if len(moduleNameIsIC) == 0 {
+		return nil
+	}
+
+	var moduleNameIsIR []string
+	for _, moduleNameIsIV := range moduleNameIsIC {
+		moduleNameIsI := moduleNameIsIV
+
+		moduleNameIsIR = append(moduleNameIsIR, moduleNameIsI)
+	}
+
+	o.ModuleNameIs = moduleNameIsIR
+
+	return nil
+}
+
+// bindPage binds and validates parameter Page from query.
+func (o *GetAPIFindingsParams) bindPage(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("page", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("page", "query", raw); err != nil {
+		return err
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("page", "query", "int64", raw)
+	}
+	o.Page = value
+
+	return nil
+}
+
+// bindPageSize binds and validates parameter PageSize from query.
+func (o *GetAPIFindingsParams) bindPageSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("pageSize", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("pageSize", "query", raw); err != nil {
+		return err
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("pageSize", "query", "int64", raw)
+	}
+	o.PageSize = value
+
+	if err := o.validatePageSize(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePageSize carries on validations for parameter PageSize
+func (o *GetAPIFindingsParams) validatePageSize(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("pageSize", "query", o.PageSize, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("pageSize", "query", o.PageSize, 50, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSeverityIs binds and validates array parameter SeverityIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIFindingsParams) bindSeverityIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvSeverityIs string
+	if len(rawData) > 0 {
+		qvSeverityIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	severityIsIC := swag.SplitByFormat(qvSeverityIs, "")
+	if len(severityIsIC) == 0 {
+		return nil
+	}
+
+	var severityIsIR []string
+	for i, severityIsIV := range severityIsIC {
+		severityIsI := severityIsIV
+
+		if err := validate.EnumCase(fmt.Sprintf("%s.%v", "severity[is]", i), "query", severityIsI, []interface{}{"INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"}, true); err != nil {
+			return err
+		}
+
+		severityIsIR = append(severityIsIR, severityIsI)
+	}
+
+	o.SeverityIs = severityIsIR
+
+	return nil
+}
+
+// bindSortDir binds and validates parameter SortDir from query.
+func (o *GetAPIFindingsParams) bindSortDir(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetAPIFindingsParams()
+		return nil
+	}
+	o.SortDir = &raw
+
+	if err := o.validateSortDir(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortDir carries on validations for parameter SortDir
+func (o *GetAPIFindingsParams) validateSortDir(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sortDir", "query", *o.SortDir, []interface{}{"ASC", "DESC"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSortKey binds and validates parameter SortKey from query.
+func (o *GetAPIFindingsParams) bindSortKey(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("sortKey", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("sortKey", "query", raw); err != nil {
+		return err
+	}
+	o.SortKey = raw
+
+	if err := o.validateSortKey(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSortKey carries on validations for parameter SortKey
+func (o *GetAPIFindingsParams) validateSortKey(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("sortKey", "query", o.SortKey, []interface{}{"severity", "firstSeen", "lastSeen", "type", "moduleName", "apiInfoId"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSpecLocationIs binds and validates array parameter SpecLocationIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIFindingsParams) bindSpecLocationIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvSpecLocationIs string
+	if len(rawData) > 0 {
+		qvSpecLocationIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	specLocationIsIC := swag.SplitByFormat(qvSpecLocationIs, "")
+	if len(specLocationIsIC) == 0 {
+		return nil
+	}
+
+	var specLocationIsIR []string
+	for _, specLocationIsIV := range specLocationIsIC {
+		specLocationIsI := specLocationIsIV
+
+		specLocationIsIR = append(specLocationIsIR, specLocationIsI)
+	}
+
+	o.SpecLocationIs = specLocationIsIR
+
+	return nil
+}
+
+// bindSpecLocationStart binds and validates parameter SpecLocationStart from query.
+func (o *GetAPIFindingsParams) bindSpecLocationStart(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.SpecLocationStart = &raw
+
+	return nil
+}
+
+// bindStartTime binds and validates parameter StartTime from query.
+func (o *GetAPIFindingsParams) bindStartTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("startTime", "query", "strfmt.DateTime", raw)
+	}
+	o.StartTime = (value.(*strfmt.DateTime))
+
+	if err := o.validateStartTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStartTime carries on validations for parameter StartTime
+func (o *GetAPIFindingsParams) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("startTime", "query", "date-time", o.StartTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTypeIs binds and validates array parameter TypeIs from query.
+//
+// Arrays are parsed according to CollectionFormat: "" (defaults to "csv" when empty).
+func (o *GetAPIFindingsParams) bindTypeIs(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var qvTypeIs string
+	if len(rawData) > 0 {
+		qvTypeIs = rawData[len(rawData)-1]
+	}
+
+	// CollectionFormat:
+	typeIsIC := swag.SplitByFormat(qvTypeIs, "")
+	if len(typeIsIC) == 0 {
+		return nil
+	}
+
+	var typeIsIR []string
+	for _, typeIsIV := range typeIsIC {
+		typeIsI := typeIsIV
+
+		typeIsIR = append(typeIsIR, typeIsI)
+	}
+
+	o.TypeIs = typeIsIR
+
+	return nil
+}
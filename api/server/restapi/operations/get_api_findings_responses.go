@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIFindingsOKCode is the HTTP code returned for type GetAPIFindingsOK
+const GetAPIFindingsOKCode int = 200
+
+/*GetAPIFindingsOK Success
+
+swagger:response getApiFindingsOK
+*/
+type GetAPIFindingsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *GetAPIFindingsOKBody `json:"body,omitempty"`
+}
+
+// NewGetAPIFindingsOK creates GetAPIFindingsOK with default headers values
+func NewGetAPIFindingsOK() *GetAPIFindingsOK {
+
+	return &GetAPIFindingsOK{}
+}
+
+// WithPayload adds the payload to the get Api findings o k response
+func (o *GetAPIFindingsOK) WithPayload(payload *GetAPIFindingsOKBody) *GetAPIFindingsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api findings o k response
+func (o *GetAPIFindingsOK) SetPayload(payload *GetAPIFindingsOKBody) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIFindingsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetAPIFindingsDefault unknown error
+
+swagger:response getApiFindingsDefault
+*/
+type GetAPIFindingsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIFindingsDefault creates GetAPIFindingsDefault with default headers values
+func NewGetAPIFindingsDefault(code int) *GetAPIFindingsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAPIFindingsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get API findings default response
+func (o *GetAPIFindingsDefault) WithStatusCode(code int) *GetAPIFindingsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get API findings default response
+func (o *GetAPIFindingsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get API findings default response
+func (o *GetAPIFindingsDefault) WithPayload(payload *models.APIResponse) *GetAPIFindingsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get API findings default response
+func (o *GetAPIFindingsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIFindingsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
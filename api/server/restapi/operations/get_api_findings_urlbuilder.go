@@ -0,0 +1,232 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GetAPIFindingsURL generates an URL for the get API findings operation
+type GetAPIFindingsURL struct {
+	APIInfoIDIs       *uint32
+	EndTime           *strfmt.DateTime
+	ModuleNameIs      []string
+	Page              int64
+	PageSize          int64
+	SeverityIs        []string
+	SortDir           *string
+	SortKey           string
+	SpecLocationIs    []string
+	SpecLocationStart *string
+	StartTime         *strfmt.DateTime
+	TypeIs            []string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIFindingsURL) WithBasePath(bp string) *GetAPIFindingsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIFindingsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetAPIFindingsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/apiFindings"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var aPIInfoIDIsQ string
+	if o.APIInfoIDIs != nil {
+		aPIInfoIDIsQ = swag.FormatUint32(*o.APIInfoIDIs)
+	}
+	if aPIInfoIDIsQ != "" {
+		qs.Set("apiInfoId[is]", aPIInfoIDIsQ)
+	}
+
+	var endTimeQ string
+	if o.EndTime != nil {
+		endTimeQ = o.EndTime.String()
+	}
+	if endTimeQ != "" {
+		qs.Set("endTime", endTimeQ)
+	}
+
+	var moduleNameIsIR []string
+	for _, moduleNameIsI := range o.ModuleNameIs {
+		moduleNameIsIS := moduleNameIsI
+		if moduleNameIsIS != "" {
+			moduleNameIsIR = append(moduleNameIsIR, moduleNameIsIS)
+		}
+	}
+
+	moduleNameIs := swag.JoinByFormat(moduleNameIsIR, "")
+
+	if len(moduleNameIs) > 0 {
+		qsv := moduleNameIs[0]
+		if qsv != "" {
+			qs.Set("moduleName[is]", qsv)
+		}
+	}
+
+	pageQ := swag.FormatInt64(o.Page)
+	if pageQ != "" {
+		qs.Set("page", pageQ)
+	}
+
+	pageSizeQ := swag.FormatInt64(o.PageSize)
+	if pageSizeQ != "" {
+		qs.Set("pageSize", pageSizeQ)
+	}
+
+	var severityIsIR []string
+	for _, severityIsI := range o.SeverityIs {
+		severityIsIS := severityIsI
+		if severityIsIS != "" {
+			severityIsIR = append(severityIsIR, severityIsIS)
+		}
+	}
+
+	severityIs := swag.JoinByFormat(severityIsIR, "")
+
+	if len(severityIs) > 0 {
+		qsv := severityIs[0]
+		if qsv != "" {
+			qs.Set("severity[is]", qsv)
+		}
+	}
+
+	var sortDirQ string
+	if o.SortDir != nil {
+		sortDirQ = *o.SortDir
+	}
+	if sortDirQ != "" {
+		qs.Set("sortDir", sortDirQ)
+	}
+
+	sortKeyQ := o.SortKey
+	if sortKeyQ != "" {
+		qs.Set("sortKey", sortKeyQ)
+	}
+
+	var specLocationIsIR []string
+	for _, specLocationIsI := range o.SpecLocationIs {
+		specLocationIsIS := specLocationIsI
+		if specLocationIsIS != "" {
+			specLocationIsIR = append(specLocationIsIR, specLocationIsIS)
+		}
+	}
+
+	specLocationIs := swag.JoinByFormat(specLocationIsIR, "")
+
+	if len(specLocationIs) > 0 {
+		qsv := specLocationIs[0]
+		if qsv != "" {
+			qs.Set("specLocation[is]", qsv)
+		}
+	}
+
+	var specLocationStartQ string
+	if o.SpecLocationStart != nil {
+		specLocationStartQ = *o.SpecLocationStart
+	}
+	if specLocationStartQ != "" {
+		qs.Set("specLocation[start]", specLocationStartQ)
+	}
+
+	var startTimeQ string
+	if o.StartTime != nil {
+		startTimeQ = o.StartTime.String()
+	}
+	if startTimeQ != "" {
+		qs.Set("startTime", startTimeQ)
+	}
+
+	var typeIsIR []string
+	for _, typeIsI := range o.TypeIs {
+		typeIsIS := typeIsI
+		if typeIsIS != "" {
+			typeIsIR = append(typeIsIR, typeIsIS)
+		}
+	}
+
+	typeIs := swag.JoinByFormat(typeIsIR, "")
+
+	if len(typeIs) > 0 {
+		qsv := typeIs[0]
+		if qsv != "" {
+			qs.Set("type[is]", qsv)
+		}
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetAPIFindingsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetAPIFindingsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetAPIFindingsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetAPIFindingsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetAPIFindingsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetAPIFindingsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAPIInventoryAPIIDGraphqlOperationsHandlerFunc turns a function with the right signature into a get API inventory API ID graphql operations handler
+type GetAPIInventoryAPIIDGraphqlOperationsHandlerFunc func(GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAPIInventoryAPIIDGraphqlOperationsHandlerFunc) Handle(params GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAPIInventoryAPIIDGraphqlOperationsHandler interface for that can handle valid get API inventory API ID graphql operations params
+type GetAPIInventoryAPIIDGraphqlOperationsHandler interface {
+	Handle(GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperations creates a new http.Handler for the get API inventory API ID graphql operations operation
+func NewGetAPIInventoryAPIIDGraphqlOperations(ctx *middleware.Context, handler GetAPIInventoryAPIIDGraphqlOperationsHandler) *GetAPIInventoryAPIIDGraphqlOperations {
+	return &GetAPIInventoryAPIIDGraphqlOperations{Context: ctx, Handler: handler}
+}
+
+/* GetAPIInventoryAPIIDGraphqlOperations swagger:route GET /apiInventory/{apiId}/graphql/operations getApiInventoryApiIdGraphqlOperations
+
+Get the usage of the GraphQL operations of an API within a selected timeframe
+
+*/
+type GetAPIInventoryAPIIDGraphqlOperations struct {
+	Context *middleware.Context
+	Handler GetAPIInventoryAPIIDGraphqlOperationsHandler
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlOperations) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAPIInventoryAPIIDGraphqlOperationsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
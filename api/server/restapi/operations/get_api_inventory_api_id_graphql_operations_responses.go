@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIInventoryAPIIDGraphqlOperationsOKCode is the HTTP code returned for type GetAPIInventoryAPIIDGraphqlOperationsOK
+const GetAPIInventoryAPIIDGraphqlOperationsOKCode int = 200
+
+/*GetAPIInventoryAPIIDGraphqlOperationsOK Success
+
+swagger:response getApiInventoryApiIdGraphqlOperationsOK
+*/
+type GetAPIInventoryAPIIDGraphqlOperationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.GraphQLOperationUsage `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsOK creates GetAPIInventoryAPIIDGraphqlOperationsOK with default headers values
+func NewGetAPIInventoryAPIIDGraphqlOperationsOK() *GetAPIInventoryAPIIDGraphqlOperationsOK {
+
+	return &GetAPIInventoryAPIIDGraphqlOperationsOK{}
+}
+
+// WithPayload adds the payload to the get Api inventory Api Id graphql operations o k response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) WithPayload(payload []*models.GraphQLOperationUsage) *GetAPIInventoryAPIIDGraphqlOperationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api inventory Api Id graphql operations o k response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) SetPayload(payload []*models.GraphQLOperationUsage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGraphqlOperationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.GraphQLOperationUsage, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetAPIInventoryAPIIDGraphqlOperationsDefault unknown error
+
+swagger:response getApiInventoryApiIdGraphqlOperationsDefault
+*/
+type GetAPIInventoryAPIIDGraphqlOperationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGraphqlOperationsDefault creates GetAPIInventoryAPIIDGraphqlOperationsDefault with default headers values
+func NewGetAPIInventoryAPIIDGraphqlOperationsDefault(code int) *GetAPIInventoryAPIIDGraphqlOperationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAPIInventoryAPIIDGraphqlOperationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get API inventory API ID graphql operations default response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) WithStatusCode(code int) *GetAPIInventoryAPIIDGraphqlOperationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get API inventory API ID graphql operations default response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get API inventory API ID graphql operations default response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) WithPayload(payload *models.APIResponse) *GetAPIInventoryAPIIDGraphqlOperationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get API inventory API ID graphql operations default response
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGraphqlOperationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
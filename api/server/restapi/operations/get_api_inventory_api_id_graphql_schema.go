@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAPIInventoryAPIIDGraphqlSchemaHandlerFunc turns a function with the right signature into a get API inventory API ID graphql schema handler
+type GetAPIInventoryAPIIDGraphqlSchemaHandlerFunc func(GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAPIInventoryAPIIDGraphqlSchemaHandlerFunc) Handle(params GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAPIInventoryAPIIDGraphqlSchemaHandler interface for that can handle valid get API inventory API ID graphql schema params
+type GetAPIInventoryAPIIDGraphqlSchemaHandler interface {
+	Handle(GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchema creates a new http.Handler for the get API inventory API ID graphql schema operation
+func NewGetAPIInventoryAPIIDGraphqlSchema(ctx *middleware.Context, handler GetAPIInventoryAPIIDGraphqlSchemaHandler) *GetAPIInventoryAPIIDGraphqlSchema {
+	return &GetAPIInventoryAPIIDGraphqlSchema{Context: ctx, Handler: handler}
+}
+
+/* GetAPIInventoryAPIIDGraphqlSchema swagger:route GET /apiInventory/{apiId}/graphql/schema getApiInventoryApiIdGraphqlSchema
+
+Get the GraphQL schema reconstructed from the traffic of an API
+
+*/
+type GetAPIInventoryAPIIDGraphqlSchema struct {
+	Context *middleware.Context
+	Handler GetAPIInventoryAPIIDGraphqlSchemaHandler
+}
+
+func (o *GetAPIInventoryAPIIDGraphqlSchema) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAPIInventoryAPIIDGraphqlSchemaParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
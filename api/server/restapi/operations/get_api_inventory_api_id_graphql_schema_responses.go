@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIInventoryAPIIDGraphqlSchemaOKCode is the HTTP code returned for type GetAPIInventoryAPIIDGraphqlSchemaOK
+const GetAPIInventoryAPIIDGraphqlSchemaOKCode int = 200
+
+/*GetAPIInventoryAPIIDGraphqlSchemaOK Success
+
+swagger:response getApiInventoryApiIdGraphqlSchemaOK
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GraphQLSchema `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaOK creates GetAPIInventoryAPIIDGraphqlSchemaOK with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaOK() *GetAPIInventoryAPIIDGraphqlSchemaOK {
+
+	return &GetAPIInventoryAPIIDGraphqlSchemaOK{}
+}
+
+// WithPayload adds the payload to the get Api inventory Api Id graphql schema o k response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) WithPayload(payload *models.GraphQLSchema) *GetAPIInventoryAPIIDGraphqlSchemaOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api inventory Api Id graphql schema o k response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) SetPayload(payload *models.GraphQLSchema) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGraphqlSchemaOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetAPIInventoryAPIIDGraphqlSchemaNotFoundCode is the HTTP code returned for type GetAPIInventoryAPIIDGraphqlSchemaNotFound
+const GetAPIInventoryAPIIDGraphqlSchemaNotFoundCode int = 404
+
+/*GetAPIInventoryAPIIDGraphqlSchemaNotFound No GraphQL traffic for this API
+
+swagger:response getApiInventoryApiIdGraphqlSchemaNotFound
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaNotFound creates GetAPIInventoryAPIIDGraphqlSchemaNotFound with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaNotFound() *GetAPIInventoryAPIIDGraphqlSchemaNotFound {
+
+	return &GetAPIInventoryAPIIDGraphqlSchemaNotFound{}
+}
+
+// WithPayload adds the payload to the get Api inventory Api Id graphql schema not found response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) WithPayload(payload *models.APIResponse) *GetAPIInventoryAPIIDGraphqlSchemaNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api inventory Api Id graphql schema not found response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGraphqlSchemaNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetAPIInventoryAPIIDGraphqlSchemaDefault unknown error
+
+swagger:response getApiInventoryApiIdGraphqlSchemaDefault
+*/
+type GetAPIInventoryAPIIDGraphqlSchemaDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGraphqlSchemaDefault creates GetAPIInventoryAPIIDGraphqlSchemaDefault with default headers values
+func NewGetAPIInventoryAPIIDGraphqlSchemaDefault(code int) *GetAPIInventoryAPIIDGraphqlSchemaDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAPIInventoryAPIIDGraphqlSchemaDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get API inventory API ID graphql schema default response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) WithStatusCode(code int) *GetAPIInventoryAPIIDGraphqlSchemaDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get API inventory API ID graphql schema default response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get API inventory API ID graphql schema default response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) WithPayload(payload *models.APIResponse) *GetAPIInventoryAPIIDGraphqlSchemaDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get API inventory API ID graphql schema default response
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGraphqlSchemaDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
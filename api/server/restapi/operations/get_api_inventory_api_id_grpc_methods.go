@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetAPIInventoryAPIIDGrpcMethodsHandlerFunc turns a function with the right signature into a get API inventory API ID grpc methods handler
+type GetAPIInventoryAPIIDGrpcMethodsHandlerFunc func(GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetAPIInventoryAPIIDGrpcMethodsHandlerFunc) Handle(params GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetAPIInventoryAPIIDGrpcMethodsHandler interface for that can handle valid get API inventory API ID grpc methods params
+type GetAPIInventoryAPIIDGrpcMethodsHandler interface {
+	Handle(GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethods creates a new http.Handler for the get API inventory API ID grpc methods operation
+func NewGetAPIInventoryAPIIDGrpcMethods(ctx *middleware.Context, handler GetAPIInventoryAPIIDGrpcMethodsHandler) *GetAPIInventoryAPIIDGrpcMethods {
+	return &GetAPIInventoryAPIIDGrpcMethods{Context: ctx, Handler: handler}
+}
+
+/* GetAPIInventoryAPIIDGrpcMethods swagger:route GET /apiInventory/{apiId}/grpc/methods getApiInventoryApiIdGrpcMethods
+
+Get the gRPC methods of an API and their usage within a selected timeframe
+
+The methods of the uploaded descriptor set that were not called are included with a zero count
+
+*/
+type GetAPIInventoryAPIIDGrpcMethods struct {
+	Context *middleware.Context
+	Handler GetAPIInventoryAPIIDGrpcMethodsHandler
+}
+
+func (o *GetAPIInventoryAPIIDGrpcMethods) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetAPIInventoryAPIIDGrpcMethodsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,181 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetAPIInventoryAPIIDGrpcMethodsParams creates a new GetAPIInventoryAPIIDGrpcMethodsParams object
+//
+// There are no default values defined in the spec.
+func NewGetAPIInventoryAPIIDGrpcMethodsParams() GetAPIInventoryAPIIDGrpcMethodsParams {
+
+	return GetAPIInventoryAPIIDGrpcMethodsParams{}
+}
+
+// GetAPIInventoryAPIIDGrpcMethodsParams contains all the bound params for the get API inventory API ID grpc methods operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetAPIInventoryAPIIDGrpcMethods
+type GetAPIInventoryAPIIDGrpcMethodsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	APIID uint32
+	/*End time of the query
+	  Required: true
+	  In: query
+	*/
+	EndTime strfmt.DateTime
+	/*Start time of the query
+	  Required: true
+	  In: query
+	*/
+	StartTime strfmt.DateTime
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetAPIInventoryAPIIDGrpcMethodsParams() beforehand.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	rAPIID, rhkAPIID, _ := route.Params.GetOK("apiId")
+	if err := o.bindAPIID(rAPIID, rhkAPIID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qEndTime, qhkEndTime, _ := qs.GetOK("endTime")
+	if err := o.bindEndTime(qEndTime, qhkEndTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStartTime, qhkStartTime, _ := qs.GetOK("startTime")
+	if err := o.bindStartTime(qStartTime, qhkStartTime, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAPIID binds and validates parameter APIID from path.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) bindAPIID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("apiId", "path", "uint32", raw)
+	}
+	o.APIID = value
+
+	return nil
+}
+
+// bindEndTime binds and validates parameter EndTime from query.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) bindEndTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("endTime", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("endTime", "query", raw); err != nil {
+		return err
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("endTime", "query", "strfmt.DateTime", raw)
+	}
+	o.EndTime = *(value.(*strfmt.DateTime))
+
+	if err := o.validateEndTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateEndTime carries on validations for parameter EndTime
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) validateEndTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("endTime", "query", "date-time", o.EndTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindStartTime binds and validates parameter StartTime from query.
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) bindStartTime(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	if !hasKey {
+		return errors.Required("startTime", "query", rawData)
+	}
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// AllowEmptyValue: false
+
+	if err := validate.RequiredString("startTime", "query", raw); err != nil {
+		return err
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("startTime", "query", "strfmt.DateTime", raw)
+	}
+	o.StartTime = *(value.(*strfmt.DateTime))
+
+	if err := o.validateStartTime(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStartTime carries on validations for parameter StartTime
+func (o *GetAPIInventoryAPIIDGrpcMethodsParams) validateStartTime(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("startTime", "query", "date-time", o.StartTime.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
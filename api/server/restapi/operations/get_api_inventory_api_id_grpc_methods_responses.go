@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetAPIInventoryAPIIDGrpcMethodsOKCode is the HTTP code returned for type GetAPIInventoryAPIIDGrpcMethodsOK
+const GetAPIInventoryAPIIDGrpcMethodsOKCode int = 200
+
+/*GetAPIInventoryAPIIDGrpcMethodsOK Success
+
+swagger:response getApiInventoryApiIdGrpcMethodsOK
+*/
+type GetAPIInventoryAPIIDGrpcMethodsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.GrpcMethodUsage `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsOK creates GetAPIInventoryAPIIDGrpcMethodsOK with default headers values
+func NewGetAPIInventoryAPIIDGrpcMethodsOK() *GetAPIInventoryAPIIDGrpcMethodsOK {
+
+	return &GetAPIInventoryAPIIDGrpcMethodsOK{}
+}
+
+// WithPayload adds the payload to the get Api inventory Api Id grpc methods o k response
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) WithPayload(payload []*models.GrpcMethodUsage) *GetAPIInventoryAPIIDGrpcMethodsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get Api inventory Api Id grpc methods o k response
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) SetPayload(payload []*models.GrpcMethodUsage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGrpcMethodsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.GrpcMethodUsage, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetAPIInventoryAPIIDGrpcMethodsDefault unknown error
+
+swagger:response getApiInventoryApiIdGrpcMethodsDefault
+*/
+type GetAPIInventoryAPIIDGrpcMethodsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetAPIInventoryAPIIDGrpcMethodsDefault creates GetAPIInventoryAPIIDGrpcMethodsDefault with default headers values
+func NewGetAPIInventoryAPIIDGrpcMethodsDefault(code int) *GetAPIInventoryAPIIDGrpcMethodsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetAPIInventoryAPIIDGrpcMethodsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get API inventory API ID grpc methods default response
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) WithStatusCode(code int) *GetAPIInventoryAPIIDGrpcMethodsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get API inventory API ID grpc methods default response
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get API inventory API ID grpc methods default response
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) WithPayload(payload *models.APIResponse) *GetAPIInventoryAPIIDGrpcMethodsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get API inventory API ID grpc methods default response
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetAPIInventoryAPIIDGrpcMethodsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GetAPIInventoryAPIIDGrpcMethodsURL generates an URL for the get API inventory API ID grpc methods operation
+type GetAPIInventoryAPIIDGrpcMethodsURL struct {
+	APIID uint32
+
+	EndTime   strfmt.DateTime
+	StartTime strfmt.DateTime
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) WithBasePath(bp string) *GetAPIInventoryAPIIDGrpcMethodsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/apiInventory/{apiId}/grpc/methods"
+
+	aPIID := swag.FormatUint32(o.APIID)
+	if aPIID != "" {
+		_path = strings.Replace(_path, "{apiId}", aPIID, -1)
+	} else {
+		return nil, errors.New("apiId is required on GetAPIInventoryAPIIDGrpcMethodsURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/api"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	endTimeQ := o.EndTime.String()
+	if endTimeQ != "" {
+		qs.Set("endTime", endTimeQ)
+	}
+
+	startTimeQ := o.StartTime.String()
+	if startTimeQ != "" {
+		qs.Set("startTime", startTimeQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetAPIInventoryAPIIDGrpcMethodsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetAPIInventoryAPIIDGrpcMethodsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetAPIInventoryAPIIDGrpcMethodsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
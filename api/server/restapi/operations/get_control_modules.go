@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlModulesHandlerFunc turns a function with the right signature into a get control modules handler
+type GetControlModulesHandlerFunc func(GetControlModulesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlModulesHandlerFunc) Handle(params GetControlModulesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlModulesHandler interface for that can handle valid get control modules params
+type GetControlModulesHandler interface {
+	Handle(GetControlModulesParams) middleware.Responder
+}
+
+// NewGetControlModules creates a new http.Handler for the get control modules operation
+func NewGetControlModules(ctx *middleware.Context, handler GetControlModulesHandler) *GetControlModules {
+	return &GetControlModules{Context: ctx, Handler: handler}
+}
+
+/* GetControlModules swagger:route GET /control/modules getControlModules
+
+Get the list of modules with their status
+
+*/
+type GetControlModules struct {
+	Context *middleware.Context
+	Handler GetControlModulesHandler
+}
+
+func (o *GetControlModules) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlModulesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlModulesOKCode is the HTTP code returned for type GetControlModulesOK
+const GetControlModulesOKCode int = 200
+
+/*GetControlModulesOK Success
+
+swagger:response getControlModulesOK
+*/
+type GetControlModulesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ModuleStatus `json:"body,omitempty"`
+}
+
+// NewGetControlModulesOK creates GetControlModulesOK with default headers values
+func NewGetControlModulesOK() *GetControlModulesOK {
+
+	return &GetControlModulesOK{}
+}
+
+// WithPayload adds the payload to the get control modules o k response
+func (o *GetControlModulesOK) WithPayload(payload []*models.ModuleStatus) *GetControlModulesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control modules o k response
+func (o *GetControlModulesOK) SetPayload(payload []*models.ModuleStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlModulesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ModuleStatus, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetControlModulesDefault unknown error
+
+swagger:response getControlModulesDefault
+*/
+type GetControlModulesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlModulesDefault creates GetControlModulesDefault with default headers values
+func NewGetControlModulesDefault(code int) *GetControlModulesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlModulesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control modules default response
+func (o *GetControlModulesDefault) WithStatusCode(code int) *GetControlModulesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control modules default response
+func (o *GetControlModulesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control modules default response
+func (o *GetControlModulesDefault) WithPayload(payload *models.APIResponse) *GetControlModulesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control modules default response
+func (o *GetControlModulesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlModulesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlRedactionPolicyHandlerFunc turns a function with the right signature into a get control redaction policy handler
+type GetControlRedactionPolicyHandlerFunc func(GetControlRedactionPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlRedactionPolicyHandlerFunc) Handle(params GetControlRedactionPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlRedactionPolicyHandler interface for that can handle valid get control redaction policy params
+type GetControlRedactionPolicyHandler interface {
+	Handle(GetControlRedactionPolicyParams) middleware.Responder
+}
+
+// NewGetControlRedactionPolicy creates a new http.Handler for the get control redaction policy operation
+func NewGetControlRedactionPolicy(ctx *middleware.Context, handler GetControlRedactionPolicyHandler) *GetControlRedactionPolicy {
+	return &GetControlRedactionPolicy{Context: ctx, Handler: handler}
+}
+
+/* GetControlRedactionPolicy swagger:route GET /control/redactionPolicy getControlRedactionPolicy
+
+Get the default redaction policy
+
+The default policy applies to the trace sources without their own policy
+
+*/
+type GetControlRedactionPolicy struct {
+	Context *middleware.Context
+	Handler GetControlRedactionPolicyHandler
+}
+
+func (o *GetControlRedactionPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlRedactionPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
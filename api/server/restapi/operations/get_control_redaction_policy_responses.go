@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlRedactionPolicyOKCode is the HTTP code returned for type GetControlRedactionPolicyOK
+const GetControlRedactionPolicyOKCode int = 200
+
+/*GetControlRedactionPolicyOK Success
+
+swagger:response getControlRedactionPolicyOK
+*/
+type GetControlRedactionPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RedactionPolicy `json:"body,omitempty"`
+}
+
+// NewGetControlRedactionPolicyOK creates GetControlRedactionPolicyOK with default headers values
+func NewGetControlRedactionPolicyOK() *GetControlRedactionPolicyOK {
+
+	return &GetControlRedactionPolicyOK{}
+}
+
+// WithPayload adds the payload to the get control redaction policy o k response
+func (o *GetControlRedactionPolicyOK) WithPayload(payload *models.RedactionPolicy) *GetControlRedactionPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control redaction policy o k response
+func (o *GetControlRedactionPolicyOK) SetPayload(payload *models.RedactionPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlRedactionPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetControlRedactionPolicyDefault unknown error
+
+swagger:response getControlRedactionPolicyDefault
+*/
+type GetControlRedactionPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlRedactionPolicyDefault creates GetControlRedactionPolicyDefault with default headers values
+func NewGetControlRedactionPolicyDefault(code int) *GetControlRedactionPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control redaction policy default response
+func (o *GetControlRedactionPolicyDefault) WithStatusCode(code int) *GetControlRedactionPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control redaction policy default response
+func (o *GetControlRedactionPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control redaction policy default response
+func (o *GetControlRedactionPolicyDefault) WithPayload(payload *models.APIResponse) *GetControlRedactionPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control redaction policy default response
+func (o *GetControlRedactionPolicyDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlRedactionPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
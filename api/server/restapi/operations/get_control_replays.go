@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlReplaysHandlerFunc turns a function with the right signature into a get control replays handler
+type GetControlReplaysHandlerFunc func(GetControlReplaysParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlReplaysHandlerFunc) Handle(params GetControlReplaysParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlReplaysHandler interface for that can handle valid get control replays params
+type GetControlReplaysHandler interface {
+	Handle(GetControlReplaysParams) middleware.Responder
+}
+
+// NewGetControlReplays creates a new http.Handler for the get control replays operation
+func NewGetControlReplays(ctx *middleware.Context, handler GetControlReplaysHandler) *GetControlReplays {
+	return &GetControlReplays{Context: ctx, Handler: handler}
+}
+
+/* GetControlReplays swagger:route GET /control/replays getControlReplays
+
+Get the replays of stored events through modules
+
+*/
+type GetControlReplays struct {
+	Context *middleware.Context
+	Handler GetControlReplaysHandler
+}
+
+func (o *GetControlReplays) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlReplaysParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlReplaysReplayIDHandlerFunc turns a function with the right signature into a get control replays replay ID handler
+type GetControlReplaysReplayIDHandlerFunc func(GetControlReplaysReplayIDParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlReplaysReplayIDHandlerFunc) Handle(params GetControlReplaysReplayIDParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlReplaysReplayIDHandler interface for that can handle valid get control replays replay ID params
+type GetControlReplaysReplayIDHandler interface {
+	Handle(GetControlReplaysReplayIDParams) middleware.Responder
+}
+
+// NewGetControlReplaysReplayID creates a new http.Handler for the get control replays replay ID operation
+func NewGetControlReplaysReplayID(ctx *middleware.Context, handler GetControlReplaysReplayIDHandler) *GetControlReplaysReplayID {
+	return &GetControlReplaysReplayID{Context: ctx, Handler: handler}
+}
+
+/* GetControlReplaysReplayID swagger:route GET /control/replays/{replayId} getControlReplaysReplayId
+
+Get the progress of a replay
+
+*/
+type GetControlReplaysReplayID struct {
+	Context *middleware.Context
+	Handler GetControlReplaysReplayIDHandler
+}
+
+func (o *GetControlReplaysReplayID) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlReplaysReplayIDParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
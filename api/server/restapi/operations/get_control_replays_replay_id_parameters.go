@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetControlReplaysReplayIDParams creates a new GetControlReplaysReplayIDParams object
+//
+// There are no default values defined in the spec.
+func NewGetControlReplaysReplayIDParams() GetControlReplaysReplayIDParams {
+
+	return GetControlReplaysReplayIDParams{}
+}
+
+// GetControlReplaysReplayIDParams contains all the bound params for the get control replays replay ID operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetControlReplaysReplayID
+type GetControlReplaysReplayIDParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ReplayID uint32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetControlReplaysReplayIDParams() beforehand.
+func (o *GetControlReplaysReplayIDParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rReplayID, rhkReplayID, _ := route.Params.GetOK("replayId")
+	if err := o.bindReplayID(rReplayID, rhkReplayID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindReplayID binds and validates parameter ReplayID from path.
+func (o *GetControlReplaysReplayIDParams) bindReplayID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("replayId", "path", "uint32", raw)
+	}
+	o.ReplayID = value
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlReplaysReplayIDOKCode is the HTTP code returned for type GetControlReplaysReplayIDOK
+const GetControlReplaysReplayIDOKCode int = 200
+
+/*GetControlReplaysReplayIDOK Success
+
+swagger:response getControlReplaysReplayIdOK
+*/
+type GetControlReplaysReplayIDOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Replay `json:"body,omitempty"`
+}
+
+// NewGetControlReplaysReplayIDOK creates GetControlReplaysReplayIDOK with default headers values
+func NewGetControlReplaysReplayIDOK() *GetControlReplaysReplayIDOK {
+
+	return &GetControlReplaysReplayIDOK{}
+}
+
+// WithPayload adds the payload to the get control replays replay Id o k response
+func (o *GetControlReplaysReplayIDOK) WithPayload(payload *models.Replay) *GetControlReplaysReplayIDOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control replays replay Id o k response
+func (o *GetControlReplaysReplayIDOK) SetPayload(payload *models.Replay) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlReplaysReplayIDOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetControlReplaysReplayIDNotFoundCode is the HTTP code returned for type GetControlReplaysReplayIDNotFound
+const GetControlReplaysReplayIDNotFoundCode int = 404
+
+/*GetControlReplaysReplayIDNotFound Replay not found
+
+swagger:response getControlReplaysReplayIdNotFound
+*/
+type GetControlReplaysReplayIDNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlReplaysReplayIDNotFound creates GetControlReplaysReplayIDNotFound with default headers values
+func NewGetControlReplaysReplayIDNotFound() *GetControlReplaysReplayIDNotFound {
+
+	return &GetControlReplaysReplayIDNotFound{}
+}
+
+// WithPayload adds the payload to the get control replays replay Id not found response
+func (o *GetControlReplaysReplayIDNotFound) WithPayload(payload *models.APIResponse) *GetControlReplaysReplayIDNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control replays replay Id not found response
+func (o *GetControlReplaysReplayIDNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlReplaysReplayIDNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetControlReplaysReplayIDDefault unknown error
+
+swagger:response getControlReplaysReplayIdDefault
+*/
+type GetControlReplaysReplayIDDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlReplaysReplayIDDefault creates GetControlReplaysReplayIDDefault with default headers values
+func NewGetControlReplaysReplayIDDefault(code int) *GetControlReplaysReplayIDDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlReplaysReplayIDDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control replays replay ID default response
+func (o *GetControlReplaysReplayIDDefault) WithStatusCode(code int) *GetControlReplaysReplayIDDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control replays replay ID default response
+func (o *GetControlReplaysReplayIDDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control replays replay ID default response
+func (o *GetControlReplaysReplayIDDefault) WithPayload(payload *models.APIResponse) *GetControlReplaysReplayIDDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control replays replay ID default response
+func (o *GetControlReplaysReplayIDDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlReplaysReplayIDDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
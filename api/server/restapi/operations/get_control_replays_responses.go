@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlReplaysOKCode is the HTTP code returned for type GetControlReplaysOK
+const GetControlReplaysOKCode int = 200
+
+/*GetControlReplaysOK Success
+
+swagger:response getControlReplaysOK
+*/
+type GetControlReplaysOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Replay `json:"body,omitempty"`
+}
+
+// NewGetControlReplaysOK creates GetControlReplaysOK with default headers values
+func NewGetControlReplaysOK() *GetControlReplaysOK {
+
+	return &GetControlReplaysOK{}
+}
+
+// WithPayload adds the payload to the get control replays o k response
+func (o *GetControlReplaysOK) WithPayload(payload []*models.Replay) *GetControlReplaysOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control replays o k response
+func (o *GetControlReplaysOK) SetPayload(payload []*models.Replay) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlReplaysOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Replay, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetControlReplaysDefault unknown error
+
+swagger:response getControlReplaysDefault
+*/
+type GetControlReplaysDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlReplaysDefault creates GetControlReplaysDefault with default headers values
+func NewGetControlReplaysDefault(code int) *GetControlReplaysDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlReplaysDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control replays default response
+func (o *GetControlReplaysDefault) WithStatusCode(code int) *GetControlReplaysDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control replays default response
+func (o *GetControlReplaysDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control replays default response
+func (o *GetControlReplaysDefault) WithPayload(payload *models.APIResponse) *GetControlReplaysDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control replays default response
+func (o *GetControlReplaysDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlReplaysDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlSamplingRatesHandlerFunc turns a function with the right signature into a get control sampling rates handler
+type GetControlSamplingRatesHandlerFunc func(GetControlSamplingRatesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlSamplingRatesHandlerFunc) Handle(params GetControlSamplingRatesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlSamplingRatesHandler interface for that can handle valid get control sampling rates params
+type GetControlSamplingRatesHandler interface {
+	Handle(GetControlSamplingRatesParams) middleware.Responder
+}
+
+// NewGetControlSamplingRates creates a new http.Handler for the get control sampling rates operation
+func NewGetControlSamplingRates(ctx *middleware.Context, handler GetControlSamplingRatesHandler) *GetControlSamplingRates {
+	return &GetControlSamplingRates{Context: ctx, Handler: handler}
+}
+
+/* GetControlSamplingRates swagger:route GET /control/samplingRates getControlSamplingRates
+
+List the sampling rates of the APIs
+
+*/
+type GetControlSamplingRates struct {
+	Context *middleware.Context
+	Handler GetControlSamplingRatesHandler
+}
+
+func (o *GetControlSamplingRates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlSamplingRatesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
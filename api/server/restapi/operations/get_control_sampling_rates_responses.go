@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlSamplingRatesOKCode is the HTTP code returned for type GetControlSamplingRatesOK
+const GetControlSamplingRatesOKCode int = 200
+
+/*GetControlSamplingRatesOK Success
+
+swagger:response getControlSamplingRatesOK
+*/
+type GetControlSamplingRatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.SamplingRate `json:"body,omitempty"`
+}
+
+// NewGetControlSamplingRatesOK creates GetControlSamplingRatesOK with default headers values
+func NewGetControlSamplingRatesOK() *GetControlSamplingRatesOK {
+
+	return &GetControlSamplingRatesOK{}
+}
+
+// WithPayload adds the payload to the get control sampling rates o k response
+func (o *GetControlSamplingRatesOK) WithPayload(payload []*models.SamplingRate) *GetControlSamplingRatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control sampling rates o k response
+func (o *GetControlSamplingRatesOK) SetPayload(payload []*models.SamplingRate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlSamplingRatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.SamplingRate, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetControlSamplingRatesDefault unknown error
+
+swagger:response getControlSamplingRatesDefault
+*/
+type GetControlSamplingRatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlSamplingRatesDefault creates GetControlSamplingRatesDefault with default headers values
+func NewGetControlSamplingRatesDefault(code int) *GetControlSamplingRatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control sampling rates default response
+func (o *GetControlSamplingRatesDefault) WithStatusCode(code int) *GetControlSamplingRatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control sampling rates default response
+func (o *GetControlSamplingRatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control sampling rates default response
+func (o *GetControlSamplingRatesDefault) WithPayload(payload *models.APIResponse) *GetControlSamplingRatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control sampling rates default response
+func (o *GetControlSamplingRatesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlSamplingRatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc turns a function with the right signature into a get control trace sources trace source ID redaction policy handler
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc func(GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc) Handle(params GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface for that can handle valid get control trace sources trace source ID redaction policy params
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface {
+	Handle(GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicy creates a new http.Handler for the get control trace sources trace source ID redaction policy operation
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicy(ctx *middleware.Context, handler GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler) *GetControlTraceSourcesTraceSourceIDRedactionPolicy {
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicy{Context: ctx, Handler: handler}
+}
+
+/* GetControlTraceSourcesTraceSourceIDRedactionPolicy swagger:route GET /control/traceSources/{traceSourceId}/redactionPolicy getControlTraceSourcesTraceSourceIdRedactionPolicy
+
+Get the redaction policy of a Trace Source
+
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicy struct {
+	Context *middleware.Context
+	Handler GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+}
+
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlTraceSourcesTraceSourceIDRedactionPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
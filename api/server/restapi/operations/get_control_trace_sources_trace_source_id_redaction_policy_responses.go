@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlTraceSourcesTraceSourceIDRedactionPolicyOKCode is the HTTP code returned for type GetControlTraceSourcesTraceSourceIDRedactionPolicyOK
+const GetControlTraceSourcesTraceSourceIDRedactionPolicyOKCode int = 200
+
+/*GetControlTraceSourcesTraceSourceIDRedactionPolicyOK Success
+
+swagger:response getControlTraceSourcesTraceSourceIdRedactionPolicyOK
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RedactionPolicy `json:"body,omitempty"`
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK creates GetControlTraceSourcesTraceSourceIDRedactionPolicyOK with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK() *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyOK{}
+}
+
+// WithPayload adds the payload to the get control trace sources trace source Id redaction policy o k response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) WithPayload(payload *models.RedactionPolicy) *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control trace sources trace source Id redaction policy o k response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) SetPayload(payload *models.RedactionPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode is the HTTP code returned for type GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound
+const GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode int = 404
+
+/*GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound Trace Source not found or without its own redaction policy
+
+swagger:response getControlTraceSourcesTraceSourceIdRedactionPolicyNotFound
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the get control trace sources trace source Id redaction policy not found response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WithPayload(payload *models.APIResponse) *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control trace sources trace source Id redaction policy not found response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault unknown error
+
+swagger:response getControlTraceSourcesTraceSourceIdRedactionPolicyDefault
+*/
+type GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control trace sources trace source ID redaction policy default response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithStatusCode(code int) *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control trace sources trace source ID redaction policy default response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control trace sources trace source ID redaction policy default response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithPayload(payload *models.APIResponse) *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control trace sources trace source ID redaction policy default response
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
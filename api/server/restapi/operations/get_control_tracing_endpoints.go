@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlTracingEndpointsHandlerFunc turns a function with the right signature into a get control tracing endpoints handler
+type GetControlTracingEndpointsHandlerFunc func(GetControlTracingEndpointsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlTracingEndpointsHandlerFunc) Handle(params GetControlTracingEndpointsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlTracingEndpointsHandler interface for that can handle valid get control tracing endpoints params
+type GetControlTracingEndpointsHandler interface {
+	Handle(GetControlTracingEndpointsParams) middleware.Responder
+}
+
+// NewGetControlTracingEndpoints creates a new http.Handler for the get control tracing endpoints operation
+func NewGetControlTracingEndpoints(ctx *middleware.Context, handler GetControlTracingEndpointsHandler) *GetControlTracingEndpoints {
+	return &GetControlTracingEndpoints{Context: ctx, Handler: handler}
+}
+
+/* GetControlTracingEndpoints swagger:route GET /control/tracingEndpoints getControlTracingEndpoints
+
+List the endpoints the tracing of the APIs is restricted to
+
+*/
+type GetControlTracingEndpoints struct {
+	Context *middleware.Context
+	Handler GetControlTracingEndpointsHandler
+}
+
+func (o *GetControlTracingEndpoints) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlTracingEndpointsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
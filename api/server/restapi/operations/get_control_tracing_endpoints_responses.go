@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlTracingEndpointsOKCode is the HTTP code returned for type GetControlTracingEndpointsOK
+const GetControlTracingEndpointsOKCode int = 200
+
+/*GetControlTracingEndpointsOK Success
+
+swagger:response getControlTracingEndpointsOK
+*/
+type GetControlTracingEndpointsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.TracingEndpoints `json:"body,omitempty"`
+}
+
+// NewGetControlTracingEndpointsOK creates GetControlTracingEndpointsOK with default headers values
+func NewGetControlTracingEndpointsOK() *GetControlTracingEndpointsOK {
+
+	return &GetControlTracingEndpointsOK{}
+}
+
+// WithPayload adds the payload to the get control tracing endpoints o k response
+func (o *GetControlTracingEndpointsOK) WithPayload(payload []*models.TracingEndpoints) *GetControlTracingEndpointsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control tracing endpoints o k response
+func (o *GetControlTracingEndpointsOK) SetPayload(payload []*models.TracingEndpoints) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTracingEndpointsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.TracingEndpoints, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetControlTracingEndpointsDefault unknown error
+
+swagger:response getControlTracingEndpointsDefault
+*/
+type GetControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlTracingEndpointsDefault creates GetControlTracingEndpointsDefault with default headers values
+func NewGetControlTracingEndpointsDefault(code int) *GetControlTracingEndpointsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control tracing endpoints default response
+func (o *GetControlTracingEndpointsDefault) WithStatusCode(code int) *GetControlTracingEndpointsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control tracing endpoints default response
+func (o *GetControlTracingEndpointsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control tracing endpoints default response
+func (o *GetControlTracingEndpointsDefault) WithPayload(payload *models.APIResponse) *GetControlTracingEndpointsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control tracing endpoints default response
+func (o *GetControlTracingEndpointsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTracingEndpointsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetControlTracingSessionsHandlerFunc turns a function with the right signature into a get control tracing sessions handler
+type GetControlTracingSessionsHandlerFunc func(GetControlTracingSessionsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetControlTracingSessionsHandlerFunc) Handle(params GetControlTracingSessionsParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetControlTracingSessionsHandler interface for that can handle valid get control tracing sessions params
+type GetControlTracingSessionsHandler interface {
+	Handle(GetControlTracingSessionsParams) middleware.Responder
+}
+
+// NewGetControlTracingSessions creates a new http.Handler for the get control tracing sessions operation
+func NewGetControlTracingSessions(ctx *middleware.Context, handler GetControlTracingSessionsHandler) *GetControlTracingSessions {
+	return &GetControlTracingSessions{Context: ctx, Handler: handler}
+}
+
+/* GetControlTracingSessions swagger:route GET /control/tracingSessions getControlTracingSessions
+
+List the active tracing sessions of the modules
+
+*/
+type GetControlTracingSessions struct {
+	Context *middleware.Context
+	Handler GetControlTracingSessionsHandler
+}
+
+func (o *GetControlTracingSessions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetControlTracingSessionsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
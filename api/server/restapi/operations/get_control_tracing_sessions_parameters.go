@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetControlTracingSessionsParams creates a new GetControlTracingSessionsParams object
+//
+// There are no default values defined in the spec.
+func NewGetControlTracingSessionsParams() GetControlTracingSessionsParams {
+
+	return GetControlTracingSessionsParams{}
+}
+
+// GetControlTracingSessionsParams contains all the bound params for the get control tracing sessions operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetControlTracingSessions
+type GetControlTracingSessionsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	APIID *uint32
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetControlTracingSessionsParams() beforehand.
+func (o *GetControlTracingSessionsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAPIID, qhkAPIID, _ := qs.GetOK("apiId")
+	if err := o.bindAPIID(qAPIID, qhkAPIID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAPIID binds and validates parameter APIID from query.
+func (o *GetControlTracingSessionsParams) bindAPIID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertUint32(raw)
+	if err != nil {
+		return errors.InvalidType("apiId", "query", "uint32", raw)
+	}
+	o.APIID = &value
+
+	return nil
+}
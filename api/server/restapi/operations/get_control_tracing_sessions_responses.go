@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// GetControlTracingSessionsOKCode is the HTTP code returned for type GetControlTracingSessionsOK
+const GetControlTracingSessionsOKCode int = 200
+
+/*GetControlTracingSessionsOK Success
+
+swagger:response getControlTracingSessionsOK
+*/
+type GetControlTracingSessionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.TracingSession `json:"body,omitempty"`
+}
+
+// NewGetControlTracingSessionsOK creates GetControlTracingSessionsOK with default headers values
+func NewGetControlTracingSessionsOK() *GetControlTracingSessionsOK {
+
+	return &GetControlTracingSessionsOK{}
+}
+
+// WithPayload adds the payload to the get control tracing sessions o k response
+func (o *GetControlTracingSessionsOK) WithPayload(payload []*models.TracingSession) *GetControlTracingSessionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control tracing sessions o k response
+func (o *GetControlTracingSessionsOK) SetPayload(payload []*models.TracingSession) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTracingSessionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.TracingSession, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetControlTracingSessionsDefault unknown error
+
+swagger:response getControlTracingSessionsDefault
+*/
+type GetControlTracingSessionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetControlTracingSessionsDefault creates GetControlTracingSessionsDefault with default headers values
+func NewGetControlTracingSessionsDefault(code int) *GetControlTracingSessionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get control tracing sessions default response
+func (o *GetControlTracingSessionsDefault) WithStatusCode(code int) *GetControlTracingSessionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get control tracing sessions default response
+func (o *GetControlTracingSessionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get control tracing sessions default response
+func (o *GetControlTracingSessionsDefault) WithPayload(payload *models.APIResponse) *GetControlTracingSessionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get control tracing sessions default response
+func (o *GetControlTracingSessionsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetControlTracingSessionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
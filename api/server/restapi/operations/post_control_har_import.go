@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostControlHarImportHandlerFunc turns a function with the right signature into a post control har import handler
+type PostControlHarImportHandlerFunc func(PostControlHarImportParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostControlHarImportHandlerFunc) Handle(params PostControlHarImportParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostControlHarImportHandler interface for that can handle valid post control har import params
+type PostControlHarImportHandler interface {
+	Handle(PostControlHarImportParams) middleware.Responder
+}
+
+// NewPostControlHarImport creates a new http.Handler for the post control har import operation
+func NewPostControlHarImport(ctx *middleware.Context, handler PostControlHarImportHandler) *PostControlHarImport {
+	return &PostControlHarImport{Context: ctx, Handler: handler}
+}
+
+/* PostControlHarImport swagger:route POST /control/harImport postControlHarImport
+
+Import the traffic of a HAR archive
+
+Each entry of the HTTP Archive is handled like a trace received from a trace source, populating the API inventory, the reconstructed specs and the modules findings.
+
+*/
+type PostControlHarImport struct {
+	Context *middleware.Context
+	Handler PostControlHarImportHandler
+}
+
+func (o *PostControlHarImport) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostControlHarImportParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
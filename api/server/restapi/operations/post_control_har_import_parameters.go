@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewPostControlHarImportParams creates a new PostControlHarImportParams object
+//
+// There are no default values defined in the spec.
+func NewPostControlHarImportParams() PostControlHarImportParams {
+
+	return PostControlHarImportParams{}
+}
+
+// PostControlHarImportParams contains all the bound params for the post control har import operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostControlHarImport
+type PostControlHarImportParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*HAR 1.2 archive
+	  Required: true
+	  In: body
+	*/
+	Body interface{}
+	/*Trace Source under which the traffic is imported. If not set, the traffic is imported under the default trace source of APIClarity
+	  In: query
+	*/
+	TraceSourceID *strfmt.UUID
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostControlHarImportParams() beforehand.
+func (o *PostControlHarImportParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body interface{}
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// no validation on generic interface
+			o.Body = body
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+
+	qTraceSourceID, qhkTraceSourceID, _ := qs.GetOK("traceSourceId")
+	if err := o.bindTraceSourceID(qTraceSourceID, qhkTraceSourceID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTraceSourceID binds and validates parameter TraceSourceID from query.
+func (o *PostControlHarImportParams) bindTraceSourceID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: uuid
+	value, err := formats.Parse("uuid", raw)
+	if err != nil {
+		return errors.InvalidType("traceSourceId", "query", "strfmt.UUID", raw)
+	}
+	o.TraceSourceID = (value.(*strfmt.UUID))
+
+	if err := o.validateTraceSourceID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTraceSourceID carries on validations for parameter TraceSourceID
+func (o *PostControlHarImportParams) validateTraceSourceID(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("traceSourceId", "query", "uuid", o.TraceSourceID.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
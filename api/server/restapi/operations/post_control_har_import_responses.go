@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PostControlHarImportOKCode is the HTTP code returned for type PostControlHarImportOK
+const PostControlHarImportOKCode int = 200
+
+/*PostControlHarImportOK Import result
+
+swagger:response postControlHarImportOK
+*/
+type PostControlHarImportOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HarImportResult `json:"body,omitempty"`
+}
+
+// NewPostControlHarImportOK creates PostControlHarImportOK with default headers values
+func NewPostControlHarImportOK() *PostControlHarImportOK {
+
+	return &PostControlHarImportOK{}
+}
+
+// WithPayload adds the payload to the post control har import o k response
+func (o *PostControlHarImportOK) WithPayload(payload *models.HarImportResult) *PostControlHarImportOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control har import o k response
+func (o *PostControlHarImportOK) SetPayload(payload *models.HarImportResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlHarImportOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostControlHarImportBadRequestCode is the HTTP code returned for type PostControlHarImportBadRequest
+const PostControlHarImportBadRequestCode int = 400
+
+/*PostControlHarImportBadRequest Invalid HAR archive
+
+swagger:response postControlHarImportBadRequest
+*/
+type PostControlHarImportBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlHarImportBadRequest creates PostControlHarImportBadRequest with default headers values
+func NewPostControlHarImportBadRequest() *PostControlHarImportBadRequest {
+
+	return &PostControlHarImportBadRequest{}
+}
+
+// WithPayload adds the payload to the post control har import bad request response
+func (o *PostControlHarImportBadRequest) WithPayload(payload *models.APIResponse) *PostControlHarImportBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control har import bad request response
+func (o *PostControlHarImportBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlHarImportBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostControlHarImportNotFoundCode is the HTTP code returned for type PostControlHarImportNotFound
+const PostControlHarImportNotFoundCode int = 404
+
+/*PostControlHarImportNotFound Trace Source not found
+
+swagger:response postControlHarImportNotFound
+*/
+type PostControlHarImportNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlHarImportNotFound creates PostControlHarImportNotFound with default headers values
+func NewPostControlHarImportNotFound() *PostControlHarImportNotFound {
+
+	return &PostControlHarImportNotFound{}
+}
+
+// WithPayload adds the payload to the post control har import not found response
+func (o *PostControlHarImportNotFound) WithPayload(payload *models.APIResponse) *PostControlHarImportNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control har import not found response
+func (o *PostControlHarImportNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlHarImportNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PostControlHarImportDefault unknown error
+
+swagger:response postControlHarImportDefault
+*/
+type PostControlHarImportDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlHarImportDefault creates PostControlHarImportDefault with default headers values
+func NewPostControlHarImportDefault(code int) *PostControlHarImportDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostControlHarImportDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post control har import default response
+func (o *PostControlHarImportDefault) WithStatusCode(code int) *PostControlHarImportDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post control har import default response
+func (o *PostControlHarImportDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post control har import default response
+func (o *PostControlHarImportDefault) WithPayload(payload *models.APIResponse) *PostControlHarImportDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control har import default response
+func (o *PostControlHarImportDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlHarImportDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostControlReplaysHandlerFunc turns a function with the right signature into a post control replays handler
+type PostControlReplaysHandlerFunc func(PostControlReplaysParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PostControlReplaysHandlerFunc) Handle(params PostControlReplaysParams) middleware.Responder {
+	return fn(params)
+}
+
+// PostControlReplaysHandler interface for that can handle valid post control replays params
+type PostControlReplaysHandler interface {
+	Handle(PostControlReplaysParams) middleware.Responder
+}
+
+// NewPostControlReplays creates a new http.Handler for the post control replays operation
+func NewPostControlReplays(ctx *middleware.Context, handler PostControlReplaysHandler) *PostControlReplays {
+	return &PostControlReplays{Context: ctx, Handler: handler}
+}
+
+/* PostControlReplays swagger:route POST /control/replays postControlReplays
+
+Replay the stored events of an API through a module
+
+*/
+type PostControlReplays struct {
+	Context *middleware.Context
+	Handler PostControlReplaysHandler
+}
+
+func (o *PostControlReplays) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPostControlReplaysParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
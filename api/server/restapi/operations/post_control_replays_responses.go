@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PostControlReplaysCreatedCode is the HTTP code returned for type PostControlReplaysCreated
+const PostControlReplaysCreatedCode int = 201
+
+/*PostControlReplaysCreated Replay started
+
+swagger:response postControlReplaysCreated
+*/
+type PostControlReplaysCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Replay `json:"body,omitempty"`
+}
+
+// NewPostControlReplaysCreated creates PostControlReplaysCreated with default headers values
+func NewPostControlReplaysCreated() *PostControlReplaysCreated {
+
+	return &PostControlReplaysCreated{}
+}
+
+// WithPayload adds the payload to the post control replays created response
+func (o *PostControlReplaysCreated) WithPayload(payload *models.Replay) *PostControlReplaysCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control replays created response
+func (o *PostControlReplaysCreated) SetPayload(payload *models.Replay) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlReplaysCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostControlReplaysBadRequestCode is the HTTP code returned for type PostControlReplaysBadRequest
+const PostControlReplaysBadRequestCode int = 400
+
+/*PostControlReplaysBadRequest Invalid replay
+
+swagger:response postControlReplaysBadRequest
+*/
+type PostControlReplaysBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlReplaysBadRequest creates PostControlReplaysBadRequest with default headers values
+func NewPostControlReplaysBadRequest() *PostControlReplaysBadRequest {
+
+	return &PostControlReplaysBadRequest{}
+}
+
+// WithPayload adds the payload to the post control replays bad request response
+func (o *PostControlReplaysBadRequest) WithPayload(payload *models.APIResponse) *PostControlReplaysBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control replays bad request response
+func (o *PostControlReplaysBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlReplaysBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostControlReplaysNotFoundCode is the HTTP code returned for type PostControlReplaysNotFound
+const PostControlReplaysNotFoundCode int = 404
+
+/*PostControlReplaysNotFound Module not found
+
+swagger:response postControlReplaysNotFound
+*/
+type PostControlReplaysNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlReplaysNotFound creates PostControlReplaysNotFound with default headers values
+func NewPostControlReplaysNotFound() *PostControlReplaysNotFound {
+
+	return &PostControlReplaysNotFound{}
+}
+
+// WithPayload adds the payload to the post control replays not found response
+func (o *PostControlReplaysNotFound) WithPayload(payload *models.APIResponse) *PostControlReplaysNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control replays not found response
+func (o *PostControlReplaysNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlReplaysNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PostControlReplaysDefault unknown error
+
+swagger:response postControlReplaysDefault
+*/
+type PostControlReplaysDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostControlReplaysDefault creates PostControlReplaysDefault with default headers values
+func NewPostControlReplaysDefault(code int) *PostControlReplaysDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostControlReplaysDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post control replays default response
+func (o *PostControlReplaysDefault) WithStatusCode(code int) *PostControlReplaysDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post control replays default response
+func (o *PostControlReplaysDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post control replays default response
+func (o *PostControlReplaysDefault) WithPayload(payload *models.APIResponse) *PostControlReplaysDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post control replays default response
+func (o *PostControlReplaysDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostControlReplaysDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
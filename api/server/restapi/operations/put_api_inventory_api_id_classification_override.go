@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutAPIInventoryAPIIDClassificationOverrideHandlerFunc turns a function with the right signature into a put API inventory API ID classification override handler
+type PutAPIInventoryAPIIDClassificationOverrideHandlerFunc func(PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutAPIInventoryAPIIDClassificationOverrideHandlerFunc) Handle(params PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutAPIInventoryAPIIDClassificationOverrideHandler interface for that can handle valid put API inventory API ID classification override params
+type PutAPIInventoryAPIIDClassificationOverrideHandler interface {
+	Handle(PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverride creates a new http.Handler for the put API inventory API ID classification override operation
+func NewPutAPIInventoryAPIIDClassificationOverride(ctx *middleware.Context, handler PutAPIInventoryAPIIDClassificationOverrideHandler) *PutAPIInventoryAPIIDClassificationOverride {
+	return &PutAPIInventoryAPIIDClassificationOverride{Context: ctx, Handler: handler}
+}
+
+/* PutAPIInventoryAPIIDClassificationOverride swagger:route PUT /apiInventory/{apiId}/classificationOverride putApiInventoryApiIdClassificationOverride
+
+Force the classification of the next traffic of an API as API (learned and analyzed) or non-API
+
+*/
+type PutAPIInventoryAPIIDClassificationOverride struct {
+	Context *middleware.Context
+	Handler PutAPIInventoryAPIIDClassificationOverrideHandler
+}
+
+func (o *PutAPIInventoryAPIIDClassificationOverride) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutAPIInventoryAPIIDClassificationOverrideParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutAPIInventoryAPIIDClassificationOverrideOKCode is the HTTP code returned for type PutAPIInventoryAPIIDClassificationOverrideOK
+const PutAPIInventoryAPIIDClassificationOverrideOKCode int = 200
+
+/*PutAPIInventoryAPIIDClassificationOverrideOK Success
+
+swagger:response putApiInventoryApiIdClassificationOverrideOK
+*/
+type PutAPIInventoryAPIIDClassificationOverrideOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload interface{} `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideOK creates PutAPIInventoryAPIIDClassificationOverrideOK with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideOK() *PutAPIInventoryAPIIDClassificationOverrideOK {
+
+	return &PutAPIInventoryAPIIDClassificationOverrideOK{}
+}
+
+// WithPayload adds the payload to the put Api inventory Api Id classification override o k response
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) WithPayload(payload interface{}) *PutAPIInventoryAPIIDClassificationOverrideOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put Api inventory Api Id classification override o k response
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) SetPayload(payload interface{}) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDClassificationOverrideOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+// PutAPIInventoryAPIIDClassificationOverrideNotFoundCode is the HTTP code returned for type PutAPIInventoryAPIIDClassificationOverrideNotFound
+const PutAPIInventoryAPIIDClassificationOverrideNotFoundCode int = 404
+
+/*PutAPIInventoryAPIIDClassificationOverrideNotFound API not found
+
+swagger:response putApiInventoryApiIdClassificationOverrideNotFound
+*/
+type PutAPIInventoryAPIIDClassificationOverrideNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideNotFound creates PutAPIInventoryAPIIDClassificationOverrideNotFound with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideNotFound() *PutAPIInventoryAPIIDClassificationOverrideNotFound {
+
+	return &PutAPIInventoryAPIIDClassificationOverrideNotFound{}
+}
+
+// WithPayload adds the payload to the put Api inventory Api Id classification override not found response
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) WithPayload(payload *models.APIResponse) *PutAPIInventoryAPIIDClassificationOverrideNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put Api inventory Api Id classification override not found response
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDClassificationOverrideNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutAPIInventoryAPIIDClassificationOverrideDefault unknown error
+
+swagger:response putApiInventoryApiIdClassificationOverrideDefault
+*/
+type PutAPIInventoryAPIIDClassificationOverrideDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDClassificationOverrideDefault creates PutAPIInventoryAPIIDClassificationOverrideDefault with default headers values
+func NewPutAPIInventoryAPIIDClassificationOverrideDefault(code int) *PutAPIInventoryAPIIDClassificationOverrideDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutAPIInventoryAPIIDClassificationOverrideDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put API inventory API ID classification override default response
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) WithStatusCode(code int) *PutAPIInventoryAPIIDClassificationOverrideDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put API inventory API ID classification override default response
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put API inventory API ID classification override default response
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) WithPayload(payload *models.APIResponse) *PutAPIInventoryAPIIDClassificationOverrideDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put API inventory API ID classification override default response
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDClassificationOverrideDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc turns a function with the right signature into a put API inventory API ID grpc descriptor set handler
+type PutAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc func(PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc) Handle(params PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutAPIInventoryAPIIDGrpcDescriptorSetHandler interface for that can handle valid put API inventory API ID grpc descriptor set params
+type PutAPIInventoryAPIIDGrpcDescriptorSetHandler interface {
+	Handle(PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSet creates a new http.Handler for the put API inventory API ID grpc descriptor set operation
+func NewPutAPIInventoryAPIIDGrpcDescriptorSet(ctx *middleware.Context, handler PutAPIInventoryAPIIDGrpcDescriptorSetHandler) *PutAPIInventoryAPIIDGrpcDescriptorSet {
+	return &PutAPIInventoryAPIIDGrpcDescriptorSet{Context: ctx, Handler: handler}
+}
+
+/* PutAPIInventoryAPIIDGrpcDescriptorSet swagger:route PUT /apiInventory/{apiId}/grpc/descriptorSet putApiInventoryApiIdGrpcDescriptorSet
+
+Set the protobuf descriptor set of a gRPC API, used to decode the messages of its next events
+
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSet struct {
+	Context *middleware.Context
+	Handler PutAPIInventoryAPIIDGrpcDescriptorSetHandler
+}
+
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSet) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutAPIInventoryAPIIDGrpcDescriptorSetParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
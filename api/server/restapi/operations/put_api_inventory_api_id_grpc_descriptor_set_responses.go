@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutAPIInventoryAPIIDGrpcDescriptorSetOKCode is the HTTP code returned for type PutAPIInventoryAPIIDGrpcDescriptorSetOK
+const PutAPIInventoryAPIIDGrpcDescriptorSetOKCode int = 200
+
+/*PutAPIInventoryAPIIDGrpcDescriptorSetOK Success
+
+swagger:response putApiInventoryApiIdGrpcDescriptorSetOK
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GrpcServices `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetOK creates PutAPIInventoryAPIIDGrpcDescriptorSetOK with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetOK() *PutAPIInventoryAPIIDGrpcDescriptorSetOK {
+
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetOK{}
+}
+
+// WithPayload adds the payload to the put Api inventory Api Id grpc descriptor set o k response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) WithPayload(payload *models.GrpcServices) *PutAPIInventoryAPIIDGrpcDescriptorSetOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put Api inventory Api Id grpc descriptor set o k response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) SetPayload(payload *models.GrpcServices) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutAPIInventoryAPIIDGrpcDescriptorSetBadRequestCode is the HTTP code returned for type PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest
+const PutAPIInventoryAPIIDGrpcDescriptorSetBadRequestCode int = 400
+
+/*PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest Invalid descriptor set
+
+swagger:response putApiInventoryApiIdGrpcDescriptorSetBadRequest
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest creates PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest() *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest {
+
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest{}
+}
+
+// WithPayload adds the payload to the put Api inventory Api Id grpc descriptor set bad request response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) WithPayload(payload *models.APIResponse) *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put Api inventory Api Id grpc descriptor set bad request response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutAPIInventoryAPIIDGrpcDescriptorSetDefault unknown error
+
+swagger:response putApiInventoryApiIdGrpcDescriptorSetDefault
+*/
+type PutAPIInventoryAPIIDGrpcDescriptorSetDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault creates PutAPIInventoryAPIIDGrpcDescriptorSetDefault with default headers values
+func NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault(code int) *PutAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutAPIInventoryAPIIDGrpcDescriptorSetDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put API inventory API ID grpc descriptor set default response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) WithStatusCode(code int) *PutAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put API inventory API ID grpc descriptor set default response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put API inventory API ID grpc descriptor set default response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) WithPayload(payload *models.APIResponse) *PutAPIInventoryAPIIDGrpcDescriptorSetDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put API inventory API ID grpc descriptor set default response
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutAPIInventoryAPIIDGrpcDescriptorSetDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlModulesModuleNameHandlerFunc turns a function with the right signature into a put control modules module name handler
+type PutControlModulesModuleNameHandlerFunc func(PutControlModulesModuleNameParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlModulesModuleNameHandlerFunc) Handle(params PutControlModulesModuleNameParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlModulesModuleNameHandler interface for that can handle valid put control modules module name params
+type PutControlModulesModuleNameHandler interface {
+	Handle(PutControlModulesModuleNameParams) middleware.Responder
+}
+
+// NewPutControlModulesModuleName creates a new http.Handler for the put control modules module name operation
+func NewPutControlModulesModuleName(ctx *middleware.Context, handler PutControlModulesModuleNameHandler) *PutControlModulesModuleName {
+	return &PutControlModulesModuleName{Context: ctx, Handler: handler}
+}
+
+/* PutControlModulesModuleName swagger:route PUT /control/modules/{moduleName} putControlModulesModuleName
+
+Enable or disable a module
+
+*/
+type PutControlModulesModuleName struct {
+	Context *middleware.Context
+	Handler PutControlModulesModuleNameHandler
+}
+
+func (o *PutControlModulesModuleName) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlModulesModuleNameParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
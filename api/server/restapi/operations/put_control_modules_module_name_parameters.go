@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// NewPutControlModulesModuleNameParams creates a new PutControlModulesModuleNameParams object
+//
+// There are no default values defined in the spec.
+func NewPutControlModulesModuleNameParams() PutControlModulesModuleNameParams {
+
+	return PutControlModulesModuleNameParams{}
+}
+
+// PutControlModulesModuleNameParams contains all the bound params for the put control modules module name operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PutControlModulesModuleName
+type PutControlModulesModuleNameParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: body
+	*/
+	Body *models.ModuleState
+	/*
+	  Required: true
+	  In: path
+	*/
+	ModuleName string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPutControlModulesModuleNameParams() beforehand.
+func (o *PutControlModulesModuleNameParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.ModuleState
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+
+	rModuleName, rhkModuleName, _ := route.Params.GetOK("moduleName")
+	if err := o.bindModuleName(rModuleName, rhkModuleName, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindModuleName binds and validates parameter ModuleName from path.
+func (o *PutControlModulesModuleNameParams) bindModuleName(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ModuleName = raw
+
+	return nil
+}
@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlModulesModuleNameOKCode is the HTTP code returned for type PutControlModulesModuleNameOK
+const PutControlModulesModuleNameOKCode int = 200
+
+/*PutControlModulesModuleNameOK Success
+
+swagger:response putControlModulesModuleNameOK
+*/
+type PutControlModulesModuleNameOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ModuleStatus `json:"body,omitempty"`
+}
+
+// NewPutControlModulesModuleNameOK creates PutControlModulesModuleNameOK with default headers values
+func NewPutControlModulesModuleNameOK() *PutControlModulesModuleNameOK {
+
+	return &PutControlModulesModuleNameOK{}
+}
+
+// WithPayload adds the payload to the put control modules module name o k response
+func (o *PutControlModulesModuleNameOK) WithPayload(payload *models.ModuleStatus) *PutControlModulesModuleNameOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control modules module name o k response
+func (o *PutControlModulesModuleNameOK) SetPayload(payload *models.ModuleStatus) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlModulesModuleNameOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlModulesModuleNameNotFoundCode is the HTTP code returned for type PutControlModulesModuleNameNotFound
+const PutControlModulesModuleNameNotFoundCode int = 404
+
+/*PutControlModulesModuleNameNotFound Module not found
+
+swagger:response putControlModulesModuleNameNotFound
+*/
+type PutControlModulesModuleNameNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlModulesModuleNameNotFound creates PutControlModulesModuleNameNotFound with default headers values
+func NewPutControlModulesModuleNameNotFound() *PutControlModulesModuleNameNotFound {
+
+	return &PutControlModulesModuleNameNotFound{}
+}
+
+// WithPayload adds the payload to the put control modules module name not found response
+func (o *PutControlModulesModuleNameNotFound) WithPayload(payload *models.APIResponse) *PutControlModulesModuleNameNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control modules module name not found response
+func (o *PutControlModulesModuleNameNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlModulesModuleNameNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlModulesModuleNameDefault unknown error
+
+swagger:response putControlModulesModuleNameDefault
+*/
+type PutControlModulesModuleNameDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlModulesModuleNameDefault creates PutControlModulesModuleNameDefault with default headers values
+func NewPutControlModulesModuleNameDefault(code int) *PutControlModulesModuleNameDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlModulesModuleNameDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control modules module name default response
+func (o *PutControlModulesModuleNameDefault) WithStatusCode(code int) *PutControlModulesModuleNameDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control modules module name default response
+func (o *PutControlModulesModuleNameDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control modules module name default response
+func (o *PutControlModulesModuleNameDefault) WithPayload(payload *models.APIResponse) *PutControlModulesModuleNameDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control modules module name default response
+func (o *PutControlModulesModuleNameDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlModulesModuleNameDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
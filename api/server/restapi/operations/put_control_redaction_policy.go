@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlRedactionPolicyHandlerFunc turns a function with the right signature into a put control redaction policy handler
+type PutControlRedactionPolicyHandlerFunc func(PutControlRedactionPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlRedactionPolicyHandlerFunc) Handle(params PutControlRedactionPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlRedactionPolicyHandler interface for that can handle valid put control redaction policy params
+type PutControlRedactionPolicyHandler interface {
+	Handle(PutControlRedactionPolicyParams) middleware.Responder
+}
+
+// NewPutControlRedactionPolicy creates a new http.Handler for the put control redaction policy operation
+func NewPutControlRedactionPolicy(ctx *middleware.Context, handler PutControlRedactionPolicyHandler) *PutControlRedactionPolicy {
+	return &PutControlRedactionPolicy{Context: ctx, Handler: handler}
+}
+
+/* PutControlRedactionPolicy swagger:route PUT /control/redactionPolicy putControlRedactionPolicy
+
+Set the default redaction policy
+
+*/
+type PutControlRedactionPolicy struct {
+	Context *middleware.Context
+	Handler PutControlRedactionPolicyHandler
+}
+
+func (o *PutControlRedactionPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlRedactionPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
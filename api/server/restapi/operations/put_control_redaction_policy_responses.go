@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlRedactionPolicyOKCode is the HTTP code returned for type PutControlRedactionPolicyOK
+const PutControlRedactionPolicyOKCode int = 200
+
+/*PutControlRedactionPolicyOK Success
+
+swagger:response putControlRedactionPolicyOK
+*/
+type PutControlRedactionPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RedactionPolicy `json:"body,omitempty"`
+}
+
+// NewPutControlRedactionPolicyOK creates PutControlRedactionPolicyOK with default headers values
+func NewPutControlRedactionPolicyOK() *PutControlRedactionPolicyOK {
+
+	return &PutControlRedactionPolicyOK{}
+}
+
+// WithPayload adds the payload to the put control redaction policy o k response
+func (o *PutControlRedactionPolicyOK) WithPayload(payload *models.RedactionPolicy) *PutControlRedactionPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control redaction policy o k response
+func (o *PutControlRedactionPolicyOK) SetPayload(payload *models.RedactionPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlRedactionPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlRedactionPolicyBadRequestCode is the HTTP code returned for type PutControlRedactionPolicyBadRequest
+const PutControlRedactionPolicyBadRequestCode int = 400
+
+/*PutControlRedactionPolicyBadRequest Invalid redaction policy
+
+swagger:response putControlRedactionPolicyBadRequest
+*/
+type PutControlRedactionPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlRedactionPolicyBadRequest creates PutControlRedactionPolicyBadRequest with default headers values
+func NewPutControlRedactionPolicyBadRequest() *PutControlRedactionPolicyBadRequest {
+
+	return &PutControlRedactionPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the put control redaction policy bad request response
+func (o *PutControlRedactionPolicyBadRequest) WithPayload(payload *models.APIResponse) *PutControlRedactionPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control redaction policy bad request response
+func (o *PutControlRedactionPolicyBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlRedactionPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlRedactionPolicyDefault unknown error
+
+swagger:response putControlRedactionPolicyDefault
+*/
+type PutControlRedactionPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlRedactionPolicyDefault creates PutControlRedactionPolicyDefault with default headers values
+func NewPutControlRedactionPolicyDefault(code int) *PutControlRedactionPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control redaction policy default response
+func (o *PutControlRedactionPolicyDefault) WithStatusCode(code int) *PutControlRedactionPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control redaction policy default response
+func (o *PutControlRedactionPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control redaction policy default response
+func (o *PutControlRedactionPolicyDefault) WithPayload(payload *models.APIResponse) *PutControlRedactionPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control redaction policy default response
+func (o *PutControlRedactionPolicyDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlRedactionPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
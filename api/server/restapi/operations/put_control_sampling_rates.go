@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlSamplingRatesHandlerFunc turns a function with the right signature into a put control sampling rates handler
+type PutControlSamplingRatesHandlerFunc func(PutControlSamplingRatesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlSamplingRatesHandlerFunc) Handle(params PutControlSamplingRatesParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlSamplingRatesHandler interface for that can handle valid put control sampling rates params
+type PutControlSamplingRatesHandler interface {
+	Handle(PutControlSamplingRatesParams) middleware.Responder
+}
+
+// NewPutControlSamplingRates creates a new http.Handler for the put control sampling rates operation
+func NewPutControlSamplingRates(ctx *middleware.Context, handler PutControlSamplingRatesHandler) *PutControlSamplingRates {
+	return &PutControlSamplingRates{Context: ctx, Handler: handler}
+}
+
+/* PutControlSamplingRates swagger:route PUT /control/samplingRates putControlSamplingRates
+
+Set the sampling rate of an API for a component
+
+*/
+type PutControlSamplingRates struct {
+	Context *middleware.Context
+	Handler PutControlSamplingRatesHandler
+}
+
+func (o *PutControlSamplingRates) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlSamplingRatesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
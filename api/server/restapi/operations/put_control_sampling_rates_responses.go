@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlSamplingRatesOKCode is the HTTP code returned for type PutControlSamplingRatesOK
+const PutControlSamplingRatesOKCode int = 200
+
+/*PutControlSamplingRatesOK Success
+
+swagger:response putControlSamplingRatesOK
+*/
+type PutControlSamplingRatesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.SamplingRate `json:"body,omitempty"`
+}
+
+// NewPutControlSamplingRatesOK creates PutControlSamplingRatesOK with default headers values
+func NewPutControlSamplingRatesOK() *PutControlSamplingRatesOK {
+
+	return &PutControlSamplingRatesOK{}
+}
+
+// WithPayload adds the payload to the put control sampling rates o k response
+func (o *PutControlSamplingRatesOK) WithPayload(payload *models.SamplingRate) *PutControlSamplingRatesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control sampling rates o k response
+func (o *PutControlSamplingRatesOK) SetPayload(payload *models.SamplingRate) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlSamplingRatesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlSamplingRatesNotFoundCode is the HTTP code returned for type PutControlSamplingRatesNotFound
+const PutControlSamplingRatesNotFoundCode int = 404
+
+/*PutControlSamplingRatesNotFound API not found
+
+swagger:response putControlSamplingRatesNotFound
+*/
+type PutControlSamplingRatesNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlSamplingRatesNotFound creates PutControlSamplingRatesNotFound with default headers values
+func NewPutControlSamplingRatesNotFound() *PutControlSamplingRatesNotFound {
+
+	return &PutControlSamplingRatesNotFound{}
+}
+
+// WithPayload adds the payload to the put control sampling rates not found response
+func (o *PutControlSamplingRatesNotFound) WithPayload(payload *models.APIResponse) *PutControlSamplingRatesNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control sampling rates not found response
+func (o *PutControlSamplingRatesNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlSamplingRatesNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlSamplingRatesDefault unknown error
+
+swagger:response putControlSamplingRatesDefault
+*/
+type PutControlSamplingRatesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlSamplingRatesDefault creates PutControlSamplingRatesDefault with default headers values
+func NewPutControlSamplingRatesDefault(code int) *PutControlSamplingRatesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlSamplingRatesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control sampling rates default response
+func (o *PutControlSamplingRatesDefault) WithStatusCode(code int) *PutControlSamplingRatesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control sampling rates default response
+func (o *PutControlSamplingRatesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control sampling rates default response
+func (o *PutControlSamplingRatesDefault) WithPayload(payload *models.APIResponse) *PutControlSamplingRatesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control sampling rates default response
+func (o *PutControlSamplingRatesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlSamplingRatesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
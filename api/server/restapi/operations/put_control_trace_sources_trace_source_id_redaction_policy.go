@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc turns a function with the right signature into a put control trace sources trace source ID redaction policy handler
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc func(PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc) Handle(params PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface for that can handle valid put control trace sources trace source ID redaction policy params
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler interface {
+	Handle(PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicy creates a new http.Handler for the put control trace sources trace source ID redaction policy operation
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicy(ctx *middleware.Context, handler PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler) *PutControlTraceSourcesTraceSourceIDRedactionPolicy {
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicy{Context: ctx, Handler: handler}
+}
+
+/* PutControlTraceSourcesTraceSourceIDRedactionPolicy swagger:route PUT /control/traceSources/{traceSourceId}/redactionPolicy putControlTraceSourcesTraceSourceIdRedactionPolicy
+
+Set the redaction policy of a Trace Source
+
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicy struct {
+	Context *middleware.Context
+	Handler PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler
+}
+
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlTraceSourcesTraceSourceIDRedactionPolicyParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyOKCode is the HTTP code returned for type PutControlTraceSourcesTraceSourceIDRedactionPolicyOK
+const PutControlTraceSourcesTraceSourceIDRedactionPolicyOKCode int = 200
+
+/*PutControlTraceSourcesTraceSourceIDRedactionPolicyOK Success
+
+swagger:response putControlTraceSourcesTraceSourceIdRedactionPolicyOK
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.RedactionPolicy `json:"body,omitempty"`
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK creates PutControlTraceSourcesTraceSourceIDRedactionPolicyOK with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK() *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyOK{}
+}
+
+// WithPayload adds the payload to the put control trace sources trace source Id redaction policy o k response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) WithPayload(payload *models.RedactionPolicy) *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control trace sources trace source Id redaction policy o k response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) SetPayload(payload *models.RedactionPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequestCode is the HTTP code returned for type PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest
+const PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequestCode int = 400
+
+/*PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest Invalid redaction policy
+
+swagger:response putControlTraceSourcesTraceSourceIdRedactionPolicyBadRequest
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest creates PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest() *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest {
+
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest{}
+}
+
+// WithPayload adds the payload to the put control trace sources trace source Id redaction policy bad request response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) WithPayload(payload *models.APIResponse) *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control trace sources trace source Id redaction policy bad request response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode is the HTTP code returned for type PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound
+const PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFoundCode int = 404
+
+/*PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound Trace Source not found
+
+swagger:response putControlTraceSourcesTraceSourceIdRedactionPolicyNotFound
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound creates PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound() *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound{}
+}
+
+// WithPayload adds the payload to the put control trace sources trace source Id redaction policy not found response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WithPayload(payload *models.APIResponse) *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control trace sources trace source Id redaction policy not found response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault unknown error
+
+swagger:response putControlTraceSourcesTraceSourceIdRedactionPolicyDefault
+*/
+type PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault creates PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault with default headers values
+func NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault(code int) *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control trace sources trace source ID redaction policy default response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithStatusCode(code int) *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control trace sources trace source ID redaction policy default response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control trace sources trace source ID redaction policy default response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WithPayload(payload *models.APIResponse) *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control trace sources trace source ID redaction policy default response
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTraceSourcesTraceSourceIDRedactionPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlTracingEndpointsHandlerFunc turns a function with the right signature into a put control tracing endpoints handler
+type PutControlTracingEndpointsHandlerFunc func(PutControlTracingEndpointsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlTracingEndpointsHandlerFunc) Handle(params PutControlTracingEndpointsParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlTracingEndpointsHandler interface for that can handle valid put control tracing endpoints params
+type PutControlTracingEndpointsHandler interface {
+	Handle(PutControlTracingEndpointsParams) middleware.Responder
+}
+
+// NewPutControlTracingEndpoints creates a new http.Handler for the put control tracing endpoints operation
+func NewPutControlTracingEndpoints(ctx *middleware.Context, handler PutControlTracingEndpointsHandler) *PutControlTracingEndpoints {
+	return &PutControlTracingEndpoints{Context: ctx, Handler: handler}
+}
+
+/* PutControlTracingEndpoints swagger:route PUT /control/tracingEndpoints putControlTracingEndpoints
+
+Restrict the tracing of an API for a component to endpoints of its spec
+
+*/
+type PutControlTracingEndpoints struct {
+	Context *middleware.Context
+	Handler PutControlTracingEndpointsHandler
+}
+
+func (o *PutControlTracingEndpoints) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlTracingEndpointsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
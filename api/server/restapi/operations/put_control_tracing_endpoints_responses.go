@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlTracingEndpointsOKCode is the HTTP code returned for type PutControlTracingEndpointsOK
+const PutControlTracingEndpointsOKCode int = 200
+
+/*PutControlTracingEndpointsOK Success
+
+swagger:response putControlTracingEndpointsOK
+*/
+type PutControlTracingEndpointsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TracingEndpoints `json:"body,omitempty"`
+}
+
+// NewPutControlTracingEndpointsOK creates PutControlTracingEndpointsOK with default headers values
+func NewPutControlTracingEndpointsOK() *PutControlTracingEndpointsOK {
+
+	return &PutControlTracingEndpointsOK{}
+}
+
+// WithPayload adds the payload to the put control tracing endpoints o k response
+func (o *PutControlTracingEndpointsOK) WithPayload(payload *models.TracingEndpoints) *PutControlTracingEndpointsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing endpoints o k response
+func (o *PutControlTracingEndpointsOK) SetPayload(payload *models.TracingEndpoints) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingEndpointsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlTracingEndpointsBadRequestCode is the HTTP code returned for type PutControlTracingEndpointsBadRequest
+const PutControlTracingEndpointsBadRequestCode int = 400
+
+/*PutControlTracingEndpointsBadRequest Endpoint not found in the spec of the API
+
+swagger:response putControlTracingEndpointsBadRequest
+*/
+type PutControlTracingEndpointsBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTracingEndpointsBadRequest creates PutControlTracingEndpointsBadRequest with default headers values
+func NewPutControlTracingEndpointsBadRequest() *PutControlTracingEndpointsBadRequest {
+
+	return &PutControlTracingEndpointsBadRequest{}
+}
+
+// WithPayload adds the payload to the put control tracing endpoints bad request response
+func (o *PutControlTracingEndpointsBadRequest) WithPayload(payload *models.APIResponse) *PutControlTracingEndpointsBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing endpoints bad request response
+func (o *PutControlTracingEndpointsBadRequest) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingEndpointsBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlTracingEndpointsNotFoundCode is the HTTP code returned for type PutControlTracingEndpointsNotFound
+const PutControlTracingEndpointsNotFoundCode int = 404
+
+/*PutControlTracingEndpointsNotFound API not found
+
+swagger:response putControlTracingEndpointsNotFound
+*/
+type PutControlTracingEndpointsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTracingEndpointsNotFound creates PutControlTracingEndpointsNotFound with default headers values
+func NewPutControlTracingEndpointsNotFound() *PutControlTracingEndpointsNotFound {
+
+	return &PutControlTracingEndpointsNotFound{}
+}
+
+// WithPayload adds the payload to the put control tracing endpoints not found response
+func (o *PutControlTracingEndpointsNotFound) WithPayload(payload *models.APIResponse) *PutControlTracingEndpointsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing endpoints not found response
+func (o *PutControlTracingEndpointsNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingEndpointsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlTracingEndpointsDefault unknown error
+
+swagger:response putControlTracingEndpointsDefault
+*/
+type PutControlTracingEndpointsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTracingEndpointsDefault creates PutControlTracingEndpointsDefault with default headers values
+func NewPutControlTracingEndpointsDefault(code int) *PutControlTracingEndpointsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlTracingEndpointsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control tracing endpoints default response
+func (o *PutControlTracingEndpointsDefault) WithStatusCode(code int) *PutControlTracingEndpointsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control tracing endpoints default response
+func (o *PutControlTracingEndpointsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control tracing endpoints default response
+func (o *PutControlTracingEndpointsDefault) WithPayload(payload *models.APIResponse) *PutControlTracingEndpointsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing endpoints default response
+func (o *PutControlTracingEndpointsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingEndpointsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
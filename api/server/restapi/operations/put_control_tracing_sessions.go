@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PutControlTracingSessionsHandlerFunc turns a function with the right signature into a put control tracing sessions handler
+type PutControlTracingSessionsHandlerFunc func(PutControlTracingSessionsParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn PutControlTracingSessionsHandlerFunc) Handle(params PutControlTracingSessionsParams) middleware.Responder {
+	return fn(params)
+}
+
+// PutControlTracingSessionsHandler interface for that can handle valid put control tracing sessions params
+type PutControlTracingSessionsHandler interface {
+	Handle(PutControlTracingSessionsParams) middleware.Responder
+}
+
+// NewPutControlTracingSessions creates a new http.Handler for the put control tracing sessions operation
+func NewPutControlTracingSessions(ctx *middleware.Context, handler PutControlTracingSessionsHandler) *PutControlTracingSessions {
+	return &PutControlTracingSessions{Context: ctx, Handler: handler}
+}
+
+/* PutControlTracingSessions swagger:route PUT /control/tracingSessions putControlTracingSessions
+
+Set the limits of an active tracing session and restart it
+
+*/
+type PutControlTracingSessions struct {
+	Context *middleware.Context
+	Handler PutControlTracingSessionsHandler
+}
+
+func (o *PutControlTracingSessions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewPutControlTracingSessionsParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
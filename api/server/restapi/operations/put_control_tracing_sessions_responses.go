@@ -0,0 +1,160 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+// PutControlTracingSessionsOKCode is the HTTP code returned for type PutControlTracingSessionsOK
+const PutControlTracingSessionsOKCode int = 200
+
+/*PutControlTracingSessionsOK Success
+
+swagger:response putControlTracingSessionsOK
+*/
+type PutControlTracingSessionsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TracingSession `json:"body,omitempty"`
+}
+
+// NewPutControlTracingSessionsOK creates PutControlTracingSessionsOK with default headers values
+func NewPutControlTracingSessionsOK() *PutControlTracingSessionsOK {
+
+	return &PutControlTracingSessionsOK{}
+}
+
+// WithPayload adds the payload to the put control tracing sessions o k response
+func (o *PutControlTracingSessionsOK) WithPayload(payload *models.TracingSession) *PutControlTracingSessionsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing sessions o k response
+func (o *PutControlTracingSessionsOK) SetPayload(payload *models.TracingSession) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingSessionsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PutControlTracingSessionsNotFoundCode is the HTTP code returned for type PutControlTracingSessionsNotFound
+const PutControlTracingSessionsNotFoundCode int = 404
+
+/*PutControlTracingSessionsNotFound Tracing session not found
+
+swagger:response putControlTracingSessionsNotFound
+*/
+type PutControlTracingSessionsNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTracingSessionsNotFound creates PutControlTracingSessionsNotFound with default headers values
+func NewPutControlTracingSessionsNotFound() *PutControlTracingSessionsNotFound {
+
+	return &PutControlTracingSessionsNotFound{}
+}
+
+// WithPayload adds the payload to the put control tracing sessions not found response
+func (o *PutControlTracingSessionsNotFound) WithPayload(payload *models.APIResponse) *PutControlTracingSessionsNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing sessions not found response
+func (o *PutControlTracingSessionsNotFound) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingSessionsNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PutControlTracingSessionsDefault unknown error
+
+swagger:response putControlTracingSessionsDefault
+*/
+type PutControlTracingSessionsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPutControlTracingSessionsDefault creates PutControlTracingSessionsDefault with default headers values
+func NewPutControlTracingSessionsDefault(code int) *PutControlTracingSessionsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PutControlTracingSessionsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the put control tracing sessions default response
+func (o *PutControlTracingSessionsDefault) WithStatusCode(code int) *PutControlTracingSessionsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the put control tracing sessions default response
+func (o *PutControlTracingSessionsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the put control tracing sessions default response
+func (o *PutControlTracingSessionsDefault) WithPayload(payload *models.APIResponse) *PutControlTracingSessionsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the put control tracing sessions default response
+func (o *PutControlTracingSessionsDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PutControlTracingSessionsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
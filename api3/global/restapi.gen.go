@@ -28,6 +28,7 @@ import (
 // Defines values for APIClarityFeatureEnum.
 const (
 	Bfla              APIClarityFeatureEnum = "bfla"
+	Customrules       APIClarityFeatureEnum = "customrules"
 	Fuzzer            APIClarityFeatureEnum = "fuzzer"
 	SpecDiffer        APIClarityFeatureEnum = "spec_differ"
 	Specdiffs         APIClarityFeatureEnum = "specdiffs"
@@ -457,6 +458,36 @@ type RawFindingsSeverity struct {
 // RawFindingsSourceEnum An enumeration.
 type RawFindingsSourceEnum string
 
+// Rule defines model for Rule.
+type Rule struct {
+	// Description Human readable description of what the rule detects
+	Description *string `json:"description,omitempty"`
+
+	// Expression CEL expression over request, response and api, the rule matches the events for which it is true
+	Expression string `json:"expression"`
+	Name       string `json:"name"`
+
+	// Severity Severity of a finding
+	Severity externalRef0.Severity `json:"severity"`
+}
+
+// RuleDefinition defines model for RuleDefinition.
+type RuleDefinition struct {
+	// Description Human readable description of what the rule detects
+	Description *string `json:"description,omitempty"`
+
+	// Expression CEL expression over request, response and api, the rule matches the events for which it is true
+	Expression string `json:"expression"`
+
+	// Severity Severity of a finding
+	Severity externalRef0.Severity `json:"severity"`
+}
+
+// Rules defines model for Rules.
+type Rules struct {
+	Items []Rule `json:"items"`
+}
+
 // ScoreExitStatusEnum An enumeration.
 type ScoreExitStatusEnum string
 
@@ -750,6 +781,9 @@ type Redacted = bool
 // ReviewId defines model for reviewId.
 type ReviewId = uint32
 
+// RuleName defines model for ruleName.
+type RuleName = string
+
 // ShowNonApi defines model for showNonApi.
 type ShowNonApi = bool
 
@@ -1029,6 +1063,9 @@ type PostControlTraceSourcesJSONRequestBody = TraceSource
 // PostModulesBflaAuthorizationModelApiIDJSONRequestBody defines body for PostModulesBflaAuthorizationModelApiID for application/json ContentType.
 type PostModulesBflaAuthorizationModelApiIDJSONRequestBody = AuthorizationModel
 
+// CustomrulesPutRuleJSONRequestBody defines body for CustomrulesPutRule for application/json ContentType.
+type CustomrulesPutRuleJSONRequestBody = RuleDefinition
+
 // FuzzerStartTestJSONRequestBody defines body for FuzzerStartTest for application/json ContentType.
 type FuzzerStartTestJSONRequestBody = TestInput
 
@@ -1365,6 +1402,26 @@ type ClientInterface interface {
 	// BflagetVersion request
 	BflagetVersion(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// CustomrulesGetApiFindings request
+	CustomrulesGetApiFindings(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomrulesResetApiFindings request
+	CustomrulesResetApiFindings(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomrulesListRules request
+	CustomrulesListRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomrulesDeleteRule request
+	CustomrulesDeleteRule(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomrulesGetRule request
+	CustomrulesGetRule(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CustomrulesPutRule request with any body
+	CustomrulesPutRuleWithBody(ctx context.Context, ruleName RuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CustomrulesPutRule(ctx context.Context, ruleName RuleName, body CustomrulesPutRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// FuzzerGetAnnotatedSpec request
 	FuzzerGetAnnotatedSpec(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -2008,6 +2065,90 @@ func (c *Client) BflagetVersion(ctx context.Context, reqEditors ...RequestEditor
 	return c.Client.Do(req)
 }
 
+func (c *Client) CustomrulesGetApiFindings(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesGetApiFindingsRequest(c.Server, apiID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesResetApiFindings(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesResetApiFindingsRequest(c.Server, apiID)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesListRules(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesListRulesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesDeleteRule(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesDeleteRuleRequest(c.Server, ruleName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesGetRule(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesGetRuleRequest(c.Server, ruleName)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesPutRuleWithBody(ctx context.Context, ruleName RuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesPutRuleRequestWithBody(c.Server, ruleName, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CustomrulesPutRule(ctx context.Context, ruleName RuleName, body CustomrulesPutRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCustomrulesPutRuleRequest(c.Server, ruleName, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) FuzzerGetAnnotatedSpec(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewFuzzerGetAnnotatedSpecRequest(c.Server, apiID)
 	if err != nil {
@@ -5105,8 +5246,8 @@ func NewBflagetVersionRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFuzzerGetAnnotatedSpecRequest generates requests for FuzzerGetAnnotatedSpec
-func NewFuzzerGetAnnotatedSpecRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewCustomrulesGetApiFindingsRequest generates requests for CustomrulesGetApiFindings
+func NewCustomrulesGetApiFindingsRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5121,7 +5262,7 @@ func NewFuzzerGetAnnotatedSpecRequest(server string, apiID externalRef0.ApiID) (
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/annotatedspec/%s", pathParam0)
+	operationPath := fmt.Sprintf("/modules/customrules/apiFindings/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5139,8 +5280,8 @@ func NewFuzzerGetAnnotatedSpecRequest(server string, apiID externalRef0.ApiID) (
 	return req, nil
 }
 
-// NewFuzzerGetAPIFindingsRequest generates requests for FuzzerGetAPIFindings
-func NewFuzzerGetAPIFindingsRequest(server string, apiID externalRef0.ApiID, params *FuzzerGetAPIFindingsParams) (*http.Request, error) {
+// NewCustomrulesResetApiFindingsRequest generates requests for CustomrulesResetApiFindings
+func NewCustomrulesResetApiFindingsRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5155,7 +5296,7 @@ func NewFuzzerGetAPIFindingsRequest(server string, apiID externalRef0.ApiID, par
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/apiFindings/%s", pathParam0)
+	operationPath := fmt.Sprintf("/modules/customrules/apiFindings/%s/reset", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5165,25 +5306,32 @@ func NewFuzzerGetAPIFindingsRequest(server string, apiID externalRef0.ApiID, par
 		return nil, err
 	}
 
-	queryValues := queryURL.Query()
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
 
-	if params.Sensitive != nil {
+	return req, nil
+}
 
-		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensitive", runtime.ParamLocationQuery, *params.Sensitive); err != nil {
-			return nil, err
-		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
-			return nil, err
-		} else {
-			for k, v := range parsed {
-				for _, v2 := range v {
-					queryValues.Add(k, v2)
-				}
-			}
-		}
+// NewCustomrulesListRulesRequest generates requests for CustomrulesListRules
+func NewCustomrulesListRulesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
+	operationPath := fmt.Sprintf("/modules/customrules/rules")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
 
-	queryURL.RawQuery = queryValues.Encode()
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
@@ -5193,13 +5341,13 @@ func NewFuzzerGetAPIFindingsRequest(server string, apiID externalRef0.ApiID, par
 	return req, nil
 }
 
-// NewFuzzerGetTestProgressRequest generates requests for FuzzerGetTestProgress
-func NewFuzzerGetTestProgressRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewCustomrulesDeleteRuleRequest generates requests for CustomrulesDeleteRule
+func NewCustomrulesDeleteRuleRequest(server string, ruleName RuleName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
 	if err != nil {
 		return nil, err
 	}
@@ -5209,7 +5357,7 @@ func NewFuzzerGetTestProgressRequest(server string, apiID externalRef0.ApiID) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/progress", pathParam0)
+	operationPath := fmt.Sprintf("/modules/customrules/rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5219,7 +5367,7 @@ func NewFuzzerGetTestProgressRequest(server string, apiID externalRef0.ApiID) (*
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5227,13 +5375,13 @@ func NewFuzzerGetTestProgressRequest(server string, apiID externalRef0.ApiID) (*
 	return req, nil
 }
 
-// NewFuzzerGetTestReportRequest generates requests for FuzzerGetTestReport
-func NewFuzzerGetTestReportRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewCustomrulesGetRuleRequest generates requests for CustomrulesGetRule
+func NewCustomrulesGetRuleRequest(server string, ruleName RuleName) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
 	if err != nil {
 		return nil, err
 	}
@@ -5243,7 +5391,7 @@ func NewFuzzerGetTestReportRequest(server string, apiID externalRef0.ApiID) (*ht
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/report", pathParam0)
+	operationPath := fmt.Sprintf("/modules/customrules/rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5261,24 +5409,24 @@ func NewFuzzerGetTestReportRequest(server string, apiID externalRef0.ApiID) (*ht
 	return req, nil
 }
 
-// NewFuzzerStartTestRequest calls the generic FuzzerStartTest builder with application/json body
-func NewFuzzerStartTestRequest(server string, apiID externalRef0.ApiID, body FuzzerStartTestJSONRequestBody) (*http.Request, error) {
+// NewCustomrulesPutRuleRequest calls the generic CustomrulesPutRule builder with application/json body
+func NewCustomrulesPutRuleRequest(server string, ruleName RuleName, body CustomrulesPutRuleJSONRequestBody) (*http.Request, error) {
 	var bodyReader io.Reader
 	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
 	bodyReader = bytes.NewReader(buf)
-	return NewFuzzerStartTestRequestWithBody(server, apiID, "application/json", bodyReader)
+	return NewCustomrulesPutRuleRequestWithBody(server, ruleName, "application/json", bodyReader)
 }
 
-// NewFuzzerStartTestRequestWithBody generates requests for FuzzerStartTest with any type of body
-func NewFuzzerStartTestRequestWithBody(server string, apiID externalRef0.ApiID, contentType string, body io.Reader) (*http.Request, error) {
+// NewCustomrulesPutRuleRequestWithBody generates requests for CustomrulesPutRule with any type of body
+func NewCustomrulesPutRuleRequestWithBody(server string, ruleName RuleName, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
 
-	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, ruleName)
 	if err != nil {
 		return nil, err
 	}
@@ -5288,7 +5436,7 @@ func NewFuzzerStartTestRequestWithBody(server string, apiID externalRef0.ApiID,
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/start", pathParam0)
+	operationPath := fmt.Sprintf("/modules/customrules/rules/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5298,7 +5446,7 @@ func NewFuzzerStartTestRequestWithBody(server string, apiID externalRef0.ApiID,
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), body)
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
@@ -5308,8 +5456,8 @@ func NewFuzzerStartTestRequestWithBody(server string, apiID externalRef0.ApiID,
 	return req, nil
 }
 
-// NewFuzzerStopTestRequest generates requests for FuzzerStopTest
-func NewFuzzerStopTestRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewFuzzerGetAnnotatedSpecRequest generates requests for FuzzerGetAnnotatedSpec
+func NewFuzzerGetAnnotatedSpecRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5324,7 +5472,7 @@ func NewFuzzerStopTestRequest(server string, apiID externalRef0.ApiID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/stop", pathParam0)
+	operationPath := fmt.Sprintf("/modules/fuzzer/annotatedspec/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5334,7 +5482,7 @@ func NewFuzzerStopTestRequest(server string, apiID externalRef0.ApiID) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5342,8 +5490,8 @@ func NewFuzzerStopTestRequest(server string, apiID externalRef0.ApiID) (*http.Re
 	return req, nil
 }
 
-// NewFuzzerGetReportRequest generates requests for FuzzerGetReport
-func NewFuzzerGetReportRequest(server string, apiID externalRef0.ApiID, timestamp int64) (*http.Request, error) {
+// NewFuzzerGetAPIFindingsRequest generates requests for FuzzerGetAPIFindings
+func NewFuzzerGetAPIFindingsRequest(server string, apiID externalRef0.ApiID, params *FuzzerGetAPIFindingsParams) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5353,19 +5501,12 @@ func NewFuzzerGetReportRequest(server string, apiID externalRef0.ApiID, timestam
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "timestamp", runtime.ParamLocationPath, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/report/%s/%s", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/modules/fuzzer/apiFindings/%s", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5375,6 +5516,26 @@ func NewFuzzerGetReportRequest(server string, apiID externalRef0.ApiID, timestam
 		return nil, err
 	}
 
+	queryValues := queryURL.Query()
+
+	if params.Sensitive != nil {
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sensitive", runtime.ParamLocationQuery, *params.Sensitive); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+	}
+
+	queryURL.RawQuery = queryValues.Encode()
+
 	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
@@ -5383,8 +5544,8 @@ func NewFuzzerGetReportRequest(server string, apiID externalRef0.ApiID, timestam
 	return req, nil
 }
 
-// NewFuzzerGetShortReportByTimestampRequest generates requests for FuzzerGetShortReportByTimestamp
-func NewFuzzerGetShortReportByTimestampRequest(server string, apiID externalRef0.ApiID, timestamp int64) (*http.Request, error) {
+// NewFuzzerGetTestProgressRequest generates requests for FuzzerGetTestProgress
+func NewFuzzerGetTestProgressRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5394,19 +5555,12 @@ func NewFuzzerGetShortReportByTimestampRequest(server string, apiID externalRef0
 		return nil, err
 	}
 
-	var pathParam1 string
-
-	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "timestamp", runtime.ParamLocationPath, timestamp)
-	if err != nil {
-		return nil, err
-	}
-
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/report/%s/%s/short", pathParam0, pathParam1)
+	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/progress", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5424,16 +5578,23 @@ func NewFuzzerGetShortReportByTimestampRequest(server string, apiID externalRef0
 	return req, nil
 }
 
-// NewFuzzergetStateRequest generates requests for FuzzergetState
-func NewFuzzergetStateRequest(server string) (*http.Request, error) {
+// NewFuzzerGetTestReportRequest generates requests for FuzzerGetTestReport
+func NewFuzzerGetTestReportRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	if err != nil {
+		return nil, err
+	}
+
 	serverURL, err := url.Parse(server)
 	if err != nil {
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/state")
+	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/report", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5451,8 +5612,19 @@ func NewFuzzergetStateRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
-// NewFuzzerGetTestsRequest generates requests for FuzzerGetTests
-func NewFuzzerGetTestsRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewFuzzerStartTestRequest calls the generic FuzzerStartTest builder with application/json body
+func NewFuzzerStartTestRequest(server string, apiID externalRef0.ApiID, body FuzzerStartTestJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewFuzzerStartTestRequestWithBody(server, apiID, "application/json", bodyReader)
+}
+
+// NewFuzzerStartTestRequestWithBody generates requests for FuzzerStartTest with any type of body
+func NewFuzzerStartTestRequestWithBody(server string, apiID externalRef0.ApiID, contentType string, body io.Reader) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5467,7 +5639,7 @@ func NewFuzzerGetTestsRequest(server string, apiID externalRef0.ApiID) (*http.Re
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/tests/%s", pathParam0)
+	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/start", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5477,16 +5649,18 @@ func NewFuzzerGetTestsRequest(server string, apiID externalRef0.ApiID) (*http.Re
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
+	req.Header.Add("Content-Type", contentType)
+
 	return req, nil
 }
 
-// NewFuzzerGetUpdateStatusRequest generates requests for FuzzerGetUpdateStatus
-func NewFuzzerGetUpdateStatusRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+// NewFuzzerStopTestRequest generates requests for FuzzerStopTest
+func NewFuzzerStopTestRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
 	var err error
 
 	var pathParam0 string
@@ -5501,7 +5675,7 @@ func NewFuzzerGetUpdateStatusRequest(server string, apiID externalRef0.ApiID) (*
 		return nil, err
 	}
 
-	operationPath := fmt.Sprintf("/modules/fuzzer/updateStatus/%s", pathParam0)
+	operationPath := fmt.Sprintf("/modules/fuzzer/fuzz/%s/stop", pathParam0)
 	if operationPath[0] == '/' {
 		operationPath = "." + operationPath
 	}
@@ -5511,7 +5685,7 @@ func NewFuzzerGetUpdateStatusRequest(server string, apiID externalRef0.ApiID) (*
 		return nil, err
 	}
 
-	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -5519,10 +5693,187 @@ func NewFuzzerGetUpdateStatusRequest(server string, apiID externalRef0.ApiID) (*
 	return req, nil
 }
 
-// NewFuzzerPostUpdateStatusRequest calls the generic FuzzerPostUpdateStatus builder with application/json body
-func NewFuzzerPostUpdateStatusRequest(server string, apiID externalRef0.ApiID, body FuzzerPostUpdateStatusJSONRequestBody) (*http.Request, error) {
-	var bodyReader io.Reader
-	buf, err := json.Marshal(body)
+// NewFuzzerGetReportRequest generates requests for FuzzerGetReport
+func NewFuzzerGetReportRequest(server string, apiID externalRef0.ApiID, timestamp int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "timestamp", runtime.ParamLocationPath, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/modules/fuzzer/report/%s/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFuzzerGetShortReportByTimestampRequest generates requests for FuzzerGetShortReportByTimestamp
+func NewFuzzerGetShortReportByTimestampRequest(server string, apiID externalRef0.ApiID, timestamp int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "timestamp", runtime.ParamLocationPath, timestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/modules/fuzzer/report/%s/%s/short", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFuzzergetStateRequest generates requests for FuzzergetState
+func NewFuzzergetStateRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/modules/fuzzer/state")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFuzzerGetTestsRequest generates requests for FuzzerGetTests
+func NewFuzzerGetTestsRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/modules/fuzzer/tests/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFuzzerGetUpdateStatusRequest generates requests for FuzzerGetUpdateStatus
+func NewFuzzerGetUpdateStatusRequest(server string, apiID externalRef0.ApiID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "apiID", runtime.ParamLocationPath, apiID)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/modules/fuzzer/updateStatus/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewFuzzerPostUpdateStatusRequest calls the generic FuzzerPostUpdateStatus builder with application/json body
+func NewFuzzerPostUpdateStatusRequest(server string, apiID externalRef0.ApiID, body FuzzerPostUpdateStatusJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
 	if err != nil {
 		return nil, err
 	}
@@ -6184,6 +6535,26 @@ type ClientWithResponsesInterface interface {
 	// BflagetVersion request
 	BflagetVersionWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*BflagetVersionResponse, error)
 
+	// CustomrulesGetApiFindings request
+	CustomrulesGetApiFindingsWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*CustomrulesGetApiFindingsResponse, error)
+
+	// CustomrulesResetApiFindings request
+	CustomrulesResetApiFindingsWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*CustomrulesResetApiFindingsResponse, error)
+
+	// CustomrulesListRules request
+	CustomrulesListRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CustomrulesListRulesResponse, error)
+
+	// CustomrulesDeleteRule request
+	CustomrulesDeleteRuleWithResponse(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*CustomrulesDeleteRuleResponse, error)
+
+	// CustomrulesGetRule request
+	CustomrulesGetRuleWithResponse(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*CustomrulesGetRuleResponse, error)
+
+	// CustomrulesPutRule request with any body
+	CustomrulesPutRuleWithBodyWithResponse(ctx context.Context, ruleName RuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CustomrulesPutRuleResponse, error)
+
+	CustomrulesPutRuleWithResponse(ctx context.Context, ruleName RuleName, body CustomrulesPutRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CustomrulesPutRuleResponse, error)
+
 	// FuzzerGetAnnotatedSpec request
 	FuzzerGetAnnotatedSpecWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*FuzzerGetAnnotatedSpecResponse, error)
 
@@ -7102,22 +7473,157 @@ func (r PutModulesBflaAuthorizationModelApiIDLearningStopResponse) Status() stri
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutModulesBflaAuthorizationModelApiIDLearningStopResponse) StatusCode() int {
+func (r PutModulesBflaAuthorizationModelApiIDLearningStopResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostModulesBflaAuthorizationModelApiIDResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.ApiResponse
+	JSONDefault  *externalRef0.ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PostModulesBflaAuthorizationModelApiIDResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostModulesBflaAuthorizationModelApiIDResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetModulesBflaAuthorizationModelApiIDStateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *BFLAState
+	JSONDefault  *externalRef0.ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r GetModulesBflaAuthorizationModelApiIDStateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetModulesBflaAuthorizationModelApiIDStateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BflagetEventResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *APIEventAnnotations
+}
+
+// Status returns HTTPResponse.Status
+func (r BflagetEventResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BflagetEventResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutModulesBflaEventIdOperationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.ApiResponse
+	JSONDefault  *externalRef0.ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r PutModulesBflaEventIdOperationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutModulesBflaEventIdOperationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type BflagetVersionResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.ModuleVersion
+}
+
+// Status returns HTTPResponse.Status
+func (r BflagetVersionResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r BflagetVersionResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CustomrulesGetApiFindingsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *externalRef0.APIFindings
+	JSONDefault  *externalRef0.ApiResponse
+}
+
+// Status returns HTTPResponse.Status
+func (r CustomrulesGetApiFindingsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CustomrulesGetApiFindingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PostModulesBflaAuthorizationModelApiIDResetResponse struct {
+type CustomrulesResetApiFindingsResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *externalRef0.ApiResponse
 	JSONDefault  *externalRef0.ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PostModulesBflaAuthorizationModelApiIDResetResponse) Status() string {
+func (r CustomrulesResetApiFindingsResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7125,22 +7631,21 @@ func (r PostModulesBflaAuthorizationModelApiIDResetResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PostModulesBflaAuthorizationModelApiIDResetResponse) StatusCode() int {
+func (r CustomrulesResetApiFindingsResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type GetModulesBflaAuthorizationModelApiIDStateResponse struct {
+type CustomrulesListRulesResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *BFLAState
-	JSONDefault  *externalRef0.ApiResponse
+	JSON200      *Rules
 }
 
 // Status returns HTTPResponse.Status
-func (r GetModulesBflaAuthorizationModelApiIDStateResponse) Status() string {
+func (r CustomrulesListRulesResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7148,21 +7653,21 @@ func (r GetModulesBflaAuthorizationModelApiIDStateResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r GetModulesBflaAuthorizationModelApiIDStateResponse) StatusCode() int {
+func (r CustomrulesListRulesResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BflagetEventResponse struct {
+type CustomrulesDeleteRuleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *APIEventAnnotations
+	JSONDefault  *externalRef0.ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r BflagetEventResponse) Status() string {
+func (r CustomrulesDeleteRuleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7170,22 +7675,22 @@ func (r BflagetEventResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BflagetEventResponse) StatusCode() int {
+func (r CustomrulesDeleteRuleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type PutModulesBflaEventIdOperationResponse struct {
+type CustomrulesGetRuleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *externalRef0.ApiResponse
+	JSON200      *Rule
 	JSONDefault  *externalRef0.ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r PutModulesBflaEventIdOperationResponse) Status() string {
+func (r CustomrulesGetRuleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7193,21 +7698,22 @@ func (r PutModulesBflaEventIdOperationResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r PutModulesBflaEventIdOperationResponse) StatusCode() int {
+func (r CustomrulesGetRuleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
 	return 0
 }
 
-type BflagetVersionResponse struct {
+type CustomrulesPutRuleResponse struct {
 	Body         []byte
 	HTTPResponse *http.Response
-	JSON200      *externalRef0.ModuleVersion
+	JSON200      *Rule
+	JSONDefault  *externalRef0.ApiResponse
 }
 
 // Status returns HTTPResponse.Status
-func (r BflagetVersionResponse) Status() string {
+func (r CustomrulesPutRuleResponse) Status() string {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.Status
 	}
@@ -7215,7 +7721,7 @@ func (r BflagetVersionResponse) Status() string {
 }
 
 // StatusCode returns HTTPResponse.StatusCode
-func (r BflagetVersionResponse) StatusCode() int {
+func (r CustomrulesPutRuleResponse) StatusCode() int {
 	if r.HTTPResponse != nil {
 		return r.HTTPResponse.StatusCode
 	}
@@ -8182,6 +8688,68 @@ func (c *ClientWithResponses) BflagetVersionWithResponse(ctx context.Context, re
 	return ParseBflagetVersionResponse(rsp)
 }
 
+// CustomrulesGetApiFindingsWithResponse request returning *CustomrulesGetApiFindingsResponse
+func (c *ClientWithResponses) CustomrulesGetApiFindingsWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*CustomrulesGetApiFindingsResponse, error) {
+	rsp, err := c.CustomrulesGetApiFindings(ctx, apiID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesGetApiFindingsResponse(rsp)
+}
+
+// CustomrulesResetApiFindingsWithResponse request returning *CustomrulesResetApiFindingsResponse
+func (c *ClientWithResponses) CustomrulesResetApiFindingsWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*CustomrulesResetApiFindingsResponse, error) {
+	rsp, err := c.CustomrulesResetApiFindings(ctx, apiID, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesResetApiFindingsResponse(rsp)
+}
+
+// CustomrulesListRulesWithResponse request returning *CustomrulesListRulesResponse
+func (c *ClientWithResponses) CustomrulesListRulesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*CustomrulesListRulesResponse, error) {
+	rsp, err := c.CustomrulesListRules(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesListRulesResponse(rsp)
+}
+
+// CustomrulesDeleteRuleWithResponse request returning *CustomrulesDeleteRuleResponse
+func (c *ClientWithResponses) CustomrulesDeleteRuleWithResponse(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*CustomrulesDeleteRuleResponse, error) {
+	rsp, err := c.CustomrulesDeleteRule(ctx, ruleName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesDeleteRuleResponse(rsp)
+}
+
+// CustomrulesGetRuleWithResponse request returning *CustomrulesGetRuleResponse
+func (c *ClientWithResponses) CustomrulesGetRuleWithResponse(ctx context.Context, ruleName RuleName, reqEditors ...RequestEditorFn) (*CustomrulesGetRuleResponse, error) {
+	rsp, err := c.CustomrulesGetRule(ctx, ruleName, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesGetRuleResponse(rsp)
+}
+
+// CustomrulesPutRuleWithBodyWithResponse request with arbitrary body returning *CustomrulesPutRuleResponse
+func (c *ClientWithResponses) CustomrulesPutRuleWithBodyWithResponse(ctx context.Context, ruleName RuleName, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CustomrulesPutRuleResponse, error) {
+	rsp, err := c.CustomrulesPutRuleWithBody(ctx, ruleName, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesPutRuleResponse(rsp)
+}
+
+func (c *ClientWithResponses) CustomrulesPutRuleWithResponse(ctx context.Context, ruleName RuleName, body CustomrulesPutRuleJSONRequestBody, reqEditors ...RequestEditorFn) (*CustomrulesPutRuleResponse, error) {
+	rsp, err := c.CustomrulesPutRule(ctx, ruleName, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCustomrulesPutRuleResponse(rsp)
+}
+
 // FuzzerGetAnnotatedSpecWithResponse request returning *FuzzerGetAnnotatedSpecResponse
 func (c *ClientWithResponses) FuzzerGetAnnotatedSpecWithResponse(ctx context.Context, apiID externalRef0.ApiID, reqEditors ...RequestEditorFn) (*FuzzerGetAnnotatedSpecResponse, error) {
 	rsp, err := c.FuzzerGetAnnotatedSpec(ctx, apiID, reqEditors...)
@@ -9349,22 +9917,220 @@ func ParseGetFeaturesResponse(rsp *http.Response) (*GetFeaturesResponse, error)
 	return response, nil
 }
 
-// ParseBflaGetApiFindingsResponse parses an HTTP response from a BflaGetApiFindingsWithResponse call
-func ParseBflaGetApiFindingsResponse(rsp *http.Response) (*BflaGetApiFindingsResponse, error) {
+// ParseBflaGetApiFindingsResponse parses an HTTP response from a BflaGetApiFindingsWithResponse call
+func ParseBflaGetApiFindingsResponse(rsp *http.Response) (*BflaGetApiFindingsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &BflaGetApiFindingsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.APIFindings
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetModulesBflaAuthorizationModelApiIDResponse parses an HTTP response from a GetModulesBflaAuthorizationModelApiIDWithResponse call
+func ParseGetModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*GetModulesBflaAuthorizationModelApiIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetModulesBflaAuthorizationModelApiIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthorizationModel
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostModulesBflaAuthorizationModelApiIDResponse parses an HTTP response from a PostModulesBflaAuthorizationModelApiIDWithResponse call
+func ParsePostModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*PostModulesBflaAuthorizationModelApiIDResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostModulesBflaAuthorizationModelApiIDResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutModulesBflaAuthorizationModelApiIDApproveResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDApproveWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDApproveResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDApproveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutModulesBflaAuthorizationModelApiIDApproveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutModulesBflaAuthorizationModelApiIDDenyResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDenyWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDDenyResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDenyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutModulesBflaAuthorizationModelApiIDDenyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutModulesBflaAuthorizationModelApiIDDetectionStartResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDetectionStartWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDDetectionStartResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDetectionStartResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutModulesBflaAuthorizationModelApiIDDetectionStartResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutModulesBflaAuthorizationModelApiIDDetectionStopResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDetectionStopWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDDetectionStopResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDetectionStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BflaGetApiFindingsResponse{
+	response := &PutModulesBflaAuthorizationModelApiIDDetectionStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.APIFindings
+		var dest externalRef0.ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9382,22 +10148,22 @@ func ParseBflaGetApiFindingsResponse(rsp *http.Response) (*BflaGetApiFindingsRes
 	return response, nil
 }
 
-// ParseGetModulesBflaAuthorizationModelApiIDResponse parses an HTTP response from a GetModulesBflaAuthorizationModelApiIDWithResponse call
-func ParseGetModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*GetModulesBflaAuthorizationModelApiIDResponse, error) {
+// ParsePutModulesBflaAuthorizationModelApiIDLearningStartResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDLearningStartWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDLearningStartResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDLearningStartResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetModulesBflaAuthorizationModelApiIDResponse{
+	response := &PutModulesBflaAuthorizationModelApiIDLearningStartResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AuthorizationModel
+		var dest externalRef0.ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9415,26 +10181,26 @@ func ParseGetModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*Ge
 	return response, nil
 }
 
-// ParsePostModulesBflaAuthorizationModelApiIDResponse parses an HTTP response from a PostModulesBflaAuthorizationModelApiIDWithResponse call
-func ParsePostModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*PostModulesBflaAuthorizationModelApiIDResponse, error) {
+// ParsePutModulesBflaAuthorizationModelApiIDLearningStopResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDLearningStopWithResponse call
+func ParsePutModulesBflaAuthorizationModelApiIDLearningStopResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDLearningStopResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostModulesBflaAuthorizationModelApiIDResponse{
+	response := &PutModulesBflaAuthorizationModelApiIDLearningStopResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
 		var dest externalRef0.ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON201 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest externalRef0.ApiResponse
@@ -9448,15 +10214,15 @@ func ParsePostModulesBflaAuthorizationModelApiIDResponse(rsp *http.Response) (*P
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDApproveResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDApproveWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDApproveResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDApproveResponse, error) {
+// ParsePostModulesBflaAuthorizationModelApiIDResetResponse parses an HTTP response from a PostModulesBflaAuthorizationModelApiIDResetWithResponse call
+func ParsePostModulesBflaAuthorizationModelApiIDResetResponse(rsp *http.Response) (*PostModulesBflaAuthorizationModelApiIDResetResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDApproveResponse{
+	response := &PostModulesBflaAuthorizationModelApiIDResetResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9481,22 +10247,22 @@ func ParsePutModulesBflaAuthorizationModelApiIDApproveResponse(rsp *http.Respons
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDDenyResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDenyWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDDenyResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDenyResponse, error) {
+// ParseGetModulesBflaAuthorizationModelApiIDStateResponse parses an HTTP response from a GetModulesBflaAuthorizationModelApiIDStateWithResponse call
+func ParseGetModulesBflaAuthorizationModelApiIDStateResponse(rsp *http.Response) (*GetModulesBflaAuthorizationModelApiIDStateResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDDenyResponse{
+	response := &GetModulesBflaAuthorizationModelApiIDStateResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
+		var dest BFLAState
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9514,48 +10280,41 @@ func ParsePutModulesBflaAuthorizationModelApiIDDenyResponse(rsp *http.Response)
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDDetectionStartResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDetectionStartWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDDetectionStartResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDetectionStartResponse, error) {
+// ParseBflagetEventResponse parses an HTTP response from a BflagetEventWithResponse call
+func ParseBflagetEventResponse(rsp *http.Response) (*BflagetEventResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDDetectionStartResponse{
+	response := &BflagetEventResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
+		var dest APIEventAnnotations
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest externalRef0.ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDDetectionStopResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDDetectionStopWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDDetectionStopResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDDetectionStopResponse, error) {
+// ParsePutModulesBflaEventIdOperationResponse parses an HTTP response from a PutModulesBflaEventIdOperationWithResponse call
+func ParsePutModulesBflaEventIdOperationResponse(rsp *http.Response) (*PutModulesBflaEventIdOperationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDDetectionStopResponse{
+	response := &PutModulesBflaEventIdOperationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -9580,55 +10339,48 @@ func ParsePutModulesBflaAuthorizationModelApiIDDetectionStopResponse(rsp *http.R
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDLearningStartResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDLearningStartWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDLearningStartResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDLearningStartResponse, error) {
+// ParseBflagetVersionResponse parses an HTTP response from a BflagetVersionWithResponse call
+func ParseBflagetVersionResponse(rsp *http.Response) (*BflagetVersionResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDLearningStartResponse{
+	response := &BflagetVersionResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
+		var dest externalRef0.ModuleVersion
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest externalRef0.ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParsePutModulesBflaAuthorizationModelApiIDLearningStopResponse parses an HTTP response from a PutModulesBflaAuthorizationModelApiIDLearningStopWithResponse call
-func ParsePutModulesBflaAuthorizationModelApiIDLearningStopResponse(rsp *http.Response) (*PutModulesBflaAuthorizationModelApiIDLearningStopResponse, error) {
+// ParseCustomrulesGetApiFindingsResponse parses an HTTP response from a CustomrulesGetApiFindingsWithResponse call
+func ParseCustomrulesGetApiFindingsResponse(rsp *http.Response) (*CustomrulesGetApiFindingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaAuthorizationModelApiIDLearningStopResponse{
+	response := &CustomrulesGetApiFindingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
+		var dest externalRef0.APIFindings
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9646,27 +10398,20 @@ func ParsePutModulesBflaAuthorizationModelApiIDLearningStopResponse(rsp *http.Re
 	return response, nil
 }
 
-// ParsePostModulesBflaAuthorizationModelApiIDResetResponse parses an HTTP response from a PostModulesBflaAuthorizationModelApiIDResetWithResponse call
-func ParsePostModulesBflaAuthorizationModelApiIDResetResponse(rsp *http.Response) (*PostModulesBflaAuthorizationModelApiIDResetResponse, error) {
+// ParseCustomrulesResetApiFindingsResponse parses an HTTP response from a CustomrulesResetApiFindingsWithResponse call
+func ParseCustomrulesResetApiFindingsResponse(rsp *http.Response) (*CustomrulesResetApiFindingsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PostModulesBflaAuthorizationModelApiIDResetResponse{
+	response := &CustomrulesResetApiFindingsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSON200 = &dest
-
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
 		var dest externalRef0.ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -9679,81 +10424,74 @@ func ParsePostModulesBflaAuthorizationModelApiIDResetResponse(rsp *http.Response
 	return response, nil
 }
 
-// ParseGetModulesBflaAuthorizationModelApiIDStateResponse parses an HTTP response from a GetModulesBflaAuthorizationModelApiIDStateWithResponse call
-func ParseGetModulesBflaAuthorizationModelApiIDStateResponse(rsp *http.Response) (*GetModulesBflaAuthorizationModelApiIDStateResponse, error) {
+// ParseCustomrulesListRulesResponse parses an HTTP response from a CustomrulesListRulesWithResponse call
+func ParseCustomrulesListRulesResponse(rsp *http.Response) (*CustomrulesListRulesResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetModulesBflaAuthorizationModelApiIDStateResponse{
+	response := &CustomrulesListRulesResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest BFLAState
+		var dest Rules
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
-		var dest externalRef0.ApiResponse
-		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
-			return nil, err
-		}
-		response.JSONDefault = &dest
-
 	}
 
 	return response, nil
 }
 
-// ParseBflagetEventResponse parses an HTTP response from a BflagetEventWithResponse call
-func ParseBflagetEventResponse(rsp *http.Response) (*BflagetEventResponse, error) {
+// ParseCustomrulesDeleteRuleResponse parses an HTTP response from a CustomrulesDeleteRuleWithResponse call
+func ParseCustomrulesDeleteRuleResponse(rsp *http.Response) (*CustomrulesDeleteRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BflagetEventResponse{
+	response := &CustomrulesDeleteRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIEventAnnotations
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSONDefault = &dest
 
 	}
 
 	return response, nil
 }
 
-// ParsePutModulesBflaEventIdOperationResponse parses an HTTP response from a PutModulesBflaEventIdOperationWithResponse call
-func ParsePutModulesBflaEventIdOperationResponse(rsp *http.Response) (*PutModulesBflaEventIdOperationResponse, error) {
+// ParseCustomrulesGetRuleResponse parses an HTTP response from a CustomrulesGetRuleWithResponse call
+func ParseCustomrulesGetRuleResponse(rsp *http.Response) (*CustomrulesGetRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &PutModulesBflaEventIdOperationResponse{
+	response := &CustomrulesGetRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ApiResponse
+		var dest Rule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -9771,27 +10509,34 @@ func ParsePutModulesBflaEventIdOperationResponse(rsp *http.Response) (*PutModule
 	return response, nil
 }
 
-// ParseBflagetVersionResponse parses an HTTP response from a BflagetVersionWithResponse call
-func ParseBflagetVersionResponse(rsp *http.Response) (*BflagetVersionResponse, error) {
+// ParseCustomrulesPutRuleResponse parses an HTTP response from a CustomrulesPutRuleWithResponse call
+func ParseCustomrulesPutRuleResponse(rsp *http.Response) (*CustomrulesPutRuleResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &BflagetVersionResponse{
+	response := &CustomrulesPutRuleResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest externalRef0.ModuleVersion
+		var dest Rule
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
 		response.JSON200 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && true:
+		var dest externalRef0.ApiResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSONDefault = &dest
+
 	}
 
 	return response, nil
@@ -10648,6 +11393,24 @@ type ServerInterface interface {
 	// Get the version of this Module
 	// (GET /modules/bfla/version)
 	BflagetVersion(w http.ResponseWriter, r *http.Request)
+	// Get findings for an API and module
+	// (GET /modules/customrules/apiFindings/{apiID})
+	CustomrulesGetApiFindings(w http.ResponseWriter, r *http.Request, apiID externalRef0.ApiID)
+	// Delete all API findings for an API
+	// (POST /modules/customrules/apiFindings/{apiID}/reset)
+	CustomrulesResetApiFindings(w http.ResponseWriter, r *http.Request, apiID externalRef0.ApiID)
+	// List the custom detection rules
+	// (GET /modules/customrules/rules)
+	CustomrulesListRules(w http.ResponseWriter, r *http.Request)
+	// Delete a custom detection rule
+	// (DELETE /modules/customrules/rules/{ruleName})
+	CustomrulesDeleteRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
+	// Get a custom detection rule
+	// (GET /modules/customrules/rules/{ruleName})
+	CustomrulesGetRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
+	// Create or replace a custom detection rule
+	// (PUT /modules/customrules/rules/{ruleName})
+	CustomrulesPutRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
 	// Retreive the annotated spec for an API
 	// (GET /modules/fuzzer/annotatedspec/{apiID})
 	FuzzerGetAnnotatedSpec(w http.ResponseWriter, r *http.Request, apiID externalRef0.ApiID)
@@ -12604,6 +13367,151 @@ func (siw *ServerInterfaceWrapper) BflagetVersion(w http.ResponseWriter, r *http
 	handler.ServeHTTP(w, r.WithContext(ctx))
 }
 
+// CustomrulesGetApiFindings operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesGetApiFindings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apiID" -------------
+	var apiID externalRef0.ApiID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiID", runtime.ParamLocationPath, chi.URLParam(r, "apiID"), &apiID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesGetApiFindings(w, r, apiID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CustomrulesResetApiFindings operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesResetApiFindings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apiID" -------------
+	var apiID externalRef0.ApiID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiID", runtime.ParamLocationPath, chi.URLParam(r, "apiID"), &apiID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesResetApiFindings(w, r, apiID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CustomrulesListRules operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesListRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesListRules(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CustomrulesDeleteRule operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesDeleteRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesDeleteRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CustomrulesGetRule operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesGetRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesGetRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// CustomrulesPutRule operation middleware
+func (siw *ServerInterfaceWrapper) CustomrulesPutRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CustomrulesPutRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
 // FuzzerGetAnnotatedSpec operation middleware
 func (siw *ServerInterfaceWrapper) FuzzerGetAnnotatedSpec(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -13471,6 +14379,24 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/modules/bfla/version", wrapper.BflagetVersion)
 	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/modules/customrules/apiFindings/{apiID}", wrapper.CustomrulesGetApiFindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/modules/customrules/apiFindings/{apiID}/reset", wrapper.CustomrulesResetApiFindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/modules/customrules/rules", wrapper.CustomrulesListRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/modules/customrules/rules/{ruleName}", wrapper.CustomrulesDeleteRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/modules/customrules/rules/{ruleName}", wrapper.CustomrulesGetRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/modules/customrules/rules/{ruleName}", wrapper.CustomrulesPutRule)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/modules/fuzzer/annotatedspec/{apiID}", wrapper.FuzzerGetAnnotatedSpec)
 	})
@@ -13550,149 +14476,158 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9f2/bOrLoVyF0H3DPAbxxu/fsYl+AhwfXdlpvU9trO6e7WwQGY9E2tzKlilRy0iLn",
-	"s1/wl0RJlETZjpP25K/ENn8MZ4Yzw+Fw5pu3CndRSBBh1Dv/5kUwhjvEUCw+zRGhmOFbxD/4iK5iHDEc",
-	"Eu/cm2/DJPDBGhMfkw0FmKyCxEeA6i7AhwyC/+91PMzbf0lQfO91PAJ3yDv30mZex6OrLdpBOcUaJgHz",
-	"ztcwoKjjsfuIN74JwwBB4j08dDwYoJiN6AUOGIrLYPX4z+A9Jj741LsczhbL0fhiAsIYyE8fe7PxdQVM",
-	"YuhPmF7nYMIM7QQy/k+M1t6591/dDGNd2Yx2xbRzdItizO6HJNl5Dyn0MI7hvQn7Qnz/rRoG3qAaDjUs",
-	"ZTEmG/s8ER7eIsLmYczeo3sL8cKYgc/ovoo4ql/Hi9GXBMfI985ZnCATnFpsFOZXMI38dNURZFtj0eK3",
-	"utnWYbyDzDv3EkzY//zZSxeNCUMbFGdTVDEGjDDAPmAhiBFLYlLFAwqUbOoCutU8ZB2OfJMNqwYTDUvE",
-	"dF4P4XgM4/snJGUJBgXbGO5QPyQMYtKAB/7n00o1PYSr+ZRD4tOPmG0dpkTEv26mJR905LICfDDsIzoO",
-	"mdNM45AdOtmcwZi5ooryxg7I0rKrIHWnI8Cbg0+j8WI4G/cuucQd/lP+XyVvxQQHMCaHRcrahw4HiGEC",
-	"OUCjaRM5c40PoWth1kbqFic+hMzGWNMwrxMbpubNj7RqOXObdavJD1k5Iv4C7yx8OCQ+YHiHQLgGbIuA",
-	"hsIGkh7ESe34kKE/Mdm8vC+2kE7j8Bb7yJ9HaFWPikLjEh0sNs8W0hlahYSyOFkxx0lKPRxn4k0HeL1u",
-	"nEA3dBo3pMyuk/kvQAxqJ5PoWUejMjl2iG3DRuUsW+1n7r1jLPog+lv5M4IbC3NO4QYBkuxuUOzCn2IQ",
-	"h4WbFgPvM8dfLZN/gL/hXbIDYnmNVlA6Tt38Ozmkd/6XVx1vh4n88LpjB4xt3WwF3vJwW4GP4mYoiPkc",
-	"DAXebuQCOz4MagdhqqY5RILyIVztAzGdk30QhXHFLhe/VPCa/KnNBo8c1F10oI6L3BRbdLg2i5Q2mHLq",
-	"DxrXlWt9yApjU0G4TW7pchgEPuQjVU+nfm/pI4jRLUZ3lcfN9OeDT5x0G96NQ9KLcNUSjBYOPG4ugp/c",
-	"Bji2n/gw2QAfx2glvqs++vEBrMjzevO+xy0pLrM/qU+D4bzvXdssHBom8Qo1G9a63SFckc3VuPuM6Q7Z",
-	"gTRCKzftxFserp2ospz4AWbkMKNuu5+xontXguKmKMXSHRQlb+eyqINYRMzRzB5ymkNZw1VRiumcFKVo",
-	"ZD/CiMmcDzHZQEc4xlAGWUL7oY/eMlTlR9vECDIUA7aFhB/y0ZcEBtXQqQE/bRhyQIpq3cg+2biHMJEx",
-	"XzMrmVMexFDpQJfVSA4QpW0xHDRjmMVwheZSZvrlWRf8ZyB/B6OBnjKvPPNjuGnQBPtWhsuN9Q+xMleg",
-	"iq6ko0ElzAcahYQiQdEr8pmEd2QYx6EgFBf+iAgzF0ZRgFfCqdH9D+XQfnN3Xs3UJHLK/JoTOSdAYlLB",
-	"NbIjH7c3HfUDGGN2f4EgS2KLDBlkn7gQyXqAtewCIPGFbAkwZaqJOIlT8NPbOEwicHMPBE6BVLG0AzAR",
-	"PTj+wH/ztufc9v3vn+W3alyFd3HC3CCmxliH3AqJ4jBCMcMSr6rHwATccscUM7BNdpCAGEEf3gQI+PnF",
-	"GbOXqdnR04yhlLW1RCkiVt/jCMQsQsGJjZ4Bo+1FGPd1C2WXaq78lAMsM7nCm/+gFeOT2qGxeV41bVU7",
-	"MJbOFG3a3awD6HW8dfL1KxLGYIRWSx+v1+kn/oGq/w0LP4wrvhNEhQQG93zEawvWS8BfYpv/5zLjvgKD",
-	"UsGhay794GqbstezYFnqfitY2qo2jWAj/UCQ5PxbAQJ1oeR0abMOucWiDT9fD+hsMlac2DIO1sDowSu4",
-	"WNwE9ggJmZCUllVxDp0LRdYE15uLy55qmXdDv/8bnchJG0ZIG87QWo7BED9iXlGph2uxYrZ96HjoN4Zi",
-	"AgPbAa7j7TDdQbbaIn++CiNE7a0kr+4JfoEgBh4N4CyQXEvKXMi7e4tQIWJ36d+L+wD6PuYtYbDEihvz",
-	"/fsiNOCGq5l7EEbwS4LA3+eTMVCMwaGDuygQ0vQzul8GiHjnr/9s2wx+nYJ416wa0jWkU3o9cIfgZwnR",
-	"R3QDFuFnRMAWUnCDEAGaJWzqhECbzV4Agzeqm/9jeXbbXNrTsxQSOwilpVGeXYwUhZgIszyUQlK1LoCh",
-	"JSIfEa+V7XIG5giBLWMRPe92fcggF4GfUXyGEVufhfGm64er7pbtgm68Xv31/756fQZGawCZGEsfVVb8",
-	"YFCessM/xAhgCkiYn1j8xAHCFKwxCnzeCBKAdhG7BxIRZznM/VeXG6O0+/vrmyDc0N9ff+N/l9h/+P01",
-	"QXe/v4q4QrAhM+e5esHoETBKVbRLk8jSUTGZtCsjfGzsmF3oJwECd1u82koUIF+vqLyX8rZIp+oAVjpV",
-	"3Ef1W3TYe7/8+8eF9dhiylx1h60WpgREXmoZqKpQkUrQUoskBhth24TrNNKqJI5TjV7sqi0lo6er2aJF",
-	"v5u9Ugp+Ov+WeRbTICyv42UxWOmH/my0GPV7l3Y7MjUbLLZQ7rdS18+Y+NYftAivZeh6oouDo6K0AYYx",
-	"hJrfSu86UyiljxuhsrlLhOp4LGTSNimwPv8aIG6VgQx4ClZhQpjdzZ1jeDGqdWER7osxbGYrPxeNqzCf",
-	"xkmVod2GgTypxihAt5DDHGHAzQ4gqNDsphfDT9XNlPVHHdPiHG7S8Uiy68MgoBX3sDbcCDvYghu+edxJ",
-	"/kFIR7HjbDRvjUmuPtqjcw+M5SJfrFxQiBGxk6sQPSEOKg6XQvYYiha9Q8p4j0oWxr7bpZGOTmgXYSBc",
-	"cLZpv2inmcXg+ZIgmjqZXVzA2dWLXT4aNxFt7hwyH6WdpKwFiHV7ywiR1MpHDaJQ3tGuTAMiY81FHi1z",
-	"ZAEHBbbIdoZVlWkoDb7LSwJ/D9wSdMcHtFhU6E7u7f/QkCjLz0bxMPDtA0wC32GAgnLQo2WAVSiK0SBH",
-	"cUzYX3+x7pZe5vEoYCujDcc+jZRzrrTCgsTYX1q49XQXBPazJD94K9OifCKsFIvtPPs247o3HZ2BcRIE",
-	"4OpqNACvwA5BQgFm2cFYt7+5Nz12P63jcCcUy9VIeuykFf1zTp1UXgHYmMP0XQkVGUzW3vknJ6eX99Cx",
-	"mB+tFdbDQxXnWiKytbhRdFMRLVYeKvNjhbBI7wds3hkJEWBbyPgJT8ZyIZ8fRmEQgBWkiArLH+JAeCyL",
-	"Z4YdolQFqdXvaN2wAhvSeeFOo4Rtwxh/Fdt2zr9EbyBFFpp9Rna1dguDxAFsGRAvG5dBv1bAa2obJNTx",
-	"y17H0+HLVRS64oixO2nFZbGW8m6mvBrPZtSh3zCXdZtehOlRBiTo7khj2XdwFIe3yJ+JMJsyfmT4jYge",
-	"anXameX6OcJiMtyH0EdBGZ4AwZgoP2hZnvOW2WnNDV2lSSd6EBs1uJZ1kU9z3a7I7OkAOWg72cKunTDT",
-	"S3yMiNSiBY5WbZFvxxEi/jKh6s2Y2wVDwZVeZvk61/rnv9FluJe7P4CtTeIG6/VO4rj9/UWBiBkOOya+",
-	"c478nN2an9iNxOOQpb5Cd6nNRXSuJxfX7TaAkrk1G8PCdBk77rntUo62cFh2DCubWVWnLQY3tHWIY0Zi",
-	"dfpITyPpCtXIjTSUGlOeFbhFsOMGsAxL2MEoUjIsU8vVdo66c3gDKV7xKWpIrxp0vDcIxiiuHdps8pDa",
-	"HPdj470PF6kEuXGdmrqR3fSCmhrmwLu2Y1fYIyVmZC72ozlY7m1SyWHcSGnTNClZf9xWUdtGGNzc9oZm",
-	"b/kWK33Ke2ZEA7zpzUf93tXinSc8z4vJ++GY03bYmw1n8hMHDjN5U1aEySYhtVBDph3Fv1zOF73ZwpMt",
-	"lpfD3mw8Gr/VnwfDxbC/ML4QDRZWa8uQm8Yc48lyPh32vY5njH05fDtajD70FkOv482v5tNRfzS5mi8/",
-	"DAejqw/5796N3r6zz1eUeCUy8BbAbCIMb+NYRIzfKNgllAEuyYlfssXNltoOqLduSz1s/GTs7eOb5xGk",
-	"9C6M7fKTq7EKL3thIWnLTjai3VzPCZ/jr4fpgRtue0QzO4SDQiRBwbFvRxWOltD3Y0Tt6iS7L9M8L++k",
-	"xC72Ot77yfjt8p/L/mQ8v/ownC1HA3v4dunyIr2vMgAQiziaZ4pbWnOELFtnoa84xfGVCy8+PriDFPBO",
-	"gCKhm9zMs328qU/tNMssjOIDMbbVl5ICJShAO2ReyuSdsdyQpQzuovJQrIBjTCVcHMnKjXMGEop8AAMa",
-	"Aqh+vkUxlTdZjsbxoUeXlEs6GZN1LG5Eiw83O/TkcWGThabbOnsCMZ4sB6OLC0M7/nvy4c1oqL+dv+sN",
-	"Jh/1p7fD8XDWu9QfdWeb+kgj9uBNYH2lyr/v+piKcBFoxC/m9x2qGEB+D9YBNLjLfDtSQoAR6lNyo1aH",
-	"19TG02gbYZC76naMmBlbQ2T0kOMK/2eM6efyWPzb6rFmvI/V86gaZFFOVVg7+J7WvE1Xs0rXh/Ol7fiG",
-	"r1BM1pH7lht+EdwoF7ixYHG/a7/HLSyZWtecfP2KyWaGxAM4hiwmaD+JY0QYkCGlIEbK4VnLWeXI3Mqg",
-	"Bx0WKiJTpARLfdBiTt81kmEG79K1GuvngtaG/MrQAAHJYwKawzoHzwXczDqo8FrYj6iIsmozM2WREhs0",
-	"8UruKJ/Hk2wAGNyA1EFVjq41+GGfMJUCb4voFwvStnizRTQNV2kTvRSaK6y9pBe6qkd8TUl1GUvT+Igi",
-	"fsTPMgRDBpxhmqFK7HvgJ5xIMkAMiaWZ12fyjknLvPJ4iA8Hm8M7TPrkob42x7fSs54/plabx02WRPA+",
-	"CKFfcdGdXZTYfhS63ua5S2Jsv2JA8U2LzTGV9kj94hdwU7stsNxh+VUfwKp2rbuAm/RuUWsL46uSmdve",
-	"9V0hEQzcpV9W7NDiUVfCZoBi50NcL6Kk86BHfNmljJq1bAek4BSvDyQv2gKgozjcFM5sBl/F6RRZoPQ0",
-	"198Zg/qiw6525ETp0nNIseGCOvmocxizurCojjE3d6tEyHVpkxQx30QjF6+X6dAaTMZDr+MNZ7PJzOt4",
-	"o/FyOpu8nQ3n80pgbLz+DrOK4LWV/ropWqHj/fancMepEfHNKiIEjhDeUvmiqNIcyb2CEYYiBCmhO+AO",
-	"sy3XCDimlsc0ja9gVtUApLBVihQjToGKhbmnXMmjYZEN5HYTWNO//Pyy/MSo8inRDhK4kUEREBjLK0vz",
-	"wisyO+0apjPtyYbLh3xQyKA85WigT00mwzgFAVoRnPlYDH/V2+HC63jvhr2B1/Gmkzn/NL1aiGQDl0Ph",
-	"pO1PxuNhn381mS5Gk/Hc63iLWa/Pf5v2Fn27izZ3s2e7g/9V+TKOExxMamOMEqt7r+jsFJ43MXUaPZwN",
-	"2zFhtvkw8kZlacVHDSsUiWh8x0e8JUBFiKqB/jygt5V0KeDrtgYZkwiRXoTnEVrRugiZGEUxoogwbTrr",
-	"RzVKwxvhOWK3UzGgRd/n4seaXF0qDin/6KRd5wf7oqX+KwatQBlxISIWyb1gJfGFuFBW38r/bTvJPCSX",
-	"N1JqwugwPFvcbu1Bv/qdTfqmRjYGTF1L1og480icvsmpOMpX71d1smnhPjCNa9Z4ejZRaiGk8fObhPiB",
-	"5cLRhwxa/MvyLZ3IrCv5KolRegcYwzubf46P1Km7W3BFgeig47ndjMn5KozR8DfMTHNSd14aUWiFbGXy",
-	"B7DBtyLTDWIQBxTAmzCRNwYiJAqkhqheq5wFqO5WA6TaZz7L0AfSZkAKwP8HclabPjylgzVduhiQZl1S",
-	"hSuIfW1lH8Uf9UxknhDzbFT3pqUwRkbdFtb3m9FiPnr7jivvRe9yMhdKfDgeCCU+6c2XvXHv8l/zITfN",
-	"386mffn53+Iz/1noefPL3nS0vLj6N/9gR0hqXZVIa+O1FkuZX/X7/NTQ8cbDxcfJ7P3yoje6vJpxM2Qx",
-	"mSwvJ+J+edqbzYdLfdwQlwKjftrUgNkGjg1qg0KF7AfqF26lQfOVmgL4cvLR63jpvba4zO546Xsqfha6",
-	"mHCIsmdtqk0ZiG0YswWibGqcbG05JW4Q1WpUtBOwERCSTSi2jXRMlR+tD1yerA9UzEgFBFNjTsMLlqYh",
-	"fP3KzEP4quGGwjiwiyQ6zJqNJ93helLRtgBB+WCYiSOVy6fZ8ZYBYT9Oy1wYnEYgRUT1kTolaJW/wxhu",
-	"Zve5tSPbAb6qp0H/Ae4Q3fVDk/riilk5lJQK6wDMN8x9WWcpXagbWnUX3OzpiVvAnPW0gBs375vJlDQL",
-	"NCyzZA0n6hczisAlJhOvYkbOryaa3hLuE6twqItGr5HaQydcbjJk9yL+qxNrpFOeKKQzW6IIdpkbF/9Z",
-	"OJbwxE1nk19HgyE/78+G/cl4vphd9RfDgfXsMU9WK0Rp+8cOAJPsmQOVo8gm6ncSsq167d7i6UMZz8lm",
-	"gyirjmZ3f+9zyrj3hTrdFOIJ4jiMK6VWL5VBCrcctTIFVbbnh+YQ9qQVx1bfqU3yfHR3x7tNAoJieIMD",
-	"7OLV/7XQ3DwsLhC1Sk7+/TtoPx62U865Q0/jo7sqfhqRKLFYEjobpgiAxLxNFnmqvc92u1DFJraM4JMu",
-	"h6i5bwrzgLe23mTIca7rFpx1dgnFlesXw9rjb/9xNeq/F97Pi97VpfSDDqemVs3PbNtjpqF+KvFfOiAI",
-	"NZBZmKeGQxkcKRQfMdtmtu7JxV4ANUgLN1kkIh3VBV6MVgjfqpieI8imJzhCZXeezrZ0diX444txTDbS",
-	"K57Gyec5tDcd0RHpw9W24pVRa6d5JzdmlYQ7OLJNLPq5hLXJFdmWWnfZ19PvouWjaEwBRcoJyLuJG9Es",
-	"rr91mFvuZqjx9fcVwV8SlUEM+4gwvL7noEBgPuG2Hg4rItg7Tm9XDAzpQ1GCHS+ALOEalY9civOYiYKm",
-	"o7fD4fKfXse7+MvyzejtUuRgECHuxqvcxb/eZx9tR4rHvXj6tSwwXKJRC1foMWZ4VZ2cJ1wDLpgM7u/r",
-	"HjYhtsWbrftQ73hr2zBBeOc+ymV4V5HdxMc2W6lqnA+yvW2o2vxF5ZEcBEQ+FjgjagwrHgSo7DgynD+M",
-	"wT3cBepGoETUPQdpPIPyr3SaxfRdVvbOiIt5z1AQ3uuzV2evVPgWgRH2zr3/EV8ZYaxd7XcRnzZIaO40",
-	"xmrke+feW8R6aaNOri5khVGXNelmacmrDDqjsS7E5NA0ko/QndqJ+jUObYvFEh266MoKLk2z+g9usBQq",
-	"Czp0KpQ7ckKPUcvGuX2WI92xSylvvmO/Qj0Cx16FAg4utCknnG/ZqxVKbOn1W3W7bNWtWLGjVZ9WC7OX",
-	"3mvfcd9Jc9Xv9unaauJyiTQXzNoqfjj2a9++HVvaKlw49mu/Uy2lVlzkYq7or3sHacteF1L6//nVq1aZ",
-	"/J3SbRq5zGVyRapTw27wLSLyNV4MyQZ1wFqsQ0YZc1V1BkTvAJEN28q3wjcIBOEditMCEPwYYpRlc83b",
-	"IrMO7pUdUqWErFqGzNAuV7J/2shy8QN1JSDdfOqpnn2ZKVG7uSINol5CstvB+F7aMQZNxI+Z9dP9huTt",
-	"04OTHaSvqkrmUDmFl8yvWVVEA6UDHVSA6lDGduOd05KokkLdyJL30ZVkpZyRfyQSpos+MSmzYEtxBPIl",
-	"CBXUjauSc7qS2J7d84XOJ6BzPo62TOw0Z14DPbN2bQ+cOsXf050hy1XSj3qOLNYpb9OllTlYVTzcvWt7",
-	"o9BeTN6FRi0t/2gvc99S29mtY1XRZkeO8nXzkxqw0qST8fAFG/aRbVUdNt/KVM2De2AS85PJTZxKOpHW",
-	"lVpE4jSkRZmowtbfhP79MZVHvlZQuQTakXWVeuDw2KjuizQneWzLt5IltSR3W3cdh7t3HOvE1wnIXZRV",
-	"T2zVQt+2CkyUOXeUYIcLhHKBZsfs77VU++XVL6eqkMepOhqAccjARZgQ/5j7UzADEFmNRXF6cUoXaHfj",
-	"mx7xF8WXd/vyUWmsx+Wr5naWko0v3PgU3Cj+KRbHLHPoN9H/oWtUjnPmxV5a4K21MT7yvcc+8hS11gk0",
-	"d1p6RJBDLbMS5Vn9rju42aD4TC/RmQCp4SkH+DuVaVOeghg1V5rFq8yTGlOpi0G/3lQw4QDVkKZQDWxf",
-	"+uTt+xciVRHJ/s7WiVJUv/B1JspcP+F9bjIr92b5xLuj/No5jBBJKwDpOF1dTI7TqIko3ahUVSNAMrgt",
-	"T6SB+N5Op1wlhGdIs+L7hMcn2xWhXNMUfKc28nQ8FY9dODcm7JFxffxDqI6decgf1FmcoPJh9PXjTFtj",
-	"SLbjJ8s728LYItUmDLCvAsdllY5jcVDP94UnxsdM8cw+uzu2l79pv8VtNVBe9nm6zy2+83bUKj9QcleV",
-	"hb7PkjB5EJ/CYEmJEmsYSsSQv8iDVqn6iZN7b6ZGKFRPaUsSDcijieoCfE4S+3vep70oCu5lin21csUG",
-	"gIUqlbKqqlhkmZRPRO2c7tbIMFazQ0XjNBvZcwm8bBfGuEdEorJNxCvHQZueOby37/4SCvkSCvkSCvkS",
-	"CvlMQiEPNaXcUilq7VJ+Sn4SLyrYYp2b+A6zLSbc2kWBqJ4hAgzXHEfpa0jzhjcXxsexEodBl6C7Aaar",
-	"8Ja3Eu8hDKurWHsCi4fK4R0FEKwCjAgDP0GwgQzdwftc/Uqu4EWlFfHIYqXeW8gcVATdBffAT2cVt8+i",
-	"hn9I0sT24kYaBjGC/r3MWEU7AIskBnhDwhj5Z2Zq29Qs7Mt1jUvL2t+is6SirI4AKCyro5yHOj0lgTt0",
-	"rtJ97FkJSwJgu3//wYzHAqflOKpf5CjJRHneNhOm1lmOimcWZvOjRo0IQJY0g8Qh96xr7EdTMtdc6EYO",
-	"jicI4dArXYVkjTcJ3yX5RddGdFTR6fgHtRxSbRvr9WNNdZrADii2TO5ZbOXO6X7L3Vs/NPuyLHRa5Gos",
-	"l9D5iyXJ1pPcRucuhUnIwPqYd9ISPcUHyQ+dNpKpAZOvTsWYOUxhItWcylf/wxCMm1x1C23nYMgXGhfp",
-	"N7o+pNubEMZ+6uyo01QD3TqtKnwaJ8cjhyOoatCnCyFMZEVmOwG6AWSIsjQpmTMxLo1+pziL5NLDPc15",
-	"RKIqC1unlUjdhZRdUVkF2RmjH3SnU6CzF+GnPNpxBMk8HuK8IvCoipvVsuGFbvOYezS1uNVs3JCrxUtp",
-	"eSJxT/bOTxvweoXyRRw/QcLVVn9b7NRYUEB8q/oqw9dSlUGgdidyytDuzTqAnEt1jlx5OTQwH7UVAtbo",
-	"Z3nlr4suqTyuxqLk2B2xpt50dAZG/FC8Q4TJYgdijbJR6RD7Zh1A6c02smAXZLzlqY1Malb30MYh25mD",
-	"SpgjQjHDt4+tFNL6VFa10BPoBmm9KpXpOb8bT2F+iM0M4qxFke1TJhEXkxJszhYp9WVi0k8e50Pv2sKa",
-	"pcrcFg4tCQWZMYlyZrKU9la8ciquelRGsZRLdxXXT8QgBYrXnXifHxkf4VLUSsHHPHI3kOw5M0sL8aBv",
-	"04U7qiLYqZm/eml5jBPqIDH2FxEgnw6e1rCtHr2UDsg+kgL64HE+/432hWvyCreD65FPU98dbwfhCgZ/",
-	"2oPDRcWWg9h7IGu+vPD2C28/O95maCVAFS6bQ9lcDSZuaH8U0++PocZNRgijo/FBGL2wwffEBgGCMcFk",
-	"cwxxcKnGOrU0qFA2JF7KdLGeRbOcLA3IH4yJDhUlGQ+9SJLviwliRKXX6BC/w0wM8kL374juVOdP399p",
-	"KFOw/xhUf3Nx2ZPr+d5pLuL6ut9wTSY+TtMNYjIrnQv93I59J1LO05EAvEdIyFQl+4pMAqKdrNINc61t",
-	"11EoayzeKGQdhIue49Yok+l0oMtI0f2WUuDBUdOqZGxZ6f/HIFTHOkpozLnfls0X2H0R2O0cAUZ+feul",
-	"o2ZZ1U5GyWIKPlRfIm4Q+zUtaPFopMgXrLag5FcTYqQBtm/IytU1InGdfP2K4q7awsinEVo13uTOEIsR",
-	"vkXm7s+9I5TXdbLqYAf88uqXLE0JCNkWxXeYlpF/IWB5i7S8qnq5+Wy15lFSIaRBraKwyB5BYfnhRFEh",
-	"XW3Ikvrf/lDYCAjLMZwb5UuMJ7msgvWeTwhBxoDGLfpLEMEPG0RgY0f+J7X8zaJWVoaUlZ5krbn8Bqjg",
-	"rFw5sx/jPGAp01ZpWqyTAGQUPb1sQ/EtLsa7/uUgKd8ahhFhKCYwALZ4tmp+0gK1TpTmeDerjnY8zs2q",
-	"Nv9IfKsrwr1w7dNzbeaktz6la8e1orUqkfedB/tktVAf+b2aUQC2zYY4KTOq6uVvoA9mEtcvu/KRd6W6",
-	"9ajYlGEEflpBskLBzwCCOCFElDJ03KRh9BR71PEd1Qurfxes7sqBLqwvLaeU+b+llawdPCJQ19c1/SCy",
-	"piaWB1DMKMhqY1eaWyc3tTp71cC1gGMuzqF8RWVF8OtH1nJG9eZmN4zdC2KhdivfRzWndem2zno/Pr8J",
-	"a1ii4839wmj/wn9Pc/ZoYkCMKshfyOSmEOXOlMXrzrJDPaGIq0XjTSdVCQU4ZVZJHIsCLUoIi/FMsvEv",
-	"az1wG8T0hemjSoBChWwLEfpqLXmw80ur8MnXoAHTOg+UIHg7H3xg8orJCz/dYbblhJFs8nP98f5H8Ugt",
-	"1JbZQ6ijBoy22klJ5EOGpAnnTlBRpV92VbeoJkUT4qMYrGVR+2pyXhlTP+Y2qqquX8b9DN5pl3HqMbZr",
-	"1XYIqCDHUTn57IyvfReSrqpvfHYPd0ETf9tPSyojruCyq0OoPA1pmczH9zzUUbgpB6PrsSqfpPRAyls2",
-	"4pFviVMtdYJ74se9Ic4hi5sMSx+v1yhu548biD4GqcoppvSTe+WUk/++hME94f1YBelcWKLRGdSSIcLo",
-	"hR+eAT/YCFdmByP3aRh3EYE3AXIKip0XOw9l30dSWzKbgZrD6jSvT570nGgjsdz1MeV/5WE4Qisz/6/U",
-	"EbW02lcLToNkg4l1B+cmeJ6BUwp6N7VoNK5BZKVybECQSL89HYlw4Pi5Oryfp56qYHmVrxNTqWSc6ZbX",
-	"YO5ke+InG98T1cJoH6KJ90yQwOD+mcWGLUzAXvLM/CFDxBqZs+mJUI6JxEugp2EjJ8kiXyo9M5LpTJBB",
-	"IH3dZerVkQwVXkPoUvA1YiXLZNhTowBjgKKzHal3IvWyo/Qow4XyCtIDLzVcahv4cMXQY9dSbHiSkv5s",
-	"S0RYxD9ReK+hfCt/hkFvimntMTZHWhlvxL/RfV9OtE9uOVYS041dGn0d+zNLGL3wyrOyV+tYhbdG8a0m",
-	"TRIH3rkotOM9XD/8bwAAAP//sr2OINECAQA=",
+	"H4sIAAAAAAAC/+x9/XPbOJLov4LiXdXN1tNYyd7s1p6rtl4pkpxo40g6SZ7sbsZPBYuQhA0FcgjQHifl",
+	"/dtf4YsESZAEJVl2Mv4lsSR8NLobjUajP756q3AXhQQRRr3zr14EY7hDDMXi0xwRihm+RfyDj+gqxhHD",
+	"IfHOvfk2TAIfrDHxMdlQgMkqSHwEqO4CfMgg+L9ex8O8/a8Jiu+9jkfgDnnnXtrM63h0tUU7KKdYwyRg",
+	"3vkaBhR1PHYf8cY3YRggSLyHh44HAxSzEb3AAUNxGawe/xm8x8QHn3qXw9liORpfTEAYA/npY282vq6A",
+	"SQz9CdPrHEyYoZ1Axn/GaO2de//RzTDWlc1oV0w7R7coxux+SJKd95BCD+MY3puwL8T3X6th4A2q4VDD",
+	"UhZjsrHPE+HhLSJsHsbsPbq3EC+MGfiM7quIo/p1vBj9muAY+d45ixNkglOLjcL8CqaRn646gmxrLFr8",
+	"VjfbOox3kHnnXoIJ++8/eumiMWFog+JsiirGgBEG2AcsBDFiSUyqeECBkk1dQLeah6zDkW+yYdVgomGJ",
+	"mM7rIRyPYXz/hKQswaBgG8Md6oeEQUwa8MD/+7RSTQ/haj7lkPj0I2ZbhykR8a+backHHbmsAB8M+4iO",
+	"Q+Y00zhkh042ZzBmrqiivLEDsrTsKkjd6Qjw5uDTaLwYzsa9Sy5xh3+Xf1fJWzHBAYzJYZGy9qHDAWKY",
+	"QA7QaNpEzlzjQ+hamLWRusWJDyGzMdY0zJ+JDVPz5kdatZy5zbrV5IesHBF/gXcWPhwSHzC8QyBcA7ZF",
+	"QENhA0kP4nTs+JChH5lsXt4XW0incXiLfeTPI7SqR0WhcYkOFp1nC+kMrUJCWZysmOMkpR6OM/GmA7xe",
+	"N06gGzqNG1JmP5P5L0AMaieT6FlHozI5dohtw8bDWbbaT917x1j0QfS38mcENxbmnMINAiTZ3aDYhT/F",
+	"IA4LNzUG3meOv1gm/wB/w7tkB8TyGrWgdJy6+XdySO/8T6863g4T+eF1xw4Y27rpCrzl4boCH8VNURDz",
+	"OSgKvN3IBXZ8GNQOwlRNc4gE5UO46gdiOif9IArjil0ufqngNflTmw0eORx30YFnXOR2sEWHn2aROg2m",
+	"nPqDxnXlWh+ywtg8INwmt3Q5DAIf8pGqp1O/t7QRxOgWo7vK62b688E3zjgJ0BjuUNVE+ue6iSLIGIp5",
+	"5//3qffjP+GPX179+D/Lsx+v/89/WpUNug3vxiHpRbgKbUYLh31lIo7fFgc4tt8yMdkAH8doJb6rvm7y",
+	"AawE83rzvse1N35OfFKfBsN537u2LjRM4hVqVuZ1u0M4MZurcccb0x2y62mEVm4nIm95+IlIlbbGL00j",
+	"hxl12/0UJN27EhS3w1ks3eFw5u1cFnUQi4g5mtlDTnMoa7gezmI6p8NZNLJfm8RkzhenbKAjXJ0ogyyh",
+	"/dBHbxmqst1tYgQZigHbQgLCGKBfExhUQ6cG/LRhyAEpqnUj+2TjHsJExnzNrGROeRBDpQNdViM5QJS2",
+	"xXDQjGEWwxWaS5npl2dd8J+B/B2MBnrK/DmaH8Pt1E6wb2W43Fj/K1bmClTRfHU0qITKQqOQUCQoekU+",
+	"k/CODOM4FITiwh8RoVrDKArwShhSuv+iHNqv7gazmZpETplfcyLnBEhMKrhGduTj9qajfgBjzO4vEGRJ",
+	"bJEhg+wTFyJZD7CWXQAkvpAtAaZMNRG3fwp+eBuHSQRu7oHAKZBHLO0ATEQPjj/wX7ztOde3/+sP8ls1",
+	"rsK7uNVuEFNjrEOuhURxGKGYYYlX1WNgAm5514oZ2CY7SECMoA9vAgT8/OKM2cvU7OhptGJYS5QiYvXb",
+	"kUDMIhSc2GiNMNpehHFft1C6sObKTznAMpUrvPkXWjE+qR0am7VX01a1A0rL1ardzTqAXsdbJZSFO64F",
+	"U6/jrZMvX5BQDSO0Wvp4vU4/8Q9U/W3cMcK44jtBYkhgcM9HvLbQoLSUS2yzQF1mvFhgVyr4dc1lIVxt",
+	"U2Z7FgxM3d8lSxvXdj7YGGEgSMLvJzkI1JOW07PROuT6i1YDfT2gswJZcWfM+FkDowev4GnxFtkjJGRC",
+	"blpWxfl1Lo61JrjeXFz2VMu8Ifz9X+hETtowQtpwhtZyDIb4JfeKylO5Fitm24eOh37jV0cY2K5zHW+H",
+	"6Q6y1Rb581UYIWpvJXl1T/ALBDHwaABngeRaUuZCeg9YRAwRu0v/XtwH0PcxbwmDJVbcmO/fF84JN/zQ",
+	"uQdhBH9NEPjbfDIGijE4dHAXBUK2fkb3ywAR7/z1H22bwa87Lt41HxTpGtIpvR64Q/CzhOgjugGL8DMi",
+	"YAspuEGIAM0StsOFQJsGXwCDN6qb/2N5dttc2ta0FBI7CKXeUZ5djBSFmAglPZRCUrUugKElIh8Rr5Um",
+	"cwbmCIEtYxE973Z9yCAXgZ9RfIYRW5+F8abrh6vulu2Cbrxe/fl/Xr0+A6M1gEyMpS8uK35NKE/Z4R9i",
+	"BDAFJMxPLH7iAGEK1hgFPm8ECUC7iN0DiYizHOb+o8tVU9r99+ubINzQf7/+yv9fYv/h368Juvv3q4gf",
+	"CDZk5mxnLxg9Akap8rdpElnaLyeTdmWEj40dswv9JEDgbotXW4kC5OsVlfdSXhfpVF3HSneM+6h+iw57",
+	"75d/+7iwXmJMmate0dXClIDISy0DVRVHpBK01CKJwUboNuE69fUqieP0RC921ZqS0dNVbdGi301fKblf",
+	"nX/N7IypG5jX8TIvsPRDfzZajPq9S7semaoNFl0o91up62dMfOsPWoTXMnQ90cU1UlHaAMMYQs1vpXed",
+	"KpTSx41Q2dwlQnU8FjKpmxRYn38NENfKQAY8BaswIazC0G4yvBjVurAI98UYNrWV35LGVZhPPbXK0G7D",
+	"QN5bYxSgW8hhjjDgagcQVGh+KBDDT9XbmPVH7VXj7PDS8Uiy68MgoBUvwTbcCD3Yghu+edxJ/kFIR7Hj",
+	"bDRvjUl+fLRH5x4Yy/neWLmg4KViJ1fBf0NcVByepexeHC16h5TxHpUsjH23ZyvtH9HOx0EY5GzT/qpN",
+	"aBaF59cE0dTk7GIQzh5i7PLReJdo8wKRWSztJGUtQKzbW4aTpj581CAK5R1t2DQgMtZc5NEyRxZwUGCL",
+	"bGdYjzINpcF3eUng74Fbgu74gBaNCt3Jvf0vGhKl+dkoHga+fYBJ4DsMUDgc9GgZYBUHxWiQozgm7M8/",
+	"WXdLL7N4FLCV0YZjn0bKVFdaYUFi7C8t3Hq6CwL7XZJfvJVqUb4RVorFdnZ+m3Ldm47OwDgJAnB1NRqA",
+	"V2CHIKEAs+xirNvf3JsWux/WcbgTB8vVSFrspBb9h9xxUvkgYGMO03YljshgsvbOPzkZvbyHjkX9aH1g",
+	"PTxUca7FJ1yLG0U35VNj5aEyP1YIi/S1wGadkRABtoWM3/CkNxny+WUUBgFYQYqo0PwhDoTFsnhn2CFK",
+	"lZtc/Y7WDSuwIY0X7jRK2DaM8Rexbef8S/QGUmSh2WdkP9ZuYZA4gC1d8mXjMujXCnhNbYOE2oPa63ja",
+	"gbqKQlccMXYjrXg61lLeTZVX49mUOvQb5rJu04swPcqABN0daSz7Do7i8Bb5M+HoU8aPdAAS/kutbjuz",
+	"XD9HWEyG+xD6KCjDEyAYE2UHLctz3jK7rbmhqzTpRA9iowY/ZV3k01y3KzJ7OkAO2k62sGsnzPQSHyMi",
+	"T9ECR6u2yLfjCBF/mVAVteb2wFAwpZdZvs60/vkvdBnuZe4PYGuVuEF7vZM4bv9+USBihsOOie+cIT+n",
+	"t+YndiPxOGSprdBdanMRnevJxXW7DaBkbs3GsDBdxo57bruUoy0cll3DympW1W2LwQ1t7WSZkVjdPtLb",
+	"SLpCNXIjDeWJKe8KXCPYcQVYOinsYBQpGZYdy9V6jnpzeAMpXvEpakivGnS8NwjGKK4d2mzykOoc92Mj",
+	"4oiLVILcuE5N3chuekFNDXPgXduxK/SREjMyF/3RHCwXHVUyGDdS2lRNStof11XUthEKN9e9odlbRoOl",
+	"wcRnhm/Am9581O9dLd55wvK8mLwfjjlth73ZcCY/ceAwky9lRZhsElILNWTqUfzL5XzRmy082WJ5OezN",
+	"xqPxW/15MFwM+wvjC9FgYdW2DLlpzDGeLOfTYd/reMbYl8O3o8XoQ28x9Dre/Go+HfVHk6v58sNwMLr6",
+	"kP/u3ejtO/t8RYlXIgNvAcwmQvE2rkXE+I2CXUIZ4JKc+CVd3Gyp9YB67bbUw8ZPxt4+vnoeQUrvwtgu",
+	"P/kxVmFlLywkbdnJRrSr6znhc/z1MD1ww2uPaGaHcFDwJCgY9u2owtES+n6MqP04yd7LNM/LNymxi72O",
+	"934yfrv8+7I/Gc+vPgxny9HA7sxderxI36sMAMQijmaZ4prWHCHL1lnoJ05xfeXCi48P7iAFvBOgSJxN",
+	"burZPtbUpzaaZRpGMUSNbfWjpEAJCtAOmY8yeWMsV2Qpg7uoPBQr4BhTCRdHsjLjnIGEIh/AgIYAqp9v",
+	"UUzlS5ajcnzo1SXlkk7GZB2LGdFiw80uPXlc2GShabbOAiLGk+VgdHFhnI7/nHx4Mxrqb+fveoPJR/3p",
+	"7XA8nPUu9Ufd2XZ8pP578Cawxsny77s+psJdBBrejPl9hyoGkN+DdQAN7jIjSUoIMFx9SmbUaveaWn8a",
+	"rSMMck/djh4zY6uLjB5yXGH/jDH9XB6Lf1s91oz3sVoeVYPMy6kKawe/05qv6WpWafpwfrQd3/AVisk6",
+	"ct9yxS+CG2UCNxYs3nft77iFJVPrmpMvXzDZzJAIwWPIooL2kzhGhAHpUgpipAyetZxV9tOtdHrQbqHC",
+	"M0VKsNQGLeb0XT0ZZvAuXauxfi5obcivdA0QkDwmoDmsc/BcwM20gwqrhf2KiiirVjNTFimxQROv5K7y",
+	"eTzJBoDBDUgNVGXvWoMf9nFTKfC28H6xIG2LN1tEU3eVNt5LobnC2kd6cVb1iK8pqR5jaeofUcSP+Fm6",
+	"YEiHM0wzVIl9D/yEE0k6iCGxNPP5TL4xaZlXHg/x4WCze4dJnzzU1+b4VnrW88fUqvO4yZII3gch9Cse",
+	"urOHEtuP4qy3We6SGNufGFB802JzTKU+Ur/4BdzUbgssd1h+1Qewqv3UXcBN+raoTwvjq5Ka2970XSER",
+	"DNylX1bs0OJVV8JmgGLnQ1wvoqTxoEd82aWMmrVsB6TgFNEHkhdtDtBRHG4KdzaDr+J0isxReprr74xB",
+	"/dBhP3bkROnSc0ix4YI62ahzGLOasKj2MTd3q0TIdWmTFDHfRCMXq5dp0BpMxkOv4w1ns8nM63ij8XI6",
+	"m7ydDefzSmBsvP4OswrntZX+uslboeP99mO449SI+GYVHgJHcG+pjC+qVEdyUTBCUYQgJXQH3GG25ScC",
+	"jqklmKYxCmZVDUAKW6VIMfwUqFiYe9KXPBoW2UBuL4E1/cvBmOUQo8pQoh0kcCOdIiAwlleW5oWYMjvt",
+	"GqYz9cmGx4e8U8igPOVooG9NJsM4OQFaEZzZWAx71dvhwut474a9gdfxppM5/zS9WojUA5dDYaTtT8bj",
+	"YZ9/NZkuRpPx3Ot4i1mvz3+b9hZ9u4k297Jne4P/WdkyjuMcTGp9jBKrea9o7BSWNzF16j2cDdsxYbbZ",
+	"MPJKZWnFR3UrFKlwfMeQ3hKgwkXVQH8e0NtKuhTwdVuDjEmESC/C8witaJ2HTIyiGFFEmFaddVCNOuEN",
+	"9xyx26kY0HLe5/zHmkxdyg8pH3TSrvODfdHy/Cs6rUDpcSE8Fsm9YCXxhXhQVt/Kv207ybwklzdSqsJo",
+	"Nzyb327tRb86ziaNqZGNAVPPkjUizrwSpzE5FVf56v2qbjYtzAemcs0ab88mSi2ENH5+kxA/sDw4+pBB",
+	"i31ZxtKJ3L6Sr5IYpW+AMbyz2ef4SJ26twVXFIgO2p/bTZmcr8IYDX/DzFQndeel4YVWyJcmfwAbfCvy",
+	"3iAGcUABvAkT+WIgXKJAqojqtcpZgOpuVUCqbeazDH0gbQakAPwryGlt+vKUDtb06GJAmnVJD1xB7Gsr",
+	"+yj+qGci84aYZ6O6mJbCGBl1W2jfb0aL+ejtO354L3qXk7k4xIfjgTjEJ735sjfuXf5jPuSq+dvZtC8/",
+	"/1N85j+Lc978sjcdLS+u/sk/2BGSaldlOZYEBXfRwjur05MkqQjPb9gmSYAGaI0JlvfdawWP8V0703t9",
+	"ZOudfjmLE/EjQyt7kB76jZ9/1DpFf3gJst9BeCvsL0IwdoC2qohzEka4k00nY4mp3Ie3HA1CAkmfYiwc",
+	"UlmcoFw8HYzwmXBK+OtfwS+pk+UvHvglefXqj3+W/4JfPIriWxT/4gFMUhDOtgj60jHqCEGIxY2ZRWwZ",
+	"uLKpHDORSOHAlwDBo01+QnIkGww2cdpit86v+n1+Me544+Hi42T2fnnRG11ezbimvZhMlpcT4UIx7c3m",
+	"w6W+UYt3r1E/bWpsSxs4FiKZ4qmQ7kP9wnkamoGYCuDLyUev46WuG8Jfo+OlIYP8un8x4RBlnKbalIHY",
+	"hjFbIMqmhvHGlkTlRrG2tmkI2AgIySYUJ4O0vZbzMgxcsjIMlFtUBQRTY07D0Jvm+nz9ykz2+arhEc6w",
+	"SYmsUcyafio9xPSkom0BgrLtIztxVfKqZttyBoTdYiSTv3AagRQR1VajlKBVJj1juJndrNyObAeYY58G",
+	"/QdY/HTXD00aGpf8ymaqtLQOwHzD3JfVMqXu6YZW9Qxu9jQ2L2DugrCAGzcDs8mUNPOlLbNkDSfqoDBF",
+	"4BKTicCvkXNgUFO47D7uOIdaIfUaqd07yOWxTnYv4r86d0w65Ym8lrMlCvVtbvi2ZB6Hwtg8nU1+Hg2G",
+	"A6/jzYb9yXi+mF31F8OB9Xo9T1YrRGn7eB6uA6WRPFSOIpuo30nItiqhQ4vonjKek80GUVYdsOEe0nbK",
+	"0I6FusAXXGbiOIwrpVYvlUEKtxy1MudatueH5hD2vCzHPr5TneT5nN0d7zYJCIrhDQ6wy8PVz4Xmpj1k",
+	"gahVcvLv30G7BaTd4Zy71zfGlVbx04hEiUWT0OlfhY8v5m0y52r9wGLXC5X7bUsnVWlVi5r7pjAPeGvr",
+	"Y50c57puwVlnF29zuX4xrN3F/H+vRv33wsB/0bu6lKb+4dQ8VfMz2/aYqaifSvyXLgjiGMg0zFPDoRSO",
+	"FIqPmG0zXffkYi+AGqSFmywSzrzqjTpGK4RvldvaEWTTE1yhsmd9Z106e/X+/sU4Jhv58JOGguQ5tDcd",
+	"0RHpw9W2IpCu9btQJzdmlYQ72GQjFv1cPDflimxLrXvP7unQf2Wjo4AiZefm3cSjfxa60tqTM/f42Zjg",
+	"4IrgXxOVJA/7iDC8vuegQGBmKbBeDiuCNDpO4VkGhvSlKMGOb5wWj6TKOK7iPGYurOno7XC4/LvX8S7+",
+	"tHwzersUaUZEFIcReL74x/vso+1K8bhvqz+XBYaLw3XBSyTGDK+q80+Fa8AFk8H9fd3DJsS2eLN1H+od",
+	"b20bJgjv3Ee5DO8qEvj42KYrVY3zQba3DVWboqs8koOAyLu7Z0SNYUXMi0oAJSNWwhjcw12gHr1KRN1z",
+	"kMY7KP9KZxJNQw+zUDou5j3jgPBen706e6U8FAmMsHfu/bf4yvDU7mq7i/i0QeLkTt0IR7537r1FrJc2",
+	"6uSKr1YodVmTbpaHv0qhMxrramcOTSOZZ8GpnSgS5dC2WJHUoYsuJeLSNCt44gZLoXynQ6dCTTEn9BgF",
+	"o5zbZ0UBHLuUCkU49isU4HDsVahY4kKbcoWFlr1aocRWT6JVt8tW3Yolalr1abUwe33L9h33nTRXYnKf",
+	"rq0mLtchdMGsrcSNY7/27duxpa2ki2O/9jvVUlvIRS7mKmu7d5C67HWhhsUfX71qVbrCKaOska5fP/wr",
+	"P9UNvkVEBpzGkGxQB6zFOqQjPT+qzoDoHSCyYVsZDn+DQBDeoTiteMKvIUbtQ9fURDKx5l4JUFXW06pl",
+	"yCIEciX7Z0YtV/tQTwLSzKeiUe3LTInazVUlEQVCkt0OxvdSjzFoIn7MtJ/uVyRfnx6c9CD9VFVSh8pZ",
+	"6mQK2aqqMSgd6KAqb4cythvvnJZElRTqRpbUpq4kK6VF/T2RMF30iUmZ+ROLK5AvQaigblyVf9aVxPYE",
+	"ti90PgGd867iZWKnaSEb6Jm1a3vh1Fksn+4OWUp9edx7ZFqlv4UOVC7s795rH41QdW2vFKqO7fXCqKXm",
+	"H+2l7lsKqLt1rKqM7shRvm5+UgVWqnQy5KOgwz6yrqojQ1qpqnlwD8zTfzK5iVNJJzIXU4tInIa0KBOV",
+	"A/Kb0L8/5uGRL4dVrvl35LNKxfA8Nqr7IpNPHtsyHLh0LMnd1l3H4e4dxzrxdY59l8OqJ7ZqoW/bA2wb",
+	"UuYqwQ4XCOUq6I4FDmqp9tOrn05VEpJTdTQA45CBizAh/jH3p2AGIBJ3c6rIW7pAuxvf9Ii/KAaX7stH",
+	"pbEel6+a21lqlL5w41Nwo/ijWA22zKFfRf+HrlEc0ZkXe2kNw9bK+Mj3HvvKUzy1TnByp9V1BDnUMitR",
+	"npWou4ObDYrP9BKdCZAqnnKAv1GZGegpiFHzpFl8yjypMpWaGHSAsoIJB6iGNIWCd/vSJ6/fvxCpikj2",
+	"UHInSlEdxO5MlLmOUn9uMisXln/i3VEO6A8jRNIiV9pPV9dL5DRqIko3KhWOCZB0bssTaSC+t9MpV+zj",
+	"GdKsGJ/w+GS7IpSfNAXbqY08HU/5YxfujQl7ZFwf/xKqfWce8hd1FieofBl9/TjT1iiS7fjJEkpeGFtk",
+	"k4UB9pXjuCxEcywO6vm+sMT4mCme2Wd3x/YKT+23uK3Mz8s+T/e5xXbejlrlACX3o7LQ91kSJg/iUygs",
+	"KVFiDUOJGPIXedEqFfhxMu/N1AiFAkFtSaIBeTRRXYDPSWJ/y/u0F0XBvawioVau2ACwUGULV4VDiyyT",
+	"8okoD9XdGkn0anaoaJwm3Hsujpft3Bj38EhUuomIchy06ZnDe/vuL66QL66QL66QL66Qz8QV8lBVyi1b",
+	"qD5dyqHkJ7Gigi3W6bfvMNtiwrVdFIgCMcLBcM1xlEZDmi+8OTc+jpU4DLoE3Q0wXYW3vJWIhzC0rmJ5",
+	"FSwClcM7CiBYBRgRBn6AYAMZuoP3uRKt/IAXxYREkMVKxVvINGsE3QX3wE9nFa/PZ2C0BiFJazeIF2kY",
+	"xAj69zIpG+2oHFB4Q8IY+Wdm9uZULezLdY1Ly9pfo7NkW632ACgsq6OMhzoDK4E7dK7SfexZ7E0CYHt/",
+	"/86UxwKn5TiqX+QoyUR53jZzAtdpjopnFmbzo3qNCECWNIPEIb2yq+9HU77inOtGDo4ncOHQK12FZI03",
+	"Cd8l+UXXenRU0en4F7UcUm0b6/VjTXUaxw4otkwuLLZy53S/5t6tH5ptWRY6LXJlxEvo/MmSZOtJXqNz",
+	"j8IkZGB9zDdpiZ5iQPJDp41kasDkq1MxZg5TmMhjTpVk+G4IxlWuuoW2MzDka+mL9BtdH9LtTQhjPzV2",
+	"1J1UA906LZx9GiPHI7sjqILnp3MhTGTRcTsBugFkiLI0KZkzMS6Nfqe4i+TSwz3NfUSiKnNbp5VI3YWU",
+	"XVFZ6NsZox90p1Ogsxfhp7zacQTJPB7iviLwqOr31bLhhW7zmHs01bjVbFyRq8VLaXkicU8W56cVeL1C",
+	"GRHHb5BwtdXfFjs11swQ36q+SvG1FB4RqN2JnDK0e7MOIOdSnQZaPg4NzKC2gsMa/Syf/HVdMZXH1ViU",
+	"HLsj1tSbjs7AiF+Kd4gwWc9DrFE2Kl1i36wDKK3ZRqL3goy3hNrIpGZ1gTYO2c4cjoQ5IhQzfPvYh0Ja",
+	"gs16LPQEukFakk0lM8/vxlOoH2IzpwmlLWyfMol4mJRgc7ZIqS8Tk37yOB961xbWLBWft3BoSSjIjEmU",
+	"M5Oler3ilVNx1aMySml17uL6iRikQPG6G+/zI+MjPIpaKfiYV+4Gkj1nZmkhHvRrujBHVTg7NfNXL60A",
+	"c8IzSIz9q3CQTwdPyzRXj15KB2QfSQF98Dif/0L7wjR5hdvB9ci3qW+Ot4NwBYMf9+BwUZToIPYeyLJG",
+	"L7z9wtvPjrcZWglQhcnmUDZXg4kX2u9F9ft9HOMmI4TR0fggjF7Y4FtigwDBmGCyOYY4uFRjnVoaVBw2",
+	"JF7KdLGe5WQ5WRqQ3xkTHSpKMh56kSTfFhPEiEqr0SF2h5kY5IXu3xDdqc6fvr/RUKZg/z6o/ubisifX",
+	"863TXPj1db/imkx8nKYbxGRWOhf6uV37TnQ4T0cC8B4hIRMD0KpMAqKdLEQPc61tz1EoayxiFLIOwkTP",
+	"cWtUgnW60GWk6H5NKfDgeNKqZGxpNeZHIVTHOkpozLnfls3XkH4R2O0MAUZ+feujo2ZZ1U56yWIKPlQ/",
+	"Im4Q+zktaPFopMjXZLeg5GcTYqQBtm/IytU1InGVUBbuYvH3M3rM7WdgPdmb7ssz7eHPtG14rUm/NnhC",
+	"KNHPhSssPphSyX9m5NJOlEEgqGShXDW5Yl1q2aokGZS5xJTJusyPuHvkBDanRlURm9rcp7mglGsCqWnQ",
+	"aF297u5X/t8Y7lDBh7c8eQ6xSnKLEt0wRuAzihhICMMB//5efCd4/qxO/kmyzaSUbBmXq8D23LhWzuQ/",
+	"V761E6/SCzh/hBwdf8fl5jpmfo5iv4YY1nqNfC1GZXtMwWqLVp+5IoDWoXJYExsFU0BZGCPfqG8v1ikd",
+	"0owS91kFuzjc8R8IkMXVK/lgmhyFD47vysGhGqA1Jpil2uDp4tvrGFDS4lnyoQrJEA2iAK5qRIQp3tfJ",
+	"ly8o7qpLK/JphFaN6u4MsZgzm3nfzWXOkJqPrLPdAT+9+ilLzAdCtkXxHaZlNfdCwMI1XD2kPVfJs9Vx",
+	"j5L8Kw3jEqX09giDyA8nymjq+pqWYlf21DhGCESOy9woX7pqSS4rXLY06z2fe1bGgMaF5MVt9vdxH1Ps",
+	"yP9L72BmGVcrQ8raprK6cn4DVHBWroDv93F7txQmrjSmrZMAZBQ9vWxD8S0uRnj96SAp3xqGEWEoJjAA",
+	"tgiOan7SArVOlOZ4N6sHfDzOVdWCvzO+1TWQX7j26bk2c0uxJo9ox7WitSoK/Y27t2fV/x85QwOf6B2U",
+	"B3qLDXFSZpTVy8Eb6IOZxPXLrnzkXan8fCo2ZRiBH1aQrFDwBwBBnBAiinc7btIweoo96pg54IXVvwlW",
+	"d+VAF9aXmlPK/F8Z3iHK4C5ysIhAIHvn7CCyijyWF1DMKEhHrFa3Tq5qdUpWSg2kNt2Ls1l/YHLLWsAx",
+	"F+dQsA0T9uefTl2vjQucj5htq1W/ghnGbgWxULuV7aOa07p0W6e9H5/fhDYs0fHmfmG0f+G/p7l7NDEg",
+	"RhXkL+QuVohyZ8qig1/ZhSShiB+LRhYTqlJoyVfFOBYlCZUQFuOZZONf1lrgNohpF8FHlQCYbKRvSKUD",
+	"X1+tJQ92fmkVXig1aMC0zgIlCN7OBh+YvGLywg93mG05YSSb/KH+ev+9WKQWasvsIdRRA0Zb7aQk8iFD",
+	"UoVzJ2gAKQOyq/IbNCmaEB/FgE/AFaVKcl4ZUz/mNrqQgMiJesSvlmczeKdNxqnF2H6qtkNABTmOysln",
+	"Z3ztu5B0wwgRGOGze7gLmvjbfltSNSAEl10dQuVpSMtkPr7loY7CTa+yrteqfFr+Aylv2YhH9otMT6kT",
+	"eEY+rk9kDllcZVj6eL1GcTt73ED0MUhV9jvQSaaUUU7++RL48YTvYxWkc2GJRmNQS4YIoxd+eAb8YCNc",
+	"mR2MbP9h3EUE3gTIKQxsXuw8lH0f6diS+bvUHFajeX260OdEG4nlro8p/19ehiO0MiteyDOillb7noLT",
+	"INlgYt3BuQmeZ6iAgt7tWDQa1yCy8nBsQJAoODMdiQC4+LkavJ/nOVXB8ipDPabykHGmW/4EcyfbEwcp",
+	"f0tUC6N9iCYi+CGBwf0z8w1bmIC9ZFb8XbqINTJnU9BOjolewnZOELaTJxkqxP92v4pv6sRKlru7p0YB",
+	"xgBFYztSkdH1sqMUhuxCeQXpgY8aLtW8fLgSgS+PKj8agrDTn22pt4v4JwrvNZRvZc8w6E0xrb3G5kgr",
+	"/Y34N7rvy432yTXHSmK6sUujrWN/ZgmjF155VvpqHavw1ii+1aRJ4sA7F6UlvYfrh/8fAAD//9vCihQo",
+	"EQEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
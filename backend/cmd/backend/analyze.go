@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/urfave/cli"
+
+	"github.com/openclarity/apiclarity/backend/pkg/backend"
+	log_utils "github.com/openclarity/speculator/pkg/utils/log"
+)
+
+const (
+	tracesFlag       = "traces"
+	outputFlag       = "output"
+	providedSpecFlag = "provided-spec"
+)
+
+func analyzeCommand(c *cli.Context) error {
+	// The report may be written to stdout.
+	log_utils.InitLogs(c, os.Stderr)
+
+	tracesPath := c.String(tracesFlag)
+	if tracesPath == "" {
+		return fmt.Errorf("--%s is required", tracesFlag)
+	}
+
+	providedSpecs := map[string]string{}
+	for _, providedSpec := range c.StringSlice(providedSpecFlag) {
+		const specFlagParts = 2
+		parts := strings.SplitN(providedSpec, "=", specFlagParts)
+		if len(parts) != specFlagParts {
+			return fmt.Errorf("invalid --%s %q, expected <host>:<port>=<spec file>", providedSpecFlag, providedSpec)
+		}
+		providedSpecs[parts[0]] = parts[1]
+	}
+
+	report, err := backend.Analyze(context.Background(), &backend.AnalyzeConfig{
+		TracesPath:    tracesPath,
+		ProvidedSpecs: providedSpecs,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to analyze traces: %v", err)
+	}
+
+	var output io.Writer = os.Stdout
+	if outputFile := c.String(outputFlag); outputFile != "-" {
+		file, err := os.Create(outputFile)
+		if err != nil {
+			return fmt.Errorf("failed to create report file: %v", err)
+		}
+		defer file.Close()
+		output = file
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return fmt.Errorf("failed to write report: %v", err)
+	}
+	return nil
+}
+
+func newAnalyzeCommand() cli.Command {
+	command := cli.Command{
+		Name:   "analyze",
+		Usage:  "Runs APIClarity offline over recorded telemetries and writes a JSON report",
+		Action: analyzeCommand,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  tracesFlag,
+				Usage: "Telemetries file (JSON or JSONL), or a directory of such files",
+			},
+			cli.StringFlag{
+				Name:  outputFlag,
+				Value: "-",
+				Usage: "Report file, - for stdout",
+			},
+			cli.StringSliceFlag{
+				Name:  providedSpecFlag,
+				Usage: "Provided spec to diff the traffic of an API against, as <host>:<port>=<spec file>. Can be repeated",
+			},
+			cli.StringFlag{
+				Name:  log_utils.LogLevelFlag,
+				Value: log_utils.LogLevelDefaultValue,
+				Usage: log_utils.LogLevelFlagUsage,
+			},
+		},
+	}
+	command.UsageText = command.Name + " --" + tracesFlag + " <path>"
+	return command
+}
@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	httptransport "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/urfave/cli"
+
+	"github.com/openclarity/apiclarity/api/client/client"
+	"github.com/openclarity/apiclarity/api/client/client/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/har"
+)
+
+const (
+	harFileFlag        = "file"
+	backendAddressFlag = "backend-address"
+	traceSourceIDFlag  = "trace-source-id"
+	timeoutFlag        = "timeout"
+)
+
+// importHARCommand sends a HAR archive to a running backend, which owns the inventory and the speculators state.
+func importHARCommand(c *cli.Context) error {
+	fileName := c.String(harFileFlag)
+	if fileName == "" {
+		return fmt.Errorf("--%s is required", harFileFlag)
+	}
+
+	file, err := os.Open(fileName)
+	if err != nil {
+		return fmt.Errorf("failed to open HAR file: %v", err)
+	}
+	defer file.Close()
+
+	// Parse locally to fail fast on invalid archives and to send only the fields that are used.
+	archive, err := har.Parse(file)
+	if err != nil {
+		return err
+	}
+
+	params := operations.NewPostControlHarImportParams().
+		WithTimeout(c.Duration(timeoutFlag)).
+		WithBody(archive)
+	if id := c.String(traceSourceIDFlag); id != "" {
+		if !strfmt.IsUUID(id) {
+			return fmt.Errorf("invalid trace source id: %s", id)
+		}
+		traceSourceID := strfmt.UUID(id)
+		params = params.WithTraceSourceID(&traceSourceID)
+	}
+
+	apiClient := client.New(httptransport.New(c.String(backendAddressFlag), client.DefaultBasePath, client.DefaultSchemes), strfmt.Default)
+	res, err := apiClient.Operations.PostControlHarImport(params)
+	if err != nil {
+		return fmt.Errorf("failed to import HAR file: %v", err)
+	}
+
+	result := res.GetPayload()
+	fmt.Printf("Imported: %d\nFailed: %d\n", *result.Imported, *result.Failed)
+	for _, e := range result.Errors {
+		fmt.Println(e)
+	}
+	return nil
+}
+
+func newImportHARCommand() cli.Command {
+	command := cli.Command{
+		Name:   "import-har",
+		Usage:  "Imports the traffic of a HAR file into a running APIClarity",
+		Action: importHARCommand,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  harFileFlag,
+				Usage: "HAR file to import",
+			},
+			cli.StringFlag{
+				Name:  backendAddressFlag,
+				Value: "localhost:8080",
+				Usage: "Address (host:port) of the APIClarity REST API",
+			},
+			cli.StringFlag{
+				Name:  traceSourceIDFlag,
+				Usage: "UID of the trace source to import the traffic under, the default trace source is used if not set",
+			},
+			cli.DurationFlag{
+				Name:  timeoutFlag,
+				Value: 10 * time.Minute, //nolint:gomnd
+				Usage: "Timeout of the import request",
+			},
+		},
+	}
+	command.UsageText = command.Name + " --" + harFileFlag + " <path>"
+	return command
+}
@@ -26,6 +26,8 @@ import (
 	"github.com/openclarity/apiclarity/backend/pkg/backend"
 	"github.com/openclarity/apiclarity/backend/pkg/config"
 	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
+	"github.com/openclarity/apiclarity/backend/pkg/modules"
 	"github.com/openclarity/apiclarity/backend/pkg/version"
 	log_utils "github.com/openclarity/speculator/pkg/utils/log"
 )
@@ -44,12 +46,8 @@ func main() {
 	viper.SetDefault(config.HealthCheckAddress, ":8081")
 	viper.SetDefault(config.HTTPTracesPort, "9000")
 	viper.SetDefault(config.HTTPTracesTLSPort, "9443")
-	viper.SetDefault(config.HTTPTraceSamplingManagerPort, "9990")
-	viper.SetDefault(config.HTTPSTraceSamplingManagerPort, "9943")
-	viper.SetDefault(config.GRPCTraceSamplingManagerPort, "9991")
-	viper.SetDefault(config.HostToTraceSecretName, "hosts-to-trace")
-	viper.SetDefault(config.HostToTraceSecretNamespace, os.Getenv("POD_NAMESPACE"))
-	viper.SetDefault(config.HostToTraceSecretOwnerName, "apiclarity")
+	viper.SetDefault(config.GRPCTracesPort, "9002")
+	viper.SetDefault(config.TracingSessionIntervalSec, "10")
 	viper.SetDefault(config.BackendRestPort, "8080")
 	viper.SetDefault(config.BackendRestTLSPort, "8443")
 	viper.SetDefault(config.StateBackupIntervalSec, "30")
@@ -61,6 +59,16 @@ func main() {
 	viper.SetDefault(config.TLSServerKeyFilePath, "/etc/certs/server.key")
 	viper.SetDefault(config.RootCertFilePath, "/etc/root-ca/ca.crt")
 	viper.SetDefault(config.ExternalHTTPTracesTLSPort, "10443")
+	viper.SetDefault(config.ExternalGRPCTracesTLSPort, "10444")
+	viper.SetDefault(config.IngestionMaxQueueSize, ingestion.DefaultMaxQueueSize)
+	viper.SetDefault(config.IngestionWorkers, ingestion.DefaultWorkers)
+	viper.SetDefault(config.IngestionRetryAfterSec, "1")
+	viper.SetDefault(config.OTLPGRPCPort, "4317")
+	viper.SetDefault(config.OTLPHTTPPort, "4318")
+	viper.SetDefault(config.ShutdownTimeoutSec, "30")
+	viper.SetDefault(config.ModulesQueueSize, modules.DefaultDispatchQueueSize)
+	viper.SetDefault(config.ModulesWorkers, modules.DefaultDispatchWorkers)
+	viper.SetDefault(config.ModulesQueuePolicy, string(modules.DispatchPolicyDrop))
 	viper.AutomaticEnv()
 	app := cli.NewApp()
 	app.Usage = ""
@@ -91,6 +99,8 @@ func main() {
 	app.Commands = []cli.Command{
 		runCommand,
 		versionCommand,
+		newImportHARCommand(),
+		newAnalyzeCommand(),
 	}
 
 	if err := app.Run(os.Args); err != nil {
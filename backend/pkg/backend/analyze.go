@@ -0,0 +1,442 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	uuid "github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/backend/pkg/backend/apiclassifier"
+	"github.com/openclarity/apiclarity/backend/pkg/backend/speculatoraccessor"
+	"github.com/openclarity/apiclarity/backend/pkg/common"
+	_config "github.com/openclarity/apiclarity/backend/pkg/config"
+	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/graphql"
+	"github.com/openclarity/apiclarity/backend/pkg/modules"
+	"github.com/openclarity/apiclarity/backend/pkg/redaction"
+	"github.com/openclarity/apiclarity/backend/pkg/sampling"
+	speculators_repo "github.com/openclarity/apiclarity/backend/pkg/speculators"
+	"github.com/openclarity/apiclarity/backend/pkg/traces"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+	_spec "github.com/openclarity/speculator/pkg/spec"
+	_speculator "github.com/openclarity/speculator/pkg/speculator"
+)
+
+// maxReportedTelemetryErrors bounds the number of replay errors kept in the report.
+const maxReportedTelemetryErrors = 100
+
+type AnalyzeConfig struct {
+	// TracesPath is a telemetry file (JSON or JSONL) or a directory of such files.
+	TracesPath string
+	// ProvidedSpecs maps a host:port to an OpenAPI spec file (JSON or YAML) to diff the traffic against.
+	ProvidedSpecs map[string]string
+}
+
+type AnalysisReport struct {
+	Modules     []string                  `json:"modules"`
+	Telemetries TelemetriesAnalysisReport `json:"telemetries"`
+	APIs        []*APIAnalysisReport      `json:"apis"`
+}
+
+type TelemetriesAnalysisReport struct {
+	Total   int      `json:"total"`
+	Handled int      `json:"handled"`
+	Failed  int      `json:"failed"`
+	Errors  []string `json:"errors,omitempty"`
+}
+
+type APIAnalysisReport struct {
+	ID                uint                               `json:"id"`
+	Name              string                             `json:"name"`
+	Port              int64                              `json:"port"`
+	Type              string                             `json:"type"`
+	Events            int                                `json:"events"`
+	HasProvidedSpec   bool                               `json:"hasProvidedSpec"`
+	ReconstructedSpec json.RawMessage                    `json:"reconstructedSpec,omitempty"`
+	GraphQLSchema     string                             `json:"graphqlSchema,omitempty"`
+	SpecDiffs         []*SpecDiffAnalysisReport          `json:"specDiffs,omitempty"`
+	Findings          map[string][]oapicommon.APIFinding `json:"findings,omitempty"`
+}
+
+type SpecDiffAnalysisReport struct {
+	EventID                  uint   `json:"eventId"`
+	Method                   string `json:"method"`
+	Path                     string `json:"path"`
+	StatusCode               int64  `json:"statusCode"`
+	SpecDiffType             string `json:"specDiffType"`
+	HasProvidedSpecDiff      bool   `json:"hasProvidedSpecDiff"`
+	HasReconstructedSpecDiff bool   `json:"hasReconstructedSpecDiff"`
+}
+
+// Analyze replays recorded telemetries through the same handling as Run, against a temporary
+// sqlite database and without starting any server, and reports what APIClarity learned from them.
+// The reconstructed specs are generated by approving the suggested reviews as is.
+func Analyze(ctx context.Context, analyzeConfig *AnalyzeConfig) (*AnalysisReport, error) {
+	config, err := _config.LoadConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config: %v", err)
+	}
+	// Nothing may depend on the environment the analysis runs in.
+	config.EnableK8s = false
+	config.TraceSamplingEnabled = false
+	// All the telemetries are handled by the modules, however slow they are.
+	config.ModulesQueuePolicy = string(modules.DispatchPolicyBlock)
+	config.ModulesQueuePolicyOverrides = nil
+
+	dbDir, err := os.MkdirTemp("", "apiclarity-analyze")
+	if err != nil {
+		return nil, fmt.Errorf("failed to create database directory: %v", err)
+	}
+	defer os.RemoveAll(dbDir)
+
+	dbConfig := createDatabaseConfig(config)
+	dbConfig.DriverType = _database.DBDriverTypeLocal
+	dbConfig.LocalDBPath = filepath.Join(dbDir, "db.db")
+	dbHandler := _database.Init(dbConfig)
+	defer func() {
+		if sqlDB, err := dbHandler.DB.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	}()
+
+	speculators := speculators_repo.NewMapRepository(config.SpeculatorConfig)
+
+	samplingManager, err := sampling.CreateTraceSamplingManager(dbHandler, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create trace sampling manager: %v", err)
+	}
+
+	modulesManager, modInfos, err := modules.New(ctx, dbHandler, nil, samplingManager, speculatoraccessor.NewSpeculatorAccessor(speculators), nil, config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create modules: %v", err)
+	}
+	if err := modulesManager.Start(ctx); err != nil {
+		return nil, fmt.Errorf("failed to start modules: %v", err)
+	}
+
+	apiClassifier, err := apiclassifier.New(config.APIMediaTypes, config.APIClassificationOverrides)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create API classifier: %v", err)
+	}
+
+	redactionPolicies, err := redaction.NewRepository(dbHandler, config.RedactionHashKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create redaction policies repository: %v", err)
+	}
+
+	backend := CreateBackend(config, nil, speculators, dbHandler, modulesManager, nil, apiClassifier, redactionPolicies)
+
+	for hostAndPort, specFile := range analyzeConfig.ProvidedSpecs {
+		if err := loadProvidedSpecFile(speculators.Get(common.DefaultTraceSourceID), hostAndPort, specFile); err != nil {
+			return nil, err
+		}
+	}
+
+	report := &AnalysisReport{}
+	for _, info := range modInfos {
+		report.Modules = append(report.Modules, info.Name)
+	}
+	sort.Strings(report.Modules)
+
+	err = replayTelemetries(analyzeConfig.TracesPath, func(trace *pluginsmodels.Telemetry, err error) {
+		report.Telemetries.Total++
+		if err == nil {
+			err = traces.ValidateTelemetry(trace)
+		}
+		if err == nil {
+			err = backend.handleHTTPTrace(ctx, trace, nil)
+		}
+		if err != nil {
+			report.Telemetries.Failed++
+			if len(report.Telemetries.Errors) < maxReportedTelemetryErrors {
+				report.Telemetries.Errors = append(report.Telemetries.Errors, err.Error())
+			}
+			return
+		}
+		report.Telemetries.Handled++
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := approveSuggestedReviews(speculators); err != nil {
+		return nil, err
+	}
+
+	// the modules flush their state so that it is part of the report
+	if err := modulesManager.Stop(ctx); err != nil {
+		return nil, fmt.Errorf("failed to stop modules: %v", err)
+	}
+
+	report.APIs, err = createAPIsAnalysisReport(ctx, dbHandler, speculators, modulesManager, modInfos)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+// replayTelemetries reads every telemetry of a file, or of the JSON and JSONL files of a directory, in lexical order.
+// Each file holds a JSON array of telemetries or a stream of telemetries (one JSON document, or one per line).
+func replayTelemetries(path string, handleFunc func(trace *pluginsmodels.Telemetry, err error)) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return fmt.Errorf("failed to stat traces path: %v", err)
+	}
+	if !info.IsDir() {
+		return replayTelemetriesFile(path, handleFunc)
+	}
+
+	// nolint:wrapcheck
+	return filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		if ext := filepath.Ext(filePath); ext != ".json" && ext != ".jsonl" {
+			return nil
+		}
+		return replayTelemetriesFile(filePath, handleFunc)
+	})
+}
+
+func replayTelemetriesFile(filePath string, handleFunc func(trace *pluginsmodels.Telemetry, err error)) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to open traces file: %v", err)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+	decoder := json.NewDecoder(reader)
+	if isJSONArray(reader) {
+		if _, err := decoder.Token(); err != nil {
+			return fmt.Errorf("failed to read %s: %v", filePath, err)
+		}
+	}
+
+	for index := 0; decoder.More(); index++ {
+		trace := &pluginsmodels.Telemetry{}
+		if err := decoder.Decode(trace); err != nil {
+			// The position in the stream is lost, the rest of the file can't be read.
+			handleFunc(nil, fmt.Errorf("%s[%d]: failed to decode telemetry: %v", filePath, index, err))
+			return nil
+		}
+		handleFunc(trace, nil)
+	}
+
+	return nil
+}
+
+func isJSONArray(reader *bufio.Reader) bool {
+	for {
+		b, err := reader.Peek(1)
+		if err != nil {
+			return false
+		}
+		if !strings.ContainsRune(" \t\r\n", rune(b[0])) {
+			return b[0] == '['
+		}
+		_, _ = reader.ReadByte()
+	}
+}
+
+func loadProvidedSpecFile(speculator *_speculator.Speculator, hostAndPort, specFile string) error {
+	host, port, err := _speculator.GetHostAndPortFromSpecKey(_speculator.SpecKey(hostAndPort))
+	if err != nil {
+		return fmt.Errorf("invalid provided spec host %q: %v", hostAndPort, err)
+	}
+
+	rawSpec, err := os.ReadFile(specFile)
+	if err != nil {
+		return fmt.Errorf("failed to read provided spec: %v", err)
+	}
+	jsonSpec, err := yaml.YAMLToJSON(rawSpec)
+	if err != nil {
+		return fmt.Errorf("failed to convert provided spec %s to json: %v", specFile, err)
+	}
+	doc, _, err := _spec.LoadAndValidateRawJSONSpec(jsonSpec)
+	if err != nil {
+		return fmt.Errorf("failed to validate provided spec %s: %v", specFile, err)
+	}
+
+	pathToPathID := make(map[string]string)
+	for path := range doc.Paths {
+		pathToPathID[path] = uuid.NewV4().String()
+	}
+
+	_ = speculator.InitSpec(host, port)
+	if err := speculator.LoadProvidedSpec(_speculator.GetSpecKey(host, port), jsonSpec, pathToPathID); err != nil {
+		return fmt.Errorf("failed to load provided spec %s: %v", specFile, err)
+	}
+
+	return nil
+}
+
+func approveSuggestedReviews(speculators *speculators_repo.Repository) error {
+	for _, speculator := range speculators.Speculators {
+		for specKey := range speculator.Specs {
+			if speculator.HasApprovedSpec(specKey) {
+				continue
+			}
+			suggestedReview, err := speculator.SuggestedReview(specKey)
+			if err != nil {
+				return fmt.Errorf("failed to get suggested review for %s: %v", specKey, err)
+			}
+			if len(suggestedReview.PathItemsReview) == 0 {
+				continue
+			}
+
+			approvedReview := &_spec.ApprovedSpecReview{
+				PathToPathItem: suggestedReview.PathToPathItem,
+			}
+			for _, item := range suggestedReview.PathItemsReview {
+				approvedReview.PathItemsReview = append(approvedReview.PathItemsReview, &_spec.ApprovedSpecReviewPathItem{
+					ReviewPathItem: item.ReviewPathItem,
+					PathUUID:       uuid.NewV4().String(),
+				})
+			}
+			if err := speculator.ApplyApprovedReview(specKey, approvedReview, _spec.OASv3); err != nil {
+				return fmt.Errorf("failed to approve review for %s: %v", specKey, err)
+			}
+		}
+	}
+	return nil
+}
+
+func createAPIsAnalysisReport(ctx context.Context, dbHandler *_database.Handler, speculators *speculators_repo.Repository,
+	modulesManager modules.ModulesManager, modInfos []modules.ModuleInfo,
+) ([]*APIAnalysisReport, error) {
+	hostGroups, err := dbHandler.APIEventsTable().GroupByAPIInfo()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API inventory: %v", err)
+	}
+
+	hasSpecDiff := true
+	diffEvents, err := dbHandler.APIEventsTable().GetAPIEventsWithAnnotations(ctx, _database.GetAPIEventsQuery{
+		APIEventsFilters: &_database.APIEventsFilters{HasSpecDiffIs: &hasSpecDiff},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get spec diffs: %v", err)
+	}
+	specDiffs := map[uint][]*SpecDiffAnalysisReport{}
+	for _, event := range diffEvents {
+		specDiffs[event.APIInfoID] = append(specDiffs[event.APIInfoID], &SpecDiffAnalysisReport{
+			EventID:                  event.ID,
+			Method:                   string(event.Method),
+			Path:                     event.Path,
+			StatusCode:               event.StatusCode,
+			SpecDiffType:             string(event.SpecDiffType),
+			HasProvidedSpecDiff:      event.HasProvidedSpecDiff,
+			HasReconstructedSpecDiff: event.HasReconstructedSpecDiff,
+		})
+	}
+
+	apis := []*APIAnalysisReport{}
+	for _, hostGroup := range hostGroups {
+		apiInfo := &_database.APIInfo{}
+		if err := dbHandler.APIInventoryTable().First(apiInfo, hostGroup.APIInfoID); err != nil {
+			return nil, fmt.Errorf("failed to get API %d: %v", hostGroup.APIInfoID, err)
+		}
+
+		api := &APIAnalysisReport{
+			ID:        apiInfo.ID,
+			Name:      apiInfo.Name,
+			Port:      apiInfo.Port,
+			Type:      string(apiInfo.Type),
+			Events:    hostGroup.Count,
+			SpecDiffs: specDiffs[apiInfo.ID],
+			Findings:  getModulesAPIFindings(modulesManager, modInfos, apiInfo.ID),
+		}
+
+		speculator := speculators.Get(apiInfo.TraceSourceID)
+		specKey := _speculator.GetSpecKey(apiInfo.Name, strconv.Itoa(int(apiInfo.Port)))
+		api.HasProvidedSpec = speculator.HasProvidedSpec(specKey)
+		if speculator.HasApprovedSpec(specKey) {
+			reconstructedSpec, err := speculator.Specs[specKey].GenerateOASJson(_spec.OASv3)
+			if err != nil {
+				return nil, fmt.Errorf("failed to generate reconstructed spec for %s: %v", specKey, err)
+			}
+			api.ReconstructedSpec = reconstructedSpec
+		}
+
+		schemaDB, err := dbHandler.GraphQLSchemasTable().Get(apiInfo.ID)
+		switch {
+		case err == nil:
+			schema := graphql.NewSchema()
+			if err := json.Unmarshal([]byte(schemaDB.Schema), schema); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal GraphQL schema of %s: %v", specKey, err)
+			}
+			api.GraphQLSchema = schema.SDL()
+		case errors.Is(err, gorm.ErrRecordNotFound):
+		default:
+			return nil, fmt.Errorf("failed to get GraphQL schema of %s: %v", specKey, err)
+		}
+
+		apis = append(apis, api)
+	}
+
+	sort.Slice(apis, func(i, j int) bool { return apis[i].ID < apis[j].ID })
+	return apis, nil
+}
+
+// getModulesAPIFindings collects the findings of the modules that serve them at GET apiFindings/{apiID},
+// by calling their HTTP handlers directly.
+func getModulesAPIFindings(modulesManager modules.ModulesManager, modInfos []modules.ModuleInfo, apiID uint) map[string][]oapicommon.APIFinding {
+	handler := modulesManager.HTTPHandler()
+	findings := map[string][]oapicommon.APIFinding{}
+	for _, info := range modInfos {
+		path := fmt.Sprintf("%s/%s/apiFindings/%d", modules.BaseHTTPPath, info.Name, apiID)
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+		if recorder.Code != http.StatusOK {
+			continue
+		}
+
+		var apiFindings oapicommon.APIFindings
+		if err := json.NewDecoder(recorder.Body).Decode(&apiFindings); err != nil && !errors.Is(err, io.EOF) {
+			log.Warnf("Failed to decode API findings of module %s: %v", info.Name, err)
+			continue
+		}
+		if apiFindings.Items != nil && len(*apiFindings.Items) > 0 {
+			findings[info.Name] = *apiFindings.Items
+		}
+	}
+	if len(findings) == 0 {
+		return nil
+	}
+	return findings
+}
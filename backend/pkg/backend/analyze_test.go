@@ -0,0 +1,123 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func Test_replayTelemetries(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"1-array.json":   ` [{"requestID": "1"}, {"requestID": "2"}]`,
+		"2-stream.jsonl": "{\"requestID\": \"3\"}\n{\"requestID\": \"4\"}\n",
+		"3-bad.json":     `{"requestID": "5"} {"requestID": `,
+		"4-ignored.txt":  `{"requestID": "6"}`,
+	}
+	for name, content := range files {
+		assert.NilError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+	}
+
+	var requestIDs []string
+	var errs []error
+	err := replayTelemetries(dir, func(trace *pluginsmodels.Telemetry, err error) {
+		if err != nil {
+			errs = append(errs, err)
+			return
+		}
+		requestIDs = append(requestIDs, trace.RequestID)
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, requestIDs, []string{"1", "2", "3", "4", "5"})
+	assert.Equal(t, len(errs), 1)
+
+	err = replayTelemetries(filepath.Join(dir, "missing"), func(trace *pluginsmodels.Telemetry, err error) {})
+	assert.ErrorContains(t, err, "failed to stat traces path")
+}
+
+func TestAnalyze(t *testing.T) {
+	report, err := Analyze(context.Background(), &AnalyzeConfig{
+		TracesPath: filepath.Join("..", "test", "trace_files"),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, report.Telemetries.Failed, 0)
+	assert.Assert(t, report.Telemetries.Handled > 0)
+	assert.Assert(t, len(report.APIs) > 0)
+
+	var httpbin *APIAnalysisReport
+	for _, api := range report.APIs {
+		if api.Name == "httpbin" {
+			httpbin = api
+		}
+	}
+	assert.Assert(t, httpbin != nil)
+	assert.Assert(t, len(httpbin.ReconstructedSpec) > 0)
+}
+
+func TestAnalyze_graphql(t *testing.T) {
+	dir := t.TempDir()
+	headers := []*pluginsmodels.Header{
+		{Key: "host", Value: "graphql:8080"},
+		{Key: "content-type", Value: "application/json"},
+	}
+	trace := &pluginsmodels.Telemetry{
+		RequestID:          "1",
+		DestinationAddress: "10.0.0.1:8080",
+		SourceAddress:      "10.0.0.2:8080",
+		Request: &pluginsmodels.Request{
+			Method: "POST",
+			Path:   "/graphql",
+			Host:   "graphql",
+			Common: &pluginsmodels.Common{
+				Headers: headers,
+				Body:    []byte(`{"query": "query GetUser($id: ID!) { user(id: $id) { name } }", "variables": {"id": "1"}}`),
+			},
+		},
+		Response: &pluginsmodels.Response{
+			StatusCode: "200",
+			Common: &pluginsmodels.Common{
+				Headers: headers,
+				Body:    []byte(`{"data": {"user": {"name": "a"}}}`),
+			},
+		},
+	}
+	content, err := json.Marshal(trace)
+	assert.NilError(t, err)
+	assert.NilError(t, os.WriteFile(filepath.Join(dir, "graphql.json"), content, 0o600))
+
+	report, err := Analyze(context.Background(), &AnalyzeConfig{
+		TracesPath: dir,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, report.Telemetries.Failed, 0)
+	assert.Equal(t, len(report.APIs), 1)
+	assert.Equal(t, report.APIs[0].GraphQLSchema, `type Query {
+  user(id: ID!): User
+}
+
+type User {
+  name: String
+}
+`)
+}
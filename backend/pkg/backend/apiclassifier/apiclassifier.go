@@ -0,0 +1,179 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclassifier
+
+import (
+	"fmt"
+	"mime"
+	"path"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	_spec "github.com/openclarity/speculator/pkg/spec"
+	_speculator "github.com/openclarity/speculator/pkg/speculator"
+)
+
+const (
+	contentTypeHeaderName = "content-type"
+
+	OverrideAPI    = "API"
+	OverrideNonAPI = "NON_API"
+)
+
+// DefaultAPIMediaTypes are the response media types of APIs: JSON, XML (SOAP included), forms and gRPC.
+var DefaultAPIMediaTypes = []string{
+	"application/*json",
+	"application/xml",
+	"application/*+xml",
+	"text/xml",
+	"application/x-www-form-urlencoded",
+	"multipart/form-data",
+	"application/grpc",
+	"application/grpc+*",
+}
+
+// Classifier decides whether a telemetry is an API call, that is learned by the speculator and
+// analyzed by the modules, or a non-API resource (web page, image, script...).
+type Classifier interface {
+	IsNonAPI(telemetry *_spec.Telemetry) bool
+}
+
+// New creates the default classifier: the overrides first, then the response media type.
+func New(apiMediaTypes []string, overrides []string) (Classifier, error) {
+	if len(apiMediaTypes) == 0 {
+		apiMediaTypes = DefaultAPIMediaTypes
+	}
+	mediaTypeClassifier, err := NewMediaTypeClassifier(apiMediaTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedOverrides, err := ParseOverrides(overrides)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsedOverrides) == 0 {
+		return mediaTypeClassifier, nil
+	}
+
+	return NewOverridesClassifier(parsedOverrides, mediaTypeClassifier), nil
+}
+
+// MediaTypeClassifier classifies a telemetry as API if the media type of its response matches one of the allowed patterns.
+// A response without Content-Type is classified as API.
+type MediaTypeClassifier struct {
+	apiMediaTypes []string
+}
+
+// NewMediaTypeClassifier creates a MediaTypeClassifier. The patterns follow path.Match, so
+// "application/*+xml" matches "application/soap+xml" but "*" does not match across the "/".
+func NewMediaTypeClassifier(apiMediaTypes []string) (*MediaTypeClassifier, error) {
+	for _, pattern := range apiMediaTypes {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid API media type pattern %q: %v", pattern, err)
+		}
+	}
+
+	return &MediaTypeClassifier{
+		apiMediaTypes: apiMediaTypes,
+	}, nil
+}
+
+// NewDefaultMediaTypeClassifier creates a MediaTypeClassifier of DefaultAPIMediaTypes.
+func NewDefaultMediaTypeClassifier() *MediaTypeClassifier {
+	return &MediaTypeClassifier{
+		apiMediaTypes: DefaultAPIMediaTypes,
+	}
+}
+
+func (c *MediaTypeClassifier) IsNonAPI(telemetry *_spec.Telemetry) bool {
+	respHeaders := _spec.ConvertHeadersToMap(telemetry.Response.Common.Headers)
+
+	respContentType, ok := respHeaders[contentTypeHeaderName]
+	if !ok {
+		return false
+	}
+
+	mediaType, _, err := mime.ParseMediaType(respContentType)
+	if err != nil {
+		log.Errorf("Failed to parse response media type - classifying as non-API. Content-Type=%v: %v", respContentType, err)
+		return true
+	}
+
+	return !c.IsAPIMediaType(mediaType)
+}
+
+func (c *MediaTypeClassifier) IsAPIMediaType(mediaType string) bool {
+	for _, pattern := range c.apiMediaTypes {
+		// patterns were validated on creation
+		if matched, _ := path.Match(pattern, mediaType); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// OverridesClassifier forces the classification of the telemetries of some APIs, identified by
+// host:port or by host (any port), and delegates the others to the next classifier.
+type OverridesClassifier struct {
+	nonAPI map[string]bool
+	next   Classifier
+}
+
+func NewOverridesClassifier(nonAPI map[string]bool, next Classifier) *OverridesClassifier {
+	return &OverridesClassifier{
+		nonAPI: nonAPI,
+		next:   next,
+	}
+}
+
+func (c *OverridesClassifier) IsNonAPI(telemetry *_spec.Telemetry) bool {
+	host := telemetry.Request.Host
+	if destInfo, err := _speculator.GetAddressInfoFromAddress(telemetry.DestinationAddress); err == nil {
+		if nonAPI, ok := c.nonAPI[host+":"+destInfo.Port]; ok {
+			return nonAPI
+		}
+	}
+	if nonAPI, ok := c.nonAPI[host]; ok {
+		return nonAPI
+	}
+
+	return c.next.IsNonAPI(telemetry)
+}
+
+// ParseOverrides parses overrides in the format <host>[:<port>]=API or <host>[:<port>]=NON_API.
+// It returns whether the telemetries of each host[:port] are non-API.
+func ParseOverrides(overrides []string) (map[string]bool, error) {
+	ret := make(map[string]bool, len(overrides))
+	for _, override := range overrides {
+		const overrideParts = 2
+		parts := strings.SplitN(override, "=", overrideParts)
+		if len(parts) != overrideParts || parts[0] == "" {
+			return nil, fmt.Errorf("invalid API classification override %q, expected <host>[:<port>]=%s|%s", override, OverrideAPI, OverrideNonAPI)
+		}
+
+		switch strings.ToUpper(parts[1]) {
+		case OverrideAPI:
+			ret[parts[0]] = false
+		case OverrideNonAPI:
+			ret[parts[0]] = true
+		default:
+			return nil, fmt.Errorf("invalid API classification %q in override %q, expected %s or %s", parts[1], override, OverrideAPI, OverrideNonAPI)
+		}
+	}
+	return ret, nil
+}
@@ -0,0 +1,112 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclassifier
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	_spec "github.com/openclarity/speculator/pkg/spec"
+)
+
+func createTelemetry(host, destinationAddress, contentType string) *_spec.Telemetry {
+	var headers []*_spec.Header
+	if contentType != "" {
+		headers = append(headers, &_spec.Header{Key: contentTypeHeaderName, Value: contentType})
+	}
+	return &_spec.Telemetry{
+		DestinationAddress: destinationAddress,
+		Request: &_spec.Request{
+			Host: host,
+		},
+		Response: &_spec.Response{
+			Common: &_spec.Common{
+				Headers: headers,
+			},
+		},
+	}
+}
+
+func TestMediaTypeClassifier_IsNonAPI(t *testing.T) {
+	tests := []struct {
+		name          string
+		apiMediaTypes []string
+		contentType   string
+		want          bool
+	}{
+		{name: "json", contentType: "application/json", want: false},
+		{name: "json with params", contentType: "application/json; charset=utf-8", want: false},
+		{name: "problem+json", contentType: "application/problem+json", want: false},
+		{name: "xml", contentType: "application/xml", want: false},
+		{name: "text xml", contentType: "text/xml; charset=utf-8", want: false},
+		{name: "soap+xml", contentType: "application/soap+xml", want: false},
+		{name: "form", contentType: "application/x-www-form-urlencoded", want: false},
+		{name: "grpc", contentType: "application/grpc", want: false},
+		{name: "grpc+proto", contentType: "application/grpc+proto", want: false},
+		{name: "html", contentType: "text/html", want: true},
+		{name: "image", contentType: "image/png", want: true},
+		{name: "missing content type", contentType: "", want: false},
+		{name: "invalid content type", contentType: "application/json; =", want: true},
+		{name: "custom allow-list", apiMediaTypes: []string{"application/json", "text/*"}, contentType: "text/plain", want: false},
+		{name: "custom allow-list excludes default", apiMediaTypes: []string{"application/json"}, contentType: "application/xml", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			classifier, err := New(tt.apiMediaTypes, nil)
+			assert.NilError(t, err)
+			assert.Equal(t, classifier.IsNonAPI(createTelemetry("svc", "10.0.0.1:80", tt.contentType)), tt.want)
+		})
+	}
+}
+
+func TestNew_invalidMediaType(t *testing.T) {
+	_, err := New([]string{"application/[json"}, nil)
+	assert.ErrorContains(t, err, "invalid API media type pattern")
+}
+
+func TestOverridesClassifier_IsNonAPI(t *testing.T) {
+	classifier, err := New(nil, []string{"legacy:8080=API", "static=non_api", "static:9090=API"})
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name      string
+		telemetry *_spec.Telemetry
+		want      bool
+	}{
+		{name: "host and port override", telemetry: createTelemetry("legacy", "10.0.0.1:8080", "text/html"), want: false},
+		{name: "other port falls through", telemetry: createTelemetry("legacy", "10.0.0.1:80", "text/html"), want: true},
+		{name: "host override", telemetry: createTelemetry("static", "10.0.0.2:80", "application/json"), want: true},
+		{name: "host and port override preferred over host", telemetry: createTelemetry("static", "10.0.0.2:9090", "text/html"), want: false},
+		{name: "no override", telemetry: createTelemetry("svc", "10.0.0.3:80", "application/json"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, classifier.IsNonAPI(tt.telemetry), tt.want)
+		})
+	}
+}
+
+func TestParseOverrides(t *testing.T) {
+	overrides, err := ParseOverrides([]string{"a=API", "b:80=NON_API"})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, overrides, map[string]bool{"a": false, "b:80": true})
+
+	for _, invalid := range []string{"a", "=API", "a=JSON"} {
+		_, err := ParseOverrides([]string{invalid})
+		assert.Assert(t, err != nil, invalid)
+	}
+}
@@ -17,8 +17,10 @@ package backend
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
 	"fmt"
-	"mime"
 	"net/url"
 	"os"
 	"os/signal"
@@ -34,14 +36,19 @@ import (
 	"k8s.io/client-go/kubernetes"
 
 	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/backend/apiclassifier"
 	"github.com/openclarity/apiclarity/backend/pkg/backend/speculatoraccessor"
 	"github.com/openclarity/apiclarity/backend/pkg/common"
 	_config "github.com/openclarity/apiclarity/backend/pkg/config"
 	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/graphql"
+	"github.com/openclarity/apiclarity/backend/pkg/grpcapi"
 	"github.com/openclarity/apiclarity/backend/pkg/healthz"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
 	"github.com/openclarity/apiclarity/backend/pkg/k8smonitor"
 	"github.com/openclarity/apiclarity/backend/pkg/modules"
 	_notifier "github.com/openclarity/apiclarity/backend/pkg/notifier"
+	"github.com/openclarity/apiclarity/backend/pkg/redaction"
 	"github.com/openclarity/apiclarity/backend/pkg/rest"
 	"github.com/openclarity/apiclarity/backend/pkg/sampling"
 	speculators_repo "github.com/openclarity/apiclarity/backend/pkg/speculators"
@@ -51,7 +58,6 @@ import (
 	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
 	_spec "github.com/openclarity/speculator/pkg/spec"
 	_speculator "github.com/openclarity/speculator/pkg/speculator"
-	_mimeutils "github.com/openclarity/speculator/pkg/utils"
 )
 
 type Backend struct {
@@ -63,17 +69,31 @@ type Backend struct {
 	dbHandler           _database.Database
 	modulesManager      modules.ModulesManager
 	notifier            *_notifier.Notifier
+	ingestionMetrics    *ingestion.Metrics
+	apiClassifier       apiclassifier.Classifier
+	graphQLSchemasLock  sync.Mutex
+	graphQLSchemas      map[uint]*graphql.Schema
+	grpcDescriptors     *grpcapi.Repository
+	redactionPolicies   *redaction.Repository
+	// the telemetries of the events are stored to be replayed through the modules
+	storeEventTelemetries bool
 }
 
-func CreateBackend(config *_config.Config, monitor *k8smonitor.Monitor, speculators *speculators_repo.Repository, dbHandler *_database.Handler, modulesManager modules.ModulesManager, notifier *_notifier.Notifier) *Backend {
+func CreateBackend(config *_config.Config, monitor *k8smonitor.Monitor, speculators *speculators_repo.Repository, dbHandler *_database.Handler, modulesManager modules.ModulesManager, notifier *_notifier.Notifier, apiClassifier apiclassifier.Classifier, redactionPolicies *redaction.Repository) *Backend {
 	return &Backend{
-		speculators:         speculators,
-		stateBackupInterval: time.Second * time.Duration(config.StateBackupIntervalSec),
-		stateBackupFileName: config.StateBackupFileName,
-		monitor:             monitor,
-		dbHandler:           dbHandler,
-		modulesManager:      modulesManager,
-		notifier:            notifier,
+		speculators:           speculators,
+		stateBackupInterval:   time.Second * time.Duration(config.StateBackupIntervalSec),
+		stateBackupFileName:   config.StateBackupFileName,
+		monitor:               monitor,
+		dbHandler:             dbHandler,
+		modulesManager:        modulesManager,
+		notifier:              notifier,
+		ingestionMetrics:      ingestion.NewMetrics(),
+		apiClassifier:         apiClassifier,
+		graphQLSchemas:        map[uint]*graphql.Schema{},
+		grpcDescriptors:       grpcapi.NewRepository(dbHandler),
+		redactionPolicies:     redactionPolicies,
+		storeEventTelemetries: config.EventTelemetryRetentionHours > 0,
 	}
 }
 
@@ -117,6 +137,11 @@ func Run() {
 	dbConfig := createDatabaseConfig(config)
 	dbHandler := _database.Init(dbConfig)
 	dbHandler.StartReviewTableCleaner(globalCtx, time.Duration(config.DatabaseCleanerIntervalSec)*time.Second)
+	dbHandler.StartAnnotationsReaper(globalCtx, time.Duration(config.DatabaseCleanerIntervalSec)*time.Second)
+	if config.EventTelemetryRetentionHours > 0 {
+		dbHandler.StartEventTelemetriesCleaner(globalCtx, time.Duration(config.DatabaseCleanerIntervalSec)*time.Second,
+			time.Duration(config.EventTelemetryRetentionHours)*time.Hour)
+	}
 	var clientset kubernetes.Interface
 	var monitor *k8smonitor.Monitor
 
@@ -167,11 +192,12 @@ func Run() {
 		notifier.Start(context.Background())
 	}
 
-	samplingManager, err := sampling.CreateTraceSamplingManager(dbHandler, config, clientset, errChan)
+	samplingManager, err := sampling.CreateTraceSamplingManager(dbHandler, config)
 	if err != nil {
 		log.Errorf("Failed to create Trace Sampling Manager: %v", err)
 		return
 	}
+	samplingManager.StartTracingSessionsExpiry(globalCtx, time.Duration(config.TracingSessionCheckIntervalSec)*time.Second)
 
 	modulesWrapper, modInfos, err := modules.New(globalCtx, dbHandler, clientset, samplingManager, speculatoraccessor.NewSpeculatorAccessor(speculators), notifier, config)
 	if err != nil {
@@ -181,7 +207,19 @@ func Run() {
 
 	features := append(modInfos, getCoreFeatures()...)
 
-	backend := CreateBackend(config, monitor, speculators, dbHandler, modulesWrapper, notifier)
+	apiClassifier, err := apiclassifier.New(config.APIMediaTypes, config.APIClassificationOverrides)
+	if err != nil {
+		log.Errorf("Failed to create API classifier: %v", err)
+		return
+	}
+
+	redactionPolicies, err := redaction.NewRepository(dbHandler, config.RedactionHashKey)
+	if err != nil {
+		log.Errorf("Failed to create redaction policies repository: %v", err)
+		return
+	}
+
+	backend := CreateBackend(config, monitor, speculators, dbHandler, modulesWrapper, notifier, apiClassifier, redactionPolicies)
 
 	serverConfig := &rest.ServerConfig{
 		EnableTLS:             config.EnableTLS,
@@ -195,13 +233,30 @@ func Run() {
 		Features:              features,
 		Notifier:              notifier,
 		SamplingManager:       samplingManager,
+		TraceHandleFunc:       backend.handleHTTPTrace,
+		GRPCDescriptors:       backend.grpcDescriptors,
+		RedactionPolicies:     backend.redactionPolicies,
 	}
 	restServer, err := rest.CreateRESTServer(serverConfig)
 	if err != nil {
 		log.Fatalf("Failed to create REST server: %v", err)
 	}
 	restServer.Start(errChan)
-	defer restServer.Stop()
+
+	if err := modulesWrapper.Start(globalCtx); err != nil {
+		log.Errorf("Failed to start modules: %v", err)
+		return
+	}
+
+	ingestionPipeline := ingestion.NewPipeline(backend.handleHTTPTrace, config.IngestionMaxQueueSize, config.IngestionWorkers, backend.ingestionMetrics)
+	ingestionPipeline.Start(globalCtx)
+	// Exposed by the health server under /debug/vars
+	expvar.Publish("ingestion", expvar.Func(func() interface{} {
+		return ingestionPipeline.Stats()
+	}))
+	expvar.Publish("modules", expvar.Func(func() interface{} {
+		return modulesWrapper.DispatchStats()
+	}))
 
 	httpTracesServerConfig := &traces.HTTPTracesServerConfig{
 		EnableTLS:             config.EnableTLS,
@@ -209,17 +264,52 @@ func Run() {
 		TLSPort:               config.HTTPTracesTLSPort,
 		TLSServerCertFilePath: config.TLSServerCertFilePath,
 		TLSServerKeyFilePath:  config.TLSServerKeyFilePath,
-		TraceHandleFunc:       backend.handleHTTPTrace,
+		TraceHandleFunc:       ingestionPipeline.Enqueue,
 		NewDiscoveredAPIsFunc: restServer.CreateNewDiscoveredAPIs,
 		TraceSourceAuthFunc:   nil,
 		TraceSamplingManager:  samplingManager,
+		RetryAfterSec:         config.IngestionRetryAfterSec,
 	}
 	tracesServer, err := traces.CreateHTTPTracesServer(httpTracesServerConfig)
 	if err != nil {
 		log.Fatalf("Failed to create trace server: %v", err)
 	}
 	tracesServer.Start(errChan)
-	defer tracesServer.Stop()
+	// the servers receiving the telemetries, stopped first on shutdown
+	receivers := []func(){tracesServer.Stop}
+
+	grpcTracesServerConfig := &traces.GRPCTracesServerConfig{
+		EnableTLS:             config.EnableTLS,
+		Port:                  config.GRPCTracesPort,
+		TLSServerCertFilePath: config.TLSServerCertFilePath,
+		TLSServerKeyFilePath:  config.TLSServerKeyFilePath,
+		TraceHandleFunc:       ingestionPipeline.Enqueue,
+		TraceSourceAuthFunc:   nil,
+	}
+	grpcTracesServer, err := traces.CreateGRPCTracesServer(grpcTracesServerConfig)
+	if err != nil {
+		log.Fatalf("Failed to create gRPC trace server: %v", err)
+	}
+	grpcTracesServer.Start(errChan)
+	receivers = append(receivers, grpcTracesServer.Stop)
+
+	if config.OTLPReceiverEnabled {
+		otlpServer, err := traces.CreateOTLPServer(&traces.OTLPServerConfig{
+			EnableTLS:             config.EnableTLS,
+			GRPCPort:              config.OTLPGRPCPort,
+			HTTPPort:              config.OTLPHTTPPort,
+			TLSServerCertFilePath: config.TLSServerCertFilePath,
+			TLSServerKeyFilePath:  config.TLSServerKeyFilePath,
+			PreferHostNames:       config.OTLPPreferHostNames,
+			TraceHandleFunc:       ingestionPipeline.Enqueue,
+			RetryAfterSec:         config.IngestionRetryAfterSec,
+		})
+		if err != nil {
+			log.Fatalf("Failed to create OTLP server: %v", err)
+		}
+		otlpServer.Start(errChan)
+		receivers = append(receivers, otlpServer.Stop)
+	}
 
 	if config.EnableTLS {
 		httpExternalTracesServerConfig := httpTracesServerConfig
@@ -232,9 +322,20 @@ func Run() {
 			log.Fatalf("Failed to create external trace server: %v", err)
 		}
 		externalTracesServer.Start(errChan)
-		defer externalTracesServer.Stop()
+		receivers = append(receivers, externalTracesServer.Stop)
+
+		grpcExternalTracesServerConfig := *grpcTracesServerConfig
+		grpcExternalTracesServerConfig.Port = config.ExternalGRPCTracesTLSPort
+		grpcExternalTracesServerConfig.TraceSourceAuthFunc = backend.traceSourceAuth
+
+		externalGRPCTracesServer, err := traces.CreateGRPCTracesServer(&grpcExternalTracesServerConfig)
+		if err != nil {
+			log.Fatalf("Failed to create external gRPC trace server: %v", err)
+		}
+		externalGRPCTracesServer.Start(errChan)
+		receivers = append(receivers, externalGRPCTracesServer.Stop)
 	} else {
-		log.Warningf("External trace server not started because TLS is not enabled")
+		log.Warningf("External trace servers not started because TLS is not enabled")
 	}
 
 	backend.startStateBackup(globalCtx)
@@ -256,6 +357,58 @@ func Run() {
 	case s := <-sig:
 		log.Warningf("Received a termination signal: %v", s)
 	}
+
+	healthServer.SetIsReady(false)
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), time.Duration(config.ShutdownTimeoutSec)*time.Second)
+	defer shutdownCancel()
+
+	shutdown(shutdownCtx, []shutdownStep{
+		{
+			name: "traces servers",
+			stop: func(ctx context.Context) error {
+				for _, stop := range receivers {
+					stop()
+				}
+				return nil
+			},
+		},
+		{
+			// the telemetries already accepted are handled
+			name: "ingestion pipeline",
+			stop: func(ctx context.Context) error {
+				ingestionPipeline.Stop()
+				return nil
+			},
+		},
+		{
+			name: "modules",
+			stop: modulesWrapper.Stop,
+		},
+		{
+			// after the modules, which notify on stop
+			name: "notifier",
+			stop: func(ctx context.Context) error {
+				if notifier == nil {
+					return nil
+				}
+				return notifier.Stop(ctx)
+			},
+		},
+		{
+			name: "speculators state backup",
+			stop: func(ctx context.Context) error {
+				return speculators.EncodeState(config.StateBackupFileName)
+			},
+		},
+		{
+			name: "REST server",
+			stop: func(ctx context.Context) error {
+				restServer.Stop()
+				return nil
+			},
+		},
+	})
 }
 
 func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Telemetry, traceSource *models.TraceSource) error {
@@ -266,6 +419,12 @@ func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Tele
 		traceSourceID = uint(traceSource.ID)
 	}
 
+	// redact the trace before it is learned, stored and dispatched to the modules
+	redactionPolicy, err := b.redact(trace, traceSourceID)
+	if err != nil {
+		return fmt.Errorf("failed to redact telemetry: %v", err)
+	}
+
 	log.Debugf("Handling telemetry: %+v", trace)
 
 	// TODO: Selective tracing for spec diffs and spec reconstruction
@@ -319,6 +478,7 @@ func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Tele
 	}
 
 	// lock the API inventory to avoid creating API entries twice on trace handling races
+	inventoryStart := time.Now()
 	b.apiInventoryLock.Lock()
 	created, err := b.dbHandler.APIInventoryTable().FirstOrCreate(&apiInfo)
 	if err != nil {
@@ -326,20 +486,38 @@ func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Tele
 		return fmt.Errorf("failed to get or create API info: %v", err)
 	}
 	b.apiInventoryLock.Unlock()
+	b.ingestionMetrics.ObserveStageSince(ingestion.StageInventory, inventoryStart)
 	log.Infof("API Info in DB: %+v", apiInfo)
 	if created {
 		log.Infof("Sending notification for new created API %+v", apiInfo)
 	}
 
-	isNonAPI := isNonAPI(telemetry)
+	speculatorStart := time.Now()
+	isNonAPI := b.isNonAPI(&apiInfo, telemetry)
+	grpcCall := grpcapi.Detect(trace)
+	var graphQLCall *graphql.Call
 	if !isNonAPI {
-		// Handle trace telemetry by Speculator
-		if !b.speculators.Get(traceSourceID).HasApprovedSpec(specKey) {
-			err := b.speculators.Get(traceSourceID).LearnTelemetry(telemetry)
-			if err != nil {
+		// Handle trace telemetry by Speculator, gRPC methods are kept in the gRPC inventory instead of the spec
+		if grpcCall == nil && !b.speculators.Get(traceSourceID).HasApprovedSpec(specKey) {
+			learnedTelemetry := telemetry
+			// A body that doesn't match its media type (truncated JSON, broken multipart...) must not
+			// prevent learning the operation, its parameters, headers and security.
+			var bodyErr *speculatorutils.BodyDecodingError
+			if err := speculatorutils.CheckBodies(telemetry); errors.As(err, &bodyErr) {
+				log.Warnf("Learning telemetry without bodies: %v", bodyErr)
+				learnedTelemetry = telemetryWithoutBodies(telemetry)
+			}
+			if err := b.speculators.Get(traceSourceID).LearnTelemetry(learnedTelemetry); err != nil {
 				return fmt.Errorf("failed to learn telemetry: %v", err)
 			}
 		}
+
+		// GraphQL operations all share the same path, keep track of the executed operations and learn their schema
+		if graphQLCall = graphql.Detect(telemetry); graphQLCall != nil {
+			if err := b.learnGraphQLSchema(apiInfo.ID, graphQLCall, telemetry.Response.Common.Body); err != nil {
+				log.Errorf("Failed to learn GraphQL schema: %v", err)
+			}
+		}
 	}
 
 	path, query := _spec.GetPathAndQuery(telemetry.Request.Path)
@@ -358,6 +536,7 @@ func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Tele
 			return fmt.Errorf("failed to get path id of reconstructed spec: %v", err)
 		}
 	}
+	b.ingestionMetrics.ObserveStageSince(ingestion.StageSpeculator, speculatorStart)
 
 	// Update API event in DB
 	statusCode, err := strconv.Atoi(telemetry.Response.StatusCode)
@@ -382,14 +561,62 @@ func (b *Backend) handleHTTPTrace(ctx context.Context, trace *pluginsmodels.Tele
 		ProvidedPathID:      providedPathID,
 		ReconstructedPathID: reconstructedPathID,
 	}
+	if graphQLCall != nil {
+		setGraphQLOperation(event, graphQLCall)
+	}
+	if grpcCall != nil {
+		setGRPCCall(event, grpcCall)
+		if err := b.decodeGRPCMessages(apiInfo.ID, event, trace, redactionPolicy); err != nil {
+			log.Warnf("Failed to decode gRPC messages of %s/%s: %v", grpcCall.Service, grpcCall.Method, err)
+		}
+	}
 
+	databaseStart := time.Now()
 	b.dbHandler.APIEventsTable().CreateAPIEvent(event)
+	if b.storeEventTelemetries {
+		b.storeEventTelemetry(ctx, event, trace)
+	}
+	b.ingestionMetrics.ObserveStageSince(ingestion.StageDatabase, databaseStart)
 
+	modulesStart := time.Now()
 	b.modulesManager.EventNotify(ctx, &modules.Event{APIEvent: event, APIInfo: &apiInfo, Telemetry: trace})
+	b.ingestionMetrics.ObserveStageSince(ingestion.StageModules, modulesStart)
 
 	return nil
 }
 
+// storeEventTelemetry retains the (redacted) telemetry of the event, so that it can be replayed through the modules.
+func (b *Backend) storeEventTelemetry(ctx context.Context, event *_database.APIEvent, trace *pluginsmodels.Telemetry) {
+	telemetry, err := json.Marshal(trace)
+	if err != nil {
+		log.Errorf("Failed to serialize telemetry of event %v: %v", event.ID, err)
+		return
+	}
+	if err := b.dbHandler.APIEventTelemetriesTable().Create(ctx, &_database.APIEventTelemetry{
+		EventID:   event.ID,
+		APIInfoID: event.APIInfoID,
+		Time:      time.Time(event.Time),
+		Telemetry: telemetry,
+	}); err != nil {
+		log.Errorf("Failed to store telemetry of event %v: %v", event.ID, err)
+	}
+}
+
+// redact applies the redaction policy of the trace source to the trace and returns it, nil if there is none.
+func (b *Backend) redact(trace *pluginsmodels.Telemetry, traceSourceID uint) (*redaction.Policy, error) {
+	if b.redactionPolicies == nil {
+		return nil, nil
+	}
+	policy, err := b.redactionPolicies.Get(traceSourceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get redaction policy: %v", err)
+	}
+	if policy != nil {
+		policy.Redact(trace)
+	}
+	return policy, nil
+}
+
 func (b *Backend) traceSourceAuth(ctx context.Context, token string) (*models.TraceSource, error) {
 	traceSourceDB, err := b.dbHandler.TraceSourcesTable().GetTraceSourceFromToken(token)
 	if err != nil {
@@ -437,23 +664,40 @@ const (
 	contentTypeApplicationJSON = "application/json"
 )
 
-func isNonAPI(telemetry *_spec.Telemetry) bool {
-	respHeaders := _spec.ConvertHeadersToMap(telemetry.Response.Common.Headers)
+// defaultAPIClassifier is used by backends created without a classifier.
+var defaultAPIClassifier = apiclassifier.NewDefaultMediaTypeClassifier()
 
-	// If response content-type header is missing, we will classify it as API
-	respContentType, ok := respHeaders[contentTypeHeaderName]
-	if !ok {
-		return false
+// isNonAPI classifies the telemetry with the classification override of its API, or else with the classifier.
+func (b *Backend) isNonAPI(apiInfo *_database.APIInfo, telemetry *_spec.Telemetry) bool {
+	if apiInfo.ClassificationOverride != "" {
+		return apiInfo.ClassificationOverride == models.APIClassificationNONAPI
 	}
-
-	mediaType, _, err := mime.ParseMediaType(respContentType)
-	if err != nil {
-		log.Errorf("Failed to parse response media type - classifying as non-API. Content-Type=%v: %v", respContentType, err)
-		return true
+	if b.apiClassifier == nil {
+		return isNonAPI(telemetry)
 	}
+	return b.apiClassifier.IsNonAPI(telemetry)
+}
+
+func isNonAPI(telemetry *_spec.Telemetry) bool {
+	return defaultAPIClassifier.IsNonAPI(telemetry)
+}
+
+func telemetryWithoutBodies(telemetry *_spec.Telemetry) *_spec.Telemetry {
+	ret := *telemetry
+
+	request := *telemetry.Request
+	requestCommon := *telemetry.Request.Common
+	requestCommon.Body = nil
+	request.Common = &requestCommon
+	ret.Request = &request
+
+	response := *telemetry.Response
+	responseCommon := *telemetry.Response.Common
+	responseCommon.Body = nil
+	response.Common = &responseCommon
+	ret.Response = &response
 
-	// If response content-type is not application/json, need to classify the telemetry as non-api
-	return !_mimeutils.IsApplicationJSONMediaType(mediaType)
+	return &ret
 }
 
 func (b *Backend) startStateBackup(ctx context.Context) {
@@ -24,6 +24,7 @@ import (
 	"github.com/golang/mock/gomock"
 	"gotest.tools/assert"
 
+	"github.com/openclarity/apiclarity/api/server/models"
 	"github.com/openclarity/apiclarity/backend/pkg/common"
 	_database "github.com/openclarity/apiclarity/backend/pkg/database"
 	"github.com/openclarity/apiclarity/backend/pkg/k8smonitor"
@@ -108,6 +109,24 @@ func Test_isNonAPI(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "content type is application/xml - classify as API",
+			args: args{
+				trace: &_spec.Telemetry{
+					Response: &_spec.Response{
+						Common: &_spec.Common{
+							Headers: []*_spec.Header{
+								{
+									Key:   contentTypeHeaderName,
+									Value: "application/xml; charset=utf-8",
+								},
+							},
+						},
+					},
+				},
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -118,6 +137,69 @@ func Test_isNonAPI(t *testing.T) {
 	}
 }
 
+func TestBackend_isNonAPI_classificationOverride(t *testing.T) {
+	htmlTelemetry := &_spec.Telemetry{
+		Response: &_spec.Response{
+			Common: &_spec.Common{
+				Headers: []*_spec.Header{{Key: contentTypeHeaderName, Value: "text/html"}},
+			},
+		},
+	}
+	jsonTelemetry := &_spec.Telemetry{
+		Response: &_spec.Response{
+			Common: &_spec.Common{
+				Headers: []*_spec.Header{{Key: contentTypeHeaderName, Value: contentTypeApplicationJSON}},
+			},
+		},
+	}
+	b := &Backend{}
+
+	tests := []struct {
+		name      string
+		override  models.APIClassification
+		telemetry *_spec.Telemetry
+		want      bool
+	}{
+		{name: "no override html", telemetry: htmlTelemetry, want: true},
+		{name: "no override json", telemetry: jsonTelemetry, want: false},
+		{name: "api override html", override: models.APIClassificationAPI, telemetry: htmlTelemetry, want: false},
+		{name: "non api override json", override: models.APIClassificationNONAPI, telemetry: jsonTelemetry, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiInfo := &_database.APIInfo{ClassificationOverride: tt.override}
+			if got := b.isNonAPI(apiInfo, tt.telemetry); got != tt.want {
+				t.Errorf("isNonAPI() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_telemetryWithoutBodies(t *testing.T) {
+	telemetry := &_spec.Telemetry{
+		RequestID: "1",
+		Request: &_spec.Request{
+			Method: "POST",
+			Common: &_spec.Common{Body: []byte("{"), Headers: []*_spec.Header{{Key: contentTypeHeaderName, Value: contentTypeApplicationJSON}}},
+		},
+		Response: &_spec.Response{
+			StatusCode: "200",
+			Common:     &_spec.Common{Body: []byte("<a/>")},
+		},
+	}
+
+	got := telemetryWithoutBodies(telemetry)
+	if len(got.Request.Common.Body) > 0 || len(got.Response.Common.Body) > 0 {
+		t.Errorf("telemetryWithoutBodies() has bodies")
+	}
+	if got.RequestID != "1" || got.Request.Method != "POST" || got.Response.StatusCode != "200" || len(got.Request.Common.Headers) != 1 {
+		t.Errorf("telemetryWithoutBodies() = %+v, expected the telemetry without bodies", got)
+	}
+	if string(telemetry.Request.Common.Body) != "{" || string(telemetry.Response.Common.Body) != "<a/>" {
+		t.Errorf("telemetryWithoutBodies() modified the original telemetry")
+	}
+}
+
 func Test_getHostname(t *testing.T) {
 	type args struct {
 		host string
@@ -0,0 +1,79 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/graphql"
+)
+
+func setGraphQLOperation(event *_database.APIEvent, call *graphql.Call) {
+	event.GraphQLOperationType = models.GraphQLOperationType(strings.ToUpper(string(call.Operation.Type)))
+	event.GraphQLOperationName = call.Operation.Name
+	event.GraphQLRootFields = _database.GraphQLRootFieldsToColumn(call.RootFields)
+}
+
+// maxCachedGraphQLSchemas bounds the schemas kept in memory, an evicted schema is loaded again from the database.
+const maxCachedGraphQLSchemas = 1000
+
+// learnGraphQLSchema merges the call into the reconstructed GraphQL schema of the API, the schema is saved when changed.
+func (b *Backend) learnGraphQLSchema(apiID uint, call *graphql.Call, responseBody []byte) error {
+	b.graphQLSchemasLock.Lock()
+	defer b.graphQLSchemasLock.Unlock()
+
+	schema, ok := b.graphQLSchemas[apiID]
+	if !ok {
+		schema = graphql.NewSchema()
+		schemaDB, err := b.dbHandler.GraphQLSchemasTable().Get(apiID)
+		switch {
+		case err == nil:
+			if err := json.Unmarshal([]byte(schemaDB.Schema), schema); err != nil {
+				return fmt.Errorf("failed to unmarshal GraphQL schema: %v", err)
+			}
+		case errors.Is(err, gorm.ErrRecordNotFound):
+		default:
+			return fmt.Errorf("failed to get GraphQL schema: %v", err)
+		}
+		if len(b.graphQLSchemas) >= maxCachedGraphQLSchemas {
+			for evictedID := range b.graphQLSchemas {
+				delete(b.graphQLSchemas, evictedID)
+				break
+			}
+		}
+		b.graphQLSchemas[apiID] = schema
+	}
+
+	if !schema.Learn(call.Document, call.Operation, responseBody) {
+		return nil
+	}
+
+	schemaB, err := json.Marshal(schema)
+	if err != nil {
+		return fmt.Errorf("failed to marshal GraphQL schema: %v", err)
+	}
+	if err := b.dbHandler.GraphQLSchemasTable().Set(apiID, string(schemaB)); err != nil {
+		return fmt.Errorf("failed to save GraphQL schema: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+
+	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/grpcapi"
+	"github.com/openclarity/apiclarity/backend/pkg/redaction"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func setGRPCCall(event *_database.APIEvent, call *pluginsmodels.GrpcTelemetry) {
+	event.GRPCService = call.Service
+	event.GRPCMethod = call.Method
+	event.GRPCStatus = call.Status
+	event.GRPCStatusMessage = call.StatusMessage
+	event.GRPCRequestMessagesCount = call.RequestMessagesCount
+	event.GRPCRequestMessagesSize = call.RequestMessagesSize
+	event.GRPCResponseMessagesCount = call.ResponseMessagesCount
+	event.GRPCResponseMessagesSize = call.ResponseMessagesSize
+}
+
+// decodeGRPCMessages sets the messages of the event decoded to JSON, if a descriptor set of the API defines the method.
+// The binary messages can't be redacted, the redaction policy applies to the decoded ones.
+func (b *Backend) decodeGRPCMessages(apiID uint, event *_database.APIEvent, trace *pluginsmodels.Telemetry, redactionPolicy *redaction.Policy) error {
+	descriptors, err := b.grpcDescriptors.Get(apiID)
+	if err != nil {
+		return fmt.Errorf("failed to get descriptors: %v", err)
+	}
+	if descriptors == nil || descriptors.Method(event.GRPCService, event.GRPCMethod) == nil {
+		return nil
+	}
+
+	if event.GRPCRequestMessages, err = decodeGRPCBody(descriptors, event, trace.Request.Common, true); err != nil {
+		return fmt.Errorf("failed to decode request messages: %v", err)
+	}
+	if trace.Response != nil {
+		if event.GRPCResponseMessages, err = decodeGRPCBody(descriptors, event, trace.Response.Common, false); err != nil {
+			return fmt.Errorf("failed to decode response messages: %v", err)
+		}
+	}
+
+	if redactionPolicy != nil {
+		event.GRPCRequestMessages = redactGRPCMessages(redactionPolicy, event.GRPCRequestMessages)
+		event.GRPCResponseMessages = redactGRPCMessages(redactionPolicy, event.GRPCResponseMessages)
+	}
+
+	return nil
+}
+
+// redactGRPCMessages redacts each message of a JSON array of decoded messages, the policy JSON paths
+// being relative to a message.
+func redactGRPCMessages(redactionPolicy *redaction.Policy, messages string) string {
+	if messages == "" {
+		return messages
+	}
+	var decoded []json.RawMessage
+	if err := json.Unmarshal([]byte(messages), &decoded); err != nil {
+		return redactionPolicy.RedactJSON(messages)
+	}
+	for i, message := range decoded {
+		decoded[i] = json.RawMessage(redactionPolicy.RedactJSON(string(message)))
+	}
+	ret, err := json.Marshal(decoded)
+	if err != nil {
+		return redactionPolicy.RedactJSON(messages)
+	}
+	return string(ret)
+}
+
+func decodeGRPCBody(descriptors *grpcapi.Descriptors, event *_database.APIEvent, common *pluginsmodels.Common, isRequest bool) (string, error) {
+	if common == nil {
+		return "", nil
+	}
+	messages, err := grpcapi.SplitMessages(common.Body, grpcapi.MessagesEncoding(common.Headers))
+	if err != nil {
+		return "", err
+	}
+	return descriptors.DecodeMessages(event.GRPCService, event.GRPCMethod, messages, isRequest)
+}
@@ -0,0 +1,34 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/redaction"
+)
+
+func Test_redactGRPCMessages(t *testing.T) {
+	policy, err := redaction.Compile(&models.RedactionPolicy{BodyFields: []string{"$.password"}}, nil)
+	assert.NilError(t, err)
+
+	assert.Equal(t, redactGRPCMessages(policy, `[{"name":"a","password":"b"},{"password":"c"}]`),
+		`[{"name":"a","password":"[REDACTED]"},{"password":"[REDACTED]"}]`)
+	assert.Equal(t, redactGRPCMessages(policy, ""), "")
+}
@@ -0,0 +1,57 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type shutdownStep struct {
+	name string
+	stop func(ctx context.Context) error
+}
+
+// shutdown runs the steps one after the other, in order. The steps share the deadline of ctx: a step which
+// doesn't end in time is abandoned, and the next ones are still run with the done ctx so that they can at least
+// release what doesn't need to wait.
+func shutdown(ctx context.Context, steps []shutdownStep) {
+	log.Infof("Shutting down")
+	for _, step := range steps {
+		start := time.Now()
+		if err := runShutdownStep(ctx, step); err != nil {
+			log.Errorf("Failed to stop %s: %v", step.name, err)
+			continue
+		}
+		log.Infof("Stopped %s in %v", step.name, time.Since(start))
+	}
+}
+
+func runShutdownStep(ctx context.Context, step shutdownStep) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- step.stop(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backend
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+)
+
+func Test_shutdown(t *testing.T) {
+	var stopped []string
+	step := func(name string, err error) shutdownStep {
+		return shutdownStep{
+			name: name,
+			stop: func(ctx context.Context) error {
+				stopped = append(stopped, name)
+				return err
+			},
+		}
+	}
+
+	// a failing step doesn't prevent the next ones from running
+	shutdown(context.Background(), []shutdownStep{
+		step("first", nil),
+		step("second", errors.New("failed")),
+		step("third", nil),
+	})
+	assert.DeepEqual(t, stopped, []string{"first", "second", "third"})
+}
+
+func Test_shutdownTimeout(t *testing.T) {
+	release := make(chan struct{})
+	defer close(release)
+	lastRun := make(chan struct{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	shutdown(ctx, []shutdownStep{
+		{
+			name: "hanging",
+			stop: func(ctx context.Context) error {
+				<-release
+				return nil
+			},
+		},
+		{
+			name: "last",
+			stop: func(ctx context.Context) error {
+				assert.Assert(t, ctx.Err() != nil)
+				close(lastRun)
+				return nil
+			},
+		},
+	})
+	assert.Assert(t, time.Since(start) < 5*time.Second)
+
+	// the steps after the timeout are still run
+	select {
+	case <-lastRun:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the last step was not run")
+	}
+}
@@ -26,30 +26,29 @@ import (
 )
 
 const (
-	BackendRestPort               = "BACKEND_REST_PORT"
-	BackendRestTLSPort            = "BACKEND_REST_TLS_PORT"
-	TraceSamplingEnabled          = "TRACE_SAMPLING_ENABLED"
-	HTTPTracesPort                = "HTTP_TRACES_PORT"
-	HTTPTracesTLSPort             = "HTTP_TRACES_TLS_PORT"
-	HTTPTraceSamplingManagerPort  = "HTTP_TRACE_SAMPLING_MANAGER_PORT"
-	HTTPSTraceSamplingManagerPort = "HTTPS_TRACE_SAMPLING_MANAGER_PORT"
-	GRPCTraceSamplingManagerPort  = "GRPC_TRACE_SAMPLING_MANAGER_PORT"
-	HostToTraceSecretName         = "HOST_TO_TRACE_SECRET_NAME"       //nolint:gosec
-	HostToTraceSecretNamespace    = "HOST_TO_TRACE_SECRET_NAMESPACE"  //nolint:gosec
-	HostToTraceSecretOwnerName    = "HOST_TO_TRACE_SECRET_OWNER_NAME" //nolint:gosec
-	HealthCheckAddress            = "HEALTH_CHECK_ADDRESS"
-	StateBackupIntervalSec        = "STATE_BACKUP_INTERVAL_SEC"
-	DatabaseCleanerIntervalSec    = "DATABASE_CLEANER_INTERVAL_SEC"
-	StateBackupFileName           = "STATE_BACKUP_FILE_NAME"
-	NoMonitorEnvVar               = "NO_K8S_MONITOR"
-	K8sLocalEnvVar                = "K8S_LOCAL"
-	EnableK8s                     = "ENABLE_K8S"
-	EnableTLS                     = "ENABLE_TLS"
-	TLSServerCertFilePath         = "TLS_SERVER_CERT_FILE_PATH"
-	TLSServerKeyFilePath          = "TLS_SERVER_KEY_FILE_PATH"
-	RootCertFilePath              = "ROOT_CERT_FILE_PATH"
+	BackendRestPort            = "BACKEND_REST_PORT"
+	BackendRestTLSPort         = "BACKEND_REST_TLS_PORT"
+	TraceSamplingEnabled       = "TRACE_SAMPLING_ENABLED"
+	HTTPTracesPort             = "HTTP_TRACES_PORT"
+	HTTPTracesTLSPort          = "HTTP_TRACES_TLS_PORT"
+	GRPCTracesPort             = "GRPC_TRACES_PORT"
+	TracingSessionDurationSec  = "TRACING_SESSION_DEFAULT_DURATION_SEC"
+	TracingSessionMaxEvents    = "TRACING_SESSION_DEFAULT_MAX_EVENTS"
+	TracingSessionIntervalSec  = "TRACING_SESSION_CHECK_INTERVAL_SEC"
+	HealthCheckAddress         = "HEALTH_CHECK_ADDRESS"
+	StateBackupIntervalSec     = "STATE_BACKUP_INTERVAL_SEC"
+	DatabaseCleanerIntervalSec = "DATABASE_CLEANER_INTERVAL_SEC"
+	StateBackupFileName        = "STATE_BACKUP_FILE_NAME"
+	NoMonitorEnvVar            = "NO_K8S_MONITOR"
+	K8sLocalEnvVar             = "K8S_LOCAL"
+	EnableK8s                  = "ENABLE_K8S"
+	EnableTLS                  = "ENABLE_TLS"
+	TLSServerCertFilePath      = "TLS_SERVER_CERT_FILE_PATH"
+	TLSServerKeyFilePath       = "TLS_SERVER_KEY_FILE_PATH"
+	RootCertFilePath           = "ROOT_CERT_FILE_PATH"
 
 	ExternalHTTPTracesTLSPort = "EXTERNAL_HTTP_TRACES_TLS_PORT"
+	ExternalGRPCTracesTLSPort = "EXTERNAL_GRPC_TRACES_TLS_PORT"
 
 	DBNameEnvVar     = "DB_NAME"
 	DBUserEnvVar     = "DB_USER"
@@ -62,9 +61,35 @@ const (
 	ResponseHeadersToIgnore = "RESPONSE_HEADERS_TO_IGNORE"
 	RequestHeadersToIgnore  = "REQUEST_HEADERS_TO_IGNORE"
 
+	APIMediaTypes              = "API_MEDIA_TYPES"
+	APIClassificationOverrides = "API_CLASSIFICATION_OVERRIDES"
+
 	ModulesAssetsEnvVar = "MODULES_ASSETS"
 
 	NotificationPrefix = "NOTIFICATION_BACKEND_PREFIX"
+
+	IngestionMaxQueueSize  = "INGESTION_MAX_QUEUE_SIZE"
+	IngestionWorkers       = "INGESTION_WORKERS"
+	IngestionRetryAfterSec = "INGESTION_RETRY_AFTER_SEC"
+
+	OTLPReceiverEnabled = "OTLP_RECEIVER_ENABLED"
+	OTLPGRPCPort        = "OTLP_GRPC_PORT"
+	OTLPHTTPPort        = "OTLP_HTTP_PORT"
+	OTLPPreferHostNames = "OTLP_PREFER_HOSTNAMES"
+
+	ShutdownTimeoutSec = "SHUTDOWN_TIMEOUT_SEC"
+
+	ModulesQueueSize            = "MODULES_QUEUE_SIZE"
+	ModulesWorkers              = "MODULES_WORKERS"
+	ModulesQueuePolicy          = "MODULES_QUEUE_POLICY"
+	ModulesQueuePolicyOverrides = "MODULES_QUEUE_POLICY_OVERRIDES"
+
+	RemoteModules       = "REMOTE_MODULES"
+	RemoteModulesTokens = "REMOTE_MODULES_TOKENS"
+
+	EventTelemetryRetentionHours = "EVENT_TELEMETRY_RETENTION_HOURS"
+
+	RedactionHashKey = "REDACTION_HASH_KEY"
 )
 
 type Config struct {
@@ -72,6 +97,7 @@ type Config struct {
 	BackendRestTLSPort         int
 	HTTPTracesPort             int
 	HTTPTracesTLSPort          int
+	GRPCTracesPort             int
 	HealthCheckAddress         string
 	StateBackupIntervalSec     int
 	DatabaseCleanerIntervalSec int
@@ -86,17 +112,52 @@ type Config struct {
 
 	NotificationPrefix string
 
-	// External HTTP Trace server
+	// External HTTP and gRPC Trace servers
 	ExternalHTTPTracesTLSPort int
+	ExternalGRPCTracesTLSPort int
+
+	// telemetries ingestion config
+	IngestionMaxQueueSize  int
+	IngestionWorkers       int
+	IngestionRetryAfterSec int
+
+	// OTLP receiver config
+	OTLPReceiverEnabled bool
+	OTLPGRPCPort        int
+	OTLPHTTPPort        int
+	OTLPPreferHostNames bool
+
+	// maximum duration of the graceful shutdown
+	ShutdownTimeoutSec int
+
+	// dispatch of the events to the modules, each module has its own queue and workers
+	ModulesQueueSize            int
+	ModulesWorkers              int
+	ModulesQueuePolicy          string
+	ModulesQueuePolicyOverrides []string
+
+	// modules running in other processes, in the format <name>=<url>
+	RemoteModules []string
+	// tokens shared with the remote modules, in the format <name>=<token>
+	RemoteModulesTokens []string
+
+	// the telemetries of the events are retained to be replayed through the modules, 0 to not retain them
+	EventTelemetryRetentionHours int
+
+	// key of the HMAC-SHA256 of the values redacted with the HASH action, generated on start when empty
+	RedactionHashKey string `json:"-"`
+
+	// API classification config
+	APIMediaTypes              []string
+	APIClassificationOverrides []string
 
 	// trace sampling config
-	HTTPTraceSamplingManagerPort  int
-	HTTPSTraceSamplingManagerPort int
-	GRPCTraceSamplingManagerPort  int
-	TraceSamplingEnabled          bool
-	HostToTraceSecretName         string
-	HostToTraceSecretNamespace    string
-	HostToTraceSecretOwnerName    string
+	TraceSamplingEnabled bool
+
+	// default limits of the tracing sessions of the modules, 0 for no limit
+	TracingSessionDefaultDurationSec int
+	TracingSessionDefaultMaxEvents   int64
+	TracingSessionCheckIntervalSec   int
 
 	// database config
 	DatabaseDriver   string
@@ -115,13 +176,11 @@ func LoadConfig() (*Config, error) {
 	config.BackendRestTLSPort = viper.GetInt(BackendRestTLSPort)
 	config.HTTPTracesPort = viper.GetInt(HTTPTracesPort)
 	config.HTTPTracesTLSPort = viper.GetInt(HTTPTracesTLSPort)
-	config.HTTPTraceSamplingManagerPort = viper.GetInt(HTTPTraceSamplingManagerPort)
-	config.HTTPSTraceSamplingManagerPort = viper.GetInt(HTTPSTraceSamplingManagerPort)
-	config.GRPCTraceSamplingManagerPort = viper.GetInt(GRPCTraceSamplingManagerPort)
-	config.HostToTraceSecretName = viper.GetString(HostToTraceSecretName)
-	config.HostToTraceSecretNamespace = viper.GetString(HostToTraceSecretNamespace)
-	config.HostToTraceSecretOwnerName = viper.GetString(HostToTraceSecretOwnerName)
+	config.GRPCTracesPort = viper.GetInt(GRPCTracesPort)
 	config.TraceSamplingEnabled = viper.GetBool(TraceSamplingEnabled)
+	config.TracingSessionDefaultDurationSec = viper.GetInt(TracingSessionDurationSec)
+	config.TracingSessionDefaultMaxEvents = viper.GetInt64(TracingSessionMaxEvents)
+	config.TracingSessionCheckIntervalSec = viper.GetInt(TracingSessionIntervalSec)
 	config.HealthCheckAddress = viper.GetString(HealthCheckAddress)
 	config.StateBackupIntervalSec = viper.GetInt(StateBackupIntervalSec)
 	config.DatabaseCleanerIntervalSec = viper.GetInt(DatabaseCleanerIntervalSec)
@@ -132,6 +191,33 @@ func LoadConfig() (*Config, error) {
 	config.NotificationPrefix = viper.GetString(NotificationPrefix)
 
 	config.ExternalHTTPTracesTLSPort = viper.GetInt(ExternalHTTPTracesTLSPort)
+	config.ExternalGRPCTracesTLSPort = viper.GetInt(ExternalGRPCTracesTLSPort)
+
+	config.IngestionMaxQueueSize = viper.GetInt(IngestionMaxQueueSize)
+	config.IngestionWorkers = viper.GetInt(IngestionWorkers)
+	config.IngestionRetryAfterSec = viper.GetInt(IngestionRetryAfterSec)
+
+	config.OTLPReceiverEnabled = viper.GetBool(OTLPReceiverEnabled)
+	config.OTLPGRPCPort = viper.GetInt(OTLPGRPCPort)
+	config.OTLPHTTPPort = viper.GetInt(OTLPHTTPPort)
+	config.OTLPPreferHostNames = viper.GetBool(OTLPPreferHostNames)
+
+	config.ShutdownTimeoutSec = viper.GetInt(ShutdownTimeoutSec)
+
+	config.ModulesQueueSize = viper.GetInt(ModulesQueueSize)
+	config.ModulesWorkers = viper.GetInt(ModulesWorkers)
+	config.ModulesQueuePolicy = viper.GetString(ModulesQueuePolicy)
+	config.ModulesQueuePolicyOverrides = viper.GetStringSlice(ModulesQueuePolicyOverrides)
+
+	config.RemoteModules = viper.GetStringSlice(RemoteModules)
+	config.RemoteModulesTokens = viper.GetStringSlice(RemoteModulesTokens)
+
+	config.EventTelemetryRetentionHours = viper.GetInt(EventTelemetryRetentionHours)
+
+	config.RedactionHashKey = viper.GetString(RedactionHashKey)
+
+	config.APIMediaTypes = viper.GetStringSlice(APIMediaTypes)
+	config.APIClassificationOverrides = viper.GetStringSlice(APIClassificationOverrides)
 
 	config.K8sLocal = viper.GetBool(K8sLocalEnvVar)
 	config.EnableK8s = viper.GetBool(EnableK8s)
@@ -0,0 +1,51 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// StartAnnotationsReaper periodically deletes the expired API and event annotations.
+func (db *Handler) StartAnnotationsReaper(ctx context.Context, reapInterval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debugf("Stopping annotations reaper")
+				return
+			case <-time.After(reapInterval):
+				db.reapExpiredAnnotations(ctx, time.Now())
+			}
+		}
+	}()
+}
+
+func (db *Handler) reapExpiredAnnotations(ctx context.Context, now time.Time) {
+	if deleted, err := db.APIInfoAnnotationsTable().DeleteExpired(ctx, now); err != nil {
+		log.Errorf("Failed to delete expired API annotations from database. %v", err)
+	} else if deleted > 0 {
+		log.Debugf("Deleted %d expired API annotations", deleted)
+	}
+	if deleted, err := db.APIEventsAnnotationsTable().DeleteExpired(ctx, now); err != nil {
+		log.Errorf("Failed to delete expired event annotations from database. %v", err)
+	} else if deleted > 0 {
+		log.Debugf("Deleted %d expired event annotations", deleted)
+	}
+}
@@ -0,0 +1,73 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gotest.tools/assert"
+)
+
+func TestAnnotationsExpiry(t *testing.T) {
+	ctx := context.Background()
+	db := Init(&DBConfig{
+		DriverType:  DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	apiInfo := &APIInfo{Name: "test.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(apiInfo)
+	apiEvent := &APIEvent{APIInfoID: apiInfo.ID, Path: "/test"}
+	db.APIEventsTable().CreateAPIEvent(apiEvent)
+	past := time.Now().Add(-time.Minute)
+	future := time.Now().Add(time.Hour)
+
+	assert.NilError(t, db.APIInfoAnnotationsTable().UpdateOrCreate(ctx,
+		APIInfoAnnotation{ModuleName: "mod", APIID: apiInfo.ID, Name: "expired", ExpiresAt: &past},
+		APIInfoAnnotation{ModuleName: "mod", APIID: apiInfo.ID, Name: "expiring", ExpiresAt: &future},
+		APIInfoAnnotation{ModuleName: "mod", APIID: apiInfo.ID, Name: "kept"},
+	))
+	assert.NilError(t, db.APIEventsAnnotationsTable().Create(ctx,
+		APIEventAnnotation{ModuleName: "mod", EventID: apiEvent.ID, Name: "expired", ExpiresAt: &past},
+		APIEventAnnotation{ModuleName: "mod", EventID: apiEvent.ID, Name: "kept"},
+	))
+
+	apiAnns, err := db.APIInfoAnnotationsTable().List(ctx, "mod", apiInfo.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(apiAnns), 2)
+	_, err = db.APIInfoAnnotationsTable().Get(ctx, "mod", apiInfo.ID, "expired")
+	assert.Assert(t, errors.Is(err, gorm.ErrRecordNotFound))
+	eventAnns, err := db.APIEventsAnnotationsTable().List(ctx, "mod", apiEvent.ID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(eventAnns), 1)
+	assert.Equal(t, eventAnns[0].Name, "kept")
+
+	// storing an expired annotation again pushes its expiry back
+	assert.NilError(t, db.APIInfoAnnotationsTable().UpdateOrCreate(ctx, APIInfoAnnotation{ModuleName: "mod", APIID: apiInfo.ID, Name: "expired", ExpiresAt: &future}))
+	_, err = db.APIInfoAnnotationsTable().Get(ctx, "mod", apiInfo.ID, "expired")
+	assert.NilError(t, err)
+
+	db.reapExpiredAnnotations(ctx, time.Now().Add(2*time.Hour))
+	var apiAnnsCount, eventAnnsCount int64
+	assert.NilError(t, db.DB.Table(apiEventAnnotationsTableName).Count(&apiAnnsCount).Error)
+	assert.NilError(t, db.DB.Table(eventAnnotationsTableName).Count(&eventAnnsCount).Error)
+	assert.Equal(t, apiAnnsCount, int64(1))
+	assert.Equal(t, eventAnnsCount, int64(1))
+}
@@ -18,6 +18,7 @@ package database
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -28,6 +29,11 @@ const (
 	apiIDColumnName              = "api_id"
 	moduleNameColumnName         = "module_name"
 	annotationColumnName         = "annotation"
+	expiresAtColumnName          = "expires_at"
+
+	// the annotations are expired once their expiry time is reached, the times are compared in UTC
+	expiredCondition    = expiresAtColumnName + " <= ?"
+	notExpiredCondition = "(" + expiresAtColumnName + " IS NULL OR " + expiresAtColumnName + " > ?)"
 )
 
 type APIInfoAnnotation struct {
@@ -36,6 +42,8 @@ type APIInfoAnnotation struct {
 	APIID      uint   `json:"api_id,omitempty" gorm:"column:api_id;uniqueIndex:api_ann_idx_model" faker:"-"`
 	Name       string `json:"name,omitempty" gorm:"column:name;uniqueIndex:api_ann_idx_model" faker:"-"`
 	Annotation []byte `json:"annotation,omitempty" gorm:"column:annotation" faker:"-"`
+	// ExpiresAt is nil when the annotation never expires, an expired annotation is not returned and is eventually deleted
+	ExpiresAt *time.Time `json:"expires_at,omitempty" gorm:"column:expires_at;index" faker:"-"`
 }
 
 type APIAnnotationsTable interface {
@@ -44,6 +52,8 @@ type APIAnnotationsTable interface {
 	List(ctx context.Context, modName string, apiID uint) ([]*APIInfoAnnotation, error)
 	Delete(ctx context.Context, modName string, apiID uint, names ...string) error
 	DeleteAll(ctx context.Context, modName string, apiID uint) error
+	// DeleteExpired deletes the annotations expired at now and returns how many were deleted.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
 }
 
 type APIInfoAnnotationsTableHandler struct {
@@ -66,6 +76,7 @@ func (am *APIInfoAnnotationsTableHandler) Get(ctx context.Context, modName strin
 
 	if err := am.tx.Where(fmt.Sprintf("%s = ? AND %s = ? AND %s = ?",
 		moduleNameColumnName, apiIDColumnName, nameColumnName), modName, apiID, name).
+		Where(notExpiredCondition, time.Now().UTC()).
 		WithContext(ctx).
 		First(&model).
 		Error; err != nil {
@@ -84,7 +95,7 @@ func (am *APIInfoAnnotationsTableHandler) List(ctx context.Context, modName stri
 	} else {
 		t = am.tx.Where(fmt.Sprintf("%s = ? AND %s = ?", moduleNameColumnName, apiIDColumnName), modName, apiID)
 	}
-	if err := t.WithContext(ctx).Find(&annotations).Error; err != nil {
+	if err := t.Where(notExpiredCondition, time.Now().UTC()).WithContext(ctx).Find(&annotations).Error; err != nil {
 		return nil, err
 	}
 
@@ -105,3 +116,10 @@ func (am *APIInfoAnnotationsTableHandler) DeleteAll(ctx context.Context, modName
 		Delete(&APIInfoAnnotation{}).
 		Error
 }
+
+func (am *APIInfoAnnotationsTableHandler) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := am.tx.Where(expiredCondition, now.UTC()).
+		WithContext(ctx).
+		Delete(&APIInfoAnnotation{})
+	return result.RowsAffected, result.Error
+}
@@ -58,12 +58,25 @@ const (
 	apiInfoIDColumnName            = "api_info_id"
 	isNonAPIColumnName             = "is_non_api"
 	eventTypeColumnName            = "event_type"
+	graphQLOperationTypeColumnName = "graphql_operation_type"
+	graphQLOperationNameColumnName = "graphql_operation_name"
+	graphQLRootFieldsColumnName    = "graphql_root_fields"
+	grpcServiceColumnName          = "grpc_service"
+	grpcMethodColumnName           = "grpc_method"
+	grpcStatusColumnName           = "grpc_status"
+	grpcRequestSizeColumnName      = "grpc_request_messages_size"
+	grpcResponseSizeColumnName     = "grpc_response_messages_size"
+	grpcRequestMessagesColumnName  = "grpc_request_messages"
+	grpcResponseMessagesColumnName = "grpc_response_messages"
 )
 
 const alertAnnotation = "ALERT"
 
 var specDiffColumns = []string{newReconstructedSpecColumnName, oldReconstructedSpecColumnName, newProvidedSpecColumnName, oldProvidedSpecColumnName}
 
+// largeColumns are only loaded by the queries that need them.
+var largeColumns = append([]string{grpcRequestMessagesColumnName, grpcResponseMessagesColumnName}, specDiffColumns...)
+
 type APIEvent struct {
 	// will be populated after inserting to DB
 	ID uint `gorm:"primarykey" faker:"-"`
@@ -88,6 +101,25 @@ type APIEvent struct {
 	HostSpecName             string            `json:"hostSpecName,omitempty" gorm:"column:host_spec_name" faker:"oneof: test.com, example.com, kaki.org"`
 	IsNonAPI                 bool              `json:"isNonApi,omitempty" gorm:"column:is_non_api" faker:"-"`
 
+	// GraphQL operation info, empty for non GraphQL events
+	GraphQLOperationType models.GraphQLOperationType `json:"graphqlOperationType,omitempty" gorm:"column:graphql_operation_type" faker:"-"`
+	GraphQLOperationName string                      `json:"graphqlOperationName,omitempty" gorm:"column:graphql_operation_name" faker:"-"`
+	// Root fields separated and surrounded by commas (",a,b,") to filter by field, see GraphQLRootFieldsToColumn
+	GraphQLRootFields string `json:"graphqlRootFields,omitempty" gorm:"column:graphql_root_fields" faker:"-"`
+
+	// gRPC call info, empty for non gRPC events
+	GRPCService               string `json:"grpcService,omitempty" gorm:"column:grpc_service" faker:"-"`
+	GRPCMethod                string `json:"grpcMethod,omitempty" gorm:"column:grpc_method" faker:"-"`
+	GRPCStatus                int32  `json:"grpcStatus,omitempty" gorm:"column:grpc_status" faker:"-"`
+	GRPCStatusMessage         string `json:"grpcStatusMessage,omitempty" gorm:"column:grpc_status_message" faker:"-"`
+	GRPCRequestMessagesCount  int64  `json:"grpcRequestMessagesCount,omitempty" gorm:"column:grpc_request_messages_count" faker:"-"`
+	GRPCRequestMessagesSize   int64  `json:"grpcRequestMessagesSize,omitempty" gorm:"column:grpc_request_messages_size" faker:"-"`
+	GRPCResponseMessagesCount int64  `json:"grpcResponseMessagesCount,omitempty" gorm:"column:grpc_response_messages_count" faker:"-"`
+	GRPCResponseMessagesSize  int64  `json:"grpcResponseMessagesSize,omitempty" gorm:"column:grpc_response_messages_size" faker:"-"`
+	// Messages decoded to JSON arrays, set only if the API has a descriptor set
+	GRPCRequestMessages  string `json:"grpcRequestMessages,omitempty" gorm:"column:grpc_request_messages" faker:"-"`
+	GRPCResponseMessages string `json:"grpcResponseMessages,omitempty" gorm:"column:grpc_response_messages" faker:"-"`
+
 	// Spec diff info
 	// New reconstructed spec json string
 	NewReconstructedSpec string `json:"newReconstructedSpec,omitempty" gorm:"column:new_reconstructed_spec" faker:"-"`
@@ -121,6 +153,9 @@ type APIEventsTable interface {
 	CreateAPIEvent(event *APIEvent)
 	UpdateAPIEvent(event *APIEvent) error
 	GroupByAPIInfo() ([]HostGroup, error)
+	GetGraphQLOperationsUsage(apiID uint32, startTime, endTime time.Time) ([]*models.GraphQLOperationUsage, error)
+	GetAPIEventGRPCMessages(eventID uint32) (*APIEvent, error)
+	GetGRPCMethodsUsage(apiID uint32, startTime, endTime time.Time) ([]*models.GrpcMethodUsage, error)
 }
 
 func (a *APIEventsTableHandler) UpdateAPIEvent(event *APIEvent) error {
@@ -200,6 +235,13 @@ type APIEventsFilters struct {
 	StatusCodeIs          []string
 	StatusCodeLte         *string
 	APIInfoIDIs           *uint32
+
+	GraphQLOperationTypeIs []string
+	GraphQLOperationNameIs []string
+	GraphQLRootFieldIs     []string
+
+	GRPCServiceIs []string
+	GRPCMethodIs  []string
 }
 
 const dashboardTopAPIsNum = 5
@@ -264,7 +306,7 @@ func (a *APIEventsTableHandler) GetAPIEventsWithAnnotations(ctx context.Context,
 		}
 	}
 
-	tx = tx.Preload("Annotations")
+	tx = tx.Preload("Annotations", notExpiredCondition, time.Now().UTC())
 
 	sortDir := "DESC"
 	if query.AscSortDir {
@@ -347,6 +389,25 @@ func APIEventFromDB(event *APIEvent) *models.APIEvent {
 		RequestTime:              event.RequestTime,
 		Alerts:                   []*models.ModuleAlert{},
 	}
+	if event.GraphQLOperationType != "" {
+		e.GraphqlOperation = &models.GraphQLOperation{
+			OperationName: event.GraphQLOperationName,
+			OperationType: event.GraphQLOperationType,
+			RootFields:    GraphQLRootFieldsFromColumn(event.GraphQLRootFields),
+		}
+	}
+	if event.GRPCService != "" {
+		e.GrpcCall = &models.GrpcCall{
+			Service:               event.GRPCService,
+			Method:                event.GRPCMethod,
+			Status:                event.GRPCStatus,
+			StatusMessage:         event.GRPCStatusMessage,
+			RequestMessagesCount:  event.GRPCRequestMessagesCount,
+			RequestMessagesSize:   event.GRPCRequestMessagesSize,
+			ResponseMessagesCount: event.GRPCResponseMessagesCount,
+			ResponseMessagesSize:  event.GRPCResponseMessagesSize,
+		}
+	}
 	for _, ann := range event.Annotations {
 		e.Alerts = append(e.Alerts, &models.ModuleAlert{
 			Alert:      models.AlertSeverityEnum(ann.Name),
@@ -404,7 +465,7 @@ func (a *APIEventsTableHandler) GetAPIEventsAndTotal(params operations.GetAPIEve
 
 	// get specific page ordered items with the current filters
 	if err := tx.Order(sortOrder).
-		Omit(specDiffColumns...).
+		Omit(largeColumns...).
 		Find(&apiEvents).Error; err != nil {
 		return nil, 0, err
 	}
@@ -417,7 +478,7 @@ func (a *APIEventsTableHandler) GetAPIEvent(eventID uint32) (*APIEvent, error) {
 
 	tx := a.tx
 	tx = tx.Preload("Annotations", fmt.Sprintf("%s = ?", nameColumnName), alertAnnotation)
-	if err := tx.Omit(specDiffColumns...).First(&apiEvent, eventID).Error; err != nil {
+	if err := tx.Omit(largeColumns...).First(&apiEvent, eventID).Error; err != nil {
 		return nil, err
 	}
 
@@ -509,6 +570,15 @@ func (a *APIEventsTableHandler) setAPIEventsFilters(filters *APIEventsFilters) *
 	// API Id filter
 	tx = FilterIsUint32(tx, apiInfoIDColumnName, filters.APIInfoIDIs)
 
+	// GraphQL filters
+	tx = FilterIs(tx, graphQLOperationTypeColumnName, filters.GraphQLOperationTypeIs)
+	tx = FilterIs(tx, graphQLOperationNameColumnName, filters.GraphQLOperationNameIs)
+	tx = filterGraphQLRootFieldIs(tx, filters.GraphQLRootFieldIs)
+
+	// gRPC filters
+	tx = FilterIs(tx, grpcServiceColumnName, filters.GRPCServiceIs)
+	tx = FilterIs(tx, grpcMethodColumnName, filters.GRPCMethodIs)
+
 	// ignore non APIs
 	if !filters.ShowNonAPI {
 		tx.Where(fmt.Sprintf("%s = ?", isNonAPIColumnName), false)
@@ -603,6 +673,13 @@ func getAPIEventsParamsToFilters(params operations.GetAPIEventsParams) *APIEvent
 		StatusCodeIs:         params.StatusCodeIs,
 		StatusCodeLte:        params.StatusCodeLte,
 		APIInfoIDIs:          params.APIInfoIDIs,
+
+		GraphQLOperationTypeIs: params.GraphqlOperationTypeIs,
+		GraphQLOperationNameIs: params.GraphqlOperationNameIs,
+		GraphQLRootFieldIs:     params.GraphqlRootFieldIs,
+
+		GRPCServiceIs: params.GrpcServiceIs,
+		GRPCMethodIs:  params.GrpcMethodIs,
 	}
 }
 
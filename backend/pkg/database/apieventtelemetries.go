@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+const (
+	apiEventTelemetriesTableName = "api_event_telemetries"
+	telemetryTimeColumnName      = "time"
+)
+
+// APIEventTelemetry is the original telemetry of an API event, retained so that the event can be replayed through the modules.
+type APIEventTelemetry struct {
+	EventID   uint      `json:"event_id" gorm:"column:event_id;primaryKey" faker:"-"`
+	APIInfoID uint      `json:"api_info_id" gorm:"column:api_info_id;index:api_event_telemetries_idx" faker:"-"`
+	Time      time.Time `json:"time" gorm:"column:time;index:api_event_telemetries_idx" faker:"-"`
+	Telemetry []byte    `json:"telemetry" gorm:"column:telemetry" faker:"-"`
+}
+
+type APIEventTelemetriesTable interface {
+	Create(ctx context.Context, telemetry *APIEventTelemetry) error
+	// Count returns the number of telemetries of the API in [startTime, endTime].
+	Count(ctx context.Context, apiID uint, startTime, endTime time.Time) (int64, error)
+	// List returns, ordered by event ID, up to limit telemetries of the API in [startTime, endTime] with an event ID greater than afterEventID.
+	List(ctx context.Context, apiID uint, startTime, endTime time.Time, afterEventID uint, limit int) ([]*APIEventTelemetry, error)
+	// DeleteOlderThan deletes the telemetries of the events older than t and returns how many were deleted.
+	DeleteOlderThan(ctx context.Context, t time.Time) (int64, error)
+}
+
+type APIEventTelemetriesTableHandler struct {
+	tx *gorm.DB
+}
+
+func (APIEventTelemetry) TableName() string {
+	return apiEventTelemetriesTableName
+}
+
+func (h *APIEventTelemetriesTableHandler) Create(ctx context.Context, telemetry *APIEventTelemetry) error {
+	telemetry.Time = telemetry.Time.UTC()
+	return h.tx.WithContext(ctx).Create(telemetry).Error
+}
+
+func (h *APIEventTelemetriesTableHandler) Count(ctx context.Context, apiID uint, startTime, endTime time.Time) (int64, error) {
+	var count int64
+	err := h.inRange(ctx, apiID, startTime, endTime).Count(&count).Error
+	return count, err
+}
+
+func (h *APIEventTelemetriesTableHandler) List(ctx context.Context, apiID uint, startTime, endTime time.Time, afterEventID uint, limit int) ([]*APIEventTelemetry, error) {
+	var telemetries []*APIEventTelemetry
+	err := h.inRange(ctx, apiID, startTime, endTime).
+		Where(eventIDColumnName+" > ?", afterEventID).
+		Order(eventIDColumnName + " ASC").
+		Limit(limit).
+		Find(&telemetries).Error
+	return telemetries, err
+}
+
+func (h *APIEventTelemetriesTableHandler) DeleteOlderThan(ctx context.Context, t time.Time) (int64, error) {
+	ret := h.tx.WithContext(ctx).
+		Where(telemetryTimeColumnName+" < ?", t.UTC()).
+		Delete(&APIEventTelemetry{})
+	return ret.RowsAffected, ret.Error
+}
+
+func (h *APIEventTelemetriesTableHandler) inRange(ctx context.Context, apiID uint, startTime, endTime time.Time) *gorm.DB {
+	return h.tx.WithContext(ctx).
+		Where(apiInfoIDColumnName+" = ?", apiID).
+		Where(telemetryTimeColumnName+" BETWEEN ? AND ?", startTime.UTC(), endTime.UTC())
+}
+
+// StartEventTelemetriesCleaner periodically deletes the telemetries of the events older than the retention.
+func (db *Handler) StartEventTelemetriesCleaner(ctx context.Context, cleanInterval time.Duration, retention time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debugf("Stopping event telemetries cleaner")
+				return
+			case <-time.After(cleanInterval):
+				deleted, err := db.APIEventTelemetriesTable().DeleteOlderThan(ctx, time.Now().Add(-retention))
+				if err != nil {
+					log.Errorf("Failed to delete event telemetries from database. %v", err)
+				} else if deleted > 0 {
+					log.Debugf("Deleted %d event telemetries", deleted)
+				}
+			}
+		}
+	}()
+}
@@ -0,0 +1,322 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+)
+
+const (
+	apiFindingsTableName                   = "api_findings"
+	severityRankColumnName                 = "severity_rank"
+	severityColumnName                     = "severity"
+	providedSpecLocationColumnName         = "provided_spec_location"
+	reconstructedSpecLocationColumnName    = "reconstructed_spec_location"
+	firstSeenColumnName                    = "first_seen"
+	lastSeenColumnName                     = "last_seen"
+	apiFindingAPINameColumnName            = "api_name"
+	apiFindingsSortKeyTiebreakerColumnName = apiFindingsTableName + "." + idColumnName
+)
+
+// APIFinding is a finding of a module on an API, as last notified by the module.
+type APIFinding struct {
+	ID         uint   `json:"id" gorm:"primarykey" faker:"-"`
+	APIInfoID  uint   `json:"api_info_id" gorm:"column:api_info_id;index:api_findings_idx" faker:"-"`
+	ModuleName string `json:"module_name" gorm:"column:module_name;index:api_findings_idx" faker:"-"`
+	// APIName is only read, from the API of the finding
+	APIName                   string `json:"api_name,omitempty" gorm:"->;column:api_name;-:migration" faker:"-"`
+	Source                    string `json:"source" gorm:"column:source" faker:"-"`
+	Type                      string `json:"type" gorm:"column:type" faker:"-"`
+	Name                      string `json:"name" gorm:"column:name" faker:"-"`
+	Description               string `json:"description" gorm:"column:description" faker:"-"`
+	Severity                  string `json:"severity" gorm:"column:severity" faker:"-"`
+	SeverityRank              int    `json:"severity_rank" gorm:"column:severity_rank" faker:"-"`
+	ProvidedSpecLocation      string `json:"provided_spec_location" gorm:"column:provided_spec_location" faker:"-"`
+	ReconstructedSpecLocation string `json:"reconstructed_spec_location" gorm:"column:reconstructed_spec_location" faker:"-"`
+	// AdditionalInfo is the opaque JSON object of the module
+	AdditionalInfo []byte    `json:"additional_info" gorm:"column:additional_info" faker:"-"`
+	FirstSeen      time.Time `json:"first_seen" gorm:"column:first_seen" faker:"-"`
+	LastSeen       time.Time `json:"last_seen" gorm:"column:last_seen;index" faker:"-"`
+
+	APIInfo APIInfo `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+// APIFindingsSeverityCount is the number of findings of an API with a severity.
+type APIFindingsSeverityCount struct {
+	APIInfoID uint   `gorm:"column:api_info_id"`
+	Severity  string `gorm:"column:severity"`
+	Count     int64  `gorm:"column:count"`
+}
+
+type APIFindingsTable interface {
+	// Set replaces the findings of a module on an API, the findings which were already set keep their first seen time.
+	Set(ctx context.Context, moduleName string, apiID uint, findings []*APIFinding, now time.Time) error
+	// Import replaces the findings of a module on an API like Set, but the findings which were already set keep both
+	// their first and last seen times: they are not seen again.
+	Import(ctx context.Context, moduleName string, apiID uint, findings []*APIFinding, now time.Time) error
+	GetAPIFindingsAndTotal(params operations.GetAPIFindingsParams) ([]APIFinding, int64, error)
+	// CountBySeverity returns the number of findings of the APIs by severity.
+	CountBySeverity(apiIDs []uint) ([]APIFindingsSeverityCount, error)
+}
+
+type APIFindingsTableHandler struct {
+	tx *gorm.DB
+}
+
+func (APIFinding) TableName() string {
+	return apiFindingsTableName
+}
+
+// SeverityRank orders the severities of the findings, from the least to the most severe.
+func SeverityRank(severity string) int {
+	switch models.FindingSeverity(severity) {
+	case models.FindingSeverityINFO:
+		return 1
+	case models.FindingSeverityLOW:
+		return 2
+	case models.FindingSeverityMEDIUM:
+		return 3
+	case models.FindingSeverityHIGH:
+		return 4
+	case models.FindingSeverityCRITICAL:
+		return 5
+	}
+	return 0
+}
+
+func APIFindingFromDB(finding *APIFinding) *models.APIFinding {
+	apiInfoID := uint32(finding.APIInfoID)
+	id := uint32(finding.ID)
+	severity := models.FindingSeverity(finding.Severity)
+	firstSeen := strfmt.DateTime(finding.FirstSeen)
+	lastSeen := strfmt.DateTime(finding.LastSeen)
+	ret := &models.APIFinding{
+		ID:                        &id,
+		APIInfoID:                 &apiInfoID,
+		APIName:                   finding.APIName,
+		ModuleName:                &finding.ModuleName,
+		Source:                    finding.Source,
+		Type:                      &finding.Type,
+		Name:                      &finding.Name,
+		Description:               finding.Description,
+		Severity:                  &severity,
+		ProvidedSpecLocation:      finding.ProvidedSpecLocation,
+		ReconstructedSpecLocation: finding.ReconstructedSpecLocation,
+		FirstSeen:                 &firstSeen,
+		LastSeen:                  &lastSeen,
+	}
+	if len(finding.AdditionalInfo) > 0 {
+		var additionalInfo map[string]interface{}
+		if err := json.Unmarshal(finding.AdditionalInfo, &additionalInfo); err != nil {
+			log.Errorf("Failed to unmarshal additional info of finding %v: %v", finding.ID, err)
+		} else {
+			ret.AdditionalInfo = additionalInfo
+		}
+	}
+	return ret
+}
+
+// APIFindingsSummariesFromDB sums up the findings counts of each API by severity.
+func APIFindingsSummariesFromDB(counts []APIFindingsSeverityCount) map[uint]*models.APIFindingsSummary {
+	summaries := map[uint]*models.APIFindingsSummary{}
+	for _, count := range counts {
+		summary, ok := summaries[count.APIInfoID]
+		if !ok {
+			summary = &models.APIFindingsSummary{}
+			summaries[count.APIInfoID] = summary
+		}
+		switch models.FindingSeverity(count.Severity) {
+		case models.FindingSeverityINFO:
+			summary.Info += count.Count
+		case models.FindingSeverityLOW:
+			summary.Low += count.Count
+		case models.FindingSeverityMEDIUM:
+			summary.Medium += count.Count
+		case models.FindingSeverityHIGH:
+			summary.High += count.Count
+		case models.FindingSeverityCRITICAL:
+			summary.Critical += count.Count
+		}
+		summary.Total += count.Count
+	}
+	return summaries
+}
+
+// findingKey identifies a finding among the findings of a module on an API.
+type findingKey struct {
+	findingType, name, providedSpecLocation, reconstructedSpecLocation string
+}
+
+func (f *APIFinding) key() findingKey {
+	return findingKey{f.Type, f.Name, f.ProvidedSpecLocation, f.ReconstructedSpecLocation}
+}
+
+func (h *APIFindingsTableHandler) Set(ctx context.Context, moduleName string, apiID uint, findings []*APIFinding, now time.Time) error {
+	return h.set(ctx, moduleName, apiID, findings, now, false)
+}
+
+func (h *APIFindingsTableHandler) Import(ctx context.Context, moduleName string, apiID uint, findings []*APIFinding, now time.Time) error {
+	return h.set(ctx, moduleName, apiID, findings, now, true)
+}
+
+func (h *APIFindingsTableHandler) set(ctx context.Context, moduleName string, apiID uint, findings []*APIFinding, now time.Time, keepLastSeen bool) error {
+	now = now.UTC()
+	return h.tx.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var current []*APIFinding
+		if err := tx.Where(apiInfoIDColumnName+" = ? AND "+moduleNameColumnName+" = ?", apiID, moduleName).Find(&current).Error; err != nil {
+			return fmt.Errorf("failed to get findings: %v", err)
+		}
+		previous := map[findingKey][]*APIFinding{}
+		for _, f := range current {
+			previous[f.key()] = append(previous[f.key()], f)
+		}
+
+		if len(current) > 0 {
+			if err := tx.Delete(&current).Error; err != nil {
+				return fmt.Errorf("failed to delete findings: %v", err)
+			}
+		}
+		if len(findings) == 0 {
+			return nil
+		}
+
+		for _, f := range findings {
+			f.ID = 0
+			f.APIInfoID = apiID
+			f.ModuleName = moduleName
+			f.SeverityRank = SeverityRank(f.Severity)
+			f.FirstSeen, f.LastSeen = now, now
+			if same := previous[f.key()]; len(same) > 0 {
+				f.FirstSeen = same[0].FirstSeen
+				if keepLastSeen {
+					f.LastSeen = same[0].LastSeen
+				}
+				previous[f.key()] = same[1:]
+			}
+		}
+		if err := tx.Create(findings).Error; err != nil {
+			return fmt.Errorf("failed to create findings: %v", err)
+		}
+		return nil
+	})
+}
+
+func (h *APIFindingsTableHandler) GetAPIFindingsAndTotal(params operations.GetAPIFindingsParams) ([]APIFinding, int64, error) {
+	var findings []APIFinding
+	var count int64
+
+	tx := h.setAPIFindingsFilters(params)
+	// get total count item with the current filters
+	if err := tx.Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+
+	sortOrder, err := createAPIFindingsSortOrder(params.SortKey, params.SortDir)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to create sort order: %v", err)
+	}
+
+	// get specific page ordered items with the current filters
+	if err := tx.Select(FieldInTable(apiFindingsTableName, "*") + ", " + FieldInTable(apiInventoryTableName, nameColumnName) + " AS " + apiFindingAPINameColumnName).
+		Joins(fmt.Sprintf("LEFT JOIN %s ON %s = %s", apiInventoryTableName,
+			FieldInTable(apiInventoryTableName, idColumnName), FieldInTable(apiFindingsTableName, apiInfoIDColumnName))).
+		Scopes(Paginate(params.Page, params.PageSize)).
+		Order(sortOrder).
+		Order(apiFindingsSortKeyTiebreakerColumnName).
+		Find(&findings).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return findings, count, nil
+}
+
+func (h *APIFindingsTableHandler) setAPIFindingsFilters(params operations.GetAPIFindingsParams) *gorm.DB {
+	table := h.tx
+
+	table = FilterIsUint32(table, FieldInTable(apiFindingsTableName, apiInfoIDColumnName), params.APIInfoIDIs)
+	table = FilterIs(table, FieldInTable(apiFindingsTableName, severityColumnName), params.SeverityIs)
+	table = FilterIs(table, FieldInTable(apiFindingsTableName, moduleNameColumnName), params.ModuleNameIs)
+	table = FilterIs(table, FieldInTable(apiFindingsTableName, typeColumnName), params.TypeIs)
+
+	// the spec location filters match the location in either spec
+	providedSpecLocation := FieldInTable(apiFindingsTableName, providedSpecLocationColumnName)
+	reconstructedSpecLocation := FieldInTable(apiFindingsTableName, reconstructedSpecLocationColumnName)
+	if len(params.SpecLocationIs) > 0 {
+		table = table.Where(fmt.Sprintf("(%s IN ? OR %s IN ?)", providedSpecLocation, reconstructedSpecLocation),
+			params.SpecLocationIs, params.SpecLocationIs)
+	}
+	if params.SpecLocationStart != nil {
+		prefix := escapeLike(*params.SpecLocationStart) + "%"
+		table = table.Where(fmt.Sprintf("(%s LIKE ?%s OR %s LIKE ?%s)", providedSpecLocation, likeEscapeClause, reconstructedSpecLocation, likeEscapeClause), prefix, prefix)
+	}
+
+	// the findings seen in the time range are the ones last seen after its start and first seen before its end
+	if params.StartTime != nil {
+		table = table.Where(FieldInTable(apiFindingsTableName, lastSeenColumnName)+" >= ?", time.Time(*params.StartTime).UTC())
+	}
+	if params.EndTime != nil {
+		table = table.Where(FieldInTable(apiFindingsTableName, firstSeenColumnName)+" <= ?", time.Time(*params.EndTime).UTC())
+	}
+
+	return table
+}
+
+func createAPIFindingsSortOrder(sortKey string, sortDir *string) (string, error) {
+	var column string
+	switch models.APIFindingSortKey(sortKey) {
+	case models.APIFindingSortKeySeverity:
+		column = severityRankColumnName
+	case models.APIFindingSortKeyFirstSeen:
+		column = firstSeenColumnName
+	case models.APIFindingSortKeyLastSeen:
+		column = lastSeenColumnName
+	case models.APIFindingSortKeyType:
+		column = typeColumnName
+	case models.APIFindingSortKeyModuleName:
+		column = moduleNameColumnName
+	case models.APIFindingSortKeyAPIInfoID:
+		column = apiInfoIDColumnName
+	default:
+		return "", fmt.Errorf("unknown sort key (%v)", sortKey)
+	}
+	dir := "asc"
+	if sortDir != nil && *sortDir == "DESC" {
+		dir = "desc"
+	}
+	return fmt.Sprintf("%v %v", FieldInTable(apiFindingsTableName, column), dir), nil
+}
+
+func (h *APIFindingsTableHandler) CountBySeverity(apiIDs []uint) ([]APIFindingsSeverityCount, error) {
+	var counts []APIFindingsSeverityCount
+	if len(apiIDs) == 0 {
+		return counts, nil
+	}
+	err := h.tx.Select(apiInfoIDColumnName+", "+severityColumnName+", COUNT(*) AS count").
+		Where(apiInfoIDColumnName+" IN ?", apiIDs).
+		Group(apiInfoIDColumnName + ", " + severityColumnName).
+		Find(&counts).Error
+	return counts, err
+}
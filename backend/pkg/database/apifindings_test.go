@@ -0,0 +1,164 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+)
+
+func TestAPIFindings(t *testing.T) {
+	ctx := context.Background()
+	db := Init(&DBConfig{
+		DriverType:  DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api1 := &APIInfo{Name: "one.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(api1)
+	api2 := &APIInfo{Name: "two.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(api2)
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+
+	assert.NilError(t, db.APIFindingsTable().Set(ctx, "traceanalyzer", api1.ID, []*APIFinding{
+		{Type: "WEAK_JWT", Name: "weak", Severity: "HIGH", ProvidedSpecLocation: "/paths/~1login/get", AdditionalInfo: []byte(`{"key":"value"}`)},
+		{Type: "GUESSABLE_ID", Name: "guessable", Severity: "LOW", ReconstructedSpecLocation: "/paths/~1users~1{id}/get"},
+	}, t0))
+	assert.NilError(t, db.APIFindingsTable().Set(ctx, "bfla", api2.ID, []*APIFinding{
+		{Type: "BFLA", Name: "bfla", Severity: "CRITICAL", ProvidedSpecLocation: "/paths/~1admin/delete"},
+	}, t0))
+
+	// the findings set again keep their first seen time, the missing ones are removed
+	assert.NilError(t, db.APIFindingsTable().Set(ctx, "traceanalyzer", api1.ID, []*APIFinding{
+		{Type: "WEAK_JWT", Name: "weak", Severity: "HIGH", ProvidedSpecLocation: "/paths/~1login/get", AdditionalInfo: []byte(`{"key":"value"}`)},
+		{Type: "SENSITIVE", Name: "sensitive", Severity: "MEDIUM"},
+	}, t1))
+
+	get := func(params operations.GetAPIFindingsParams) ([]string, int64) {
+		if params.Page == 0 {
+			params.Page, params.PageSize = 1, 50
+		}
+		if params.SortKey == "" {
+			params.SortKey = string(models.APIFindingSortKeySeverity)
+		}
+		findings, total, err := db.APIFindingsTable().GetAPIFindingsAndTotal(params)
+		assert.NilError(t, err)
+		names := []string{}
+		for _, f := range findings {
+			names = append(names, f.Name)
+		}
+		return names, total
+	}
+	desc := "DESC"
+	apiID := uint32(api1.ID)
+	start := strfmt.DateTime(t0.Add(time.Minute))
+	location := "/paths/~1"
+
+	names, total := get(operations.GetAPIFindingsParams{})
+	assert.DeepEqual(t, names, []string{"sensitive", "weak", "bfla"})
+	assert.Equal(t, total, int64(3))
+	names, _ = get(operations.GetAPIFindingsParams{SortDir: &desc})
+	assert.DeepEqual(t, names, []string{"bfla", "weak", "sensitive"})
+	names, total = get(operations.GetAPIFindingsParams{Page: 2, PageSize: 2, SortDir: &desc})
+	assert.DeepEqual(t, names, []string{"sensitive"})
+	assert.Equal(t, total, int64(3))
+	names, _ = get(operations.GetAPIFindingsParams{APIInfoIDIs: &apiID})
+	assert.DeepEqual(t, names, []string{"sensitive", "weak"})
+	names, _ = get(operations.GetAPIFindingsParams{SeverityIs: []string{"HIGH", "CRITICAL"}})
+	assert.DeepEqual(t, names, []string{"weak", "bfla"})
+	names, _ = get(operations.GetAPIFindingsParams{ModuleNameIs: []string{"bfla"}})
+	assert.DeepEqual(t, names, []string{"bfla"})
+	names, _ = get(operations.GetAPIFindingsParams{TypeIs: []string{"SENSITIVE"}})
+	assert.DeepEqual(t, names, []string{"sensitive"})
+	names, _ = get(operations.GetAPIFindingsParams{SpecLocationIs: []string{"/paths/~1admin/delete"}})
+	assert.DeepEqual(t, names, []string{"bfla"})
+	names, _ = get(operations.GetAPIFindingsParams{SpecLocationStart: &location})
+	assert.DeepEqual(t, names, []string{"weak", "bfla"})
+	names, _ = get(operations.GetAPIFindingsParams{StartTime: &start})
+	assert.DeepEqual(t, names, []string{"sensitive", "weak"})
+	names, _ = get(operations.GetAPIFindingsParams{EndTime: &start, SortKey: string(models.APIFindingSortKeyModuleName)})
+	assert.DeepEqual(t, names, []string{"bfla", "weak"})
+
+	findings, _, err := db.APIFindingsTable().GetAPIFindingsAndTotal(operations.GetAPIFindingsParams{Page: 1, PageSize: 1, SortKey: string(models.APIFindingSortKeySeverity), SortDir: &desc, APIInfoIDIs: &apiID})
+	assert.NilError(t, err)
+	finding := APIFindingFromDB(&findings[0])
+	assert.Equal(t, finding.APIName, "one.com")
+	assert.Equal(t, *finding.ModuleName, "traceanalyzer")
+	assert.Equal(t, time.Time(*finding.FirstSeen).UTC(), t0)
+	assert.Equal(t, time.Time(*finding.LastSeen).UTC(), t1)
+	assert.DeepEqual(t, finding.AdditionalInfo, map[string]interface{}{"key": "value"})
+
+	counts, err := db.APIFindingsTable().CountBySeverity([]uint{api1.ID, api2.ID})
+	assert.NilError(t, err)
+	summaries := APIFindingsSummariesFromDB(counts)
+	assert.DeepEqual(t, summaries[api1.ID], &models.APIFindingsSummary{Medium: 1, High: 1, Total: 2})
+	assert.DeepEqual(t, summaries[api2.ID], &models.APIFindingsSummary{Critical: 1, Total: 1})
+
+	// a module notifying no findings removes its findings
+	assert.NilError(t, db.APIFindingsTable().Set(ctx, "bfla", api2.ID, nil, t1))
+	_, total = get(operations.GetAPIFindingsParams{})
+	assert.Equal(t, total, int64(2))
+}
+
+func TestAPIFindings_Import(t *testing.T) {
+	ctx := context.Background()
+	db := Init(&DBConfig{
+		DriverType:  DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &APIInfo{Name: "one.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(api)
+	t0 := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Hour)
+
+	assert.NilError(t, db.APIFindingsTable().Set(ctx, "traceanalyzer", api.ID, []*APIFinding{
+		{Type: "WEAK_JWT", Name: "weak", Severity: "HIGH", ProvidedSpecLocation: "/paths/~1user_profile/get"},
+		{Type: "GUESSABLE_ID", Name: "guessable", Severity: "LOW"},
+	}, t0))
+	// the imported findings which were already set keep their times, the new ones are seen at the import time
+	assert.NilError(t, db.APIFindingsTable().Import(ctx, "traceanalyzer", api.ID, []*APIFinding{
+		{Type: "WEAK_JWT", Name: "weak", Severity: "HIGH", ProvidedSpecLocation: "/paths/~1user_profile/get"},
+		{Type: "SENSITIVE", Name: "sensitive", Severity: "MEDIUM", ProvidedSpecLocation: "/paths/~1userXprofile/get"},
+	}, t1))
+
+	get := func(params operations.GetAPIFindingsParams) []string {
+		params.Page, params.PageSize, params.SortKey = 1, 50, string(models.APIFindingSortKeySeverity)
+		findings, _, err := db.APIFindingsTable().GetAPIFindingsAndTotal(params)
+		assert.NilError(t, err)
+		ret := []string{}
+		for _, f := range findings {
+			ret = append(ret, f.Name+" "+f.FirstSeen.UTC().Format(time.Kitchen)+" "+f.LastSeen.UTC().Format(time.Kitchen))
+		}
+		return ret
+	}
+	assert.DeepEqual(t, get(operations.GetAPIFindingsParams{}), []string{"sensitive 1:00AM 1:00AM", "weak 12:00AM 12:00AM"})
+
+	// the wildcards of the spec location prefix are matched literally
+	location := "/paths/~1user_"
+	assert.DeepEqual(t, get(operations.GetAPIFindingsParams{SpecLocationStart: &location}), []string{"weak 12:00AM 12:00AM"})
+
+	// the findings of a deleted API are deleted
+	assert.NilError(t, db.DB.Delete(&APIInfo{}, api.ID).Error)
+	assert.DeepEqual(t, get(operations.GetAPIFindingsParams{}), []string{})
+}
@@ -16,6 +16,7 @@
 package database
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/go-openapi/strfmt"
@@ -43,6 +44,7 @@ const (
 	providedSpecInfoColumnName           = "provided_spec_info"
 	providedSpecCreatedAtColumnName      = "provided_spec_created_at"
 	reconstructedSpecCreatedAtColumnName = "reconstructed_spec_created_at"
+	classificationOverrideColumnName     = "classification_override"
 )
 
 type APIInfo struct {
@@ -62,6 +64,8 @@ type APIInfo struct {
 	DestinationNamespace       string          `json:"destinationNamespace,omitempty" gorm:"column:destination_namespace;uniqueIndex:api_info_idx_model" faker:"-"`
 	ProvidedSpecCreatedAt      strfmt.DateTime `json:"providedSpecCreatedAt,omitempty" gorm:"column:provided_spec_created_at" faker:"-"`
 	ReconstructedSpecCreatedAt strfmt.DateTime `json:"reconstructedSpecCreatedAt,omitempty" gorm:"column:reconstructed_spec_created_at" faker:"-"`
+	// ClassificationOverride forces the classification of the traffic of the API, empty when classified by media type
+	ClassificationOverride models.APIClassification `json:"classificationOverride,omitempty" gorm:"column:classification_override" faker:"-"`
 
 	TraceSource TraceSource          `gorm:"constraint:OnDelete:CASCADE"`
 	Annotations []*APIInfoAnnotation `gorm:"foreignKey:APIID;references:ID;constraint:OnDelete:CASCADE"`
@@ -79,6 +83,11 @@ type APIInventoryTable interface {
 	First(dest *APIInfo, conds ...interface{}) error
 	FirstOrCreate(apiInfo *APIInfo) (created bool, err error)
 	CreateAPIInfo(event *APIInfo)
+	// GetAPIIDs returns the IDs of all the APIs.
+	GetAPIIDs(ctx context.Context) ([]uint, error)
+	// SetClassificationOverride forces the classification of the traffic of an API, an empty classification unsets it.
+	// gorm.ErrRecordNotFound is returned if the API doesn't exist.
+	SetClassificationOverride(apiID uint32, classification models.APIClassification) error
 }
 
 type APIInventoryTableHandler struct {
@@ -91,15 +100,16 @@ func (APIInfo) TableName() string {
 
 func APIInfoFromDB(apiInfo *APIInfo) *models.APIInfo {
 	return &models.APIInfo{
-		HasProvidedSpec:      &apiInfo.HasProvidedSpec,
-		HasReconstructedSpec: &apiInfo.HasReconstructedSpec,
-		ID:                   uint32(apiInfo.ID),
-		Name:                 apiInfo.Name,
-		Port:                 apiInfo.Port,
-		DestinationNamespace: apiInfo.DestinationNamespace,
-		TraceSourceID:        strfmt.UUID(apiInfo.TraceSource.UID.String()),
-		TraceSourceName:      apiInfo.TraceSource.Name,
-		TraceSourceType:      apiInfo.TraceSource.Type,
+		HasProvidedSpec:        &apiInfo.HasProvidedSpec,
+		HasReconstructedSpec:   &apiInfo.HasReconstructedSpec,
+		ID:                     uint32(apiInfo.ID),
+		Name:                   apiInfo.Name,
+		Port:                   apiInfo.Port,
+		DestinationNamespace:   apiInfo.DestinationNamespace,
+		TraceSourceID:          strfmt.UUID(apiInfo.TraceSource.UID.String()),
+		TraceSourceName:        apiInfo.TraceSource.Name,
+		TraceSourceType:        apiInfo.TraceSource.Type,
+		ClassificationOverride: apiInfo.ClassificationOverride,
 	}
 }
 
@@ -188,6 +198,25 @@ func (a *APIInventoryTableHandler) First(dest *APIInfo, conds ...interface{}) er
 	return a.tx.Preload("TraceSource").First(dest, conds).Error
 }
 
+func (a *APIInventoryTableHandler) GetAPIIDs(ctx context.Context) ([]uint, error) {
+	var ids []uint
+	if err := a.tx.WithContext(ctx).Order(idColumnName).Pluck(idColumnName, &ids).Error; err != nil {
+		return nil, fmt.Errorf("failed to get API IDs: %v", err)
+	}
+	return ids, nil
+}
+
+func (a *APIInventoryTableHandler) SetClassificationOverride(apiID uint32, classification models.APIClassification) error {
+	tx := a.tx.Where(idColumnName+" = ?", apiID).Update(classificationOverrideColumnName, classification)
+	if tx.Error != nil {
+		return fmt.Errorf("failed to set classification override: %v", tx.Error)
+	}
+	if tx.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
 func (a *APIInventoryTableHandler) FirstOrCreate(apiInfo *APIInfo) (created bool, err error) {
 	tx := a.tx.Preload("TraceSource").Where(*apiInfo).FirstOrCreate(apiInfo)
 	return tx.RowsAffected > 0, tx.Error
@@ -0,0 +1,57 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+func TestAPIInventory_SetClassificationOverride(t *testing.T) {
+	db := Init(&DBConfig{
+		DriverType:  DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &APIInfo{Name: "xml.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(api)
+
+	getOverride := func() models.APIClassification {
+		apiInfo := &APIInfo{}
+		assert.NilError(t, db.APIInventoryTable().First(apiInfo, api.ID))
+		return apiInfo.ClassificationOverride
+	}
+
+	assert.NilError(t, db.APIInventoryTable().SetClassificationOverride(uint32(api.ID), models.APIClassificationAPI))
+	assert.Equal(t, getOverride(), models.APIClassificationAPI)
+
+	// the override is kept when the API is looked up by the traces handling
+	apiInfo := APIInfo{Name: "xml.com", Port: 80}
+	_, err := db.APIInventoryTable().FirstOrCreate(&apiInfo)
+	assert.NilError(t, err)
+	assert.Equal(t, apiInfo.ClassificationOverride, models.APIClassificationAPI)
+
+	assert.NilError(t, db.APIInventoryTable().SetClassificationOverride(uint32(api.ID), ""))
+	assert.Equal(t, getOverride(), models.APIClassification(""))
+
+	err = db.APIInventoryTable().SetClassificationOverride(uint32(api.ID+1), models.APIClassificationNONAPI)
+	assert.Assert(t, errors.Is(err, gorm.ErrRecordNotFound))
+}
@@ -122,6 +122,16 @@ func FilterIsNot(db *gorm.DB, column string, values []string) *gorm.DB {
 	return db.Where(fmt.Sprintf("%s NOT IN ?", column), values)
 }
 
+// likeEscapeClause is appended to the LIKE conditions matching values escaped with escapeLike.
+const likeEscapeClause = ` ESCAPE '\'`
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLike escapes the wildcards of a value to match it literally in a LIKE pattern, see likeEscapeClause.
+func escapeLike(value string) string {
+	return likeEscaper.Replace(value)
+}
+
 func FilterStartsWith(db *gorm.DB, column string, value *string) *gorm.DB {
 	if value == nil {
 		return db
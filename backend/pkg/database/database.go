@@ -46,6 +46,15 @@ type Database interface {
 	APIInfoAnnotationsTable() APIAnnotationsTable
 	TraceSourcesTable() TraceSourcesTable
 	TraceSamplingTable() TraceSamplingTable
+	GraphQLSchemasTable() GraphQLSchemasTable
+	GRPCDescriptorSetsTable() GRPCDescriptorSetsTable
+	RedactionPoliciesTable() RedactionPoliciesTable
+	TraceSamplingRatesTable() TraceSamplingRatesTable
+	TraceSamplingEndpointsTable() TraceSamplingEndpointsTable
+	ModuleStatesTable() ModuleStatesTable
+	ModuleConfigsTable() ModuleConfigsTable
+	APIEventTelemetriesTable() APIEventTelemetriesTable
+	APIFindingsTable() APIFindingsTable
 }
 
 type Handler struct {
@@ -60,6 +69,8 @@ type DBConfig struct {
 	DBHost         string
 	DBPort         string
 	DBName         string
+	// LocalDBPath is the sqlite file used by the LOCAL driver, defaults to ./db.db
+	LocalDBPath string
 }
 
 func Init(config *DBConfig) *Handler {
@@ -116,6 +127,60 @@ func (db *Handler) TraceSamplingTable() TraceSamplingTable {
 	}
 }
 
+func (db *Handler) GraphQLSchemasTable() GraphQLSchemasTable {
+	return &GraphQLSchemasTableHandler{
+		tx: db.DB.Table(graphQLSchemasTableName),
+	}
+}
+
+func (db *Handler) GRPCDescriptorSetsTable() GRPCDescriptorSetsTable {
+	return &GRPCDescriptorSetsTableHandler{
+		tx: db.DB.Table(grpcDescriptorSetsTableName),
+	}
+}
+
+func (db *Handler) RedactionPoliciesTable() RedactionPoliciesTable {
+	return &RedactionPoliciesTableHandler{
+		tx: db.DB.Table(redactionPoliciesTableName),
+	}
+}
+
+func (db *Handler) TraceSamplingRatesTable() TraceSamplingRatesTable {
+	return &TraceSamplingRatesTableHandler{
+		tx: db.DB.Table(traceSamplingRatesTableName),
+	}
+}
+
+func (db *Handler) TraceSamplingEndpointsTable() TraceSamplingEndpointsTable {
+	return &TraceSamplingEndpointsTableHandler{
+		tx: db.DB.Table(traceSamplingEndpointsTableName),
+	}
+}
+
+func (db *Handler) ModuleStatesTable() ModuleStatesTable {
+	return &ModuleStatesTableHandler{
+		tx: db.DB.Table(moduleStatesTableName),
+	}
+}
+
+func (db *Handler) ModuleConfigsTable() ModuleConfigsTable {
+	return &ModuleConfigsTableHandler{
+		tx: db.DB.Table(moduleConfigsTableName),
+	}
+}
+
+func (db *Handler) APIFindingsTable() APIFindingsTable {
+	return &APIFindingsTableHandler{
+		tx: db.DB.Table(apiFindingsTableName),
+	}
+}
+
+func (db *Handler) APIEventTelemetriesTable() APIEventTelemetriesTable {
+	return &APIEventTelemetriesTableHandler{
+		tx: db.DB.Table(apiEventTelemetriesTableName),
+	}
+}
+
 func cleanLocalDataBase(databasePath string) {
 	if _, err := os.Stat(databasePath); !os.IsNotExist(err) {
 		log.Debug("deleting db...")
@@ -137,7 +202,7 @@ func initDataBase(config *DBConfig) *gorm.DB {
 	case DBDriverTypePostgres:
 		db = initPostgres(config, dbLogger)
 	case DBDriverTypeLocal:
-		db = initSqlite(dbLogger)
+		db = initSqlite(config.LocalDBPath, dbLogger)
 	default:
 		log.Fatalf("DB driver is not supported: %v", dbDriver)
 	}
@@ -149,7 +214,16 @@ func initDataBase(config *DBConfig) *gorm.DB {
 		&APIEventAnnotation{},
 		&APIInfoAnnotation{},
 		&TraceSource{},
-		&TraceSampling{}); err != nil {
+		&TraceSampling{},
+		&GraphQLSchema{},
+		&GRPCDescriptorSet{},
+		&RedactionPolicy{},
+		&TraceSamplingRate{},
+		&TraceSamplingEndpoint{},
+		&ModuleState{},
+		&ModuleConfig{},
+		&APIEventTelemetry{},
+		&APIFinding{}); err != nil {
 		log.Fatalf("Failed to run auto migration: %v", err)
 	}
 
@@ -170,10 +244,13 @@ func initPostgres(config *DBConfig, dbLogger logger.Interface) *gorm.DB {
 	return db
 }
 
-func initSqlite(dbLogger logger.Interface) *gorm.DB {
-	cleanLocalDataBase(localDBPath)
+func initSqlite(databasePath string, dbLogger logger.Interface) *gorm.DB {
+	if databasePath == "" {
+		databasePath = localDBPath
+	}
+	cleanLocalDataBase(databasePath)
 
-	db, err := gorm.Open(sqlite.Open(localDBPath), &gorm.Config{
+	db, err := gorm.Open(sqlite.Open(databasePath), &gorm.Config{
 		Logger: dbLogger,
 	})
 	if err != nil {
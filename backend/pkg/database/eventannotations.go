@@ -17,8 +17,10 @@ package database
 
 import (
 	"context"
+	"time"
 
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 const (
@@ -35,12 +37,16 @@ type APIEventAnnotation struct {
 	EventID    uint   `json:"event_id,omitempty" gorm:"column:event_id;uniqueIndex:api_event_ann_idx_model" faker:"-"`
 	Name       string `json:"name,omitempty" gorm:"column:name;uniqueIndex:api_event_ann_idx_model" faker:"-"`
 	Annotation []byte `json:"annotation,omitempty" gorm:"column:annotation" faker:"-"`
+	// ExpiresAt is nil when the annotation never expires, an expired annotation is not returned and is eventually deleted
+	ExpiresAt *time.Time `json:"expires_at,omitempty" gorm:"column:expires_at;index" faker:"-"`
 }
 
 type APIEventAnnotationTable interface {
 	Create(ctx context.Context, eas ...APIEventAnnotation) error
 	Get(ctx context.Context, modName string, eventID uint, name string) (*APIEventAnnotation, error)
 	List(ctx context.Context, modName string, eventID uint) ([]*APIEventAnnotation, error)
+	// DeleteExpired deletes the annotations expired at now and returns how many were deleted.
+	DeleteExpired(ctx context.Context, now time.Time) (int64, error)
 }
 
 type APIEventAnnotationTableHandler struct {
@@ -52,13 +58,18 @@ func (APIEventAnnotation) TableName() string {
 }
 
 func (ea *APIEventAnnotationTableHandler) Create(ctx context.Context, annotations ...APIEventAnnotation) error {
-	return ea.tx.WithContext(ctx).Create(annotations).Error
+	// An annotation created again, when the event is replayed through the module, replaces the previous one
+	return ea.tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: moduleNameColumnName}, {Name: eventIDColumnName}, {Name: nameColumnName}},
+		UpdateAll: true,
+	}).WithContext(ctx).Create(annotations).Error
 }
 
 func (ea *APIEventAnnotationTableHandler) List(ctx context.Context, modName string, eventID uint) ([]*APIEventAnnotation, error) {
 	var events []*APIEventAnnotation
 
-	t := ea.tx.Where("module_name = ? AND event_id = ? AND name NOT IN ?", modName, eventID, alertKinds)
+	t := ea.tx.Where("module_name = ? AND event_id = ? AND name NOT IN ?", modName, eventID, alertKinds).
+		Where(notExpiredCondition, time.Now().UTC())
 
 	if err := t.WithContext(ctx).Find(&events).Error; err != nil {
 		return nil, err
@@ -70,7 +81,8 @@ func (ea *APIEventAnnotationTableHandler) List(ctx context.Context, modName stri
 func (ea *APIEventAnnotationTableHandler) Get(ctx context.Context, modName string, eventID uint, name string) (*APIEventAnnotation, error) {
 	annotation := &APIEventAnnotation{}
 
-	t := ea.tx.Where("module_name = ? AND event_id = ? AND name = ?", modName, eventID, name)
+	t := ea.tx.Where("module_name = ? AND event_id = ? AND name = ?", modName, eventID, name).
+		Where(notExpiredCondition, time.Now().UTC())
 
 	if err := t.WithContext(ctx).First(&annotation).Error; err != nil {
 		return annotation, err
@@ -78,3 +90,10 @@ func (ea *APIEventAnnotationTableHandler) Get(ctx context.Context, modName strin
 
 	return annotation, nil
 }
+
+func (ea *APIEventAnnotationTableHandler) DeleteExpired(ctx context.Context, now time.Time) (int64, error) {
+	result := ea.tx.Where(expiredCondition, now.UTC()).
+		WithContext(ctx).
+		Delete(&APIEventAnnotation{})
+	return result.RowsAffected, result.Error
+}
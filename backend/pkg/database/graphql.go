@@ -0,0 +1,156 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+const (
+	graphQLSchemasTableName = "graphql_schemas"
+	graphQLSchemaColumnName = "schema"
+
+	graphQLRootFieldsSeparator = ","
+)
+
+// GraphQLSchema holds the GraphQL schema reconstructed for an API, serialized by the graphql package.
+type GraphQLSchema struct {
+	ID        uint   `gorm:"primarykey" faker:"-"`
+	APIInfoID uint   `json:"apiInfoId,omitempty" gorm:"column:api_info_id;uniqueIndex" faker:"-"`
+	Schema    string `json:"schema,omitempty" gorm:"column:schema" faker:"-"`
+
+	APIInfo APIInfo `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type GraphQLSchemasTable interface {
+	Get(apiID uint) (*GraphQLSchema, error)
+	Set(apiID uint, schema string) error
+}
+
+type GraphQLSchemasTableHandler struct {
+	tx *gorm.DB
+}
+
+func (GraphQLSchema) TableName() string {
+	return graphQLSchemasTableName
+}
+
+func (h *GraphQLSchemasTableHandler) Get(apiID uint) (*GraphQLSchema, error) {
+	var schema GraphQLSchema
+
+	if err := h.tx.Where(fmt.Sprintf("%s = ?", apiInfoIDColumnName), apiID).First(&schema).Error; err != nil {
+		return nil, err
+	}
+
+	return &schema, nil
+}
+
+func (h *GraphQLSchemasTableHandler) Set(apiID uint, schema string) error {
+	return h.tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: apiInfoIDColumnName}},
+		DoUpdates: clause.AssignmentColumns([]string{graphQLSchemaColumnName}),
+	}).Create(&GraphQLSchema{
+		APIInfoID: apiID,
+		Schema:    schema,
+	}).Error
+}
+
+// GraphQLRootFieldsToColumn joins the root fields for the graphql_root_fields column.
+func GraphQLRootFieldsToColumn(rootFields []string) string {
+	if len(rootFields) == 0 {
+		return ""
+	}
+	return graphQLRootFieldsSeparator + strings.Join(rootFields, graphQLRootFieldsSeparator) + graphQLRootFieldsSeparator
+}
+
+func GraphQLRootFieldsFromColumn(column string) []string {
+	column = strings.Trim(column, graphQLRootFieldsSeparator)
+	if column == "" {
+		return nil
+	}
+	return strings.Split(column, graphQLRootFieldsSeparator)
+}
+
+// filterGraphQLRootFieldIs keeps the events that select any of the root fields.
+func filterGraphQLRootFieldIs(db *gorm.DB, rootFields []string) *gorm.DB {
+	if len(rootFields) == 0 {
+		return db
+	}
+
+	conditions := make([]string, 0, len(rootFields))
+	args := make([]interface{}, 0, len(rootFields))
+	for _, rootField := range rootFields {
+		conditions = append(conditions, fmt.Sprintf("%s LIKE ?", graphQLRootFieldsColumnName)+likeEscapeClause)
+		args = append(args, "%"+escapeLike(GraphQLRootFieldsToColumn([]string{rootField}))+"%")
+	}
+	return db.Where("("+strings.Join(conditions, " OR ")+")", args...)
+}
+
+// GetGraphQLOperationsUsage returns the hit count of each GraphQL operation of the API within the time range.
+func (a *APIEventsTableHandler) GetGraphQLOperationsUsage(apiID uint32, startTime, endTime time.Time) ([]*models.GraphQLOperationUsage, error) {
+	rows, err := a.tx.
+		Where(CreateTimeFilter(timeColumnName, strfmt.DateTime(startTime), strfmt.DateTime(endTime))).
+		Where(fmt.Sprintf("%s = ?", apiInfoIDColumnName), apiID).
+		Where(fmt.Sprintf("%s <> ''", graphQLOperationTypeColumnName)).
+		Select(graphQLOperationTypeColumnName +
+			", " + graphQLOperationNameColumnName +
+			", " + graphQLRootFieldsColumnName +
+			", COUNT(*) AS count" +
+			", MAX(" + timeColumnName + ") AS last_seen").
+		Group(graphQLOperationTypeColumnName).
+		Group(graphQLOperationNameColumnName).
+		Group(graphQLRootFieldsColumnName).
+		Order("count desc").
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get GraphQL operations usage: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Warnf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var ret []*models.GraphQLOperationUsage
+	for rows.Next() {
+		var operationType, operationName, rootFields string
+		var count int64
+		var lastSeen strfmt.DateTime
+		if err := rows.Scan(&operationType, &operationName, &rootFields, &count, &lastSeen); err != nil {
+			return nil, fmt.Errorf("failed to get fields: %v", err)
+		}
+		ret = append(ret, &models.GraphQLOperationUsage{
+			Operation: &models.GraphQLOperation{
+				OperationName: operationName,
+				OperationType: models.GraphQLOperationType(operationType),
+				RootFields:    GraphQLRootFieldsFromColumn(rootFields),
+			},
+			Count:    count,
+			LastSeen: lastSeen,
+		})
+	}
+
+	return ret, nil
+}
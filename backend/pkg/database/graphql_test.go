@@ -0,0 +1,50 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func Test_filterGraphQLRootFieldIs(t *testing.T) {
+	db := Init(&DBConfig{
+		DriverType:  DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &APIInfo{Name: "graphql.com", Port: 80}
+	db.APIInventoryTable().CreateAPIInfo(api)
+	for _, rootFields := range [][]string{{"user_profile"}, {"userXprofile", "posts"}, {"user%"}, {"user"}} {
+		db.APIEventsTable().CreateAPIEvent(&APIEvent{APIInfoID: api.ID, GraphQLRootFields: GraphQLRootFieldsToColumn(rootFields)})
+	}
+
+	find := func(rootFields ...string) []string {
+		var events []APIEvent
+		assert.NilError(t, filterGraphQLRootFieldIs(db.DB.Table(apiEventTableName), rootFields).Order(idColumnName).Find(&events).Error)
+		ret := []string{}
+		for _, event := range events {
+			ret = append(ret, event.GraphQLRootFields)
+		}
+		return ret
+	}
+
+	// the wildcards in the field names are matched literally
+	assert.DeepEqual(t, find("user_profile"), []string{",user_profile,"})
+	assert.DeepEqual(t, find("user%"), []string{",user%,"})
+	assert.DeepEqual(t, find("user", "posts"), []string{",userXprofile,posts,", ",user,"})
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+)
+
+const (
+	grpcDescriptorSetsTableName = "grpc_descriptor_sets"
+	descriptorSetColumnName     = "descriptor_set"
+)
+
+// GRPCDescriptorSet holds the serialized protobuf FileDescriptorSet uploaded for a gRPC API.
+type GRPCDescriptorSet struct {
+	ID            uint   `gorm:"primarykey" faker:"-"`
+	APIInfoID     uint   `json:"apiInfoId,omitempty" gorm:"column:api_info_id;uniqueIndex" faker:"-"`
+	DescriptorSet []byte `json:"descriptorSet,omitempty" gorm:"column:descriptor_set" faker:"-"`
+
+	APIInfo APIInfo `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type GRPCDescriptorSetsTable interface {
+	Get(apiID uint) (*GRPCDescriptorSet, error)
+	Set(apiID uint, descriptorSet []byte) error
+	Delete(apiID uint) error
+}
+
+type GRPCDescriptorSetsTableHandler struct {
+	tx *gorm.DB
+}
+
+func (GRPCDescriptorSet) TableName() string {
+	return grpcDescriptorSetsTableName
+}
+
+func (h *GRPCDescriptorSetsTableHandler) Get(apiID uint) (*GRPCDescriptorSet, error) {
+	var descriptorSet GRPCDescriptorSet
+
+	if err := h.tx.Where(fmt.Sprintf("%s = ?", apiInfoIDColumnName), apiID).First(&descriptorSet).Error; err != nil {
+		return nil, err
+	}
+
+	return &descriptorSet, nil
+}
+
+func (h *GRPCDescriptorSetsTableHandler) Set(apiID uint, descriptorSet []byte) error {
+	return h.tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: apiInfoIDColumnName}},
+		DoUpdates: clause.AssignmentColumns([]string{descriptorSetColumnName}),
+	}).Create(&GRPCDescriptorSet{
+		APIInfoID:     apiID,
+		DescriptorSet: descriptorSet,
+	}).Error
+}
+
+func (h *GRPCDescriptorSetsTableHandler) Delete(apiID uint) error {
+	return h.tx.Where(fmt.Sprintf("%s = ?", apiInfoIDColumnName), apiID).Delete(&GRPCDescriptorSet{}).Error
+}
+
+func (a *APIEventsTableHandler) GetAPIEventGRPCMessages(eventID uint32) (*APIEvent, error) {
+	var apiEvent APIEvent
+
+	if err := a.tx.Select(grpcServiceColumnName, grpcMethodColumnName, grpcRequestMessagesColumnName, grpcResponseMessagesColumnName).First(&apiEvent, eventID).Error; err != nil {
+		return nil, err
+	}
+
+	return &apiEvent, nil
+}
+
+// GetGRPCMethodsUsage returns the calls statistics of each gRPC method of the API within the time range.
+func (a *APIEventsTableHandler) GetGRPCMethodsUsage(apiID uint32, startTime, endTime time.Time) ([]*models.GrpcMethodUsage, error) {
+	rows, err := a.tx.
+		Where(CreateTimeFilter(timeColumnName, strfmt.DateTime(startTime), strfmt.DateTime(endTime))).
+		Where(fmt.Sprintf("%s = ?", apiInfoIDColumnName), apiID).
+		Where(fmt.Sprintf("%s <> ''", grpcServiceColumnName)).
+		Select(grpcServiceColumnName +
+			", " + grpcMethodColumnName +
+			", COUNT(*) AS count" +
+			", SUM(CASE WHEN " + grpcStatusColumnName + " <> 0 THEN 1 ELSE 0 END) AS error_count" +
+			", AVG(" + grpcRequestSizeColumnName + ") AS average_request_size" +
+			", AVG(" + grpcResponseSizeColumnName + ") AS average_response_size" +
+			", MAX(" + timeColumnName + ") AS last_seen").
+		Group(grpcServiceColumnName).
+		Group(grpcMethodColumnName).
+		Order("count desc").
+		Rows()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get gRPC methods usage: %v", err)
+	}
+	defer func() {
+		if err := rows.Close(); err != nil {
+			log.Warnf("Failed to close rows: %v", err)
+		}
+	}()
+
+	var ret []*models.GrpcMethodUsage
+	for rows.Next() {
+		var service, method string
+		var count, errorCount int64
+		var averageRequestSize, averageResponseSize float64
+		var lastSeen strfmt.DateTime
+		if err := rows.Scan(&service, &method, &count, &errorCount, &averageRequestSize, &averageResponseSize, &lastSeen); err != nil {
+			return nil, fmt.Errorf("failed to get fields: %v", err)
+		}
+		ret = append(ret, &models.GrpcMethodUsage{
+			Service:             service,
+			Method:              method,
+			Count:               count,
+			ErrorCount:          errorCount,
+			AverageRequestSize:  int64(averageRequestSize),
+			AverageResponseSize: int64(averageResponseSize),
+			LastSeen:            lastSeen,
+		})
+	}
+
+	return ret, nil
+}
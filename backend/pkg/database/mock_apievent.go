@@ -65,6 +65,21 @@ func (mr *MockAPIEventsTableMockRecorder) GetAPIEvent(arg0 interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIEvent", reflect.TypeOf((*MockAPIEventsTable)(nil).GetAPIEvent), arg0)
 }
 
+// GetAPIEventGRPCMessages mocks base method.
+func (m *MockAPIEventsTable) GetAPIEventGRPCMessages(arg0 uint32) (*APIEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIEventGRPCMessages", arg0)
+	ret0, _ := ret[0].(*APIEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIEventGRPCMessages indicates an expected call of GetAPIEventGRPCMessages.
+func (mr *MockAPIEventsTableMockRecorder) GetAPIEventGRPCMessages(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIEventGRPCMessages", reflect.TypeOf((*MockAPIEventsTable)(nil).GetAPIEventGRPCMessages), arg0)
+}
+
 // GetAPIEventProvidedSpecDiff mocks base method.
 func (m *MockAPIEventsTable) GetAPIEventProvidedSpecDiff(arg0 uint32) (*APIEvent, error) {
 	m.ctrl.T.Helper()
@@ -171,6 +186,36 @@ func (mr *MockAPIEventsTableMockRecorder) GetDashboardAPIUsages(arg0, arg1, arg2
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDashboardAPIUsages", reflect.TypeOf((*MockAPIEventsTable)(nil).GetDashboardAPIUsages), arg0, arg1, arg2)
 }
 
+// GetGRPCMethodsUsage mocks base method.
+func (m *MockAPIEventsTable) GetGRPCMethodsUsage(arg0 uint32, arg1, arg2 time.Time) ([]*models.GrpcMethodUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGRPCMethodsUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.GrpcMethodUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGRPCMethodsUsage indicates an expected call of GetGRPCMethodsUsage.
+func (mr *MockAPIEventsTableMockRecorder) GetGRPCMethodsUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGRPCMethodsUsage", reflect.TypeOf((*MockAPIEventsTable)(nil).GetGRPCMethodsUsage), arg0, arg1, arg2)
+}
+
+// GetGraphQLOperationsUsage mocks base method.
+func (m *MockAPIEventsTable) GetGraphQLOperationsUsage(arg0 uint32, arg1, arg2 time.Time) ([]*models.GraphQLOperationUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetGraphQLOperationsUsage", arg0, arg1, arg2)
+	ret0, _ := ret[0].([]*models.GraphQLOperationUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetGraphQLOperationsUsage indicates an expected call of GetGraphQLOperationsUsage.
+func (mr *MockAPIEventsTableMockRecorder) GetGraphQLOperationsUsage(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGraphQLOperationsUsage", reflect.TypeOf((*MockAPIEventsTable)(nil).GetGraphQLOperationsUsage), arg0, arg1, arg2)
+}
+
 // GroupByAPIInfo mocks base method.
 func (m *MockAPIEventsTable) GroupByAPIInfo() ([]HostGroup, error) {
 	m.ctrl.T.Helper()
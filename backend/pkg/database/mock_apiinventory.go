@@ -5,6 +5,7 @@
 package database
 
 import (
+	context "context"
 	reflect "reflect"
 
 	strfmt "github.com/go-openapi/strfmt"
@@ -126,6 +127,21 @@ func (mr *MockAPIInventoryTableMockRecorder) GetAPIID(arg0, arg1, arg2 interface
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIID", reflect.TypeOf((*MockAPIInventoryTable)(nil).GetAPIID), arg0, arg1, arg2)
 }
 
+// GetAPIIDs mocks base method.
+func (m *MockAPIInventoryTable) GetAPIIDs(arg0 context.Context) ([]uint, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAPIIDs", arg0)
+	ret0, _ := ret[0].([]uint)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAPIIDs indicates an expected call of GetAPIIDs.
+func (mr *MockAPIInventoryTableMockRecorder) GetAPIIDs(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAPIIDs", reflect.TypeOf((*MockAPIInventoryTable)(nil).GetAPIIDs), arg0)
+}
+
 // GetAPIInventoryAndTotal mocks base method.
 func (m *MockAPIInventoryTable) GetAPIInventoryAndTotal(arg0 operations.GetAPIInventoryParams) ([]APIInfo, int64, error) {
 	m.ctrl.T.Helper()
@@ -185,3 +201,17 @@ func (mr *MockAPIInventoryTableMockRecorder) PutAPISpec(arg0, arg1, arg2, arg3,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutAPISpec", reflect.TypeOf((*MockAPIInventoryTable)(nil).PutAPISpec), arg0, arg1, arg2, arg3, arg4)
 }
+
+// SetClassificationOverride mocks base method.
+func (m *MockAPIInventoryTable) SetClassificationOverride(arg0 uint32, arg1 models.APIClassification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetClassificationOverride", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetClassificationOverride indicates an expected call of SetClassificationOverride.
+func (mr *MockAPIInventoryTableMockRecorder) SetClassificationOverride(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetClassificationOverride", reflect.TypeOf((*MockAPIInventoryTable)(nil).SetClassificationOverride), arg0, arg1)
+}
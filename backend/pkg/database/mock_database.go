@@ -33,6 +33,20 @@ func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
+// APIEventTelemetriesTable mocks base method.
+func (m *MockDatabase) APIEventTelemetriesTable() APIEventTelemetriesTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIEventTelemetriesTable")
+	ret0, _ := ret[0].(APIEventTelemetriesTable)
+	return ret0
+}
+
+// APIEventTelemetriesTable indicates an expected call of APIEventTelemetriesTable.
+func (mr *MockDatabaseMockRecorder) APIEventTelemetriesTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIEventTelemetriesTable", reflect.TypeOf((*MockDatabase)(nil).APIEventTelemetriesTable))
+}
+
 // APIEventsAnnotationsTable mocks base method.
 func (m *MockDatabase) APIEventsAnnotationsTable() APIEventAnnotationTable {
 	m.ctrl.T.Helper()
@@ -61,6 +75,20 @@ func (mr *MockDatabaseMockRecorder) APIEventsTable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIEventsTable", reflect.TypeOf((*MockDatabase)(nil).APIEventsTable))
 }
 
+// APIFindingsTable mocks base method.
+func (m *MockDatabase) APIFindingsTable() APIFindingsTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "APIFindingsTable")
+	ret0, _ := ret[0].(APIFindingsTable)
+	return ret0
+}
+
+// APIFindingsTable indicates an expected call of APIFindingsTable.
+func (mr *MockDatabaseMockRecorder) APIFindingsTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIFindingsTable", reflect.TypeOf((*MockDatabase)(nil).APIFindingsTable))
+}
+
 // APIInfoAnnotationsTable mocks base method.
 func (m *MockDatabase) APIInfoAnnotationsTable() APIAnnotationsTable {
 	m.ctrl.T.Helper()
@@ -89,6 +117,76 @@ func (mr *MockDatabaseMockRecorder) APIInventoryTable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIInventoryTable", reflect.TypeOf((*MockDatabase)(nil).APIInventoryTable))
 }
 
+// GRPCDescriptorSetsTable mocks base method.
+func (m *MockDatabase) GRPCDescriptorSetsTable() GRPCDescriptorSetsTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GRPCDescriptorSetsTable")
+	ret0, _ := ret[0].(GRPCDescriptorSetsTable)
+	return ret0
+}
+
+// GRPCDescriptorSetsTable indicates an expected call of GRPCDescriptorSetsTable.
+func (mr *MockDatabaseMockRecorder) GRPCDescriptorSetsTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GRPCDescriptorSetsTable", reflect.TypeOf((*MockDatabase)(nil).GRPCDescriptorSetsTable))
+}
+
+// GraphQLSchemasTable mocks base method.
+func (m *MockDatabase) GraphQLSchemasTable() GraphQLSchemasTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GraphQLSchemasTable")
+	ret0, _ := ret[0].(GraphQLSchemasTable)
+	return ret0
+}
+
+// GraphQLSchemasTable indicates an expected call of GraphQLSchemasTable.
+func (mr *MockDatabaseMockRecorder) GraphQLSchemasTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GraphQLSchemasTable", reflect.TypeOf((*MockDatabase)(nil).GraphQLSchemasTable))
+}
+
+// ModuleConfigsTable mocks base method.
+func (m *MockDatabase) ModuleConfigsTable() ModuleConfigsTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModuleConfigsTable")
+	ret0, _ := ret[0].(ModuleConfigsTable)
+	return ret0
+}
+
+// ModuleConfigsTable indicates an expected call of ModuleConfigsTable.
+func (mr *MockDatabaseMockRecorder) ModuleConfigsTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleConfigsTable", reflect.TypeOf((*MockDatabase)(nil).ModuleConfigsTable))
+}
+
+// ModuleStatesTable mocks base method.
+func (m *MockDatabase) ModuleStatesTable() ModuleStatesTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModuleStatesTable")
+	ret0, _ := ret[0].(ModuleStatesTable)
+	return ret0
+}
+
+// ModuleStatesTable indicates an expected call of ModuleStatesTable.
+func (mr *MockDatabaseMockRecorder) ModuleStatesTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModuleStatesTable", reflect.TypeOf((*MockDatabase)(nil).ModuleStatesTable))
+}
+
+// RedactionPoliciesTable mocks base method.
+func (m *MockDatabase) RedactionPoliciesTable() RedactionPoliciesTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RedactionPoliciesTable")
+	ret0, _ := ret[0].(RedactionPoliciesTable)
+	return ret0
+}
+
+// RedactionPoliciesTable indicates an expected call of RedactionPoliciesTable.
+func (mr *MockDatabaseMockRecorder) RedactionPoliciesTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RedactionPoliciesTable", reflect.TypeOf((*MockDatabase)(nil).RedactionPoliciesTable))
+}
+
 // ReviewTable mocks base method.
 func (m *MockDatabase) ReviewTable() ReviewTable {
 	m.ctrl.T.Helper()
@@ -103,6 +201,34 @@ func (mr *MockDatabaseMockRecorder) ReviewTable() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewTable", reflect.TypeOf((*MockDatabase)(nil).ReviewTable))
 }
 
+// TraceSamplingEndpointsTable mocks base method.
+func (m *MockDatabase) TraceSamplingEndpointsTable() TraceSamplingEndpointsTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceSamplingEndpointsTable")
+	ret0, _ := ret[0].(TraceSamplingEndpointsTable)
+	return ret0
+}
+
+// TraceSamplingEndpointsTable indicates an expected call of TraceSamplingEndpointsTable.
+func (mr *MockDatabaseMockRecorder) TraceSamplingEndpointsTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceSamplingEndpointsTable", reflect.TypeOf((*MockDatabase)(nil).TraceSamplingEndpointsTable))
+}
+
+// TraceSamplingRatesTable mocks base method.
+func (m *MockDatabase) TraceSamplingRatesTable() TraceSamplingRatesTable {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TraceSamplingRatesTable")
+	ret0, _ := ret[0].(TraceSamplingRatesTable)
+	return ret0
+}
+
+// TraceSamplingRatesTable indicates an expected call of TraceSamplingRatesTable.
+func (mr *MockDatabaseMockRecorder) TraceSamplingRatesTable() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TraceSamplingRatesTable", reflect.TypeOf((*MockDatabase)(nil).TraceSamplingRatesTable))
+}
+
 // TraceSamplingTable mocks base method.
 func (m *MockDatabase) TraceSamplingTable() TraceSamplingTable {
 	m.ctrl.T.Helper()
@@ -0,0 +1,69 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"errors"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	moduleConfigsTableName = "module_configs"
+	configColumnName       = "config"
+)
+
+// ModuleConfig is the config of a module set at runtime, a JSON document matching the schema of the module config.
+type ModuleConfig struct {
+	Name   string `json:"name" gorm:"column:name;primaryKey" faker:"-"`
+	Config string `json:"config" gorm:"column:config" faker:"-"`
+}
+
+type ModuleConfigsTable interface {
+	// Get returns the config of a module, nil when it was never set.
+	Get(name string) (*ModuleConfig, error)
+	Set(config *ModuleConfig) error
+}
+
+// ModuleConfigsTableHandler starts each query from a new session since it is held for the lifetime of the modules.
+type ModuleConfigsTableHandler struct {
+	tx *gorm.DB
+}
+
+func (ModuleConfig) TableName() string {
+	return moduleConfigsTableName
+}
+
+func (h *ModuleConfigsTableHandler) Get(name string) (*ModuleConfig, error) {
+	config := &ModuleConfig{}
+
+	if err := h.tx.Session(&gorm.Session{}).Where("name = ?", name).First(config).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (h *ModuleConfigsTableHandler) Set(config *ModuleConfig) error {
+	return h.tx.Session(&gorm.Session{}).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{configColumnName}),
+	}).Create(config).Error
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	moduleStatesTableName = "module_states"
+	enabledColumnName     = "enabled"
+)
+
+// ModuleState is the state of a module set at runtime, the modules without state are enabled.
+type ModuleState struct {
+	Name    string `json:"name" gorm:"column:name;primaryKey" faker:"-"`
+	Enabled bool   `json:"enabled" gorm:"column:enabled" faker:"-"`
+}
+
+type ModuleStatesTable interface {
+	GetAll() ([]*ModuleState, error)
+	Set(state *ModuleState) error
+}
+
+type ModuleStatesTableHandler struct {
+	tx *gorm.DB
+}
+
+func (ModuleState) TableName() string {
+	return moduleStatesTableName
+}
+
+func (h *ModuleStatesTableHandler) GetAll() ([]*ModuleState, error) {
+	var states []*ModuleState
+
+	if err := h.tx.Find(&states).Error; err != nil {
+		return nil, err
+	}
+
+	return states, nil
+}
+
+func (h *ModuleStatesTableHandler) Set(state *ModuleState) error {
+	return h.tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{enabledColumnName}),
+	}).Create(state).Error
+}
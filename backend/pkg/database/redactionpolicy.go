@@ -0,0 +1,104 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+const (
+	redactionPoliciesTableName = "redaction_policies"
+	traceSourceIDColumnName    = "trace_source_id"
+)
+
+// RedactionPolicy holds a JSON serialized models.RedactionPolicy. The default policy has no trace source.
+type RedactionPolicy struct {
+	ID            uint   `gorm:"primarykey" faker:"-"`
+	TraceSourceID *uint  `json:"traceSourceId,omitempty" gorm:"column:trace_source_id;uniqueIndex" faker:"-"`
+	Policy        string `json:"policy,omitempty" gorm:"column:policy" faker:"-"`
+
+	TraceSource *TraceSource `gorm:"constraint:OnDelete:CASCADE"`
+}
+
+type RedactionPoliciesTable interface {
+	// Get returns the policy of a trace source, or the default policy if traceSourceID is nil
+	Get(traceSourceID *uint) (*RedactionPolicy, error)
+	GetAll() ([]*RedactionPolicy, error)
+	Set(traceSourceID *uint, policy string) error
+	Delete(traceSourceID *uint) error
+}
+
+type RedactionPoliciesTableHandler struct {
+	tx *gorm.DB
+}
+
+func (RedactionPolicy) TableName() string {
+	return redactionPoliciesTableName
+}
+
+func whereTraceSource(tx *gorm.DB, traceSourceID *uint) *gorm.DB {
+	if traceSourceID == nil {
+		return tx.Where(fmt.Sprintf("%s IS NULL", traceSourceIDColumnName))
+	}
+	return tx.Where(fmt.Sprintf("%s = ?", traceSourceIDColumnName), *traceSourceID)
+}
+
+func (h *RedactionPoliciesTableHandler) Get(traceSourceID *uint) (*RedactionPolicy, error) {
+	var policy RedactionPolicy
+
+	if err := whereTraceSource(h.tx, traceSourceID).First(&policy).Error; err != nil {
+		return nil, err
+	}
+
+	return &policy, nil
+}
+
+func (h *RedactionPoliciesTableHandler) GetAll() ([]*RedactionPolicy, error) {
+	var policies []*RedactionPolicy
+
+	if err := h.tx.Find(&policies).Error; err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// Set creates or updates the policy. The NULL trace source of the default policy doesn't conflict on the
+// unique index, so the policy is looked up first instead of upserted.
+func (h *RedactionPoliciesTableHandler) Set(traceSourceID *uint, policy string) error {
+	return h.tx.Transaction(func(tx *gorm.DB) error {
+		var existing RedactionPolicy
+		err := whereTraceSource(tx, traceSourceID).First(&existing).Error
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return tx.Create(&RedactionPolicy{
+				TraceSourceID: traceSourceID,
+				Policy:        policy,
+			}).Error
+		case err != nil:
+			return err
+		default:
+			return tx.Model(&existing).Update("policy", policy).Error
+		}
+	})
+}
+
+func (h *RedactionPoliciesTableHandler) Delete(traceSourceID *uint) error {
+	return whereTraceSource(h.tx, traceSourceID).Delete(&RedactionPolicy{}).Error
+}
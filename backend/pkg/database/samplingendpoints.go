@@ -0,0 +1,93 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"gorm.io/gorm"
+)
+
+const (
+	traceSamplingEndpointsTableName = "trace_sampling_endpoints"
+)
+
+// TraceSamplingEndpoint restricts the tracing of an API for a component to an endpoint, a path template of the spec
+// of the API and a method. The tracing of an API without endpoints is not restricted.
+type TraceSamplingEndpoint struct {
+	ID        uint   `gorm:"primarykey" faker:"-"`
+	APIID     uint   `json:"api_id,omitempty" gorm:"column:api_id;uniqueIndex:trace_sampling_endpoint_idx" faker:"-"`
+	Component string `json:"component,omitempty" gorm:"column:component;uniqueIndex:trace_sampling_endpoint_idx" faker:"-"`
+	Method    string `json:"method,omitempty" gorm:"column:method;uniqueIndex:trace_sampling_endpoint_idx" faker:"-"`
+	Path      string `json:"path,omitempty" gorm:"column:path;uniqueIndex:trace_sampling_endpoint_idx" faker:"-"`
+
+	APIInfo APIInfo `gorm:"foreignKey:APIID;constraint:OnDelete:CASCADE"`
+}
+
+type TraceSamplingEndpointsTable interface {
+	// GetAll returns the endpoints with their API info.
+	GetAll() ([]*TraceSamplingEndpoint, error)
+	// Set replaces the endpoints of an API for a component.
+	Set(apiID uint, component string, endpoints []*TraceSamplingEndpoint) error
+	Delete(apiID uint, component string) error
+}
+
+type TraceSamplingEndpointsTableHandler struct {
+	tx *gorm.DB
+}
+
+func (TraceSamplingEndpoint) TableName() string {
+	return traceSamplingEndpointsTableName
+}
+
+// Hosts returns the hosts of the API of the endpoint, in the hosts to trace format.
+func (e *TraceSamplingEndpoint) Hosts() []string {
+	return createHostFromTraceSamplingWithHostAndPort(&TraceSamplingWithHostAndPort{
+		APIID:         e.APIID,
+		TraceSourceID: e.APIInfo.TraceSourceID,
+		Component:     e.Component,
+		Name:          e.APIInfo.Name,
+		Port:          uint(e.APIInfo.Port),
+	})
+}
+
+func (h *TraceSamplingEndpointsTableHandler) GetAll() ([]*TraceSamplingEndpoint, error) {
+	var endpoints []*TraceSamplingEndpoint
+
+	if err := h.tx.Preload("APIInfo").Find(&endpoints).Error; err != nil {
+		return nil, err
+	}
+
+	return endpoints, nil
+}
+
+func (h *TraceSamplingEndpointsTableHandler) Set(apiID uint, component string, endpoints []*TraceSamplingEndpoint) error {
+	return h.tx.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("api_id = ? AND component = ?", apiID, component).Delete(&TraceSamplingEndpoint{}).Error; err != nil {
+			return err
+		}
+		if len(endpoints) == 0 {
+			return nil
+		}
+		for _, endpoint := range endpoints {
+			endpoint.APIID = apiID
+			endpoint.Component = component
+		}
+		return tx.Omit("APIInfo").Create(endpoints).Error
+	})
+}
+
+func (h *TraceSamplingEndpointsTableHandler) Delete(apiID uint, component string) error {
+	return h.tx.Where("api_id = ? AND component = ?", apiID, component).Delete(&TraceSamplingEndpoint{}).Error
+}
@@ -0,0 +1,85 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package database
+
+import (
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	traceSamplingRatesTableName  = "trace_sampling_rates"
+	ratioColumnName              = "ratio"
+	maxEventsPerSecondColumnName = "max_events_per_second"
+)
+
+// TraceSamplingRate is the sampling of the traces of an API for a component: "*" for the trace sources, or a module.
+type TraceSamplingRate struct {
+	ID                 uint    `gorm:"primarykey" faker:"-"`
+	APIID              uint    `json:"api_id,omitempty" gorm:"column:api_id;uniqueIndex:trace_sampling_rate_idx" faker:"-"`
+	Component          string  `json:"component,omitempty" gorm:"column:component;uniqueIndex:trace_sampling_rate_idx" faker:"-"`
+	Ratio              float64 `json:"ratio" gorm:"column:ratio" faker:"-"`
+	MaxEventsPerSecond float64 `json:"max_events_per_second,omitempty" gorm:"column:max_events_per_second" faker:"-"`
+
+	APIInfo APIInfo `gorm:"foreignKey:APIID;constraint:OnDelete:CASCADE"`
+}
+
+type TraceSamplingRatesTable interface {
+	// GetAll returns the sampling rates with their API info.
+	GetAll() ([]*TraceSamplingRate, error)
+	Set(rate *TraceSamplingRate) error
+	Delete(apiID uint, component string) error
+}
+
+type TraceSamplingRatesTableHandler struct {
+	tx *gorm.DB
+}
+
+func (TraceSamplingRate) TableName() string {
+	return traceSamplingRatesTableName
+}
+
+// Hosts returns the hosts of the API the rate applies to, in the hosts to trace format.
+func (r *TraceSamplingRate) Hosts() []string {
+	return createHostFromTraceSamplingWithHostAndPort(&TraceSamplingWithHostAndPort{
+		APIID:         r.APIID,
+		TraceSourceID: r.APIInfo.TraceSourceID,
+		Component:     r.Component,
+		Name:          r.APIInfo.Name,
+		Port:          uint(r.APIInfo.Port),
+	})
+}
+
+func (h *TraceSamplingRatesTableHandler) GetAll() ([]*TraceSamplingRate, error) {
+	var rates []*TraceSamplingRate
+
+	if err := h.tx.Preload("APIInfo").Find(&rates).Error; err != nil {
+		return nil, err
+	}
+
+	return rates, nil
+}
+
+func (h *TraceSamplingRatesTableHandler) Set(rate *TraceSamplingRate) error {
+	return h.tx.Omit("APIInfo").Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "api_id"}, {Name: "component"}},
+		DoUpdates: clause.AssignmentColumns([]string{ratioColumnName, maxEventsPerSecondColumnName}),
+	}).Create(rate).Error
+}
+
+func (h *TraceSamplingRatesTableHandler) Delete(apiID uint, component string) error {
+	return h.tx.Where("api_id = ? AND component = ?", apiID, component).Delete(&TraceSamplingRate{}).Error
+}
@@ -17,6 +17,7 @@ package database
 
 import (
 	"strconv"
+	"time"
 
 	"gorm.io/gorm"
 
@@ -35,11 +36,21 @@ type TraceSampling struct {
 	APIID         uint   `json:"api_id,omitempty" gorm:"column:api_id" faker:"-"`
 	TraceSourceID uint   `json:"trace_source_id,omitempty" gorm:"column:trace_source_id" faker:"-"`
 	Component     string `json:"component,omitempty" gorm:"column:component" faker:"-"`
+	TracingSession
 
 	APIInfo     APIInfo     `gorm:"foreignKey:APIID;constraint:OnDelete:CASCADE"`
 	TraceSource TraceSource `gorm:"constraint:OnDelete:CASCADE"`
 }
 
+// TracingSession bounds the tracing of an API for a component, the tracing is disabled once the session expires or
+// once MaxEvents events were dispatched to the component.
+type TracingSession struct {
+	StartedAt   time.Time  `json:"started_at,omitempty" gorm:"column:started_at" faker:"-"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty" gorm:"column:expires_at" faker:"-"`
+	MaxEvents   int64      `json:"max_events,omitempty" gorm:"column:max_events" faker:"-"`
+	EventsCount int64      `json:"events_count,omitempty" gorm:"column:events_count" faker:"-"`
+}
+
 type TraceSamplingWithHostAndPort struct {
 	APIID                uint
 	TraceSourceID        uint
@@ -50,8 +61,10 @@ type TraceSamplingWithHostAndPort struct {
 }
 
 type TraceSamplingTable interface {
-	AddAPIToTrace(component string, traceSourceID uint, apiID uint32) error
+	AddAPIToTrace(component string, traceSourceID uint, apiID uint32, session TracingSession) error
 	GetAPIsToTrace(component string, traceSourceID uint) ([]*TraceSampling, error)
+	GetTracingSessions() ([]*TraceSampling, error)
+	UpdateEventsCount(component string, apiID uint, eventsCount int64) error
 	DeleteAPIToTrace(component string, traceSourceID uint, apiID uint32) error
 	DeleteAll() error
 	ResetAPIsToTraceByTraceSource(component string, traceSourceID uint) error
@@ -69,13 +82,22 @@ func (TraceSampling) TableName() string {
 	return traceSamplingTableName
 }
 
-func (h *TraceSamplingTableHandler) AddAPIToTrace(component string, traceSourceID uint, apiID uint32) error {
+// AddAPIToTrace enables the tracing of an API for a component, or restarts its tracing session when it is already enabled.
+func (h *TraceSamplingTableHandler) AddAPIToTrace(component string, traceSourceID uint, apiID uint32, session TracingSession) error {
 	sampling := TraceSampling{
 		APIID:         uint(apiID),
 		TraceSourceID: traceSourceID,
 		Component:     component,
 	}
-	return h.tx.Where(sampling).FirstOrCreate(&sampling).Error
+	return h.tx.Where(sampling).
+		Assign(map[string]interface{}{
+			"started_at":   session.StartedAt,
+			"expires_at":   session.ExpiresAt,
+			"max_events":   session.MaxEvents,
+			"events_count": session.EventsCount,
+		}).
+		FirstOrCreate(&sampling).
+		Error
 }
 
 func (h *TraceSamplingTableHandler) GetAPIsToTrace(component string, traceSourceID uint) ([]*TraceSampling, error) {
@@ -89,6 +111,22 @@ func (h *TraceSamplingTableHandler) GetAPIsToTrace(component string, traceSource
 	return samplings, nil
 }
 
+func (h *TraceSamplingTableHandler) GetTracingSessions() ([]*TraceSampling, error) {
+	var samplings []*TraceSampling
+	if err := h.tx.Preload("APIInfo").Find(&samplings).Error; err != nil {
+		return nil, err
+	}
+
+	return samplings, nil
+}
+
+func (h *TraceSamplingTableHandler) UpdateEventsCount(component string, apiID uint, eventsCount int64) error {
+	return h.tx.Model(&TraceSampling{}).
+		Where("component = ? AND api_id = ?", component, apiID).
+		Update("events_count", eventsCount).
+		Error
+}
+
 func (h *TraceSamplingTableHandler) DeleteAPIToTrace(component string, traceSourceID uint, apiID uint32) error {
 	return h.tx.Unscoped().
 		Where("trace_source_id = ? AND component = ? AND api_id = ?", traceSourceID, component, apiID).
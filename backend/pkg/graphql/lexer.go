@@ -0,0 +1,187 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunctuator
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+)
+
+const unicodeBOM = "\ufeff"
+
+type token struct {
+	kind  tokenKind
+	value string
+	pos   int
+}
+
+// lexer splits a GraphQL document into tokens (https://spec.graphql.org/October2021/#sec-Language.Source-Text).
+// Commas, white space and comments are ignored.
+type lexer struct {
+	src string
+	pos int
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || isDigit(c)
+}
+
+func (l *lexer) skipIgnored() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; c {
+		case ' ', '\t', '\n', '\r', ',':
+			l.pos++
+		case '#':
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.pos++
+			}
+		default:
+			if strings.HasPrefix(l.src[l.pos:], unicodeBOM) {
+				l.pos += len(unicodeBOM)
+				continue
+			}
+			return
+		}
+	}
+}
+
+func (l *lexer) next() (token, error) {
+	l.skipIgnored()
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, pos: l.pos}, nil
+	}
+
+	start := l.pos
+	c := l.src[l.pos]
+	switch {
+	case strings.HasPrefix(l.src[l.pos:], "..."):
+		l.pos += len("...")
+		return token{kind: tokenPunctuator, value: "...", pos: start}, nil
+	case strings.IndexByte("!$&():=@[]{}|", c) >= 0:
+		l.pos++
+		return token{kind: tokenPunctuator, value: string(c), pos: start}, nil
+	case isNameStart(c):
+		for l.pos < len(l.src) && isNameContinue(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenName, value: l.src[start:l.pos], pos: start}, nil
+	case c == '-' || isDigit(c):
+		return l.readNumber()
+	case c == '"':
+		if strings.HasPrefix(l.src[l.pos:], `"""`) {
+			return l.readBlockString()
+		}
+		return l.readString()
+	}
+
+	return token{}, fmt.Errorf("unexpected character %q at position %d", c, start)
+}
+
+func (l *lexer) readDigits() int {
+	start := l.pos
+	for l.pos < len(l.src) && isDigit(l.src[l.pos]) {
+		l.pos++
+	}
+	return l.pos - start
+}
+
+func (l *lexer) readNumber() (token, error) {
+	start := l.pos
+	kind := tokenInt
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	if l.readDigits() == 0 {
+		return token{}, fmt.Errorf("invalid number at position %d", start)
+	}
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		kind = tokenFloat
+		l.pos++
+		if l.readDigits() == 0 {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		kind = tokenFloat
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		if l.readDigits() == 0 {
+			return token{}, fmt.Errorf("invalid number at position %d", start)
+		}
+	}
+	if l.pos < len(l.src) && (isNameStart(l.src[l.pos]) || l.src[l.pos] == '.') {
+		return token{}, fmt.Errorf("invalid number at position %d", start)
+	}
+	return token{kind: kind, value: l.src[start:l.pos], pos: start}, nil
+}
+
+// readString reads a quoted string, the escape sequences are kept as is since only the shape of the values is used.
+func (l *lexer) readString() (token, error) {
+	start := l.pos
+	l.pos++
+	for l.pos < len(l.src) {
+		switch l.src[l.pos] {
+		case '"':
+			l.pos++
+			return token{kind: tokenString, value: l.src[start+1 : l.pos-1], pos: start}, nil
+		case '\\':
+			l.pos += 2
+		case '\n', '\r':
+			return token{}, fmt.Errorf("unterminated string at position %d", start)
+		default:
+			l.pos++
+		}
+	}
+	return token{}, fmt.Errorf("unterminated string at position %d", start)
+}
+
+func (l *lexer) readBlockString() (token, error) {
+	start := l.pos
+	l.pos += len(`"""`)
+	for l.pos < len(l.src) {
+		switch {
+		case strings.HasPrefix(l.src[l.pos:], `\"""`):
+			l.pos += len(`\"""`)
+		case strings.HasPrefix(l.src[l.pos:], `"""`):
+			l.pos += len(`"""`)
+			return token{kind: tokenString, value: l.src[start+len(`"""`) : l.pos-len(`"""`)], pos: start}, nil
+		default:
+			l.pos++
+		}
+	}
+	return token{}, fmt.Errorf("unterminated block string at position %d", start)
+}
@@ -0,0 +1,638 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"sort"
+)
+
+type OperationType string
+
+const (
+	OperationTypeQuery        OperationType = "query"
+	OperationTypeMutation     OperationType = "mutation"
+	OperationTypeSubscription OperationType = "subscription"
+)
+
+// Document is an executable GraphQL document: operations and fragments.
+type Document struct {
+	Operations []*Operation
+	Fragments  map[string]*Fragment
+}
+
+type Operation struct {
+	Type                OperationType
+	Name                string
+	VariableDefinitions []*VariableDefinition
+	SelectionSet        []*Selection
+}
+
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	SelectionSet  []*Selection
+}
+
+type VariableDefinition struct {
+	Name string
+	// Type as written in the document, e.g. [ID!]!
+	Type string
+}
+
+type SelectionKind int
+
+const (
+	SelectionKindField SelectionKind = iota
+	SelectionKindFragmentSpread
+	SelectionKindInlineFragment
+)
+
+type Selection struct {
+	Kind SelectionKind
+	// Alias of a field
+	Alias string
+	// Name of a field, or of the fragment of a fragment spread
+	Name      string
+	Arguments []*Argument
+	// TypeCondition of an inline fragment, empty if not set
+	TypeCondition string
+	SelectionSet  []*Selection
+}
+
+// ResponseKey is the key of the field in the response data.
+func (s *Selection) ResponseKey() string {
+	if s.Alias != "" {
+		return s.Alias
+	}
+	return s.Name
+}
+
+type Argument struct {
+	Name  string
+	Value *Value
+}
+
+type ValueKind int
+
+const (
+	ValueKindVariable ValueKind = iota
+	ValueKindInt
+	ValueKindFloat
+	ValueKindString
+	ValueKindBoolean
+	ValueKindNull
+	ValueKindEnum
+	ValueKindList
+	ValueKindObject
+)
+
+type Value struct {
+	Kind ValueKind
+	// Raw is the literal, or the name of the variable
+	Raw  string
+	List []*Value
+}
+
+// Parse parses an executable GraphQL document (https://spec.graphql.org/October2021/#sec-Document).
+// Type system definitions are not supported.
+func Parse(query string) (*Document, error) {
+	p := &parser{lexer: lexer{src: query}}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	doc := &Document{
+		Fragments: map[string]*Fragment{},
+	}
+	for p.tok.kind != tokenEOF {
+		switch {
+		case p.peekPunctuator("{"):
+			selectionSet, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, &Operation{Type: OperationTypeQuery, SelectionSet: selectionSet})
+		case p.peekName("query"), p.peekName("mutation"), p.peekName("subscription"):
+			operation, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, operation)
+		case p.peekName("fragment"):
+			fragment, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			doc.Fragments[fragment.Name] = fragment
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	if len(doc.Operations) == 0 {
+		return nil, fmt.Errorf("no operation in document")
+	}
+
+	return doc, nil
+}
+
+// Operation returns the operation to execute, as selected by the operation name of the request.
+func (d *Document) Operation(operationName string) (*Operation, error) {
+	if operationName == "" {
+		if len(d.Operations) != 1 {
+			return nil, fmt.Errorf("operation name is required for a document with %d operations", len(d.Operations))
+		}
+		return d.Operations[0], nil
+	}
+
+	for _, operation := range d.Operations {
+		if operation.Name == operationName {
+			return operation, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown operation %q", operationName)
+}
+
+// RootFields returns the sorted names of the root fields selected by the operation, through fragments included.
+func (d *Document) RootFields(operation *Operation) []string {
+	names := map[string]bool{}
+	d.walkFields(operation.SelectionSet, "", map[string]bool{}, func(field *Selection, _ string) {
+		names[field.Name] = true
+	})
+
+	ret := make([]string, 0, len(names))
+	for name := range names {
+		ret = append(ret, name)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// walkFields calls f for each field of the selection set, fragment spreads and inline fragments are expanded.
+// The type condition of the innermost fragment is passed to f, or typeCondition if none.
+func (d *Document) walkFields(selectionSet []*Selection, typeCondition string, visitedFragments map[string]bool, f func(field *Selection, typeCondition string)) {
+	for _, selection := range selectionSet {
+		switch selection.Kind {
+		case SelectionKindField:
+			f(selection, typeCondition)
+		case SelectionKindFragmentSpread:
+			fragment, ok := d.Fragments[selection.Name]
+			// cycles are invalid, but must not hang the traces handling, and chains of spreads are bounded like the
+			// nesting of the selection sets
+			if !ok || visitedFragments[selection.Name] || len(visitedFragments) >= maxDepth {
+				continue
+			}
+			visitedFragments[selection.Name] = true
+			d.walkFields(fragment.SelectionSet, fragment.TypeCondition, visitedFragments, f)
+			delete(visitedFragments, selection.Name)
+		case SelectionKindInlineFragment:
+			condition := typeCondition
+			if selection.TypeCondition != "" {
+				condition = selection.TypeCondition
+			}
+			d.walkFields(selection.SelectionSet, condition, visitedFragments, f)
+		}
+	}
+}
+
+// maxDepth bounds the nesting of the selection sets, values and types, the parser recursing on each level.
+const maxDepth = 64
+
+type parser struct {
+	lexer lexer
+	tok   token
+	depth int
+}
+
+func (p *parser) advance() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+// enter increments the nesting depth, it fails past maxDepth. Each call must be followed by a call to leave.
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxDepth {
+		return fmt.Errorf("nesting deeper than %d at position %d", maxDepth, p.tok.pos)
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+func (p *parser) unexpected() error {
+	if p.tok.kind == tokenEOF {
+		return fmt.Errorf("unexpected end of document")
+	}
+	return fmt.Errorf("unexpected %q at position %d", p.tok.value, p.tok.pos)
+}
+
+func (p *parser) peekPunctuator(value string) bool {
+	return p.tok.kind == tokenPunctuator && p.tok.value == value
+}
+
+func (p *parser) peekName(value string) bool {
+	return p.tok.kind == tokenName && p.tok.value == value
+}
+
+func (p *parser) expectPunctuator(value string) error {
+	if !p.peekPunctuator(value) {
+		return p.unexpected()
+	}
+	return p.advance()
+}
+
+func (p *parser) expectName() (string, error) {
+	if p.tok.kind != tokenName {
+		return "", p.unexpected()
+	}
+	name := p.tok.value
+	return name, p.advance()
+}
+
+func (p *parser) parseOperation() (*Operation, error) {
+	operation := &Operation{Type: OperationType(p.tok.value)}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	if p.tok.kind == tokenName {
+		operation.Name = p.tok.value
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.peekPunctuator("(") {
+		variableDefinitions, err := p.parseVariableDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		operation.VariableDefinitions = variableDefinitions
+	}
+
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	operation.SelectionSet = selectionSet
+
+	return operation, nil
+}
+
+func (p *parser) parseFragment() (*Fragment, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if !p.peekName("on") {
+		return nil, p.unexpected()
+	}
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+	typeCondition, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Fragment{
+		Name:          name,
+		TypeCondition: typeCondition,
+		SelectionSet:  selectionSet,
+	}, nil
+}
+
+func (p *parser) parseVariableDefinitions() ([]*VariableDefinition, error) {
+	if err := p.expectPunctuator("("); err != nil {
+		return nil, err
+	}
+
+	var ret []*VariableDefinition
+	for !p.peekPunctuator(")") {
+		if err := p.expectPunctuator("$"); err != nil {
+			return nil, err
+		}
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunctuator(":"); err != nil {
+			return nil, err
+		}
+		typ, err := p.parseType()
+		if err != nil {
+			return nil, err
+		}
+		if p.peekPunctuator("=") {
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			if _, err := p.parseValue(); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.parseDirectives(); err != nil {
+			return nil, err
+		}
+		ret = append(ret, &VariableDefinition{Name: name, Type: typ})
+	}
+
+	return ret, p.advance()
+}
+
+func (p *parser) parseType() (string, error) {
+	if err := p.enter(); err != nil {
+		return "", err
+	}
+	defer p.leave()
+
+	var typ string
+	if p.peekPunctuator("[") {
+		if err := p.advance(); err != nil {
+			return "", err
+		}
+		itemType, err := p.parseType()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expectPunctuator("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + itemType + "]"
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return "", err
+		}
+		typ = name
+	}
+
+	if p.peekPunctuator("!") {
+		if err := p.advance(); err != nil {
+			return "", err
+		}
+		typ += "!"
+	}
+	return typ, nil
+}
+
+func (p *parser) parseDirectives() error {
+	for p.peekPunctuator("@") {
+		if err := p.advance(); err != nil {
+			return err
+		}
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if p.peekPunctuator("(") {
+			if _, err := p.parseArguments(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (p *parser) parseSelectionSet() ([]*Selection, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	if err := p.expectPunctuator("{"); err != nil {
+		return nil, err
+	}
+
+	var ret []*Selection
+	for !p.peekPunctuator("}") {
+		selection, err := p.parseSelection()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, selection)
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("empty selection set at position %d", p.tok.pos)
+	}
+
+	return ret, p.advance()
+}
+
+func (p *parser) parseSelection() (*Selection, error) {
+	if p.peekPunctuator("...") {
+		return p.parseFragmentSelection()
+	}
+
+	selection := &Selection{Kind: SelectionKindField}
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if p.peekPunctuator(":") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		selection.Alias = name
+		if name, err = p.expectName(); err != nil {
+			return nil, err
+		}
+	}
+	selection.Name = name
+
+	if p.peekPunctuator("(") {
+		if selection.Arguments, err = p.parseArguments(); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	if p.peekPunctuator("{") {
+		if selection.SelectionSet, err = p.parseSelectionSet(); err != nil {
+			return nil, err
+		}
+	}
+
+	return selection, nil
+}
+
+func (p *parser) parseFragmentSelection() (*Selection, error) {
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	// fragment spread
+	if p.tok.kind == tokenName && !p.peekName("on") {
+		selection := &Selection{Kind: SelectionKindFragmentSpread, Name: p.tok.value}
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		return selection, p.parseDirectives()
+	}
+
+	selection := &Selection{Kind: SelectionKindInlineFragment}
+	if p.peekName("on") {
+		if err := p.advance(); err != nil {
+			return nil, err
+		}
+		typeCondition, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		selection.TypeCondition = typeCondition
+	}
+	if err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	selectionSet, err := p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	selection.SelectionSet = selectionSet
+
+	return selection, nil
+}
+
+func (p *parser) parseArguments() ([]*Argument, error) {
+	if err := p.expectPunctuator("("); err != nil {
+		return nil, err
+	}
+
+	var ret []*Argument
+	for !p.peekPunctuator(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunctuator(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		ret = append(ret, &Argument{Name: name, Value: value})
+	}
+
+	return ret, p.advance()
+}
+
+func (p *parser) parseValue() (*Value, error) {
+	tok := p.tok
+	switch tok.kind {
+	case tokenInt:
+		return &Value{Kind: ValueKindInt, Raw: tok.value}, p.advance()
+	case tokenFloat:
+		return &Value{Kind: ValueKindFloat, Raw: tok.value}, p.advance()
+	case tokenString:
+		return &Value{Kind: ValueKindString, Raw: tok.value}, p.advance()
+	case tokenName:
+		kind := ValueKindEnum
+		switch tok.value {
+		case "true", "false":
+			kind = ValueKindBoolean
+		case "null":
+			kind = ValueKindNull
+		}
+		return &Value{Kind: kind, Raw: tok.value}, p.advance()
+	case tokenPunctuator:
+		switch tok.value {
+		case "$":
+			if err := p.advance(); err != nil {
+				return nil, err
+			}
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			return &Value{Kind: ValueKindVariable, Raw: name}, nil
+		case "[":
+			return p.parseListValue()
+		case "{":
+			return p.parseObjectValue()
+		}
+	case tokenEOF:
+	}
+
+	return nil, p.unexpected()
+}
+
+func (p *parser) parseListValue() (*Value, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	value := &Value{Kind: ValueKindList}
+	for !p.peekPunctuator("]") {
+		item, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		value.List = append(value.List, item)
+	}
+	return value, p.advance()
+}
+
+func (p *parser) parseObjectValue() (*Value, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	if err := p.advance(); err != nil {
+		return nil, err
+	}
+
+	for !p.peekPunctuator("}") {
+		if _, err := p.expectName(); err != nil {
+			return nil, err
+		}
+		if err := p.expectPunctuator(":"); err != nil {
+			return nil, err
+		}
+		if _, err := p.parseValue(); err != nil {
+			return nil, err
+		}
+	}
+	return &Value{Kind: ValueKindObject}, p.advance()
+}
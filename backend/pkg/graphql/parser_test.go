@@ -0,0 +1,143 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestParse(t *testing.T) {
+	query := `
+# comment
+query GetUser($id: ID!, $first: Int = 10, $tags: [String!]) @cached(ttl: 60) {
+  me: user(id: $id) {
+    name
+    friends(first: $first, filter: {tags: $tags, active: true}) { ...UserFields }
+  }
+  ... on Query { version }
+  ... @include(if: true) { posts(order: DESC, ids: [1, 2]) { title } }
+}
+
+mutation { addUser(name: "a \" b", score: -1.5e3, text: """block "" string""") { id } }
+
+fragment UserFields on User { id, name }
+`
+	doc, err := Parse(query)
+	assert.NilError(t, err)
+	assert.Equal(t, len(doc.Operations), 2)
+	assert.Equal(t, len(doc.Fragments), 1)
+
+	getUser := doc.Operations[0]
+	assert.Equal(t, getUser.Type, OperationTypeQuery)
+	assert.Equal(t, getUser.Name, "GetUser")
+	assert.DeepEqual(t, getUser.VariableDefinitions, []*VariableDefinition{
+		{Name: "id", Type: "ID!"},
+		{Name: "first", Type: "Int"},
+		{Name: "tags", Type: "[String!]"},
+	})
+	me := getUser.SelectionSet[0]
+	assert.Equal(t, me.Alias, "me")
+	assert.Equal(t, me.Name, "user")
+	assert.Equal(t, me.ResponseKey(), "me")
+	assert.Equal(t, me.Arguments[0].Value.Kind, ValueKindVariable)
+	assert.Equal(t, me.SelectionSet[1].SelectionSet[0].Kind, SelectionKindFragmentSpread)
+	assert.DeepEqual(t, doc.RootFields(getUser), []string{"posts", "user", "version"})
+
+	addUser := doc.Operations[1]
+	assert.Equal(t, addUser.Type, OperationTypeMutation)
+	assert.Equal(t, addUser.Name, "")
+	assert.Equal(t, addUser.SelectionSet[0].Arguments[1].Value.Kind, ValueKindFloat)
+	assert.Equal(t, addUser.SelectionSet[0].Arguments[2].Value.Raw, `block "" string`)
+}
+
+func TestParse_shorthand(t *testing.T) {
+	doc, err := Parse(`{ a b { c } }`)
+	assert.NilError(t, err)
+	operation, err := doc.Operation("")
+	assert.NilError(t, err)
+	assert.Equal(t, operation.Type, OperationTypeQuery)
+	assert.DeepEqual(t, doc.RootFields(operation), []string{"a", "b"})
+}
+
+func TestParse_errors(t *testing.T) {
+	for _, query := range []string{
+		``,
+		`fragment F on User { id }`,
+		`{ }`,
+		`{ a(`,
+		`query Q { a } }`,
+		`{ a(x: "unterminated) }`,
+		`{ a(x: 1.) }`,
+		`type Query { a: String }`,
+		`{"query": "{ a }"}`,
+	} {
+		_, err := Parse(query)
+		assert.Assert(t, err != nil, query)
+	}
+}
+
+func TestDocument_Operation(t *testing.T) {
+	doc, err := Parse(`query A { a } query B { b }`)
+	assert.NilError(t, err)
+
+	_, err = doc.Operation("")
+	assert.ErrorContains(t, err, "operation name is required")
+	_, err = doc.Operation("C")
+	assert.ErrorContains(t, err, "unknown operation")
+	operation, err := doc.Operation("B")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, doc.RootFields(operation), []string{"b"})
+}
+
+func TestDocument_RootFields_fragmentCycle(t *testing.T) {
+	doc, err := Parse(`{ ...A } fragment A on Query { a ...B } fragment B on Query { b ...A }`)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, doc.RootFields(doc.Operations[0]), []string{"a", "b"})
+}
+
+func TestParse_maxDepth(t *testing.T) {
+	nested := func(depth int, open, close string) string {
+		return strings.Repeat(open, depth) + strings.Repeat(close, depth)
+	}
+
+	_, err := Parse(nested(maxDepth, "{ a ", "}"))
+	assert.NilError(t, err)
+	for _, query := range []string{
+		nested(100000, "{ a ", "}"),
+		"{ a(x: " + nested(100000, "[", "]") + ") }",
+		"{ a(x: " + nested(100000, "{x: ", "}") + ") }",
+		"query Q($x: " + nested(100000, "[", "]") + ") { a }",
+		nested(100000, "{ ... on Query ", "}"),
+	} {
+		_, err := Parse(query)
+		assert.ErrorContains(t, err, "nesting deeper than")
+	}
+}
+
+func TestDocument_RootFields_fragmentChain(t *testing.T) {
+	var b strings.Builder
+	b.WriteString("{ ...F0 }")
+	for i := 0; i < 100000; i++ {
+		fmt.Fprintf(&b, " fragment F%d on Query { f%d ...F%d }", i, i, i+1)
+	}
+	doc, err := Parse(b.String())
+	assert.NilError(t, err)
+	assert.Equal(t, len(doc.RootFields(doc.Operations[0])), maxDepth)
+}
@@ -0,0 +1,126 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	_spec "github.com/openclarity/speculator/pkg/spec"
+	"github.com/openclarity/speculator/pkg/utils"
+)
+
+const (
+	contentTypeHeaderName  = "content-type"
+	mediaTypeGraphQL       = "application/graphql"
+	queryParamName         = "query"
+	operationNameParamName = "operationName"
+)
+
+// Request is a GraphQL over HTTP request (https://graphql.github.io/graphql-over-http/).
+type Request struct {
+	Query         string `json:"query"`
+	OperationName string `json:"operationName"`
+}
+
+// Call is a detected GraphQL operation execution.
+type Call struct {
+	Document   *Document
+	Operation  *Operation
+	RootFields []string
+}
+
+// Detect returns the GraphQL operation executed by the telemetry, or nil if the telemetry is not a GraphQL request.
+// Persisted queries, sent without the query document, and batched requests are not detected.
+func Detect(telemetry *_spec.Telemetry) *Call {
+	request := RequestFromTelemetry(telemetry)
+	if request == nil {
+		return nil
+	}
+
+	call, err := request.Parse()
+	if err != nil {
+		return nil
+	}
+	return call
+}
+
+// RequestFromTelemetry extracts the GraphQL request from a GET query string, a JSON body or an application/graphql body.
+func RequestFromTelemetry(telemetry *_spec.Telemetry) *Request {
+	_, rawQuery := _spec.GetPathAndQuery(telemetry.Request.Path)
+	queryParams, _ := url.ParseQuery(rawQuery)
+
+	switch strings.ToUpper(telemetry.Request.Method) {
+	case http.MethodGet:
+		if query := queryParams.Get(queryParamName); query != "" {
+			return &Request{
+				Query:         query,
+				OperationName: queryParams.Get(operationNameParamName),
+			}
+		}
+	case http.MethodPost:
+		body := telemetry.Request.Common.Body
+		if len(body) == 0 || telemetry.Request.Common.TruncatedBody {
+			return nil
+		}
+		mediaType, _, err := mime.ParseMediaType(_spec.ConvertHeadersToMap(telemetry.Request.Common.Headers)[contentTypeHeaderName])
+		if err != nil {
+			return nil
+		}
+		switch {
+		case mediaType == mediaTypeGraphQL:
+			return &Request{
+				Query:         string(body),
+				OperationName: queryParams.Get(operationNameParamName),
+			}
+		case utils.IsApplicationJSONMediaType(mediaType):
+			// avoid decoding the bodies of all the JSON APIs
+			if !bytes.Contains(body, []byte(`"query"`)) {
+				return nil
+			}
+			var request Request
+			if err := json.Unmarshal(body, &request); err != nil || request.Query == "" {
+				return nil
+			}
+			return &request
+		}
+	}
+
+	return nil
+}
+
+// Parse parses the query document and selects the operation to execute.
+func (r *Request) Parse() (*Call, error) {
+	doc, err := Parse(r.Query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse query: %v", err)
+	}
+	operation, err := doc.Operation(r.OperationName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Call{
+		Document:   doc,
+		Operation:  operation,
+		RootFields: doc.RootFields(operation),
+	}, nil
+}
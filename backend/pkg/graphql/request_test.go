@@ -0,0 +1,105 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"testing"
+
+	_spec "github.com/openclarity/speculator/pkg/spec"
+	"gotest.tools/assert"
+)
+
+func newTelemetry(method, path, contentType, body string, truncated bool) *_spec.Telemetry {
+	var headers []*_spec.Header
+	if contentType != "" {
+		headers = append(headers, &_spec.Header{Key: "Content-Type", Value: contentType})
+	}
+	return &_spec.Telemetry{
+		Request: &_spec.Request{
+			Method: method,
+			Path:   path,
+			Common: &_spec.Common{
+				Headers:       headers,
+				Body:          []byte(body),
+				TruncatedBody: truncated,
+			},
+		},
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		name           string
+		telemetry      *_spec.Telemetry
+		wantType       OperationType
+		wantName       string
+		wantRootFields []string
+	}{
+		{
+			name:           "GET",
+			telemetry:      newTelemetry("GET", "/graphql?query=query%20Q%20%7B%20a%20%7D&operationName=Q", "", "", false),
+			wantType:       OperationTypeQuery,
+			wantName:       "Q",
+			wantRootFields: []string{"a"},
+		},
+		{
+			name:           "POST JSON",
+			telemetry:      newTelemetry("POST", "/graphql", "application/json; charset=utf-8", `{"query": "query A { a } mutation B { b c }", "operationName": "B", "variables": {}}`, false),
+			wantType:       OperationTypeMutation,
+			wantName:       "B",
+			wantRootFields: []string{"b", "c"},
+		},
+		{
+			name:           "POST application/graphql",
+			telemetry:      newTelemetry("POST", "/graphql", "application/graphql", `subscription { s }`, false),
+			wantType:       OperationTypeSubscription,
+			wantRootFields: []string{"s"},
+		},
+		{
+			name:      "POST JSON not GraphQL",
+			telemetry: newTelemetry("POST", "/graphql", "application/json", `{"name": "a"}`, false),
+		},
+		{
+			name:      "POST JSON invalid query",
+			telemetry: newTelemetry("POST", "/graphql", "application/json", `{"query": "SELECT * FROM users"}`, false),
+		},
+		{
+			name:      "POST truncated",
+			telemetry: newTelemetry("POST", "/graphql", "application/json", `{"query": "{ a }"}`, true),
+		},
+		{
+			name:      "POST persisted query",
+			telemetry: newTelemetry("POST", "/graphql", "application/json", `{"extensions": {"persistedQuery": {"sha256Hash": "abc"}}}`, false),
+		},
+		{
+			name:      "PUT",
+			telemetry: newTelemetry("PUT", "/graphql", "application/json", `{"query": "{ a }"}`, false),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			call := Detect(tt.telemetry)
+			if tt.wantRootFields == nil {
+				assert.Assert(t, call == nil)
+				return
+			}
+			assert.Assert(t, call != nil)
+			assert.Equal(t, call.Operation.Type, tt.wantType)
+			assert.Equal(t, call.Operation.Name, tt.wantName)
+			assert.DeepEqual(t, call.RootFields, tt.wantRootFields)
+		})
+	}
+}
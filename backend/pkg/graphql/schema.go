@@ -0,0 +1,370 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	typenameField = "__typename"
+	// unknownTypeScalar is rendered for the fields and arguments whose type was never observed.
+	unknownTypeScalar = "JSON"
+	// maxLearnedListItems bounds the items of a list value that are walked to learn the fields of its type.
+	maxLearnedListItems = 10
+	// maxLearnedDepth bounds the nesting of the selection sets that are learned.
+	maxLearnedDepth = 32
+	// maxLearnedFields bounds the fields learned from an operation, fragments spread in several fields multiply them.
+	maxLearnedFields = 10000
+)
+
+var rootTypeNames = map[OperationType]string{
+	OperationTypeQuery:        "Query",
+	OperationTypeMutation:     "Mutation",
+	OperationTypeSubscription: "Subscription",
+}
+
+// Schema is a GraphQL schema reconstructed from the observed operations and their responses.
+// Type names come from the __typename of the responses when selected, or are derived from the field names.
+type Schema struct {
+	Types map[string]*ObjectType `json:"types"`
+}
+
+type ObjectType struct {
+	Fields map[string]*Field `json:"fields"`
+}
+
+type Field struct {
+	// Type in SDL notation, empty if unknown
+	Type string `json:"type,omitempty"`
+	// Arguments types by name, empty if unknown
+	Arguments map[string]string `json:"arguments,omitempty"`
+}
+
+func NewSchema() *Schema {
+	return &Schema{
+		Types: map[string]*ObjectType{},
+	}
+}
+
+type response struct {
+	Data map[string]interface{} `json:"data"`
+}
+
+// Learn merges the operation and the data of its response into the schema. It returns whether the schema was changed.
+// The response body may be empty or invalid (truncated), only the fields and arguments are learned in that case.
+func (s *Schema) Learn(doc *Document, operation *Operation, responseBody []byte) bool {
+	var resp response
+	decoder := json.NewDecoder(bytes.NewReader(responseBody))
+	decoder.UseNumber()
+	if err := decoder.Decode(&resp); err != nil {
+		resp.Data = nil
+	}
+
+	variableTypes := make(map[string]string, len(operation.VariableDefinitions))
+	for _, variable := range operation.VariableDefinitions {
+		variableTypes[variable.Name] = variable.Type
+	}
+
+	l := &learner{
+		schema:           s,
+		doc:              doc,
+		variableTypes:    variableTypes,
+		visitedFragments: map[string]bool{},
+	}
+	l.learnSelectionSet(rootTypeNames[operation.Type], operation.SelectionSet, resp.Data, true, 0)
+	return l.changed
+}
+
+type learner struct {
+	schema        *Schema
+	doc           *Document
+	variableTypes map[string]string
+	// visitedFragments are the fragments spread by the selection sets being learned, shared by the nested
+	// selection sets so that a fragment spreading itself through a field is not expanded again.
+	visitedFragments map[string]bool
+	learnedFields    int
+	changed          bool
+}
+
+func (l *learner) learnSelectionSet(typeName string, selectionSet []*Selection, data map[string]interface{}, hasData bool, depth int) {
+	if depth > maxLearnedDepth {
+		return
+	}
+	dataTypename, _ := data[typenameField].(string)
+
+	l.doc.walkFields(selectionSet, "", l.visitedFragments, func(field *Selection, typeCondition string) {
+		if field.Name == typenameField || l.learnedFields >= maxLearnedFields {
+			return
+		}
+		l.learnedFields++
+
+		value, ok := data[field.ResponseKey()]
+		owner := typeName
+		if typeCondition != "" {
+			// fields of a fragment belong to the type of the condition, when the object is of that type
+			if (dataTypename != "" && dataTypename != typeCondition) || (hasData && !ok) {
+				return
+			}
+			owner = typeCondition
+		}
+
+		var fieldType string
+		if len(field.SelectionSet) > 0 {
+			fieldType = l.learnObjectField(owner, field, value, ok, depth)
+		} else {
+			fieldType = scalarType(value)
+		}
+		l.setField(owner, field.Name, fieldType, l.argumentTypes(field.Arguments))
+	})
+}
+
+// learnObjectField learns the type of a field with a selection set and returns its SDL type.
+func (l *learner) learnObjectField(owner string, field *Selection, value interface{}, hasValue bool, depth int) string {
+	var objects []map[string]interface{}
+	var isList bool
+	switch v := value.(type) {
+	case map[string]interface{}:
+		objects = append(objects, v)
+	case []interface{}:
+		isList = true
+		for _, item := range v {
+			if object, ok := item.(map[string]interface{}); ok && len(objects) < maxLearnedListItems {
+				objects = append(objects, object)
+			}
+		}
+	}
+
+	typeName := l.objectTypeName(owner, field, objects)
+	if len(objects) == 0 {
+		// no data (null, error or no response), learn the selected fields only
+		l.learnSelectionSet(typeName, field.SelectionSet, nil, false, depth+1)
+	}
+	for _, object := range objects {
+		l.learnSelectionSet(typeName, field.SelectionSet, object, true, depth+1)
+	}
+
+	if !hasValue || value == nil {
+		// the type is known but not whether it is a list
+		if existing := l.existingFieldType(owner, field.Name); existing != "" {
+			return existing
+		}
+		return typeName
+	}
+	if isList {
+		return "[" + typeName + "]"
+	}
+	return typeName
+}
+
+func (l *learner) objectTypeName(owner string, field *Selection, objects []map[string]interface{}) string {
+	for _, object := range objects {
+		if typename, ok := object[typenameField].(string); ok && typename != "" {
+			return typename
+		}
+	}
+	if existing := l.existingFieldType(owner, field.Name); existing != "" {
+		return strings.Trim(existing, "[]!")
+	}
+	return strings.ToUpper(field.Name[:1]) + field.Name[1:]
+}
+
+func (l *learner) existingFieldType(typeName, fieldName string) string {
+	objectType, ok := l.schema.Types[typeName]
+	if !ok {
+		return ""
+	}
+	field, ok := objectType.Fields[fieldName]
+	if !ok {
+		return ""
+	}
+	return field.Type
+}
+
+func (l *learner) argumentTypes(arguments []*Argument) map[string]string {
+	if len(arguments) == 0 {
+		return nil
+	}
+	ret := make(map[string]string, len(arguments))
+	for _, argument := range arguments {
+		ret[argument.Name] = l.valueType(argument.Value)
+	}
+	return ret
+}
+
+// valueType returns the SDL type of an argument value, or empty if it can't be inferred (enums, input objects, null).
+func (l *learner) valueType(value *Value) string {
+	switch value.Kind {
+	case ValueKindVariable:
+		return l.variableTypes[value.Raw]
+	case ValueKindInt:
+		return "Int"
+	case ValueKindFloat:
+		return "Float"
+	case ValueKindString:
+		return "String"
+	case ValueKindBoolean:
+		return "Boolean"
+	case ValueKindList:
+		for _, item := range value.List {
+			if itemType := l.valueType(item); itemType != "" {
+				return "[" + itemType + "]"
+			}
+		}
+	case ValueKindNull, ValueKindEnum, ValueKindObject:
+	}
+	return ""
+}
+
+func (l *learner) setField(typeName, fieldName, fieldType string, arguments map[string]string) {
+	objectType, ok := l.schema.Types[typeName]
+	if !ok {
+		objectType = &ObjectType{Fields: map[string]*Field{}}
+		l.schema.Types[typeName] = objectType
+		l.changed = true
+	}
+
+	field, ok := objectType.Fields[fieldName]
+	if !ok {
+		field = &Field{}
+		objectType.Fields[fieldName] = field
+		l.changed = true
+	}
+	if mergedType := mergeTypes(field.Type, fieldType); mergedType != field.Type {
+		field.Type = mergedType
+		l.changed = true
+	}
+
+	for name, argumentType := range arguments {
+		existing, ok := field.Arguments[name]
+		if ok && mergeTypes(existing, argumentType) == existing {
+			continue
+		}
+		if field.Arguments == nil {
+			field.Arguments = map[string]string{}
+		}
+		field.Arguments[name] = mergeTypes(existing, argumentType)
+		l.changed = true
+	}
+}
+
+// mergeTypes keeps the first known type, except for integers that are widened to floats.
+func mergeTypes(existing, observed string) string {
+	switch {
+	case existing == "":
+		return observed
+	case observed == "":
+		return existing
+	case strings.Replace(existing, "Int", "Float", 1) == observed:
+		return observed
+	}
+	return existing
+}
+
+func scalarType(value interface{}) string {
+	switch v := value.(type) {
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return "Float"
+		}
+		return "Int"
+	case string:
+		return "String"
+	case bool:
+		return "Boolean"
+	case []interface{}:
+		for _, item := range v {
+			if itemType := scalarType(item); itemType != "" {
+				return "[" + itemType + "]"
+			}
+		}
+	case map[string]interface{}:
+		// custom scalar with an object value
+		return unknownTypeScalar
+	}
+	return ""
+}
+
+// SDL renders the schema in the GraphQL schema definition language. Root types come first.
+func (s *Schema) SDL() string {
+	typeNames := make([]string, 0, len(s.Types))
+	for name := range s.Types {
+		typeNames = append(typeNames, name)
+	}
+	rootTypeOrder := map[string]int{"Query": 1, "Mutation": 2, "Subscription": 3} //nolint:gomnd
+	sort.Slice(typeNames, func(i, j int) bool {
+		oi, oj := rootTypeOrder[typeNames[i]], rootTypeOrder[typeNames[j]]
+		if oi != oj {
+			if oi == 0 || oj == 0 {
+				return oj == 0
+			}
+			return oi < oj
+		}
+		return typeNames[i] < typeNames[j]
+	})
+
+	var hasUnknownTypes bool
+	typeOrUnknown := func(typ string) string {
+		if typ == "" {
+			typ = unknownTypeScalar
+		}
+		if strings.Trim(typ, "[]!") == unknownTypeScalar {
+			hasUnknownTypes = true
+		}
+		return typ
+	}
+
+	var types []string
+	for _, typeName := range typeNames {
+		objectType := s.Types[typeName]
+		fieldNames := make([]string, 0, len(objectType.Fields))
+		for name := range objectType.Fields {
+			fieldNames = append(fieldNames, name)
+		}
+		sort.Strings(fieldNames)
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "type %s {\n", typeName)
+		for _, fieldName := range fieldNames {
+			field := objectType.Fields[fieldName]
+			fmt.Fprintf(&b, "  %s", fieldName)
+			if len(field.Arguments) > 0 {
+				argumentNames := make([]string, 0, len(field.Arguments))
+				for name := range field.Arguments {
+					argumentNames = append(argumentNames, name)
+				}
+				sort.Strings(argumentNames)
+				arguments := make([]string, 0, len(argumentNames))
+				for _, name := range argumentNames {
+					arguments = append(arguments, name+": "+typeOrUnknown(field.Arguments[name]))
+				}
+				fmt.Fprintf(&b, "(%s)", strings.Join(arguments, ", "))
+			}
+			fmt.Fprintf(&b, ": %s\n", typeOrUnknown(field.Type))
+		}
+		b.WriteString("}\n")
+		types = append(types, b.String())
+	}
+
+	if hasUnknownTypes {
+		types = append([]string{"scalar " + unknownTypeScalar + "\n"}, types...)
+	}
+	return strings.Join(types, "\n")
+}
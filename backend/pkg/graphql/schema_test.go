@@ -0,0 +1,124 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package graphql
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func learn(t *testing.T, schema *Schema, query string, responseBody string) bool {
+	t.Helper()
+	call, err := (&Request{Query: query}).Parse()
+	assert.NilError(t, err)
+	return schema.Learn(call.Document, call.Operation, []byte(responseBody))
+}
+
+func TestSchema_Learn(t *testing.T) {
+	schema := NewSchema()
+
+	changed := learn(t, schema,
+		`query ($id: ID!) { user(id: $id) { __typename name age friends { name } ... on Admin { level } } }`,
+		`{"data": {"user": {"__typename": "Admin", "name": "a", "age": 30, "friends": [{"name": "b"}], "level": 1}}}`)
+	assert.Assert(t, changed)
+
+	// same operation, no change
+	changed = learn(t, schema,
+		`query ($id: ID!) { user(id: $id) { __typename name age friends { name } ... on Admin { level } } }`,
+		`{"data": {"user": {"__typename": "Admin", "name": "c", "age": 31, "friends": [], "level": 2}}}`)
+	assert.Assert(t, !changed)
+
+	// int widened to float, new mutation learned without response
+	changed = learn(t, schema, `{ user(id: "1") { age } }`, `{"data": {"user": {"age": 30.5}}}`)
+	assert.Assert(t, changed)
+	changed = learn(t, schema, `mutation { deleteUser(id: 1, mode: SOFT) { ok } }`, ``)
+	assert.Assert(t, changed)
+
+	assert.Equal(t, schema.SDL(), `scalar JSON
+
+type Query {
+  user(id: ID!): Admin
+}
+
+type Mutation {
+  deleteUser(id: Int, mode: JSON): DeleteUser
+}
+
+type Admin {
+  age: Float
+  friends: [Friends]
+  level: Int
+  name: String
+}
+
+type DeleteUser {
+  ok: JSON
+}
+
+type Friends {
+  name: String
+}
+`)
+}
+
+func TestSchema_Learn_fragmentOnOtherType(t *testing.T) {
+	schema := NewSchema()
+	learn(t, schema,
+		`{ search { __typename ... on User { name } ... on Post { title } } }`,
+		`{"data": {"search": [{"__typename": "User", "name": "a"}, {"__typename": "Post", "title": "b"}]}}`)
+
+	assert.Equal(t, schema.SDL(), `type Query {
+  search: [User]
+}
+
+type Post {
+  title: String
+}
+
+type User {
+  name: String
+}
+`)
+}
+
+func TestSchema_Learn_fragmentCycle(t *testing.T) {
+	schema := NewSchema()
+	learn(t, schema, `query { a { ...F } } fragment F on A { a { ...F } }`, `{"data": {"a": {"a": {"a": null}}}}`)
+
+	assert.Equal(t, schema.SDL(), `type Query {
+  a: A
+}
+
+type A {
+  a: A
+}
+`)
+}
+
+func TestSchema_Learn_fragmentsFanOut(t *testing.T) {
+	// each fragment spreads the next one twice, the fields expanded grow exponentially with the fragments
+	query := `{ ...F0 }`
+	for i := 0; i < 40; i++ {
+		query += fmt.Sprintf(" fragment F%d on Query { a { ...F%d } b { ...F%d } }", i, i+1, i+1)
+	}
+	query += " fragment F40 on Query { c }"
+
+	schema := NewSchema()
+	assert.Assert(t, learn(t, schema, query, `{"data": {"a": null, "b": null}}`))
+	assert.Assert(t, len(schema.Types) > 0)
+}
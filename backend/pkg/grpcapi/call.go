@@ -0,0 +1,142 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/openclarity/apiclarity/plugins/api/grpcutils"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+const (
+	contentTypeHeaderName = "content-type"
+	statusHeaderName      = "grpc-status"
+	messageHeaderName     = "grpc-message"
+	encodingHeaderName    = "grpc-encoding"
+
+	gzipEncoding = "gzip"
+
+	compressedFlag = 1
+
+	// maxDecompressedSize bounds the size of the decompressed messages of a body, as the default max message size of
+	// gRPC servers, so that a small compressed body can't expand without limit.
+	maxDecompressedSize = 4 << 20
+)
+
+// Detect returns the gRPC call of a trace, or nil if the trace is not a gRPC call.
+// The details set by the trace source are used when present, otherwise they are taken from the request path,
+// the headers and the bodies (the trailers are not always captured, the status is OK if missing).
+func Detect(trace *pluginsmodels.Telemetry) *pluginsmodels.GrpcTelemetry {
+	if trace.Grpc != nil && trace.Grpc.Service != "" {
+		return trace.Grpc
+	}
+	if trace.Request == nil || trace.Request.Common == nil {
+		return nil
+	}
+
+	if !grpcutils.IsGRPCMediaType(getHeader(trace.Request.Common.Headers, contentTypeHeaderName)) {
+		return nil
+	}
+	service, method, ok := grpcutils.SplitPath(trace.Request.Path)
+	if !ok {
+		return nil
+	}
+
+	ret := &pluginsmodels.GrpcTelemetry{
+		Service: service,
+		Method:  method,
+	}
+	ret.RequestMessagesCount, ret.RequestMessagesSize = grpcutils.MessagesCountAndSize(trace.Request.Common.Body)
+	if trace.Response != nil && trace.Response.Common != nil {
+		headers := trace.Response.Common.Headers
+		if status, err := strconv.ParseInt(getHeader(headers, statusHeaderName), 10, 32); err == nil {
+			ret.Status = int32(status)
+		}
+		if message, err := url.PathUnescape(getHeader(headers, messageHeaderName)); err == nil {
+			ret.StatusMessage = message
+		}
+		ret.ResponseMessagesCount, ret.ResponseMessagesSize = grpcutils.MessagesCountAndSize(trace.Response.Common.Body)
+	}
+
+	return ret
+}
+
+// SplitMessages returns the length-prefixed messages of a gRPC body, decompressed with the message encoding
+// (grpc-encoding header). A message cut by a body truncation is dropped.
+func SplitMessages(body []byte, encoding string) ([][]byte, error) {
+	var messages [][]byte
+	decompressedSize := 0
+	for len(body) >= grpcutils.MessagePrefixLength {
+		length := binary.BigEndian.Uint32(body[1:grpcutils.MessagePrefixLength])
+		if uint64(len(body)-grpcutils.MessagePrefixLength) < uint64(length) {
+			break
+		}
+		message := body[grpcutils.MessagePrefixLength : grpcutils.MessagePrefixLength+int(length)]
+		if body[0] == compressedFlag {
+			var err error
+			if message, err = decompress(message, encoding, maxDecompressedSize-decompressedSize); err != nil {
+				return nil, err
+			}
+			decompressedSize += len(message)
+		}
+		messages = append(messages, message)
+		body = body[grpcutils.MessagePrefixLength+int(length):]
+	}
+	return messages, nil
+}
+
+// MessagesEncoding returns the value of the grpc-encoding header.
+func MessagesEncoding(headers []*pluginsmodels.Header) string {
+	return getHeader(headers, encodingHeaderName)
+}
+
+// decompress returns the decompressed message, an error is returned if it's larger than maxSize.
+func decompress(message []byte, encoding string, maxSize int) ([]byte, error) {
+	if encoding != gzipEncoding {
+		return nil, fmt.Errorf("unsupported message encoding %q", encoding)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(message))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message: %v", err)
+	}
+	defer reader.Close()
+	// read one more byte than allowed to tell a message of the max size from a larger one
+	ret, err := io.ReadAll(io.LimitReader(reader, int64(maxSize)+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress message: %v", err)
+	}
+	if len(ret) > maxSize {
+		return nil, fmt.Errorf("decompressed messages exceed the max size of %d bytes", maxDecompressedSize)
+	}
+	return ret, nil
+}
+
+func getHeader(headers []*pluginsmodels.Header, name string) string {
+	for _, header := range headers {
+		if strings.EqualFold(header.Key, name) {
+			return header.Value
+		}
+	}
+	return ""
+}
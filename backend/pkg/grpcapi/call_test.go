@@ -0,0 +1,116 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"gotest.tools/assert"
+
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func frame(compressed bool, message []byte) []byte {
+	var flag byte
+	if compressed {
+		flag = compressedFlag
+	}
+	length := len(message)
+	return append([]byte{flag, byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}, message...)
+}
+
+func TestDetect(t *testing.T) {
+	newTrace := func(contentType, path string) *pluginsmodels.Telemetry {
+		return &pluginsmodels.Telemetry{
+			Request: &pluginsmodels.Request{
+				Method: "POST",
+				Path:   path,
+				Common: &pluginsmodels.Common{
+					Headers: []*pluginsmodels.Header{{Key: "Content-Type", Value: contentType}},
+					Body:    frame(false, []byte("abc")),
+				},
+			},
+			Response: &pluginsmodels.Response{
+				StatusCode: "200",
+				Common: &pluginsmodels.Common{
+					Headers: []*pluginsmodels.Header{{Key: "grpc-status", Value: "14"}, {Key: "grpc-message", Value: "no%20backend"}},
+					Body:    append(frame(false, []byte("de")), frame(false, []byte("fgh"))[:6]...),
+				},
+			},
+		}
+	}
+
+	assert.DeepEqual(t, Detect(newTrace("application/grpc", "/users.v1.Users/ListUsers")), &pluginsmodels.GrpcTelemetry{
+		Service:               "users.v1.Users",
+		Method:                "ListUsers",
+		Status:                14,
+		StatusMessage:         "no backend",
+		RequestMessagesCount:  1,
+		RequestMessagesSize:   3,
+		ResponseMessagesCount: 2,
+		ResponseMessagesSize:  5,
+	})
+	assert.Equal(t, Detect(newTrace("application/grpc+proto", "/users.v1.Users/GetUser")).Method, "GetUser")
+	assert.Assert(t, Detect(newTrace("application/json", "/users.v1.Users/GetUser")) == nil)
+	assert.Assert(t, Detect(newTrace("application/grpc", "/users/v1/GetUser")) == nil)
+
+	// details set by the trace source
+	trace := newTrace("application/json", "/")
+	trace.Grpc = &pluginsmodels.GrpcTelemetry{Service: "users.v1.Users", Method: "GetUser"}
+	assert.Equal(t, Detect(trace), trace.Grpc)
+}
+
+func TestSplitMessages(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	_, err := writer.Write([]byte("compressed"))
+	assert.NilError(t, err)
+	assert.NilError(t, writer.Close())
+
+	body := append(frame(false, []byte("abc")), frame(true, compressed.Bytes())...)
+	body = append(body, frame(false, []byte("cut"))[:6]...)
+	messages, err := SplitMessages(body, "gzip")
+	assert.NilError(t, err)
+	assert.DeepEqual(t, messages, [][]byte{[]byte("abc"), []byte("compressed")})
+
+	_, err = SplitMessages(body, "snappy")
+	assert.ErrorContains(t, err, "unsupported message encoding")
+}
+
+func TestSplitMessages_decompressionBomb(t *testing.T) {
+	compress := func(size int) []byte {
+		var compressed bytes.Buffer
+		writer := gzip.NewWriter(&compressed)
+		_, err := writer.Write(make([]byte, size))
+		assert.NilError(t, err)
+		assert.NilError(t, writer.Close())
+		return compressed.Bytes()
+	}
+
+	messages, err := SplitMessages(frame(true, compress(maxDecompressedSize)), "gzip")
+	assert.NilError(t, err)
+	assert.Equal(t, len(messages[0]), maxDecompressedSize)
+
+	_, err = SplitMessages(frame(true, compress(maxDecompressedSize+1)), "gzip")
+	assert.ErrorContains(t, err, "exceed the max size")
+
+	// the limit applies to all the messages of the body
+	half := frame(true, compress(maxDecompressedSize/2+1))
+	_, err = SplitMessages(append(append([]byte{}, half...), half...), "gzip")
+	assert.ErrorContains(t, err, "exceed the max size")
+}
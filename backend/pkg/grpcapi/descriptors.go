@@ -0,0 +1,190 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// Method is a gRPC method defined in a descriptor set.
+type Method struct {
+	Service         string
+	Name            string
+	RequestType     string
+	ResponseType    string
+	ClientStreaming bool
+	ServerStreaming bool
+
+	descriptor protoreflect.MethodDescriptor
+}
+
+// Descriptors are the gRPC services of an API, built from a serialized FileDescriptorSet.
+type Descriptors struct {
+	descriptorSet []byte
+	types         *protoregistry.Types
+	methods       map[string]*Method
+}
+
+// ParseDescriptorSet parses a serialized FileDescriptorSet, the imported files must be included
+// (protoc --include_imports --descriptor_set_out).
+func ParseDescriptorSet(descriptorSet []byte) (*Descriptors, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(descriptorSet, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal descriptor set: %v", err)
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create descriptors: %v", err)
+	}
+
+	ret := &Descriptors{
+		descriptorSet: descriptorSet,
+		types:         &protoregistry.Types{},
+		methods:       map[string]*Method{},
+	}
+	files.RangeFiles(func(file protoreflect.FileDescriptor) bool {
+		if err = registerMessages(ret.types, file.Messages()); err != nil {
+			return false
+		}
+		for i := 0; i < file.Services().Len(); i++ {
+			service := file.Services().Get(i)
+			for j := 0; j < service.Methods().Len(); j++ {
+				method := service.Methods().Get(j)
+				ret.methods[methodKey(string(service.FullName()), string(method.Name()))] = &Method{
+					Service:         string(service.FullName()),
+					Name:            string(method.Name()),
+					RequestType:     string(method.Input().FullName()),
+					ResponseType:    string(method.Output().FullName()),
+					ClientStreaming: method.IsStreamingClient(),
+					ServerStreaming: method.IsStreamingServer(),
+					descriptor:      method,
+				}
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to register message types: %v", err)
+	}
+	if len(ret.methods) == 0 {
+		return nil, fmt.Errorf("no service defined in the descriptor set")
+	}
+
+	return ret, nil
+}
+
+// registerMessages registers the message types, used to resolve the google.protobuf.Any messages.
+func registerMessages(types *protoregistry.Types, messages protoreflect.MessageDescriptors) error {
+	for i := 0; i < messages.Len(); i++ {
+		message := messages.Get(i)
+		if message.IsMapEntry() {
+			continue
+		}
+		if err := types.RegisterMessage(dynamicpb.NewMessageType(message)); err != nil {
+			return err
+		}
+		if err := registerMessages(types, message.Messages()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func methodKey(service, method string) string {
+	return service + "/" + method
+}
+
+// DescriptorSet returns the serialized FileDescriptorSet the descriptors were built from.
+func (d *Descriptors) DescriptorSet() []byte {
+	return d.descriptorSet
+}
+
+// Methods returns the methods sorted by service and name.
+func (d *Descriptors) Methods() []*Method {
+	ret := make([]*Method, 0, len(d.methods))
+	for _, method := range d.methods {
+		ret = append(ret, method)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return methodKey(ret[i].Service, ret[i].Name) < methodKey(ret[j].Service, ret[j].Name)
+	})
+	return ret
+}
+
+// Services returns the sorted full names of the services.
+func (d *Descriptors) Services() []string {
+	var ret []string
+	for _, method := range d.Methods() {
+		if len(ret) == 0 || ret[len(ret)-1] != method.Service {
+			ret = append(ret, method.Service)
+		}
+	}
+	return ret
+}
+
+// Method returns the method of a service, or nil if it isn't defined.
+func (d *Descriptors) Method(service, method string) *Method {
+	return d.methods[methodKey(service, method)]
+}
+
+// DecodeMessages decodes the request or response messages of a method and returns them as a JSON array.
+func (d *Descriptors) DecodeMessages(service, method string, messages [][]byte, isRequest bool) (string, error) {
+	m := d.Method(service, method)
+	if m == nil {
+		return "", fmt.Errorf("unknown method %s", methodKey(service, method))
+	}
+	messageDescriptor := m.descriptor.Output()
+	if isRequest {
+		messageDescriptor = m.descriptor.Input()
+	}
+
+	unmarshalOptions := proto.UnmarshalOptions{Resolver: d.types}
+	marshalOptions := protojson.MarshalOptions{Resolver: d.types}
+	decoded := make([]json.RawMessage, 0, len(messages))
+	for _, message := range messages {
+		dynamicMessage := dynamicpb.NewMessage(messageDescriptor)
+		if err := unmarshalOptions.Unmarshal(message, dynamicMessage); err != nil {
+			return "", fmt.Errorf("failed to unmarshal %s message: %v", messageDescriptor.FullName(), err)
+		}
+		jsonMessage, err := marshalOptions.Marshal(dynamicMessage)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal %s message to JSON: %v", messageDescriptor.FullName(), err)
+		}
+		// protojson output is deliberately unstable, compact it
+		var compacted bytes.Buffer
+		if err := json.Compact(&compacted, jsonMessage); err != nil {
+			return "", fmt.Errorf("failed to compact %s message: %v", messageDescriptor.FullName(), err)
+		}
+		decoded = append(decoded, compacted.Bytes())
+	}
+
+	ret, err := json.Marshal(decoded)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal messages: %v", err)
+	}
+	return string(ret), nil
+}
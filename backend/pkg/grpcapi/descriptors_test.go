@@ -0,0 +1,148 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+	"gotest.tools/assert"
+)
+
+// createTestDescriptorSet returns a descriptor set of:
+//
+//	package users.v1;
+//	message GetUserRequest { string id = 1; }
+//	message User { string id = 1; string name = 2; int64 age = 3; }
+//	service Users {
+//	  rpc GetUser(GetUserRequest) returns (User);
+//	  rpc ListUsers(GetUserRequest) returns (stream User);
+//	}
+func createTestDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:     typ.Enum(),
+		}
+	}
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("users.proto"),
+			Package: proto.String("users.v1"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{
+				{
+					Name:  proto.String("GetUserRequest"),
+					Field: []*descriptorpb.FieldDescriptorProto{field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING)},
+				},
+				{
+					Name: proto.String("User"),
+					Field: []*descriptorpb.FieldDescriptorProto{
+						field("id", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+						field("name", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+						field("age", 3, descriptorpb.FieldDescriptorProto_TYPE_INT64),
+					},
+				},
+			},
+			Service: []*descriptorpb.ServiceDescriptorProto{{
+				Name: proto.String("Users"),
+				Method: []*descriptorpb.MethodDescriptorProto{
+					{
+						Name:       proto.String("GetUser"),
+						InputType:  proto.String(".users.v1.GetUserRequest"),
+						OutputType: proto.String(".users.v1.User"),
+					},
+					{
+						Name:            proto.String("ListUsers"),
+						InputType:       proto.String(".users.v1.GetUserRequest"),
+						OutputType:      proto.String(".users.v1.User"),
+						ServerStreaming: proto.Bool(true),
+					},
+				},
+			}},
+		}},
+	}
+
+	ret, err := proto.Marshal(set)
+	assert.NilError(t, err)
+	return ret
+}
+
+func marshalTestMessage(t *testing.T, descriptor protoreflect.MessageDescriptor, fields map[string]interface{}) []byte {
+	t.Helper()
+
+	message := dynamicpb.NewMessage(descriptor)
+	for name, value := range fields {
+		message.Set(descriptor.Fields().ByName(protoreflect.Name(name)), protoreflect.ValueOf(value))
+	}
+	ret, err := proto.Marshal(message)
+	assert.NilError(t, err)
+	return ret
+}
+
+func TestParseDescriptorSet(t *testing.T) {
+	descriptors, err := ParseDescriptorSet(createTestDescriptorSet(t))
+	assert.NilError(t, err)
+
+	assert.DeepEqual(t, descriptors.Services(), []string{"users.v1.Users"})
+	methods := descriptors.Methods()
+	assert.Equal(t, len(methods), 2)
+	assert.Equal(t, methods[0].Name, "GetUser")
+	assert.Equal(t, methods[0].RequestType, "users.v1.GetUserRequest")
+	assert.Equal(t, methods[0].ResponseType, "users.v1.User")
+	assert.Equal(t, methods[1].Name, "ListUsers")
+	assert.Equal(t, methods[1].ServerStreaming, true)
+	assert.Assert(t, descriptors.Method("users.v1.Users", "DeleteUser") == nil)
+
+	_, err = ParseDescriptorSet([]byte("not a descriptor set"))
+	assert.ErrorContains(t, err, "failed to unmarshal descriptor set")
+	empty, err := proto.Marshal(&descriptorpb.FileDescriptorSet{})
+	assert.NilError(t, err)
+	_, err = ParseDescriptorSet(empty)
+	assert.ErrorContains(t, err, "no service defined")
+}
+
+func TestDescriptors_DecodeMessages(t *testing.T) {
+	descriptors, err := ParseDescriptorSet(createTestDescriptorSet(t))
+	assert.NilError(t, err)
+	userDescriptor := descriptors.Method("users.v1.Users", "GetUser").descriptor.Output()
+
+	request := marshalTestMessage(t, descriptors.Method("users.v1.Users", "GetUser").descriptor.Input(), map[string]interface{}{"id": "1"})
+	decoded, err := descriptors.DecodeMessages("users.v1.Users", "GetUser", [][]byte{request}, true)
+	assert.NilError(t, err)
+	assert.Equal(t, decoded, `[{"id":"1"}]`)
+
+	responses := [][]byte{
+		marshalTestMessage(t, userDescriptor, map[string]interface{}{"id": "1", "name": "a", "age": int64(30)}),
+		marshalTestMessage(t, userDescriptor, map[string]interface{}{"id": "2"}),
+	}
+	decoded, err = descriptors.DecodeMessages("users.v1.Users", "ListUsers", responses, false)
+	assert.NilError(t, err)
+	assert.Equal(t, decoded, `[{"id":"1","name":"a","age":"30"},{"id":"2"}]`)
+
+	_, err = descriptors.DecodeMessages("users.v1.Users", "GetUser", [][]byte{{0xff}}, false)
+	assert.ErrorContains(t, err, "failed to unmarshal users.v1.User message")
+	_, err = descriptors.DecodeMessages("users.v1.Users", "DeleteUser", nil, true)
+	assert.ErrorContains(t, err, "unknown method")
+}
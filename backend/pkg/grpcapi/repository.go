@@ -0,0 +1,89 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcapi
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+// Repository holds the descriptors uploaded for the gRPC APIs. They are stored in the database and cached.
+type Repository struct {
+	dbHandler database.Database
+
+	lock sync.Mutex
+	// nil descriptors are cached for the APIs without descriptor set
+	descriptors map[uint]*Descriptors
+}
+
+func NewRepository(dbHandler database.Database) *Repository {
+	return &Repository{
+		dbHandler:   dbHandler,
+		descriptors: map[uint]*Descriptors{},
+	}
+}
+
+// Get returns the descriptors of an API, or nil if no descriptor set was uploaded.
+func (r *Repository) Get(apiID uint) (*Descriptors, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if descriptors, ok := r.descriptors[apiID]; ok {
+		return descriptors, nil
+	}
+
+	var descriptors *Descriptors
+	descriptorSet, err := r.dbHandler.GRPCDescriptorSetsTable().Get(apiID)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get descriptor set: %v", err)
+	default:
+		if descriptors, err = ParseDescriptorSet(descriptorSet.DescriptorSet); err != nil {
+			return nil, fmt.Errorf("failed to parse stored descriptor set: %v", err)
+		}
+	}
+
+	r.descriptors[apiID] = descriptors
+	return descriptors, nil
+}
+
+func (r *Repository) Set(apiID uint, descriptors *Descriptors) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.dbHandler.GRPCDescriptorSetsTable().Set(apiID, descriptors.DescriptorSet()); err != nil {
+		return fmt.Errorf("failed to store descriptor set: %v", err)
+	}
+	r.descriptors[apiID] = descriptors
+	return nil
+}
+
+func (r *Repository) Delete(apiID uint) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.dbHandler.GRPCDescriptorSetsTable().Delete(apiID); err != nil {
+		return fmt.Errorf("failed to delete descriptor set: %v", err)
+	}
+	r.descriptors[apiID] = nil
+	return nil
+}
@@ -0,0 +1,265 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package har converts HTTP Archive (HAR 1.2) captures into APIClarity telemetries.
+package har
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// DefaultSourceAddress is used as the telemetry source address since HAR files don't record the client address.
+const DefaultSourceAddress = "0.0.0.0:0"
+
+// HAR is the subset of the HAR 1.2 format that is needed to build telemetries.
+type HAR struct {
+	Log Log `json:"log"`
+}
+
+type Log struct {
+	Entries []Entry `json:"entries"`
+}
+
+type Entry struct {
+	StartedDateTime time.Time `json:"startedDateTime"`
+	// Total elapsed time of the request in milliseconds.
+	Time            float64  `json:"time"`
+	Request         Request  `json:"request"`
+	Response        Response `json:"response"`
+	ServerIPAddress string   `json:"serverIPAddress,omitempty"`
+}
+
+type Request struct {
+	Method      string    `json:"method"`
+	URL         string    `json:"url"`
+	HTTPVersion string    `json:"httpVersion"`
+	Headers     []Header  `json:"headers"`
+	PostData    *PostData `json:"postData,omitempty"`
+}
+
+type Response struct {
+	Status      int      `json:"status"`
+	HTTPVersion string   `json:"httpVersion"`
+	Headers     []Header `json:"headers"`
+	Content     Content  `json:"content"`
+}
+
+type Header struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+type PostData struct {
+	MimeType string  `json:"mimeType"`
+	Text     string  `json:"text"`
+	Params   []Param `json:"params,omitempty"`
+}
+
+type Param struct {
+	Name  string `json:"name"`
+	Value string `json:"value,omitempty"`
+}
+
+type Content struct {
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+// Parse decodes a HAR archive.
+func Parse(r io.Reader) (*HAR, error) {
+	var har HAR
+	if err := json.NewDecoder(r).Decode(&har); err != nil {
+		return nil, fmt.Errorf("failed to decode HAR: %v", err)
+	}
+	return &har, nil
+}
+
+// ConvertEntry converts a single HAR entry into a telemetry.
+func ConvertEntry(entry *Entry) (*models.Telemetry, error) {
+	u, err := url.Parse(entry.Request.URL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse request url %q: %v", entry.Request.URL, err)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing host in request url %q", entry.Request.URL)
+	}
+	if entry.Request.Method == "" {
+		return nil, fmt.Errorf("missing request method")
+	}
+	if entry.Response.Status <= 0 {
+		// Requests that were blocked or aborted by the browser are recorded with status 0.
+		return nil, fmt.Errorf("no response recorded for %s %s", entry.Request.Method, entry.Request.URL)
+	}
+
+	port := u.Port()
+	if port == "" {
+		port = defaultPort(u.Scheme)
+	}
+
+	responseBody, err := getResponseBody(&entry.Response.Content)
+	if err != nil {
+		return nil, err
+	}
+
+	requestTime := entry.StartedDateTime.UnixMilli()
+	responseTime := requestTime
+	if entry.Time > 0 {
+		responseTime += int64(entry.Time)
+	}
+
+	return &models.Telemetry{
+		DestinationAddress: net.JoinHostPort(getDestinationIP(entry.ServerIPAddress, u.Hostname()), port),
+		RequestID:          uuid.NewString(),
+		Scheme:             u.Scheme,
+		SourceAddress:      DefaultSourceAddress,
+		Request: &models.Request{
+			Common: &models.Common{
+				Body:    getRequestBody(entry.Request.PostData),
+				Headers: convertHeaders(entry.Request.Headers),
+				Time:    requestTime,
+				Version: getVersion(entry.Request.HTTPVersion),
+			},
+			Host:   u.Host,
+			Method: strings.ToUpper(entry.Request.Method),
+			Path:   u.RequestURI(),
+		},
+		Response: &models.Response{
+			Common: &models.Common{
+				Body:    responseBody,
+				Headers: convertHeaders(entry.Response.Headers),
+				Time:    responseTime,
+				Version: getVersion(entry.Response.HTTPVersion),
+			},
+			StatusCode: strconv.Itoa(entry.Response.Status),
+		},
+	}, nil
+}
+
+// getDestinationIP prefers the recorded server IP, unless it is an IPv6 address which the speculator can't handle.
+func getDestinationIP(serverIPAddress, hostname string) string {
+	serverIPAddress = strings.Trim(serverIPAddress, "[]")
+	if serverIPAddress == "" || strings.Contains(serverIPAddress, ":") {
+		return hostname
+	}
+	return serverIPAddress
+}
+
+func defaultPort(scheme string) string {
+	if scheme == "https" {
+		return "443"
+	}
+	return "80"
+}
+
+// getVersion converts a HAR http version (e.g. HTTP/1.1) to the telemetry format (e.g. 1.1).
+func getVersion(httpVersion string) string {
+	return strings.TrimPrefix(strings.ToUpper(httpVersion), "HTTP/")
+}
+
+func convertHeaders(headers []Header) []*models.Header {
+	ret := make([]*models.Header, 0, len(headers))
+	for _, header := range headers {
+		// HTTP/2 pseudo headers (:authority, :path...) are not real headers.
+		if strings.HasPrefix(header.Name, ":") {
+			continue
+		}
+		ret = append(ret, &models.Header{
+			Key:   strings.ToLower(header.Name),
+			Value: header.Value,
+		})
+	}
+	return ret
+}
+
+// getRequestBody returns the posted text, or rebuilds url encoded forms that some tools only record as params.
+func getRequestBody(postData *PostData) []byte {
+	if postData == nil {
+		return nil
+	}
+	if postData.Text == "" && len(postData.Params) > 0 && strings.HasPrefix(postData.MimeType, "application/x-www-form-urlencoded") {
+		values := url.Values{}
+		for _, param := range postData.Params {
+			values.Add(param.Name, param.Value)
+		}
+		return []byte(values.Encode())
+	}
+	return []byte(postData.Text)
+}
+
+func getResponseBody(content *Content) ([]byte, error) {
+	if content.Encoding == "base64" {
+		body, err := base64.StdEncoding.DecodeString(content.Text)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode base64 response body: %v", err)
+		}
+		return body, nil
+	}
+	return []byte(content.Text), nil
+}
+
+// maxReportedErrors bounds the number of error messages kept in a Result, a single capture can hold thousands of entries.
+const maxReportedErrors = 100
+
+// Result summarizes the import of a HAR archive.
+type Result struct {
+	Imported int
+	Failed   int
+	Errors   []string
+}
+
+// Import converts every entry of the archive and hands it to handleFunc, in capture order.
+// Failing entries are counted and reported but don't stop the import.
+func Import(ctx context.Context, har *HAR, handleFunc func(ctx context.Context, trace *models.Telemetry) error) *Result {
+	result := &Result{}
+	for i := range har.Log.Entries {
+		if ctx.Err() != nil {
+			result.addError(i, ctx.Err())
+			result.Failed += len(har.Log.Entries) - i - 1
+			break
+		}
+
+		trace, err := ConvertEntry(&har.Log.Entries[i])
+		if err == nil {
+			err = handleFunc(ctx, trace)
+		}
+		if err != nil {
+			result.addError(i, err)
+			continue
+		}
+		result.Imported++
+	}
+	return result
+}
+
+func (r *Result) addError(index int, err error) {
+	r.Failed++
+	if len(r.Errors) < maxReportedErrors {
+		r.Errors = append(r.Errors, fmt.Sprintf("entry %d: %v", index, err))
+	}
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package har
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+const testHAR = `{
+  "log": {
+    "version": "1.2",
+    "creator": {"name": "test", "version": "1"},
+    "entries": [
+      {
+        "startedDateTime": "2023-03-01T10:00:00.000Z",
+        "time": 42.7,
+        "serverIPAddress": "10.0.0.1",
+        "request": {
+          "method": "post",
+          "url": "https://api.example.com/users?verbose=true",
+          "httpVersion": "HTTP/2.0",
+          "headers": [{"name": ":authority", "value": "api.example.com"}, {"name": "Content-Type", "value": "application/json"}],
+          "postData": {"mimeType": "application/json", "text": "{\"name\":\"bob\"}"}
+        },
+        "response": {
+          "status": 201,
+          "httpVersion": "HTTP/2.0",
+          "headers": [{"name": "Content-Type", "value": "application/json"}],
+          "content": {"mimeType": "application/json", "text": "eyJpZCI6MX0=", "encoding": "base64"}
+        }
+      },
+      {
+        "startedDateTime": "2023-03-01T10:00:01.000Z",
+        "time": 10,
+        "serverIPAddress": "[2001:db8::1]",
+        "request": {
+          "method": "POST",
+          "url": "http://shop.example.com:8080/login",
+          "httpVersion": "HTTP/1.1",
+          "headers": [],
+          "postData": {"mimeType": "application/x-www-form-urlencoded", "params": [{"name": "user", "value": "bob"}]}
+        },
+        "response": {"status": 302, "httpVersion": "HTTP/1.1", "headers": [], "content": {"mimeType": ""}}
+      },
+      {
+        "startedDateTime": "2023-03-01T10:00:02.000Z",
+        "time": -1,
+        "request": {"method": "GET", "url": "https://blocked.example.com/", "httpVersion": "HTTP/1.1", "headers": []},
+        "response": {"status": 0, "httpVersion": "", "headers": [], "content": {"mimeType": ""}}
+      }
+    ]
+  }
+}`
+
+func TestConvertEntry(t *testing.T) {
+	har, err := Parse(strings.NewReader(testHAR))
+	assert.NilError(t, err)
+	assert.Equal(t, len(har.Log.Entries), 3)
+
+	trace, err := ConvertEntry(&har.Log.Entries[0])
+	assert.NilError(t, err)
+	assert.Equal(t, trace.DestinationAddress, "10.0.0.1:443")
+	assert.Equal(t, trace.SourceAddress, DefaultSourceAddress)
+	assert.Equal(t, trace.Scheme, "https")
+	assert.Assert(t, trace.RequestID != "")
+	assert.Equal(t, trace.Request.Host, "api.example.com")
+	assert.Equal(t, trace.Request.Method, "POST")
+	assert.Equal(t, trace.Request.Path, "/users?verbose=true")
+	assert.Equal(t, trace.Request.Common.Version, "2.0")
+	assert.Equal(t, len(trace.Request.Common.Headers), 1)
+	assert.Equal(t, trace.Request.Common.Headers[0].Key, "content-type")
+	assert.Equal(t, string(trace.Request.Common.Body), `{"name":"bob"}`)
+	assert.Equal(t, trace.Response.StatusCode, "201")
+	assert.Equal(t, string(trace.Response.Common.Body), `{"id":1}`)
+	assert.Equal(t, trace.Response.Common.Time-trace.Request.Common.Time, int64(42))
+
+	trace, err = ConvertEntry(&har.Log.Entries[1])
+	assert.NilError(t, err)
+	assert.Equal(t, trace.DestinationAddress, "shop.example.com:8080")
+	assert.Equal(t, string(trace.Request.Common.Body), "user=bob")
+	assert.Equal(t, trace.Response.StatusCode, "302")
+
+	_, err = ConvertEntry(&har.Log.Entries[2])
+	assert.ErrorContains(t, err, "no response recorded")
+}
+
+func TestImport(t *testing.T) {
+	har, err := Parse(strings.NewReader(testHAR))
+	assert.NilError(t, err)
+
+	var handled []*models.Telemetry
+	result := Import(context.Background(), har, func(ctx context.Context, trace *models.Telemetry) error {
+		if trace.Request.Path == "/login" {
+			return fmt.Errorf("failed to handle")
+		}
+		handled = append(handled, trace)
+		return nil
+	})
+	assert.Equal(t, result.Imported, 1)
+	assert.Equal(t, result.Failed, 2)
+	assert.DeepEqual(t, result.Errors, []string{"entry 1: failed to handle", "entry 2: no response recorded for GET https://blocked.example.com/"})
+	assert.Equal(t, len(handled), 1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	result = Import(ctx, har, func(ctx context.Context, trace *models.Telemetry) error { return nil })
+	assert.Equal(t, result.Imported, 0)
+	assert.Equal(t, result.Failed, 3)
+}
+
+func TestParse_Invalid(t *testing.T) {
+	_, err := Parse(strings.NewReader("not a har"))
+	assert.ErrorContains(t, err, "failed to decode HAR")
+}
@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"sync/atomic"
+	"time"
+)
+
+type Stage string
+
+const (
+	// StageQueue is the time a telemetry waited in the queue before being picked by a worker.
+	StageQueue Stage = "queue"
+	// StageInventory is the time spent to find or create the API in the inventory.
+	StageInventory Stage = "inventory"
+	// StageSpeculator is the time spent by the speculator to learn the telemetry and resolve the path IDs.
+	StageSpeculator Stage = "speculator"
+	// StageDatabase is the time spent to store the API event.
+	StageDatabase Stage = "database"
+	// StageModules is the time spent to dispatch the event to the queues of the modules.
+	StageModules Stage = "modules"
+	// StageTotal is the time spent to handle the telemetry, from the enqueue to the end of the handling.
+	StageTotal Stage = "total"
+)
+
+var stages = []Stage{StageQueue, StageInventory, StageSpeculator, StageDatabase, StageModules, StageTotal}
+
+type stageCounters struct {
+	count      uint64
+	totalNanos uint64
+	maxNanos   uint64
+}
+
+func (c *stageCounters) observe(d time.Duration) {
+	nanos := uint64(d.Nanoseconds())
+	atomic.AddUint64(&c.count, 1)
+	atomic.AddUint64(&c.totalNanos, nanos)
+	for {
+		current := atomic.LoadUint64(&c.maxNanos)
+		if nanos <= current || atomic.CompareAndSwapUint64(&c.maxNanos, current, nanos) {
+			return
+		}
+	}
+}
+
+func (c *stageCounters) stats() StageStats {
+	count := atomic.LoadUint64(&c.count)
+	total := atomic.LoadUint64(&c.totalNanos)
+
+	stats := StageStats{
+		Count:   count,
+		TotalMs: float64(total) / float64(time.Millisecond),
+		MaxMs:   float64(atomic.LoadUint64(&c.maxNanos)) / float64(time.Millisecond),
+	}
+	if count > 0 {
+		stats.AvgMs = stats.TotalMs / float64(count)
+	}
+
+	return stats
+}
+
+// Metrics holds the counters of the ingestion pipeline.
+// A nil *Metrics is valid and does not record anything.
+type Metrics struct {
+	enqueued  uint64
+	rejected  uint64
+	processed uint64
+	failed    uint64
+
+	stages map[Stage]*stageCounters
+}
+
+func NewMetrics() *Metrics {
+	m := &Metrics{
+		stages: make(map[Stage]*stageCounters, len(stages)),
+	}
+	for _, stage := range stages {
+		m.stages[stage] = &stageCounters{}
+	}
+
+	return m
+}
+
+// ObserveStage records the latency of a single pipeline stage.
+func (m *Metrics) ObserveStage(stage Stage, d time.Duration) {
+	if m == nil {
+		return
+	}
+	if counters, ok := m.stages[stage]; ok {
+		counters.observe(d)
+	}
+}
+
+// ObserveStageSince records the latency of a single pipeline stage which started at start.
+func (m *Metrics) ObserveStageSince(stage Stage, start time.Time) {
+	m.ObserveStage(stage, time.Since(start))
+}
+
+func (m *Metrics) incEnqueued() {
+	if m != nil {
+		atomic.AddUint64(&m.enqueued, 1)
+	}
+}
+
+func (m *Metrics) incRejected() {
+	if m != nil {
+		atomic.AddUint64(&m.rejected, 1)
+	}
+}
+
+func (m *Metrics) incProcessed(failed bool) {
+	if m == nil {
+		return
+	}
+	atomic.AddUint64(&m.processed, 1)
+	if failed {
+		atomic.AddUint64(&m.failed, 1)
+	}
+}
+
+type StageStats struct {
+	Count   uint64  `json:"count"`
+	TotalMs float64 `json:"totalMs"`
+	AvgMs   float64 `json:"avgMs"`
+	MaxMs   float64 `json:"maxMs"`
+}
+
+type Stats struct {
+	QueueDepth    int                  `json:"queueDepth"`
+	QueueCapacity int                  `json:"queueCapacity"`
+	Workers       int                  `json:"workers"`
+	Enqueued      uint64               `json:"enqueued"`
+	Rejected      uint64               `json:"rejected"`
+	Processed     uint64               `json:"processed"`
+	Failed        uint64               `json:"failed"`
+	Stages        map[Stage]StageStats `json:"stages"`
+}
+
+func (m *Metrics) stats() Stats {
+	stats := Stats{
+		Stages: make(map[Stage]StageStats, len(stages)),
+	}
+	if m == nil {
+		return stats
+	}
+
+	stats.Enqueued = atomic.LoadUint64(&m.enqueued)
+	stats.Rejected = atomic.LoadUint64(&m.rejected)
+	stats.Processed = atomic.LoadUint64(&m.processed)
+	stats.Failed = atomic.LoadUint64(&m.failed)
+	for stage, counters := range m.stages {
+		stats.Stages[stage] = counters.stats()
+	}
+
+	return stats
+}
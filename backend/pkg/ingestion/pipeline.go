@@ -0,0 +1,167 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+const (
+	DefaultMaxQueueSize = 1000
+	DefaultWorkers      = 10
+)
+
+var (
+	// ErrQueueFull is returned by Enqueue when the pipeline can't accept more telemetries.
+	ErrQueueFull = errors.New("ingestion queue is full")
+	// ErrStopped is returned by Enqueue when the pipeline was stopped.
+	ErrStopped = errors.New("ingestion pipeline is stopped")
+)
+
+type HandleTraceFunc func(ctx context.Context, trace *pluginsmodels.Telemetry, traceSource *models.TraceSource) error
+
+type job struct {
+	trace       *pluginsmodels.Telemetry
+	traceSource *models.TraceSource
+	enqueueTime time.Time
+}
+
+// Pipeline decouples the reception of the telemetries from their handling.
+// Telemetries are pushed to a bounded queue and handled asynchronously by a pool of workers,
+// when the queue is full the telemetries are rejected so that the senders can back off.
+type Pipeline struct {
+	handleTraceFunc HandleTraceFunc
+	queue           chan job
+	workers         int
+	metrics         *Metrics
+
+	lock    sync.RWMutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+func NewPipeline(handleTraceFunc HandleTraceFunc, maxQueueSize int, workers int, metrics *Metrics) *Pipeline {
+	if maxQueueSize <= 0 {
+		maxQueueSize = DefaultMaxQueueSize
+	}
+	if workers <= 0 {
+		workers = DefaultWorkers
+	}
+	if metrics == nil {
+		metrics = NewMetrics()
+	}
+
+	return &Pipeline{
+		handleTraceFunc: handleTraceFunc,
+		queue:           make(chan job, maxQueueSize),
+		workers:         workers,
+		metrics:         metrics,
+	}
+}
+
+// Start starts the workers. The telemetries are handled with ctx, and not with the context of the request
+// that enqueued them, since the request is already answered by the time the telemetry is handled.
+func (p *Pipeline) Start(ctx context.Context) {
+	log.Infof("Starting ingestion pipeline. workers=%v, queue size=%v", p.workers, cap(p.queue))
+	for i := 0; i < p.workers; i++ {
+		p.wg.Add(1)
+		go p.worker(ctx)
+	}
+}
+
+// Stop stops accepting new telemetries and waits for the workers to handle the queued ones.
+func (p *Pipeline) Stop() {
+	p.lock.Lock()
+	if p.stopped {
+		p.lock.Unlock()
+		return
+	}
+	p.stopped = true
+	close(p.queue)
+	p.lock.Unlock()
+
+	log.Infof("Stopping ingestion pipeline, waiting for %v queued telemetries", len(p.queue))
+	p.wg.Wait()
+}
+
+// Enqueue pushes a telemetry to the queue without blocking. It has the signature of a trace handling func
+// so that it can replace it in the traces servers.
+func (p *Pipeline) Enqueue(_ context.Context, trace *pluginsmodels.Telemetry, traceSource *models.TraceSource) error {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	if p.stopped {
+		return ErrStopped
+	}
+
+	select {
+	case p.queue <- job{trace: trace, traceSource: traceSource, enqueueTime: time.Now()}:
+		p.metrics.incEnqueued()
+		return nil
+	default:
+		p.metrics.incRejected()
+		return ErrQueueFull
+	}
+}
+
+func (p *Pipeline) Metrics() *Metrics {
+	return p.metrics
+}
+
+// Stats returns a snapshot of the pipeline counters.
+func (p *Pipeline) Stats() Stats {
+	stats := p.metrics.stats()
+	stats.QueueDepth = len(p.queue)
+	stats.QueueCapacity = cap(p.queue)
+	stats.Workers = p.workers
+
+	return stats
+}
+
+func (p *Pipeline) worker(ctx context.Context) {
+	defer p.wg.Done()
+
+	for {
+		select {
+		case j, ok := <-p.queue:
+			if !ok {
+				return
+			}
+			p.handle(ctx, j)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (p *Pipeline) handle(ctx context.Context, j job) {
+	p.metrics.ObserveStageSince(StageQueue, j.enqueueTime)
+	defer p.metrics.ObserveStageSince(StageTotal, j.enqueueTime)
+
+	err := p.handleTraceFunc(ctx, j.trace, j.traceSource)
+	if err != nil {
+		log.Errorf("Error from trace handling func: %v", err)
+	}
+	p.metrics.incProcessed(err != nil)
+}
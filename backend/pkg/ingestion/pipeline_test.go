@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingestion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func TestPipeline_EnqueueQueueFull(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{}, 1)
+	p := NewPipeline(func(ctx context.Context, trace *pluginsmodels.Telemetry, traceSource *models.TraceSource) error {
+		select {
+		case started <- struct{}{}:
+		default:
+		}
+		<-release
+		return nil
+	}, 2, 1, nil)
+	p.Start(context.Background())
+
+	// The first telemetry is taken by the single worker which is then blocked.
+	assert.NilError(t, p.Enqueue(context.Background(), &pluginsmodels.Telemetry{}, nil))
+	<-started
+
+	assert.NilError(t, p.Enqueue(context.Background(), &pluginsmodels.Telemetry{}, nil))
+	assert.NilError(t, p.Enqueue(context.Background(), &pluginsmodels.Telemetry{}, nil))
+	err := p.Enqueue(context.Background(), &pluginsmodels.Telemetry{}, nil)
+	assert.Assert(t, errors.Is(err, ErrQueueFull))
+
+	stats := p.Stats()
+	assert.Equal(t, stats.QueueDepth, 2)
+	assert.Equal(t, stats.QueueCapacity, 2)
+	assert.Equal(t, stats.Enqueued, uint64(3))
+	assert.Equal(t, stats.Rejected, uint64(1))
+
+	close(release)
+	p.Stop()
+
+	stats = p.Stats()
+	assert.Equal(t, stats.QueueDepth, 0)
+	assert.Equal(t, stats.Processed, uint64(3))
+	assert.Equal(t, stats.Stages[StageQueue].Count, uint64(3))
+	assert.Equal(t, stats.Stages[StageTotal].Count, uint64(3))
+
+	err = p.Enqueue(context.Background(), &pluginsmodels.Telemetry{}, nil)
+	assert.Assert(t, errors.Is(err, ErrStopped))
+}
+
+func TestPipeline_Handle(t *testing.T) {
+	traceSource := &models.TraceSource{ID: 2}
+
+	var lock sync.Mutex
+	handled := map[string]bool{}
+	p := NewPipeline(func(ctx context.Context, trace *pluginsmodels.Telemetry, ts *models.TraceSource) error {
+		assert.Equal(t, ts, traceSource)
+		lock.Lock()
+		defer lock.Unlock()
+		handled[trace.RequestID] = true
+		if trace.RequestID == "fail" {
+			return fmt.Errorf("failed")
+		}
+		return nil
+	}, 10, 3, nil)
+	p.Start(context.Background())
+
+	// The request context is canceled right after the enqueue, the telemetry must be handled anyway.
+	ctx, cancel := context.WithCancel(context.Background())
+	for _, id := range []string{"1", "2", "fail"} {
+		assert.NilError(t, p.Enqueue(ctx, &pluginsmodels.Telemetry{RequestID: id}, traceSource))
+	}
+	cancel()
+	p.Stop()
+
+	assert.DeepEqual(t, handled, map[string]bool{"1": true, "2": true, "fail": true})
+	stats := p.Stats()
+	assert.Equal(t, stats.Processed, uint64(3))
+	assert.Equal(t, stats.Failed, uint64(1))
+}
+
+func TestMetrics_NilSafe(t *testing.T) {
+	var m *Metrics
+	m.ObserveStage(StageDatabase, 1)
+	m.incEnqueued()
+	m.incRejected()
+	m.incProcessed(true)
+	assert.Equal(t, m.stats().Processed, uint64(0))
+}
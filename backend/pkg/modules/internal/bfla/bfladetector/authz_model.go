@@ -47,7 +47,7 @@ type SourceObject struct {
 }
 
 func (u *DetectedUser) IsMismatchedScopes(op *spec.Operation) bool {
-	if u.Source != DetectedUserSourceJWT || u.JWTClaims == nil {
+	if op == nil || u.Source != DetectedUserSourceJWT || u.JWTClaims == nil {
 		return false
 	}
 	if u.JWTClaims.Scope == nil {
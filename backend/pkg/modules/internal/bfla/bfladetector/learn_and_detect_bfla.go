@@ -26,7 +26,6 @@ import (
 	"sync"
 	"time"
 
-	"github.com/go-openapi/spec"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 
@@ -38,6 +37,7 @@ import (
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/bfla/recovery"
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/bfla/restapi"
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	_spec "github.com/openclarity/speculator/pkg/spec"
 )
 
 const (
@@ -67,12 +67,31 @@ const (
 	BFLADetecting
 )
 
+// ConfigSchema is the schema of the config which can be changed at runtime under /api/modules/bfla/config.
+const ConfigSchema = `{
+	"type": "object",
+	"properties": {
+		"automaticLearningAndDetection": {
+			"description": "Whether the APIs start learning on their first trace and switch to detecting once learnt",
+			"type": "boolean"
+		},
+		"learningNrTraces": {
+			"description": "The number of traces the APIs learn from in the automatic learning and detection",
+			"type": "integer",
+			"minimum": 1
+		}
+	},
+	"required": ["automaticLearningAndDetection", "learningNrTraces"],
+	"additionalProperties": false
+}`
+
 type BflaConfig struct {
-	AutomaticLearningAndDetection bool
-	LearningNrTraces              uint
+	AutomaticLearningAndDetection bool `json:"automaticLearningAndDetection"`
+	LearningNrTraces              uint `json:"learningNrTraces"`
 }
 
-func loadConfig() BflaConfig {
+// LoadConfig returns the config from the environment, which is only the default of the config stored in the database.
+func LoadConfig() BflaConfig {
 	viper.SetDefault(automaticLearningAndDetectionEnv, automaticLearningAndDetectionDefault)
 	viper.SetDefault(learningNrTracesEnv, learningNrTracesDefault)
 
@@ -118,7 +137,7 @@ func NewBFLADetector(ctx context.Context, modName string, bflaBackendAccessor bf
 		findingsRegistry:       NewFindingsRegistry(sp),
 		mu:                     &sync.RWMutex{},
 		modName:                modName,
-		config:                 loadConfig(),
+		config:                 LoadConfig(),
 	}
 	go func() {
 		for {
@@ -152,11 +171,15 @@ type BFLADetector interface {
 	StartDetection(apiID uint) error
 	StopDetection(apiID uint) error
 
+	SetConfig(config BflaConfig) error
+
 	ProvideAuthzModel(apiID uint, am AuthorizationModel)
 }
 
 type bflaBackendAccessor interface {
 	GetAPIInfo(ctx context.Context, apiID uint) (*database.APIInfo, error)
+	ResolveEventPath(ctx context.Context, event *database.APIEvent) (*core.ResolvedPath, error)
+	ResolveSpecPath(ctx context.Context, apiID uint, path string, method models.HTTPMethod) (*core.ResolvedPath, error)
 	EnableTraces(ctx context.Context, modName string, apiID uint) error
 	DisableTraces(ctx context.Context, modName string, apiID uint) error
 }
@@ -225,6 +248,11 @@ type StopDetectionCommand struct {
 	ErrorChan
 }
 
+type SetConfigCommand struct {
+	config BflaConfig
+	ErrorChan
+}
+
 func (a *StopLearningCommand) isCommand()      {}
 func (a *StartLearningCommand) isCommand()     {}
 func (a *ResetModelCommand) isCommand()        {}
@@ -233,6 +261,7 @@ func (a *MarkIllegitimateCommand) isCommand()  {}
 func (a *ProvideAuthzModelCommand) isCommand() {}
 func (a *StartDetectionCommand) isCommand()    {}
 func (a *StopDetectionCommand) isCommand()     {}
+func (a *SetConfigCommand) isCommand()         {}
 
 type EventOperation struct {
 	Path        string
@@ -346,19 +375,14 @@ func (l *learnAndDetectBFLA) checkBFLAState(apiID uint, allowedStates ...BFLASta
 func (l *learnAndDetectBFLA) commandsRunner(ctx context.Context, command Command) (err error) {
 	defer runtimeRecover()
 	switch cmd := command.(type) {
+	case *SetConfigCommand:
+		l.config = cmd.config
 	case *MarkLegitimateCommand:
-		apiInfo, err := l.bflaBackendAccessor.GetAPIInfo(ctx, cmd.apiID)
-		if err != nil {
-			return fmt.Errorf("unable to get api info: %w", err)
-		}
-		tags, err := ParseSpecInfo(apiInfo)
-		if err != nil {
-			return fmt.Errorf("unable to parse spec info: %w", err)
-		}
-		tagNames, err := resolveTagsFromPathAndMethod(tags, cmd.path, cmd.method)
+		resolved, err := l.bflaBackendAccessor.ResolveSpecPath(ctx, cmd.apiID, cmd.path, models.HTTPMethod(cmd.method))
 		if err != nil {
 			return fmt.Errorf("unable to resolve to tags: %w", err)
 		}
+		tagNames := resolved.Tags
 		_, _, err = l.checkBFLAState(cmd.apiID, BFLALearnt, BFLADetecting)
 		if err != nil {
 			return fmt.Errorf("unable to perform command 'Mark Legitimate': %w", err)
@@ -376,18 +400,11 @@ func (l *learnAndDetectBFLA) commandsRunner(ctx context.Context, command Command
 		l.logError(l.notifyAuthzModel(ctx, cmd.apiID))
 
 	case *MarkIllegitimateCommand:
-		apiInfo, err := l.bflaBackendAccessor.GetAPIInfo(ctx, cmd.apiID)
-		if err != nil {
-			return fmt.Errorf("unable to get api info: %w", err)
-		}
-		tags, err := ParseSpecInfo(apiInfo)
-		if err != nil {
-			return fmt.Errorf("unable to parse spec info: %w", err)
-		}
-		tagNames, err := resolveTagsFromPathAndMethod(tags, cmd.path, cmd.method)
+		resolved, err := l.bflaBackendAccessor.ResolveSpecPath(ctx, cmd.apiID, cmd.path, models.HTTPMethod(cmd.method))
 		if err != nil {
 			return fmt.Errorf("unable to resolve to tags: %w", err)
 		}
+		tagNames := resolved.Tags
 		_, _, err = l.checkBFLAState(cmd.apiID, BFLALearnt, BFLADetecting)
 		if err != nil {
 			return fmt.Errorf("unable to perform command 'Mark Illegitimate': %w", err)
@@ -582,30 +599,6 @@ func (l *learnAndDetectBFLA) mergeAuthzModel(newModel AuthorizationModel, oldMod
 	return oldModel
 }
 
-func GetSpecOperation(spc *spec.Swagger, method models.HTTPMethod, resolvedPath string) *spec.Operation {
-	switch method {
-	case models.HTTPMethodGET:
-		return spc.Paths.Paths[resolvedPath].Get
-	case models.HTTPMethodHEAD:
-		return spc.Paths.Paths[resolvedPath].Head
-	case models.HTTPMethodPOST:
-		return spc.Paths.Paths[resolvedPath].Post
-	case models.HTTPMethodPUT:
-		return spc.Paths.Paths[resolvedPath].Put
-	case models.HTTPMethodDELETE:
-		return spc.Paths.Paths[resolvedPath].Delete
-	case models.HTTPMethodCONNECT:
-		// op = spc.Paths.Paths[resolvedPath].Connect TODO
-	case models.HTTPMethodOPTIONS:
-		return spc.Paths.Paths[resolvedPath].Options
-	case models.HTTPMethodTRACE:
-		// op = spc.Paths.Paths[resolvedPath].Trace TODO
-	case models.HTTPMethodPATCH:
-		return spc.Paths.Paths[resolvedPath].Patch
-	}
-	return nil
-}
-
 func ContainsAll(items []string, vals []string) bool {
 	for _, item := range items {
 		if !Contains(vals, item) {
@@ -631,23 +624,12 @@ func (l *learnAndDetectBFLA) traceRunner(ctx context.Context, trace *CompositeTr
 	apiID := trace.APIEvent.APIInfoID
 	log.Infof("bfla received event: %d", apiID)
 	// load the model from store in the case it's not already present in memory or don't do anything if the model with id does not exist
-	apiInfo, err := l.bflaBackendAccessor.GetAPIInfo(ctx, apiID)
-	if err != nil {
-		return fmt.Errorf("unable to get api info: %w", err)
-	}
-	tags, err := ParseSpecInfo(apiInfo)
-	if err != nil {
-		return fmt.Errorf("unable to parse spec info: %w", err)
-	}
-	resolvedPath, tagNames, err := ResolvePath(tags, trace.APIEvent)
+	resolved, err := l.bflaBackendAccessor.ResolveEventPath(ctx, trace.APIEvent)
 	if err != nil {
 		return fmt.Errorf("unable to process trace: %w", err)
 	}
-
-	specType := SpecTypeFromAPIInfo(apiInfo)
-	if specType == SpecTypeNone {
-		return fmt.Errorf("spec not present cannot learn BFLA; apiID=%d", trace.APIEvent.APIInfoID)
-	}
+	resolvedPath, tagNames := resolved.Path, resolved.Tags
+	specType := SpecTypeFromSpecSource(resolved.SpecSource)
 
 	var (
 		state      BFLAState
@@ -751,13 +733,8 @@ func (l *learnAndDetectBFLA) traceRunner(ctx context.Context, trace *CompositeTr
 			aud.WarningStatus = ResolveBFLAStatusInt(int(trace.APIEvent.StatusCode))
 		}
 
-		spc, err := GetOpenAPI(apiInfo, apiID)
-		if err != nil {
-			log.Warnf("unable to get openapi spec")
-		}
-		op := GetSpecOperation(spc, trace.APIEvent.Method, resolvedPath)
 		for _, user := range aud.EndUsers {
-			if user.IsMismatchedScopes(op) {
+			if user.IsMismatchedScopes(resolved.Operation) {
 				updated, err := l.findingsRegistry.Add(trace.APIEvent.APIInfoID, APIFindingBFLAScopesMismatch(specType, resolvedPath, trace.APIEvent.Method))
 				if err != nil {
 					log.Warnf("unable to add scope mismatch findings: %s", err)
@@ -1041,6 +1018,14 @@ func (l *learnAndDetectBFLA) ResetModel(apiID uint) error {
 	})
 }
 
+// SetConfig changes the config, it applies to the traces and commands handled after it.
+func (l *learnAndDetectBFLA) SetConfig(config BflaConfig) error {
+	return l.commandsCh.SendAndReplyErr(&SetConfigCommand{
+		config:    config,
+		ErrorChan: NewErrorChan(),
+	})
+}
+
 func (l *learnAndDetectBFLA) StopLearning(apiID uint) error {
 	return l.commandsCh.SendAndReplyErr(&StopLearningCommand{
 		apiID:     apiID,
@@ -1114,3 +1099,13 @@ func SpecTypeFromAPIInfo(apiinfo *database.APIInfo) SpecType {
 	}
 	return SpecTypeNone
 }
+
+func SpecTypeFromSpecSource(source _spec.SpecSource) SpecType {
+	switch source {
+	case _spec.SpecSourceProvided:
+		return SpecTypeProvided
+	case _spec.SpecSourceReconstructed:
+		return SpecTypeReconstructed
+	}
+	return SpecTypeNone
+}
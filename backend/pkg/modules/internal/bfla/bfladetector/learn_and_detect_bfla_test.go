@@ -79,6 +79,26 @@ func getAPIInfoWithTags(method string, path string) *database.APIInfo {
 	return &apiInfo
 }
 
+func resolveEventWithSpecOf(method string, path string) func(ctx context.Context, event *database.APIEvent) (*core.ResolvedPath, error) {
+	return func(ctx context.Context, event *database.APIEvent) (*core.ResolvedPath, error) {
+		specs, err := core.NewAPISpecs(getAPIInfoWithTags(method, path))
+		if err != nil {
+			return nil, err
+		}
+		return specs.ResolveEvent(event)
+	}
+}
+
+func resolveSpecPathWithSpecOf(method string, path string) func(ctx context.Context, apiID uint, specPath string, specMethod models.HTTPMethod) (*core.ResolvedPath, error) {
+	return func(ctx context.Context, apiID uint, specPath string, specMethod models.HTTPMethod) (*core.ResolvedPath, error) {
+		specs, err := core.NewAPISpecs(getAPIInfoWithTags(method, path))
+		if err != nil {
+			return nil, err
+		}
+		return specs.ResolvePath(specPath, specMethod)
+	}
+}
+
 func initBFLADetector(ctrl *gomock.Controller, backendAccessor *core.MockBackendAccessor, storedAuthModels map[uint]bfladetector.AuthorizationModel, storedBFLAStates map[uint]bfladetector.BFLAState, storedBFLAFindings map[uint]common.APIFindings) bfladetector.BFLADetector {
 	var (
 		ctx            = context.Background()
@@ -169,9 +189,7 @@ func Test_learnAndDetectBFLA_BuildAuthzModelTraceCounter(t *testing.T) {
 			assertNoErr(t, detector.StartLearning(mapID2name["carts"], 100))
 
 			for _, trace := range tt.traces {
-				backendAccessor.EXPECT().GetAPIInfo(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, apiID uint) (*database.APIInfo, error) {
-					return getAPIInfoWithTags(string(trace.APIEvent.Method), trace.resolvedPath), nil
-				}).AnyTimes()
+				backendAccessor.EXPECT().ResolveEventPath(context.TODO(), gomock.Any()).DoAndReturn(resolveEventWithSpecOf(string(trace.APIEvent.Method), trace.resolvedPath)).AnyTimes()
 				trace.APIEvent.APIInfoID = mapID2name[trace.K8SDestination.Uid]
 				detector.SendTrace(trace.CompositeTrace)
 				time.Sleep(100 * time.Millisecond)
@@ -229,9 +247,7 @@ func Test_learnAndDetectBFLA_BuildAuthzModelNoTraceCounter(t *testing.T) {
 			assertNoErr(t, detector.StartLearning(mapID2name["carts"], -1))
 
 			for _, trace := range tt.learningTraces {
-				backendAccessor.EXPECT().GetAPIInfo(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, apiID uint) (*database.APIInfo, error) {
-					return getAPIInfoWithTags(string(trace.APIEvent.Method), trace.resolvedPath), nil
-				}).Times(1)
+				backendAccessor.EXPECT().ResolveEventPath(context.TODO(), gomock.Any()).DoAndReturn(resolveEventWithSpecOf(string(trace.APIEvent.Method), trace.resolvedPath)).Times(1)
 				trace.APIEvent.APIInfoID = mapID2name[trace.K8SDestination.Uid]
 				detector.SendTrace(trace.CompositeTrace)
 				time.Sleep(100 * time.Millisecond)
@@ -253,9 +269,7 @@ func Test_learnAndDetectBFLA_BuildAuthzModelNoTraceCounter(t *testing.T) {
 			assertNoErr(t, detector.StartDetection(mapID2name["carts"]))
 
 			for _, trace := range tt.detectionTraces {
-				backendAccessor.EXPECT().GetAPIInfo(context.TODO(), gomock.Any()).DoAndReturn(func(ctx context.Context, apiID uint) (*database.APIInfo, error) {
-					return getAPIInfoWithTags(string(trace.APIEvent.Method), trace.resolvedPath), nil
-				}).AnyTimes()
+				backendAccessor.EXPECT().ResolveEventPath(context.TODO(), gomock.Any()).DoAndReturn(resolveEventWithSpecOf(string(trace.APIEvent.Method), trace.resolvedPath)).AnyTimes()
 				trace.APIEvent.APIInfoID = mapID2name[trace.K8SDestination.Uid]
 				detector.SendTrace(trace.CompositeTrace)
 				time.Sleep(100 * time.Millisecond)
@@ -405,6 +419,7 @@ func Test_learnAndDetectBFLA_DenyTrace(t *testing.T) {
 			backendAccessor.EXPECT().GetAPIInfo(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, apiID uint) (*database.APIInfo, error) {
 				return getAPIInfoWithTags("POST", "/carts/{id}/items"), nil
 			}).AnyTimes()
+			backendAccessor.EXPECT().ResolveSpecPath(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(resolveSpecPathWithSpecOf("POST", "/carts/{id}/items")).AnyTimes()
 			backendAccessor.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			detector := initBFLADetector(ctrl, backendAccessor, tt.authModels, storedBFLAStates, storedBFLAFindings)
 			backendAccessor.EXPECT().EnableTraces(context.TODO(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
@@ -535,6 +550,7 @@ func Test_learnAndDetectBFLA_ApproveTrace(t *testing.T) {
 			backendAccessor.EXPECT().GetAPIInfo(gomock.Any(), gomock.Any()).DoAndReturn(func(ctx context.Context, apiID uint) (*database.APIInfo, error) {
 				return getAPIInfoWithTags("POST", "/carts/{id}/merge"), nil
 			}).AnyTimes()
+			backendAccessor.EXPECT().ResolveSpecPath(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(resolveSpecPathWithSpecOf("POST", "/carts/{id}/merge")).AnyTimes()
 			backendAccessor.EXPECT().Notify(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
 			detector := initBFLADetector(ctrl, backendAccessor, tt.authModels, storedBFLAStates, storedBFLAFindings)
 			backendAccessor.EXPECT().EnableTraces(context.TODO(), gomock.Any(), gomock.Any()).Return(nil).Times(1)
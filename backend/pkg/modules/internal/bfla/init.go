@@ -46,6 +46,9 @@ type bfla struct {
 	httpHandler  http.Handler
 	bflaDetector bfladetector.BFLADetector
 	k8s          k8straceannotator.K8sClient
+	state        recovery.StatePersister
+
+	findingsRegistry bfladetector.FindingsRegistry
 
 	accessor core.BackendAccessor
 	info     *core.ModuleInfo
@@ -56,6 +59,16 @@ func (p *bfla) Info() core.ModuleInfo {
 }
 func (p *bfla) HTTPHandler() http.Handler { return p.httpHandler }
 
+func (p *bfla) Start(ctx context.Context) error { return nil }
+
+// Stop persists the state not yet persisted by the periodic persistence.
+func (p *bfla) Stop(ctx context.Context) error {
+	if err := p.state.Persist(ctx); err != nil {
+		return fmt.Errorf("failed to persist bfla state: %w", err)
+	}
+	return nil
+}
+
 func newModule(ctx context.Context, accessor core.BackendAccessor) (_ core.Module, err error) {
 	p := &bfla{
 		accessor: accessor,
@@ -74,20 +87,51 @@ func newModule(ctx context.Context, accessor core.BackendAccessor) (_ core.Modul
 	}
 
 	sp := recovery.NewStatePersister(ctx, accessor, bfladetector.ModuleName, persistenceInterval)
+	p.state = sp
+	p.findingsRegistry = bfladetector.NewFindingsRegistry(sp)
 	ctrlNotifier := bfladetector.NewBFLANotifier(bfladetector.ModuleName, accessor)
 	p.bflaDetector = bfladetector.NewBFLADetector(ctx, bfladetector.ModuleName, accessor, eventAlerter{accessor}, ctrlNotifier, sp, controllerResyncInterval)
 
+	// The config from the environment is only the default, the config stored in the database takes precedence.
+	conf, err := accessor.RegisterConfig(bfladetector.ModuleName, []byte(bfladetector.ConfigSchema), bfladetector.LoadConfig(), p.setConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register config: %w", err)
+	}
+	if err := p.setConfig(ctx, conf); err != nil {
+		return nil, err
+	}
+
 	p.httpHandler = restapi.HandlerWithOptions(&httpHandler{
 		bflaDetector:     p.bflaDetector,
 		state:            sp,
 		accessor:         accessor,
-		findingsRegistry: bfladetector.NewFindingsRegistry(sp),
+		findingsRegistry: p.findingsRegistry,
 	}, restapi.ChiServerOptions{
 		BaseURL: core.BaseHTTPPath + "/" + bfladetector.ModuleName,
 	})
 	return p, nil
 }
 
+// StoredAPIFindings returns the findings notified on an API, see core.APIFindingsProvider.
+func (p *bfla) StoredAPIFindings(_ context.Context, apiID uint) ([]oapicommon.APIFinding, error) {
+	findings, err := p.findingsRegistry.GetAll(apiID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get findings of API %v: %w", apiID, err)
+	}
+	return findings, nil
+}
+
+func (p *bfla) setConfig(_ context.Context, config []byte) error {
+	var conf bfladetector.BflaConfig
+	if err := json.Unmarshal(config, &conf); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+	if err := p.bflaDetector.SetConfig(conf); err != nil {
+		return fmt.Errorf("failed to set config: %w", err)
+	}
+	return nil
+}
+
 type eventAlerter struct {
 	accessor core.BackendAccessor
 }
@@ -242,16 +286,6 @@ func getBFLAAnnotations(ctx context.Context, modName string, accessor core.Backe
 	return
 }
 
-func (p *bfla) EventAnnotationNotify(modName string, eventID uint, ann core.Annotation) error {
-	log.Debugf("[BFLA] EventAnnotationNotify %s %d %s", modName, eventID, ann.Name)
-	return nil
-}
-
-func (p *bfla) APIAnnotationNotify(modName string, apiID uint, ann *core.Annotation) error {
-	log.Debugf("[BFLA] APIAnnotationNotify %s %d %s", modName, apiID, ann.Name)
-	return nil
-}
-
 type httpHandler struct {
 	state            recovery.StatePersister
 	bflaDetector     bfladetector.BFLADetector
@@ -288,9 +322,9 @@ func (h httpHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventID in
 		common.HTTPResponse(w, http.StatusInternalServerError, &oapicommon.ApiResponse{Message: err.Error()})
 		return
 	}
-	spc, err := bfladetector.GetOpenAPI(apiinfo, event.APIInfoID)
-	if err != nil {
-		log.Error("unable to get the spec")
+	resolved, resolveErr := h.accessor.ResolveEventPath(r.Context(), event)
+	if resolveErr != nil {
+		log.Warnf("path not found in the spec: %v", resolveErr)
 	}
 	if user != nil {
 		e.DetectedUser = &restapi.DetectedUser{
@@ -298,7 +332,9 @@ func (h httpHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventID in
 			Source:    restapi.DetectedUserSource(user.Source.String()),
 			IpAddress: user.IPAddress,
 		}
-		e.MismatchedScopes = user.IsMismatchedScopes(bfladetector.GetSpecOperation(spc, event.Method, event.Path))
+		if resolved != nil {
+			e.MismatchedScopes = user.IsMismatchedScopes(resolved.Operation)
+		}
 	}
 	specType := bfladetector.SpecTypeFromAPIInfo(apiinfo)
 	if specType == bfladetector.SpecTypeNone {
@@ -312,15 +348,8 @@ func (h httpHandler) GetEvent(w http.ResponseWriter, r *http.Request, eventID in
 		return
 	}
 
-	tags, err := bfladetector.ParseSpecInfo(apiinfo)
-	if err != nil {
-		log.Warnf("tags for apiID=%d not found", event.APIInfoID)
-	}
-	resolvedPath, _, err := bfladetector.ResolvePath(tags, event)
-	if err != nil {
-		log.Warnf("path not found in the spec: %v", err)
-	} else {
-		if obj, err := h.bflaDetector.FindSourceObj(resolvedPath, string(event.Method), src, event.APIInfoID); err != nil {
+	if resolved != nil {
+		if obj, err := h.bflaDetector.FindSourceObj(resolved.Path, string(event.Method), src, event.APIInfoID); err != nil {
 			log.Errorf("unable to map the source onto an existing entity in the auth model: %v", err)
 		} else if !obj.Authorized {
 			e.BflaStatus = bfladetector.ResolveBFLAStatusInt(int(event.StatusCode))
@@ -634,14 +663,6 @@ func (h httpHandler) PutEventIdOperation(w http.ResponseWriter, r *http.Request,
 		return
 	}
 
-	apiInfo, err := h.accessor.GetAPIInfo(r.Context(), apiEvent.APIInfoID)
-	if err != nil {
-		log.Error(err)
-		common.HTTPResponse(w, http.StatusBadRequest, &oapicommon.ApiResponse{
-			Message: err.Error(),
-		})
-		return
-	}
 	ctx := r.Context()
 	select {
 	case <-ctx.Done():
@@ -650,23 +671,19 @@ func (h httpHandler) PutEventIdOperation(w http.ResponseWriter, r *http.Request,
 		common.HTTPResponse(w, http.StatusInternalServerError, &oapicommon.ApiResponse{Message: err.Error()})
 	default:
 		log.Infof("apply %s operation on trace=%d", operation, eventID)
-		tags, err := bfladetector.ParseSpecInfo(apiInfo)
-		if err != nil {
-			log.Warnf("tags for apiID=%d not found", apiEvent.APIInfoID)
-		}
-		resolvedPath, _, err := bfladetector.ResolvePath(tags, apiEvent)
+		resolved, err := h.accessor.ResolveEventPath(ctx, apiEvent)
 		if err != nil {
 			log.Warnf("path not found in the spec: %v", err)
 		} else {
 			switch operation {
 			case restapi.Approve:
-				h.bflaDetector.ApproveTrace(resolvedPath, string(apiEvent.Method), src, apiEvent.APIInfoID, nil)
+				h.bflaDetector.ApproveTrace(resolved.Path, string(apiEvent.Method), src, apiEvent.APIInfoID, nil)
 			case restapi.Deny:
-				h.bflaDetector.DenyTrace(resolvedPath, string(apiEvent.Method), src, apiEvent.APIInfoID, nil)
+				h.bflaDetector.DenyTrace(resolved.Path, string(apiEvent.Method), src, apiEvent.APIInfoID, nil)
 			case restapi.ApproveUser:
-				h.bflaDetector.ApproveTrace(resolvedPath, string(apiEvent.Method), src, apiEvent.APIInfoID, user)
+				h.bflaDetector.ApproveTrace(resolved.Path, string(apiEvent.Method), src, apiEvent.APIInfoID, user)
 			case restapi.DenyUser:
-				h.bflaDetector.DenyTrace(resolvedPath, string(apiEvent.Method), src, apiEvent.APIInfoID, user)
+				h.bflaDetector.DenyTrace(resolved.Path, string(apiEvent.Method), src, apiEvent.APIInfoID, user)
 			}
 			log.Infof("%s operation applied successfully on trace=%d", operation, eventID)
 		}
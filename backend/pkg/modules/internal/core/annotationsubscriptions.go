@@ -0,0 +1,153 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"runtime/debug"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// AnnotationSubscriber is implemented by the modules which react to the annotations stored by the other modules
+// through the BackendAccessor. The notifications are synchronous, in the goroutine of the module storing the
+// annotations, so a subscriber must return quickly and hand any slow work to its own goroutine.
+type AnnotationSubscriber interface {
+	// AnnotationSubscriptions returns the annotations the module is notified of, it is called on every stored annotation.
+	AnnotationSubscriptions() []AnnotationSubscription
+	// EventAnnotationNotify is called once an annotation of an API event was stored by module modName.
+	EventAnnotationNotify(ctx context.Context, modName string, eventID uint, ann Annotation) error
+	// APIAnnotationNotify is called once an annotation of an API was stored by module modName.
+	APIAnnotationNotify(ctx context.Context, modName string, apiID uint, ann Annotation) error
+}
+
+// AnnotationSubscription selects the annotations a module is notified of.
+type AnnotationSubscription struct {
+	// ModuleName is the module storing the annotations, any module when empty.
+	ModuleName string
+	// Names are the names of the annotations, any annotation when empty.
+	Names []string
+}
+
+func (s AnnotationSubscription) matches(modName string, annName string) bool {
+	if s.ModuleName != "" && s.ModuleName != modName {
+		return false
+	}
+	if len(s.Names) == 0 {
+		return true
+	}
+	for _, name := range s.Names {
+		if name == annName {
+			return true
+		}
+	}
+	return false
+}
+
+type annotationSubscriber struct {
+	moduleName string
+	subscriber AnnotationSubscriber
+}
+
+func (s *annotationSubscriber) isSubscribed(modName string, annName string) bool {
+	// a module is not notified of its own annotations
+	if modName == s.moduleName {
+		return false
+	}
+	for _, subscription := range s.subscriber.AnnotationSubscriptions() {
+		if subscription.matches(modName, annName) {
+			return true
+		}
+	}
+	return false
+}
+
+// AnnotationSubscriptions notifies the subscribed modules of the annotations stored by the other modules.
+type AnnotationSubscriptions struct {
+	subscribers []*annotationSubscriber
+	// isEnabled tells whether a module is enabled, the disabled modules are not notified
+	isEnabled func(moduleName string) bool
+	lock      sync.RWMutex
+}
+
+func NewAnnotationSubscriptions() *AnnotationSubscriptions {
+	return &AnnotationSubscriptions{}
+}
+
+func (s *AnnotationSubscriptions) subscribe(moduleName string, subscriber AnnotationSubscriber) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.subscribers = append(s.subscribers, &annotationSubscriber{
+		moduleName: moduleName,
+		subscriber: subscriber,
+	})
+	log.Infof("Module %s subscribed to annotations", moduleName)
+}
+
+func (s *AnnotationSubscriptions) setEnabledFunc(isEnabled func(moduleName string) bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.isEnabled = isEnabled
+}
+
+func (s *AnnotationSubscriptions) publishEventAnnotations(ctx context.Context, modName string, eventID uint, annotations []Annotation) {
+	s.publish(modName, annotations, func(sub *annotationSubscriber, ann Annotation) error {
+		return sub.subscriber.EventAnnotationNotify(ctx, modName, eventID, ann)
+	})
+}
+
+func (s *AnnotationSubscriptions) publishAPIAnnotations(ctx context.Context, modName string, apiID uint, annotations []Annotation) {
+	s.publish(modName, annotations, func(sub *annotationSubscriber, ann Annotation) error {
+		return sub.subscriber.APIAnnotationNotify(ctx, modName, apiID, ann)
+	})
+}
+
+func (s *AnnotationSubscriptions) publish(modName string, annotations []Annotation, notify func(sub *annotationSubscriber, ann Annotation) error) {
+	if s == nil {
+		return
+	}
+
+	s.lock.RLock()
+	subscribers := s.subscribers
+	isEnabled := s.isEnabled
+	s.lock.RUnlock()
+
+	for _, sub := range subscribers {
+		if isEnabled != nil && !isEnabled(sub.moduleName) {
+			continue
+		}
+		for _, ann := range annotations {
+			if sub.isSubscribed(modName, ann.Name) {
+				notifySubscriber(sub, ann, notify)
+			}
+		}
+	}
+}
+
+func notifySubscriber(sub *annotationSubscriber, ann Annotation, notify func(sub *annotationSubscriber, ann Annotation) error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("Module %s panicked while being notified of annotation %s: %v\n%s", sub.moduleName, ann.Name, r, debug.Stack())
+		}
+	}()
+
+	if err := notify(sub, ann); err != nil {
+		log.Errorf("Module %s failed to handle annotation %s: %v", sub.moduleName, ann.Name, err)
+	}
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/config"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+type subscribedModule struct {
+	replayedModule
+	subscriptions []AnnotationSubscription
+	lock          sync.Mutex
+	notified      []string
+}
+
+func (m *subscribedModule) AnnotationSubscriptions() []AnnotationSubscription {
+	return m.subscriptions
+}
+
+func (m *subscribedModule) EventAnnotationNotify(ctx context.Context, modName string, eventID uint, ann Annotation) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.notified = append(m.notified, "event "+modName+" "+ann.Name)
+	return nil
+}
+
+func (m *subscribedModule) APIAnnotationNotify(ctx context.Context, modName string, apiID uint, ann Annotation) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.notified = append(m.notified, "api "+modName+" "+ann.Name)
+	return nil
+}
+
+func (m *subscribedModule) notifications() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	ret := m.notified
+	m.notified = nil
+	return ret
+}
+
+func TestAnnotationSubscriptions(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	apiInfo := &database.APIInfo{Name: "test.com", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(apiInfo)
+	event := &database.APIEvent{APIInfoID: apiInfo.ID, Path: "/pets"}
+	dbHandler.APIEventsTable().CreateAPIEvent(event)
+
+	subs := NewAnnotationSubscriptions()
+	ctx := context.Background()
+	b, err := NewAccessor(dbHandler, nil, nil, nil, nil, nil, nil, subs, &config.Config{})
+	assert.NilError(t, err)
+
+	detector := &subscribedModule{
+		replayedModule: replayedModule{name: "detector"},
+		subscriptions: []AnnotationSubscription{
+			{ModuleName: "bfla"},
+			{Names: []string{"fuzzer_findings"}},
+		},
+	}
+	subs.subscribe("detector", detector)
+	enabled := true
+	subs.setEnabledFunc(func(moduleName string) bool { return enabled })
+
+	assert.NilError(t, b.CreateAPIEventAnnotations(ctx, "bfla", event.ID, Annotation{Name: "bfla_alert", Annotation: []byte("{}")}))
+	assert.NilError(t, b.StoreAPIInfoAnnotations(ctx, "bfla", apiInfo.ID, Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	assert.NilError(t, b.StoreAPIInfoAnnotations(ctx, "fuzzer", apiInfo.ID,
+		Annotation{Name: "fuzzer_findings", Annotation: []byte("{}")},
+		Annotation{Name: "fuzzer_status", Annotation: []byte("{}")},
+	))
+	assert.DeepEqual(t, detector.notifications(), []string{"event bfla bfla_alert", "api bfla bfla_findings", "api fuzzer fuzzer_findings"})
+
+	// a module is not notified of its own annotations
+	assert.NilError(t, b.StoreAPIInfoAnnotations(ctx, "detector", apiInfo.ID, Annotation{Name: "fuzzer_findings", Annotation: []byte("{}")}))
+	assert.Equal(t, len(detector.notifications()), 0)
+
+	// a disabled module is not notified
+	enabled = false
+	assert.NilError(t, b.StoreAPIInfoAnnotations(ctx, "bfla", apiInfo.ID, Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	assert.Equal(t, len(detector.notifications()), 0)
+}
@@ -0,0 +1,177 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/api3/notifications"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+const apiFindingsNotificationType = "ApiFindingsNotification"
+
+// storeAPIFindings indexes the findings notified by a module on an API, replacing the ones it notified before,
+// so that the findings of all the modules are queried together under /api/apiFindings.
+func (b *accessor) storeAPIFindings(ctx context.Context, modName string, apiID uint, n notifications.APIClarityNotification) error {
+	discriminator, err := n.Discriminator()
+	if err != nil {
+		return fmt.Errorf("failed to get notification type: %v", err)
+	}
+	if discriminator != apiFindingsNotificationType {
+		return nil
+	}
+	apiFindings, err := n.AsApiFindingsNotification()
+	if err != nil {
+		return fmt.Errorf("failed to decode API findings notification: %v", err)
+	}
+
+	var findings []*database.APIFinding
+	if apiFindings.Items != nil {
+		for i := range *apiFindings.Items {
+			finding, err := toDBAPIFinding(&(*apiFindings.Items)[i])
+			if err != nil {
+				return err
+			}
+			findings = append(findings, finding)
+		}
+	}
+
+	if err := b.dbHandler.APIFindingsTable().Set(ctx, modName, apiID, findings, time.Now()); err != nil {
+		return fmt.Errorf("failed to store API findings: %v", err)
+	}
+	return nil
+}
+
+// APIFindingsProvider is implemented by the modules which store the API findings they notify. When APIClarity starts,
+// their stored findings are imported in the index, so that the findings notified before it existed, or while it failed
+// to be written, are queried too.
+type APIFindingsProvider interface {
+	// StoredAPIFindings returns the findings the module stored on an API.
+	StoredAPIFindings(ctx context.Context, apiID uint) ([]oapicommon.APIFinding, error)
+}
+
+// APIFindingsBackfill imports the findings stored by the modules in the API findings index, in the background.
+type APIFindingsBackfill struct {
+	dbHandler database.Database
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewAPIFindingsBackfill(dbHandler database.Database) *APIFindingsBackfill {
+	return &APIFindingsBackfill{
+		dbHandler: dbHandler,
+		cancel:    func() {},
+	}
+}
+
+// start imports the findings stored by the providers on all the APIs, by module name.
+func (b *APIFindingsBackfill) start(ctx context.Context, providers map[string]APIFindingsProvider) {
+	if len(providers) == 0 {
+		return
+	}
+	ctx, b.cancel = context.WithCancel(ctx)
+
+	b.wg.Add(1)
+	go func() {
+		defer b.wg.Done()
+
+		if err := b.run(ctx, providers); err != nil {
+			log.Errorf("Failed to import the stored API findings: %v", err)
+		}
+	}()
+}
+
+func (b *APIFindingsBackfill) run(ctx context.Context, providers map[string]APIFindingsProvider) error {
+	apiIDs, err := b.dbHandler.APIInventoryTable().GetAPIIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for modName, provider := range providers {
+		imported := 0
+		for _, apiID := range apiIDs {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			findings, err := provider.StoredAPIFindings(ctx, apiID)
+			if err != nil {
+				log.Errorf("Failed to get the findings of module %s on API %v: %v", modName, apiID, err)
+				continue
+			}
+			if err := b.importAPIFindings(ctx, modName, apiID, findings); err != nil {
+				log.Errorf("Failed to import the findings of module %s on API %v: %v", modName, apiID, err)
+				continue
+			}
+			imported += len(findings)
+		}
+		log.Infof("Imported %v stored API findings of module %s", imported, modName)
+	}
+	return nil
+}
+
+func (b *APIFindingsBackfill) importAPIFindings(ctx context.Context, modName string, apiID uint, apiFindings []oapicommon.APIFinding) error {
+	findings := make([]*database.APIFinding, 0, len(apiFindings))
+	for i := range apiFindings {
+		finding, err := toDBAPIFinding(&apiFindings[i])
+		if err != nil {
+			return err
+		}
+		findings = append(findings, finding)
+	}
+	// the findings are not seen again, only the ones missing from the index get the import time
+	if err := b.dbHandler.APIFindingsTable().Import(ctx, modName, apiID, findings, time.Now()); err != nil {
+		return fmt.Errorf("failed to import API findings: %v", err)
+	}
+	return nil
+}
+
+// stop cancels the import and waits for it to end.
+func (b *APIFindingsBackfill) stop() {
+	b.cancel()
+	b.wg.Wait()
+}
+
+func toDBAPIFinding(finding *oapicommon.APIFinding) (*database.APIFinding, error) {
+	ret := &database.APIFinding{
+		Source:      finding.Source,
+		Type:        finding.Type,
+		Name:        finding.Name,
+		Description: finding.Description,
+		Severity:    string(finding.Severity),
+	}
+	if finding.ProvidedSpecLocation != nil {
+		ret.ProvidedSpecLocation = *finding.ProvidedSpecLocation
+	}
+	if finding.ReconstructedSpecLocation != nil {
+		ret.ReconstructedSpecLocation = *finding.ReconstructedSpecLocation
+	}
+	if finding.AdditionalInfo != nil {
+		additionalInfo, err := json.Marshal(finding.AdditionalInfo)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal additional info of finding %q: %v", finding.Name, err)
+		}
+		ret.AdditionalInfo = additionalInfo
+	}
+	return ret, nil
+}
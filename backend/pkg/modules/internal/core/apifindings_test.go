@@ -0,0 +1,166 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/api3/notifications"
+	"github.com/openclarity/apiclarity/backend/pkg/config"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/notifier"
+)
+
+func TestAccessor_NotifyStoresAPIFindings(t *testing.T) {
+	ctx := context.Background()
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	apiInfo := &database.APIInfo{Name: "test.com", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(apiInfo)
+	accessor, err := NewAccessor(dbHandler, nil, nil, nil, nil, nil, nil, nil, &config.Config{})
+	assert.NilError(t, err)
+
+	notify := func(modName string, items ...oapicommon.APIFinding) {
+		n := notifications.APIClarityNotification{}
+		assert.NilError(t, n.FromApiFindingsNotification(notifications.ApiFindingsNotification{
+			NotificationType: apiFindingsNotificationType,
+			Items:            &items,
+		}))
+		assert.NilError(t, accessor.Notify(ctx, modName, apiInfo.ID, n))
+	}
+	list := func() []string {
+		findings, _, err := dbHandler.APIFindingsTable().GetAPIFindingsAndTotal(operations.GetAPIFindingsParams{
+			Page: 1, PageSize: 50, SortKey: string(models.APIFindingSortKeyModuleName),
+		})
+		assert.NilError(t, err)
+		names := []string{}
+		for _, f := range findings {
+			names = append(names, f.ModuleName+" "+f.Name+" "+f.Severity)
+		}
+		return names
+	}
+
+	location := "/paths/~1login/post"
+	notify("traceanalyzer", oapicommon.APIFinding{Type: "WEAK_JWT", Name: "weak", Severity: oapicommon.HIGH, ProvidedSpecLocation: &location})
+	notify("fuzzer", oapicommon.APIFinding{Type: "FUZZ", Name: "crash", Severity: oapicommon.CRITICAL})
+	assert.DeepEqual(t, list(), []string{"fuzzer crash CRITICAL", "traceanalyzer weak HIGH"})
+
+	// the findings of a module replace its previous ones only
+	notify("traceanalyzer")
+	assert.DeepEqual(t, list(), []string{"fuzzer crash CRITICAL"})
+}
+
+func TestAccessor_NotifyForwardsWhenIndexingFails(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	assert.NilError(t, dbHandler.DB.Migrator().DropTable(&database.APIFinding{}))
+
+	received := make(chan string, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received <- r.URL.Path
+	}))
+	defer server.Close()
+	n := notifier.NewNotifier(server.URL, 1, 1, nil)
+	n.Start(ctx)
+	accessor, err := NewAccessor(dbHandler, nil, nil, nil, n, nil, nil, nil, &config.Config{})
+	assert.NilError(t, err)
+
+	notification := notifications.APIClarityNotification{}
+	assert.NilError(t, notification.FromApiFindingsNotification(notifications.ApiFindingsNotification{
+		NotificationType: apiFindingsNotificationType,
+		Items:            &[]oapicommon.APIFinding{{Type: "FUZZ", Name: "crash", Severity: oapicommon.CRITICAL}},
+	}))
+	assert.NilError(t, accessor.Notify(ctx, "fuzzer", 7, notification))
+
+	select {
+	case path := <-received:
+		assert.Equal(t, path, "/notification/7")
+	case <-time.After(5 * time.Second):
+		t.Fatal("notification not forwarded")
+	}
+}
+
+type fakeAPIFindingsProvider map[uint][]oapicommon.APIFinding
+
+func (p fakeAPIFindingsProvider) StoredAPIFindings(_ context.Context, apiID uint) ([]oapicommon.APIFinding, error) {
+	findings, ok := p[apiID]
+	if !ok {
+		return nil, fmt.Errorf("unknown API %v", apiID)
+	}
+	return findings, nil
+}
+
+func TestAPIFindingsBackfill_run(t *testing.T) {
+	ctx := context.Background()
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api1 := &database.APIInfo{Name: "test1.com", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(api1)
+	api2 := &database.APIInfo{Name: "test2.com", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(api2)
+
+	// a finding indexed before the backfill is replaced by the stored ones
+	assert.NilError(t, dbHandler.APIFindingsTable().Set(ctx, "traceanalyzer", api1.ID, []*database.APIFinding{
+		{Type: "OLD", Name: "old", Severity: string(oapicommon.LOW)},
+	}, time.Now()))
+
+	backfill := NewAPIFindingsBackfill(dbHandler)
+	assert.NilError(t, backfill.run(ctx, map[string]APIFindingsProvider{
+		"traceanalyzer": fakeAPIFindingsProvider{
+			api1.ID: {{Type: "WEAK_JWT", Name: "weak", Severity: oapicommon.HIGH}},
+			api2.ID: {{Type: "NLID", Name: "nlid", Severity: oapicommon.MEDIUM}},
+		},
+		// the failure of a provider on an API doesn't prevent importing the others
+		"customrules": fakeAPIFindingsProvider{
+			api2.ID: {{Type: "RULE", Name: "rule", Severity: oapicommon.CRITICAL}},
+		},
+	}))
+
+	findings, _, err := dbHandler.APIFindingsTable().GetAPIFindingsAndTotal(operations.GetAPIFindingsParams{
+		Page: 1, PageSize: 50, SortKey: string(models.APIFindingSortKeyModuleName),
+	})
+	assert.NilError(t, err)
+	names := []string{}
+	for _, f := range findings {
+		names = append(names, fmt.Sprintf("%s %v %s", f.ModuleName, f.APIInfoID, f.Name))
+	}
+	sort.Strings(names)
+	assert.DeepEqual(t, names, []string{
+		fmt.Sprintf("customrules %v rule", api2.ID),
+		fmt.Sprintf("traceanalyzer %v weak", api1.ID),
+		fmt.Sprintf("traceanalyzer %v nlid", api2.ID),
+	})
+}
@@ -17,16 +17,22 @@ package core
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"net/http"
 	"os"
 	"path/filepath"
 	"runtime"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 
 	"github.com/openclarity/apiclarity/backend/pkg/config"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
 	"github.com/openclarity/apiclarity/backend/pkg/sampling"
 )
 
@@ -61,14 +67,70 @@ func RegisterModule(m ModuleFactory) {
 
 type ModuleFactory func(ctx context.Context, accessor BackendAccessor) (Module, error)
 
-func New(ctx context.Context, accessor BackendAccessor, samplingManager *sampling.TraceSamplingManager, traceSamplingEnabled bool) (Module, []ModuleInfo) {
+var ErrModuleNotFound = errors.New("module not found")
+
+// ModuleStatus is a module along with whether it is enabled.
+type ModuleStatus struct {
+	ModuleInfo
+	Enabled bool
+}
+
+// ModulesManager is the Module dispatching to all the modules, which can be enabled and disabled at runtime.
+type ModulesManager interface {
+	Module
+
+	// ModulesStatus returns the modules with whether they are enabled.
+	ModulesStatus() []ModuleStatus
+	// SetModuleEnabled enables or disables a module, a disabled module receives no event and doesn't serve its API.
+	SetModuleEnabled(name string, enabled bool) (*ModuleStatus, error)
+	// APISpecsChanged must be called once the specs of an API are uploaded, approved or deleted.
+	APISpecsChanged(apiID uint)
+	// DispatchStats returns the counters of the dispatch of the events, by module name.
+	DispatchStats() map[string]DispatchStats
+	// StartReplay replays in the background the stored events of an API in [startTime, endTime] through an enabled module.
+	StartReplay(moduleName string, apiID uint, startTime, endTime time.Time) (*Replay, error)
+	// Replays returns the replays, the latest first.
+	Replays() []Replay
+	GetReplay(id uint) (*Replay, error)
+	// CancelReplay cancels a running replay.
+	CancelReplay(id uint) (*Replay, error)
+}
+
+// New creates the registered modules and the remote modules, which aren't registered since they're configured at runtime.
+func New(ctx context.Context, accessor BackendAccessor, samplingManager *sampling.TraceSamplingManager, traceSamplingEnabled bool, moduleStates database.ModuleStatesTable, dispatchConfig *DispatchConfig, moduleConfigs *ModuleConfigs, apiSpecs *APISpecsCache, replays *Replays, findingsBackfill *APIFindingsBackfill, annotationSubs *AnnotationSubscriptions, remoteModules map[string]ModuleFactory) (ModulesManager, []ModuleInfo, error) {
 	c := &Core{}
 	c.Modules = map[string]Module{}
+	c.dispatchers = map[string]*moduleDispatcher{}
 	c.samplingManager = samplingManager
 	c.traceSamplingEnabled = traceSamplingEnabled
+	c.moduleStates = moduleStates
+	c.moduleConfigs = moduleConfigs
+	c.apiSpecs = apiSpecs
+	c.replays = replays
+	c.findingsBackfill = findingsBackfill
+	c.disabled = map[string]bool{}
+
+	states, err := moduleStates.GetAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get modules states: %v", err)
+	}
+	for _, state := range states {
+		c.disabled[state.Name] = !state.Enabled
+	}
+
+	factories := make(map[string]ModuleFactory, len(modules)+len(remoteModules))
+	for moduleName, moduleFactory := range modules {
+		factories[moduleName] = moduleFactory
+	}
+	for moduleName, moduleFactory := range remoteModules {
+		if _, ok := factories[moduleName]; ok {
+			return nil, nil, fmt.Errorf("remote module %s has the name of a module of APIClarity", moduleName)
+		}
+		factories[moduleName] = moduleFactory
+	}
 
 	modInfos := []ModuleInfo{}
-	for moduleFolderName, moduleFactory := range modules {
+	for moduleFolderName, moduleFactory := range factories {
 		module, err := moduleFactory(ctx, accessor)
 		if err != nil {
 			log.Error(err)
@@ -77,17 +139,44 @@ func New(ctx context.Context, accessor BackendAccessor, samplingManager *samplin
 		if module.Info().Name != moduleFolderName {
 			log.Panicf("module %s's name does not match with folder name containing the module %s", module.Info().Name, moduleFolderName)
 		}
-		log.Infof("Module %s initialized", module.Info().Name)
+		log.Infof("Module %s initialized (enabled=%v)", module.Info().Name, !c.disabled[moduleFolderName])
 		c.Modules[moduleFolderName] = module
+		c.dispatchers[moduleFolderName] = newModuleDispatcher(moduleFolderName, module, dispatchConfig)
+		if subscriber, ok := module.(AnnotationSubscriber); ok && annotationSubs != nil {
+			annotationSubs.subscribe(moduleFolderName, subscriber)
+		}
 		modInfos = append(modInfos, module.Info())
 	}
-	return c, modInfos
+	if annotationSubs != nil {
+		annotationSubs.setEnabledFunc(c.isEnabled)
+	}
+	return c, modInfos, nil
 }
 
 type Core struct {
 	Modules              map[string]Module
 	samplingManager      *sampling.TraceSamplingManager
 	traceSamplingEnabled bool
+
+	// each module handles the events with its own queue and workers
+	dispatchers map[string]*moduleDispatcher
+
+	// the modules disabled at runtime, the modules without state are enabled
+	moduleStates database.ModuleStatesTable
+	disabled     map[string]bool
+	lock         sync.RWMutex
+
+	// the configs registered by the modules, served whether the module is enabled or not
+	moduleConfigs *ModuleConfigs
+
+	// the parsed specs shared by the modules
+	apiSpecs *APISpecsCache
+
+	// the replays of the stored events through the modules
+	replays *Replays
+
+	// the import of the API findings stored by the modules
+	findingsBackfill *APIFindingsBackfill
 }
 
 func (c *Core) Info() ModuleInfo {
@@ -124,7 +213,10 @@ func (c *Core) EventNotify(ctx context.Context, event *Event) {
 	port := event.APIEvent.DestinationPort
 	traceSourceID := event.APIInfo.TraceSourceID
 
-	for modName, mod := range c.Modules {
+	for modName := range c.Modules {
+		if !c.isEnabled(modName) {
+			continue
+		}
 		if c.traceSamplingEnabled {
 			hosts, err := c.samplingManager.GetHostsToTrace(modName, traceSourceID)
 			if err != nil {
@@ -135,20 +227,203 @@ func (c *Core) EventNotify(ctx context.Context, event *Event) {
 				log.Debugf("Trace of host %s should NOT be sent to module %s.", host, modName)
 				continue
 			}
+			if !c.samplingManager.ShouldTraceEndpoint(modName, event.APIInfo.ID, string(event.APIEvent.Method), event.APIEvent.Path) {
+				log.Debugf("Trace of %s %s of host %s should NOT be sent to module %s.", event.APIEvent.Method, event.APIEvent.Path, host, modName)
+				continue
+			}
+		}
+		if c.samplingManager != nil && !c.samplingManager.ShouldSample(modName, event.APIInfo.ID) {
+			log.Debugf("Trace of host %s is sampled out for module %s.", host, modName)
+			continue
+		}
+		if c.traceSamplingEnabled && !c.samplingManager.CountTracedEvent(modName, event.APIInfo.ID) {
+			log.Debugf("Tracing session of host %s has ended for module %s.", host, modName)
+			continue
 		}
 		log.Debugf("Trace of host %s should be sent to module %s.", host, modName)
 
-		mod.EventNotify(ctx, event)
+		c.dispatchers[modName].enqueue(ctx, event)
+	}
+}
+
+// Start starts the modules, including the disabled ones so that they can be enabled at runtime, and the dispatch
+// of the events to them. Once they are started, the API findings they stored are imported in the background.
+func (c *Core) Start(ctx context.Context) error {
+	providers := map[string]APIFindingsProvider{}
+	for _, moduleName := range c.moduleNames() {
+		if err := c.Modules[moduleName].Start(ctx); err != nil {
+			return fmt.Errorf("failed to start module %s: %v", moduleName, err)
+		}
+		c.dispatchers[moduleName].start(ctx)
+		log.Infof("Module %s started", moduleName)
+		if provider, ok := c.Modules[moduleName].(APIFindingsProvider); ok {
+			providers[moduleName] = provider
+		}
+	}
+	if c.findingsBackfill != nil {
+		c.findingsBackfill.start(ctx, providers)
 	}
+	return nil
+}
+
+// Stop waits for the modules to handle their queued events, then stops them one after the other so that they flush
+// their state. A module which doesn't stop before ctx is done is abandoned, and the remaining modules are still asked to stop.
+func (c *Core) Stop(ctx context.Context) error {
+	if c.replays != nil {
+		c.replays.stop()
+	}
+	if c.findingsBackfill != nil {
+		c.findingsBackfill.stop()
+	}
+
+	var failed []string
+	for _, moduleName := range c.moduleNames() {
+		if err := c.dispatchers[moduleName].stop(ctx); err != nil {
+			log.Errorf("Failed to dispatch the queued events to module %s: %v", moduleName, err)
+		}
+		if err := stopModule(ctx, c.Modules[moduleName]); err != nil {
+			log.Errorf("Failed to stop module %s: %v", moduleName, err)
+			failed = append(failed, moduleName)
+			continue
+		}
+		log.Infof("Module %s stopped", moduleName)
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to stop modules: %s", strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+func stopModule(ctx context.Context, m Module) error {
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- m.Stop(ctx)
+	}()
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("module did not stop in time: %w", ctx.Err())
+	}
+}
+
+func (c *Core) moduleNames() []string {
+	names := make([]string, 0, len(c.Modules))
+	for moduleName := range c.Modules {
+		names = append(names, moduleName)
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (c *Core) HTTPHandler() http.Handler {
 	handler := http.NewServeMux()
 	for moduleName, m := range c.Modules {
 		if m.HTTPHandler() != nil {
-			handler.Handle(BaseHTTPPath+"/"+moduleName+"/", m.HTTPHandler())
+			handler.Handle(BaseHTTPPath+"/"+moduleName+"/", c.enabledModuleHandler(moduleName, m.HTTPHandler()))
+		}
+		if c.moduleConfigs.isRegistered(moduleName) {
+			handler.Handle(BaseHTTPPath+"/"+moduleName+"/config", c.moduleConfigs.configHandler(moduleName))
 		}
 	}
 
 	return handler
 }
+
+// enabledModuleHandler serves the API of a module only while it is enabled.
+func (c *Core) enabledModuleHandler(moduleName string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !c.isEnabled(moduleName) {
+			http.Error(w, fmt.Sprintf("module %s is disabled", moduleName), http.StatusNotFound)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c *Core) isEnabled(moduleName string) bool {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return !c.disabled[moduleName]
+}
+
+func (c *Core) ModulesStatus() []ModuleStatus {
+	statuses := make([]ModuleStatus, 0, len(c.Modules))
+	for moduleName, m := range c.Modules {
+		statuses = append(statuses, ModuleStatus{
+			ModuleInfo: m.Info(),
+			Enabled:    c.isEnabled(moduleName),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+func (c *Core) APISpecsChanged(apiID uint) {
+	if c.apiSpecs != nil {
+		c.apiSpecs.Invalidate(apiID)
+	}
+}
+
+func (c *Core) DispatchStats() map[string]DispatchStats {
+	stats := make(map[string]DispatchStats, len(c.dispatchers))
+	for moduleName, d := range c.dispatchers {
+		stats[moduleName] = d.stats()
+	}
+	return stats
+}
+
+func (c *Core) SetModuleEnabled(name string, enabled bool) (*ModuleStatus, error) {
+	m, ok := c.Modules[name]
+	if !ok {
+		return nil, fmt.Errorf("failed to set state of module %s: %w", name, ErrModuleNotFound)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if err := c.moduleStates.Set(&database.ModuleState{Name: name, Enabled: enabled}); err != nil {
+		return nil, fmt.Errorf("failed to set state of module %s: %v", name, err)
+	}
+	c.disabled[name] = !enabled
+	log.Infof("Module %s enabled=%v", name, enabled)
+
+	return &ModuleStatus{
+		ModuleInfo: m.Info(),
+		Enabled:    enabled,
+	}, nil
+}
+
+func (c *Core) StartReplay(moduleName string, apiID uint, startTime, endTime time.Time) (*Replay, error) {
+	dispatcher, ok := c.dispatchers[moduleName]
+	if !ok {
+		return nil, fmt.Errorf("failed to replay events through module %s: %w", moduleName, ErrModuleNotFound)
+	}
+	if !c.isEnabled(moduleName) {
+		return nil, fmt.Errorf("failed to replay events through module %s: %w: the module is disabled", moduleName, ErrInvalidReplay)
+	}
+
+	replay, err := c.replays.start(dispatcher, apiID, startTime, endTime)
+	if err != nil {
+		return nil, fmt.Errorf("failed to replay events through module %s: %w", moduleName, err)
+	}
+	log.Infof("Replay %v of API %v through module %s started", replay.ID, apiID, moduleName)
+
+	return replay, nil
+}
+
+func (c *Core) Replays() []Replay {
+	return c.replays.List()
+}
+
+func (c *Core) GetReplay(id uint) (*Replay, error) {
+	return c.replays.Get(id)
+}
+
+func (c *Core) CancelReplay(id uint) (*Replay, error) {
+	return c.replays.Cancel(id)
+}
@@ -16,7 +16,18 @@
 package core
 
 import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
 )
 
 func TestShouldTrace(t *testing.T) {
@@ -158,3 +169,162 @@ func TestShouldTrace(t *testing.T) {
 		})
 	}
 }
+
+type countingModule struct {
+	name    string
+	events  int32
+	started bool
+	stopped bool
+	// when set, Stop hangs until it is closed
+	stopBlock chan struct{}
+}
+
+func (m *countingModule) Info() ModuleInfo {
+	return ModuleInfo{Name: m.name, Description: m.name + " module"}
+}
+
+func (m *countingModule) EventNotify(ctx context.Context, event *Event) {
+	atomic.AddInt32(&m.events, 1)
+}
+
+func (m *countingModule) HTTPHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+}
+
+func (m *countingModule) eventsCount() int32 {
+	return atomic.LoadInt32(&m.events)
+}
+
+func (m *countingModule) Start(ctx context.Context) error {
+	m.started = true
+	return nil
+}
+
+func (m *countingModule) Stop(ctx context.Context) error {
+	if m.stopBlock != nil {
+		<-m.stopBlock
+	}
+	m.stopped = true
+	return nil
+}
+
+// waitDispatched waits for the modules to handle the events enqueued so far.
+func waitDispatched(t *testing.T, c ModulesManager) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		pending := false
+		for _, stats := range c.DispatchStats() {
+			if stats.Processed < stats.Enqueued {
+				pending = true
+			}
+		}
+		if !pending {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("the events were not dispatched in time")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestCore_SetModuleEnabled(t *testing.T) {
+	mod1 := &countingModule{name: "mod1"}
+	mod2 := &countingModule{name: "mod2"}
+	modules["mod1"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return mod1, nil }
+	modules["mod2"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return mod2, nil }
+	t.Cleanup(func() {
+		delete(modules, "mod1")
+		delete(modules, "mod2")
+	})
+
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	assert.NilError(t, dbHandler.ModuleStatesTable().Set(&database.ModuleState{Name: "mod2", Enabled: false}))
+
+	c, infos, err := New(context.Background(), nil, nil, false, dbHandler.ModuleStatesTable(), &DispatchConfig{}, nil, nil, nil, nil, nil, nil)
+	assert.NilError(t, err)
+	assert.Equal(t, len(infos), 2)
+	assert.DeepEqual(t, c.ModulesStatus(), []ModuleStatus{
+		{ModuleInfo: mod1.Info(), Enabled: true},
+		{ModuleInfo: mod2.Info(), Enabled: false},
+	})
+
+	event := &Event{APIEvent: &database.APIEvent{}, APIInfo: &database.APIInfo{}}
+	serve := func(moduleName string) int {
+		rec := httptest.NewRecorder()
+		c.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, BaseHTTPPath+"/"+moduleName+"/findings", nil))
+		return rec.Code
+	}
+
+	assert.NilError(t, c.Start(context.Background()))
+
+	// the disabled module receives no event and doesn't serve its API
+	c.EventNotify(context.Background(), event)
+	waitDispatched(t, c)
+	assert.Equal(t, mod1.eventsCount(), int32(1))
+	assert.Equal(t, mod2.eventsCount(), int32(0))
+	assert.Equal(t, serve("mod1"), http.StatusOK)
+	assert.Equal(t, serve("mod2"), http.StatusNotFound)
+
+	status, err := c.SetModuleEnabled("mod2", true)
+	assert.NilError(t, err)
+	assert.Equal(t, status.Enabled, true)
+	_, err = c.SetModuleEnabled("mod1", false)
+	assert.NilError(t, err)
+	_, err = c.SetModuleEnabled("mod3", false)
+	assert.Assert(t, errors.Is(err, ErrModuleNotFound))
+
+	c.EventNotify(context.Background(), event)
+	waitDispatched(t, c)
+	assert.Equal(t, mod1.eventsCount(), int32(1))
+	assert.Equal(t, mod2.eventsCount(), int32(1))
+	assert.Equal(t, serve("mod1"), http.StatusNotFound)
+	assert.Equal(t, serve("mod2"), http.StatusOK)
+
+	// the states are persisted
+	c, _, err = New(context.Background(), nil, nil, false, dbHandler.ModuleStatesTable(), &DispatchConfig{}, nil, nil, nil, nil, nil, nil)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, c.ModulesStatus(), []ModuleStatus{
+		{ModuleInfo: mod1.Info(), Enabled: false},
+		{ModuleInfo: mod2.Info(), Enabled: true},
+	})
+}
+
+func TestCore_StartStop(t *testing.T) {
+	mod1 := &countingModule{name: "mod1"}
+	mod2 := &countingModule{name: "mod2", stopBlock: make(chan struct{})}
+	modules["mod1"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return mod1, nil }
+	modules["mod2"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return mod2, nil }
+	t.Cleanup(func() {
+		close(mod2.stopBlock)
+		delete(modules, "mod1")
+		delete(modules, "mod2")
+	})
+
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	// the disabled modules are started and stopped as well
+	assert.NilError(t, dbHandler.ModuleStatesTable().Set(&database.ModuleState{Name: "mod2", Enabled: false}))
+
+	c, _, err := New(context.Background(), nil, nil, false, dbHandler.ModuleStatesTable(), &DispatchConfig{}, nil, nil, nil, nil, nil, nil)
+	assert.NilError(t, err)
+
+	assert.NilError(t, c.Start(context.Background()))
+	assert.Assert(t, mod1.started)
+	assert.Assert(t, mod2.started)
+
+	// mod2 doesn't stop in time
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	err = c.Stop(ctx)
+	assert.Error(t, err, "failed to stop modules: mod2")
+	assert.Assert(t, mod1.stopped)
+}
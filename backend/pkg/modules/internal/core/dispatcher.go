@@ -0,0 +1,313 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+type DispatchPolicy string
+
+const (
+	// DispatchPolicyDrop drops the events of a module when its queue is full, so that the ingestion is never delayed.
+	DispatchPolicyDrop DispatchPolicy = "drop"
+	// DispatchPolicyBlock makes the ingestion wait for room in the queue of the module, no event is lost.
+	DispatchPolicyBlock DispatchPolicy = "block"
+
+	DefaultDispatchQueueSize = 1000
+	DefaultDispatchWorkers   = 1
+)
+
+// DispatchConfig configures the dispatch of the events to the modules. Each module has its own queue and workers,
+// with a single worker (the default) a module handles the events in order.
+type DispatchConfig struct {
+	QueueSize int
+	Workers   int
+	Policy    DispatchPolicy
+	// the policies of specific modules, by module name
+	ModulesPolicies map[string]DispatchPolicy
+}
+
+// NewDispatchConfig creates a dispatch config, the policy overrides have the format <module>=drop|block.
+func NewDispatchConfig(queueSize int, workers int, policy string, policyOverrides []string) (*DispatchConfig, error) {
+	c := &DispatchConfig{
+		QueueSize:       queueSize,
+		Workers:         workers,
+		Policy:          DispatchPolicyDrop,
+		ModulesPolicies: make(map[string]DispatchPolicy, len(policyOverrides)),
+	}
+	if policy != "" {
+		p, err := parseDispatchPolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+		c.Policy = p
+	}
+	for _, override := range policyOverrides {
+		const overrideParts = 2
+		parts := strings.SplitN(override, "=", overrideParts)
+		if len(parts) != overrideParts || parts[0] == "" {
+			return nil, fmt.Errorf("invalid module dispatch policy override %q, expected <module>=%s|%s", override, DispatchPolicyDrop, DispatchPolicyBlock)
+		}
+		p, err := parseDispatchPolicy(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		c.ModulesPolicies[parts[0]] = p
+	}
+
+	return c, nil
+}
+
+func parseDispatchPolicy(policy string) (DispatchPolicy, error) {
+	switch p := DispatchPolicy(strings.ToLower(policy)); p {
+	case DispatchPolicyDrop, DispatchPolicyBlock:
+		return p, nil
+	default:
+		return "", fmt.Errorf("invalid module dispatch policy %q, expected %s or %s", policy, DispatchPolicyDrop, DispatchPolicyBlock)
+	}
+}
+
+func (c *DispatchConfig) policy(moduleName string) DispatchPolicy {
+	if p, ok := c.ModulesPolicies[moduleName]; ok {
+		return p
+	}
+	return c.Policy
+}
+
+type LatencyStats struct {
+	Count   uint64  `json:"count"`
+	TotalMs float64 `json:"totalMs"`
+	AvgMs   float64 `json:"avgMs"`
+	MaxMs   float64 `json:"maxMs"`
+}
+
+// DispatchStats are the counters of the dispatch of the events to a module.
+type DispatchStats struct {
+	Policy        DispatchPolicy `json:"policy"`
+	QueueDepth    int            `json:"queueDepth"`
+	QueueCapacity int            `json:"queueCapacity"`
+	Workers       int            `json:"workers"`
+	Enqueued      uint64         `json:"enqueued"`
+	Dropped       uint64         `json:"dropped"`
+	Processed     uint64         `json:"processed"`
+	Panics        uint64         `json:"panics"`
+	// the time spent by the module to handle an event
+	Latency LatencyStats `json:"latency"`
+}
+
+type dispatchMetrics struct {
+	enqueued   uint64
+	dropped    uint64
+	processed  uint64
+	panics     uint64
+	totalNanos uint64
+	maxNanos   uint64
+}
+
+func (m *dispatchMetrics) observe(d time.Duration, panicked bool) {
+	nanos := uint64(d.Nanoseconds())
+	atomic.AddUint64(&m.processed, 1)
+	atomic.AddUint64(&m.totalNanos, nanos)
+	if panicked {
+		atomic.AddUint64(&m.panics, 1)
+	}
+	for {
+		current := atomic.LoadUint64(&m.maxNanos)
+		if nanos <= current || atomic.CompareAndSwapUint64(&m.maxNanos, current, nanos) {
+			return
+		}
+	}
+}
+
+// moduleDispatcher isolates a module from the ingestion and from the other modules: the events are pushed to
+// the queue of the module and handled by its own workers, which recover from the panics of the module.
+type moduleDispatcher struct {
+	module  Module
+	name    string
+	policy  DispatchPolicy
+	queue   chan *Event
+	workers int
+	metrics dispatchMetrics
+
+	lock    sync.RWMutex
+	stopped bool
+	wg      sync.WaitGroup
+}
+
+func newModuleDispatcher(name string, module Module, config *DispatchConfig) *moduleDispatcher {
+	queueSize := config.QueueSize
+	if queueSize <= 0 {
+		queueSize = DefaultDispatchQueueSize
+	}
+	workers := config.Workers
+	if workers <= 0 {
+		workers = DefaultDispatchWorkers
+	}
+
+	return &moduleDispatcher{
+		module:  module,
+		name:    name,
+		policy:  config.policy(name),
+		queue:   make(chan *Event, queueSize),
+		workers: workers,
+	}
+}
+
+// start starts the workers, the events are handled with ctx.
+func (d *moduleDispatcher) start(ctx context.Context) {
+	for i := 0; i < d.workers; i++ {
+		d.wg.Add(1)
+		go d.worker(ctx)
+	}
+}
+
+// stop stops accepting new events and waits for the workers to handle the queued ones, or for ctx to be done.
+func (d *moduleDispatcher) stop(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		// an enqueue blocked on the full queue holds the lock until the workers make room
+		d.lock.Lock()
+		if !d.stopped {
+			d.stopped = true
+			close(d.queue)
+		}
+		d.lock.Unlock()
+
+		d.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to handle %v queued events: %w", len(d.queue), ctx.Err())
+	}
+}
+
+// enqueue pushes an event to the queue of the module, according to the policy of the module when the queue is full.
+// With the block policy, it waits until ctx is done.
+func (d *moduleDispatcher) enqueue(ctx context.Context, event *Event) {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if d.stopped {
+		atomic.AddUint64(&d.metrics.dropped, 1)
+		return
+	}
+
+	if d.policy == DispatchPolicyBlock {
+		select {
+		case d.queue <- event:
+			atomic.AddUint64(&d.metrics.enqueued, 1)
+		case <-ctx.Done():
+			atomic.AddUint64(&d.metrics.dropped, 1)
+			log.Warnf("Event dropped for module %s: %v", d.name, ctx.Err())
+		}
+		return
+	}
+
+	select {
+	case d.queue <- event:
+		atomic.AddUint64(&d.metrics.enqueued, 1)
+	default:
+		atomic.AddUint64(&d.metrics.dropped, 1)
+		log.Debugf("Event dropped for module %s, its queue is full", d.name)
+	}
+}
+
+// enqueueWait pushes an event to the queue of the module, waiting for room whatever the policy of the module until
+// ctx is done. It returns whether the event was enqueued.
+func (d *moduleDispatcher) enqueueWait(ctx context.Context, event *Event) bool {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+
+	if d.stopped {
+		return false
+	}
+
+	select {
+	case d.queue <- event:
+		atomic.AddUint64(&d.metrics.enqueued, 1)
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+func (d *moduleDispatcher) worker(ctx context.Context) {
+	defer d.wg.Done()
+
+	for {
+		select {
+		case event, ok := <-d.queue:
+			if !ok {
+				return
+			}
+			d.handle(ctx, event)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func (d *moduleDispatcher) handle(ctx context.Context, event *Event) {
+	start := time.Now()
+	defer func() {
+		r := recover()
+		if r != nil {
+			log.Errorf("Module %s panicked while handling an event: %v\n%s", d.name, r, debug.Stack())
+		}
+		d.metrics.observe(time.Since(start), r != nil)
+	}()
+
+	d.module.EventNotify(ctx, event)
+}
+
+func (d *moduleDispatcher) stats() DispatchStats {
+	processed := atomic.LoadUint64(&d.metrics.processed)
+	total := atomic.LoadUint64(&d.metrics.totalNanos)
+
+	stats := DispatchStats{
+		Policy:        d.policy,
+		QueueDepth:    len(d.queue),
+		QueueCapacity: cap(d.queue),
+		Workers:       d.workers,
+		Enqueued:      atomic.LoadUint64(&d.metrics.enqueued),
+		Dropped:       atomic.LoadUint64(&d.metrics.dropped),
+		Processed:     processed,
+		Panics:        atomic.LoadUint64(&d.metrics.panics),
+		Latency: LatencyStats{
+			Count:   processed,
+			TotalMs: float64(total) / float64(time.Millisecond),
+			MaxMs:   float64(atomic.LoadUint64(&d.metrics.maxNanos)) / float64(time.Millisecond),
+		},
+	}
+	if processed > 0 {
+		stats.Latency.AvgMs = stats.Latency.TotalMs / float64(processed)
+	}
+
+	return stats
+}
@@ -0,0 +1,194 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+type blockingModule struct {
+	countingModule
+	// EventNotify hangs until it is closed
+	release chan struct{}
+	panics  bool
+}
+
+func (m *blockingModule) EventNotify(ctx context.Context, event *Event) {
+	<-m.release
+	if m.panics {
+		panic("module failure")
+	}
+	m.countingModule.EventNotify(ctx, event)
+}
+
+func TestNewDispatchConfig(t *testing.T) {
+	c, err := NewDispatchConfig(10, 2, "BLOCK", []string{"bfla=drop", "fuzzer=block"})
+	assert.NilError(t, err)
+	assert.Equal(t, c.policy("bfla"), DispatchPolicyDrop)
+	assert.Equal(t, c.policy("fuzzer"), DispatchPolicyBlock)
+	assert.Equal(t, c.policy("traceanalyzer"), DispatchPolicyBlock)
+
+	c, err = NewDispatchConfig(0, 0, "", nil)
+	assert.NilError(t, err)
+	assert.Equal(t, c.policy("bfla"), DispatchPolicyDrop)
+
+	_, err = NewDispatchConfig(0, 0, "wait", nil)
+	assert.ErrorContains(t, err, "invalid module dispatch policy")
+	_, err = NewDispatchConfig(0, 0, "", []string{"bfla"})
+	assert.ErrorContains(t, err, "invalid module dispatch policy override")
+	_, err = NewDispatchConfig(0, 0, "", []string{"bfla=later"})
+	assert.ErrorContains(t, err, "invalid module dispatch policy")
+}
+
+func TestModuleDispatcher_dropPolicy(t *testing.T) {
+	m := &blockingModule{countingModule: countingModule{name: "slow"}, release: make(chan struct{})}
+	d := newModuleDispatcher("slow", m, &DispatchConfig{QueueSize: 2, Workers: 1, Policy: DispatchPolicyDrop})
+	d.start(context.Background())
+
+	// the worker takes the first event and hangs, two events fill the queue and the next ones are dropped
+	d.enqueue(context.Background(), &Event{})
+	assert.Assert(t, waitFor(func() bool { return len(d.queue) == 0 }))
+	for i := 0; i < 4; i++ {
+		d.enqueue(context.Background(), &Event{})
+	}
+	stats := d.stats()
+	assert.Equal(t, stats.Enqueued, uint64(3))
+	assert.Equal(t, stats.Dropped, uint64(2))
+	assert.Equal(t, stats.QueueDepth, 2)
+
+	close(m.release)
+	assert.NilError(t, d.stop(context.Background()))
+	stats = d.stats()
+	assert.Equal(t, stats.Processed, uint64(3))
+	assert.Equal(t, stats.Latency.Count, uint64(3))
+	assert.Equal(t, m.eventsCount(), int32(3))
+
+	// the events are dropped once stopped
+	d.enqueue(context.Background(), &Event{})
+	assert.Equal(t, d.stats().Dropped, uint64(3))
+}
+
+func TestModuleDispatcher_blockPolicy(t *testing.T) {
+	m := &blockingModule{countingModule: countingModule{name: "slow"}, release: make(chan struct{})}
+	d := newModuleDispatcher("slow", m, &DispatchConfig{QueueSize: 1, Workers: 1, Policy: DispatchPolicyBlock})
+	d.start(context.Background())
+
+	d.enqueue(context.Background(), &Event{})
+	assert.Assert(t, waitFor(func() bool { return len(d.queue) == 0 }))
+	d.enqueue(context.Background(), &Event{})
+
+	// the queue is full, the enqueue waits until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	d.enqueue(ctx, &Event{})
+	assert.Equal(t, d.stats().Dropped, uint64(1))
+
+	// the enqueue waits for room in the queue
+	enqueued := make(chan struct{})
+	go func() {
+		d.enqueue(context.Background(), &Event{})
+		close(enqueued)
+	}()
+	close(m.release)
+	<-enqueued
+
+	assert.NilError(t, d.stop(context.Background()))
+	assert.Equal(t, m.eventsCount(), int32(3))
+	assert.Equal(t, d.stats().Dropped, uint64(1))
+}
+
+func TestModuleDispatcher_panicRecovery(t *testing.T) {
+	m := &blockingModule{countingModule: countingModule{name: "faulty"}, release: make(chan struct{}), panics: true}
+	close(m.release)
+	d := newModuleDispatcher("faulty", m, &DispatchConfig{})
+	d.start(context.Background())
+
+	// the worker survives the panics
+	for i := 0; i < 3; i++ {
+		d.enqueue(context.Background(), &Event{})
+	}
+	assert.NilError(t, d.stop(context.Background()))
+	stats := d.stats()
+	assert.Equal(t, stats.Processed, uint64(3))
+	assert.Equal(t, stats.Panics, uint64(3))
+	assert.Equal(t, stats.QueueCapacity, DefaultDispatchQueueSize)
+	assert.Equal(t, stats.Workers, DefaultDispatchWorkers)
+}
+
+func TestModuleDispatcher_stopTimeout(t *testing.T) {
+	m := &blockingModule{countingModule: countingModule{name: "slow"}, release: make(chan struct{})}
+	defer close(m.release)
+	d := newModuleDispatcher("slow", m, &DispatchConfig{})
+	d.start(context.Background())
+	d.enqueue(context.Background(), &Event{})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	assert.ErrorContains(t, d.stop(ctx), context.DeadlineExceeded.Error())
+}
+
+func waitFor(cond func() bool) bool {
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(time.Millisecond)
+	}
+	return true
+}
+
+// a slow module doesn't delay the other ones
+func TestCore_EventNotifyIsolation(t *testing.T) {
+	slow := &blockingModule{countingModule: countingModule{name: "slow"}, release: make(chan struct{})}
+	defer close(slow.release)
+	fast := &countingModule{name: "fast"}
+	modules["slow"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return slow, nil }
+	modules["fast"] = func(ctx context.Context, accessor BackendAccessor) (Module, error) { return fast, nil }
+	t.Cleanup(func() {
+		delete(modules, "slow")
+		delete(modules, "fast")
+	})
+
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	c, _, err := New(context.Background(), nil, nil, false, dbHandler.ModuleStatesTable(), &DispatchConfig{
+		QueueSize:       1,
+		Policy:          DispatchPolicyDrop,
+		ModulesPolicies: map[string]DispatchPolicy{"fast": DispatchPolicyBlock},
+	}, nil, nil, nil, nil, nil, nil)
+	assert.NilError(t, err)
+	assert.NilError(t, c.Start(context.Background()))
+
+	for i := 0; i < 5; i++ {
+		c.EventNotify(context.Background(), &Event{APIEvent: &database.APIEvent{}, APIInfo: &database.APIInfo{}})
+	}
+	assert.Assert(t, waitFor(func() bool { return fast.eventsCount() == 5 }))
+	assert.Equal(t, slow.eventsCount(), int32(0))
+	stats := c.DispatchStats()
+	assert.Equal(t, stats["slow"].Enqueued+stats["slow"].Dropped, uint64(5))
+	assert.Assert(t, stats["slow"].Dropped > 0)
+	assert.Equal(t, stats["fast"].Dropped, uint64(0))
+}
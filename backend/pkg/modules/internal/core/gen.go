@@ -15,4 +15,4 @@
 
 package core
 
-//go:generate go run github.com/golang/mock/mockgen -destination=./mocks.gen.go -package=core . Module,BackendAccessor
+//go:generate go run github.com/golang/mock/mockgen -destination=./mocks.gen.go -package=core . Module,BackendAccessor,ModulesManager
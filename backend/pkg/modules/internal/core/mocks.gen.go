@@ -1,5 +1,5 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: github.com/openclarity/apiclarity/backend/pkg/modules/internal/core (interfaces: Module,BackendAccessor)
+// Source: github.com/openclarity/apiclarity/backend/pkg/modules/internal/core (interfaces: Module,BackendAccessor,ModulesManager)
 
 // Package core is a generated GoMock package.
 package core
@@ -8,8 +8,10 @@ import (
 	context "context"
 	http "net/http"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	models "github.com/openclarity/apiclarity/api/server/models"
 	notifications "github.com/openclarity/apiclarity/api3/notifications"
 	speculatoraccessor "github.com/openclarity/apiclarity/backend/pkg/backend/speculatoraccessor"
 	database "github.com/openclarity/apiclarity/backend/pkg/database"
@@ -80,6 +82,34 @@ func (mr *MockModuleMockRecorder) Info() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockModule)(nil).Info))
 }
 
+// Start mocks base method.
+func (m *MockModule) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockModuleMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockModule)(nil).Start), arg0)
+}
+
+// Stop mocks base method.
+func (m *MockModule) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockModuleMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockModule)(nil).Stop), arg0)
+}
+
 // MockBackendAccessor is a mock of BackendAccessor interface.
 type MockBackendAccessor struct {
 	ctrl     *gomock.Controller
@@ -329,6 +359,65 @@ func (mr *MockBackendAccessorMockRecorder) Notify(arg0, arg1, arg2, arg3 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockBackendAccessor)(nil).Notify), arg0, arg1, arg2, arg3)
 }
 
+// RegisterConfig mocks base method.
+func (m *MockBackendAccessor) RegisterConfig(arg0 string, arg1 []byte, arg2 interface{}, arg3 ConfigChangeFunc) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterConfig", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterConfig indicates an expected call of RegisterConfig.
+func (mr *MockBackendAccessorMockRecorder) RegisterConfig(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterConfig", reflect.TypeOf((*MockBackendAccessor)(nil).RegisterConfig), arg0, arg1, arg2, arg3)
+}
+
+// ResolveEventPath mocks base method.
+func (m *MockBackendAccessor) ResolveEventPath(arg0 context.Context, arg1 *database.APIEvent) (*ResolvedPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveEventPath", arg0, arg1)
+	ret0, _ := ret[0].(*ResolvedPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveEventPath indicates an expected call of ResolveEventPath.
+func (mr *MockBackendAccessorMockRecorder) ResolveEventPath(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveEventPath", reflect.TypeOf((*MockBackendAccessor)(nil).ResolveEventPath), arg0, arg1)
+}
+
+// ResolveSpecPath mocks base method.
+func (m *MockBackendAccessor) ResolveSpecPath(arg0 context.Context, arg1 uint, arg2 string, arg3 models.HTTPMethod) (*ResolvedPath, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveSpecPath", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*ResolvedPath)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveSpecPath indicates an expected call of ResolveSpecPath.
+func (mr *MockBackendAccessorMockRecorder) ResolveSpecPath(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveSpecPath", reflect.TypeOf((*MockBackendAccessor)(nil).ResolveSpecPath), arg0, arg1, arg2, arg3)
+}
+
+// SetConfig mocks base method.
+func (m *MockBackendAccessor) SetConfig(arg0 context.Context, arg1 string, arg2 []byte) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfig", arg0, arg1, arg2)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetConfig indicates an expected call of SetConfig.
+func (mr *MockBackendAccessorMockRecorder) SetConfig(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfig", reflect.TypeOf((*MockBackendAccessor)(nil).SetConfig), arg0, arg1, arg2)
+}
+
 // StoreAPIInfoAnnotations mocks base method.
 func (m *MockBackendAccessor) StoreAPIInfoAnnotations(arg0 context.Context, arg1 string, arg2 uint, arg3 ...Annotation) error {
 	m.ctrl.T.Helper()
@@ -361,3 +450,208 @@ func (mr *MockBackendAccessorMockRecorder) UpdateAPIEvent(arg0, arg1 interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAPIEvent", reflect.TypeOf((*MockBackendAccessor)(nil).UpdateAPIEvent), arg0, arg1)
 }
+
+// MockModulesManager is a mock of ModulesManager interface.
+type MockModulesManager struct {
+	ctrl     *gomock.Controller
+	recorder *MockModulesManagerMockRecorder
+}
+
+// MockModulesManagerMockRecorder is the mock recorder for MockModulesManager.
+type MockModulesManagerMockRecorder struct {
+	mock *MockModulesManager
+}
+
+// NewMockModulesManager creates a new mock instance.
+func NewMockModulesManager(ctrl *gomock.Controller) *MockModulesManager {
+	mock := &MockModulesManager{ctrl: ctrl}
+	mock.recorder = &MockModulesManagerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModulesManager) EXPECT() *MockModulesManagerMockRecorder {
+	return m.recorder
+}
+
+// APISpecsChanged mocks base method.
+func (m *MockModulesManager) APISpecsChanged(arg0 uint) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "APISpecsChanged", arg0)
+}
+
+// APISpecsChanged indicates an expected call of APISpecsChanged.
+func (mr *MockModulesManagerMockRecorder) APISpecsChanged(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APISpecsChanged", reflect.TypeOf((*MockModulesManager)(nil).APISpecsChanged), arg0)
+}
+
+// CancelReplay mocks base method.
+func (m *MockModulesManager) CancelReplay(arg0 uint) (*Replay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelReplay", arg0)
+	ret0, _ := ret[0].(*Replay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelReplay indicates an expected call of CancelReplay.
+func (mr *MockModulesManagerMockRecorder) CancelReplay(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelReplay", reflect.TypeOf((*MockModulesManager)(nil).CancelReplay), arg0)
+}
+
+// DispatchStats mocks base method.
+func (m *MockModulesManager) DispatchStats() map[string]DispatchStats {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchStats")
+	ret0, _ := ret[0].(map[string]DispatchStats)
+	return ret0
+}
+
+// DispatchStats indicates an expected call of DispatchStats.
+func (mr *MockModulesManagerMockRecorder) DispatchStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchStats", reflect.TypeOf((*MockModulesManager)(nil).DispatchStats))
+}
+
+// EventNotify mocks base method.
+func (m *MockModulesManager) EventNotify(arg0 context.Context, arg1 *Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "EventNotify", arg0, arg1)
+}
+
+// EventNotify indicates an expected call of EventNotify.
+func (mr *MockModulesManagerMockRecorder) EventNotify(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EventNotify", reflect.TypeOf((*MockModulesManager)(nil).EventNotify), arg0, arg1)
+}
+
+// GetReplay mocks base method.
+func (m *MockModulesManager) GetReplay(arg0 uint) (*Replay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReplay", arg0)
+	ret0, _ := ret[0].(*Replay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReplay indicates an expected call of GetReplay.
+func (mr *MockModulesManagerMockRecorder) GetReplay(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReplay", reflect.TypeOf((*MockModulesManager)(nil).GetReplay), arg0)
+}
+
+// HTTPHandler mocks base method.
+func (m *MockModulesManager) HTTPHandler() http.Handler {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HTTPHandler")
+	ret0, _ := ret[0].(http.Handler)
+	return ret0
+}
+
+// HTTPHandler indicates an expected call of HTTPHandler.
+func (mr *MockModulesManagerMockRecorder) HTTPHandler() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HTTPHandler", reflect.TypeOf((*MockModulesManager)(nil).HTTPHandler))
+}
+
+// Info mocks base method.
+func (m *MockModulesManager) Info() ModuleInfo {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Info")
+	ret0, _ := ret[0].(ModuleInfo)
+	return ret0
+}
+
+// Info indicates an expected call of Info.
+func (mr *MockModulesManagerMockRecorder) Info() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Info", reflect.TypeOf((*MockModulesManager)(nil).Info))
+}
+
+// ModulesStatus mocks base method.
+func (m *MockModulesManager) ModulesStatus() []ModuleStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModulesStatus")
+	ret0, _ := ret[0].([]ModuleStatus)
+	return ret0
+}
+
+// ModulesStatus indicates an expected call of ModulesStatus.
+func (mr *MockModulesManagerMockRecorder) ModulesStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModulesStatus", reflect.TypeOf((*MockModulesManager)(nil).ModulesStatus))
+}
+
+// Replays mocks base method.
+func (m *MockModulesManager) Replays() []Replay {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replays")
+	ret0, _ := ret[0].([]Replay)
+	return ret0
+}
+
+// Replays indicates an expected call of Replays.
+func (mr *MockModulesManagerMockRecorder) Replays() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replays", reflect.TypeOf((*MockModulesManager)(nil).Replays))
+}
+
+// SetModuleEnabled mocks base method.
+func (m *MockModulesManager) SetModuleEnabled(arg0 string, arg1 bool) (*ModuleStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetModuleEnabled", arg0, arg1)
+	ret0, _ := ret[0].(*ModuleStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetModuleEnabled indicates an expected call of SetModuleEnabled.
+func (mr *MockModulesManagerMockRecorder) SetModuleEnabled(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetModuleEnabled", reflect.TypeOf((*MockModulesManager)(nil).SetModuleEnabled), arg0, arg1)
+}
+
+// Start mocks base method.
+func (m *MockModulesManager) Start(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Start", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockModulesManagerMockRecorder) Start(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockModulesManager)(nil).Start), arg0)
+}
+
+// StartReplay mocks base method.
+func (m *MockModulesManager) StartReplay(arg0 string, arg1 uint, arg2, arg3 time.Time) (*Replay, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartReplay", arg0, arg1, arg2, arg3)
+	ret0, _ := ret[0].(*Replay)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartReplay indicates an expected call of StartReplay.
+func (mr *MockModulesManagerMockRecorder) StartReplay(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartReplay", reflect.TypeOf((*MockModulesManager)(nil).StartReplay), arg0, arg1, arg2, arg3)
+}
+
+// Stop mocks base method.
+func (m *MockModulesManager) Stop(arg0 context.Context) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Stop", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Stop indicates an expected call of Stop.
+func (mr *MockModulesManagerMockRecorder) Stop(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Stop", reflect.TypeOf((*MockModulesManager)(nil).Stop), arg0)
+}
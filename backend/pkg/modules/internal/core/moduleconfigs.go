@@ -0,0 +1,226 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+var (
+	ErrModuleConfigNotFound = errors.New("module has no config")
+	ErrInvalidModuleConfig  = errors.New("invalid module config")
+)
+
+// ConfigChangeFunc is called with a new config of a module, which matches the schema of the module config.
+// The config is not stored when an error is returned.
+type ConfigChangeFunc func(ctx context.Context, config []byte) error
+
+type moduleConfig struct {
+	schema    *gojsonschema.Schema
+	rawSchema json.RawMessage
+	config    json.RawMessage
+	onChange  ConfigChangeFunc
+}
+
+// ModuleConfigs holds the configs of the modules, which are stored in the database and can be changed at runtime.
+type ModuleConfigs struct {
+	table database.ModuleConfigsTable
+
+	lock    sync.RWMutex
+	configs map[string]*moduleConfig
+}
+
+func NewModuleConfigs(table database.ModuleConfigsTable) *ModuleConfigs {
+	return &ModuleConfigs{
+		table:   table,
+		configs: map[string]*moduleConfig{},
+	}
+}
+
+// Register registers the JSON schema of the config of a module and the func to call when the config changes.
+// It returns the config the module starts with: the stored config if it still matches the schema, the default config otherwise.
+func (c *ModuleConfigs) Register(modName string, schema []byte, defaultConfig interface{}, onChange ConfigChangeFunc) ([]byte, error) {
+	compiledSchema, err := gojsonschema.NewSchema(gojsonschema.NewBytesLoader(schema))
+	if err != nil {
+		return nil, fmt.Errorf("invalid config schema of module %s: %v", modName, err)
+	}
+	config, err := json.Marshal(defaultConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode default config of module %s: %v", modName, err)
+	}
+	if err := validateModuleConfig(compiledSchema, config); err != nil {
+		return nil, fmt.Errorf("default config of module %s: %w", modName, err)
+	}
+
+	stored, err := c.table.Get(modName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get config of module %s: %v", modName, err)
+	}
+	if stored != nil {
+		if err := validateModuleConfig(compiledSchema, []byte(stored.Config)); err != nil {
+			log.Warnf("Ignoring the stored config of module %s: %v", modName, err)
+		} else {
+			config = []byte(stored.Config)
+		}
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.configs[modName] = &moduleConfig{
+		schema:    compiledSchema,
+		rawSchema: schema,
+		config:    config,
+		onChange:  onChange,
+	}
+	return config, nil
+}
+
+// Get returns the schema of the config of a module and its current config.
+func (c *ModuleConfigs) Get(modName string) (schema []byte, config []byte, err error) {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	mc, ok := c.configs[modName]
+	if !ok {
+		return nil, nil, fmt.Errorf("failed to get config of module %s: %w", modName, ErrModuleConfigNotFound)
+	}
+	return mc.rawSchema, mc.config, nil
+}
+
+func (c *ModuleConfigs) isRegistered(modName string) bool {
+	if c == nil {
+		return false
+	}
+
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	_, ok := c.configs[modName]
+	return ok
+}
+
+// Set validates a new config of a module, delivers it to the module and stores it. The module gets back its
+// previous config when the new one fails to be stored.
+func (c *ModuleConfigs) Set(ctx context.Context, modName string, config []byte) error {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	mc, ok := c.configs[modName]
+	if !ok {
+		return fmt.Errorf("failed to set config of module %s: %w", modName, ErrModuleConfigNotFound)
+	}
+	if err := validateModuleConfig(mc.schema, config); err != nil {
+		return err
+	}
+	if err := mc.onChange(ctx, config); err != nil {
+		return fmt.Errorf("module %s rejected the config: %w: %v", modName, ErrInvalidModuleConfig, err)
+	}
+	if err := c.table.Set(&database.ModuleConfig{Name: modName, Config: string(config)}); err != nil {
+		if rollbackErr := mc.onChange(ctx, mc.config); rollbackErr != nil {
+			log.Errorf("Failed to restore the previous config of module %s: %v", modName, rollbackErr)
+		}
+		return fmt.Errorf("failed to store config of module %s: %v", modName, err)
+	}
+	mc.config = config
+	// the config isn't logged, it may hold credentials of the module
+	log.Infof("Config of module %s changed", modName)
+
+	return nil
+}
+
+func validateModuleConfig(schema *gojsonschema.Schema, config []byte) error {
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(config))
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidModuleConfig, err)
+	}
+	if !result.Valid() {
+		errs := make([]string, 0, len(result.Errors()))
+		for _, e := range result.Errors() {
+			errs = append(errs, e.String())
+		}
+		return fmt.Errorf("%w: %s", ErrInvalidModuleConfig, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+type moduleConfigResponse struct {
+	Schema json.RawMessage `json:"schema"`
+	Config json.RawMessage `json:"config"`
+}
+
+// configHandler serves the config of a module: GET returns its schema and current value, PUT replaces it.
+func (c *ModuleConfigs) configHandler(modName string) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+		case http.MethodPut:
+			var config json.RawMessage
+			if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+				writeConfigError(w, http.StatusBadRequest, fmt.Errorf("%w: %v", ErrInvalidModuleConfig, err))
+				return
+			}
+			if err := c.Set(r.Context(), modName, config); err != nil {
+				writeConfigError(w, configErrorStatus(err), err)
+				return
+			}
+		default:
+			w.Header().Set("Allow", "GET, PUT")
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		schema, config, err := c.Get(modName)
+		if err != nil {
+			writeConfigError(w, configErrorStatus(err), err)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(moduleConfigResponse{Schema: schema, Config: config})
+	})
+}
+
+func configErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, ErrModuleConfigNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, ErrInvalidModuleConfig):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+func writeConfigError(w http.ResponseWriter, statusCode int, err error) {
+	if statusCode == http.StatusInternalServerError {
+		log.Error(err)
+		err = errors.New("Oops")
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"message": err.Error()})
+}
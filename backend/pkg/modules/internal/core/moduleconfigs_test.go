@@ -0,0 +1,172 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+const testConfigSchema = `{
+	"type": "object",
+	"properties": {
+		"interval": {"type": "integer", "minimum": 1}
+	},
+	"required": ["interval"],
+	"additionalProperties": false
+}`
+
+type testConfig struct {
+	Interval int `json:"interval"`
+}
+
+func newTestModuleConfigs(t *testing.T) *ModuleConfigs {
+	t.Helper()
+
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	return NewModuleConfigs(dbHandler.ModuleConfigsTable())
+}
+
+func TestModuleConfigs(t *testing.T) {
+	configs := newTestModuleConfigs(t)
+
+	var changed []string
+	onChange := func(ctx context.Context, config []byte) error {
+		var c testConfig
+		if err := json.Unmarshal(config, &c); err != nil {
+			return err
+		}
+		if c.Interval == 42 {
+			return errors.New("not that one")
+		}
+		changed = append(changed, string(config))
+		return nil
+	}
+
+	_, err := configs.Register("mod1", []byte(testConfigSchema), testConfig{}, onChange)
+	assert.Assert(t, errors.Is(err, ErrInvalidModuleConfig))
+
+	config, err := configs.Register("mod1", []byte(testConfigSchema), testConfig{Interval: 10}, onChange)
+	assert.NilError(t, err)
+	assert.Equal(t, string(config), `{"interval":10}`)
+
+	_, _, err = configs.Get("mod2")
+	assert.Assert(t, errors.Is(err, ErrModuleConfigNotFound))
+	assert.Assert(t, errors.Is(configs.Set(context.Background(), "mod2", []byte(`{"interval":20}`)), ErrModuleConfigNotFound))
+
+	assert.Assert(t, errors.Is(configs.Set(context.Background(), "mod1", []byte(`{"interval":0}`)), ErrInvalidModuleConfig))
+	assert.Assert(t, errors.Is(configs.Set(context.Background(), "mod1", []byte(`{"other":20}`)), ErrInvalidModuleConfig))
+	assert.Assert(t, errors.Is(configs.Set(context.Background(), "mod1", []byte(`{"interval":42}`)), ErrInvalidModuleConfig))
+	assert.Equal(t, len(changed), 0)
+
+	assert.NilError(t, configs.Set(context.Background(), "mod1", []byte(`{"interval":20}`)))
+	assert.DeepEqual(t, changed, []string{`{"interval":20}`})
+	schema, config, err := configs.Get("mod1")
+	assert.NilError(t, err)
+	assert.Equal(t, string(schema), testConfigSchema)
+	assert.Equal(t, string(config), `{"interval":20}`)
+
+	// the stored config takes precedence over the default one once the module is registered again
+	restarted := NewModuleConfigs(configs.table)
+	config, err = restarted.Register("mod1", []byte(testConfigSchema), testConfig{Interval: 10}, onChange)
+	assert.NilError(t, err)
+	assert.Equal(t, string(config), `{"interval":20}`)
+
+	// the stored config is ignored when it doesn't match the schema anymore
+	newSchema := strings.Replace(testConfigSchema, `"minimum": 1`, `"minimum": 30`, 1)
+	restarted = NewModuleConfigs(configs.table)
+	config, err = restarted.Register("mod1", []byte(newSchema), testConfig{Interval: 30}, onChange)
+	assert.NilError(t, err)
+	assert.Equal(t, string(config), `{"interval":30}`)
+}
+
+func TestModuleConfigs_configHandler(t *testing.T) {
+	configs := newTestModuleConfigs(t)
+	_, err := configs.Register("mod1", []byte(testConfigSchema), testConfig{Interval: 10}, func(ctx context.Context, config []byte) error {
+		return nil
+	})
+	assert.NilError(t, err)
+
+	serve := func(modName, method, body string) (int, string) {
+		rec := httptest.NewRecorder()
+		configs.configHandler(modName).ServeHTTP(rec, httptest.NewRequest(method, BaseHTTPPath+"/"+modName+"/config", strings.NewReader(body)))
+		return rec.Code, strings.TrimSpace(rec.Body.String())
+	}
+
+	code, body := serve("mod1", http.MethodGet, "")
+	assert.Equal(t, code, http.StatusOK)
+	var resp moduleConfigResponse
+	assert.NilError(t, json.Unmarshal([]byte(body), &resp))
+	assert.Equal(t, string(resp.Config), `{"interval":10}`)
+
+	code, _ = serve("mod1", http.MethodPut, `{"interval":-1}`)
+	assert.Equal(t, code, http.StatusBadRequest)
+	code, _ = serve("mod1", http.MethodPut, `not json`)
+	assert.Equal(t, code, http.StatusBadRequest)
+	code, _ = serve("mod2", http.MethodPut, `{"interval":20}`)
+	assert.Equal(t, code, http.StatusNotFound)
+	code, _ = serve("mod1", http.MethodDelete, "")
+	assert.Equal(t, code, http.StatusMethodNotAllowed)
+
+	code, body = serve("mod1", http.MethodPut, `{"interval":20}`)
+	assert.Equal(t, code, http.StatusOK)
+	assert.NilError(t, json.Unmarshal([]byte(body), &resp))
+	assert.Equal(t, string(resp.Config), `{"interval":20}`)
+}
+
+// failingModuleConfigsTable fails to store the configs.
+type failingModuleConfigsTable struct {
+	database.ModuleConfigsTable
+}
+
+func (failingModuleConfigsTable) Set(*database.ModuleConfig) error {
+	return errors.New("db failure")
+}
+
+func TestModuleConfigs_Set_storeFailure(t *testing.T) {
+	configs := newTestModuleConfigs(t)
+	configs.table = failingModuleConfigsTable{ModuleConfigsTable: configs.table}
+
+	var current string
+	_, err := configs.Register("mod1", []byte(testConfigSchema), testConfig{Interval: 10}, func(ctx context.Context, config []byte) error {
+		current = string(config)
+		return nil
+	})
+	assert.NilError(t, err)
+
+	err = configs.Set(context.Background(), "mod1", []byte(`{"interval":20}`))
+	assert.ErrorContains(t, err, "failed to store config")
+	assert.Assert(t, !errors.Is(err, ErrInvalidModuleConfig))
+
+	// the module is back on the config it had
+	assert.Equal(t, current, `{"interval":10}`)
+	_, config, err := configs.Get("mod1")
+	assert.NilError(t, err)
+	assert.Equal(t, string(config), `{"interval":10}`)
+}
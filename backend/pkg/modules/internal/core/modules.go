@@ -19,9 +19,12 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
+	log "github.com/sirupsen/logrus"
 	"k8s.io/client-go/kubernetes"
 
+	"github.com/openclarity/apiclarity/api/server/models"
 	"github.com/openclarity/apiclarity/api3/notifications"
 	"github.com/openclarity/apiclarity/backend/pkg/backend/speculatoraccessor"
 	"github.com/openclarity/apiclarity/backend/pkg/config"
@@ -34,6 +37,8 @@ import (
 type Annotation struct {
 	Name       string
 	Annotation []byte
+	// ExpiresAt is the time after which the annotation is no longer returned and gets deleted, the zero value means it never expires
+	ExpiresAt time.Time
 }
 
 type ModuleInfo struct {
@@ -56,6 +61,14 @@ type Module interface {
 
 	// HTTPHandler that will be served by APIClarity under /api/modules/{moduleName}
 	HTTPHandler() http.Handler
+
+	// Start called once all the modules are created, before any event is notified.
+	// The background work of the module must stop when ctx is done.
+	Start(ctx context.Context) error
+
+	// Stop called on shutdown, once no more event is notified. The module must flush its state
+	// (pending notifications, in-memory state not yet persisted...) before ctx is done.
+	Stop(ctx context.Context) error
 }
 
 type BackendAccessor interface {
@@ -81,15 +94,30 @@ type BackendAccessor interface {
 	DisableTraces(ctx context.Context, modName string, apiID uint) error
 
 	Notify(ctx context.Context, modName string, apiID uint, notification notifications.APIClarityNotification) error
+
+	// ResolveEventPath resolves an event to the operation of the specs of its API, ErrSpecPathNotFound is returned
+	// when the API has no spec or when the event isn't in its specs.
+	ResolveEventPath(ctx context.Context, event *database.APIEvent) (*ResolvedPath, error)
+	// ResolveSpecPath resolves a path template and method to the operation of the specs of an API.
+	ResolveSpecPath(ctx context.Context, apiID uint, path string, method models.HTTPMethod) (*ResolvedPath, error)
+
+	// RegisterConfig registers the JSON schema of the config of a module, served under /api/modules/{moduleName}/config.
+	// It returns the config to start with, onChange is called with every valid config set later on.
+	RegisterConfig(modName string, schema []byte, defaultConfig interface{}, onChange ConfigChangeFunc) ([]byte, error)
+	// SetConfig replaces the config of a module as if it was put under /api/modules/{moduleName}/config.
+	SetConfig(ctx context.Context, modName string, config []byte) error
 }
 
-func NewAccessor(dbHandler *database.Handler, clientset kubernetes.Interface, samplingManager *sampling.TraceSamplingManager, speculatorAccessor speculatoraccessor.SpeculatorsAccessor, notifier *notifier.Notifier, conf *config.Config) (BackendAccessor, error) {
+func NewAccessor(dbHandler *database.Handler, clientset kubernetes.Interface, samplingManager *sampling.TraceSamplingManager, speculatorAccessor speculatoraccessor.SpeculatorsAccessor, notifier *notifier.Notifier, moduleConfigs *ModuleConfigs, apiSpecs *APISpecsCache, annotationSubs *AnnotationSubscriptions, conf *config.Config) (BackendAccessor, error) {
 	return &accessor{
 		dbHandler:            dbHandler,
 		clientset:            clientset,
 		samplingManager:      samplingManager,
 		speculatorAccessor:   speculatorAccessor,
 		notifier:             notifier,
+		moduleConfigs:        moduleConfigs,
+		apiSpecs:             apiSpecs,
+		annotationSubs:       annotationSubs,
 		traceSamplingEnabled: conf.TraceSamplingEnabled,
 	}, nil
 }
@@ -100,6 +128,9 @@ type accessor struct {
 	samplingManager      *sampling.TraceSamplingManager
 	speculatorAccessor   speculatoraccessor.SpeculatorsAccessor
 	notifier             *notifier.Notifier
+	moduleConfigs        *ModuleConfigs
+	apiSpecs             *APISpecsCache
+	annotationSubs       *AnnotationSubscriptions
 	traceSamplingEnabled bool
 }
 
@@ -118,11 +149,27 @@ func (b *accessor) GetTraceSamplingAccessor() *sampling.TraceSamplingManager {
 func (b *accessor) GetAPIInfo(ctx context.Context, apiID uint) (*database.APIInfo, error) {
 	apiInfo := &database.APIInfo{}
 	if err := b.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
-		return nil, fmt.Errorf("failed to retrieve API info for apiID=%v: %v", apiID, err)
+		return nil, fmt.Errorf("failed to retrieve API info for apiID=%v: %w", apiID, err)
 	}
 	return apiInfo, nil
 }
 
+func (b *accessor) ResolveEventPath(ctx context.Context, event *database.APIEvent) (*ResolvedPath, error) {
+	specs, err := b.apiSpecs.Get(ctx, event.APIInfoID)
+	if err != nil {
+		return nil, err
+	}
+	return specs.ResolveEvent(event)
+}
+
+func (b *accessor) ResolveSpecPath(ctx context.Context, apiID uint, path string, method models.HTTPMethod) (*ResolvedPath, error) {
+	specs, err := b.apiSpecs.Get(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+	return specs.ResolvePath(path, method)
+}
+
 func (b *accessor) UpdateAPIEvent(ctx context.Context, event *database.APIEvent) error {
 	//nolint: wrapcheck
 	return b.dbHandler.APIEventsTable().UpdateAPIEvent(event)
@@ -141,7 +188,7 @@ func (b *accessor) GetAPIEventAnnotation(ctx context.Context, modName string, ev
 	if err != nil {
 		return nil, fmt.Errorf("unable to get apievent annotation: %w", err)
 	}
-	return &Annotation{Name: ann.Name, Annotation: ann.Annotation}, nil
+	return &Annotation{Name: ann.Name, Annotation: ann.Annotation, ExpiresAt: fromDBExpiresAt(ann.ExpiresAt)}, nil
 }
 
 func (b *accessor) ListAPIEventAnnotations(ctx context.Context, modName string, eventID uint) ([]*Annotation, error) {
@@ -151,7 +198,7 @@ func (b *accessor) ListAPIEventAnnotations(ctx context.Context, modName string,
 		return nil, fmt.Errorf("unable to list apievent annotations: %w", err)
 	}
 	for _, ann := range dbAnnotations {
-		anns = append(anns, &Annotation{Name: ann.Name, Annotation: ann.Annotation})
+		anns = append(anns, &Annotation{Name: ann.Name, Annotation: ann.Annotation, ExpiresAt: fromDBExpiresAt(ann.ExpiresAt)})
 	}
 	return anns, nil
 }
@@ -165,12 +212,14 @@ func (b *accessor) CreateAPIEventAnnotations(ctx context.Context, modName string
 			ModuleName: modName,
 			Name:       a.Name,
 			Annotation: a.Annotation,
+			ExpiresAt:  toDBExpiresAt(a.ExpiresAt),
 		})
 	}
 
 	if err := b.dbHandler.APIEventsAnnotationsTable().Create(ctx, dbAnns...); err != nil {
 		return fmt.Errorf("unable to create apiinfo annotation: %w", err)
 	}
+	b.annotationSubs.publishEventAnnotations(ctx, modName, eventID, annotations)
 	return nil
 }
 
@@ -179,7 +228,7 @@ func (b *accessor) GetAPIInfoAnnotation(ctx context.Context, modName string, api
 	if err != nil {
 		return nil, fmt.Errorf("unable to get apiinfo annotation: %w", err)
 	}
-	return &Annotation{Name: ann.Name, Annotation: ann.Annotation}, nil
+	return &Annotation{Name: ann.Name, Annotation: ann.Annotation, ExpiresAt: fromDBExpiresAt(ann.ExpiresAt)}, nil
 }
 
 func (b *accessor) ListAPIInfoAnnotations(ctx context.Context, modName string, apiID uint) (annotations []*Annotation, err error) {
@@ -191,11 +240,27 @@ func (b *accessor) ListAPIInfoAnnotations(ctx context.Context, modName string, a
 		annotations = append(annotations, &Annotation{
 			Name:       ann.Name,
 			Annotation: ann.Annotation,
+			ExpiresAt:  fromDBExpiresAt(ann.ExpiresAt),
 		})
 	}
 	return annotations, nil
 }
 
+func toDBExpiresAt(expiresAt time.Time) *time.Time {
+	if expiresAt.IsZero() {
+		return nil
+	}
+	utc := expiresAt.UTC()
+	return &utc
+}
+
+func fromDBExpiresAt(expiresAt *time.Time) time.Time {
+	if expiresAt == nil {
+		return time.Time{}
+	}
+	return *expiresAt
+}
+
 func (b *accessor) StoreAPIInfoAnnotations(ctx context.Context, modName string, apiID uint, annotations ...Annotation) error {
 	var dbAnns []database.APIInfoAnnotation
 
@@ -205,12 +270,14 @@ func (b *accessor) StoreAPIInfoAnnotations(ctx context.Context, modName string,
 			ModuleName: modName,
 			Name:       a.Name,
 			Annotation: a.Annotation,
+			ExpiresAt:  toDBExpiresAt(a.ExpiresAt),
 		})
 	}
 
 	if err := b.dbHandler.APIInfoAnnotationsTable().UpdateOrCreate(ctx, dbAnns...); err != nil {
 		return fmt.Errorf("unable to store apiinfo annotation: %w", err)
 	}
+	b.annotationSubs.publishAPIAnnotations(ctx, modName, apiID, annotations)
 	return nil
 }
 
@@ -229,6 +296,11 @@ func (b *accessor) DeleteAllAPIInfoAnnotations(ctx context.Context, modName stri
 }
 
 func (b *accessor) Notify(ctx context.Context, modName string, apiID uint, n notifications.APIClarityNotification) error {
+	// The index is rebuilt from the modules stored findings on startup, so failing to write it must not prevent the
+	// notification to be sent.
+	if err := b.storeAPIFindings(ctx, modName, apiID, n); err != nil {
+		log.Errorf("Failed to index the findings of module %s on API %v: %v", modName, apiID, err)
+	}
 	if b.notifier == nil {
 		return nil
 	}
@@ -242,7 +314,7 @@ func (b *accessor) EnableTraces(ctx context.Context, modName string, apiID uint)
 	if !b.traceSamplingEnabled {
 		return nil
 	}
-	if err := b.samplingManager.AddHostToTrace(modName, uint32(apiID)); err != nil {
+	if err := b.samplingManager.AddHostToTrace(modName, uint32(apiID), nil); err != nil {
 		return fmt.Errorf("failed to add API %v to trace: %v", apiID, err)
 	}
 	return nil
@@ -257,3 +329,11 @@ func (b *accessor) DisableTraces(ctx context.Context, modName string, apiID uint
 	}
 	return nil
 }
+
+func (b *accessor) RegisterConfig(modName string, schema []byte, defaultConfig interface{}, onChange ConfigChangeFunc) ([]byte, error) {
+	return b.moduleConfigs.Register(modName, schema, defaultConfig, onChange)
+}
+
+func (b *accessor) SetConfig(ctx context.Context, modName string, config []byte) error {
+	return b.moduleConfigs.Set(ctx, modName, config)
+}
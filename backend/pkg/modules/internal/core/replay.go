@@ -0,0 +1,300 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+type ReplayStatus string
+
+const (
+	ReplayRunning   ReplayStatus = "RUNNING"
+	ReplayCompleted ReplayStatus = "COMPLETED"
+	ReplayCanceled  ReplayStatus = "CANCELED"
+	ReplayFailed    ReplayStatus = "FAILED"
+
+	// replayBatchSize is the number of telemetries read from the database at once.
+	replayBatchSize = 100
+
+	// the finished replays are kept for replayRetention, and at most maxFinishedReplays of them.
+	replayRetention    = 24 * time.Hour
+	maxFinishedReplays = 100
+)
+
+var (
+	ErrReplayNotFound = errors.New("replay not found")
+	ErrInvalidReplay  = errors.New("invalid replay")
+)
+
+// Replay is a job replaying the stored events of an API in a time range through a module. Only the events whose
+// telemetry is retained are replayed.
+type Replay struct {
+	ID         uint
+	ModuleName string
+	APIID      uint
+	StartTime  time.Time
+	EndTime    time.Time
+	Status     ReplayStatus
+	// Total is the number of events to replay, Replayed and Skipped the number of events dispatched to the module so
+	// far and the number of events which could not be replayed.
+	Total      int64
+	Replayed   int64
+	Skipped    int64
+	Error      string
+	CreatedAt  time.Time
+	FinishedAt time.Time
+}
+
+type replayJob struct {
+	Replay
+	cancel context.CancelFunc
+}
+
+// Replays runs the replays of the stored events through the modules, and keeps them to report their progress
+// until they are pruned.
+type Replays struct {
+	dbHandler database.Database
+
+	jobs        map[uint]*replayJob
+	nextID      uint
+	retention   time.Duration
+	maxFinished int
+	lock        sync.Mutex
+	wg          sync.WaitGroup
+}
+
+func NewReplays(dbHandler database.Database) *Replays {
+	return &Replays{
+		dbHandler:   dbHandler,
+		jobs:        map[uint]*replayJob{},
+		nextID:      1,
+		retention:   replayRetention,
+		maxFinished: maxFinishedReplays,
+	}
+}
+
+// start runs a replay of the events of an API in [startTime, endTime] through the dispatcher of a module, in the
+// background. The replayed events wait for room in the queue of the module whatever its dispatch policy.
+func (r *Replays) start(dispatcher *moduleDispatcher, apiID uint, startTime, endTime time.Time) (*Replay, error) {
+	if endTime.Before(startTime) {
+		return nil, fmt.Errorf("%w: the end time is before the start time", ErrInvalidReplay)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.prune(time.Now().UTC())
+
+	job := &replayJob{
+		Replay: Replay{
+			ID:         r.nextID,
+			ModuleName: dispatcher.name,
+			APIID:      apiID,
+			StartTime:  startTime,
+			EndTime:    endTime,
+			Status:     ReplayRunning,
+			CreatedAt:  time.Now().UTC(),
+		},
+		cancel: cancel,
+	}
+	r.nextID++
+	r.jobs[job.ID] = job
+
+	r.wg.Add(1)
+	go func() {
+		defer r.wg.Done()
+		defer cancel()
+
+		err := r.run(ctx, job, dispatcher)
+		r.finish(job, err, ctx.Err() != nil)
+	}()
+
+	replay := job.Replay
+	return &replay, nil
+}
+
+func (r *Replays) run(ctx context.Context, job *replayJob, dispatcher *moduleDispatcher) error {
+	telemetries := r.dbHandler.APIEventTelemetriesTable()
+	total, err := telemetries.Count(ctx, job.APIID, job.StartTime, job.EndTime)
+	if err != nil {
+		return fmt.Errorf("failed to count events: %v", err)
+	}
+	r.update(job, func(replay *Replay) { replay.Total = total })
+
+	apiInfo := &database.APIInfo{}
+	if err := r.dbHandler.APIInventoryTable().First(apiInfo, job.APIID); err != nil {
+		return fmt.Errorf("failed to get API info: %v", err)
+	}
+
+	var lastEventID uint
+	for {
+		batch, err := telemetries.List(ctx, job.APIID, job.StartTime, job.EndTime, lastEventID, replayBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to list event telemetries: %v", err)
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		for _, telemetry := range batch {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+			lastEventID = telemetry.EventID
+			replayed := r.replayEvent(ctx, dispatcher, apiInfo, telemetry)
+			r.update(job, func(replay *Replay) {
+				if replayed {
+					replay.Replayed++
+				} else {
+					replay.Skipped++
+				}
+			})
+		}
+	}
+}
+
+func (r *Replays) replayEvent(ctx context.Context, dispatcher *moduleDispatcher, apiInfo *database.APIInfo, telemetry *database.APIEventTelemetry) bool {
+	eventID := uint32(telemetry.EventID)
+	events, err := r.dbHandler.APIEventsTable().GetAPIEventsWithAnnotations(ctx, database.GetAPIEventsQuery{EventID: &eventID})
+	if err != nil || len(events) == 0 {
+		log.Warnf("Failed to get event %v to replay: %v", telemetry.EventID, err)
+		return false
+	}
+	trace := &pluginsmodels.Telemetry{}
+	if err := json.Unmarshal(telemetry.Telemetry, trace); err != nil {
+		log.Warnf("Failed to decode telemetry of event %v to replay: %v", telemetry.EventID, err)
+		return false
+	}
+
+	return dispatcher.enqueueWait(ctx, &Event{APIEvent: events[0], APIInfo: apiInfo, Telemetry: trace})
+}
+
+func (r *Replays) update(job *replayJob, update func(replay *Replay)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	update(&job.Replay)
+}
+
+func (r *Replays) finish(job *replayJob, err error, canceled bool) {
+	// read under the lock, the replay is shared with the REST handlers
+	var status ReplayStatus
+	r.update(job, func(replay *Replay) {
+		replay.FinishedAt = time.Now().UTC()
+		switch {
+		case canceled:
+			replay.Status = ReplayCanceled
+		case err == nil:
+			replay.Status = ReplayCompleted
+		default:
+			replay.Status = ReplayFailed
+			replay.Error = err.Error()
+		}
+		status = replay.Status
+	})
+	log.Infof("Replay %v of API %v through module %s finished: %s", job.ID, job.APIID, job.ModuleName, status)
+}
+
+// prune deletes the replays finished for longer than the retention, and the earliest finished replays beyond the
+// max count. It must be called with the lock held.
+func (r *Replays) prune(now time.Time) {
+	finished := make([]*replayJob, 0, len(r.jobs))
+	for id, job := range r.jobs {
+		if job.Status == ReplayRunning {
+			continue
+		}
+		if now.Sub(job.FinishedAt) > r.retention {
+			delete(r.jobs, id)
+			continue
+		}
+		finished = append(finished, job)
+	}
+	if len(finished) <= r.maxFinished {
+		return
+	}
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[i].FinishedAt.Before(finished[j].FinishedAt)
+	})
+	for _, job := range finished[:len(finished)-r.maxFinished] {
+		delete(r.jobs, job.ID)
+	}
+}
+
+// List returns the replays, the latest first.
+func (r *Replays) List() []Replay {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.prune(time.Now().UTC())
+
+	replays := make([]Replay, 0, len(r.jobs))
+	for _, job := range r.jobs {
+		replays = append(replays, job.Replay)
+	}
+	sort.Slice(replays, func(i, j int) bool {
+		return replays[i].ID > replays[j].ID
+	})
+	return replays
+}
+
+func (r *Replays) Get(id uint) (*Replay, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	job, ok := r.jobs[id]
+	if !ok {
+		return nil, fmt.Errorf("failed to get replay %v: %w", id, ErrReplayNotFound)
+	}
+	replay := job.Replay
+	return &replay, nil
+}
+
+// Cancel cancels a running replay, the events already replayed are not reverted.
+func (r *Replays) Cancel(id uint) (*Replay, error) {
+	r.lock.Lock()
+	job, ok := r.jobs[id]
+	r.lock.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("failed to cancel replay %v: %w", id, ErrReplayNotFound)
+	}
+	job.cancel()
+
+	return r.Get(id)
+}
+
+// stop cancels the running replays and waits for them to end.
+func (r *Replays) stop() {
+	r.lock.Lock()
+	for _, job := range r.jobs {
+		job.cancel()
+	}
+	r.lock.Unlock()
+
+	r.wg.Wait()
+}
@@ -0,0 +1,205 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+type replayedModule struct {
+	name  string
+	lock  sync.Mutex
+	paths []string
+	// when set, EventNotify hangs until it is closed
+	block chan struct{}
+}
+
+func (m *replayedModule) Info() ModuleInfo                { return ModuleInfo{Name: m.name} }
+func (m *replayedModule) HTTPHandler() http.Handler       { return nil }
+func (m *replayedModule) Start(ctx context.Context) error { return nil }
+func (m *replayedModule) Stop(ctx context.Context) error  { return nil }
+
+func (m *replayedModule) EventNotify(ctx context.Context, event *Event) {
+	if m.block != nil {
+		<-m.block
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.paths = append(m.paths, event.APIEvent.Path+" "+event.Telemetry.Request.Path)
+}
+
+func (m *replayedModule) replayedPaths() []string {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.paths
+}
+
+func createReplayedEvent(t *testing.T, dbHandler *database.Handler, apiID uint, path string, eventTime time.Time, withTelemetry bool) {
+	t.Helper()
+
+	event := &database.APIEvent{APIInfoID: apiID, Path: path, Time: strfmt.DateTime(eventTime)}
+	dbHandler.APIEventsTable().CreateAPIEvent(event)
+	if !withTelemetry {
+		return
+	}
+	telemetry, err := json.Marshal(&pluginsmodels.Telemetry{Request: &pluginsmodels.Request{Path: path + "?q=1"}})
+	assert.NilError(t, err)
+	assert.NilError(t, dbHandler.APIEventTelemetriesTable().Create(context.Background(), &database.APIEventTelemetry{
+		EventID:   event.ID,
+		APIInfoID: apiID,
+		Time:      eventTime,
+		Telemetry: telemetry,
+	}))
+}
+
+func waitReplayFinished(t *testing.T, replays *Replays, id uint) *Replay {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		replay, err := replays.Get(id)
+		assert.NilError(t, err)
+		if replay.Status != ReplayRunning {
+			return replay
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("replay %v did not finish", id)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestReplays(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	apiInfo := &database.APIInfo{Name: "test.com", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(apiInfo)
+
+	now := time.Now().UTC()
+	createReplayedEvent(t, dbHandler, apiInfo.ID, "/old", now.Add(-3*time.Hour), true)
+	createReplayedEvent(t, dbHandler, apiInfo.ID, "/first", now.Add(-2*time.Hour), true)
+	createReplayedEvent(t, dbHandler, apiInfo.ID, "/notRetained", now.Add(-90*time.Minute), false)
+	createReplayedEvent(t, dbHandler, apiInfo.ID, "/second", now.Add(-time.Hour), true)
+
+	replays := NewReplays(dbHandler)
+	mod := &replayedModule{name: "mod"}
+	// the replayed events wait for room in the queue of the module even with the drop policy
+	dispatcher := newModuleDispatcher("mod", mod, &DispatchConfig{QueueSize: 1, Policy: DispatchPolicyDrop})
+	dispatcher.start(context.Background())
+
+	_, err := replays.start(dispatcher, apiInfo.ID, now, now.Add(-time.Hour))
+	assert.Assert(t, errors.Is(err, ErrInvalidReplay))
+
+	replay, err := replays.start(dispatcher, apiInfo.ID, now.Add(-150*time.Minute), now)
+	assert.NilError(t, err)
+	assert.Equal(t, replay.Status, ReplayRunning)
+
+	replay = waitReplayFinished(t, replays, replay.ID)
+	assert.Equal(t, replay.Status, ReplayCompleted)
+	assert.Equal(t, replay.Total, int64(2))
+	assert.Equal(t, replay.Replayed, int64(2))
+	assert.Equal(t, replay.Skipped, int64(0))
+	assert.Assert(t, !replay.FinishedAt.IsZero())
+	// the events are replayed in order with their retained telemetry, through the dispatcher of the module
+	assert.NilError(t, dispatcher.stop(context.Background()))
+	assert.DeepEqual(t, mod.replayedPaths(), []string{"/first /first?q=1", "/second /second?q=1"})
+	assert.Equal(t, dispatcher.stats().Dropped, uint64(0))
+
+	// canceled replay, the module handles the first event and its queue holds the second one
+	blocked := &replayedModule{name: "blocked", block: make(chan struct{})}
+	blockedDispatcher := newModuleDispatcher("blocked", blocked, &DispatchConfig{QueueSize: 1})
+	blockedDispatcher.start(context.Background())
+	replay, err = replays.start(blockedDispatcher, apiInfo.ID, now.Add(-4*time.Hour), now)
+	assert.NilError(t, err)
+	_, err = replays.Cancel(replay.ID)
+	assert.NilError(t, err)
+	replay = waitReplayFinished(t, replays, replay.ID)
+	assert.Equal(t, replay.Status, ReplayCanceled)
+	assert.Assert(t, replay.Replayed < 3)
+	close(blocked.block)
+	assert.NilError(t, blockedDispatcher.stop(context.Background()))
+
+	_, err = replays.Cancel(42)
+	assert.Assert(t, errors.Is(err, ErrReplayNotFound))
+	list := replays.List()
+	assert.Equal(t, len(list), 2)
+	assert.Equal(t, list[0].ModuleName, "blocked")
+
+	replays.stop()
+}
+
+func TestReplays_prune(t *testing.T) {
+	replays := NewReplays(nil)
+	replays.maxFinished = 2
+
+	now := time.Now().UTC()
+	for id, finishedAt := range map[uint]time.Time{
+		1: now.Add(-time.Minute),
+		2: now.Add(-2 * time.Minute),
+		3: now.Add(-replayRetention - time.Minute),
+		4: now.Add(-3 * time.Minute),
+		5: {},
+	} {
+		status := ReplayCompleted
+		if finishedAt.IsZero() {
+			status = ReplayRunning
+		}
+		replays.jobs[id] = &replayJob{Replay: Replay{ID: id, Status: status, FinishedAt: finishedAt}}
+	}
+
+	// the expired replay and the earliest finished replay beyond the max count are pruned, the running one is kept
+	list := replays.List()
+	ids := make([]uint, 0, len(list))
+	for _, replay := range list {
+		ids = append(ids, replay.ID)
+	}
+	assert.DeepEqual(t, ids, []uint{5, 2, 1})
+}
+
+func TestCore_StartReplay(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	mod := &replayedModule{name: "mod"}
+	c := &Core{
+		Modules:     map[string]Module{"mod": mod},
+		dispatchers: map[string]*moduleDispatcher{"mod": newModuleDispatcher("mod", mod, &DispatchConfig{})},
+		disabled:    map[string]bool{"mod": true},
+		replays:     NewReplays(dbHandler),
+	}
+
+	_, err := c.StartReplay("unknown", 1, time.Now().Add(-time.Hour), time.Now())
+	assert.Assert(t, errors.Is(err, ErrModuleNotFound))
+	_, err = c.StartReplay("mod", 1, time.Now().Add(-time.Hour), time.Now())
+	assert.Assert(t, errors.Is(err, ErrInvalidReplay))
+	assert.Equal(t, len(c.Replays()), 0)
+}
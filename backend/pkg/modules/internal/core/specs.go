@@ -0,0 +1,288 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ghodss/yaml"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	_spec "github.com/openclarity/speculator/pkg/spec"
+)
+
+// ErrSpecPathNotFound is returned when an event or a path doesn't resolve to a path of the specs of its API,
+// either because the API has no spec or because the path is not in its specs.
+var ErrSpecPathNotFound = errors.New("spec path not found")
+
+// ResolvedPath is the operation of a spec of an API which an event or a path resolves to.
+type ResolvedPath struct {
+	SpecSource _spec.SpecSource
+	PathID     string
+	// Path is the path template of the spec, e.g. /pets/{petId}
+	Path string
+	// PathParams are the values of the path parameters of the event, by parameter name
+	PathParams map[string]string
+	Tags       []string
+	// Operation is nil when the spec can't be loaded as an OpenAPI v2 spec
+	Operation *spec.Operation
+}
+
+type specOperationKey struct {
+	path   string
+	method models.HTTPMethod
+}
+
+type specOperation struct {
+	pathID    string
+	path      string
+	tags      []string
+	operation *spec.Operation
+}
+
+type apiSpec struct {
+	source _spec.SpecSource
+	// the operations by path id and by path template
+	byPathID map[specOperationKey]*specOperation
+	byPath   map[specOperationKey]*specOperation
+}
+
+// APISpecs are the provided and reconstructed specs of an API parsed for path resolution.
+type APISpecs struct {
+	provided      *apiSpec
+	reconstructed *apiSpec
+}
+
+func NewAPISpecs(apiInfo *database.APIInfo) (*APISpecs, error) {
+	specs := &APISpecs{}
+
+	var err error
+	if apiInfo.HasProvidedSpec && apiInfo.ProvidedSpecInfo != "" {
+		if specs.provided, err = newAPISpec(_spec.SpecSourceProvided, apiInfo.ProvidedSpec, apiInfo.ProvidedSpecInfo); err != nil {
+			return nil, fmt.Errorf("failed to parse provided spec of api=%d: %w", apiInfo.ID, err)
+		}
+	}
+	if apiInfo.HasReconstructedSpec && apiInfo.ReconstructedSpecInfo != "" {
+		if specs.reconstructed, err = newAPISpec(_spec.SpecSourceReconstructed, apiInfo.ReconstructedSpec, apiInfo.ReconstructedSpecInfo); err != nil {
+			return nil, fmt.Errorf("failed to parse reconstructed spec of api=%d: %w", apiInfo.ID, err)
+		}
+	}
+
+	return specs, nil
+}
+
+func newAPISpec(source _spec.SpecSource, rawSpec, serializedSpecInfo string) (*apiSpec, error) {
+	var specInfo models.SpecInfo
+	if err := json.Unmarshal([]byte(serializedSpecInfo), &specInfo); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal spec info: %w", err)
+	}
+
+	swagger, err := loadSwagger(rawSpec)
+	if err != nil {
+		log.Debugf("Unable to load %s spec, the operations won't be resolved: %v", source, err)
+	}
+
+	s := &apiSpec{
+		source:   source,
+		byPathID: map[specOperationKey]*specOperation{},
+		byPath:   map[specOperationKey]*specOperation{},
+	}
+	for _, tag := range specInfo.Tags {
+		for _, methodAndPath := range tag.MethodAndPathList {
+			byPathIDKey := specOperationKey{path: methodAndPath.PathID.String(), method: methodAndPath.Method}
+			op, ok := s.byPathID[byPathIDKey]
+			if !ok {
+				op = &specOperation{
+					pathID:    methodAndPath.PathID.String(),
+					path:      methodAndPath.Path,
+					operation: getOperation(swagger, methodAndPath.Path, methodAndPath.Method),
+				}
+				s.byPathID[byPathIDKey] = op
+				s.byPath[specOperationKey{path: methodAndPath.Path, method: methodAndPath.Method}] = op
+			}
+			op.tags = append(op.tags, tag.Name)
+		}
+	}
+
+	return s, nil
+}
+
+func loadSwagger(rawSpec string) (*spec.Swagger, error) {
+	jsonSpec, err := yaml.YAMLToJSON([]byte(rawSpec))
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert yaml spec to json: %v", err)
+	}
+	analyzed, err := loads.Analyzed(jsonSpec, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to analyze spec: %v", err)
+	}
+	return analyzed.Spec(), nil
+}
+
+func getOperation(swagger *spec.Swagger, path string, method models.HTTPMethod) *spec.Operation {
+	if swagger == nil || swagger.Paths == nil {
+		return nil
+	}
+	pathItem, ok := swagger.Paths.Paths[path]
+	if !ok {
+		return nil
+	}
+
+	switch method {
+	case models.HTTPMethodGET:
+		return pathItem.Get
+	case models.HTTPMethodHEAD:
+		return pathItem.Head
+	case models.HTTPMethodPOST:
+		return pathItem.Post
+	case models.HTTPMethodPUT:
+		return pathItem.Put
+	case models.HTTPMethodDELETE:
+		return pathItem.Delete
+	case models.HTTPMethodOPTIONS:
+		return pathItem.Options
+	case models.HTTPMethodPATCH:
+		return pathItem.Patch
+	}
+	return nil
+}
+
+func (s *apiSpec) resolvedPath(op *specOperation) *ResolvedPath {
+	return &ResolvedPath{
+		SpecSource: s.source,
+		PathID:     op.pathID,
+		Path:       op.path,
+		Tags:       op.tags,
+		Operation:  op.operation,
+	}
+}
+
+// ResolveEvent resolves an event with the path id of the provided spec, or else with the path id of the reconstructed spec.
+func (s *APISpecs) ResolveEvent(event *database.APIEvent) (*ResolvedPath, error) {
+	candidates := []struct {
+		spec   *apiSpec
+		pathID string
+	}{
+		{spec: s.provided, pathID: event.ProvidedPathID},
+		{spec: s.reconstructed, pathID: event.ReconstructedPathID},
+	}
+	for _, candidate := range candidates {
+		if candidate.spec == nil || candidate.pathID == "" {
+			continue
+		}
+		if op, ok := candidate.spec.byPathID[specOperationKey{path: candidate.pathID, method: event.Method}]; ok {
+			resolved := candidate.spec.resolvedPath(op)
+			resolved.PathParams = getPathParams(op.path, event.Path)
+			return resolved, nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to resolve event %v (%s %s): %w", event.ID, event.Method, event.Path, ErrSpecPathNotFound)
+}
+
+// ResolvePath resolves a path template of the provided spec, or else of the reconstructed spec.
+func (s *APISpecs) ResolvePath(path string, method models.HTTPMethod) (*ResolvedPath, error) {
+	for _, apiSpec := range []*apiSpec{s.provided, s.reconstructed} {
+		if apiSpec == nil {
+			continue
+		}
+		if op, ok := apiSpec.byPath[specOperationKey{path: path, method: method}]; ok {
+			return apiSpec.resolvedPath(op), nil
+		}
+	}
+
+	return nil, fmt.Errorf("failed to resolve %s %s: %w", method, path, ErrSpecPathNotFound)
+}
+
+func getPathParams(specPath string, path string) map[string]string {
+	params := map[string]string{}
+
+	specSegs := strings.Split(specPath, "/")
+	segs := strings.Split(path, "/")
+	if len(specSegs) != len(segs) {
+		return params
+	}
+	for i, specSeg := range specSegs {
+		if len(specSeg) > 2 && strings.HasPrefix(specSeg, "{") && strings.HasSuffix(specSeg, "}") {
+			params[specSeg[1:len(specSeg)-1]] = segs[i]
+		}
+	}
+
+	return params
+}
+
+// APISpecsCache holds the parsed specs of the APIs, so that the modules don't load and parse the specs of
+// the API of each event. The specs of an API must be invalidated when they are uploaded, approved or deleted.
+type APISpecsCache struct {
+	dbHandler database.Database
+
+	lock  sync.RWMutex
+	specs map[uint]*APISpecs
+	// incremented on each invalidation, so that specs loaded before an invalidation aren't cached
+	generation uint64
+}
+
+func NewAPISpecsCache(dbHandler database.Database) *APISpecsCache {
+	return &APISpecsCache{
+		dbHandler: dbHandler,
+		specs:     map[uint]*APISpecs{},
+	}
+}
+
+func (c *APISpecsCache) Get(ctx context.Context, apiID uint) (*APISpecs, error) {
+	c.lock.RLock()
+	specs, ok := c.specs[apiID]
+	generation := c.generation
+	c.lock.RUnlock()
+	if ok {
+		return specs, nil
+	}
+
+	apiInfo := &database.APIInfo{}
+	if err := c.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+		return nil, fmt.Errorf("failed to retrieve API info for apiID=%v: %w", apiID, err)
+	}
+	specs, err := NewAPISpecs(apiInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.generation == generation {
+		c.specs[apiID] = specs
+	}
+
+	return specs, nil
+}
+
+// Invalidate drops the specs of an API, they are loaded again on the next resolution.
+func (c *APISpecsCache) Invalidate(apiID uint) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.specs, apiID)
+	c.generation++
+}
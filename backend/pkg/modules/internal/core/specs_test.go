@@ -0,0 +1,125 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	_spec "github.com/openclarity/speculator/pkg/spec"
+)
+
+const (
+	testSpec = `{"swagger": "2.0", "info": {"title": "", "version": ""}, "paths": {
+		"/pets/{petId}": {"get": {"operationId": "getPet", "responses": {"200": {"description": ""}}}},
+		"/pets": {"post": {"operationId": "addPet", "responses": {"200": {"description": ""}}}}
+	}}`
+	petIDPathID = "38f0e2f6-6ba4-4ad2-8d44-3f1d0ad6e3b9"
+	petsPathID  = "a4c2b8b0-ff2f-4d8b-9e5f-3d3e5b0e0a53"
+)
+
+func testSpecInfo() *models.SpecInfo {
+	return &models.SpecInfo{Tags: []*models.SpecTag{
+		{Name: "pets", MethodAndPathList: []*models.MethodAndPath{
+			{Method: models.HTTPMethodGET, Path: "/pets/{petId}", PathID: petIDPathID},
+			{Method: models.HTTPMethodPOST, Path: "/pets", PathID: petsPathID},
+		}},
+		{Name: "store", MethodAndPathList: []*models.MethodAndPath{
+			{Method: models.HTTPMethodGET, Path: "/pets/{petId}", PathID: petIDPathID},
+		}},
+	}}
+}
+
+func TestAPISpecs(t *testing.T) {
+	specInfo := `{"tags":[{"name":"pets","methodAndPathList":[{"method":"GET","path":"/pets/{petId}","pathId":"` + petIDPathID + `"},{"method":"POST","path":"/pets","pathId":"` + petsPathID + `"}]},{"name":"store","methodAndPathList":[{"method":"GET","path":"/pets/{petId}","pathId":"` + petIDPathID + `"}]}]}`
+	specs, err := NewAPISpecs(&database.APIInfo{
+		HasReconstructedSpec:  true,
+		ReconstructedSpec:     testSpec,
+		ReconstructedSpecInfo: specInfo,
+	})
+	assert.NilError(t, err)
+
+	resolved, err := specs.ResolveEvent(&database.APIEvent{Method: models.HTTPMethodGET, Path: "/pets/12", ReconstructedPathID: petIDPathID})
+	assert.NilError(t, err)
+	assert.Equal(t, resolved.SpecSource, _spec.SpecSourceReconstructed)
+	assert.Equal(t, resolved.Path, "/pets/{petId}")
+	assert.DeepEqual(t, resolved.PathParams, map[string]string{"petId": "12"})
+	assert.DeepEqual(t, resolved.Tags, []string{"pets", "store"})
+	assert.Assert(t, resolved.Operation != nil)
+	assert.Equal(t, resolved.Operation.ID, "getPet")
+
+	// the provided spec is not there, the reconstructed one is used
+	resolved, err = specs.ResolveEvent(&database.APIEvent{Method: models.HTTPMethodPOST, Path: "/pets", ProvidedPathID: petsPathID, ReconstructedPathID: petsPathID})
+	assert.NilError(t, err)
+	assert.Equal(t, resolved.Operation.ID, "addPet")
+
+	_, err = specs.ResolveEvent(&database.APIEvent{Method: models.HTTPMethodPOST, Path: "/pets/12", ReconstructedPathID: petIDPathID})
+	assert.Assert(t, errors.Is(err, ErrSpecPathNotFound))
+	_, err = specs.ResolveEvent(&database.APIEvent{Method: models.HTTPMethodGET, Path: "/pets/12"})
+	assert.Assert(t, errors.Is(err, ErrSpecPathNotFound))
+
+	resolved, err = specs.ResolvePath("/pets/{petId}", models.HTTPMethodGET)
+	assert.NilError(t, err)
+	assert.Equal(t, resolved.PathID, petIDPathID)
+	assert.DeepEqual(t, resolved.Tags, []string{"pets", "store"})
+	_, err = specs.ResolvePath("/pets/{petId}", models.HTTPMethodDELETE)
+	assert.Assert(t, errors.Is(err, ErrSpecPathNotFound))
+
+	_, err = NewAPISpecs(&database.APIInfo{HasProvidedSpec: true, ProvidedSpecInfo: "not json"})
+	assert.Assert(t, err != nil)
+}
+
+func TestAPISpecsCache(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	apiInfo := &database.APIInfo{Name: "pets", Port: 80}
+	dbHandler.APIInventoryTable().CreateAPIInfo(apiInfo)
+	cache := NewAPISpecsCache(dbHandler)
+	event := &database.APIEvent{APIInfoID: apiInfo.ID, Method: models.HTTPMethodGET, Path: "/pets/12", ProvidedPathID: petIDPathID}
+
+	specs, err := cache.Get(context.Background(), apiInfo.ID)
+	assert.NilError(t, err)
+	_, err = specs.ResolveEvent(event)
+	assert.Assert(t, errors.Is(err, ErrSpecPathNotFound))
+
+	// the specs are cached until they are invalidated
+	assert.NilError(t, dbHandler.APIInventoryTable().PutAPISpec(apiInfo.ID, testSpec, testSpecInfo(), database.ProvidedSpecType, strfmt.DateTime(time.Now())))
+	specs, err = cache.Get(context.Background(), apiInfo.ID)
+	assert.NilError(t, err)
+	_, err = specs.ResolveEvent(event)
+	assert.Assert(t, errors.Is(err, ErrSpecPathNotFound))
+
+	cache.Invalidate(apiInfo.ID)
+	specs, err = cache.Get(context.Background(), apiInfo.ID)
+	assert.NilError(t, err)
+	resolved, err := specs.ResolveEvent(event)
+	assert.NilError(t, err)
+	assert.Equal(t, resolved.SpecSource, _spec.SpecSourceProvided)
+	assert.Equal(t, resolved.Path, "/pets/{petId}")
+
+	_, err = cache.Get(context.Background(), apiInfo.ID+1)
+	assert.Assert(t, err != nil)
+}
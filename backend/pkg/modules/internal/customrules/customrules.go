@@ -0,0 +1,432 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customrules
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	log "github.com/sirupsen/logrus"
+
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/api3/notifications"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/customrules/restapi"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/utils"
+	_spec "github.com/openclarity/speculator/pkg/spec"
+)
+
+const (
+	moduleName        = "customrules"
+	moduleDescription = "Detects the API events matching custom rules defined as CEL expressions"
+
+	// matchedRulesAnnotation is the annotation of an event listing the rules it matched.
+	matchedRulesAnnotation = "matched_rules"
+	// findingType is the type of the API findings of the rules, their name is the name of the rule.
+	findingType = "CUSTOM_RULE"
+)
+
+const configSchema = `{
+	"type": "object",
+	"properties": {
+		"rules": {
+			"description": "The detection rules",
+			"type": "array",
+			"items": {
+				"type": "object",
+				"properties": {
+					"name": {"type": "string", "pattern": "^[A-Za-z0-9_.-]+$"},
+					"description": {"type": "string"},
+					"severity": {"enum": ["INFO", "LOW", "MEDIUM", "HIGH", "CRITICAL"]},
+					"expression": {"type": "string", "minLength": 1}
+				},
+				"required": ["name", "severity", "expression"],
+				"additionalProperties": false
+			}
+		}
+	},
+	"required": ["rules"],
+	"additionalProperties": false
+}`
+
+type rulesConfig struct {
+	Rules []Rule `json:"rules"`
+}
+
+// apiFinding is a rule matched by the events of an API operation, it is stored as an annotation of the API.
+type apiFinding struct {
+	Rule Rule `json:"rule"`
+	// Method and SpecPath are the operation of the spec the events were resolved to, they are empty when the API has no spec
+	Method     string           `json:"method,omitempty"`
+	SpecPath   string           `json:"specPath,omitempty"`
+	SpecSource _spec.SpecSource `json:"specSource,omitempty"`
+	// EventID is the first event which matched the rule
+	EventID   uint      `json:"eventId"`
+	FirstSeen time.Time `json:"firstSeen"`
+}
+
+// annotationName identifies the finding among the annotations of the API.
+func (f *apiFinding) annotationName() string {
+	if f.SpecPath == "" {
+		return f.Rule.Name
+	}
+	return f.Rule.Name + " " + f.Method + " " + f.SpecPath
+}
+
+func (f *apiFinding) toAPIFinding() oapicommon.APIFinding {
+	description := f.Rule.Description
+	if description == "" {
+		description = fmt.Sprintf("The API events match the expression %s", f.Rule.Expression)
+	}
+	additionalInfo := map[string]interface{}{
+		"expression": f.Rule.Expression,
+		"eventId":    f.EventID,
+		"firstSeen":  f.FirstSeen,
+	}
+	apiFinding := oapicommon.APIFinding{
+		Source:         moduleName,
+		Type:           findingType,
+		Name:           f.Rule.Name,
+		Description:    description,
+		Severity:       f.Rule.Severity,
+		AdditionalInfo: &additionalInfo,
+	}
+	if f.SpecPath != "" {
+		location := utils.JSONPointer("paths", f.SpecPath, strings.ToLower(f.Method))
+		if f.SpecSource == _spec.SpecSourceProvided {
+			apiFinding.ProvidedSpecLocation = &location
+		} else {
+			apiFinding.ReconstructedSpecLocation = &location
+		}
+	}
+	return apiFinding
+}
+
+type customRules struct {
+	httpHandler http.Handler
+	accessor    core.BackendAccessor
+	env         *cel.Env
+
+	rules     []*compiledRule
+	rulesLock sync.RWMutex
+	// editLock serializes the changes of single rules, which replace the whole config
+	editLock sync.Mutex
+
+	// findings are the rules already matched by each API, by annotation name, loaded from the database on first use
+	findings     map[uint]map[string]Rule
+	findingsLock sync.Mutex
+}
+
+//nolint:gochecknoinits
+func init() {
+	core.RegisterModule(newModule)
+}
+
+func newModule(ctx context.Context, accessor core.BackendAccessor) (core.Module, error) {
+	env, err := newRulesEnv()
+	if err != nil {
+		return nil, err
+	}
+	m := &customRules{
+		accessor: accessor,
+		env:      env,
+		findings: map[uint]map[string]Rule{},
+	}
+	m.httpHandler = restapi.HandlerWithOptions(&httpHandler{m: m}, restapi.ChiServerOptions{BaseURL: core.BaseHTTPPath + "/" + moduleName})
+
+	conf, err := accessor.RegisterConfig(moduleName, []byte(configSchema), rulesConfig{Rules: []Rule{}}, m.setConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register config: %w", err)
+	}
+	if err := m.setConfig(ctx, conf); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// setConfig compiles the rules of a config, the config is rejected when any of them is invalid.
+func (m *customRules) setConfig(_ context.Context, config []byte) error {
+	var conf rulesConfig
+	if err := json.Unmarshal(config, &conf); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	rules := make([]*compiledRule, 0, len(conf.Rules))
+	names := map[string]bool{}
+	for _, rule := range conf.Rules {
+		if names[rule.Name] {
+			return fmt.Errorf("duplicate rule %s", rule.Name)
+		}
+		names[rule.Name] = true
+		compiled, err := compileRule(m.env, rule)
+		if err != nil {
+			return err
+		}
+		rules = append(rules, compiled)
+	}
+
+	m.rulesLock.Lock()
+	defer m.rulesLock.Unlock()
+	m.rules = rules
+
+	return nil
+}
+
+func (m *customRules) getRules() []*compiledRule {
+	m.rulesLock.RLock()
+	defer m.rulesLock.RUnlock()
+
+	return m.rules
+}
+
+// editRules replaces the rules by the ones returned by edit, through the config of the module so that they are stored.
+func (m *customRules) editRules(ctx context.Context, edit func(rules []Rule) []Rule) error {
+	m.editLock.Lock()
+	defer m.editLock.Unlock()
+
+	current := m.getRules()
+	rules := make([]Rule, 0, len(current))
+	for _, rule := range current {
+		rules = append(rules, rule.Rule)
+	}
+	config, err := json.Marshal(rulesConfig{Rules: edit(rules)})
+	if err != nil {
+		return fmt.Errorf("failed to encode rules: %v", err)
+	}
+	if err := m.accessor.SetConfig(ctx, moduleName, config); err != nil {
+		return fmt.Errorf("failed to set rules: %w", err)
+	}
+	return nil
+}
+
+func (m *customRules) Info() core.ModuleInfo {
+	return core.ModuleInfo{
+		Name:        moduleName,
+		Description: moduleDescription,
+	}
+}
+
+func (m *customRules) HTTPHandler() http.Handler {
+	return m.httpHandler
+}
+
+func (m *customRules) Start(_ context.Context) error {
+	return nil
+}
+
+func (m *customRules) Stop(_ context.Context) error {
+	return nil
+}
+
+func (m *customRules) EventNotify(ctx context.Context, event *core.Event) {
+	rules := m.getRules()
+	if len(rules) == 0 {
+		return
+	}
+
+	activation := eventActivation(event)
+	var matched []Rule
+	for _, rule := range rules {
+		ok, err := rule.matches(activation)
+		if err != nil {
+			log.Debugf("[customrules] event %v: %v", event.APIEvent.ID, err)
+			continue
+		}
+		if ok {
+			matched = append(matched, rule.Rule)
+		}
+	}
+	if len(matched) == 0 {
+		return
+	}
+	log.Debugf("[customrules] event %v of API %v matched %d rules", event.APIEvent.ID, event.APIEvent.APIInfoID, len(matched))
+
+	annotation, err := json.Marshal(matched)
+	if err != nil {
+		log.Errorf("Failed to encode matched rules: %v", err)
+		return
+	}
+	if err := m.accessor.CreateAPIEventAnnotations(ctx, moduleName, event.APIEvent.ID,
+		core.Annotation{Name: matchedRulesAnnotation, Annotation: annotation},
+		alertAnnotation(matched),
+	); err != nil {
+		log.Error(err)
+	}
+
+	if err := m.addFindings(ctx, event, matched); err != nil {
+		log.Error(err)
+	}
+}
+
+// addFindings stores the API findings of the matched rules which are new to the API operation of the event, or
+// whose rule changed since, and notifies the API findings when any was stored.
+func (m *customRules) addFindings(ctx context.Context, event *core.Event, matched []Rule) error {
+	var method, specPath string
+	var specSource _spec.SpecSource
+	resolved, err := m.accessor.ResolveEventPath(ctx, event.APIEvent)
+	switch {
+	case err == nil:
+		method, specPath, specSource = string(event.APIEvent.Method), resolved.Path, resolved.SpecSource
+	case !errors.Is(err, core.ErrSpecPathNotFound):
+		return fmt.Errorf("failed to resolve spec path of event %v: %v", event.APIEvent.ID, err)
+	}
+
+	m.findingsLock.Lock()
+	defer m.findingsLock.Unlock()
+
+	apiID := event.APIEvent.APIInfoID
+	known, err := m.apiFindings(ctx, apiID)
+	if err != nil {
+		return err
+	}
+	var annotations []core.Annotation
+	stored := map[string]Rule{}
+	for _, rule := range matched {
+		finding := &apiFinding{
+			Rule:       rule,
+			Method:     method,
+			SpecPath:   specPath,
+			SpecSource: specSource,
+			EventID:    event.APIEvent.ID,
+			FirstSeen:  time.Now().UTC(),
+		}
+		name := finding.annotationName()
+		if knownRule, ok := known[name]; ok && knownRule == rule {
+			continue
+		}
+		annotation, err := json.Marshal(finding)
+		if err != nil {
+			return fmt.Errorf("failed to encode finding: %v", err)
+		}
+		annotations = append(annotations, core.Annotation{Name: name, Annotation: annotation})
+		stored[name] = rule
+	}
+	if len(annotations) == 0 {
+		return nil
+	}
+
+	if err := m.accessor.StoreAPIInfoAnnotations(ctx, moduleName, apiID, annotations...); err != nil {
+		return fmt.Errorf("failed to store findings of API %v: %v", apiID, err)
+	}
+	for name, rule := range stored {
+		known[name] = rule
+	}
+
+	return m.sendAPIFindingsNotification(ctx, apiID)
+}
+
+// apiFindings returns the rules already matched by an API by annotation name, m.findingsLock must be held.
+func (m *customRules) apiFindings(ctx context.Context, apiID uint) (map[string]Rule, error) {
+	if known, ok := m.findings[apiID]; ok {
+		return known, nil
+	}
+	findings, err := m.getAPIFindings(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+	known := make(map[string]Rule, len(findings))
+	for _, finding := range findings {
+		known[finding.annotationName()] = finding.Rule
+	}
+	m.findings[apiID] = known
+	return known, nil
+}
+
+func (m *customRules) getAPIFindings(ctx context.Context, apiID uint) ([]*apiFinding, error) {
+	anns, err := m.accessor.ListAPIInfoAnnotations(ctx, moduleName, apiID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get findings of API %v: %v", apiID, err)
+	}
+	findings := make([]*apiFinding, 0, len(anns))
+	for _, ann := range anns {
+		finding := &apiFinding{}
+		if err := json.Unmarshal(ann.Annotation, finding); err != nil {
+			log.Errorf("Failed to decode finding %s of API %v: %v", ann.Name, apiID, err)
+			continue
+		}
+		findings = append(findings, finding)
+	}
+	return findings, nil
+}
+
+// StoredAPIFindings returns the findings notified on an API, see core.APIFindingsProvider.
+func (m *customRules) StoredAPIFindings(ctx context.Context, apiID uint) ([]oapicommon.APIFinding, error) {
+	findings, err := m.getAPIFindings(ctx, apiID)
+	if err != nil {
+		return nil, err
+	}
+	items := make([]oapicommon.APIFinding, 0, len(findings))
+	for _, finding := range findings {
+		items = append(items, finding.toAPIFinding())
+	}
+	return items, nil
+}
+
+func (m *customRules) resetAPIFindings(ctx context.Context, apiID uint) error {
+	m.findingsLock.Lock()
+	defer m.findingsLock.Unlock()
+
+	delete(m.findings, apiID)
+	if err := m.accessor.DeleteAllAPIInfoAnnotations(ctx, moduleName, apiID); err != nil {
+		return fmt.Errorf("failed to delete findings of API %v: %v", apiID, err)
+	}
+	return m.notifyAPIFindings(ctx, apiID, []oapicommon.APIFinding{})
+}
+
+func (m *customRules) sendAPIFindingsNotification(ctx context.Context, apiID uint) error {
+	items, err := m.StoredAPIFindings(ctx, apiID)
+	if err != nil {
+		return err
+	}
+	return m.notifyAPIFindings(ctx, apiID, items)
+}
+
+func (m *customRules) notifyAPIFindings(ctx context.Context, apiID uint, items []oapicommon.APIFinding) error {
+	n := notifications.APIClarityNotification{}
+	if err := n.FromApiFindingsNotification(notifications.ApiFindingsNotification{
+		NotificationType: "ApiFindingsNotification",
+		Items:            &items,
+	}); err != nil {
+		return fmt.Errorf("failed to serialize notification: %v", err)
+	}
+	if err := m.accessor.Notify(ctx, moduleName, apiID, n); err != nil {
+		return fmt.Errorf("failed to send notification: %v", err)
+	}
+	return nil
+}
+
+// alertAnnotation raises the alert of the most severe of the matched rules.
+func alertAnnotation(matched []Rule) core.Annotation {
+	alert := core.AlertInfoAnn
+	for _, rule := range matched {
+		switch rule.Severity {
+		case oapicommon.CRITICAL:
+			return core.AlertCriticalAnn
+		case oapicommon.MEDIUM, oapicommon.HIGH:
+			alert = core.AlertWarnAnn
+		case oapicommon.INFO, oapicommon.LOW:
+		}
+	}
+	return alert
+}
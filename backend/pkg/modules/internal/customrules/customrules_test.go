@@ -0,0 +1,173 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customrules
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+	_spec "github.com/openclarity/speculator/pkg/spec"
+)
+
+// newTestModule creates the module with a mock accessor which delivers the configs set to the module.
+func newTestModule(t *testing.T, accessor *core.MockBackendAccessor, rules ...Rule) *customRules {
+	t.Helper()
+
+	var m *customRules
+	conf, err := json.Marshal(rulesConfig{Rules: rules})
+	assert.NilError(t, err)
+	accessor.EXPECT().RegisterConfig(moduleName, gomock.Any(), gomock.Any(), gomock.Any()).Return(conf, nil)
+	accessor.EXPECT().SetConfig(gomock.Any(), moduleName, gomock.Any()).DoAndReturn(func(ctx context.Context, _ string, config []byte) error {
+		if err := m.setConfig(ctx, config); err != nil {
+			return fmt.Errorf("%w: %v", core.ErrInvalidModuleConfig, err)
+		}
+		return nil
+	}).AnyTimes()
+
+	module, err := newModule(context.Background(), accessor)
+	assert.NilError(t, err)
+	m = module.(*customRules) //nolint:forcetypeassert
+	return m
+}
+
+func TestCustomRules_EventNotify(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	accessor := core.NewMockBackendAccessor(mockCtrl)
+	m := newTestModule(t, accessor,
+		Rule{Name: "server-header", Description: "Internal APIs must not return the Server header", Severity: oapicommon.MEDIUM, Expression: `api.type == "INTERNAL" && "server" in response.headers`},
+		Rule{Name: "admin-bearer", Severity: oapicommon.CRITICAL, Expression: `request.path.startsWith("/admin/") && !("authorization" in request.headers)`},
+	)
+	ctx := context.Background()
+	event := testEvent(models.APITypeINTERNAL, "GET", "/pets/1", nil, []*pluginsmodels.Header{{Key: "Server", Value: "nginx"}}, "")
+
+	var stored []core.Annotation
+	accessor.EXPECT().CreateAPIEventAnnotations(ctx, moduleName, uint(3), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ uint, anns ...core.Annotation) error {
+		assert.Equal(t, len(anns), 2)
+		assert.Equal(t, anns[0].Name, matchedRulesAnnotation)
+		var matched []Rule
+		assert.NilError(t, json.Unmarshal(anns[0].Annotation, &matched))
+		assert.Equal(t, len(matched), 1)
+		assert.Equal(t, matched[0].Name, "server-header")
+		assert.DeepEqual(t, anns[1], core.AlertWarnAnn)
+		return nil
+	}).Times(2)
+	accessor.EXPECT().ResolveEventPath(ctx, event.APIEvent).Return(&core.ResolvedPath{SpecSource: _spec.SpecSourceProvided, Path: "/pets/{petId}"}, nil).Times(2)
+	accessor.EXPECT().ListAPIInfoAnnotations(ctx, moduleName, uint(2)).DoAndReturn(func(context.Context, string, uint) ([]*core.Annotation, error) {
+		anns := make([]*core.Annotation, 0, len(stored))
+		for i := range stored {
+			anns = append(anns, &stored[i])
+		}
+		return anns, nil
+	}).Times(3)
+	accessor.EXPECT().StoreAPIInfoAnnotations(ctx, moduleName, uint(2), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ uint, anns ...core.Annotation) error {
+		stored = append(stored, anns...)
+		return nil
+	})
+	accessor.EXPECT().Notify(ctx, moduleName, uint(2), gomock.Any()).Return(nil)
+
+	// the finding is only stored and notified for the first event
+	m.EventNotify(ctx, event)
+	m.EventNotify(ctx, event)
+
+	assert.Equal(t, len(stored), 1)
+	assert.Equal(t, stored[0].Name, "server-header GET /pets/{petId}")
+	findings, err := m.getAPIFindings(ctx, 2)
+	assert.NilError(t, err)
+	finding := findings[0].toAPIFinding()
+	assert.Equal(t, finding.Source, moduleName)
+	assert.Equal(t, finding.Type, findingType)
+	assert.Equal(t, finding.Name, "server-header")
+	assert.Equal(t, finding.Description, "Internal APIs must not return the Server header")
+	assert.Equal(t, finding.Severity, oapicommon.MEDIUM)
+	assert.Equal(t, *finding.ProvidedSpecLocation, "/paths/~1pets~1{petId}/get")
+	assert.Assert(t, finding.ReconstructedSpecLocation == nil)
+
+	// an event matching no rule isn't annotated
+	m.EventNotify(ctx, testEvent(models.APITypeEXTERNAL, "GET", "/pets/1", nil, nil, ""))
+}
+
+func TestCustomRules_HTTPHandler(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	accessor := core.NewMockBackendAccessor(mockCtrl)
+	m := newTestModule(t, accessor)
+	basePath := core.BaseHTTPPath + "/" + moduleName
+
+	serve := func(method string, path string, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		m.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(method, basePath+path, strings.NewReader(body)))
+		return rec
+	}
+	listRules := func() []string {
+		rec := serve(http.MethodGet, "/rules", "")
+		assert.Equal(t, rec.Code, http.StatusOK)
+		var rules struct {
+			Items []Rule `json:"items"`
+		}
+		assert.NilError(t, json.NewDecoder(rec.Body).Decode(&rules))
+		names := []string{}
+		for _, rule := range rules.Items {
+			names = append(names, rule.Name)
+		}
+		return names
+	}
+
+	assert.Equal(t, serve(http.MethodPut, "/rules/status", `{"severity":"LOW","expression":"response.statusCode >= 500"}`).Code, http.StatusOK)
+	assert.Equal(t, serve(http.MethodPut, "/rules/admin", `{"severity":"HIGH","expression":"request.path.startsWith(\"/admin\")"}`).Code, http.StatusOK)
+	assert.DeepEqual(t, listRules(), []string{"status", "admin"})
+
+	// replaced in place
+	rec := serve(http.MethodPut, "/rules/status", `{"description":"Server errors","severity":"MEDIUM","expression":"response.statusCode >= 500"}`)
+	assert.Equal(t, rec.Code, http.StatusOK)
+	rec = serve(http.MethodGet, "/rules/status", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	var rule Rule
+	assert.NilError(t, json.NewDecoder(rec.Body).Decode(&rule))
+	assert.DeepEqual(t, rule, Rule{Name: "status", Description: "Server errors", Severity: oapicommon.MEDIUM, Expression: "response.statusCode >= 500"})
+	assert.DeepEqual(t, listRules(), []string{"status", "admin"})
+
+	// invalid expressions are rejected
+	assert.Equal(t, serve(http.MethodPut, "/rules/broken", `{"severity":"LOW","expression":"response.statusCode >="}`).Code, http.StatusBadRequest)
+	assert.Equal(t, serve(http.MethodPut, "/rules/broken", `{"severity":"LOW","expression":"response.statusCode"}`).Code, http.StatusOK)
+	assert.Equal(t, serve(http.MethodPut, "/rules/broken", `{"severity":"LOW","expression":"1 + 1"}`).Code, http.StatusBadRequest)
+	assert.Equal(t, serve(http.MethodPut, "/rules/broken", `{`).Code, http.StatusBadRequest)
+
+	assert.Equal(t, serve(http.MethodDelete, "/rules/broken", "").Code, http.StatusNoContent)
+	assert.Equal(t, serve(http.MethodDelete, "/rules/broken", "").Code, http.StatusNotFound)
+	assert.Equal(t, serve(http.MethodGet, "/rules/broken", "").Code, http.StatusNotFound)
+	assert.DeepEqual(t, listRules(), []string{"status", "admin"})
+
+	accessor.EXPECT().DeleteAllAPIInfoAnnotations(gomock.Any(), moduleName, uint(2)).Return(nil)
+	accessor.EXPECT().Notify(gomock.Any(), moduleName, uint(2), gomock.Any()).Return(nil)
+	assert.Equal(t, serve(http.MethodPost, "/apiFindings/2/reset", "").Code, http.StatusNoContent)
+	accessor.EXPECT().ListAPIInfoAnnotations(gomock.Any(), moduleName, uint(2)).Return(nil, nil)
+	rec = serve(http.MethodGet, "/apiFindings/2", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, strings.TrimSpace(rec.Body.String()), `{"items":[]}`)
+}
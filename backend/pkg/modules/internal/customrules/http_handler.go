@@ -0,0 +1,166 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customrules
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	log "github.com/sirupsen/logrus"
+
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/common"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/customrules/restapi"
+)
+
+type httpHandler struct {
+	m *customRules
+}
+
+func toRestRule(rule Rule) restapi.Rule {
+	restRule := restapi.Rule{
+		Name:       rule.Name,
+		Severity:   rule.Severity,
+		Expression: rule.Expression,
+	}
+	if rule.Description != "" {
+		description := rule.Description
+		restRule.Description = &description
+	}
+	return restRule
+}
+
+func (h *httpHandler) findRule(name string) (Rule, bool) {
+	for _, rule := range h.m.getRules() {
+		if rule.Name == name {
+			return rule.Rule, true
+		}
+	}
+	return Rule{}, false
+}
+
+func (h *httpHandler) ListRules(w http.ResponseWriter, r *http.Request) {
+	items := []restapi.Rule{}
+	for _, rule := range h.m.getRules() {
+		items = append(items, toRestRule(rule.Rule))
+	}
+	common.HTTPResponse(w, http.StatusOK, restapi.Rules{Items: items})
+}
+
+func (h *httpHandler) GetRule(w http.ResponseWriter, r *http.Request, ruleName restapi.RuleName) {
+	rule, ok := h.findRule(ruleName)
+	if !ok {
+		common.HTTPResponse(w, http.StatusNotFound, &oapicommon.ApiResponse{Message: fmt.Sprintf("Rule %s not found", ruleName)})
+		return
+	}
+	common.HTTPResponse(w, http.StatusOK, toRestRule(rule))
+}
+
+func (h *httpHandler) PutRule(w http.ResponseWriter, r *http.Request, ruleName restapi.RuleName) {
+	var definition restapi.RuleDefinition
+	if err := json.NewDecoder(r.Body).Decode(&definition); err != nil {
+		common.HTTPResponse(w, http.StatusBadRequest, &oapicommon.ApiResponse{Message: fmt.Sprintf("Invalid rule: %v", err)})
+		return
+	}
+	rule := Rule{
+		Name:       ruleName,
+		Severity:   definition.Severity,
+		Expression: definition.Expression,
+	}
+	if definition.Description != nil {
+		rule.Description = *definition.Description
+	}
+
+	err := h.m.editRules(r.Context(), func(rules []Rule) []Rule {
+		for i := range rules {
+			if rules[i].Name == ruleName {
+				rules[i] = rule
+				return rules
+			}
+		}
+		return append(rules, rule)
+	})
+	if err != nil {
+		writeEditError(w, err)
+		return
+	}
+
+	log.Infof("Rule %s set: %s", ruleName, rule.Expression)
+	common.HTTPResponse(w, http.StatusOK, toRestRule(rule))
+}
+
+func (h *httpHandler) DeleteRule(w http.ResponseWriter, r *http.Request, ruleName restapi.RuleName) {
+	if _, ok := h.findRule(ruleName); !ok {
+		common.HTTPResponse(w, http.StatusNotFound, &oapicommon.ApiResponse{Message: fmt.Sprintf("Rule %s not found", ruleName)})
+		return
+	}
+
+	err := h.m.editRules(r.Context(), func(rules []Rule) []Rule {
+		kept := make([]Rule, 0, len(rules))
+		for _, rule := range rules {
+			if rule.Name != ruleName {
+				kept = append(kept, rule)
+			}
+		}
+		return kept
+	})
+	if err != nil {
+		writeEditError(w, err)
+		return
+	}
+
+	log.Infof("Rule %s deleted", ruleName)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func writeEditError(w http.ResponseWriter, err error) {
+	if errors.Is(err, core.ErrInvalidModuleConfig) {
+		common.HTTPResponse(w, http.StatusBadRequest, &oapicommon.ApiResponse{Message: err.Error()})
+		return
+	}
+	log.Error(err)
+	common.HTTPResponse(w, http.StatusInternalServerError, &oapicommon.ApiResponse{Message: "Internal error, could not store the rules"})
+}
+
+func (h *httpHandler) GetApiFindings(w http.ResponseWriter, r *http.Request, apiID oapicommon.ApiID) { //nolint:revive,stylecheck
+	findings, err := h.m.getAPIFindings(r.Context(), uint(apiID))
+	if err != nil {
+		log.Error(err)
+		common.HTTPResponse(w, http.StatusInternalServerError, &oapicommon.ApiResponse{Message: "Internal error, could not read data from database"})
+		return
+	}
+
+	items := make([]oapicommon.APIFinding, 0, len(findings))
+	for _, finding := range findings {
+		items = append(items, finding.toAPIFinding())
+	}
+	common.HTTPResponse(w, http.StatusOK, oapicommon.APIFindings{Items: &items})
+}
+
+//nolint:revive,stylecheck // Api is not uppercased because it's defined as is in the specification
+func (h *httpHandler) ResetApiFindings(w http.ResponseWriter, r *http.Request, apiID oapicommon.ApiID) {
+	if err := h.m.resetAPIFindings(r.Context(), uint(apiID)); err != nil {
+		log.Error(err)
+		common.HTTPResponse(w, http.StatusInternalServerError, &oapicommon.ApiResponse{Message: "Internal error, could not delete data from database"})
+		return
+	}
+
+	log.Infof("API Findings successfully reset for api=%d", apiID)
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,472 @@
+// Package restapi provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.11.1-0.20220609223533-7da811e1cf30 DO NOT EDIT.
+package restapi
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+
+	"github.com/deepmap/oapi-codegen/pkg/runtime"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/go-chi/chi/v5"
+	externalRef0 "github.com/openclarity/apiclarity/api3/common"
+)
+
+// Rule defines model for Rule.
+type Rule struct {
+	// Human readable description of what the rule detects
+	Description *string `json:"description,omitempty"`
+
+	// CEL expression over request, response and api, the rule matches the events for which it is true
+	Expression string `json:"expression"`
+	Name       string `json:"name"`
+
+	// Severity of a finding
+	Severity externalRef0.Severity `json:"severity"`
+}
+
+// RuleDefinition defines model for RuleDefinition.
+type RuleDefinition struct {
+	// Human readable description of what the rule detects
+	Description *string `json:"description,omitempty"`
+
+	// CEL expression over request, response and api, the rule matches the events for which it is true
+	Expression string `json:"expression"`
+
+	// Severity of a finding
+	Severity externalRef0.Severity `json:"severity"`
+}
+
+// Rules defines model for Rules.
+type Rules struct {
+	Items []Rule `json:"items"`
+}
+
+// RuleName defines model for ruleName.
+type RuleName = string
+
+// PutRuleJSONBody defines parameters for PutRule.
+type PutRuleJSONBody = RuleDefinition
+
+// PutRuleJSONRequestBody defines body for PutRule for application/json ContentType.
+type PutRuleJSONRequestBody = PutRuleJSONBody
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get findings for an API and module
+	// (GET /apiFindings/{apiID})
+	GetApiFindings(w http.ResponseWriter, r *http.Request, apiID externalRef0.ApiID)
+	// Delete all API findings for an API
+	// (POST /apiFindings/{apiID}/reset)
+	ResetApiFindings(w http.ResponseWriter, r *http.Request, apiID externalRef0.ApiID)
+	// List the custom detection rules
+	// (GET /rules)
+	ListRules(w http.ResponseWriter, r *http.Request)
+	// Delete a custom detection rule
+	// (DELETE /rules/{ruleName})
+	DeleteRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
+	// Get a custom detection rule
+	// (GET /rules/{ruleName})
+	GetRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
+	// Create or replace a custom detection rule
+	// (PUT /rules/{ruleName})
+	PutRule(w http.ResponseWriter, r *http.Request, ruleName RuleName)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetApiFindings operation middleware
+func (siw *ServerInterfaceWrapper) GetApiFindings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apiID" -------------
+	var apiID externalRef0.ApiID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiID", runtime.ParamLocationPath, chi.URLParam(r, "apiID"), &apiID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetApiFindings(w, r, apiID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ResetApiFindings operation middleware
+func (siw *ServerInterfaceWrapper) ResetApiFindings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "apiID" -------------
+	var apiID externalRef0.ApiID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "apiID", runtime.ParamLocationPath, chi.URLParam(r, "apiID"), &apiID)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "apiID", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ResetApiFindings(w, r, apiID)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// ListRules operation middleware
+func (siw *ServerInterfaceWrapper) ListRules(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListRules(w, r)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// DeleteRule operation middleware
+func (siw *ServerInterfaceWrapper) DeleteRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// GetRule operation middleware
+func (siw *ServerInterfaceWrapper) GetRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+// PutRule operation middleware
+func (siw *ServerInterfaceWrapper) PutRule(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var err error
+
+	// ------------- Path parameter "ruleName" -------------
+	var ruleName RuleName
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "ruleName", runtime.ParamLocationPath, chi.URLParam(r, "ruleName"), &ruleName)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "ruleName", Err: err})
+		return
+	}
+
+	var handler http.Handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PutRule(w, r, ruleName)
+	})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r.WithContext(ctx))
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{})
+}
+
+type ChiServerOptions struct {
+	BaseURL          string
+	BaseRouter       chi.Router
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, r chi.Router) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseRouter: r,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, r chi.Router, baseURL string) http.Handler {
+	return HandlerWithOptions(si, ChiServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: r,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handler {
+	r := options.BaseRouter
+
+	if r == nil {
+		r = chi.NewRouter()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/apiFindings/{apiID}", wrapper.GetApiFindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/apiFindings/{apiID}/reset", wrapper.ResetApiFindings)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rules", wrapper.ListRules)
+	})
+	r.Group(func(r chi.Router) {
+		r.Delete(options.BaseURL+"/rules/{ruleName}", wrapper.DeleteRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/rules/{ruleName}", wrapper.GetRule)
+	})
+	r.Group(func(r chi.Router) {
+		r.Put(options.BaseURL+"/rules/{ruleName}", wrapper.PutRule)
+	})
+
+	return r
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/9RXTW/jNhD9K4Pp3qpI7qYoUAN78Cbp1kCaBumemqQFI40ibiSSJSlvXUP/vRhStvyh",
+	"pnswsDAQxDZJcR7fezMcrTDXjdGKlHc4XaERVjTkyYZftq3pRjTE36XCKRrhK0xQhbFhOkFLf7XSUoFT",
+	"b1tK0OUVNSLu6D1ZfviP+9nZ7+Lsn8nZj3+mZ4/fvsEE/dLwTs5bqZ6x67r1oyH+XVuH2KKufy1xer9C",
+	"Y7Uh6yWFedVj299lG899XPW4iaWfPlHusUtW+MZSiVP8JhtIyPrwGce+pFIq6aVW2D12Ce6NTffxFORy",
+	"K816cucn/tw2QoElUYinmmBrEnQJnyvhwVcETCoU5Cn37pCgBOlvY8m50RAXV9cwzINekAVmgpxPwJIz",
+	"WjkCoQoQRiZDuEb4vCIXBmjBNECpLXyuZF6B9CAdsKzI0UVjWBQURqYMDt69gwec33y8uruZXT8gPLST",
+	"ydsf4n94QEd2QfYBQaoNhLQiUbDHRs7naEFW+iWfrtcnTbO9P2HkOYvWaJVpQ4rBLEVTjyr523rHfWNs",
+	"Qu2wOuKUILw71Ft6ana//J+jeLN+d2GtOMQUdzrEwOukKvWh6LPb+UUt+Bxw0TqvGwho4RddsLaz2znT",
+	"LH1Q7fXFmOCCbPQWTtJJ+h3j7QnGKZ6nk/Qck1AGwnFZiJ+kKqR6dtlKGDm/7Hj8mfwIUPcSfVX2T7Dv",
+	"hYItTE2AkQSLzm7nKczZbQ0pTwU8LYFEXvWLMACzgjefFzjFD+RnA5qAcqhl96MFLAB+tXodx4GzEKfr",
+	"HjlUTIFA39vJhD9yrTypwJgwppZ5OFT2ycUcPzqa2/mGpeCrPZmCIrBeAu9bVUTjFlSKtvZfA7KRdz1x",
+	"Y5CvrNV2U11CTrm2aYRdRl8MjmP7RcsFj/VW4gfGrJxZctHJRrvwuWu5O54+PdN9f5ib4SSnq/El1eQJ",
+	"RF0HaUfkjhrbdR3vC9SunNfS+Vjpj5On/3kLjObdx/42dntnY1ThZs5jwY69Ad/vw+p4sGy17si6qDCT",
+	"cqg1B9phSZdDKyAswQsZD63ysubxZRgLmZAeFN1I/F2sx3veH2NhWJJtuscvc2iMVJy+R8d15HONmvID",
+	"+aPTe1w3v2bm0742XtHKtH48s7b6b+kgryh/4d6FSm1pSDPpwHltqdjqwgMj3IXr7UbcUk5yQQWUVjc8",
+	"oUCrw0S8bY9ik/Cu8F4Xy6M6ZPs9arfh5auv+0r+jAKcuE0vLAlPEBaYWuSvFJiu6/4NAAD//xr3MQ/v",
+	"DwAA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %s", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %s", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	var res = make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	pathPrefix := path.Dir(pathToFile)
+
+	for rawPath, rawFunc := range externalRef0.PathToRawSpec(path.Join(pathPrefix, "../../../../../../api3/common/openapi.yaml")) {
+		if _, ok := res[rawPath]; ok {
+			// it is not possible to compare functions in golang, so always overwrite the old value
+		}
+		res[rawPath] = rawFunc
+	}
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	var resolvePath = PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		var pathToFile = url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
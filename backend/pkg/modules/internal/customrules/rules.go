@@ -0,0 +1,172 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customrules
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/google/cel-go/cel"
+
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// maxRuleCost bounds the evaluation of a rule on an event, to keep a costly expression from slowing the module down.
+const maxRuleCost = 100000
+
+// Rule is a detection rule, the rules are stored in the config of the module.
+type Rule struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description,omitempty"`
+	Severity    oapicommon.Severity `json:"severity"`
+	Expression  string              `json:"expression"`
+}
+
+type compiledRule struct {
+	Rule
+	program cel.Program
+}
+
+// newRulesEnv declares the variables the expressions of the rules are evaluated on, see eventActivation.
+func newRulesEnv() (*cel.Env, error) {
+	env, err := cel.NewEnv(
+		cel.Variable("request", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("response", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("api", cel.MapType(cel.StringType, cel.DynType)),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create rules environment: %v", err)
+	}
+	return env, nil
+}
+
+func compileRule(env *cel.Env, rule Rule) (*compiledRule, error) {
+	ast, issues := env.Compile(rule.Expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, fmt.Errorf("invalid expression of rule %s: %v", rule.Name, issues.Err())
+	}
+	// The type of an expression on the dynamic values of the event is only known when it is evaluated
+	if outputType := ast.OutputType(); !cel.BoolType.IsAssignableType(outputType) && outputType.String() != cel.DynType.String() {
+		return nil, fmt.Errorf("expression of rule %s must be a bool, not %v", rule.Name, outputType)
+	}
+	program, err := env.Program(ast, cel.CostLimit(maxRuleCost))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expression of rule %s: %v", rule.Name, err)
+	}
+	return &compiledRule{Rule: rule, program: program}, nil
+}
+
+// matches evaluates the rule on the activation of an event. An expression which fails to evaluate, e.g. because it
+// reads a header the event doesn't have, doesn't match.
+func (r *compiledRule) matches(activation map[string]interface{}) (bool, error) {
+	out, _, err := r.program.Eval(activation)
+	if err != nil {
+		return false, fmt.Errorf("failed to evaluate rule %s: %v", r.Name, err)
+	}
+	matched, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("rule %s evaluated to %v, not to a bool", r.Name, out.Value())
+	}
+	return matched, nil
+}
+
+// eventActivation returns the variables of the expressions for an event:
+//   - request: method, path, query (first value of each parameter), host, headers, body and json
+//   - response: statusCode, headers, body and json
+//   - api: id, name, port, type and namespace
+//
+// The header names are lower-cased, json is the decoded body and is only set when the body is JSON.
+func eventActivation(event *core.Event) map[string]interface{} {
+	request := map[string]interface{}{
+		"method": string(event.APIEvent.Method),
+		"path":   event.APIEvent.Path,
+		"query":  queryParams(event.APIEvent.Query),
+	}
+	response := map[string]interface{}{
+		"statusCode": event.APIEvent.StatusCode,
+	}
+	if event.Telemetry != nil && event.Telemetry.Request != nil {
+		request["host"] = event.Telemetry.Request.Host
+		addCommon(request, event.Telemetry.Request.Common)
+	} else {
+		addCommon(request, nil)
+	}
+	if event.Telemetry != nil && event.Telemetry.Response != nil {
+		addCommon(response, event.Telemetry.Response.Common)
+	} else {
+		addCommon(response, nil)
+	}
+
+	api := map[string]interface{}{
+		"id": int64(event.APIEvent.APIInfoID),
+	}
+	if event.APIInfo != nil {
+		api["name"] = event.APIInfo.Name
+		api["port"] = event.APIInfo.Port
+		api["type"] = string(event.APIInfo.Type)
+		api["namespace"] = event.APIInfo.DestinationNamespace
+	}
+
+	return map[string]interface{}{
+		"request":  request,
+		"response": response,
+		"api":      api,
+	}
+}
+
+func addCommon(values map[string]interface{}, common *pluginsmodels.Common) {
+	headers := map[string]interface{}{}
+	values["headers"] = headers
+	values["body"] = ""
+	if common == nil {
+		return
+	}
+
+	for _, header := range common.Headers {
+		if header == nil {
+			continue
+		}
+		key := strings.ToLower(header.Key)
+		if value, ok := headers[key]; ok {
+			headers[key] = value.(string) + ", " + header.Value
+		} else {
+			headers[key] = header.Value
+		}
+	}
+	values["body"] = string(common.Body)
+	var decoded interface{}
+	if len(common.Body) > 0 && json.Unmarshal(common.Body, &decoded) == nil {
+		values["json"] = decoded
+	}
+}
+
+func queryParams(query string) map[string]interface{} {
+	params := map[string]interface{}{}
+	values, err := url.ParseQuery(query)
+	if err != nil {
+		return params
+	}
+	for key, value := range values {
+		if len(value) > 0 {
+			params[key] = value[0]
+		}
+	}
+	return params
+}
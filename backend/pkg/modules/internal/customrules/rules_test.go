@@ -0,0 +1,109 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package customrules
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	oapicommon "github.com/openclarity/apiclarity/api3/common"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func testEvent(apiType models.APIType, method models.HTTPMethod, path string, requestHeaders []*pluginsmodels.Header, responseHeaders []*pluginsmodels.Header, responseBody string) *core.Event {
+	return &core.Event{
+		APIEvent: &database.APIEvent{ID: 3, APIInfoID: 2, Method: method, Path: path, Query: "page=2&page=3", StatusCode: 200},
+		APIInfo:  &database.APIInfo{ID: 2, Name: "pets", Port: 8080, Type: apiType},
+		Telemetry: &pluginsmodels.Telemetry{
+			Request: &pluginsmodels.Request{
+				Host:   "pets:8080",
+				Method: string(method),
+				Path:   path,
+				Common: &pluginsmodels.Common{Headers: requestHeaders},
+			},
+			Response: &pluginsmodels.Response{
+				StatusCode: "200",
+				Common:     &pluginsmodels.Common{Headers: responseHeaders, Body: []byte(responseBody)},
+			},
+		},
+	}
+}
+
+func TestCompileRule(t *testing.T) {
+	env, err := newRulesEnv()
+	assert.NilError(t, err)
+
+	for _, expression := range []string{
+		`response.statusCode == 200`,
+		`request.headers.authorization`,
+		`api.type == "INTERNAL" && "server" in response.headers`,
+	} {
+		_, err := compileRule(env, Rule{Name: "rule", Severity: oapicommon.LOW, Expression: expression})
+		assert.NilError(t, err, expression)
+	}
+
+	for _, expression := range []string{
+		`response.statusCode ==`,
+		`1 + 1`,
+		`"server"`,
+		`unknown.path == "/"`,
+	} {
+		_, err := compileRule(env, Rule{Name: "rule", Severity: oapicommon.LOW, Expression: expression})
+		assert.ErrorContains(t, err, "rule", expression)
+	}
+}
+
+func TestRuleMatches(t *testing.T) {
+	env, err := newRulesEnv()
+	assert.NilError(t, err)
+
+	match := func(expression string, event *core.Event) bool {
+		t.Helper()
+		rule, err := compileRule(env, Rule{Name: "rule", Severity: oapicommon.LOW, Expression: expression})
+		assert.NilError(t, err)
+		matched, _ := rule.matches(eventActivation(event))
+		return matched
+	}
+
+	serverHeader := `api.type == "INTERNAL" && "server" in response.headers`
+	assert.Assert(t, match(serverHeader, testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, []*pluginsmodels.Header{{Key: "Server", Value: "nginx"}}, "")))
+	assert.Assert(t, !match(serverHeader, testEvent(models.APITypeEXTERNAL, "GET", "/pets", nil, []*pluginsmodels.Header{{Key: "Server", Value: "nginx"}}, "")))
+	assert.Assert(t, !match(serverHeader, testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, nil, "")))
+
+	bearer := `request.path.startsWith("/admin/") && !("authorization" in request.headers && request.headers.authorization.startsWith("Bearer "))`
+	assert.Assert(t, match(bearer, testEvent(models.APITypeINTERNAL, "GET", "/admin/users", nil, nil, "")))
+	assert.Assert(t, match(bearer, testEvent(models.APITypeINTERNAL, "GET", "/admin/users", []*pluginsmodels.Header{{Key: "Authorization", Value: "Basic YTpi"}}, nil, "")))
+	assert.Assert(t, !match(bearer, testEvent(models.APITypeINTERNAL, "GET", "/admin/users", []*pluginsmodels.Header{{Key: "Authorization", Value: "Bearer token"}}, nil, "")))
+	assert.Assert(t, !match(bearer, testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, nil, "")))
+
+	// the body is decoded when it is JSON
+	event := testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, nil, `{"pets": [{"name": "rex", "owner": {"ssn": "123"}}]}`)
+	assert.Assert(t, match(`has(response.json.pets) && response.json.pets.exists(p, has(p.owner.ssn))`, event))
+	assert.Assert(t, match(`response.body.contains("ssn")`, event))
+	assert.Assert(t, !match(`has(response.json)`, testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, nil, "not json")))
+
+	// a rule which fails to evaluate doesn't match
+	assert.Assert(t, !match(`request.headers.authorization == "secret"`, testEvent(models.APITypeINTERNAL, "GET", "/pets", nil, nil, "")))
+
+	assert.Assert(t, match(`request.method == "POST" && request.query.page == "2" && api.name == "pets" && api.port == 8080`,
+		testEvent(models.APITypeINTERNAL, "POST", "/pets", nil, nil, "")))
+	assert.Assert(t, match(`request.headers["x-forwarded-for"] == "a, b"`,
+		testEvent(models.APITypeINTERNAL, "GET", "/pets", []*pluginsmodels.Header{{Key: "X-Forwarded-For", Value: "a"}, {Key: "x-forwarded-for", Value: "b"}}, nil, "")))
+}
@@ -181,6 +181,14 @@ func (d *demo) Info() core.ModuleInfo {
 	return *d.info
 }
 
+func (d *demo) Start(ctx context.Context) error {
+	return nil
+}
+
+func (d *demo) Stop(ctx context.Context) error {
+	return nil
+}
+
 func (d *demo) EventNotify(ctx context.Context, event *core.Event) {
 	log.Infof("[demo] APIEvent.ID=%d Path=%s Method=%s", event.APIEvent.ID, event.Telemetry.Request.Path, event.Telemetry.Request.Method)
 }
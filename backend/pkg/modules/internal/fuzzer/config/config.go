@@ -177,6 +177,8 @@ func (c *Config) Dump() {
 	logging.Debugf("%v ----------------------", prefix)
 }
 
+// NewFuzzerConfig reads the config from the environment only. Unlike the other modules settings, it is not registered
+// to be changed at runtime: it describes how the fuzzing jobs are deployed, which follows the APIClarity deployment.
 func NewFuzzerConfig() *Config {
 	// Set default configuration
 	viper.SetDefault(FuzzerImageNameEnvVar, ImageName)
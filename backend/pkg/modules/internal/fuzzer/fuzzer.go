@@ -112,6 +112,14 @@ func (p *pluginFuzzer) Info() core.ModuleInfo {
 	return *p.info
 }
 
+func (p *pluginFuzzer) Start(ctx context.Context) error {
+	return nil
+}
+
+func (p *pluginFuzzer) Stop(ctx context.Context) error {
+	return nil
+}
+
 func (p *pluginFuzzer) EventNotify(ctx context.Context, event *core.Event) {
 	// Fuzzer doesn't use this
 	// Logf("[Fuzzer] received a new trace for API(%s) EventID(%v)", event.APIInfoID, event.ID)
@@ -0,0 +1,242 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/go-chi/chi/v5"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api3/notifications"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+)
+
+// accessorHandler serves to a remote module a subset of the BackendAccessor: the API info, the annotations,
+// the notifications and the tracing control. The module name is implied by the path, the requests must carry
+// the token of the module.
+type accessorHandler struct {
+	modName  string
+	token    string
+	accessor core.BackendAccessor
+}
+
+func newAccessorHandler(modName string, token string, accessor core.BackendAccessor, basePath string) http.Handler {
+	h := &accessorHandler{
+		modName:  modName,
+		token:    token,
+		accessor: accessor,
+	}
+
+	r := chi.NewRouter()
+	r.Use(h.authenticate)
+	r.Route(basePath, func(r chi.Router) {
+		r.Get("/apis/{apiID}", h.getAPIInfo)
+		r.Get("/apis/{apiID}/annotations", h.listAPIInfoAnnotations)
+		r.Put("/apis/{apiID}/annotations", h.storeAPIInfoAnnotations)
+		r.Delete("/apis/{apiID}/annotations", h.deleteAPIInfoAnnotations)
+		r.Post("/apis/{apiID}/notifications", h.notify)
+		r.Put("/apis/{apiID}/traces", h.enableTraces)
+		r.Delete("/apis/{apiID}/traces", h.disableTraces)
+		r.Get("/events/{eventID}/annotations", h.listAPIEventAnnotations)
+		r.Post("/events/{eventID}/annotations", h.createAPIEventAnnotations)
+	})
+	return r
+}
+
+func (h *accessorHandler) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get(authorizationHeader), bearerPrefix)
+		if subtle.ConstantTimeCompare([]byte(token), []byte(h.token)) != 1 {
+			httpError(w, http.StatusUnauthorized, errors.New("invalid token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *accessorHandler) getAPIInfo(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	apiInfo, err := h.accessor.GetAPIInfo(r.Context(), apiID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		httpError(w, http.StatusNotFound, fmt.Errorf("api %v not found", apiID))
+		return
+	}
+	if err != nil {
+		h.internalError(w, fmt.Errorf("failed to get api info: %v", err))
+		return
+	}
+	httpResponse(w, http.StatusOK, toAPIInfo(apiInfo))
+}
+
+func (h *accessorHandler) listAPIInfoAnnotations(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	anns, err := h.accessor.ListAPIInfoAnnotations(r.Context(), h.modName, apiID)
+	if err != nil {
+		h.internalError(w, fmt.Errorf("failed to list api annotations: %v", err))
+		return
+	}
+	httpResponse(w, http.StatusOK, toAnnotations(anns))
+}
+
+func (h *accessorHandler) storeAPIInfoAnnotations(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	var anns []Annotation
+	if !decodeBody(w, r, &anns) {
+		return
+	}
+	if err := h.accessor.StoreAPIInfoAnnotations(r.Context(), h.modName, apiID, fromAnnotations(anns)...); err != nil {
+		h.internalError(w, fmt.Errorf("failed to store api annotations: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// deleteAPIInfoAnnotations deletes the annotations given with the name query parameters, or all of them.
+func (h *accessorHandler) deleteAPIInfoAnnotations(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	var err error
+	if names := r.URL.Query()["name"]; len(names) > 0 {
+		err = h.accessor.DeleteAPIInfoAnnotations(r.Context(), h.modName, apiID, names...)
+	} else {
+		err = h.accessor.DeleteAllAPIInfoAnnotations(r.Context(), h.modName, apiID)
+	}
+	if err != nil {
+		h.internalError(w, fmt.Errorf("failed to delete api annotations: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *accessorHandler) notify(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	var notification notifications.APIClarityNotification
+	if !decodeBody(w, r, &notification) {
+		return
+	}
+	if err := h.accessor.Notify(r.Context(), h.modName, apiID, notification); err != nil {
+		h.internalError(w, fmt.Errorf("failed to notify: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *accessorHandler) enableTraces(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	if err := h.accessor.EnableTraces(r.Context(), h.modName, apiID); err != nil {
+		h.internalError(w, fmt.Errorf("failed to enable traces: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *accessorHandler) disableTraces(w http.ResponseWriter, r *http.Request) {
+	apiID, ok := idParam(w, r, "apiID")
+	if !ok {
+		return
+	}
+	if err := h.accessor.DisableTraces(r.Context(), h.modName, apiID); err != nil {
+		h.internalError(w, fmt.Errorf("failed to disable traces: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *accessorHandler) listAPIEventAnnotations(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := idParam(w, r, "eventID")
+	if !ok {
+		return
+	}
+	anns, err := h.accessor.ListAPIEventAnnotations(r.Context(), h.modName, eventID)
+	if err != nil {
+		h.internalError(w, fmt.Errorf("failed to list event annotations: %v", err))
+		return
+	}
+	httpResponse(w, http.StatusOK, toAnnotations(anns))
+}
+
+func (h *accessorHandler) createAPIEventAnnotations(w http.ResponseWriter, r *http.Request) {
+	eventID, ok := idParam(w, r, "eventID")
+	if !ok {
+		return
+	}
+	var anns []Annotation
+	if !decodeBody(w, r, &anns) {
+		return
+	}
+	if err := h.accessor.CreateAPIEventAnnotations(r.Context(), h.modName, eventID, fromAnnotations(anns)...); err != nil {
+		h.internalError(w, fmt.Errorf("failed to create event annotations: %v", err))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *accessorHandler) internalError(w http.ResponseWriter, err error) {
+	log.Errorf("Remote module %s: %v", h.modName, err)
+	httpError(w, http.StatusInternalServerError, errors.New("Oops"))
+}
+
+func idParam(w http.ResponseWriter, r *http.Request, name string) (uint, bool) {
+	id, err := strconv.ParseUint(chi.URLParam(r, name), 10, 32) //nolint:gomnd
+	if err != nil {
+		httpError(w, http.StatusBadRequest, fmt.Errorf("invalid %s: %v", name, err))
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func decodeBody(w http.ResponseWriter, r *http.Request, v interface{}) bool {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		httpError(w, http.StatusBadRequest, fmt.Errorf("invalid body: %v", err))
+		return false
+	}
+	return true
+}
+
+func httpResponse(w http.ResponseWriter, statusCode int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(data)
+}
+
+func httpError(w http.ResponseWriter, statusCode int, err error) {
+	httpResponse(w, statusCode, errorResponse{Message: err.Error()})
+}
@@ -0,0 +1,273 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+)
+
+const (
+	requestTimeout     = 10 * time.Second
+	defaultDescription = "Remote module"
+	// notificationsQueueSize is the number of annotations waiting to be posted to a remote module, the annotations
+	// stored while the queue is full are dropped.
+	notificationsQueueSize = 100
+)
+
+// Factories creates the factories of the remote modules, configured in the format <name>=<url>, with their tokens
+// configured in the format <name>=<token>. Each remote module must have a token.
+func Factories(remoteModules []string, remoteModulesTokens []string) (map[string]core.ModuleFactory, error) {
+	const parts = 2
+	tokens := make(map[string]string, len(remoteModulesTokens))
+	for _, remoteModuleToken := range remoteModulesTokens {
+		nameAndToken := strings.SplitN(remoteModuleToken, "=", parts)
+		if len(nameAndToken) != parts || nameAndToken[0] == "" || nameAndToken[1] == "" {
+			return nil, fmt.Errorf("invalid remote module token, expected <name>=<token>")
+		}
+		tokens[nameAndToken[0]] = nameAndToken[1]
+	}
+
+	factories := make(map[string]core.ModuleFactory, len(remoteModules))
+	for _, remoteModule := range remoteModules {
+		nameAndURL := strings.SplitN(remoteModule, "=", parts)
+		if len(nameAndURL) != parts || nameAndURL[0] == "" || strings.Contains(nameAndURL[0], "/") {
+			return nil, fmt.Errorf("invalid remote module %q, expected <name>=<url>", remoteModule)
+		}
+		name := nameAndURL[0]
+		moduleURL, err := url.Parse(nameAndURL[1])
+		if err != nil || (moduleURL.Scheme != "http" && moduleURL.Scheme != "https") || moduleURL.Host == "" {
+			return nil, fmt.Errorf("invalid url of remote module %q, expected http(s)://<host>[:<port>]", remoteModule)
+		}
+		if _, ok := factories[name]; ok {
+			return nil, fmt.Errorf("remote module %s is configured more than once", name)
+		}
+		token, ok := tokens[name]
+		if !ok {
+			return nil, fmt.Errorf("remote module %s has no token", name)
+		}
+		factories[name] = func(ctx context.Context, accessor core.BackendAccessor) (core.Module, error) {
+			return newModule(name, moduleURL, token, accessor), nil
+		}
+	}
+	for name := range tokens {
+		if _, ok := factories[name]; !ok {
+			return nil, fmt.Errorf("token of remote module %s which is not configured", name)
+		}
+	}
+	return factories, nil
+}
+
+// module forwards the events to a module running in another process, and serves it the accessor and its API.
+type module struct {
+	name string
+	url  *url.URL
+	// token shared with the remote module, sent by both sides
+	token    string
+	client   *http.Client
+	accessor core.BackendAccessor
+	handler  http.Handler
+
+	lock                    sync.RWMutex
+	info                    core.ModuleInfo
+	annotationSubscriptions []core.AnnotationSubscription
+
+	// the annotations are posted one at a time by a worker, until the module is stopped
+	notifications     chan AnnotationNotification
+	notificationsDone chan struct{}
+	notificationsLock sync.RWMutex
+	stopped           bool
+}
+
+func newModule(name string, moduleURL *url.URL, token string, accessor core.BackendAccessor) *module {
+	m := &module{
+		name:     name,
+		url:      moduleURL,
+		token:    token,
+		client:   &http.Client{Timeout: requestTimeout},
+		accessor: accessor,
+		info: core.ModuleInfo{
+			Name:        name,
+			Description: defaultDescription,
+		},
+		notifications:     make(chan AnnotationNotification, notificationsQueueSize),
+		notificationsDone: make(chan struct{}),
+	}
+	go m.notificationsWorker()
+
+	proxy := httputil.NewSingleHostReverseProxy(moduleURL)
+	proxy.ErrorHandler = func(w http.ResponseWriter, r *http.Request, err error) {
+		log.Errorf("Failed to proxy request to remote module %s: %v", name, err)
+		httpError(w, http.StatusBadGateway, fmt.Errorf("remote module %s is unavailable", name))
+	}
+
+	basePath := core.BaseHTTPPath + "/" + name
+	handler := http.NewServeMux()
+	handler.Handle(basePath+"/accessor/"+ProtocolVersion+"/", newAccessorHandler(name, token, accessor, basePath+"/accessor/"+ProtocolVersion))
+	// the API of the module is served by the remote module under the same path
+	handler.Handle(basePath+"/", proxy)
+	m.handler = handler
+
+	return m
+}
+
+func (m *module) Info() core.ModuleInfo {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.info
+}
+
+func (m *module) HTTPHandler() http.Handler {
+	return m.handler
+}
+
+// Start checks that the remote module speaks the protocol version of APIClarity. An unavailable remote
+// module is not an error, the events are sent to it once it is available.
+func (m *module) Start(ctx context.Context) error {
+	info := &Info{}
+	if err := m.do(ctx, http.MethodGet, "/info", nil, info); err != nil {
+		log.Warnf("Remote module %s is unavailable: %v", m.name, err)
+		return nil
+	}
+	if info.ProtocolVersion != ProtocolVersion {
+		return fmt.Errorf("remote module %s protocol version %q is not supported, expected %q", m.name, info.ProtocolVersion, ProtocolVersion)
+	}
+	if info.Name != "" && info.Name != m.name {
+		log.Warnf("Remote module %s is configured with name %s", info.Name, m.name)
+	}
+	m.lock.Lock()
+	if info.Description != "" {
+		m.info.Description = info.Description
+	}
+	m.annotationSubscriptions = fromAnnotationSubscriptions(info.AnnotationSubscriptions)
+	m.lock.Unlock()
+	return nil
+}
+
+// Stop posts the queued annotations, until ctx is done.
+func (m *module) Stop(ctx context.Context) error {
+	m.notificationsLock.Lock()
+	if !m.stopped {
+		m.stopped = true
+		close(m.notifications)
+	}
+	m.notificationsLock.Unlock()
+
+	select {
+	case <-m.notificationsDone:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send %v queued annotations to remote module %s: %w", len(m.notifications), m.name, ctx.Err())
+	}
+}
+
+func (m *module) EventNotify(ctx context.Context, event *core.Event) {
+	if err := m.do(ctx, http.MethodPost, "/events", toEvent(event), nil); err != nil {
+		log.Errorf("Failed to send event to remote module %s: %v", m.name, err)
+	}
+}
+
+func (m *module) AnnotationSubscriptions() []core.AnnotationSubscription {
+	m.lock.RLock()
+	defer m.lock.RUnlock()
+
+	return m.annotationSubscriptions
+}
+
+func (m *module) EventAnnotationNotify(_ context.Context, modName string, eventID uint, ann core.Annotation) error {
+	m.notifyAnnotation(AnnotationNotification{ModuleName: modName, EventID: eventID, Annotation: toAnnotations([]*core.Annotation{&ann})[0]})
+	return nil
+}
+
+func (m *module) APIAnnotationNotify(_ context.Context, modName string, apiID uint, ann core.Annotation) error {
+	m.notifyAnnotation(AnnotationNotification{ModuleName: modName, APIID: apiID, Annotation: toAnnotations([]*core.Annotation{&ann})[0]})
+	return nil
+}
+
+// notifyAnnotation queues the annotation to be posted in the background, not to delay the module which stored it.
+func (m *module) notifyAnnotation(notification AnnotationNotification) {
+	m.notificationsLock.RLock()
+	defer m.notificationsLock.RUnlock()
+
+	if m.stopped {
+		log.Warnf("Annotation %s dropped for remote module %s, the module is stopped", notification.Annotation.Name, m.name)
+		return
+	}
+	select {
+	case m.notifications <- notification:
+	default:
+		log.Warnf("Annotation %s dropped for remote module %s, its queue is full", notification.Annotation.Name, m.name)
+	}
+}
+
+func (m *module) notificationsWorker() {
+	defer close(m.notificationsDone)
+
+	for notification := range m.notifications {
+		ctx, cancel := context.WithTimeout(context.Background(), requestTimeout)
+		if err := m.do(ctx, http.MethodPost, "/annotations", notification, nil); err != nil {
+			log.Errorf("Failed to send annotation %s to remote module %s: %v", notification.Annotation.Name, m.name, err)
+		}
+		cancel()
+	}
+}
+
+// do sends a request to an endpoint of the protocol of the remote module.
+func (m *module) do(ctx context.Context, method string, path string, body interface{}, result interface{}) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		if err := json.NewEncoder(&reqBody).Encode(body); err != nil {
+			return fmt.Errorf("failed to encode request: %v", err)
+		}
+	}
+
+	reqURL := strings.TrimSuffix(m.url.String(), "/") + "/" + ProtocolVersion + path
+	req, err := http.NewRequestWithContext(ctx, method, reqURL, &reqBody)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(authorizationHeader, bearerPrefix+m.token)
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code %v from %s %s", resp.StatusCode, method, reqURL)
+	}
+	if result != nil {
+		if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+			return fmt.Errorf("failed to decode response: %v", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,299 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gorm.io/gorm"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func TestFactories(t *testing.T) {
+	factories, err := Factories([]string{"detector=http://detector.default:8080", "other=https://other:8443/base"}, []string{"detector=secret", "other=other=secret"})
+	assert.NilError(t, err)
+	assert.Equal(t, len(factories), 2)
+
+	m, err := factories["detector"](context.Background(), nil)
+	assert.NilError(t, err)
+	assert.Equal(t, m.Info().Name, "detector")
+
+	for _, invalid := range [][]string{
+		{"detector"},
+		{"=http://detector:8080"},
+		{"a/b=http://detector:8080"},
+		{"detector=detector:8080"},
+		{"detector=ftp://detector"},
+		{"detector=http://detector:8080", "detector=http://other:8080"},
+	} {
+		_, err := Factories(invalid, []string{"detector=secret"})
+		assert.Assert(t, err != nil, invalid)
+	}
+
+	for _, invalidTokens := range [][]string{
+		nil,
+		{"detector"},
+		{"detector="},
+		{"=secret"},
+		{"detector=secret", "other=secret"},
+	} {
+		_, err := Factories([]string{"detector=http://detector:8080"}, invalidTokens)
+		assert.Assert(t, err != nil, invalidTokens)
+	}
+}
+
+const testToken = "secret"
+
+// remoteModule is a fake remote module.
+type remoteModule struct {
+	info          Info
+	events        chan *Event
+	notifications chan *AnnotationNotification
+}
+
+func (f *remoteModule) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/v1/") && r.Header.Get("Authorization") != "Bearer "+testToken:
+		w.WriteHeader(http.StatusUnauthorized)
+	case r.Method == http.MethodGet && r.URL.Path == "/v1/info":
+		_ = json.NewEncoder(w).Encode(f.info)
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/events":
+		event := &Event{}
+		if err := json.NewDecoder(r.Body).Decode(event); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.events <- event
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && r.URL.Path == "/v1/annotations":
+		notification := &AnnotationNotification{}
+		if err := json.NewDecoder(r.Body).Decode(notification); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		f.notifications <- notification
+		w.WriteHeader(http.StatusNoContent)
+	case strings.HasPrefix(r.URL.Path, core.BaseHTTPPath+"/detector/"):
+		_, _ = fmt.Fprintf(w, "%s %s?%s", r.Method, r.URL.Path, r.URL.RawQuery)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func startRemoteModule(t *testing.T, info Info) (*remoteModule, *module) {
+	t.Helper()
+	f := &remoteModule{info: info, events: make(chan *Event, 1), notifications: make(chan *AnnotationNotification, 1)}
+	server := httptest.NewServer(f)
+	t.Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	assert.NilError(t, err)
+	return f, newModule("detector", serverURL, testToken, nil)
+}
+
+func TestModule_Start(t *testing.T) {
+	_, m := startRemoteModule(t, Info{Name: "detector", Description: "Proprietary detector", ProtocolVersion: ProtocolVersion})
+	assert.NilError(t, m.Start(context.Background()))
+	assert.Equal(t, m.Info().Description, "Proprietary detector")
+
+	_, m = startRemoteModule(t, Info{Name: "detector", ProtocolVersion: "v0"})
+	assert.ErrorContains(t, m.Start(context.Background()), "not supported")
+
+	// an unavailable remote module may start later
+	unavailableURL, _ := url.Parse("http://127.0.0.1:1")
+	m = newModule("detector", unavailableURL, testToken, nil)
+	assert.NilError(t, m.Start(context.Background()))
+	assert.Equal(t, m.Info().Description, defaultDescription)
+}
+
+func TestModule_EventNotify(t *testing.T) {
+	f, m := startRemoteModule(t, Info{})
+
+	m.EventNotify(context.Background(), &core.Event{
+		APIEvent:  &database.APIEvent{ID: 3, APIInfoID: 2, Method: "GET", Path: "/pets/1", StatusCode: 200, HostSpecName: "pets"},
+		APIInfo:   &database.APIInfo{ID: 2, Name: "pets", Port: 8080, Type: "INTERNAL"},
+		Telemetry: &pluginsmodels.Telemetry{RequestID: "req"},
+	})
+	event := <-f.events
+	assert.Equal(t, event.APIEvent.ID, uint(3))
+	assert.Equal(t, event.APIEvent.APIInfoID, uint(2))
+	assert.Equal(t, event.APIEvent.Path, "/pets/1")
+	assert.DeepEqual(t, event.APIInfo, APIInfo{ID: 2, Name: "pets", Port: 8080, Type: "INTERNAL"})
+	assert.Equal(t, event.Telemetry.RequestID, "req")
+}
+
+func TestModule_AnnotationNotify(t *testing.T) {
+	f, m := startRemoteModule(t, Info{
+		Name:                    "detector",
+		ProtocolVersion:         ProtocolVersion,
+		AnnotationSubscriptions: []AnnotationSubscription{{ModuleName: "bfla", Names: []string{"bfla_findings"}}},
+	})
+	assert.NilError(t, m.Start(context.Background()))
+	assert.DeepEqual(t, m.AnnotationSubscriptions(), []core.AnnotationSubscription{{ModuleName: "bfla", Names: []string{"bfla_findings"}}})
+
+	assert.NilError(t, m.APIAnnotationNotify(context.Background(), "bfla", 2, core.Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	notification := <-f.notifications
+	assert.DeepEqual(t, *notification, AnnotationNotification{ModuleName: "bfla", APIID: 2, Annotation: Annotation{Name: "bfla_findings", Annotation: []byte("{}")}})
+
+	assert.NilError(t, m.EventAnnotationNotify(context.Background(), "bfla", 3, core.Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	notification = <-f.notifications
+	assert.DeepEqual(t, *notification, AnnotationNotification{ModuleName: "bfla", EventID: 3, Annotation: Annotation{Name: "bfla_findings", Annotation: []byte("{}")}})
+
+	// the queued annotations are sent when the module stops, the later ones are dropped
+	assert.NilError(t, m.APIAnnotationNotify(context.Background(), "bfla", 4, core.Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	stopped := make(chan error)
+	go func() { stopped <- m.Stop(context.Background()) }()
+	notification = <-f.notifications
+	assert.Equal(t, notification.APIID, uint(4))
+	assert.NilError(t, <-stopped)
+	assert.NilError(t, m.APIAnnotationNotify(context.Background(), "bfla", 5, core.Annotation{Name: "bfla_findings", Annotation: []byte("{}")}))
+	assert.Equal(t, len(m.notifications), 0)
+}
+
+func TestModule_notifyAnnotation_queueFull(t *testing.T) {
+	f, m := startRemoteModule(t, Info{})
+
+	// the remote module holds the second annotation, the worker waits for it
+	notify := func(apiID uint) {
+		assert.NilError(t, m.APIAnnotationNotify(context.Background(), "bfla", apiID, core.Annotation{Name: "bfla_findings"}))
+	}
+	notify(1)
+	notify(2)
+	for len(m.notifications) > 0 {
+		time.Sleep(10 * time.Millisecond)
+	}
+	for i := 0; i < notificationsQueueSize; i++ {
+		notify(uint(i + 3))
+	}
+	notify(42)
+	assert.Equal(t, len(m.notifications), notificationsQueueSize)
+
+	received := make(chan []uint)
+	go func() {
+		var apiIDs []uint
+		for notification := range f.notifications {
+			apiIDs = append(apiIDs, notification.APIID)
+		}
+		received <- apiIDs
+	}()
+	assert.NilError(t, m.Stop(context.Background()))
+	close(f.notifications)
+	apiIDs := <-received
+	assert.Equal(t, len(apiIDs), notificationsQueueSize+2)
+	assert.Equal(t, apiIDs[len(apiIDs)-1], uint(notificationsQueueSize+2))
+}
+
+func TestModule_HTTPHandler_proxy(t *testing.T) {
+	_, m := startRemoteModule(t, Info{})
+
+	rec := httptest.NewRecorder()
+	m.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, core.BaseHTTPPath+"/detector/findings?apiID=2", nil))
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, rec.Body.String(), "GET "+core.BaseHTTPPath+"/detector/findings?apiID=2")
+
+	// the remote module is unavailable
+	unavailableURL, _ := url.Parse("http://127.0.0.1:1")
+	m = newModule("detector", unavailableURL, testToken, nil)
+	rec = httptest.NewRecorder()
+	m.HTTPHandler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, core.BaseHTTPPath+"/detector/findings", nil))
+	assert.Equal(t, rec.Code, http.StatusBadGateway)
+}
+
+func TestModule_HTTPHandler_accessor(t *testing.T) {
+	mockCtrl := gomock.NewController(t)
+	defer mockCtrl.Finish()
+	accessor := core.NewMockBackendAccessor(mockCtrl)
+	moduleURL, _ := url.Parse("http://127.0.0.1:1")
+	handler := newModule("detector", moduleURL, testToken, accessor).HTTPHandler()
+	basePath := core.BaseHTTPPath + "/detector/accessor/v1"
+
+	serveWithToken := func(token string, method string, path string, body string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		req := httptest.NewRequest(method, basePath+path, strings.NewReader(body))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+		handler.ServeHTTP(rec, req)
+		return rec
+	}
+	serve := func(method string, path string, body string) *httptest.ResponseRecorder {
+		return serveWithToken(testToken, method, path, body)
+	}
+	decode := func(r io.Reader, v interface{}) {
+		assert.NilError(t, json.NewDecoder(r).Decode(v))
+	}
+
+	// the requests without the token of the remote module are rejected
+	assert.Equal(t, serveWithToken("", http.MethodGet, "/apis/2", "").Code, http.StatusUnauthorized)
+	assert.Equal(t, serveWithToken("other", http.MethodGet, "/apis/2", "").Code, http.StatusUnauthorized)
+
+	accessor.EXPECT().GetAPIInfo(gomock.Any(), uint(2)).Return(&database.APIInfo{ID: 2, Name: "pets", Port: 8080}, nil)
+	rec := serve(http.MethodGet, "/apis/2", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	apiInfo := APIInfo{}
+	decode(rec.Body, &apiInfo)
+	assert.DeepEqual(t, apiInfo, APIInfo{ID: 2, Name: "pets", Port: 8080})
+
+	accessor.EXPECT().GetAPIInfo(gomock.Any(), uint(5)).Return(nil, fmt.Errorf("failed: %w", gorm.ErrRecordNotFound))
+	assert.Equal(t, serve(http.MethodGet, "/apis/5", "").Code, http.StatusNotFound)
+	assert.Equal(t, serve(http.MethodGet, "/apis/pets", "").Code, http.StatusBadRequest)
+
+	// the annotations are the ones of the remote module
+	accessor.EXPECT().ListAPIInfoAnnotations(gomock.Any(), "detector", uint(2)).Return([]*core.Annotation{{Name: "finding", Annotation: []byte("{}")}}, nil)
+	rec = serve(http.MethodGet, "/apis/2/annotations", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	var anns []Annotation
+	decode(rec.Body, &anns)
+	assert.DeepEqual(t, anns, []Annotation{{Name: "finding", Annotation: []byte("{}")}})
+
+	accessor.EXPECT().StoreAPIInfoAnnotations(gomock.Any(), "detector", uint(2), core.Annotation{Name: "finding", Annotation: []byte("{}")}).Return(nil)
+	assert.Equal(t, serve(http.MethodPut, "/apis/2/annotations", `[{"name":"finding","annotation":"e30="}]`).Code, http.StatusNoContent)
+	assert.Equal(t, serve(http.MethodPut, "/apis/2/annotations", `{`).Code, http.StatusBadRequest)
+
+	accessor.EXPECT().DeleteAPIInfoAnnotations(gomock.Any(), "detector", uint(2), "a", "b").Return(nil)
+	assert.Equal(t, serve(http.MethodDelete, "/apis/2/annotations?name=a&name=b", "").Code, http.StatusNoContent)
+	accessor.EXPECT().DeleteAllAPIInfoAnnotations(gomock.Any(), "detector", uint(2)).Return(fmt.Errorf("db failure"))
+	assert.Equal(t, serve(http.MethodDelete, "/apis/2/annotations", "").Code, http.StatusInternalServerError)
+
+	accessor.EXPECT().Notify(gomock.Any(), "detector", uint(2), gomock.Any()).Return(nil)
+	assert.Equal(t, serve(http.MethodPost, "/apis/2/notifications", `{"notificationType":"ApiFindingsNotification"}`).Code, http.StatusNoContent)
+
+	accessor.EXPECT().EnableTraces(gomock.Any(), "detector", uint(2)).Return(nil)
+	assert.Equal(t, serve(http.MethodPut, "/apis/2/traces", "").Code, http.StatusNoContent)
+	accessor.EXPECT().DisableTraces(gomock.Any(), "detector", uint(2)).Return(nil)
+	assert.Equal(t, serve(http.MethodDelete, "/apis/2/traces", "").Code, http.StatusNoContent)
+
+	accessor.EXPECT().ListAPIEventAnnotations(gomock.Any(), "detector", uint(3)).Return(nil, nil)
+	rec = serve(http.MethodGet, "/events/3/annotations", "")
+	assert.Equal(t, rec.Code, http.StatusOK)
+	assert.Equal(t, strings.TrimSpace(rec.Body.String()), "[]")
+	accessor.EXPECT().CreateAPIEventAnnotations(gomock.Any(), "detector", uint(3), core.Annotation{Name: "alert", Annotation: []byte("{}")}).Return(nil)
+	assert.Equal(t, serve(http.MethodPost, "/events/3/annotations", `[{"name":"alert","annotation":"e30="}]`).Code, http.StatusNoContent)
+}
@@ -0,0 +1,178 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package remote
+
+import (
+	"time"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// ProtocolVersion is the version of the protocol between APIClarity and the remote modules. It prefixes the
+// paths of the endpoints served by the remote modules and of the accessor served by APIClarity.
+const ProtocolVersion = "v1"
+
+// The token shared by APIClarity and a remote module is sent by both sides in the Authorization header.
+const (
+	authorizationHeader = "Authorization"
+	bearerPrefix        = "Bearer "
+)
+
+// Info is served by a remote module under /v1/info.
+type Info struct {
+	Name            string `json:"name"`
+	Description     string `json:"description,omitempty"`
+	ProtocolVersion string `json:"protocolVersion"`
+	// AnnotationSubscriptions are the annotations of the other modules posted to the remote module under /v1/annotations.
+	AnnotationSubscriptions []AnnotationSubscription `json:"annotationSubscriptions,omitempty"`
+}
+
+// AnnotationSubscription selects annotations by the module storing them and by name, an empty field matches any.
+type AnnotationSubscription struct {
+	ModuleName string   `json:"moduleName,omitempty"`
+	Names      []string `json:"names,omitempty"`
+}
+
+// AnnotationNotification is posted to a remote module under /v1/annotations for each annotation it subscribed to,
+// either EventID or APIID is set.
+type AnnotationNotification struct {
+	ModuleName string     `json:"moduleName"`
+	EventID    uint       `json:"eventId,omitempty"`
+	APIID      uint       `json:"apiId,omitempty"`
+	Annotation Annotation `json:"annotation"`
+}
+
+// Event is posted to a remote module under /v1/events for each API event it should handle.
+type Event struct {
+	APIEvent  APIEvent                 `json:"apiEvent"`
+	APIInfo   APIInfo                  `json:"apiInfo"`
+	Telemetry *pluginsmodels.Telemetry `json:"telemetry,omitempty"`
+}
+
+type APIEvent struct {
+	ID                  uint      `json:"id"`
+	APIInfoID           uint      `json:"apiInfoId"`
+	Time                time.Time `json:"time"`
+	Method              string    `json:"method"`
+	Path                string    `json:"path"`
+	ProvidedPathID      string    `json:"providedPathId,omitempty"`
+	ReconstructedPathID string    `json:"reconstructedPathId,omitempty"`
+	Query               string    `json:"query,omitempty"`
+	StatusCode          int64     `json:"statusCode"`
+	SourceIP            string    `json:"sourceIP"`
+	DestinationIP       string    `json:"destinationIP"`
+	DestinationPort     int64     `json:"destinationPort"`
+	HostSpecName        string    `json:"hostSpecName"`
+}
+
+type APIInfo struct {
+	ID                   uint   `json:"id"`
+	Name                 string `json:"name"`
+	Port                 int64  `json:"port"`
+	Type                 string `json:"type"`
+	DestinationNamespace string `json:"destinationNamespace,omitempty"`
+	TraceSourceID        uint   `json:"traceSourceId"`
+	HasProvidedSpec      bool   `json:"hasProvidedSpec"`
+	HasReconstructedSpec bool   `json:"hasReconstructedSpec"`
+}
+
+// Annotation is an opaque annotation of a remote module, its value is base64 encoded in JSON.
+type Annotation struct {
+	Name       string     `json:"name"`
+	Annotation []byte     `json:"annotation"`
+	ExpiresAt  *time.Time `json:"expiresAt,omitempty"`
+}
+
+type errorResponse struct {
+	Message string `json:"message"`
+}
+
+func toEvent(e *core.Event) *Event {
+	event := &Event{Telemetry: e.Telemetry}
+	if e.APIEvent != nil {
+		event.APIEvent = toAPIEvent(e.APIEvent)
+	}
+	if e.APIInfo != nil {
+		event.APIInfo = toAPIInfo(e.APIInfo)
+	}
+	return event
+}
+
+func toAPIEvent(e *database.APIEvent) APIEvent {
+	return APIEvent{
+		ID:                  e.ID,
+		APIInfoID:           e.APIInfoID,
+		Time:                time.Time(e.Time),
+		Method:              string(e.Method),
+		Path:                e.Path,
+		ProvidedPathID:      e.ProvidedPathID,
+		ReconstructedPathID: e.ReconstructedPathID,
+		Query:               e.Query,
+		StatusCode:          e.StatusCode,
+		SourceIP:            e.SourceIP,
+		DestinationIP:       e.DestinationIP,
+		DestinationPort:     e.DestinationPort,
+		HostSpecName:        e.HostSpecName,
+	}
+}
+
+func toAPIInfo(i *database.APIInfo) APIInfo {
+	return APIInfo{
+		ID:                   i.ID,
+		Name:                 i.Name,
+		Port:                 i.Port,
+		Type:                 string(i.Type),
+		DestinationNamespace: i.DestinationNamespace,
+		TraceSourceID:        i.TraceSourceID,
+		HasProvidedSpec:      i.HasProvidedSpec,
+		HasReconstructedSpec: i.HasReconstructedSpec,
+	}
+}
+
+func fromAnnotationSubscriptions(subscriptions []AnnotationSubscription) []core.AnnotationSubscription {
+	ret := make([]core.AnnotationSubscription, 0, len(subscriptions))
+	for _, s := range subscriptions {
+		ret = append(ret, core.AnnotationSubscription{ModuleName: s.ModuleName, Names: s.Names})
+	}
+	return ret
+}
+
+func toAnnotations(anns []*core.Annotation) []Annotation {
+	ret := make([]Annotation, 0, len(anns))
+	for _, a := range anns {
+		ann := Annotation{Name: a.Name, Annotation: a.Annotation}
+		if !a.ExpiresAt.IsZero() {
+			expiresAt := a.ExpiresAt
+			ann.ExpiresAt = &expiresAt
+		}
+		ret = append(ret, ann)
+	}
+	return ret
+}
+
+func fromAnnotations(anns []Annotation) []core.Annotation {
+	ret := make([]core.Annotation, 0, len(anns))
+	for _, a := range anns {
+		ann := core.Annotation{Name: a.Name, Annotation: a.Annotation}
+		if a.ExpiresAt != nil {
+			ann.ExpiresAt = *a.ExpiresAt
+		}
+		ret = append(ret, ann)
+	}
+	return ret
+}
@@ -26,9 +26,34 @@ const (
 	diffsSendThreshold          = "DIFF_SEND_THRESHOLD"
 )
 
+// Schema is the JSON schema of Values, the config which can be changed at runtime under /api/modules/spec_differ/config.
+const Schema = `{
+	"type": "object",
+	"properties": {
+		"sendNotificationIntervalSec": {
+			"description": "The interval in seconds between two notifications of the aggregated diffs",
+			"type": "integer",
+			"minimum": 1
+		},
+		"diffsSendThreshold": {
+			"description": "The number of aggregated diffs from which the diffs are sent without waiting for the interval",
+			"type": "integer",
+			"minimum": 0
+		}
+	},
+	"required": ["sendNotificationIntervalSec", "diffsSendThreshold"],
+	"additionalProperties": false
+}`
+
+type Values struct {
+	SendNotificationIntervalSec int `json:"sendNotificationIntervalSec"`
+	DiffsSendThreshold          int `json:"diffsSendThreshold"`
+}
+
 type Config struct {
 	sendNotificationIntervalSec int
 	diffsSendThreshold          int
+	sync.RWMutex
 }
 
 // the Mutex to restrict access to configSingleton.
@@ -49,13 +74,33 @@ func GetConfig() *Config {
 }
 
 func (c *Config) SendNotificationIntervalSec() int {
+	c.RLock()
+	defer c.RUnlock()
 	return c.sendNotificationIntervalSec
 }
 
 func (c *Config) DiffsSendThreshold() int {
+	c.RLock()
+	defer c.RUnlock()
 	return c.diffsSendThreshold
 }
 
+func (c *Config) Values() Values {
+	c.RLock()
+	defer c.RUnlock()
+	return Values{
+		SendNotificationIntervalSec: c.sendNotificationIntervalSec,
+		DiffsSendThreshold:          c.diffsSendThreshold,
+	}
+}
+
+func (c *Config) SetValues(values Values) {
+	c.Lock()
+	defer c.Unlock()
+	c.sendNotificationIntervalSec = values.SendNotificationIntervalSec
+	c.diffsSendThreshold = values.DiffsSendThreshold
+}
+
 func NewDifferConfig() *Config {
 	viper.SetDefault(sendNotificationIntervalSec, "300")
 	viper.SetDefault(diffsSendThreshold, "500")
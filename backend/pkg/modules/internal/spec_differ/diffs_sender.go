@@ -43,14 +43,27 @@ func (s *specDiffer) StartDiffsSender(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if err := s.sendDiffsNotifications(); err != nil {
-				log.Errorf("Failed to send diffs notification. total diffs=%v.: %v", s.totalUniqueDiffs, err)
+			if err := s.flushDiffs(ctx); err != nil {
+				log.Error(err)
 			}
-			s.clearDiffs()
+		case <-s.intervalChanged:
+			interval = s.config.SendNotificationIntervalSec()
+			log.Infof("Diffs notifications interval changed to %vs", interval)
+			ticker.Reset(time.Duration(interval) * time.Second)
 		}
 	}
 }
 
+// flushDiffs sends the aggregated diffs and clears them.
+func (s *specDiffer) flushDiffs(ctx context.Context) error {
+	defer s.clearDiffs()
+
+	if err := s.sendDiffsNotifications(ctx); err != nil {
+		return fmt.Errorf("failed to send diffs notification. total diffs=%v.: %v", s.getTotalUniqueDiffs(), err)
+	}
+	return nil
+}
+
 func (s *specDiffer) clearDiffs() {
 	s.Lock()
 	defer s.Unlock()
@@ -58,7 +71,7 @@ func (s *specDiffer) clearDiffs() {
 	s.totalUniqueDiffs = 0
 }
 
-func (s *specDiffer) sendDiffsNotifications() error {
+func (s *specDiffer) sendDiffsNotifications(ctx context.Context) error {
 	if s.getTotalUniqueDiffs() == 0 {
 		log.Infof("No events to send")
 		return nil
@@ -74,7 +87,7 @@ func (s *specDiffer) sendDiffsNotifications() error {
 			return fmt.Errorf("failed to convert to apiclarity notification: %v", err)
 		}
 		apiID := *notification.Diffs.ApiInfo.Id
-		if err := s.accessor.Notify(context.TODO(), moduleName, uint(apiID), n); err != nil {
+		if err := s.accessor.Notify(ctx, moduleName, uint(apiID), n); err != nil {
 			return fmt.Errorf("failed to notify: %v", err)
 		}
 	}
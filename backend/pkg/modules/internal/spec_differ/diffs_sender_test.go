@@ -17,6 +17,7 @@
 package spec_differ
 
 import (
+	"context"
 	"crypto/sha256"
 	"reflect"
 	"sort"
@@ -223,6 +224,44 @@ func Test_pluginDiffer_getSpecDiffsNotifications(t *testing.T) {
 	}
 }
 
+func Test_specDiffer_Stop(t *testing.T) {
+	mockCtrlAccessor := gomock.NewController(t)
+	defer mockCtrlAccessor.Finish()
+	mockAccessor := core.NewMockBackendAccessor(mockCtrlAccessor)
+
+	p := &specDiffer{
+		apiIDToDiffs: map[uint]map[diffHash]global.Diff{
+			1: {
+				sha256.Sum256([]byte("new" + "old")): global.Diff{
+					DiffType: common.GENERALDIFF,
+					Method:   common.GET,
+					NewSpec:  "new",
+					OldSpec:  "old",
+					Path:     "/some/path",
+					SpecType: common.PROVIDED,
+				},
+			},
+		},
+		totalUniqueDiffs: 1,
+		accessor:         mockAccessor,
+	}
+	mockAccessor.EXPECT().GetAPIInfo(gomock.Any(), uint(1)).Return(&database.APIInfo{
+		ID:   1,
+		Name: "foo",
+		Port: 8080,
+		Type: models.APITypeINTERNAL,
+	}, nil)
+	// the pending diffs are notified on stop
+	mockAccessor.EXPECT().Notify(gomock.Any(), moduleName, uint(1), gomock.Any()).Return(nil)
+
+	if err := p.Stop(context.Background()); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if p.getTotalUniqueDiffs() != 0 || len(p.apiIDToDiffs) != 0 {
+		t.Errorf("Stop() did not clear the diffs")
+	}
+}
+
 func stringPtr(val string) *string {
 	ret := val
 
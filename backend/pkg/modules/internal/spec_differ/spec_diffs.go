@@ -17,10 +17,13 @@
 package spec_differ
 
 import (
+	"bytes"
 	"context"
 	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"sort"
 	"strconv"
 	"sync"
 	"time"
@@ -47,6 +50,10 @@ type diffHash [32]byte
 const (
 	moduleName = "spec_differ"
 	moduleInfo = "Calculate API events spec diffs based on provided and reconstructed specs, and send diffs as notifications"
+
+	// unsupportedContentDiffAnnotation marks the events whose diffs are only due to bodies of media types
+	// which are not compared against the specs (XML, plain text...).
+	unsupportedContentDiffAnnotation = "unsupported_content_diff"
 )
 
 type specDiffer struct {
@@ -55,6 +62,8 @@ type specDiffer struct {
 	apiIDToDiffs     map[uint]map[diffHash]global.Diff
 	totalUniqueDiffs int
 	config           *config.Config
+	// signals the diffs sender that the notifications interval changed
+	intervalChanged chan struct{}
 
 	accessor core.BackendAccessor
 	info     core.ModuleInfo
@@ -72,6 +81,7 @@ func newSpecDiffer(ctx context.Context, accessor core.BackendAccessor) (core.Mod
 	d := &specDiffer{
 		accessor:         accessor,
 		config:           config.GetConfig(),
+		intervalChanged:  make(chan struct{}, 1),
 		apiIDToDiffs:     make(map[uint]map[diffHash]global.Diff),
 		totalUniqueDiffs: 0,
 		info: core.ModuleInfo{
@@ -82,11 +92,36 @@ func newSpecDiffer(ctx context.Context, accessor core.BackendAccessor) (core.Mod
 	h := restapi.HandlerWithOptions(&httpHandler{differ: d}, restapi.ChiServerOptions{BaseURL: core.BaseHTTPPath + "/" + moduleName})
 	d.httpHandler = h
 
-	go d.StartDiffsSender(ctx)
+	// The values from the environment are only the default, the config stored in the database takes precedence.
+	conf, err := accessor.RegisterConfig(moduleName, []byte(config.Schema), d.config.Values(), d.setConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register config: %v", err)
+	}
+	if err := d.setConfig(ctx, conf); err != nil {
+		return nil, err
+	}
 
 	return d, nil
 }
 
+func (s *specDiffer) setConfig(_ context.Context, conf []byte) error {
+	var values config.Values
+	if err := json.Unmarshal(conf, &values); err != nil {
+		return fmt.Errorf("failed to decode config: %v", err)
+	}
+
+	previousInterval := s.config.SendNotificationIntervalSec()
+	s.config.SetValues(values)
+	if values.SendNotificationIntervalSec != previousInterval {
+		select {
+		case s.intervalChanged <- struct{}{}:
+		default:
+		}
+	}
+
+	return nil
+}
+
 func (s *specDiffer) Name() string {
 	return moduleName
 }
@@ -95,9 +130,20 @@ func (s *specDiffer) Info() core.ModuleInfo {
 	return s.info
 }
 
+func (s *specDiffer) Start(ctx context.Context) error {
+	go s.StartDiffsSender(ctx)
+	return nil
+}
+
+// Stop sends the diffs aggregated since the last notifications.
+func (s *specDiffer) Stop(ctx context.Context) error {
+	return s.flushDiffs(ctx)
+}
+
 func (s *specDiffer) EventNotify(ctx context.Context, event *core.Event) {
 	var reconstructedDiff, providedDiff *_spec.APIDiff
 	var reconstructedSpecVersion, providedSpecVersion _spec.OASVersion
+	var unsupportedContent unsupportedContentDiffAnnotationValue
 	var err error
 
 	log.Infof("Got new event notification. event=%+v", event)
@@ -122,6 +168,10 @@ func (s *specDiffer) EventNotify(ctx context.Context, event *core.Event) {
 			log.Errorf("Failed to diff telemetry against provided spec: %v", err)
 			return
 		}
+		if unsupportedContent.Provided, err = unsupportedContentDiff(providedDiff); err != nil {
+			log.Errorf("Failed to check provided spec diff content: %v", err)
+			return
+		}
 		providedSpecVersion = s.accessor.GetSpeculatorAccessor().GetProvidedSpecVersion(event.APIInfo.TraceSourceID, specKey)
 		if err := setAPIEventProvidedDiff(apiEvent, providedDiff, providedSpecVersion); err != nil {
 			log.Errorf("Failed to set api event provided diff: %v", err)
@@ -136,6 +186,10 @@ func (s *specDiffer) EventNotify(ctx context.Context, event *core.Event) {
 			log.Errorf("Failed to diff telemetry against approved spec: %v", err)
 			return
 		}
+		if unsupportedContent.Reconstructed, err = unsupportedContentDiff(reconstructedDiff); err != nil {
+			log.Errorf("Failed to check approved spec diff content: %v", err)
+			return
+		}
 		reconstructedSpecVersion = s.accessor.GetSpeculatorAccessor().GetApprovedSpecVersion(event.APIInfo.TraceSourceID, specKey)
 		if err := setAPIEventReconstructedDiff(apiEvent, reconstructedDiff, reconstructedSpecVersion); err != nil {
 			log.Errorf("Failed to set api event reconstructed diff: %v", err)
@@ -151,6 +205,9 @@ func (s *specDiffer) EventNotify(ctx context.Context, event *core.Event) {
 		log.Errorf("Failed to update api event: %v", err)
 		return
 	}
+	if err := s.annotateUnsupportedContentDiff(ctx, apiEvent.ID, unsupportedContent); err != nil {
+		log.Errorf("Failed to annotate unsupported content diff: %v", err)
+	}
 
 	if apiEvent.HasProvidedSpecDiff {
 		s.addDiffToSend(providedDiff, providedDiff.ModifiedPathItem, providedDiff.OriginalPathItem, providedDiffType, common.PROVIDED, apiEvent, providedSpecVersion)
@@ -330,6 +387,139 @@ func getHighestPrioritySpecDiffType(providedDiffType, reconstructedDiffType mode
 	return reconstructedDiffType
 }
 
+// unsupportedContentDiff returns the media types of the spec content which the diff consists of, when the operations
+// differ only by the content of media types the speculator doesn't infer schemas for (XML, plain text...).
+// Such content is never part of the telemetry operation, so the diff can't tell whether the body follows the spec.
+func unsupportedContentDiff(diff *_spec.APIDiff) ([]string, error) {
+	if diff == nil || diff.Type != _spec.DiffTypeGeneralDiff || diff.OriginalPathItem == nil || diff.ModifiedPathItem == nil {
+		return nil, nil
+	}
+
+	original, err := json.Marshal(removeUnlearnableContent(diff.OriginalPathItem))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal original path item: %v", err)
+	}
+	modified, err := json.Marshal(removeUnlearnableContent(diff.ModifiedPathItem))
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal modified path item: %v", err)
+	}
+	if !bytes.Equal(original, modified) {
+		return nil, nil
+	}
+
+	return unlearnableMediaTypes(diff.OriginalPathItem), nil
+}
+
+// unlearnableMediaTypes returns the sorted media types of the request and response content of the path item
+// which the speculator doesn't infer schemas for.
+func unlearnableMediaTypes(pathItem *v3spec.PathItem) []string {
+	mediaTypes := map[string]bool{}
+	addContent := func(content v3spec.Content) {
+		for mediaType := range content {
+			if !speculatorutils.IsLearnableMediaType(mediaType) {
+				mediaTypes[mediaType] = true
+			}
+		}
+	}
+	for _, operation := range pathItem.Operations() {
+		if operation == nil {
+			continue
+		}
+		if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+			addContent(operation.RequestBody.Value.Content)
+		}
+		for _, responseRef := range operation.Responses {
+			if responseRef != nil && responseRef.Value != nil {
+				addContent(responseRef.Value.Content)
+			}
+		}
+	}
+
+	ret := make([]string, 0, len(mediaTypes))
+	for mediaType := range mediaTypes {
+		ret = append(ret, mediaType)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// unsupportedContentDiffAnnotationValue is the value of the unsupported content diff annotation of an API event, the media
+// types not compared by its provided and reconstructed diffs.
+type unsupportedContentDiffAnnotationValue struct {
+	Provided      []string `json:"provided,omitempty"`
+	Reconstructed []string `json:"reconstructed,omitempty"`
+}
+
+// annotateUnsupportedContentDiff marks the diffs of an event which come down to content not compared against the spec.
+func (s *specDiffer) annotateUnsupportedContentDiff(ctx context.Context, eventID uint, value unsupportedContentDiffAnnotationValue) error {
+	if len(value.Provided) == 0 && len(value.Reconstructed) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Errorf("failed to marshal annotation: %v", err)
+	}
+	if err := s.accessor.CreateAPIEventAnnotations(ctx, moduleName, eventID, core.Annotation{Name: unsupportedContentDiffAnnotation, Annotation: b}); err != nil {
+		return fmt.Errorf("failed to create annotation: %v", err)
+	}
+	return nil
+}
+
+// removeUnlearnableContent returns a copy of the path item without the request and response content of unlearnable media types.
+// A request body left without content is removed.
+func removeUnlearnableContent(pathItem *v3spec.PathItem) *v3spec.PathItem {
+	ret := *pathItem
+	for method, operation := range pathItem.Operations() {
+		if operation == nil {
+			continue
+		}
+		op := *operation
+
+		if op.RequestBody != nil && op.RequestBody.Value != nil && len(op.RequestBody.Value.Content) > 0 {
+			content := learnableContent(op.RequestBody.Value.Content)
+			if len(content) == 0 {
+				op.RequestBody = nil
+			} else {
+				requestBody := *op.RequestBody.Value
+				requestBody.Content = content
+				op.RequestBody = &v3spec.RequestBodyRef{Value: &requestBody}
+			}
+		}
+
+		if op.Responses != nil {
+			responses := make(v3spec.Responses, len(op.Responses))
+			for code, responseRef := range op.Responses {
+				if responseRef == nil || responseRef.Value == nil {
+					responses[code] = responseRef
+					continue
+				}
+				response := *responseRef.Value
+				response.Content = learnableContent(response.Content)
+				responses[code] = &v3spec.ResponseRef{Value: &response}
+			}
+			op.Responses = responses
+		}
+
+		ret.SetOperation(method, &op)
+	}
+
+	return &ret
+}
+
+func learnableContent(content v3spec.Content) v3spec.Content {
+	var ret v3spec.Content
+	for mediaType, value := range content {
+		if !speculatorutils.IsLearnableMediaType(mediaType) {
+			continue
+		}
+		if ret == nil {
+			ret = v3spec.Content{}
+		}
+		ret[mediaType] = value
+	}
+	return ret
+}
+
 type eventDiff struct {
 	Path     string
 	PathItem interface{}
@@ -623,3 +623,92 @@ func Test_getPathItemForVersionOrOriginal(t *testing.T) {
 		})
 	}
 }
+
+func Test_unsupportedContentDiff(t *testing.T) {
+	xmlSchema := &v3spec.SchemaRef{Value: v3spec.NewObjectSchema().WithProperty("id", v3spec.NewInt64Schema())}
+	jsonSchema := &v3spec.SchemaRef{Value: v3spec.NewObjectSchema().WithProperty("name", v3spec.NewStringSchema())}
+	xmlOperation := func() *v3spec.Operation {
+		return &v3spec.Operation{
+			RequestBody: &v3spec.RequestBodyRef{
+				Value: v3spec.NewRequestBody().WithContent(v3spec.NewContentWithSchemaRef(xmlSchema, []string{"application/xml"})),
+			},
+			Responses: v3spec.Responses{
+				"200": &v3spec.ResponseRef{
+					Value: v3spec.NewResponse().WithDescription("response").
+						WithContent(v3spec.NewContentWithSchemaRef(xmlSchema, []string{"application/xml", "text/xml"})),
+				},
+			},
+		}
+	}
+	telemetryOperation := func() *v3spec.Operation {
+		return &v3spec.Operation{
+			Responses: v3spec.Responses{
+				"200": &v3spec.ResponseRef{
+					Value: v3spec.NewResponse().WithDescription("response"),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		diff           *_spec.APIDiff
+		wantMediaTypes []string
+	}{
+		{
+			name: "only unlearnable content differs",
+			diff: &_spec.APIDiff{
+				Type:             _spec.DiffTypeGeneralDiff,
+				OriginalPathItem: &v3spec.PathItem{Post: xmlOperation()},
+				ModifiedPathItem: &v3spec.PathItem{Post: telemetryOperation()},
+			},
+			wantMediaTypes: []string{"application/xml", "text/xml"},
+		},
+		{
+			name: "learnable content differs",
+			diff: &_spec.APIDiff{
+				Type:             _spec.DiffTypeGeneralDiff,
+				OriginalPathItem: &v3spec.PathItem{Post: xmlOperation()},
+				ModifiedPathItem: &v3spec.PathItem{Post: &v3spec.Operation{
+					RequestBody: &v3spec.RequestBodyRef{
+						Value: v3spec.NewRequestBody().WithJSONSchemaRef(jsonSchema),
+					},
+					Responses: telemetryOperation().Responses,
+				}},
+			},
+		},
+		{
+			name: "parameters differ",
+			diff: &_spec.APIDiff{
+				Type:             _spec.DiffTypeGeneralDiff,
+				OriginalPathItem: &v3spec.PathItem{Post: xmlOperation()},
+				ModifiedPathItem: &v3spec.PathItem{Post: &v3spec.Operation{
+					Parameters: v3spec.Parameters{
+						&v3spec.ParameterRef{Value: v3spec.NewQueryParameter("id").WithSchema(v3spec.NewStringSchema())},
+					},
+					Responses: telemetryOperation().Responses,
+				}},
+			},
+		},
+		{
+			name: "shadow diff",
+			diff: &_spec.APIDiff{
+				Type:             _spec.DiffTypeShadowDiff,
+				ModifiedPathItem: &v3spec.PathItem{Post: telemetryOperation()},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			diffType := tt.diff.Type
+			mediaTypes, err := unsupportedContentDiff(tt.diff)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, mediaTypes, tt.wantMediaTypes, cmpopts.EquateEmpty())
+			// the diff is always kept
+			assert.Equal(t, tt.diff.Type, diffType)
+			if tt.diff.OriginalPathItem != nil {
+				assert.Assert(t, tt.diff.OriginalPathItem.Post.RequestBody.Value.Content.Get("application/xml") != nil)
+			}
+		})
+	}
+}
@@ -63,6 +63,14 @@ func (d *specReconstructorPlugin) Info() core.ModuleInfo {
 	return *d.info
 }
 
+func (d *specReconstructorPlugin) Start(ctx context.Context) error {
+	return nil
+}
+
+func (d *specReconstructorPlugin) Stop(ctx context.Context) error {
+	return nil
+}
+
 func (d *specReconstructorPlugin) EventNotify(ctx context.Context, event *core.Event) {
 	// This music doesn't use this
 }
@@ -96,7 +104,7 @@ func (c *specReconstructorPluginHTTPHandler) PostAPIIDStart(w http.ResponseWrite
 
 	component := ModuleName
 
-	if err := c.plugin.accessor.GetTraceSamplingAccessor().AddHostToTrace(component, uint32(apiID)); err != nil {
+	if err := c.plugin.accessor.GetTraceSamplingAccessor().AddHostToTrace(component, uint32(apiID), nil); err != nil {
 		log.Errorf("Failed to add API %v in APIs to trace: %v", apiID, err)
 		httpError(w, http.StatusInternalServerError, err)
 		return
@@ -17,6 +17,8 @@ package traceanalyzer
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/traceanalyzer/utils"
@@ -36,6 +38,7 @@ type APIsFindingsRepo struct {
 	accessor core.BackendAccessor
 
 	apis map[uint64]apiFindings
+	lock sync.Mutex
 }
 
 func NewAPIsFindingsRepo(accessor core.BackendAccessor) *APIsFindingsRepo {
@@ -52,8 +55,13 @@ func (r *APIsFindingsRepo) Aggregate(apiID uint64, path, method string, anns ...
 	if path == "" {
 		method = ""
 	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
 	for _, ann := range anns {
-		ann, updated := r.aggregate(apiID, path, method, ann)
+		ann, updated := r.aggregate(apiID, path, method, ann, now)
 		if updated {
 			updatedFindings = append(updatedFindings, ann)
 		}
@@ -62,6 +70,9 @@ func (r *APIsFindingsRepo) Aggregate(apiID uint64, path, method string, anns ...
 }
 
 func (r *APIsFindingsRepo) GetAPIFindings(apiID uint64) (apiFindings []utils.TraceAnalyzerAPIAnnotation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	findings, found := r.apis[apiID]
 	if !found {
 		return
@@ -74,15 +85,53 @@ func (r *APIsFindingsRepo) GetAPIFindings(apiID uint64) (apiFindings []utils.Tra
 	return
 }
 
+// APIIDs returns the APIs with aggregated findings.
+func (r *APIsFindingsRepo) APIIDs() (apiIDs []uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	for apiID := range r.apis {
+		apiIDs = append(apiIDs, apiID)
+	}
+
+	return
+}
+
 func (r *APIsFindingsRepo) ResetAPIFindings(apiID uint64) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
 	delete(r.apis, apiID)
 }
 
-func (r *APIsFindingsRepo) aggregate(apiID uint64, path, method string, ann utils.TraceAnalyzerAnnotation) (apiFinding utils.TraceAnalyzerAPIAnnotation, updated bool) {
+// ExpireFindings removes the findings which were not seen for longer than their TTL and returns, per API, the
+// names of the findings which were removed.
+func (r *APIsFindingsRepo) ExpireFindings(now time.Time) (expired map[uint64][]string) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	expired = map[uint64][]string{}
+	for apiID, findings := range r.apis {
+		names := map[string]bool{}
+		for key, f := range findings.paths {
+			if now.Sub(f.LastSeen()) > f.TTL() {
+				delete(findings.paths, key)
+				names[key.name] = true
+			}
+		}
+		for name := range names {
+			expired[apiID] = append(expired[apiID], name)
+		}
+	}
+
+	return expired
+}
+
+func (r *APIsFindingsRepo) aggregate(apiID uint64, path, method string, ann utils.TraceAnalyzerAnnotation, now time.Time) (apiFinding utils.TraceAnalyzerAPIAnnotation, updated bool) {
 	// Check if we already have an entry for this apiID
 	findings, found := r.apis[apiID]
 	if !found {
-		findings = getPreviousAPIFindings(context.TODO(), r.accessor, apiID)
+		findings = getPreviousAPIFindings(context.TODO(), r.accessor, apiID, now)
 		r.apis[apiID] = findings
 	}
 
@@ -99,11 +148,12 @@ func (r *APIsFindingsRepo) aggregate(apiID uint64, path, method string, ann util
 
 	u := apiAnn.Aggregate(ann)
 	updated = updated || u
+	apiAnn.SetLastSeen(now)
 
 	return apiAnn, updated
 }
 
-func getPreviousAPIFindings(ctx context.Context, accessor core.BackendAccessor, apiID uint64) apiFindings {
+func getPreviousAPIFindings(ctx context.Context, accessor core.BackendAccessor, apiID uint64, now time.Time) apiFindings {
 	dbAnns, err := accessor.ListAPIInfoAnnotations(ctx, utils.ModuleName, uint(apiID))
 	if err != nil {
 		return apiFindings{
@@ -114,6 +164,10 @@ func getPreviousAPIFindings(ctx context.Context, accessor core.BackendAccessor,
 	paths := map[findingKey]utils.TraceAnalyzerAPIAnnotation{}
 	taAnns := fromCoreAPIAnnotations(dbAnns)
 	for _, taAnn := range taAnns {
+		// The findings stored before they had a last seen time are considered as seen now
+		if taAnn.LastSeen().IsZero() {
+			taAnn.SetLastSeen(now)
+		}
 		paths[findingKey{path: taAnn.Path(), method: taAnn.Method(), name: taAnn.Name()}] = taAnn
 	}
 
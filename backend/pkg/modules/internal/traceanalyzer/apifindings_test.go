@@ -0,0 +1,83 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traceanalyzer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/traceanalyzer/utils"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/traceanalyzer/weakbasicauth"
+)
+
+func TestAPIsFindingsRepoExpireFindings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	accessor := core.NewMockBackendAccessor(ctrl)
+	accessor.EXPECT().ListAPIInfoAnnotations(gomock.Any(), utils.ModuleName, uint(1)).Return(nil, nil)
+
+	repo := NewAPIsFindingsRepo(accessor)
+	updated := repo.Aggregate(1, "/users", "GET", weakbasicauth.NewAnnotationShortPassword("pass", 8))
+	assert.Equal(t, len(updated), 1)
+	repo.Aggregate(1, "/orders", "GET", weakbasicauth.NewAnnotationShortPassword("pass", 8))
+	findings := repo.GetAPIFindings(1)
+	assert.Equal(t, len(findings), 2)
+	ttl := findings[0].TTL()
+
+	// Not expired yet
+	expired := repo.ExpireFindings(time.Now().Add(ttl / 2))
+	assert.Equal(t, len(expired), 0)
+	assert.Equal(t, len(repo.GetAPIFindings(1)), 2)
+
+	// Reproducing a finding pushes its expiry back
+	for _, f := range findings {
+		if f.Path() == "/orders" {
+			f.SetLastSeen(time.Now().Add(ttl))
+		}
+	}
+	expired = repo.ExpireFindings(time.Now().Add(ttl + time.Minute))
+	assert.DeepEqual(t, expired, map[uint64][]string{1: {weakbasicauth.KindShortPassword}})
+	findings = repo.GetAPIFindings(1)
+	assert.Equal(t, len(findings), 1)
+	assert.Equal(t, findings[0].Path(), "/orders")
+}
+
+func TestAPIAnnotationsExpiresAt(t *testing.T) {
+	p := &traceAnalyzer{}
+	lastSeen := time.Now().Truncate(time.Second).UTC()
+	older := weakbasicauth.NewAPIAnnotationShortPassword("/users", "GET")
+	older.SetLastSeen(lastSeen.Add(-time.Hour))
+	newer := weakbasicauth.NewAPIAnnotationShortPassword("/orders", "GET")
+	newer.SetLastSeen(lastSeen)
+
+	coreAnns := p.toCoreAPIAnnotations([]utils.TraceAnalyzerAPIAnnotation{older, newer}, false)
+	assert.Equal(t, len(coreAnns), 1)
+	assert.Equal(t, coreAnns[0].ExpiresAt, lastSeen.Add(newer.TTL()).Add(findingsExpiryInterval))
+
+	// The findings are loaded back as distinct values with their last seen time
+	anns, err := fromCoreAPIAnnotation(&coreAnns[0])
+	assert.NilError(t, err)
+	assert.Equal(t, len(anns), 2)
+	assert.Equal(t, anns[0].Path(), "/users")
+	assert.Assert(t, anns[0].LastSeen().Equal(lastSeen.Add(-time.Hour)))
+	assert.Equal(t, anns[1].Path(), "/orders")
+	assert.Assert(t, anns[1].LastSeen().Equal(lastSeen))
+}
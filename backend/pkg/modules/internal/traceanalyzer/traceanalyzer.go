@@ -18,15 +18,18 @@ package traceanalyzer
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"path/filepath"
+	"reflect"
 	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 
-	"github.com/openclarity/apiclarity/api/server/models"
 	oapicommon "github.com/openclarity/apiclarity/api3/common"
 	"github.com/openclarity/apiclarity/api3/notifications"
 	"github.com/openclarity/apiclarity/backend/pkg/config"
@@ -54,8 +57,34 @@ const (
 
 	ignoreFindingsEnvVar  = "TRACE_ANALYZER_IGNORE_FINDINGS"
 	ignoreFindingsDefault = ""
+
+	eventAnnotationsTTLEnvVar  = "TRACE_ANALYZER_EVENT_ANNOTATIONS_TTL"
+	eventAnnotationsTTLDefault = 0 // never expire
+
+	// findingsExpiryInterval is how often the expired API findings are removed. The API findings are stored with
+	// an expiry time pushed back by this interval, so that they don't expire in the database before being refreshed.
+	findingsExpiryInterval = time.Hour
 )
 
+// runtimeConfigSchema is the schema of the config which can be changed at runtime under /api/modules/traceanalyzer/config.
+const runtimeConfigSchema = `{
+	"type": "object",
+	"properties": {
+		"ignoreFindings": {
+			"description": "The names of the findings to ignore (e.g. PASSWORD_IN_URL)",
+			"type": "array",
+			"items": {"type": "string"},
+			"uniqueItems": true
+		}
+	},
+	"required": ["ignoreFindings"],
+	"additionalProperties": false
+}`
+
+type runtimeConfig struct {
+	IgnoreFindings []string `json:"ignoreFindings"`
+}
+
 type ParameterFinding struct {
 	Location string      `json:"location"`
 	Method   string      `json:"method"`
@@ -69,6 +98,7 @@ type traceAnalyzerConfig struct {
 	rulesFilenames             []string `yaml:"rulesFilenames"`
 	sensitiveKeywordsFilenames []string `yaml:"keywordsFilenames"`
 	ignoreFindings             []string `yaml:"ignoreFindings"`
+	eventAnnotationsTTL        time.Duration
 }
 
 type traceAnalyzer struct {
@@ -76,7 +106,8 @@ type traceAnalyzer struct {
 
 	config traceAnalyzerConfig
 
-	ignoreFindings map[string]bool
+	ignoreFindings     map[string]bool
+	ignoreFindingsLock sync.RWMutex
 
 	guessableID   *guessableid.GuessableAnalyzer
 	nlid          *nlid.NLID
@@ -101,14 +132,18 @@ func newTraceAnalyzer(ctx context.Context, accessor core.BackendAccessor) (core.
 	}
 	h := restapi.HandlerWithOptions(&httpHandler{ta: &p}, restapi.ChiServerOptions{BaseURL: core.BaseHTTPPath + "/" + utils.ModuleName})
 	p.httpHandler = h
-	p.ignoreFindings = map[string]bool{}
 	p.accessor = accessor
 
 	p.config = loadConfig()
 	log.Debugf("traceanalyzer Configuration: %+v", p.config)
 
-	for _, ifinding := range p.config.ignoreFindings {
-		p.ignoreFindings[ifinding] = true
+	// The ignored findings from the environment are only the default, the config stored in the database takes precedence.
+	conf, err := accessor.RegisterConfig(utils.ModuleName, []byte(runtimeConfigSchema), runtimeConfig{IgnoreFindings: p.config.ignoreFindings}, p.setRuntimeConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register config: %w", err)
+	}
+	if err := p.setRuntimeConfig(ctx, conf); err != nil {
+		return nil, err
 	}
 
 	passwordList, err := utils.ReadDictionaryFiles(p.config.dictFilenames)
@@ -137,6 +172,31 @@ func newTraceAnalyzer(ctx context.Context, accessor core.BackendAccessor) (core.
 	return &p, nil
 }
 
+func (p *traceAnalyzer) setRuntimeConfig(_ context.Context, config []byte) error {
+	var conf runtimeConfig
+	if err := json.Unmarshal(config, &conf); err != nil {
+		return fmt.Errorf("failed to decode config: %w", err)
+	}
+
+	ignoreFindings := make(map[string]bool, len(conf.IgnoreFindings))
+	for _, ifinding := range conf.IgnoreFindings {
+		ignoreFindings[ifinding] = true
+	}
+
+	p.ignoreFindingsLock.Lock()
+	defer p.ignoreFindingsLock.Unlock()
+	p.ignoreFindings = ignoreFindings
+
+	return nil
+}
+
+func (p *traceAnalyzer) isFindingIgnored(name string) bool {
+	p.ignoreFindingsLock.RLock()
+	defer p.ignoreFindingsLock.RUnlock()
+
+	return p.ignoreFindings[name]
+}
+
 func parseFilenamesFromEnv(filenames string) []string {
 	if filenames == "" {
 		return []string{}
@@ -154,11 +214,15 @@ func loadConfig() traceAnalyzerConfig {
 	viper.SetDefault(rulesFilenamesEnvVar, rulesFilenamesDefault)
 	viper.SetDefault(sensitiveKeywordsFilenamesEnvVar, sensitiveKeywordsFilenamesDefault)
 	viper.SetDefault(ignoreFindingsEnvVar, ignoreFindingsDefault)
+	viper.SetDefault(eventAnnotationsTTLEnvVar, eventAnnotationsTTLDefault)
 
 	dictFilenames := parseFilenamesFromEnv(viper.GetString(dictFilenamesEnvVar))
 	rulesFilenames := parseFilenamesFromEnv(viper.GetString(rulesFilenamesEnvVar))
 	keywordsFilenames := parseFilenamesFromEnv(viper.GetString(sensitiveKeywordsFilenamesEnvVar))
 	ignoreFindings := viper.GetStringSlice(ignoreFindingsEnvVar)
+	if ignoreFindings == nil {
+		ignoreFindings = []string{}
+	}
 	modulesAssets := viper.GetString(config.ModulesAssetsEnvVar)
 
 	var err error
@@ -188,6 +252,7 @@ func loadConfig() traceAnalyzerConfig {
 		rulesFilenames:             rulesFilenames,
 		sensitiveKeywordsFilenames: keywordsFilenames,
 		ignoreFindings:             ignoreFindings,
+		eventAnnotationsTTL:        viper.GetDuration(eventAnnotationsTTLEnvVar),
 	}
 	return c
 }
@@ -200,6 +265,74 @@ func (p *traceAnalyzer) HTTPHandler() http.Handler {
 	return p.httpHandler
 }
 
+func (p *traceAnalyzer) Start(ctx context.Context) error {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(findingsExpiryInterval):
+				p.expireAPIFindings(ctx, time.Now())
+			}
+		}
+	}()
+	return nil
+}
+
+// expireAPIFindings removes the API findings which stopped reproducing, and refreshes the expiry time of the
+// remaining ones in the database.
+func (p *traceAnalyzer) expireAPIFindings(ctx context.Context, now time.Time) {
+	expired := p.aggregator.ExpireFindings(now)
+	for _, apiID := range p.aggregator.APIIDs() {
+		apiFindings := p.aggregator.GetAPIFindings(apiID)
+		if expiredNames := expired[apiID]; len(expiredNames) > 0 {
+			log.Debugf("[traceanalyzer] findings %v of API(%v) expired", expiredNames, apiID)
+			var emptiedNames []string
+			for _, name := range expiredNames {
+				if !hasFinding(apiFindings, name) {
+					emptiedNames = append(emptiedNames, name)
+				}
+			}
+			if len(emptiedNames) > 0 {
+				if err := p.accessor.DeleteAPIInfoAnnotations(ctx, utils.ModuleName, uint(apiID), emptiedNames...); err != nil {
+					log.Error(err)
+				}
+			}
+			if err := p.sendAPIFindingsNotification(ctx, uint(apiID), apiFindings); err != nil {
+				log.Error(err)
+			}
+		}
+		if coreAPIAnnotations := p.toCoreAPIAnnotations(apiFindings, false); len(coreAPIAnnotations) > 0 {
+			if err := p.accessor.StoreAPIInfoAnnotations(ctx, utils.ModuleName, uint(apiID), coreAPIAnnotations...); err != nil {
+				log.Error(err)
+			}
+		}
+	}
+}
+
+func hasFinding(apiFindings []utils.TraceAnalyzerAPIAnnotation, name string) bool {
+	for _, f := range apiFindings {
+		if f.Name() == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Stop stores all the aggregated API findings, only the updated ones are stored on each event.
+func (p *traceAnalyzer) Stop(ctx context.Context) error {
+	for _, apiID := range p.aggregator.APIIDs() {
+		coreAPIAnnotations := p.toCoreAPIAnnotations(p.aggregator.GetAPIFindings(apiID), false)
+		if len(coreAPIAnnotations) == 0 {
+			continue
+		}
+		if err := p.accessor.StoreAPIInfoAnnotations(ctx, utils.ModuleName, uint(apiID), coreAPIAnnotations...); err != nil {
+			return fmt.Errorf("failed to store findings of api %v: %w", apiID, err)
+		}
+	}
+	return nil
+}
+
 func (p *traceAnalyzer) EventNotify(ctx context.Context, e *core.Event) {
 	event, trace := e.APIEvent, e.Telemetry
 	log.Debugf("[traceanalyzer] received a new trace for API(%v) EventID(%v)", event.APIInfoID, event.ID)
@@ -218,8 +351,6 @@ func (p *traceAnalyzer) EventNotify(ctx context.Context, e *core.Event) {
 	// accepted, hence, the parameters were accepted as well. So, we can look at
 	// the parameters to see if they are very similar with the one in previous
 	// accepted queries.
-	// FIXME: Performance KILLER. For each request, this function is called, which calls the database a deserializes data to get the specinfo
-	// FIXME: We MUST create a memory cache map[apiid]specinfo to avoid that
 	specPath, pathParams, _, err := p.getParams(ctx, event)
 	// if specPath == "" {
 	// 	specPath = trace.Request.Path
@@ -237,7 +368,7 @@ func (p *traceAnalyzer) EventNotify(ctx context.Context, e *core.Event) {
 	// Filter ignored findings for event annotations
 	filteredEventAnns := []utils.TraceAnalyzerAnnotation{}
 	for _, a := range eventAnns {
-		if !p.ignoreFindings[a.Name()] {
+		if !p.isFindingIgnored(a.Name()) {
 			filteredEventAnns = append(filteredEventAnns, a)
 		}
 	}
@@ -278,6 +409,7 @@ func (p *traceAnalyzer) EventNotify(ctx context.Context, e *core.Event) {
 }
 
 func (p *traceAnalyzer) toCoreEventAnnotations(eventAnns []utils.TraceAnalyzerAnnotation, redacted bool) (coreAnnotations []core.Annotation) {
+	expiresAt := p.eventAnnotationsExpiresAt()
 	for _, a := range eventAnns {
 		if redacted {
 			a = a.Redacted()
@@ -286,11 +418,19 @@ func (p *traceAnalyzer) toCoreEventAnnotations(eventAnns []utils.TraceAnalyzerAn
 		if err != nil {
 			log.Errorf("unable to serialize annotation: %s", err)
 		}
-		coreAnnotations = append(coreAnnotations, core.Annotation{Name: a.Name(), Annotation: annotation})
+		coreAnnotations = append(coreAnnotations, core.Annotation{Name: a.Name(), Annotation: annotation, ExpiresAt: expiresAt})
 	}
 	return coreAnnotations
 }
 
+// eventAnnotationsExpiresAt is the expiry time of the event annotations created now, they never expire without a TTL.
+func (p *traceAnalyzer) eventAnnotationsExpiresAt() time.Time {
+	if p.config.eventAnnotationsTTL <= 0 {
+		return time.Time{}
+	}
+	return time.Now().Add(p.config.eventAnnotationsTTL)
+}
+
 func fromCoreEventAnnotation(coreAnn *core.Annotation) (ann utils.TraceAnalyzerAnnotation, err error) {
 	var a utils.TraceAnalyzerAnnotation
 	switch coreAnn.Name {
@@ -363,7 +503,14 @@ func (p *traceAnalyzer) toCoreAPIAnnotations(anns []utils.TraceAnalyzerAPIAnnota
 		if err != nil {
 			log.Errorf("unable to serialize annotation: %s", err)
 		}
-		coreAnnotations = append(coreAnnotations, core.Annotation{Name: annName, Annotation: annotation})
+		// The annotation expires with the last of its findings
+		var expiresAt time.Time
+		for _, a := range anns {
+			if findingExpiresAt := a.LastSeen().Add(a.TTL()); findingExpiresAt.After(expiresAt) {
+				expiresAt = findingExpiresAt
+			}
+		}
+		coreAnnotations = append(coreAnnotations, core.Annotation{Name: annName, Annotation: annotation, ExpiresAt: expiresAt.Add(findingsExpiryInterval)})
 	}
 	return coreAnnotations
 }
@@ -411,7 +558,10 @@ func fromCoreAPIAnnotation(coreAnn *core.Annotation) (anns []utils.TraceAnalyzer
 		return nil, fmt.Errorf("unknown annotation '%s'", coreAnn.Name)
 	}
 
+	// Each annotation is unmarshalled in a new value of the same type, so that they don't share the same finding
+	annType := reflect.TypeOf(a).Elem()
 	for _, rawJSON := range rawAnnotations {
+		a := reflect.New(annType).Interface().(utils.TraceAnalyzerAPIAnnotation) //nolint:forcetypeassert
 		if err := json.Unmarshal(rawJSON, a); err != nil {
 			log.Errorf("Unable to unmarshal one of the %s annotations", coreAnn.Name)
 			log.Debugf("Unable to unmarshal annotation of type %s %s", coreAnn.Name, string(rawJSON))
@@ -459,14 +609,6 @@ func (p *traceAnalyzer) sendAPIFindingsNotification(ctx context.Context, apiID u
 	return nil
 }
 
-func (p *traceAnalyzer) EventAnnotationNotify(modName string, eventID uint, ann core.Annotation) error {
-	return nil
-}
-
-func (p *traceAnalyzer) APIAnnotationNotify(modName string, apiID uint, annotation *core.Annotation) error {
-	return nil
-}
-
 func (p *traceAnalyzer) setAlertSeverity(ctx context.Context, eventID uint, anns []utils.TraceAnalyzerAnnotation) {
 	maxAlert := core.AlertInfo
 	for _, a := range anns {
@@ -489,6 +631,8 @@ func (p *traceAnalyzer) setAlertSeverity(ctx context.Context, eventID uint, anns
 	case core.AlertCritical:
 		alertAnn = core.AlertCriticalAnn
 	}
+	// The alert expires with the annotations it was raised for
+	alertAnn.ExpiresAt = p.eventAnnotationsExpiresAt()
 
 	if err := p.accessor.CreateAPIEventAnnotations(ctx, utils.ModuleName, eventID, alertAnn); err != nil {
 		log.Error(err)
@@ -496,44 +640,16 @@ func (p *traceAnalyzer) setAlertSeverity(ctx context.Context, eventID uint, anns
 }
 
 func (p *traceAnalyzer) getParams(ctx context.Context, event *database.APIEvent) (specPath string, pathParams map[string]string, queryParams map[string]string, err error) {
-	apiInfo, err := p.accessor.GetAPIInfo(ctx, event.APIInfoID)
+	resolved, err := p.accessor.ResolveEventPath(ctx, event)
 	if err != nil {
-		return "", nil, nil, fmt.Errorf("unable to get API Information from database: %w", err)
-	}
-
-	// Prefer Provided specification if available
-	var serializedSpecInfo *string
-	var eventPathID string
-	if apiInfo.HasProvidedSpec && apiInfo.ProvidedSpecInfo != "" {
-		serializedSpecInfo = &apiInfo.ProvidedSpecInfo
-		eventPathID = event.ProvidedPathID
-	} else if apiInfo.HasReconstructedSpec && apiInfo.ReconstructedSpecInfo != "" {
-		serializedSpecInfo = &apiInfo.ReconstructedSpecInfo
-		eventPathID = event.ReconstructedPathID
-	} else {
-		return specPath, pathParams, queryParams, nil
-	}
-
-	var specInfo models.SpecInfo
-	if err := json.Unmarshal([]byte(*serializedSpecInfo), &specInfo); err != nil {
-		return specPath, pathParams, queryParams, fmt.Errorf("failed to unmarshal spec info for api=%d: %w", event.APIInfoID, err)
-	}
-
-	pathParams = make(map[string]string)
-	queryParams = make(map[string]string)
-
-	for _, t := range specInfo.Tags {
-		for _, path := range t.MethodAndPathList {
-			if path.PathID.String() == eventPathID && path.Method == event.Method {
-				specPath = path.Path
-				pathParams = utils.GetPathParams(path.Path, event.Path)
-				// XXX Need to get other parameters
-				break
-			}
+		if errors.Is(err, core.ErrSpecPathNotFound) {
+			return specPath, pathParams, queryParams, nil
 		}
+		return "", nil, nil, fmt.Errorf("unable to resolve spec path: %w", err)
 	}
 
-	return specPath, pathParams, queryParams, nil
+	// XXX Need to get other parameters
+	return resolved.Path, resolved.PathParams, map[string]string{}, nil
 }
 
 func (p *traceAnalyzer) getAPIFindings(ctx context.Context, apiID uint, sensitive bool) (apiFindings []oapicommon.APIFinding, err error) {
@@ -556,6 +672,11 @@ func (p *traceAnalyzer) getAPIFindings(ctx context.Context, apiID uint, sensitiv
 	return apiFindings, nil
 }
 
+// StoredAPIFindings returns the findings notified on an API, see core.APIFindingsProvider.
+func (p *traceAnalyzer) StoredAPIFindings(ctx context.Context, apiID uint) ([]oapicommon.APIFinding, error) {
+	return p.getAPIFindings(ctx, apiID, true)
+}
+
 type httpHandler struct {
 	ta *traceAnalyzer
 }
@@ -647,6 +768,12 @@ func (h httpHandler) ResetApiFindings(w http.ResponseWriter, r *http.Request, ap
 		return
 	}
 
+	if err := h.ta.sendAPIFindingsNotification(r.Context(), uint(apiID), nil); err != nil {
+		log.Error(err)
+		common.HTTPResponse(w, http.StatusInternalServerError, oapicommon.ApiResponse{Message: "Internal error, could not notify the reset findings"})
+		return
+	}
+
 	log.Infof("API Findings successfully reset for api=%d", apiID)
 	common.HTTPResponse(w, http.StatusNoContent, nil)
 }
@@ -38,20 +38,32 @@ type TraceAnalyzerAPIAnnotation interface {
 	Method() string
 	Aggregate(TraceAnalyzerAnnotation) (notify bool)
 	Severity() string
+	// TTL is how long the finding is kept once it stops reproducing.
 	TTL() time.Duration
+	LastSeen() time.Time
+	SetLastSeen(time.Time)
 	Redacted() TraceAnalyzerAPIAnnotation
 	ToAPIFinding() oapicommon.APIFinding
 }
 
 type BaseTraceAnalyzerAPIAnnotation struct {
-	SpecPath   string `json:"path"`
-	SpecMethod string `json:"method"`
+	SpecPath     string    `json:"path"`
+	SpecMethod   string    `json:"method"`
+	LastSeenTime time.Time `json:"lastSeen"`
 }
 
 func (a BaseTraceAnalyzerAPIAnnotation) Path() string       { return a.SpecPath }
 func (a BaseTraceAnalyzerAPIAnnotation) Method() string     { return a.SpecMethod }
 func (a BaseTraceAnalyzerAPIAnnotation) Severity() string   { return SeverityInfo }
 func (a BaseTraceAnalyzerAPIAnnotation) TTL() time.Duration { return 24 * time.Hour } //nolint:gomnd
+
+// LastSeen is when the finding was last reproduced, it expires once its TTL has elapsed since then.
+func (a BaseTraceAnalyzerAPIAnnotation) LastSeen() time.Time { return a.LastSeenTime }
+
+func (a *BaseTraceAnalyzerAPIAnnotation) SetLastSeen(lastSeen time.Time) {
+	a.LastSeenTime = lastSeen
+}
+
 func (a BaseTraceAnalyzerAPIAnnotation) SpecLocation() string {
 	if a.SpecPath != "" {
 		return utils.JSONPointer("paths", a.SpecPath, strings.ToLower(a.SpecMethod))
@@ -25,16 +25,6 @@ import (
 	_models "github.com/openclarity/apiclarity/plugins/api/server/models"
 )
 
-const (
-	pathSep     = "/"
-	paramPrefix = "{"
-	paramSuffix = "}"
-)
-
-func isPathParam(segment string) bool {
-	return strings.HasPrefix(segment, paramPrefix) && strings.HasSuffix(segment, paramSuffix)
-}
-
 func Min(x, y int) int {
 	if x < y {
 		return x
@@ -42,23 +32,6 @@ func Min(x, y int) int {
 	return y
 }
 
-func GetPathParams(specPath string, opPath string) map[string]string {
-	result := make(map[string]string)
-
-	specSegs := strings.Split(specPath, pathSep)
-	opSegs := strings.Split(opPath, pathSep)
-
-	for i, specSeg := range specSegs {
-		if specSeg == opSegs[i] {
-			continue
-		} else if isPathParam(specSeg) {
-			result[specSeg[1:len(specSeg)-1]] = opSegs[i]
-		}
-	}
-
-	return result
-}
-
 func WalkFiles(root string) ([]string, error) {
 	files := []string{}
 
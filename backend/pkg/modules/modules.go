@@ -29,8 +29,12 @@ import (
 	_ "github.com/openclarity/apiclarity/backend/pkg/modules/internal/bfla"
 	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/core"
 
+	// Enables the custom rules module.
+	_ "github.com/openclarity/apiclarity/backend/pkg/modules/internal/customrules"
+
 	// Enables the fuzzer module.
 	_ "github.com/openclarity/apiclarity/backend/pkg/modules/internal/fuzzer"
+	"github.com/openclarity/apiclarity/backend/pkg/modules/internal/remote"
 
 	// Enables the spec differ module.
 	_ "github.com/openclarity/apiclarity/backend/pkg/modules/internal/spec_differ"
@@ -44,28 +48,64 @@ import (
 	"github.com/openclarity/apiclarity/backend/pkg/sampling"
 )
 
+const (
+	BaseHTTPPath = core.BaseHTTPPath
+
+	DefaultDispatchQueueSize = core.DefaultDispatchQueueSize
+	DefaultDispatchWorkers   = core.DefaultDispatchWorkers
+	DispatchPolicyDrop       = core.DispatchPolicyDrop
+	DispatchPolicyBlock      = core.DispatchPolicyBlock
+)
+
 type (
 	ModuleInfo          = core.ModuleInfo
-	ModulesManager      = core.Module //nolint:revive
+	ModuleStatus        = core.ModuleStatus
+	ModulesManager      = core.ModulesManager //nolint:revive
 	MockModule          = core.MockModule
+	MockModulesManager  = core.MockModulesManager
 	Annotation          = core.Annotation
 	BackendAccessor     = core.BackendAccessor
 	MockBackendAccessor = core.MockBackendAccessor
 	Event               = core.Event
+	DispatchStats       = core.DispatchStats
+	Replay              = core.Replay
+
+	AnnotationSubscriber   = core.AnnotationSubscriber
+	AnnotationSubscription = core.AnnotationSubscription
 )
 
 var (
-	NewMockModulesManager  = core.NewMockModule
+	NewMockModulesManager  = core.NewMockModulesManager
 	NewMockBackendAccessor = core.NewMockBackendAccessor
+
+	ErrModuleNotFound = core.ErrModuleNotFound
+	ErrReplayNotFound = core.ErrReplayNotFound
+	ErrInvalidReplay  = core.ErrInvalidReplay
 )
 
 func New(ctx context.Context, dbHandler *database.Handler, clientset kubernetes.Interface, samplingManager *sampling.TraceSamplingManager, speculatorAccessor speculatoraccessor.SpeculatorsAccessor, notifier *notifier.Notifier, config *config.Config) (ModulesManager, []ModuleInfo, error) {
-	backendAccessor, err := core.NewAccessor(dbHandler, clientset, samplingManager, speculatorAccessor, notifier, config)
+	moduleConfigs := core.NewModuleConfigs(dbHandler.ModuleConfigsTable())
+	apiSpecs := core.NewAPISpecsCache(dbHandler)
+	annotationSubs := core.NewAnnotationSubscriptions()
+	backendAccessor, err := core.NewAccessor(dbHandler, clientset, samplingManager, speculatorAccessor, notifier, moduleConfigs, apiSpecs, annotationSubs, config)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create backend accessor: %v", err)
 	}
 
-	module, infos := core.New(ctx, backendAccessor, samplingManager, config.TraceSamplingEnabled)
+	dispatchConfig, err := core.NewDispatchConfig(config.ModulesQueueSize, config.ModulesWorkers, config.ModulesQueuePolicy, config.ModulesQueuePolicyOverrides)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create modules dispatch config: %v", err)
+	}
+
+	remoteModules, err := remote.Factories(config.RemoteModules, config.RemoteModulesTokens)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create remote modules: %v", err)
+	}
+
+	module, infos, err := core.New(ctx, backendAccessor, samplingManager, config.TraceSamplingEnabled, dbHandler.ModuleStatesTable(), dispatchConfig, moduleConfigs, apiSpecs, core.NewReplays(dbHandler), core.NewAPIFindingsBackfill(dbHandler), annotationSubs, remoteModules)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create modules: %v", err)
+	}
 
 	return module, infos, nil
 }
@@ -17,9 +17,11 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 
 	log "github.com/sirupsen/logrus"
 
@@ -32,6 +34,9 @@ const (
 	NotificationWorkers      = 10
 )
 
+// ErrStopped is returned by Notify when the notifier was stopped.
+var ErrStopped = errors.New("notifier is stopped")
+
 type notificationWithParams struct {
 	apiID   uint
 	payload notifications.APIClarityNotification
@@ -42,6 +47,10 @@ type Notifier struct {
 	notificationQueue chan notificationWithParams
 	workers           int
 	tlsOptions        *coretls.ClientTLSOptions
+
+	lock    sync.RWMutex
+	stopped bool
+	wg      sync.WaitGroup
 }
 
 func NewNotifier(notificationPrefixURL string, maxQueueSize int, workers int, tlsOptions *coretls.ClientTLSOptions) *Notifier {
@@ -53,17 +62,49 @@ func NewNotifier(notificationPrefixURL string, maxQueueSize int, workers int, tl
 	}
 }
 
-func (n Notifier) Start(ctx context.Context) {
+func (n *Notifier) Start(ctx context.Context) {
 	for i := 0; i < n.workers; i++ {
-		go worker(ctx, n.notificationURL, n.notificationQueue, n.tlsOptions)
+		n.wg.Add(1)
+		go func() {
+			defer n.wg.Done()
+			worker(ctx, n.notificationURL, n.notificationQueue, n.tlsOptions)
+		}()
 	}
 }
 
-func (n Notifier) Stop() {
-	close(n.notificationQueue)
+// Stop stops accepting new notifications and waits for the workers to send the queued ones, or for ctx to be done.
+func (n *Notifier) Stop(ctx context.Context) error {
+	log.Infof("Stopping notifier, waiting for %v queued notifications", len(n.notificationQueue))
+
+	done := make(chan struct{})
+	go func() {
+		// a Notify blocked on the full queue holds the lock until the workers make room
+		n.lock.Lock()
+		if !n.stopped {
+			n.stopped = true
+			close(n.notificationQueue)
+		}
+		n.lock.Unlock()
+
+		n.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to send %v queued notifications: %w", len(n.notificationQueue), ctx.Err())
+	}
 }
 
-func (n Notifier) Notify(apiID uint, notif notifications.APIClarityNotification) error {
+func (n *Notifier) Notify(apiID uint, notif notifications.APIClarityNotification) error {
+	n.lock.RLock()
+	defer n.lock.RUnlock()
+
+	if n.stopped {
+		return ErrStopped
+	}
 	n.notificationQueue <- notificationWithParams{apiID: apiID, payload: notif}
 
 	return nil
@@ -15,7 +15,17 @@
 
 package notifier
 
-import "testing"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/openclarity/apiclarity/api3/notifications"
+)
 
 func Test_setSchemeIfNeeded(t *testing.T) {
 	type args struct {
@@ -52,3 +62,64 @@ func Test_setSchemeIfNeeded(t *testing.T) {
 		})
 	}
 }
+
+func newTestNotification(t *testing.T) notifications.APIClarityNotification {
+	t.Helper()
+	n := notifications.APIClarityNotification{}
+	if err := n.FromSpecDiffsNotification(notifications.SpecDiffsNotification{}); err != nil {
+		t.Fatalf("failed to create notification: %v", err)
+	}
+	return n
+}
+
+func TestNotifier_Stop(t *testing.T) {
+	var received int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&received, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	n := NewNotifier(server.URL, 10, 2, nil)
+	// queued before the workers are started, they must be sent before Stop returns
+	for i := 0; i < 5; i++ {
+		if err := n.Notify(uint(i), newTestNotification(t)); err != nil {
+			t.Fatalf("Notify() error = %v", err)
+		}
+	}
+	n.Start(context.Background())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := n.Stop(ctx); err != nil {
+		t.Fatalf("Stop() error = %v", err)
+	}
+	if got := atomic.LoadInt32(&received); got != 5 {
+		t.Errorf("received %v notifications, want 5", got)
+	}
+	if err := n.Notify(1, newTestNotification(t)); !errors.Is(err, ErrStopped) {
+		t.Errorf("Notify() after Stop() error = %v, want %v", err, ErrStopped)
+	}
+}
+
+func TestNotifier_StopTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	n := NewNotifier(server.URL, 10, 1, nil)
+	n.Start(context.Background())
+	if err := n.Notify(1, newTestNotification(t)); err != nil {
+		t.Fatalf("Notify() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if err := n.Stop(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Stop() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
@@ -0,0 +1,146 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type jsonPathStepKind int
+
+const (
+	jsonPathField jsonPathStepKind = iota
+	jsonPathIndex
+	jsonPathWildcard
+)
+
+// jsonPathStep is a step of a JSON path: .name, ['name'], [n], .* or [*], matching at any depth when
+// preceded by the recursive descent (..name, ..*).
+type jsonPathStep struct {
+	kind      jsonPathStepKind
+	name      string
+	index     int
+	recursive bool
+}
+
+// parseJSONPath parses the subset of JSONPath used to select the fields to redact.
+func parseJSONPath(path string) ([]jsonPathStep, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("JSON path must start with $")
+	}
+
+	var steps []jsonPathStep
+	rest := path[1:]
+	for rest != "" {
+		var step jsonPathStep
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			step.recursive = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				break
+			}
+			step.name, rest = splitJSONPathName(rest)
+		case strings.HasPrefix(rest, "."):
+			step.name, rest = splitJSONPathName(rest[1:])
+		case strings.HasPrefix(rest, "["):
+		default:
+			return nil, fmt.Errorf("unexpected %q in JSON path", rest)
+		}
+
+		if strings.HasPrefix(rest, "[") && step.name == "" {
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("missing ] in JSON path")
+			}
+			step.name, rest = rest[1:end], rest[end+1:]
+			switch {
+			case step.name == "*":
+			case len(step.name) >= 2 && (step.name[0] == '\'' || step.name[0] == '"') && step.name[len(step.name)-1] == step.name[0]:
+				step.name = step.name[1 : len(step.name)-1]
+			default:
+				index, err := strconv.Atoi(step.name)
+				if err != nil || index < 0 {
+					return nil, fmt.Errorf("invalid subscript [%s] in JSON path", step.name)
+				}
+				step.kind, step.index = jsonPathIndex, index
+			}
+		}
+
+		if step.name == "" && step.kind != jsonPathIndex {
+			return nil, fmt.Errorf("empty field name in JSON path")
+		}
+		if step.name == "*" {
+			step.kind = jsonPathWildcard
+		}
+		steps = append(steps, step)
+	}
+
+	if len(steps) == 0 {
+		return nil, fmt.Errorf("JSON path must select a field")
+	}
+	return steps, nil
+}
+
+func splitJSONPathName(path string) (name, rest string) {
+	end := strings.IndexAny(path, ".[")
+	if end < 0 {
+		return path, ""
+	}
+	return path[:end], path[end:]
+}
+
+func (s jsonPathStep) matchesKey(key string) bool {
+	return s.kind == jsonPathWildcard || (s.kind == jsonPathField && s.name == key)
+}
+
+func (s jsonPathStep) matchesIndex(index int) bool {
+	return s.kind == jsonPathWildcard || (s.kind == jsonPathIndex && s.index == index)
+}
+
+// redactJSONPath replaces in place the values of node selected by the steps, and returns whether any was.
+func redactJSONPath(node interface{}, steps []jsonPathStep, redact func(interface{}) interface{}) bool {
+	step := steps[0]
+	redacted := false
+	apply := func(child interface{}, matches bool) interface{} {
+		if matches {
+			if len(steps) == 1 {
+				redacted = true
+				return redact(child)
+			}
+			redacted = redactJSONPath(child, steps[1:], redact) || redacted
+		}
+		if step.recursive {
+			redacted = redactJSONPath(child, steps, redact) || redacted
+		}
+		return child
+	}
+
+	switch n := node.(type) {
+	case map[string]interface{}:
+		for key, child := range n {
+			n[key] = apply(child, step.matchesKey(key))
+		}
+	case []interface{}:
+		for i, child := range n {
+			n[i] = apply(child, step.matchesIndex(i))
+		}
+	}
+
+	return redacted
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestParseJSONPath(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    []jsonPathStep
+		wantErr bool
+	}{
+		{
+			path: "$.user.password",
+			want: []jsonPathStep{{kind: jsonPathField, name: "user"}, {kind: jsonPathField, name: "password"}},
+		},
+		{
+			path: "$.cards[*].number",
+			want: []jsonPathStep{{kind: jsonPathField, name: "cards"}, {kind: jsonPathWildcard, name: "*"}, {kind: jsonPathField, name: "number"}},
+		},
+		{
+			path: "$['user.name'][2]",
+			want: []jsonPathStep{{kind: jsonPathField, name: "user.name"}, {kind: jsonPathIndex, name: "2", index: 2}},
+		},
+		{
+			path: "$..token",
+			want: []jsonPathStep{{kind: jsonPathField, name: "token", recursive: true}},
+		},
+		{
+			path: "$..[0].*",
+			want: []jsonPathStep{{kind: jsonPathIndex, name: "0", recursive: true}, {kind: jsonPathWildcard, name: "*"}},
+		},
+		{path: "user.password", wantErr: true},
+		{path: "$", wantErr: true},
+		{path: "$.user.", wantErr: true},
+		{path: "$.cards[", wantErr: true},
+		{path: "$.cards[-1]", wantErr: true},
+		{path: "$user", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := parseJSONPath(tt.path)
+			if tt.wantErr {
+				assert.Assert(t, err != nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, got, tt.want, cmpJSONPathStep)
+		})
+	}
+}
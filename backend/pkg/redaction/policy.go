@@ -0,0 +1,255 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+const (
+	MaskedValue       = "[REDACTED]"
+	hashedValuePrefix = "hmac-sha256:"
+	contentTypeHeader = "content-type"
+)
+
+// Policy is a compiled models.RedactionPolicy.
+type Policy struct {
+	model     *models.RedactionPolicy
+	headers   map[string]bool
+	jsonPaths [][]jsonPathStep
+	xmlPaths  [][]xmlPathStep
+	patterns  []*regexp.Regexp
+	hash      bool
+	// key of the HMAC of the hashed values, so that they can't be reversed by hashing guessed values
+	hashKey []byte
+}
+
+// Compile validates the policy and compiles its paths and patterns, the values are hashed with the hash key.
+func Compile(model *models.RedactionPolicy, hashKey []byte) (*Policy, error) {
+	policy := &Policy{
+		model:   model,
+		headers: make(map[string]bool, len(model.Headers)),
+		hashKey: hashKey,
+	}
+
+	switch model.Action {
+	case "", models.RedactionActionMASK:
+	case models.RedactionActionHASH:
+		if len(hashKey) == 0 {
+			return nil, fmt.Errorf("action %s requires a hash key", model.Action)
+		}
+		policy.hash = true
+	default:
+		return nil, fmt.Errorf("unknown action %q", model.Action)
+	}
+
+	for _, header := range model.Headers {
+		policy.headers[strings.ToLower(header)] = true
+	}
+
+	for _, field := range model.BodyFields {
+		switch {
+		case strings.HasPrefix(field, "$"):
+			path, err := parseJSONPath(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid body field %q: %v", field, err)
+			}
+			policy.jsonPaths = append(policy.jsonPaths, path)
+		case strings.HasPrefix(field, "/"):
+			path, err := parseXMLPath(field)
+			if err != nil {
+				return nil, fmt.Errorf("invalid body field %q: %v", field, err)
+			}
+			policy.xmlPaths = append(policy.xmlPaths, path)
+		default:
+			return nil, fmt.Errorf("invalid body field %q: expecting a JSON path ($...) or an XML path (/...)", field)
+		}
+	}
+
+	for _, pattern := range model.Patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %v", pattern, err)
+		}
+		policy.patterns = append(policy.patterns, re)
+	}
+
+	return policy, nil
+}
+
+// Model returns the policy as it was set.
+func (p *Policy) Model() *models.RedactionPolicy {
+	return p.model
+}
+
+// Redact redacts in place the headers, path and bodies of the trace.
+func (p *Policy) Redact(trace *pluginsmodels.Telemetry) {
+	if trace.Request != nil {
+		trace.Request.Path = p.redactPatterns(trace.Request.Path)
+		p.redactCommon(trace.Request.Common)
+	}
+	if trace.Response != nil {
+		p.redactCommon(trace.Response.Common)
+	}
+}
+
+// RedactJSON redacts a JSON document, used for the data decoded out of the trace bodies.
+func (p *Policy) RedactJSON(document string) string {
+	if redacted, err := p.redactJSONPaths([]byte(document)); err == nil {
+		document = string(redacted)
+	}
+	return p.redactPatterns(document)
+}
+
+func (p *Policy) redactCommon(common *pluginsmodels.Common) {
+	if common == nil {
+		return
+	}
+
+	var contentType string
+	for _, header := range common.Headers {
+		name := strings.ToLower(header.Key)
+		if name == contentTypeHeader {
+			contentType = header.Value
+		}
+		if p.headers[name] {
+			header.Value = p.redactString(header.Value)
+		} else {
+			header.Value = p.redactPatterns(header.Value)
+		}
+	}
+
+	common.Body = p.redactBody(common.Body, contentType)
+}
+
+func (p *Policy) redactBody(body []byte, contentType string) []byte {
+	if len(body) == 0 {
+		return body
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = ""
+	}
+	switch {
+	case len(p.jsonPaths) > 0 && isJSONMediaType(mediaType):
+		if redacted, err := p.redactJSONPaths(body); err != nil {
+			// truncated bodies can't be parsed, only the patterns apply to them
+			log.Debugf("Failed to redact JSON body fields: %v", err)
+		} else {
+			body = redacted
+		}
+	case len(p.xmlPaths) > 0 && isXMLMediaType(mediaType):
+		if redacted, err := redactXMLPaths(body, p.xmlPaths, p.redactBytes); err != nil {
+			log.Debugf("Failed to redact XML body fields: %v", err)
+		} else {
+			body = redacted
+		}
+	}
+
+	// binary bodies (gRPC, images...) are left untouched
+	if len(p.patterns) == 0 || !utf8.Valid(body) {
+		return body
+	}
+	return []byte(p.redactPatterns(string(body)))
+}
+
+func (p *Policy) redactJSONPaths(body []byte) ([]byte, error) {
+	if len(p.jsonPaths) == 0 {
+		return body, nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var document interface{}
+	if err := decoder.Decode(&document); err != nil {
+		return nil, fmt.Errorf("failed to parse JSON: %v", err)
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("failed to parse JSON: unexpected data after the document")
+	}
+
+	redacted := false
+	for _, path := range p.jsonPaths {
+		redacted = redactJSONPath(document, path, p.redactJSONValue) || redacted
+	}
+	if !redacted {
+		return body, nil
+	}
+
+	var ret bytes.Buffer
+	encoder := json.NewEncoder(&ret)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(document); err != nil {
+		return nil, fmt.Errorf("failed to encode JSON: %v", err)
+	}
+	return bytes.TrimSuffix(ret.Bytes(), []byte("\n")), nil
+}
+
+func (p *Policy) redactJSONValue(value interface{}) interface{} {
+	if s, ok := value.(string); ok {
+		return p.redactString(s)
+	}
+	// objects, arrays and numbers are hashed from their JSON encoding
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return MaskedValue
+	}
+	return string(p.redactBytes(encoded))
+}
+
+func (p *Policy) redactString(value string) string {
+	return string(p.redactBytes([]byte(value)))
+}
+
+func (p *Policy) redactBytes(value []byte) []byte {
+	if !p.hash {
+		return []byte(MaskedValue)
+	}
+	mac := hmac.New(sha256.New, p.hashKey)
+	mac.Write(value)
+	return []byte(hashedValuePrefix + hex.EncodeToString(mac.Sum(nil)))
+}
+
+func (p *Policy) redactPatterns(value string) string {
+	for _, re := range p.patterns {
+		value = re.ReplaceAllStringFunc(value, p.redactString)
+	}
+	return value
+}
+
+func isJSONMediaType(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func isXMLMediaType(mediaType string) bool {
+	return mediaType == "application/xml" || mediaType == "text/xml" || strings.HasSuffix(mediaType, "+xml")
+}
@@ -0,0 +1,149 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+var cmpJSONPathStep = cmp.AllowUnexported(jsonPathStep{})
+
+func newTestTrace(contentType, requestBody, responseBody string) *pluginsmodels.Telemetry {
+	return &pluginsmodels.Telemetry{
+		Request: &pluginsmodels.Request{
+			Method: "POST",
+			Path:   "/users?email=john@example.com",
+			Common: &pluginsmodels.Common{
+				Headers: []*pluginsmodels.Header{
+					{Key: "Content-Type", Value: contentType},
+					{Key: "Authorization", Value: "Bearer abc"},
+					{Key: "X-Contact", Value: "jane@example.com"},
+				},
+				Body: []byte(requestBody),
+			},
+		},
+		Response: &pluginsmodels.Response{
+			StatusCode: "200",
+			Common: &pluginsmodels.Common{
+				Headers: []*pluginsmodels.Header{{Key: "content-type", Value: contentType}},
+				Body:    []byte(responseBody),
+			},
+		},
+	}
+}
+
+var testHashKey = []byte("key")
+
+func TestCompile_errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy *models.RedactionPolicy
+	}{
+		{name: "unknown action", policy: &models.RedactionPolicy{Action: "DROP"}},
+		{name: "invalid body field", policy: &models.RedactionPolicy{BodyFields: []string{"password"}}},
+		{name: "invalid JSON path", policy: &models.RedactionPolicy{BodyFields: []string{"$.cards["}}},
+		{name: "invalid XML path", policy: &models.RedactionPolicy{BodyFields: []string{"/user/@password"}}},
+		{name: "invalid pattern", policy: &models.RedactionPolicy{Patterns: []string{"("}}},
+		{name: "hash without key", policy: &models.RedactionPolicy{Action: models.RedactionActionHASH}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Compile(tt.policy, nil)
+			assert.Assert(t, err != nil)
+		})
+	}
+}
+
+func TestPolicy_Redact(t *testing.T) {
+	policy, err := Compile(&models.RedactionPolicy{
+		Headers:    []string{"authorization"},
+		BodyFields: []string{"$.password", "$.cards[*].number", "$..token", "//password"},
+		Patterns:   []string{`[a-z]+@example\.com`},
+	}, nil)
+	assert.NilError(t, err)
+
+	trace := newTestTrace("application/json; charset=utf-8",
+		`{"user":"john@example.com","password":"s3cret","cards":[{"number":4242,"expiry":"12/30"},{"number":"5555"}]}`,
+		`{"session":{"token":{"value":"abc"}},"items":[{"token":"def"}],"html":"<b>"}`)
+	policy.Redact(trace)
+
+	assert.Equal(t, trace.Request.Path, "/users?email=[REDACTED]")
+	assert.Equal(t, trace.Request.Common.Headers[0].Value, "application/json; charset=utf-8")
+	assert.Equal(t, trace.Request.Common.Headers[1].Value, "[REDACTED]")
+	assert.Equal(t, trace.Request.Common.Headers[2].Value, "[REDACTED]")
+	assert.Equal(t, string(trace.Request.Common.Body),
+		`{"cards":[{"expiry":"12/30","number":"[REDACTED]"},{"number":"[REDACTED]"}],"password":"[REDACTED]","user":"[REDACTED]"}`)
+	assert.Equal(t, string(trace.Response.Common.Body),
+		`{"html":"<b>","items":[{"token":"[REDACTED]"}],"session":{"token":"[REDACTED]"}}`)
+
+	// XML bodies
+	trace = newTestTrace("text/xml", `<user><name>john@example.com</name><password>s3cret</password></user>`, "")
+	policy.Redact(trace)
+	assert.Equal(t, string(trace.Request.Common.Body), `<user><name>[REDACTED]</name><password>[REDACTED]</password></user>`)
+
+	// truncated bodies can't be parsed, only the patterns apply
+	trace = newTestTrace("application/json", `{"user":"john@example.com","password":"s3c`, "")
+	policy.Redact(trace)
+	assert.Equal(t, string(trace.Request.Common.Body), `{"user":"[REDACTED]","password":"s3c`)
+
+	// binary bodies are left untouched
+	binary := []byte{0, 0, 0, 0, 3, 0xff, 'a', '@'}
+	trace = newTestTrace("application/grpc", string(binary), "")
+	policy.Redact(trace)
+	assert.DeepEqual(t, []byte(trace.Request.Common.Body), binary)
+}
+
+func TestPolicy_Redact_hash(t *testing.T) {
+	policy, err := Compile(&models.RedactionPolicy{
+		Headers:    []string{"Authorization"},
+		BodyFields: []string{"$.id"},
+		Action:     models.RedactionActionHASH,
+	}, testHashKey)
+	assert.NilError(t, err)
+
+	trace := newTestTrace("application/json", `{"id":1}`, "")
+	policy.Redact(trace)
+
+	// hmac-sha256("key", "Bearer abc") and hmac-sha256("key", "1")
+	assert.Equal(t, trace.Request.Common.Headers[1].Value, "hmac-sha256:8cb3b519104875e4ac4a93a1e3d1bb41fe721135d4164b8370a2789687c278a9")
+	assert.Equal(t, string(trace.Request.Common.Body), `{"id":"hmac-sha256:6da91fb91517be1f5cdcf3af91d7d40c717dd638a306157606fb2e584f7ae926"}`)
+	// the path is left untouched without patterns
+	assert.Equal(t, trace.Request.Path, "/users?email=john@example.com")
+
+	// the hashes depend on the key
+	otherPolicy, err := Compile(policy.Model(), []byte("other key"))
+	assert.NilError(t, err)
+	trace = newTestTrace("application/json", `{"id":1}`, "")
+	otherPolicy.Redact(trace)
+	assert.Assert(t, string(trace.Request.Common.Body) != `{"id":"hmac-sha256:6da91fb91517be1f5cdcf3af91d7d40c717dd638a306157606fb2e584f7ae926"}`)
+}
+
+func TestPolicy_RedactJSON(t *testing.T) {
+	policy, err := Compile(&models.RedactionPolicy{
+		BodyFields: []string{"$.password"},
+		Patterns:   []string{`\d{4}-\d{4}`},
+	}, nil)
+	assert.NilError(t, err)
+
+	assert.Equal(t, policy.RedactJSON(`{"password":"a","card":"1234-5678"}`), `{"card":"[REDACTED]","password":"[REDACTED]"}`)
+	assert.Equal(t, policy.RedactJSON(`{"name":"a"}`), `{"name":"a"}`)
+}
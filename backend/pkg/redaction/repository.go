@@ -0,0 +1,170 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+const (
+	// defaultPolicyKey is the cache key of the default policy, trace source IDs being unsigned.
+	defaultPolicyKey = -1
+	// generatedHashKeySize is the size of the hash key generated when none is configured, the block size of SHA-256.
+	generatedHashKeySize = 64
+)
+
+// Repository holds the redaction policies, the default one and the ones set per trace source.
+// They are stored in the database and cached.
+type Repository struct {
+	dbHandler database.Database
+	hashKey   []byte
+
+	lock sync.Mutex
+	// nil policies are cached for the trace sources without their own policy
+	policies map[int64]*Policy
+}
+
+// NewRepository returns a repository whose policies hash the values with the hash key of the deployment. A key is
+// generated when hashKey is empty, the hashed values then change on each restart.
+func NewRepository(dbHandler database.Database, hashKey string) (*Repository, error) {
+	key := []byte(hashKey)
+	if len(key) == 0 {
+		log.Warnf("No redaction hash key is configured, generating one: the hashed values won't match across restarts")
+		key = make([]byte, generatedHashKeySize)
+		if _, err := rand.Read(key); err != nil {
+			return nil, fmt.Errorf("failed to generate redaction hash key: %v", err)
+		}
+	}
+
+	return &Repository{
+		dbHandler: dbHandler,
+		hashKey:   key,
+		policies:  map[int64]*Policy{},
+	}, nil
+}
+
+// Compile compiles a policy with the hash key of the repository.
+func (r *Repository) Compile(model *models.RedactionPolicy) (*Policy, error) {
+	return Compile(model, r.hashKey)
+}
+
+// Get returns the policy applied to the traces of a trace source: its own policy if set, the default one
+// otherwise. nil is returned when no policy is set.
+func (r *Repository) Get(traceSourceID uint) (*Policy, error) {
+	policy, err := r.GetTraceSource(traceSourceID)
+	if err != nil || policy != nil {
+		return policy, err
+	}
+	return r.GetDefault()
+}
+
+// GetDefault returns the default policy, or nil if not set.
+func (r *Repository) GetDefault() (*Policy, error) {
+	return r.get(nil)
+}
+
+// GetTraceSource returns the own policy of a trace source, or nil if not set.
+func (r *Repository) GetTraceSource(traceSourceID uint) (*Policy, error) {
+	return r.get(&traceSourceID)
+}
+
+func (r *Repository) SetDefault(policy *Policy) error {
+	return r.set(nil, policy)
+}
+
+func (r *Repository) SetTraceSource(traceSourceID uint, policy *Policy) error {
+	return r.set(&traceSourceID, policy)
+}
+
+// DeleteTraceSource deletes the own policy of a trace source, the default policy applies to it again.
+func (r *Repository) DeleteTraceSource(traceSourceID uint) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if err := r.dbHandler.RedactionPoliciesTable().Delete(&traceSourceID); err != nil {
+		return fmt.Errorf("failed to delete redaction policy: %v", err)
+	}
+	r.policies[int64(traceSourceID)] = nil
+	return nil
+}
+
+// Forget drops the cached policy of a trace source, to be called when the trace source is deleted.
+func (r *Repository) Forget(traceSourceID uint) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.policies, int64(traceSourceID))
+}
+
+func cacheKey(traceSourceID *uint) int64 {
+	if traceSourceID == nil {
+		return defaultPolicyKey
+	}
+	return int64(*traceSourceID)
+}
+
+func (r *Repository) get(traceSourceID *uint) (*Policy, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if policy, ok := r.policies[cacheKey(traceSourceID)]; ok {
+		return policy, nil
+	}
+
+	var policy *Policy
+	dbPolicy, err := r.dbHandler.RedactionPoliciesTable().Get(traceSourceID)
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+	case err != nil:
+		return nil, fmt.Errorf("failed to get redaction policy: %v", err)
+	default:
+		var model models.RedactionPolicy
+		if err := json.Unmarshal([]byte(dbPolicy.Policy), &model); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal stored redaction policy: %v", err)
+		}
+		if policy, err = r.Compile(&model); err != nil {
+			return nil, fmt.Errorf("failed to compile stored redaction policy: %v", err)
+		}
+	}
+
+	r.policies[cacheKey(traceSourceID)] = policy
+	return policy, nil
+}
+
+func (r *Repository) set(traceSourceID *uint, policy *Policy) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	serialized, err := json.Marshal(policy.Model())
+	if err != nil {
+		return fmt.Errorf("failed to marshal redaction policy: %v", err)
+	}
+	if err := r.dbHandler.RedactionPoliciesTable().Set(traceSourceID, string(serialized)); err != nil {
+		return fmt.Errorf("failed to store redaction policy: %v", err)
+	}
+	r.policies[cacheKey(traceSourceID)] = policy
+	return nil
+}
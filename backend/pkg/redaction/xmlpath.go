@@ -0,0 +1,145 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// xmlPathStep is an element of an XML path, /name for a child and //name for a descendant, * matching any name.
+type xmlPathStep struct {
+	name       string
+	descendant bool
+}
+
+func parseXMLPath(path string) ([]xmlPathStep, error) {
+	if !strings.HasPrefix(path, "/") {
+		return nil, fmt.Errorf("XML path must start with /")
+	}
+
+	var steps []xmlPathStep
+	rest := path
+	for rest != "" {
+		var step xmlPathStep
+		if strings.HasPrefix(rest, "//") {
+			step.descendant = true
+			rest = rest[2:]
+		} else {
+			rest = rest[1:]
+		}
+		if end := strings.Index(rest, "/"); end >= 0 {
+			step.name, rest = rest[:end], rest[end:]
+		} else {
+			step.name, rest = rest, ""
+		}
+		if step.name == "" || strings.ContainsAny(step.name, "@[]") {
+			return nil, fmt.Errorf("invalid element name %q in XML path, only elements are supported", step.name)
+		}
+		steps = append(steps, step)
+	}
+
+	return steps, nil
+}
+
+// matchXMLPath returns whether the steps select the element of the given path (the local names from the root).
+func matchXMLPath(steps []xmlPathStep, names []string) bool {
+	if len(steps) == 0 {
+		return len(names) == 0
+	}
+	if len(names) == 0 {
+		return false
+	}
+	step := steps[0]
+	if (step.name == "*" || step.name == names[0]) && matchXMLPath(steps[1:], names[1:]) {
+		return true
+	}
+	return step.descendant && matchXMLPath(steps, names[1:])
+}
+
+// redactXMLPaths replaces the content of the elements selected by the paths. The document is spliced instead of
+// re-encoded to keep it untouched apart from the redacted elements.
+func redactXMLPaths(body []byte, paths [][]xmlPathStep, redact func([]byte) []byte) ([]byte, error) {
+	type contentRange struct {
+		start, end int64
+	}
+
+	decoder := xml.NewDecoder(bytes.NewReader(body))
+	var names []string
+	var ranges []contentRange
+	redactedDepth := 0
+	for {
+		offset := decoder.InputOffset()
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse XML: %v", err)
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			names = append(names, t.Name.Local)
+			if redactedDepth == 0 && matchAnyXMLPath(paths, names) {
+				redactedDepth = len(names)
+				ranges = append(ranges, contentRange{start: decoder.InputOffset()})
+			}
+		case xml.EndElement:
+			if len(names) == 0 {
+				return nil, fmt.Errorf("failed to parse XML: unexpected end element %s", t.Name.Local)
+			}
+			if redactedDepth == len(names) {
+				ranges[len(ranges)-1].end = offset
+				redactedDepth = 0
+			}
+			names = names[:len(names)-1]
+		}
+	}
+	if len(names) != 0 {
+		return nil, fmt.Errorf("failed to parse XML: unexpected end of document")
+	}
+
+	var ret bytes.Buffer
+	var last int64
+	for _, r := range ranges {
+		// self-closing elements have no content
+		if r.end <= r.start {
+			continue
+		}
+		ret.Write(body[last:r.start])
+		if err := xml.EscapeText(&ret, redact(body[r.start:r.end])); err != nil {
+			return nil, fmt.Errorf("failed to escape redacted value: %v", err)
+		}
+		last = r.end
+	}
+	ret.Write(body[last:])
+
+	return ret.Bytes(), nil
+}
+
+func matchAnyXMLPath(paths [][]xmlPathStep, names []string) bool {
+	for _, path := range paths {
+		if matchXMLPath(path, names) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redaction
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestMatchXMLPath(t *testing.T) {
+	tests := []struct {
+		path  string
+		names []string
+		want  bool
+	}{
+		{path: "/user/password", names: []string{"user", "password"}, want: true},
+		{path: "/user/password", names: []string{"user"}, want: false},
+		{path: "/user/password", names: []string{"user", "profile", "password"}, want: false},
+		{path: "/user//password", names: []string{"user", "profile", "password"}, want: true},
+		{path: "//password", names: []string{"password"}, want: true},
+		{path: "//password", names: []string{"users", "user", "password"}, want: true},
+		{path: "/*/password", names: []string{"admin", "password"}, want: true},
+		{path: "/*/password", names: []string{"password"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			steps, err := parseXMLPath(tt.path)
+			assert.NilError(t, err)
+			assert.Equal(t, matchXMLPath(steps, tt.names), tt.want)
+		})
+	}
+}
+
+func TestParseXMLPath_errors(t *testing.T) {
+	for _, path := range []string{"user/password", "/user/", "/user/@password", "/users/user[1]"} {
+		_, err := parseXMLPath(path)
+		assert.Assert(t, err != nil, path)
+	}
+}
+
+func TestRedactXMLPaths(t *testing.T) {
+	steps, err := parseXMLPath("//password")
+	assert.NilError(t, err)
+	mask := func([]byte) []byte { return []byte(MaskedValue) }
+
+	body := `<?xml version="1.0"?>
+<users><user id="1"><name>a</name><password>s3cr&amp;t</password></user><user><password/></user><password><v>1</v></password></users>`
+	got, err := redactXMLPaths([]byte(body), [][]xmlPathStep{steps}, mask)
+	assert.NilError(t, err)
+	assert.Equal(t, string(got), `<?xml version="1.0"?>
+<users><user id="1"><name>a</name><password>[REDACTED]</password></user><user><password/></user><password>[REDACTED]</password></users>`)
+
+	_, err = redactXMLPaths([]byte("<users><password>a</password>"), [][]xmlPathStep{steps}, mask)
+	assert.Assert(t, err != nil)
+}
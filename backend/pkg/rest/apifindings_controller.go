@@ -0,0 +1,51 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func (s *Server) GetAPIFindings(params operations.GetAPIFindingsParams) middleware.Responder {
+	var apiFindings []*models.APIFinding
+
+	apiFindingsFromDB, total, err := s.dbHandler.APIFindingsTable().GetAPIFindingsAndTotal(params)
+	if err != nil {
+		log.Error(err)
+		return operations.NewGetAPIFindingsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	log.Debugf("GetAPIFindings controller was invoked. params=%+v, total=%+v", params, total)
+
+	for i := range apiFindingsFromDB {
+		apiFindings = append(apiFindings, _database.APIFindingFromDB(&apiFindingsFromDB[i]))
+	}
+
+	return operations.NewGetAPIFindingsOK().WithPayload(
+		&operations.GetAPIFindingsOKBody{
+			Items: apiFindings,
+			Total: &total,
+		})
+}
@@ -0,0 +1,66 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+)
+
+func (s *Server) PutAPIInventoryAPIIDClassificationOverride(params operations.PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+	err := s.dbHandler.APIInventoryTable().SetClassificationOverride(params.APIID, *params.Body.Classification)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return operations.NewPutAPIInventoryAPIIDClassificationOverrideNotFound().WithPayload(&models.APIResponse{
+			Message: "API not found",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to set classification override: %v", err)
+		return operations.NewPutAPIInventoryAPIIDClassificationOverrideDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutAPIInventoryAPIIDClassificationOverrideOK().WithPayload(&models.SuccessResponse{
+		Message: "Success",
+	})
+}
+
+func (s *Server) DeleteAPIInventoryAPIIDClassificationOverride(params operations.DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+	err := s.dbHandler.APIInventoryTable().SetClassificationOverride(params.APIID, "")
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return operations.NewDeleteAPIInventoryAPIIDClassificationOverrideNotFound().WithPayload(&models.APIResponse{
+			Message: "API not found",
+		})
+	}
+	if err != nil {
+		log.Errorf("Failed to delete classification override: %v", err)
+		return operations.NewDeleteAPIInventoryAPIIDClassificationOverrideDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteAPIInventoryAPIIDClassificationOverrideOK().WithPayload(&models.SuccessResponse{
+		Message: "Success",
+	})
+}
@@ -95,8 +95,26 @@ func (s *Server) GetAPIInventory(params operations.GetAPIInventoryParams) middle
 
 	log.Debugf("GetAPIInventory controller was invoked. params=%+v, apiInventoryFromDB=%+v, total=%+v", params, apiInventoryFromDB, total)
 
+	apiIDs := make([]uint, 0, len(apiInventoryFromDB))
 	for i := range apiInventoryFromDB {
-		apiInventory = append(apiInventory, _database.APIInfoFromDB(&apiInventoryFromDB[i]))
+		apiIDs = append(apiIDs, apiInventoryFromDB[i].ID)
+	}
+	findingsCounts, err := s.dbHandler.APIFindingsTable().CountBySeverity(apiIDs)
+	if err != nil {
+		log.Error(err)
+		return operations.NewGetAPIInventoryDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+	findingsSummaries := _database.APIFindingsSummariesFromDB(findingsCounts)
+
+	for i := range apiInventoryFromDB {
+		apiInfo := _database.APIInfoFromDB(&apiInventoryFromDB[i])
+		apiInfo.FindingsSummary = findingsSummaries[apiInventoryFromDB[i].ID]
+		if apiInfo.FindingsSummary == nil {
+			apiInfo.FindingsSummary = &models.APIFindingsSummary{}
+		}
+		apiInventory = append(apiInventory, apiInfo)
 	}
 
 	return operations.NewGetAPIInventoryOK().WithPayload(
@@ -44,6 +44,7 @@ func (s *Server) DeleteAPIInventoryAPIIDSpecsProvidedSpec(params operations.Dele
 		log.Errorf("Failed to delete provided spec with api id: %v from DB. %v", params.APIID, err)
 		return operations.NewDeleteAPIInventoryAPIIDSpecsProvidedSpecDefault(http.StatusInternalServerError)
 	}
+	s.apiSpecsChanged(uint(params.APIID))
 
 	return operations.NewDeleteAPIInventoryAPIIDSpecsProvidedSpecOK().WithPayload(&models.SuccessResponse{
 		Message: "Success",
@@ -67,6 +68,7 @@ func (s *Server) DeleteAPIInventoryAPIIDSpecsReconstructedSpec(params operations
 		log.Errorf("Failed to delete reconstructed spec with api id: %v from DB. %v", params.APIID, err)
 		return operations.NewDeleteAPIInventoryAPIIDSpecsReconstructedSpecDefault(http.StatusInternalServerError)
 	}
+	s.apiSpecsChanged(uint(params.APIID))
 
 	return operations.NewDeleteAPIInventoryAPIIDSpecsReconstructedSpecOK().WithPayload(&models.SuccessResponse{
 		Message: "Success",
@@ -0,0 +1,71 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/graphql"
+)
+
+func (s *Server) GetAPIInventoryAPIIDGraphqlOperations(params operations.GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder {
+	usages, err := s.dbHandler.APIEventsTable().GetGraphQLOperationsUsage(params.APIID, time.Time(params.StartTime), time.Time(params.EndTime))
+	if err != nil {
+		log.Errorf("Failed to get GraphQL operations usage: %v", err)
+		return operations.NewGetAPIInventoryAPIIDGraphqlOperationsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewGetAPIInventoryAPIIDGraphqlOperationsOK().WithPayload(usages)
+}
+
+func (s *Server) GetAPIInventoryAPIIDGraphqlSchema(params operations.GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder {
+	schemaDB, err := s.dbHandler.GraphQLSchemasTable().Get(uint(params.APIID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewGetAPIInventoryAPIIDGraphqlSchemaNotFound().WithPayload(&models.APIResponse{
+				Message: "No GraphQL traffic for this API",
+			})
+		}
+		log.Errorf("Failed to get GraphQL schema: %v", err)
+		return operations.NewGetAPIInventoryAPIIDGraphqlSchemaDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	schema := graphql.NewSchema()
+	if err := json.Unmarshal([]byte(schemaDB.Schema), schema); err != nil {
+		log.Errorf("Failed to unmarshal GraphQL schema: %v", err)
+		return operations.NewGetAPIInventoryAPIIDGraphqlSchemaDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	sdl := schema.SDL()
+	return operations.NewGetAPIInventoryAPIIDGraphqlSchemaOK().WithPayload(&models.GraphQLSchema{
+		Sdl: &sdl,
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/grpcapi"
+)
+
+func (s *Server) GetAPIInventoryAPIIDGrpcMethods(params operations.GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder {
+	usages, err := s.dbHandler.APIEventsTable().GetGRPCMethodsUsage(params.APIID, time.Time(params.StartTime), time.Time(params.EndTime))
+	if err != nil {
+		log.Errorf("Failed to get gRPC methods usage: %v", err)
+		return operations.NewGetAPIInventoryAPIIDGrpcMethodsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	descriptors, err := s.grpcDescriptors.Get(uint(params.APIID))
+	if err != nil {
+		log.Errorf("Failed to get gRPC descriptors: %v", err)
+		return operations.NewGetAPIInventoryAPIIDGrpcMethodsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewGetAPIInventoryAPIIDGrpcMethodsOK().WithPayload(addGRPCMethodsDescriptors(usages, descriptors))
+}
+
+// addGRPCMethodsDescriptors sets the types of the called methods and adds the methods that weren't called.
+func addGRPCMethodsDescriptors(usages []*models.GrpcMethodUsage, descriptors *grpcapi.Descriptors) []*models.GrpcMethodUsage {
+	if descriptors == nil {
+		return usages
+	}
+
+	called := make(map[*grpcapi.Method]bool, len(usages))
+	for _, usage := range usages {
+		method := descriptors.Method(usage.Service, usage.Method)
+		if method == nil {
+			continue
+		}
+		called[method] = true
+		setGRPCMethodDescriptor(usage, method)
+	}
+	for _, method := range descriptors.Methods() {
+		if called[method] {
+			continue
+		}
+		usage := &models.GrpcMethodUsage{
+			Service: method.Service,
+			Method:  method.Name,
+		}
+		setGRPCMethodDescriptor(usage, method)
+		usages = append(usages, usage)
+	}
+
+	return usages
+}
+
+func setGRPCMethodDescriptor(usage *models.GrpcMethodUsage, method *grpcapi.Method) {
+	usage.RequestType = method.RequestType
+	usage.ResponseType = method.ResponseType
+	usage.ClientStreaming = method.ClientStreaming
+	usage.ServerStreaming = method.ServerStreaming
+}
+
+func (s *Server) PutAPIInventoryAPIIDGrpcDescriptorSet(params operations.PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+	descriptors, err := grpcapi.ParseDescriptorSet(*params.Body.DescriptorSet)
+	if err != nil {
+		log.Errorf("Invalid descriptor set: %v", err)
+		return operations.NewPutAPIInventoryAPIIDGrpcDescriptorSetBadRequest().WithPayload(&models.APIResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.grpcDescriptors.Set(uint(params.APIID), descriptors); err != nil {
+		log.Errorf("Failed to set descriptor set: %v", err)
+		return operations.NewPutAPIInventoryAPIIDGrpcDescriptorSetDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutAPIInventoryAPIIDGrpcDescriptorSetOK().WithPayload(&models.GrpcServices{
+		Services: descriptors.Services(),
+	})
+}
+
+func (s *Server) DeleteAPIInventoryAPIIDGrpcDescriptorSet(params operations.DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+	if err := s.grpcDescriptors.Delete(uint(params.APIID)); err != nil {
+		log.Errorf("Failed to delete descriptor set: %v", err)
+		return operations.NewDeleteAPIInventoryAPIIDGrpcDescriptorSetDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteAPIInventoryAPIIDGrpcDescriptorSetOK().WithPayload(&models.SuccessResponse{
+		Message: "Success",
+	})
+}
+
+func (s *Server) GetAPIEventsEventIDGrpcMessages(params operations.GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder {
+	event, err := s.dbHandler.APIEventsTable().GetAPIEventGRPCMessages(params.EventID)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Errorf("Failed to get gRPC messages: %v", err)
+		return operations.NewGetAPIEventsEventIDGrpcMessagesDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+	if event == nil || (event.GRPCRequestMessages == "" && event.GRPCResponseMessages == "") {
+		return operations.NewGetAPIEventsEventIDGrpcMessagesNotFound().WithPayload(&models.APIResponse{
+			Message: "The messages of the event were not decoded",
+		})
+	}
+
+	return operations.NewGetAPIEventsEventIDGrpcMessagesOK().WithPayload(&models.GrpcMessages{
+		RequestMessages:  event.GRPCRequestMessages,
+		ResponseMessages: event.GRPCResponseMessages,
+	})
+}
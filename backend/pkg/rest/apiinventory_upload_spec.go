@@ -81,6 +81,7 @@ func (s *Server) PutAPIInventoryAPIIDSpecsProvidedSpec(params operations.PutAPII
 		}
 		return operations.NewPutAPIInventoryAPIIDSpecsProvidedSpecDefault(http.StatusInternalServerError)
 	}
+	s.apiSpecsChanged(uint(params.APIID))
 
 	return operations.NewPutAPIInventoryAPIIDSpecsProvidedSpecCreated().
 		WithPayload(&models.RawSpec{RawSpec: params.Body.RawSpec})
@@ -0,0 +1,82 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/har"
+	pluginsmodels "github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func (s *Server) PostControlHarImport(params operations.PostControlHarImportParams) middleware.Responder {
+	log.Debugf("PostControlHarImport controller was invoked")
+
+	// The archive is decoded generically by the generated server, re-encode it to decode it as a HAR.
+	rawHAR, err := json.Marshal(params.Body)
+	if err != nil {
+		log.Errorf("Failed to marshal HAR body: %v", err)
+		return operations.NewPostControlHarImportDefault(http.StatusInternalServerError)
+	}
+	archive, err := har.Parse(bytes.NewReader(rawHAR))
+	if err != nil {
+		return operations.NewPostControlHarImportBadRequest().WithPayload(&models.APIResponse{Message: err.Error()})
+	}
+
+	var traceSource *models.TraceSource
+	if params.TraceSourceID != nil {
+		uid, _ := uuid.Parse(params.TraceSourceID.String())
+		if uid != uuid.Nil {
+			dbSource, err := s.dbHandler.TraceSourcesTable().GetTraceSource(uid)
+			if err != nil {
+				log.Errorf("Failed to get Trace Source: %v", err)
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return operations.NewPostControlHarImportNotFound().WithPayload(&models.APIResponse{Message: err.Error()})
+				}
+				return operations.NewPostControlHarImportDefault(http.StatusInternalServerError)
+			}
+			traceSource = &models.TraceSource{
+				ID:          int64(dbSource.ID),
+				Name:        &dbSource.Name,
+				Description: dbSource.Description,
+			}
+		}
+	}
+
+	result := har.Import(params.HTTPRequest.Context(), archive, func(ctx context.Context, trace *pluginsmodels.Telemetry) error {
+		return s.traceHandleFunc(ctx, trace, traceSource)
+	})
+	log.Infof("Imported HAR archive: %d entries imported, %d failed", result.Imported, result.Failed)
+
+	imported := int64(result.Imported)
+	failed := int64(result.Failed)
+	return operations.NewPostControlHarImportOK().WithPayload(&models.HarImportResult{
+		Imported: &imported,
+		Failed:   &failed,
+		Errors:   result.Errors,
+	})
+}
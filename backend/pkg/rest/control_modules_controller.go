@@ -0,0 +1,66 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/modules"
+)
+
+func (s *Server) GetControlModules(params operations.GetControlModulesParams) middleware.Responder {
+	statuses := s.modulesManager.ModulesStatus()
+
+	payload := make([]*models.ModuleStatus, 0, len(statuses))
+	for i := range statuses {
+		payload = append(payload, moduleStatusToModel(&statuses[i]))
+	}
+
+	return operations.NewGetControlModulesOK().WithPayload(payload)
+}
+
+func (s *Server) PutControlModulesModuleName(params operations.PutControlModulesModuleNameParams) middleware.Responder {
+	status, err := s.modulesManager.SetModuleEnabled(params.ModuleName, *params.Body.Enabled)
+	if err != nil {
+		if errors.Is(err, modules.ErrModuleNotFound) {
+			return operations.NewPutControlModulesModuleNameNotFound().WithPayload(&models.APIResponse{
+				Message: "Module not found",
+			})
+		}
+		log.Errorf("Failed to set module state: %v", err)
+		return operations.NewPutControlModulesModuleNameDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlModulesModuleNameOK().WithPayload(moduleStatusToModel(status))
+}
+
+func moduleStatusToModel(status *modules.ModuleStatus) *models.ModuleStatus {
+	name := status.Name
+	enabled := status.Enabled
+	return &models.ModuleStatus{
+		Name:        &name,
+		Description: status.Description,
+		Enabled:     &enabled,
+	}
+}
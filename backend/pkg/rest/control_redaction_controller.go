@@ -0,0 +1,159 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	_database "github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func (s *Server) GetControlRedactionPolicy(_ operations.GetControlRedactionPolicyParams) middleware.Responder {
+	policy, err := s.redactionPolicies.GetDefault()
+	if err != nil {
+		log.Errorf("Failed to get default redaction policy: %v", err)
+		return operations.NewGetControlRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+	if policy == nil {
+		return operations.NewGetControlRedactionPolicyOK().WithPayload(&models.RedactionPolicy{})
+	}
+
+	return operations.NewGetControlRedactionPolicyOK().WithPayload(policy.Model())
+}
+
+func (s *Server) PutControlRedactionPolicy(params operations.PutControlRedactionPolicyParams) middleware.Responder {
+	policy, err := s.redactionPolicies.Compile(params.Body)
+	if err != nil {
+		log.Errorf("Invalid redaction policy: %v", err)
+		return operations.NewPutControlRedactionPolicyBadRequest().WithPayload(&models.APIResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.redactionPolicies.SetDefault(policy); err != nil {
+		log.Errorf("Failed to set default redaction policy: %v", err)
+		return operations.NewPutControlRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlRedactionPolicyOK().WithPayload(policy.Model())
+}
+
+func (s *Server) GetControlTraceSourcesTraceSourceIDRedactionPolicy(params operations.GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	traceSource, err := s.getTraceSource(params.TraceSourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound().WithPayload(&models.APIResponse{
+				Message: "Trace Source not found",
+			})
+		}
+		log.Errorf("Failed to get Trace Source: %v", err)
+		return operations.NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	policy, err := s.redactionPolicies.GetTraceSource(traceSource.ID)
+	if err != nil {
+		log.Errorf("Failed to get redaction policy: %v", err)
+		return operations.NewGetControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+	if policy == nil {
+		return operations.NewGetControlTraceSourcesTraceSourceIDRedactionPolicyNotFound().WithPayload(&models.APIResponse{
+			Message: "The Trace Source has no redaction policy, the default one applies",
+		})
+	}
+
+	return operations.NewGetControlTraceSourcesTraceSourceIDRedactionPolicyOK().WithPayload(policy.Model())
+}
+
+func (s *Server) PutControlTraceSourcesTraceSourceIDRedactionPolicy(params operations.PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	traceSource, err := s.getTraceSource(params.TraceSourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewPutControlTraceSourcesTraceSourceIDRedactionPolicyNotFound().WithPayload(&models.APIResponse{
+				Message: "Trace Source not found",
+			})
+		}
+		log.Errorf("Failed to get Trace Source: %v", err)
+		return operations.NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	policy, err := s.redactionPolicies.Compile(params.Body)
+	if err != nil {
+		log.Errorf("Invalid redaction policy: %v", err)
+		return operations.NewPutControlTraceSourcesTraceSourceIDRedactionPolicyBadRequest().WithPayload(&models.APIResponse{
+			Message: err.Error(),
+		})
+	}
+
+	if err := s.redactionPolicies.SetTraceSource(traceSource.ID, policy); err != nil {
+		log.Errorf("Failed to set redaction policy: %v", err)
+		return operations.NewPutControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlTraceSourcesTraceSourceIDRedactionPolicyOK().WithPayload(policy.Model())
+}
+
+func (s *Server) DeleteControlTraceSourcesTraceSourceIDRedactionPolicy(params operations.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+	traceSource, err := s.getTraceSource(params.TraceSourceID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNotFound().WithPayload(&models.APIResponse{
+				Message: "Trace Source not found",
+			})
+		}
+		log.Errorf("Failed to get Trace Source: %v", err)
+		return operations.NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	if err := s.redactionPolicies.DeleteTraceSource(traceSource.ID); err != nil {
+		log.Errorf("Failed to delete redaction policy: %v", err)
+		return operations.NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteControlTraceSourcesTraceSourceIDRedactionPolicyNoContent()
+}
+
+func (s *Server) getTraceSource(traceSourceID strfmt.UUID) (*_database.TraceSource, error) {
+	uid, err := uuid.Parse(traceSourceID.String())
+	if err != nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return s.dbHandler.TraceSourcesTable().GetTraceSource(uid)
+}
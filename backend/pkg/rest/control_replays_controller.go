@@ -0,0 +1,130 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/modules"
+)
+
+func (s *Server) GetControlReplays(params operations.GetControlReplaysParams) middleware.Responder {
+	replays := s.modulesManager.Replays()
+
+	payload := make([]*models.Replay, 0, len(replays))
+	for i := range replays {
+		payload = append(payload, replayToModel(&replays[i]))
+	}
+
+	return operations.NewGetControlReplaysOK().WithPayload(payload)
+}
+
+func (s *Server) PostControlReplays(params operations.PostControlReplaysParams) middleware.Responder {
+	replay, err := s.modulesManager.StartReplay(*params.Body.ModuleName, uint(*params.Body.APIID),
+		time.Time(*params.Body.StartTime), time.Time(*params.Body.EndTime))
+	if err != nil {
+		switch {
+		case errors.Is(err, modules.ErrModuleNotFound):
+			return operations.NewPostControlReplaysNotFound().WithPayload(&models.APIResponse{
+				Message: "Module not found",
+			})
+		case errors.Is(err, modules.ErrInvalidReplay):
+			return operations.NewPostControlReplaysBadRequest().WithPayload(&models.APIResponse{
+				Message: err.Error(),
+			})
+		}
+		log.Errorf("Failed to start replay: %v", err)
+		return operations.NewPostControlReplaysDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPostControlReplaysCreated().WithPayload(replayToModel(replay))
+}
+
+func (s *Server) GetControlReplaysReplayID(params operations.GetControlReplaysReplayIDParams) middleware.Responder {
+	replay, err := s.modulesManager.GetReplay(uint(params.ReplayID))
+	if err != nil {
+		if errors.Is(err, modules.ErrReplayNotFound) {
+			return operations.NewGetControlReplaysReplayIDNotFound().WithPayload(&models.APIResponse{
+				Message: "Replay not found",
+			})
+		}
+		log.Errorf("Failed to get replay: %v", err)
+		return operations.NewGetControlReplaysReplayIDDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewGetControlReplaysReplayIDOK().WithPayload(replayToModel(replay))
+}
+
+func (s *Server) DeleteControlReplaysReplayID(params operations.DeleteControlReplaysReplayIDParams) middleware.Responder {
+	replay, err := s.modulesManager.CancelReplay(uint(params.ReplayID))
+	if err != nil {
+		if errors.Is(err, modules.ErrReplayNotFound) {
+			return operations.NewDeleteControlReplaysReplayIDNotFound().WithPayload(&models.APIResponse{
+				Message: "Replay not found",
+			})
+		}
+		log.Errorf("Failed to cancel replay: %v", err)
+		return operations.NewDeleteControlReplaysReplayIDDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteControlReplaysReplayIDOK().WithPayload(replayToModel(replay))
+}
+
+func replayToModel(replay *modules.Replay) *models.Replay {
+	id := uint32(replay.ID)
+	moduleName := replay.ModuleName
+	apiID := uint32(replay.APIID)
+	startTime := strfmt.DateTime(replay.StartTime)
+	endTime := strfmt.DateTime(replay.EndTime)
+	status := models.ReplayStatus(replay.Status)
+	total := replay.Total
+	replayed := replay.Replayed
+	skipped := replay.Skipped
+	createdAt := strfmt.DateTime(replay.CreatedAt)
+
+	ret := &models.Replay{
+		ID:         &id,
+		ModuleName: &moduleName,
+		APIID:      &apiID,
+		StartTime:  &startTime,
+		EndTime:    &endTime,
+		Status:     &status,
+		Total:      &total,
+		Replayed:   &replayed,
+		Skipped:    &skipped,
+		Error:      replay.Error,
+		CreatedAt:  &createdAt,
+	}
+	if !replay.FinishedAt.IsZero() {
+		ret.FinishedAt = strfmt.DateTime(replay.FinishedAt)
+	}
+
+	return ret
+}
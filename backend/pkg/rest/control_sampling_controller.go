@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func (s *Server) GetControlSamplingRates(_ operations.GetControlSamplingRatesParams) middleware.Responder {
+	rates := s.samplingManager.GetSamplingRates()
+
+	payload := make([]*models.SamplingRate, 0, len(rates))
+	for _, rate := range rates {
+		payload = append(payload, samplingRateFromDB(rate))
+	}
+
+	return operations.NewGetControlSamplingRatesOK().WithPayload(payload)
+}
+
+func (s *Server) PutControlSamplingRates(params operations.PutControlSamplingRatesParams) middleware.Responder {
+	var maxEventsPerSecond float64
+	if params.Body.MaxEventsPerSecond != nil {
+		maxEventsPerSecond = *params.Body.MaxEventsPerSecond
+	}
+
+	if err := s.samplingManager.SetSamplingRate(uint(*params.Body.APIID), *params.Body.Component, *params.Body.Ratio, maxEventsPerSecond); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewPutControlSamplingRatesNotFound().WithPayload(&models.APIResponse{
+				Message: "API not found",
+			})
+		}
+		log.Errorf("Failed to set sampling rate: %v", err)
+		return operations.NewPutControlSamplingRatesDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlSamplingRatesOK().WithPayload(params.Body)
+}
+
+func (s *Server) DeleteControlSamplingRates(params operations.DeleteControlSamplingRatesParams) middleware.Responder {
+	if err := s.samplingManager.DeleteSamplingRate(uint(params.APIID), params.Component); err != nil {
+		log.Errorf("Failed to delete sampling rate: %v", err)
+		return operations.NewDeleteControlSamplingRatesDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteControlSamplingRatesNoContent()
+}
+
+func samplingRateFromDB(rate *database.TraceSamplingRate) *models.SamplingRate {
+	apiID := uint32(rate.APIID)
+	component := rate.Component
+	ratio := rate.Ratio
+	maxEventsPerSecond := rate.MaxEventsPerSecond
+
+	return &models.SamplingRate{
+		APIID:              &apiID,
+		Component:          &component,
+		Ratio:              &ratio,
+		MaxEventsPerSecond: &maxEventsPerSecond,
+	}
+}
@@ -111,6 +111,10 @@ func (s *Server) DeleteControlTraceSourcesTraceSourceID(params operations.Delete
 	log.Debugf("DeleteControlTraceSourcesTraceSourceID controller was invoked")
 
 	uid, _ := uuid.Parse(params.TraceSourceID.String())
+	// the redaction policy of the trace source is deleted with it, it must not stay cached
+	if dbSource, err := s.dbHandler.TraceSourcesTable().GetTraceSource(uid); err == nil {
+		defer s.redactionPolicies.Forget(dbSource.ID)
+	}
 	if err := s.dbHandler.TraceSourcesTable().DeleteTraceSource(uid); err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return operations.NewDeleteControlTraceSourcesTraceSourceIDNotFound()
@@ -0,0 +1,152 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/sampling"
+)
+
+func (s *Server) GetControlTracingEndpoints(params operations.GetControlTracingEndpointsParams) middleware.Responder {
+	var apiID *uint
+	if params.APIID != nil {
+		id := uint(*params.APIID)
+		apiID = &id
+	}
+	tracingEndpoints := s.samplingManager.GetTracingEndpoints(apiID)
+
+	payload := make([]*models.TracingEndpoints, 0, len(tracingEndpoints))
+	for i := range tracingEndpoints {
+		payload = append(payload, tracingEndpointsToModel(&tracingEndpoints[i]))
+	}
+
+	return operations.NewGetControlTracingEndpointsOK().WithPayload(payload)
+}
+
+func (s *Server) PutControlTracingEndpoints(params operations.PutControlTracingEndpointsParams) middleware.Responder {
+	apiID := *params.Body.APIID
+	apiInfo := &database.APIInfo{}
+	if err := s.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return operations.NewPutControlTracingEndpointsNotFound().WithPayload(&models.APIResponse{
+				Message: "API not found",
+			})
+		}
+		log.Errorf("Failed to get API %v: %v", apiID, err)
+		return operations.NewPutControlTracingEndpointsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	specsInfo, err := s.dbHandler.APIInventoryTable().GetAPISpecsInfo(apiID)
+	if err != nil {
+		log.Errorf("Failed to get specs of API %v: %v", apiID, err)
+		return operations.NewPutControlTracingEndpointsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	endpoints := make([]sampling.Endpoint, 0, len(params.Body.Endpoints))
+	for _, endpoint := range params.Body.Endpoints {
+		if endpoint == nil || endpoint.Path == nil {
+			continue
+		}
+		if !specHasEndpoint(specsInfo, endpoint.Method, *endpoint.Path) {
+			return operations.NewPutControlTracingEndpointsBadRequest().WithPayload(&models.APIResponse{
+				Message: fmt.Sprintf("Endpoint %s %s not found in the spec of the API", endpoint.Method, *endpoint.Path),
+			})
+		}
+		endpoints = append(endpoints, sampling.Endpoint{
+			Method: string(endpoint.Method),
+			Path:   *endpoint.Path,
+		})
+	}
+
+	if err := s.samplingManager.SetTracingEndpoints(*params.Body.Component, uint(apiID), endpoints); err != nil {
+		log.Errorf("Failed to set tracing endpoints: %v", err)
+		return operations.NewPutControlTracingEndpointsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlTracingEndpointsOK().WithPayload(params.Body)
+}
+
+func (s *Server) DeleteControlTracingEndpoints(params operations.DeleteControlTracingEndpointsParams) middleware.Responder {
+	if err := s.samplingManager.DeleteTracingEndpoints(params.Component, uint(params.APIID)); err != nil {
+		log.Errorf("Failed to delete tracing endpoints: %v", err)
+		return operations.NewDeleteControlTracingEndpointsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteControlTracingEndpointsNoContent()
+}
+
+// specHasEndpoint returns whether the path template (and the method, when set) is in the provided or the
+// reconstructed spec of the API. All the endpoints are accepted when the API has no spec yet.
+func specHasEndpoint(specsInfo *models.OpenAPISpecs, method models.HTTPMethod, path string) bool {
+	var specs []*models.SpecInfo
+	if specsInfo.ProvidedSpec != nil {
+		specs = append(specs, specsInfo.ProvidedSpec)
+	}
+	if specsInfo.ReconstructedSpec != nil {
+		specs = append(specs, specsInfo.ReconstructedSpec)
+	}
+	if len(specs) == 0 {
+		return true
+	}
+
+	for _, spec := range specs {
+		for _, tag := range spec.Tags {
+			for _, methodAndPath := range tag.MethodAndPathList {
+				if methodAndPath.Path == path && (method == "" || methodAndPath.Method == method) {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func tracingEndpointsToModel(tracingEndpoints *sampling.TracingEndpoints) *models.TracingEndpoints {
+	apiID := uint32(tracingEndpoints.APIID)
+	component := tracingEndpoints.Component
+
+	ret := &models.TracingEndpoints{
+		APIID:     &apiID,
+		Component: &component,
+		Endpoints: make([]*models.TracingEndpoint, 0, len(tracingEndpoints.Endpoints)),
+	}
+	for _, endpoint := range tracingEndpoints.Endpoints {
+		path := endpoint.Path
+		ret.Endpoints = append(ret.Endpoints, &models.TracingEndpoint{
+			Method: models.HTTPMethod(endpoint.Method),
+			Path:   &path,
+		})
+	}
+	return ret
+}
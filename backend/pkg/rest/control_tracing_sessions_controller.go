@@ -0,0 +1,110 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/api/server/restapi/operations"
+	"github.com/openclarity/apiclarity/backend/pkg/sampling"
+)
+
+func (s *Server) GetControlTracingSessions(params operations.GetControlTracingSessionsParams) middleware.Responder {
+	var apiID *uint
+	if params.APIID != nil {
+		id := uint(*params.APIID)
+		apiID = &id
+	}
+	sessions := s.samplingManager.GetTracingSessions(apiID)
+
+	now := time.Now()
+	payload := make([]*models.TracingSession, 0, len(sessions))
+	for i := range sessions {
+		payload = append(payload, tracingSessionToModel(&sessions[i], now))
+	}
+
+	return operations.NewGetControlTracingSessionsOK().WithPayload(payload)
+}
+
+func (s *Server) PutControlTracingSessions(params operations.PutControlTracingSessionsParams) middleware.Responder {
+	var limits sampling.TracingLimits
+	if params.Body.DurationSeconds != nil {
+		limits.Duration = time.Duration(*params.Body.DurationSeconds) * time.Second
+	}
+	if params.Body.MaxEvents != nil {
+		limits.MaxEvents = *params.Body.MaxEvents
+	}
+
+	session, err := s.samplingManager.SetTracingSessionLimits(*params.Body.Component, uint(*params.Body.APIID), limits)
+	if err != nil {
+		if errors.Is(err, sampling.ErrTracingSessionNotFound) {
+			return operations.NewPutControlTracingSessionsNotFound().WithPayload(&models.APIResponse{
+				Message: "Tracing session not found",
+			})
+		}
+		log.Errorf("Failed to set tracing session limits: %v", err)
+		return operations.NewPutControlTracingSessionsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewPutControlTracingSessionsOK().WithPayload(tracingSessionToModel(session, time.Now()))
+}
+
+func (s *Server) DeleteControlTracingSessions(params operations.DeleteControlTracingSessionsParams) middleware.Responder {
+	if err := s.samplingManager.RemoveHostToTrace(params.Component, params.APIID); err != nil {
+		log.Errorf("Failed to stop tracing session: %v", err)
+		return operations.NewDeleteControlTracingSessionsDefault(http.StatusInternalServerError).WithPayload(&models.APIResponse{
+			Message: "Oops",
+		})
+	}
+
+	return operations.NewDeleteControlTracingSessionsNoContent()
+}
+
+func tracingSessionToModel(session *sampling.TracingSession, now time.Time) *models.TracingSession {
+	apiID := uint32(session.APIID)
+	component := session.Component
+	startedAt := strfmt.DateTime(session.StartedAt)
+	maxEvents := session.MaxEvents
+	eventsCount := session.EventsCount
+
+	ret := &models.TracingSession{
+		APIID:       &apiID,
+		APIName:     session.APIName,
+		Component:   &component,
+		StartedAt:   &startedAt,
+		MaxEvents:   &maxEvents,
+		EventsCount: &eventsCount,
+	}
+
+	remainingTime, remainingEvents := session.Remaining(now)
+	if session.ExpiresAt != nil {
+		ret.ExpiresAt = strfmt.DateTime(*session.ExpiresAt)
+		ret.RemainingSeconds = int64(remainingTime / time.Second)
+	}
+	if remainingEvents >= 0 {
+		ret.RemainingEvents = remainingEvents
+	}
+	return ret
+}
@@ -30,7 +30,18 @@ func (s *Server) GetFeatures(params operations.GetFeaturesParams) middleware.Res
 		Features: []*models.APIClarityFeature{},
 	}
 
+	// the disabled modules are not available features
+	disabled := map[string]bool{}
+	if s.modulesManager != nil {
+		for _, status := range s.modulesManager.ModulesStatus() {
+			disabled[status.Name] = !status.Enabled
+		}
+	}
+
 	for _, info := range s.features {
+		if disabled[info.Name] {
+			continue
+		}
 		hostsToTrace := models.HostsToTraceForComponent{
 			Component:         info.Name,
 			TraceSourcesHosts: []*models.HostsToTraceForTraceSource{},
@@ -96,6 +96,7 @@ func (s *Server) PostAPIInventoryReviewIDApprovedReview(params operations.PostAP
 		log.Errorf("Failed to save reconstructed API spec to db: %v", err)
 		return operations.NewPostAPIInventoryReviewIDApprovedReviewDefault(http.StatusInternalServerError)
 	}
+	s.apiSpecsChanged(review.APIInfoID)
 
 	// update all the API events corresponding to the APIEventsPaths in the approved review
 	go func() {
@@ -28,8 +28,11 @@ import (
 	"github.com/openclarity/apiclarity/api/server/restapi/operations"
 	"github.com/openclarity/apiclarity/backend/pkg/common"
 	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/grpcapi"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
 	"github.com/openclarity/apiclarity/backend/pkg/modules"
 	_notifier "github.com/openclarity/apiclarity/backend/pkg/notifier"
+	"github.com/openclarity/apiclarity/backend/pkg/redaction"
 	"github.com/openclarity/apiclarity/backend/pkg/sampling"
 	"github.com/openclarity/apiclarity/backend/pkg/speculators"
 )
@@ -42,6 +45,9 @@ type Server struct {
 	features             []modules.ModuleInfo
 	notifier             *_notifier.Notifier
 	samplingManager      *sampling.TraceSamplingManager
+	traceHandleFunc      ingestion.HandleTraceFunc
+	grpcDescriptors      *grpcapi.Repository
+	redactionPolicies    *redaction.Repository
 	needsTraceSourceAuth bool
 }
 
@@ -58,6 +64,10 @@ type ServerConfig struct {
 	NeedsTraceSourceAuth  bool
 	Notifier              *_notifier.Notifier
 	SamplingManager       *sampling.TraceSamplingManager
+	// TraceHandleFunc handles the traffic imported through the REST API (HAR archives).
+	TraceHandleFunc   ingestion.HandleTraceFunc
+	GRPCDescriptors   *grpcapi.Repository
+	RedactionPolicies *redaction.Repository
 }
 
 func CreateRESTServer(config *ServerConfig) (*Server, error) {
@@ -68,6 +78,9 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 		features:             config.Features,
 		notifier:             config.Notifier,
 		samplingManager:      config.SamplingManager,
+		traceHandleFunc:      config.TraceHandleFunc,
+		grpcDescriptors:      config.GRPCDescriptors,
+		redactionPolicies:    config.RedactionPolicies,
 		needsTraceSourceAuth: config.NeedsTraceSourceAuth,
 	}
 
@@ -94,6 +107,10 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 		return s.GetAPIEventsEventIDProvidedSpecDiff(params)
 	})
 
+	api.GetAPIFindingsHandler = operations.GetAPIFindingsHandlerFunc(func(params operations.GetAPIFindingsParams) middleware.Responder {
+		return s.GetAPIFindings(params)
+	})
+
 	api.GetAPIInventoryHandler = operations.GetAPIInventoryHandlerFunc(func(params operations.GetAPIInventoryParams) middleware.Responder {
 		return s.GetAPIInventory(params)
 	})
@@ -146,6 +163,38 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 		return s.GetAPIInventoryAPIIDAPIInfo(params)
 	})
 
+	api.GetAPIInventoryAPIIDGraphqlOperationsHandler = operations.GetAPIInventoryAPIIDGraphqlOperationsHandlerFunc(func(params operations.GetAPIInventoryAPIIDGraphqlOperationsParams) middleware.Responder {
+		return s.GetAPIInventoryAPIIDGraphqlOperations(params)
+	})
+
+	api.GetAPIInventoryAPIIDGraphqlSchemaHandler = operations.GetAPIInventoryAPIIDGraphqlSchemaHandlerFunc(func(params operations.GetAPIInventoryAPIIDGraphqlSchemaParams) middleware.Responder {
+		return s.GetAPIInventoryAPIIDGraphqlSchema(params)
+	})
+
+	api.GetAPIInventoryAPIIDGrpcMethodsHandler = operations.GetAPIInventoryAPIIDGrpcMethodsHandlerFunc(func(params operations.GetAPIInventoryAPIIDGrpcMethodsParams) middleware.Responder {
+		return s.GetAPIInventoryAPIIDGrpcMethods(params)
+	})
+
+	api.PutAPIInventoryAPIIDGrpcDescriptorSetHandler = operations.PutAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc(func(params operations.PutAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+		return s.PutAPIInventoryAPIIDGrpcDescriptorSet(params)
+	})
+
+	api.DeleteAPIInventoryAPIIDGrpcDescriptorSetHandler = operations.DeleteAPIInventoryAPIIDGrpcDescriptorSetHandlerFunc(func(params operations.DeleteAPIInventoryAPIIDGrpcDescriptorSetParams) middleware.Responder {
+		return s.DeleteAPIInventoryAPIIDGrpcDescriptorSet(params)
+	})
+
+	api.PutAPIInventoryAPIIDClassificationOverrideHandler = operations.PutAPIInventoryAPIIDClassificationOverrideHandlerFunc(func(params operations.PutAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+		return s.PutAPIInventoryAPIIDClassificationOverride(params)
+	})
+
+	api.DeleteAPIInventoryAPIIDClassificationOverrideHandler = operations.DeleteAPIInventoryAPIIDClassificationOverrideHandlerFunc(func(params operations.DeleteAPIInventoryAPIIDClassificationOverrideParams) middleware.Responder {
+		return s.DeleteAPIInventoryAPIIDClassificationOverride(params)
+	})
+
+	api.GetAPIEventsEventIDGrpcMessagesHandler = operations.GetAPIEventsEventIDGrpcMessagesHandlerFunc(func(params operations.GetAPIEventsEventIDGrpcMessagesParams) middleware.Responder {
+		return s.GetAPIEventsEventIDGrpcMessages(params)
+	})
+
 	api.GetAPIInventoryAPIIDFromHostAndPortHandler = operations.GetAPIInventoryAPIIDFromHostAndPortHandlerFunc(func(params operations.GetAPIInventoryAPIIDFromHostAndPortParams) middleware.Responder {
 		return s.GetAPIInventoryAPIIDFromHostAndPort(params)
 	})
@@ -174,6 +223,10 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 		return s.PostControlNewDiscoveredAPIs(params)
 	})
 
+	api.PostControlHarImportHandler = operations.PostControlHarImportHandlerFunc(func(params operations.PostControlHarImportParams) middleware.Responder {
+		return s.PostControlHarImport(params)
+	})
+
 	api.GetControlTraceSourcesHandler = operations.GetControlTraceSourcesHandlerFunc(func(params operations.GetControlTraceSourcesParams) middleware.Responder {
 		return s.GetControlTraceSources(params)
 	})
@@ -190,6 +243,86 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 		return s.DeleteControlTraceSourcesTraceSourceID(params)
 	})
 
+	api.GetControlRedactionPolicyHandler = operations.GetControlRedactionPolicyHandlerFunc(func(params operations.GetControlRedactionPolicyParams) middleware.Responder {
+		return s.GetControlRedactionPolicy(params)
+	})
+
+	api.PutControlRedactionPolicyHandler = operations.PutControlRedactionPolicyHandlerFunc(func(params operations.PutControlRedactionPolicyParams) middleware.Responder {
+		return s.PutControlRedactionPolicy(params)
+	})
+
+	api.GetControlTraceSourcesTraceSourceIDRedactionPolicyHandler = operations.GetControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params operations.GetControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+		return s.GetControlTraceSourcesTraceSourceIDRedactionPolicy(params)
+	})
+
+	api.PutControlTraceSourcesTraceSourceIDRedactionPolicyHandler = operations.PutControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params operations.PutControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+		return s.PutControlTraceSourcesTraceSourceIDRedactionPolicy(params)
+	})
+
+	api.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandler = operations.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyHandlerFunc(func(params operations.DeleteControlTraceSourcesTraceSourceIDRedactionPolicyParams) middleware.Responder {
+		return s.DeleteControlTraceSourcesTraceSourceIDRedactionPolicy(params)
+	})
+
+	api.GetControlSamplingRatesHandler = operations.GetControlSamplingRatesHandlerFunc(func(params operations.GetControlSamplingRatesParams) middleware.Responder {
+		return s.GetControlSamplingRates(params)
+	})
+
+	api.PutControlSamplingRatesHandler = operations.PutControlSamplingRatesHandlerFunc(func(params operations.PutControlSamplingRatesParams) middleware.Responder {
+		return s.PutControlSamplingRates(params)
+	})
+
+	api.DeleteControlSamplingRatesHandler = operations.DeleteControlSamplingRatesHandlerFunc(func(params operations.DeleteControlSamplingRatesParams) middleware.Responder {
+		return s.DeleteControlSamplingRates(params)
+	})
+
+	api.GetControlTracingSessionsHandler = operations.GetControlTracingSessionsHandlerFunc(func(params operations.GetControlTracingSessionsParams) middleware.Responder {
+		return s.GetControlTracingSessions(params)
+	})
+
+	api.PutControlTracingSessionsHandler = operations.PutControlTracingSessionsHandlerFunc(func(params operations.PutControlTracingSessionsParams) middleware.Responder {
+		return s.PutControlTracingSessions(params)
+	})
+
+	api.DeleteControlTracingSessionsHandler = operations.DeleteControlTracingSessionsHandlerFunc(func(params operations.DeleteControlTracingSessionsParams) middleware.Responder {
+		return s.DeleteControlTracingSessions(params)
+	})
+
+	api.GetControlTracingEndpointsHandler = operations.GetControlTracingEndpointsHandlerFunc(func(params operations.GetControlTracingEndpointsParams) middleware.Responder {
+		return s.GetControlTracingEndpoints(params)
+	})
+
+	api.PutControlTracingEndpointsHandler = operations.PutControlTracingEndpointsHandlerFunc(func(params operations.PutControlTracingEndpointsParams) middleware.Responder {
+		return s.PutControlTracingEndpoints(params)
+	})
+
+	api.DeleteControlTracingEndpointsHandler = operations.DeleteControlTracingEndpointsHandlerFunc(func(params operations.DeleteControlTracingEndpointsParams) middleware.Responder {
+		return s.DeleteControlTracingEndpoints(params)
+	})
+
+	api.GetControlModulesHandler = operations.GetControlModulesHandlerFunc(func(params operations.GetControlModulesParams) middleware.Responder {
+		return s.GetControlModules(params)
+	})
+
+	api.PutControlModulesModuleNameHandler = operations.PutControlModulesModuleNameHandlerFunc(func(params operations.PutControlModulesModuleNameParams) middleware.Responder {
+		return s.PutControlModulesModuleName(params)
+	})
+
+	api.GetControlReplaysHandler = operations.GetControlReplaysHandlerFunc(func(params operations.GetControlReplaysParams) middleware.Responder {
+		return s.GetControlReplays(params)
+	})
+
+	api.PostControlReplaysHandler = operations.PostControlReplaysHandlerFunc(func(params operations.PostControlReplaysParams) middleware.Responder {
+		return s.PostControlReplays(params)
+	})
+
+	api.GetControlReplaysReplayIDHandler = operations.GetControlReplaysReplayIDHandlerFunc(func(params operations.GetControlReplaysReplayIDParams) middleware.Responder {
+		return s.GetControlReplaysReplayID(params)
+	})
+
+	api.DeleteControlReplaysReplayIDHandler = operations.DeleteControlReplaysReplayIDHandlerFunc(func(params operations.DeleteControlReplaysReplayIDParams) middleware.Responder {
+		return s.DeleteControlReplaysReplayID(params)
+	})
+
 	server := restapi.NewServer(api)
 
 	server.ConfigureFlags()
@@ -216,6 +349,13 @@ func CreateRESTServer(config *ServerConfig) (*Server, error) {
 	return s, nil
 }
 
+// apiSpecsChanged lets the modules know that the specs of an API were uploaded, approved or deleted.
+func (s *Server) apiSpecsChanged(apiID uint) {
+	if s.modulesManager != nil {
+		s.modulesManager.APISpecsChanged(apiID)
+	}
+}
+
 func (s *Server) Start(errChan chan struct{}) {
 	log.Infof("Starting REST server")
 	go func() {
@@ -0,0 +1,194 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+// Endpoint is a method and a path template of the spec of an API (e.g. POST /v1/carts/{cartId}/checkout),
+// an empty method matches all the methods.
+type Endpoint struct {
+	Method string
+	Path   string
+}
+
+// HostEndpoint is an endpoint of a host, to be applied by its trace source.
+type HostEndpoint struct {
+	Host   string
+	Method string
+	Path   string
+}
+
+// MatchPathTemplate returns whether a path matches a path template, the parameters of the template match any segment.
+func MatchPathTemplate(template, path string) bool {
+	templateSegments := strings.Split(strings.TrimSuffix(template, "/"), "/")
+	pathSegments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range templateSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *Endpoint) match(method, path string) bool {
+	return (e.Method == "" || strings.EqualFold(e.Method, method)) && MatchPathTemplate(e.Path, path)
+}
+
+// TracingEndpoints are the endpoints the tracing of an API is restricted to for a component.
+type TracingEndpoints struct {
+	APIID     uint
+	Component string
+	Endpoints []Endpoint
+}
+
+type endpointsKey struct {
+	component string
+	apiID     uint
+}
+
+type apiEndpoints struct {
+	apiInfo   database.APIInfo
+	endpoints []Endpoint
+}
+
+// tracingEndpoints caches the endpoints the tracing of the APIs is restricted to.
+type tracingEndpoints struct {
+	lock      sync.RWMutex
+	endpoints map[endpointsKey]*apiEndpoints
+}
+
+func (e *tracingEndpoints) load(dbHandler database.Database) error {
+	dbEndpoints, err := dbHandler.TraceSamplingEndpointsTable().GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get tracing endpoints: %v", err)
+	}
+
+	e.lock.Lock()
+	defer e.lock.Unlock()
+
+	e.endpoints = map[endpointsKey]*apiEndpoints{}
+	for _, dbEndpoint := range dbEndpoints {
+		key := endpointsKey{component: dbEndpoint.Component, apiID: dbEndpoint.APIID}
+		if e.endpoints[key] == nil {
+			e.endpoints[key] = &apiEndpoints{apiInfo: dbEndpoint.APIInfo}
+		}
+		e.endpoints[key].endpoints = append(e.endpoints[key].endpoints, Endpoint{Method: dbEndpoint.Method, Path: dbEndpoint.Path})
+	}
+	return nil
+}
+
+func (e *tracingEndpoints) list(apiID *uint) []TracingEndpoints {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	ret := []TracingEndpoints{}
+	for key, endpoints := range e.endpoints {
+		if apiID != nil && key.apiID != *apiID {
+			continue
+		}
+		ret = append(ret, TracingEndpoints{
+			APIID:     key.apiID,
+			Component: key.component,
+			Endpoints: endpoints.endpoints,
+		})
+	}
+	return ret
+}
+
+func (e *tracingEndpoints) get(component string, apiID uint) []Endpoint {
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	if endpoints, ok := e.endpoints[endpointsKey{component: component, apiID: apiID}]; ok {
+		return endpoints.endpoints
+	}
+	return nil
+}
+
+// shouldTrace returns whether a request must be traced for the component, the tracing of the APIs without endpoints
+// is not restricted.
+func (e *tracingEndpoints) shouldTrace(component string, apiID uint, method, path string) bool {
+	endpoints := e.get(component, apiID)
+	if len(endpoints) == 0 {
+		return true
+	}
+	for i := range endpoints {
+		if endpoints[i].match(method, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// hostEndpoints returns the endpoints of the hosts of the trace source the tracing can be restricted to: the union of
+// the endpoints of the components tracing an API, unless one of them traces all its endpoints.
+func (e *tracingEndpoints) hostEndpoints(traceSourceID uint, sessions []TracingSession) []*HostEndpoint {
+	componentsByAPI := map[uint][]string{}
+	for _, session := range sessions {
+		componentsByAPI[session.APIID] = append(componentsByAPI[session.APIID], session.Component)
+	}
+
+	e.lock.RLock()
+	defer e.lock.RUnlock()
+
+	var ret []*HostEndpoint
+	for apiID, components := range componentsByAPI {
+		var apiInfo *database.APIInfo
+		seen := map[Endpoint]bool{}
+		var endpoints []Endpoint
+		restricted := true
+		for _, component := range components {
+			componentEndpoints, ok := e.endpoints[endpointsKey{component: component, apiID: apiID}]
+			if !ok {
+				restricted = false
+				break
+			}
+			apiInfo = &componentEndpoints.apiInfo
+			for _, endpoint := range componentEndpoints.endpoints {
+				if !seen[endpoint] {
+					seen[endpoint] = true
+					endpoints = append(endpoints, endpoint)
+				}
+			}
+		}
+		if !restricted || apiInfo.TraceSourceID != traceSourceID {
+			continue
+		}
+
+		hosts := (&database.TraceSamplingEndpoint{APIID: apiID, APIInfo: *apiInfo}).Hosts()
+		for _, host := range hosts {
+			for _, endpoint := range endpoints {
+				ret = append(ret, &HostEndpoint{Host: host, Method: endpoint.Method, Path: endpoint.Path})
+			}
+		}
+	}
+	return ret
+}
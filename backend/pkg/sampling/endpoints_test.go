@@ -0,0 +1,86 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func TestMatchPathTemplate(t *testing.T) {
+	assert.Equal(t, MatchPathTemplate("/v1/checkout", "/v1/checkout"), true)
+	assert.Equal(t, MatchPathTemplate("/v1/checkout", "/v1/checkout/"), true)
+	assert.Equal(t, MatchPathTemplate("/v1/checkout", "/v1/cart"), false)
+	assert.Equal(t, MatchPathTemplate("/v1/carts/{cartId}/checkout", "/v1/carts/42/checkout"), true)
+	assert.Equal(t, MatchPathTemplate("/v1/carts/{cartId}/checkout", "/v1/carts//checkout"), false)
+	assert.Equal(t, MatchPathTemplate("/v1/carts/{cartId}", "/v1/carts/42/checkout"), false)
+	assert.Equal(t, MatchPathTemplate("/", "/"), true)
+}
+
+func TestTraceSamplingManager_tracingEndpoints(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &database.APIInfo{Name: "api.example.com", Port: 8080}
+	_, err := dbHandler.APIInventoryTable().FirstOrCreate(api)
+	assert.NilError(t, err)
+
+	manager := &TraceSamplingManager{traceSamplingEnabled: true, dbHandler: dbHandler}
+	assert.NilError(t, manager.tracingEndpoints.load(dbHandler))
+	assert.Equal(t, manager.ShouldTraceEndpoint("bfla", api.ID, "GET", "/v1/items"), true)
+
+	err = manager.SetTracingEndpoints("bfla", api.ID+1, nil)
+	assert.Assert(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	assert.NilError(t, manager.SetTracingEndpoints("bfla", api.ID, []Endpoint{
+		{Method: "post", Path: "/v1/checkout"},
+		{Path: "/v1/carts/{cartId}"},
+	}))
+	assert.NilError(t, manager.SetTracingEndpoints("traceanalyzer", api.ID, []Endpoint{
+		{Method: "POST", Path: "/v1/checkout"},
+	}))
+	assert.Equal(t, len(manager.GetTracingEndpoints(nil)), 2)
+	assert.Equal(t, manager.ShouldTraceEndpoint("bfla", api.ID, "POST", "/v1/checkout"), true)
+	assert.Equal(t, manager.ShouldTraceEndpoint("bfla", api.ID, "GET", "/v1/checkout"), false)
+	assert.Equal(t, manager.ShouldTraceEndpoint("bfla", api.ID, "DELETE", "/v1/carts/42"), true)
+	assert.Equal(t, manager.ShouldTraceEndpoint("traceanalyzer", api.ID, "DELETE", "/v1/carts/42"), false)
+	assert.Equal(t, manager.ShouldTraceEndpoint("specreconstructor", api.ID, "GET", "/v1/items"), true)
+
+	// the trace sources get the union of the endpoints of the components tracing the API
+	assert.Equal(t, len(manager.GetHostEndpoints(api.TraceSourceID)), 0)
+	assert.NilError(t, manager.AddHostToTrace("bfla", uint32(api.ID), nil))
+	assert.NilError(t, manager.AddHostToTrace("traceanalyzer", uint32(api.ID), nil))
+	assert.Equal(t, len(manager.GetHostEndpoints(api.TraceSourceID)), 2)
+	assert.Equal(t, len(manager.GetHostEndpoints(api.TraceSourceID+1)), 0)
+
+	// unless one of them traces all the endpoints
+	assert.NilError(t, manager.AddHostToTrace("specreconstructor", uint32(api.ID), nil))
+	assert.Equal(t, len(manager.GetHostEndpoints(api.TraceSourceID)), 0)
+	assert.NilError(t, manager.RemoveHostToTrace("specreconstructor", uint32(api.ID)))
+
+	assert.NilError(t, manager.SetTracingEndpoints("bfla", api.ID, nil))
+	assert.NilError(t, manager.DeleteTracingEndpoints("traceanalyzer", api.ID))
+	assert.Equal(t, len(manager.GetTracingEndpoints(nil)), 0)
+	assert.Equal(t, manager.ShouldTraceEndpoint("traceanalyzer", api.ID, "DELETE", "/v1/carts/42"), true)
+	assert.Equal(t, len(manager.GetHostEndpoints(api.TraceSourceID)), 0)
+}
@@ -16,97 +16,103 @@
 package sampling
 
 import (
+	"errors"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
-	"k8s.io/client-go/kubernetes"
 
 	_globalConfig "github.com/openclarity/apiclarity/backend/pkg/config"
 	"github.com/openclarity/apiclarity/backend/pkg/database"
-	"github.com/openclarity/trace-sampling-manager/manager/pkg/manager"
-	interfacemanager "github.com/openclarity/trace-sampling-manager/manager/pkg/manager/interface"
-	restmanager "github.com/openclarity/trace-sampling-manager/manager/pkg/rest"
 )
 
 type TraceSamplingManager struct {
 	traceSamplingEnabled bool
 	dbHandler            *database.Handler
-	samplingManager      *manager.Manager
+	// the sampling rates apply even when the trace sampling (of the hosts to trace) is disabled
+	samplingRates samplingRates
+	// the tracing sessions of the hosts to trace, along with the limits of the sessions started without explicit ones
+	tracingSessions      tracingSessions
+	defaultTracingLimits TracingLimits
+	// the endpoints the tracing of the hosts to trace is restricted to
+	tracingEndpoints tracingEndpoints
+
+	// closed on each change of what the trace sources must trace, to wake up their watches
+	changesLock sync.Mutex
+	changes     chan struct{}
 }
 
-func CreateTraceSamplingManager(dbHandler *database.Handler, config *_globalConfig.Config, clientset kubernetes.Interface, errChan chan struct{}) (*TraceSamplingManager, error) {
+var ErrTracingSessionNotFound = errors.New("tracing session not found")
+
+func CreateTraceSamplingManager(dbHandler *database.Handler, config *_globalConfig.Config) (*TraceSamplingManager, error) {
 	s := &TraceSamplingManager{}
 	s.dbHandler = dbHandler
 	s.traceSamplingEnabled = config.TraceSamplingEnabled
 
+	if err := s.samplingRates.load(dbHandler); err != nil {
+		return nil, err
+	}
+	if err := s.tracingEndpoints.load(dbHandler); err != nil {
+		return nil, err
+	}
+
 	if !s.traceSamplingEnabled {
 		return s, nil
 	}
 
-	/*
-	* The "old" TSM... we will relay to it all hosts to trace for internal GTW
-	* To removed when internal GTW will moved to new ApiClarity HTTP endpoint.
-	 */
-	samplingManager, err := manager.Create(clientset, &restmanager.Config{
-		RestServerPort:             config.HTTPTraceSamplingManagerPort,
-		GRPCServerPort:             config.GRPCTraceSamplingManagerPort,
-		HostToTraceSecretName:      config.HostToTraceSecretName,
-		HostToTraceSecretNamespace: config.HostToTraceSecretNamespace,
-		HostToTraceSecretOwnerName: config.HostToTraceSecretOwnerName,
-		EnableTLS:                  config.EnableTLS,
-		TLSServerCertFilePath:      config.TLSServerCertFilePath,
-		TLSServerKeyFilePath:       config.TLSServerKeyFilePath,
-		RootCertFilePath:           config.RootCertFilePath,
-		RestServerTLSPort:          config.HTTPSTraceSamplingManagerPort,
-	})
-	if err != nil {
-		log.Errorf("Failed to create a trace sampling manager: %v", err)
-		return nil, fmt.Errorf("failed to create a trace sampling manager: %v", err)
+	s.defaultTracingLimits = TracingLimits{
+		Duration:  time.Duration(config.TracingSessionDefaultDurationSec) * time.Second,
+		MaxEvents: config.TracingSessionDefaultMaxEvents,
 	}
-	if err = samplingManager.Start(errChan); err != nil {
-		log.Errorf("Failed to start trace sampling manager: %v", err)
-		return nil, fmt.Errorf("failed to start trace sampling manager: %v", err)
+	if err := s.tracingSessions.load(dbHandler); err != nil {
+		return nil, fmt.Errorf("failed to get tracing sessions: %v", err)
 	}
-	s.samplingManager = samplingManager
-	/*
-		End of stuff to remove when internal GTW will moved to new ApiClarity HTTP endpoint.
-	*/
 
 	return s, nil
 }
 
-func (m *TraceSamplingManager) AddHostToTrace(component string, apiID uint32) error {
+// AddHostToTrace enables the tracing of an API for a component until the limits of the tracing session are reached,
+// the default limits apply when limits is nil. The tracing session restarts when the tracing is already enabled.
+func (m *TraceSamplingManager) AddHostToTrace(component string, apiID uint32, limits *TracingLimits) error {
 	if !m.traceSamplingEnabled {
 		return nil
 	}
 
-	externalTraceSourceID, err := m.dbHandler.TraceSamplingTable().GetExternalTraceSourceID()
-	if err != nil {
-		log.Errorf("Failed to retrieve external source ID: %v", err)
-		return fmt.Errorf("failed to retrieve external source ID: %v", err)
-	}
-
 	apiInfo := &database.APIInfo{}
-	if err = m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+	if err := m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
 		log.Errorf("Failed to retrieve API info for apiID=%v: %v", apiID, err)
 		return fmt.Errorf("failed to retrieve API info for apiID=%v: %v", apiID, err)
 	}
 
+	if limits == nil {
+		limits = &m.defaultTracingLimits
+	}
+	session := TracingSession{
+		APIID:     uint(apiID),
+		APIName:   apiInfo.Name,
+		Component: component,
+		StartedAt: time.Now().UTC(),
+		MaxEvents: limits.MaxEvents,
+	}
+	if limits.Duration > 0 {
+		expiresAt := session.StartedAt.Add(limits.Duration)
+		session.ExpiresAt = &expiresAt
+	}
+
 	traceSourceID := apiInfo.TraceSourceID
-	if err = m.dbHandler.TraceSamplingTable().AddAPIToTrace(component, traceSourceID, apiID); err != nil {
+	if err := m.dbHandler.TraceSamplingTable().AddAPIToTrace(component, traceSourceID, apiID, database.TracingSession{
+		StartedAt: session.StartedAt,
+		ExpiresAt: session.ExpiresAt,
+		MaxEvents: session.MaxEvents,
+	}); err != nil {
 		log.Errorf("Failed to enable traces for API %v: %v", apiID, err)
 		return fmt.Errorf("failed to enable traces for API %v: %v", apiID, err)
 	}
+	m.tracingSessions.set(session)
+	m.notifyChanges()
 
-	if traceSourceID == externalTraceSourceID && m.samplingManager != nil {
-		// Relay it to the TSM
-		m.samplingManager.AddHostsToTrace(
-			&interfacemanager.HostsByComponentID{
-				Hosts:       []string{fmt.Sprintf("%s:%d", apiInfo.Name, apiInfo.Port)},
-				ComponentID: component,
-			},
-		)
-	}
 	return nil
 }
 
@@ -115,33 +121,20 @@ func (m *TraceSamplingManager) RemoveHostToTrace(component string, apiID uint32)
 		return nil
 	}
 
-	externalTraceSourceID, err := m.dbHandler.TraceSamplingTable().GetExternalTraceSourceID()
-	if err != nil {
-		log.Errorf("Failed to retrieve external source ID: %v", err)
-		return fmt.Errorf("failed to retrieve external source ID: %v", err)
-	}
-
 	apiInfo := &database.APIInfo{}
-	if err = m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+	if err := m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
 		log.Errorf("Failed to retrieve API info for apiID=%v: %v", apiID, err)
 		return fmt.Errorf("failed to retrieve API info for apiID=%v: %v", apiID, err)
 	}
 
 	traceSourceID := apiInfo.TraceSourceID
-	if err = m.dbHandler.TraceSamplingTable().DeleteAPIToTrace(component, traceSourceID, apiID); err != nil {
+	if err := m.dbHandler.TraceSamplingTable().DeleteAPIToTrace(component, traceSourceID, apiID); err != nil {
 		log.Errorf("Failed to disable traces for API %v: %v", apiID, err)
 		return fmt.Errorf("failed to disable traces for API %v: %v", apiID, err)
 	}
+	m.tracingSessions.delete(component, uint(apiID))
+	m.notifyChanges()
 
-	if traceSourceID == externalTraceSourceID && m.samplingManager != nil {
-		// Relay it to the TSM
-		m.samplingManager.RemoveHostsToTrace(
-			&interfacemanager.HostsByComponentID{
-				Hosts:       []string{fmt.Sprintf("%s:%d", apiInfo.Name, apiInfo.Port)},
-				ComponentID: component,
-			},
-		)
-	}
 	return nil
 }
 
@@ -182,15 +175,173 @@ func (m *TraceSamplingManager) ResetForComponent(component string) error {
 		log.Errorf("Failed to delete traces: %v", err)
 		return fmt.Errorf("failed to delete traces: %v", err)
 	}
+	m.tracingSessions.reset()
+	m.notifyChanges()
+
+	return nil
+}
+
+// GetSamplingRates returns the sampling rates of the APIs.
+func (m *TraceSamplingManager) GetSamplingRates() []*database.TraceSamplingRate {
+	return m.samplingRates.list()
+}
+
+// SetSamplingRate sets the ratio of the traces of an API to keep and their maximum rate, for the trace sources
+// (component "*") or a module.
+func (m *TraceSamplingManager) SetSamplingRate(apiID uint, component string, ratio, maxEventsPerSecond float64) error {
+	apiInfo := &database.APIInfo{}
+	if err := m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+		return fmt.Errorf("failed to retrieve API info for apiID=%v: %w", apiID, err)
+	}
 
-	if m.samplingManager != nil {
-		// Relay it to the TSM
-		m.samplingManager.SetHostsToTrace(
-			&interfacemanager.HostsByComponentID{
-				Hosts:       []string{},
-				ComponentID: component,
-			},
-		)
+	rate := &database.TraceSamplingRate{
+		APIID:              apiID,
+		Component:          component,
+		Ratio:              ratio,
+		MaxEventsPerSecond: maxEventsPerSecond,
+	}
+	if err := m.dbHandler.TraceSamplingRatesTable().Set(rate); err != nil {
+		return fmt.Errorf("failed to set sampling rate of API %v: %v", apiID, err)
 	}
+	rate.APIInfo = *apiInfo
+	m.samplingRates.set(rate)
+	m.notifyChanges()
+
 	return nil
 }
+
+func (m *TraceSamplingManager) DeleteSamplingRate(apiID uint, component string) error {
+	if err := m.dbHandler.TraceSamplingRatesTable().Delete(apiID, component); err != nil {
+		return fmt.Errorf("failed to delete sampling rate of API %v: %v", apiID, err)
+	}
+	m.samplingRates.delete(component, apiID)
+	m.notifyChanges()
+
+	return nil
+}
+
+// ShouldSample returns whether an event of an API must be kept according to the sampling rate of the component.
+func (m *TraceSamplingManager) ShouldSample(component string, apiID uint) bool {
+	return m.samplingRates.shouldSample(component, apiID)
+}
+
+// GetHostSamplingRates returns the sampling rates the trace source must apply to the traces of its hosts.
+func (m *TraceSamplingManager) GetHostSamplingRates(traceSourceID uint) []*HostSamplingRate {
+	return m.samplingRates.hostSamplingRates(traceSourceID)
+}
+
+// GetTracingSessions returns the active tracing sessions, of an API when apiID is not nil.
+func (m *TraceSamplingManager) GetTracingSessions(apiID *uint) []TracingSession {
+	sessions := m.tracingSessions.list()
+	if apiID == nil {
+		return sessions
+	}
+
+	ret := []TracingSession{}
+	for _, session := range sessions {
+		if session.APIID == *apiID {
+			ret = append(ret, session)
+		}
+	}
+	return ret
+}
+
+// SetTracingSessionLimits restarts an active tracing session with new limits.
+func (m *TraceSamplingManager) SetTracingSessionLimits(component string, apiID uint, limits TracingLimits) (*TracingSession, error) {
+	if _, ok := m.tracingSessions.get(component, apiID); !ok {
+		return nil, fmt.Errorf("failed to set limits of tracing session of API %v for %s: %w", apiID, component, ErrTracingSessionNotFound)
+	}
+	if err := m.AddHostToTrace(component, uint32(apiID), &limits); err != nil {
+		return nil, err
+	}
+	session, _ := m.tracingSessions.get(component, apiID)
+	return &session, nil
+}
+
+// CountTracedEvent counts an event of an API dispatched to a component and returns whether it is still within the
+// tracing session of the API, the session is then ended by the tracing sessions expiry.
+func (m *TraceSamplingManager) CountTracedEvent(component string, apiID uint) bool {
+	return m.tracingSessions.countEvent(component, apiID, time.Now())
+}
+
+// GetTracingEndpoints returns the endpoints the tracing of the APIs is restricted to, of an API when apiID is not nil.
+func (m *TraceSamplingManager) GetTracingEndpoints(apiID *uint) []TracingEndpoints {
+	return m.tracingEndpoints.list(apiID)
+}
+
+// SetTracingEndpoints restricts the tracing of an API for a component to endpoints, no endpoints removes the
+// restriction.
+func (m *TraceSamplingManager) SetTracingEndpoints(component string, apiID uint, endpoints []Endpoint) error {
+	apiInfo := &database.APIInfo{}
+	if err := m.dbHandler.APIInventoryTable().First(apiInfo, apiID); err != nil {
+		return fmt.Errorf("failed to retrieve API info for apiID=%v: %w", apiID, err)
+	}
+
+	dbEndpoints := make([]*database.TraceSamplingEndpoint, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		dbEndpoints = append(dbEndpoints, &database.TraceSamplingEndpoint{
+			Method: strings.ToUpper(endpoint.Method),
+			Path:   endpoint.Path,
+		})
+	}
+	if err := m.dbHandler.TraceSamplingEndpointsTable().Set(apiID, component, dbEndpoints); err != nil {
+		return fmt.Errorf("failed to set tracing endpoints of API %v: %v", apiID, err)
+	}
+	if err := m.tracingEndpoints.load(m.dbHandler); err != nil {
+		return err
+	}
+	m.notifyChanges()
+
+	return nil
+}
+
+func (m *TraceSamplingManager) DeleteTracingEndpoints(component string, apiID uint) error {
+	if err := m.dbHandler.TraceSamplingEndpointsTable().Delete(apiID, component); err != nil {
+		return fmt.Errorf("failed to delete tracing endpoints of API %v: %v", apiID, err)
+	}
+	if err := m.tracingEndpoints.load(m.dbHandler); err != nil {
+		return err
+	}
+	m.notifyChanges()
+
+	return nil
+}
+
+// ShouldTraceEndpoint returns whether a request of an API must be traced for a component according to the endpoints
+// its tracing is restricted to.
+func (m *TraceSamplingManager) ShouldTraceEndpoint(component string, apiID uint, method, path string) bool {
+	if !m.traceSamplingEnabled {
+		return true
+	}
+	return m.tracingEndpoints.shouldTrace(component, apiID, method, path)
+}
+
+// GetHostEndpoints returns the endpoints the trace source can restrict the tracing of its hosts to.
+func (m *TraceSamplingManager) GetHostEndpoints(traceSourceID uint) []*HostEndpoint {
+	if !m.traceSamplingEnabled {
+		return nil
+	}
+	return m.tracingEndpoints.hostEndpoints(traceSourceID, m.tracingSessions.list())
+}
+
+// Changes returns a channel closed on the next change of the hosts to trace, the sampling rates or the tracing
+// endpoints, which the trace sources watch to get them as soon as they change.
+func (m *TraceSamplingManager) Changes() <-chan struct{} {
+	m.changesLock.Lock()
+	defer m.changesLock.Unlock()
+
+	if m.changes == nil {
+		m.changes = make(chan struct{})
+	}
+	return m.changes
+}
+
+func (m *TraceSamplingManager) notifyChanges() {
+	m.changesLock.Lock()
+	defer m.changesLock.Unlock()
+
+	if m.changes != nil {
+		close(m.changes)
+		m.changes = nil
+	}
+}
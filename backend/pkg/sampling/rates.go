@@ -0,0 +1,158 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+
+	"golang.org/x/time/rate"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+// AllComponents is the component of the sampling rates applied by the trace sources to all the traces of an API.
+const AllComponents = "*"
+
+// Sampler keeps a ratio of the traces and caps their rate.
+type Sampler struct {
+	ratio   float64
+	limiter *rate.Limiter
+}
+
+func NewSampler(ratio, maxEventsPerSecond float64) *Sampler {
+	s := &Sampler{ratio: ratio}
+	if maxEventsPerSecond > 0 {
+		// allow a burst of a second of traces, at least one
+		burst := int(maxEventsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		s.limiter = rate.NewLimiter(rate.Limit(maxEventsPerSecond), burst)
+	}
+	return s
+}
+
+// Sample returns whether the trace must be kept.
+func (s *Sampler) Sample() bool {
+	if s.ratio < 1 && rand.Float64() >= s.ratio { //nolint:gosec
+		return false
+	}
+	return s.limiter == nil || s.limiter.Allow()
+}
+
+// HostSamplingRate is the sampling rate of a host, to be applied by its trace source.
+type HostSamplingRate struct {
+	Host               string
+	Ratio              float64
+	MaxEventsPerSecond float64
+}
+
+type samplingRateKey struct {
+	component string
+	apiID     uint
+}
+
+type samplingRate struct {
+	rate    *database.TraceSamplingRate
+	sampler *Sampler
+}
+
+// samplingRates caches the sampling rates of the database along with their samplers.
+type samplingRates struct {
+	lock  sync.RWMutex
+	rates map[samplingRateKey]*samplingRate
+}
+
+func (r *samplingRates) load(dbHandler database.Database) error {
+	rates, err := dbHandler.TraceSamplingRatesTable().GetAll()
+	if err != nil {
+		return fmt.Errorf("failed to get sampling rates: %v", err)
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.rates = make(map[samplingRateKey]*samplingRate, len(rates))
+	for _, dbRate := range rates {
+		r.rates[samplingRateKey{component: dbRate.Component, apiID: dbRate.APIID}] = &samplingRate{
+			rate:    dbRate,
+			sampler: NewSampler(dbRate.Ratio, dbRate.MaxEventsPerSecond),
+		}
+	}
+	return nil
+}
+
+// set replaces the sampler of the rate of an API, the samplers of the other APIs keep their limiter state.
+func (r *samplingRates) set(dbRate *database.TraceSamplingRate) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.rates == nil {
+		r.rates = map[samplingRateKey]*samplingRate{}
+	}
+	r.rates[samplingRateKey{component: dbRate.Component, apiID: dbRate.APIID}] = &samplingRate{
+		rate:    dbRate,
+		sampler: NewSampler(dbRate.Ratio, dbRate.MaxEventsPerSecond),
+	}
+}
+
+func (r *samplingRates) delete(component string, apiID uint) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.rates, samplingRateKey{component: component, apiID: apiID})
+}
+
+func (r *samplingRates) list() []*database.TraceSamplingRate {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	ret := make([]*database.TraceSamplingRate, 0, len(r.rates))
+	for _, rate := range r.rates {
+		ret = append(ret, rate.rate)
+	}
+	return ret
+}
+
+func (r *samplingRates) shouldSample(component string, apiID uint) bool {
+	r.lock.RLock()
+	rate, ok := r.rates[samplingRateKey{component: component, apiID: apiID}]
+	r.lock.RUnlock()
+
+	return !ok || rate.sampler.Sample()
+}
+
+func (r *samplingRates) hostSamplingRates(traceSourceID uint) []*HostSamplingRate {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var ret []*HostSamplingRate
+	for key, rate := range r.rates {
+		if key.component != AllComponents || rate.rate.APIInfo.TraceSourceID != traceSourceID {
+			continue
+		}
+		for _, host := range rate.rate.Hosts() {
+			ret = append(ret, &HostSamplingRate{
+				Host:               host,
+				Ratio:              rate.rate.Ratio,
+				MaxEventsPerSecond: rate.rate.MaxEventsPerSecond,
+			})
+		}
+	}
+	return ret
+}
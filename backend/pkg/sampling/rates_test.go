@@ -0,0 +1,101 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"gorm.io/gorm"
+	"gotest.tools/assert"
+
+	_config "github.com/openclarity/apiclarity/backend/pkg/config"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func countSampled(sample func() bool, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if sample() {
+			count++
+		}
+	}
+	return count
+}
+
+func TestSampler(t *testing.T) {
+	assert.Equal(t, countSampled(NewSampler(1, 0).Sample, 1000), 1000)
+	assert.Equal(t, countSampled(NewSampler(0, 0).Sample, 1000), 0)
+
+	sampled := countSampled(NewSampler(0.5, 0).Sample, 10000)
+	assert.Assert(t, sampled > 4000 && sampled < 6000, sampled)
+
+	// a burst of a second of traces
+	assert.Equal(t, countSampled(NewSampler(1, 10).Sample, 100), 10)
+	assert.Equal(t, countSampled(NewSampler(1, 0.5).Sample, 100), 1)
+}
+
+func TestTraceSamplingManager_samplingRates(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &database.APIInfo{Name: "api.example.com", Port: 8080}
+	_, err := dbHandler.APIInventoryTable().FirstOrCreate(api)
+	assert.NilError(t, err)
+
+	manager, err := CreateTraceSamplingManager(dbHandler, &_config.Config{})
+	assert.NilError(t, err)
+	assert.Equal(t, len(manager.GetSamplingRates()), 0)
+	assert.Equal(t, manager.ShouldSample("bfla", api.ID), true)
+
+	err = manager.SetSamplingRate(api.ID+1, "bfla", 0, 0)
+	assert.Assert(t, errors.Is(err, gorm.ErrRecordNotFound))
+
+	assert.NilError(t, manager.SetSamplingRate(api.ID, "bfla", 0, 0))
+	assert.NilError(t, manager.SetSamplingRate(api.ID, AllComponents, 0.5, 0))
+	assert.NilError(t, manager.SetSamplingRate(api.ID, AllComponents, 1, 100))
+	assert.Equal(t, len(manager.GetSamplingRates()), 2)
+	assert.Equal(t, manager.ShouldSample("bfla", api.ID), false)
+	assert.Equal(t, manager.ShouldSample("traceanalyzer", api.ID), true)
+
+	assert.DeepEqual(t, manager.GetHostSamplingRates(0), []*HostSamplingRate{
+		{Host: "api.example.com:8080", Ratio: 1, MaxEventsPerSecond: 100},
+	})
+	assert.Equal(t, len(manager.GetHostSamplingRates(1)), 0)
+
+	// changing the rate of an API doesn't reset the limiters of the other APIs
+	otherAPI := &database.APIInfo{Name: "other.example.com", Port: 8080}
+	_, err = dbHandler.APIInventoryTable().FirstOrCreate(otherAPI)
+	assert.NilError(t, err)
+	assert.NilError(t, manager.SetSamplingRate(otherAPI.ID, "bfla", 1, 0.001))
+	assert.Equal(t, manager.ShouldSample("bfla", otherAPI.ID), true)
+	assert.Equal(t, manager.ShouldSample("bfla", otherAPI.ID), false)
+	assert.NilError(t, manager.SetSamplingRate(api.ID, "bfla", 0, 0))
+	assert.NilError(t, manager.DeleteSamplingRate(api.ID, "traceanalyzer"))
+	assert.Equal(t, manager.ShouldSample("bfla", otherAPI.ID), false)
+	assert.NilError(t, manager.DeleteSamplingRate(otherAPI.ID, "bfla"))
+
+	// the rates are loaded from the database
+	manager, err = CreateTraceSamplingManager(dbHandler, &_config.Config{})
+	assert.NilError(t, err)
+	assert.Equal(t, manager.ShouldSample("bfla", api.ID), false)
+
+	assert.NilError(t, manager.DeleteSamplingRate(api.ID, "bfla"))
+	assert.Equal(t, manager.ShouldSample("bfla", api.ID), true)
+	assert.Equal(t, len(manager.GetSamplingRates()), 1)
+}
@@ -0,0 +1,250 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+// TracingLimits bounds a tracing session, a zero value means no limit.
+type TracingLimits struct {
+	Duration  time.Duration
+	MaxEvents int64
+}
+
+// TracingSession is the tracing of an API enabled for a component.
+type TracingSession struct {
+	APIID       uint
+	APIName     string
+	Component   string
+	StartedAt   time.Time
+	ExpiresAt   *time.Time
+	MaxEvents   int64
+	EventsCount int64
+}
+
+// Remaining returns the time and the number of events left before the session ends, negative when the session
+// has no such limit.
+func (s *TracingSession) Remaining(now time.Time) (time.Duration, int64) {
+	remainingTime := time.Duration(-1)
+	if s.ExpiresAt != nil {
+		remainingTime = s.ExpiresAt.Sub(now)
+		if remainingTime < 0 {
+			remainingTime = 0
+		}
+	}
+	remainingEvents := int64(-1)
+	if s.MaxEvents > 0 {
+		remainingEvents = s.MaxEvents - s.EventsCount
+		if remainingEvents < 0 {
+			remainingEvents = 0
+		}
+	}
+	return remainingTime, remainingEvents
+}
+
+type tracingSessionKey struct {
+	component string
+	apiID     uint
+}
+
+type tracingSession struct {
+	session TracingSession
+	// eventsCount is updated for each event, flushedCount is the count last stored in the database
+	eventsCount  int64
+	flushedCount int64
+}
+
+func (s *tracingSession) expired(now time.Time) bool {
+	return s.session.ExpiresAt != nil && !now.Before(*s.session.ExpiresAt)
+}
+
+func (s *tracingSession) exhausted() bool {
+	return s.session.MaxEvents > 0 && atomic.LoadInt64(&s.eventsCount) >= s.session.MaxEvents
+}
+
+// tracingSessions caches the tracing sessions of the database along with their events count.
+type tracingSessions struct {
+	lock     sync.RWMutex
+	sessions map[tracingSessionKey]*tracingSession
+}
+
+func newTracingSession(session TracingSession) *tracingSession {
+	return &tracingSession{
+		session:      session,
+		eventsCount:  session.EventsCount,
+		flushedCount: session.EventsCount,
+	}
+}
+
+func (s *tracingSessions) load(dbHandler database.Database) error {
+	samplings, err := dbHandler.TraceSamplingTable().GetTracingSessions()
+	if err != nil {
+		return err
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sessions = make(map[tracingSessionKey]*tracingSession, len(samplings))
+	for _, sampling := range samplings {
+		s.sessions[tracingSessionKey{component: sampling.Component, apiID: sampling.APIID}] = newTracingSession(TracingSession{
+			APIID:       sampling.APIID,
+			APIName:     sampling.APIInfo.Name,
+			Component:   sampling.Component,
+			StartedAt:   sampling.StartedAt,
+			ExpiresAt:   sampling.ExpiresAt,
+			MaxEvents:   sampling.MaxEvents,
+			EventsCount: sampling.EventsCount,
+		})
+	}
+	return nil
+}
+
+func (s *tracingSessions) set(session TracingSession) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sessions == nil {
+		s.sessions = map[tracingSessionKey]*tracingSession{}
+	}
+	s.sessions[tracingSessionKey{component: session.Component, apiID: session.APIID}] = newTracingSession(session)
+}
+
+func (s *tracingSessions) get(component string, apiID uint) (TracingSession, bool) {
+	s.lock.RLock()
+	session, ok := s.sessions[tracingSessionKey{component: component, apiID: apiID}]
+	s.lock.RUnlock()
+
+	if !ok {
+		return TracingSession{}, false
+	}
+	ret := session.session
+	ret.EventsCount = atomic.LoadInt64(&session.eventsCount)
+	return ret, true
+}
+
+func (s *tracingSessions) delete(component string, apiID uint) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	delete(s.sessions, tracingSessionKey{component: component, apiID: apiID})
+}
+
+func (s *tracingSessions) reset() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.sessions = nil
+}
+
+func (s *tracingSessions) list() []TracingSession {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	ret := make([]TracingSession, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		ret = append(ret, session.session)
+		ret[len(ret)-1].EventsCount = atomic.LoadInt64(&session.eventsCount)
+	}
+	return ret
+}
+
+// countEvent counts an event dispatched to the component and returns whether it is still within the session.
+// APIs without a session are not limited.
+func (s *tracingSessions) countEvent(component string, apiID uint, now time.Time) bool {
+	s.lock.RLock()
+	session, ok := s.sessions[tracingSessionKey{component: component, apiID: apiID}]
+	s.lock.RUnlock()
+
+	if !ok {
+		return true
+	}
+	if session.expired(now) {
+		return false
+	}
+	count := atomic.AddInt64(&session.eventsCount, 1)
+	return session.session.MaxEvents <= 0 || count <= session.session.MaxEvents
+}
+
+// ended returns the sessions which expired or exhausted their events budget, and the events count to store
+// of the others.
+func (s *tracingSessions) ended(now time.Time) (ended []tracingSessionKey, eventsCounts map[tracingSessionKey]int64) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	eventsCounts = map[tracingSessionKey]int64{}
+	for key, session := range s.sessions {
+		if session.expired(now) || session.exhausted() {
+			ended = append(ended, key)
+			continue
+		}
+		if count := atomic.LoadInt64(&session.eventsCount); count != atomic.LoadInt64(&session.flushedCount) {
+			eventsCounts[key] = count
+		}
+	}
+	return ended, eventsCounts
+}
+
+func (s *tracingSessions) flushed(key tracingSessionKey, count int64) {
+	s.lock.RLock()
+	session, ok := s.sessions[key]
+	s.lock.RUnlock()
+
+	if ok {
+		atomic.StoreInt64(&session.flushedCount, count)
+	}
+}
+
+// StartTracingSessionsExpiry periodically disables the tracing of the sessions which expired or exhausted their
+// events budget, and stores the events count of the others.
+func (m *TraceSamplingManager) StartTracingSessionsExpiry(ctx context.Context, checkInterval time.Duration) {
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				log.Debugf("Stopping tracing sessions expiry")
+				return
+			case <-time.After(checkInterval):
+				m.expireTracingSessions(time.Now())
+			}
+		}
+	}()
+}
+
+func (m *TraceSamplingManager) expireTracingSessions(now time.Time) {
+	ended, eventsCounts := m.tracingSessions.ended(now)
+	for _, key := range ended {
+		log.Infof("Tracing session of API %v for %s has ended, disabling its traces", key.apiID, key.component)
+		if err := m.RemoveHostToTrace(key.component, uint32(key.apiID)); err != nil {
+			log.Errorf("Failed to end tracing session of API %v for %s: %v", key.apiID, key.component, err)
+		}
+	}
+	for key, count := range eventsCounts {
+		if err := m.dbHandler.TraceSamplingTable().UpdateEventsCount(key.component, key.apiID, count); err != nil {
+			log.Errorf("Failed to store events count of tracing session of API %v for %s: %v", key.apiID, key.component, err)
+			continue
+		}
+		m.tracingSessions.flushed(key, count)
+	}
+}
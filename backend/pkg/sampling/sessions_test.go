@@ -0,0 +1,111 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sampling
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+)
+
+func TestTraceSamplingManager_tracingSessions(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &database.APIInfo{Name: "api.example.com", Port: 8080}
+	_, err := dbHandler.APIInventoryTable().FirstOrCreate(api)
+	assert.NilError(t, err)
+
+	manager := &TraceSamplingManager{
+		traceSamplingEnabled: true,
+		dbHandler:            dbHandler,
+		defaultTracingLimits: TracingLimits{Duration: time.Hour},
+	}
+
+	// APIs without a tracing session are not limited
+	assert.Equal(t, manager.CountTracedEvent("bfla", api.ID), true)
+
+	assert.NilError(t, manager.AddHostToTrace("bfla", uint32(api.ID), nil))
+	assert.NilError(t, manager.AddHostToTrace("traceanalyzer", uint32(api.ID), &TracingLimits{MaxEvents: 2}))
+	assert.Equal(t, len(manager.GetTracingSessions(nil)), 2)
+	otherAPIID := api.ID + 1
+	assert.Equal(t, len(manager.GetTracingSessions(&otherAPIID)), 0)
+
+	session, ok := manager.tracingSessions.get("bfla", api.ID)
+	assert.Assert(t, ok)
+	assert.Equal(t, session.APIName, "api.example.com")
+	assert.Assert(t, session.ExpiresAt != nil)
+	assert.Equal(t, session.ExpiresAt.Sub(session.StartedAt), time.Hour)
+	remainingTime, remainingEvents := session.Remaining(session.StartedAt.Add(time.Minute))
+	assert.Equal(t, remainingTime, 59*time.Minute)
+	assert.Equal(t, remainingEvents, int64(-1))
+
+	// the events budget
+	assert.Equal(t, manager.CountTracedEvent("traceanalyzer", api.ID), true)
+	manager.expireTracingSessions(time.Now())
+	assert.Equal(t, manager.CountTracedEvent("traceanalyzer", api.ID), true)
+	assert.Equal(t, manager.CountTracedEvent("traceanalyzer", api.ID), false)
+
+	// the events count is stored and the sessions are restored from the database
+	restored := &TraceSamplingManager{traceSamplingEnabled: true, dbHandler: dbHandler}
+	assert.NilError(t, restored.tracingSessions.load(dbHandler))
+	session, ok = restored.tracingSessions.get("traceanalyzer", api.ID)
+	assert.Assert(t, ok)
+	assert.Equal(t, session.EventsCount, int64(1))
+	assert.Equal(t, session.MaxEvents, int64(2))
+	session, ok = restored.tracingSessions.get("bfla", api.ID)
+	assert.Assert(t, ok)
+	assert.Assert(t, session.ExpiresAt != nil)
+
+	manager.expireTracingSessions(time.Now())
+	assert.Equal(t, len(manager.GetTracingSessions(nil)), 1)
+	hosts, err := manager.GetHostsToTrace("traceanalyzer", api.TraceSourceID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(hosts), 0)
+
+	// the duration
+	assert.Equal(t, manager.CountTracedEvent("bfla", api.ID), true)
+	assert.Equal(t, manager.tracingSessions.countEvent("bfla", api.ID, time.Now().Add(2*time.Hour)), false)
+	manager.expireTracingSessions(time.Now().Add(2 * time.Hour))
+	assert.Equal(t, len(manager.GetTracingSessions(nil)), 0)
+	hosts, err = manager.GetHostsToTrace("bfla", api.TraceSourceID)
+	assert.NilError(t, err)
+	assert.Equal(t, len(hosts), 0)
+
+	// only the active sessions can be changed, and changing them restarts them
+	_, err = manager.SetTracingSessionLimits("bfla", api.ID, TracingLimits{})
+	assert.Assert(t, errors.Is(err, ErrTracingSessionNotFound))
+
+	assert.NilError(t, manager.AddHostToTrace("bfla", uint32(api.ID), &TracingLimits{MaxEvents: 1}))
+	assert.Equal(t, manager.CountTracedEvent("bfla", api.ID), true)
+	assert.Equal(t, manager.CountTracedEvent("bfla", api.ID), false)
+	updated, err := manager.SetTracingSessionLimits("bfla", api.ID, TracingLimits{})
+	assert.NilError(t, err)
+	assert.Equal(t, updated.EventsCount, int64(0))
+	assert.Assert(t, updated.ExpiresAt == nil)
+	assert.Equal(t, manager.CountTracedEvent("bfla", api.ID), true)
+	manager.expireTracingSessions(time.Now().Add(24 * time.Hour))
+	assert.Equal(t, len(manager.GetTracingSessions(nil)), 1)
+	hosts, err = manager.GetHostsToTrace("bfla", api.TraceSourceID)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, hosts, []string{"api.example.com:8080"})
+}
@@ -0,0 +1,288 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/openclarity/apiclarity/backend/pkg/common"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
+	telemetry "github.com/openclarity/apiclarity/plugins/api/grpc_gen/telemetry-service"
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+const (
+	// gRPC metadata keys are lower case.
+	grpcTraceSourceAuthTokenKey = "x-trace-source-token" //nolint:gosec
+	// maxStreamFailedResults bounds the results of a stream, which is closed early once reached.
+	maxStreamFailedResults = 1000
+)
+
+type GRPCTracesServer struct {
+	telemetry.UnimplementedTelemetryServiceServer
+
+	traceHandleFunc     HandleTraceFunc
+	traceSourceAuthFunc TraceSourceAuthFunc
+
+	listener net.Listener
+	server   *grpc.Server
+	port     int
+}
+
+type GRPCTracesServerConfig struct {
+	EnableTLS             bool
+	Port                  int
+	TLSServerCertFilePath string
+	TLSServerKeyFilePath  string
+	TraceHandleFunc       HandleTraceFunc
+	TraceSourceAuthFunc   TraceSourceAuthFunc
+}
+
+func CreateGRPCTracesServer(config *GRPCTracesServerConfig) (*GRPCTracesServer, error) {
+	s := &GRPCTracesServer{
+		traceHandleFunc:     config.TraceHandleFunc,
+		traceSourceAuthFunc: config.TraceSourceAuthFunc,
+		port:                config.Port,
+	}
+
+	var opts []grpc.ServerOption
+	if config.EnableTLS {
+		creds, err := credentials.NewServerTLSFromFile(config.TLSServerCertFilePath, config.TLSServerKeyFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	if config.TraceSourceAuthFunc != nil {
+		opts = append(opts,
+			grpc.UnaryInterceptor(s.traceSourceAuthUnaryInterceptor),
+			grpc.StreamInterceptor(s.traceSourceAuthStreamInterceptor))
+	}
+
+	s.server = grpc.NewServer(opts...)
+	telemetry.RegisterTelemetryServiceServer(s.server, s)
+
+	return s, nil
+}
+
+func (s *GRPCTracesServer) Start(errChan chan struct{}) {
+	log.Infof("Starting gRPC traces server")
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(s.port))
+	if err != nil {
+		log.Errorf("gRPC traces server failed to listen: %v", err)
+		errChan <- common.Empty
+		return
+	}
+	s.listener = listener
+
+	go func() {
+		if err := s.server.Serve(s.listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Errorf("Failed to serve gRPC traces server: %v", err)
+			errChan <- common.Empty
+		}
+	}()
+}
+
+func (s *GRPCTracesServer) Stop() {
+	log.Infof("Stopping gRPC traces server")
+	if s.server != nil {
+		s.server.GracefulStop()
+	}
+}
+
+func (s *GRPCTracesServer) PostTelemetry(ctx context.Context, trace *telemetry.Telemetry) (*telemetry.PostTelemetryResponse, error) {
+	if err := s.handleTelemetry(ctx, trace); err != nil {
+		return nil, err
+	}
+
+	return &telemetry.PostTelemetryResponse{}, nil
+}
+
+// StreamTelemetries handles each telemetry of the stream independently, like the HTTP batch endpoint. Only the
+// results of the failed telemetries are returned, so that the reply doesn't grow with the stream, and the stream
+// is closed early once maxStreamFailedResults is reached.
+func (s *GRPCTracesServer) StreamTelemetries(stream telemetry.TelemetryService_StreamTelemetriesServer) error {
+	ctx := stream.Context()
+	result := &telemetry.TelemetriesResult{}
+	queueFull := false
+
+	for i := int64(0); result.Failed < maxStreamFailedResults; i++ {
+		trace, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("failed to receive telemetry: %w", err)
+		}
+
+		if err := s.handleTelemetry(ctx, trace); err != nil {
+			if status.Code(err) == codes.ResourceExhausted {
+				queueFull = true
+			}
+			result.Results = append(result.Results, &telemetry.TelemetryResult{
+				Index:     i,
+				RequestID: trace.GetRequestID(),
+				Message:   status.Convert(err).Message(),
+			})
+			result.Failed++
+			continue
+		}
+		result.Accepted++
+	}
+
+	if queueFull && result.Accepted == 0 {
+		return status.Error(codes.ResourceExhausted, ingestion.ErrQueueFull.Error())
+	}
+
+	if err := stream.SendAndClose(result); err != nil {
+		return fmt.Errorf("failed to send result: %w", err)
+	}
+	return nil
+}
+
+func (s *GRPCTracesServer) handleTelemetry(ctx context.Context, trace *telemetry.Telemetry) error {
+	modelTrace := convertGRPCTelemetry(trace)
+	if err := ValidateTelemetry(modelTrace); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.traceHandleFunc(ctx, modelTrace, TraceSourceFromContext(ctx)); err != nil {
+		if errors.Is(err, ingestion.ErrQueueFull) {
+			return status.Error(codes.ResourceExhausted, err.Error())
+		}
+		log.Errorf("Error from trace handling func: %v", err)
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	return nil
+}
+
+func (s *GRPCTracesServer) authenticate(ctx context.Context) (context.Context, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	tokens := md.Get(grpcTraceSourceAuthTokenKey)
+	if len(tokens) == 0 || tokens[0] == "" {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	traceSource, err := s.traceSourceAuthFunc(ctx, tokens[0])
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, "Unauthorized")
+	}
+
+	return WithTraceSource(ctx, traceSource), nil
+}
+
+func (s *GRPCTracesServer) traceSourceAuthUnaryInterceptor(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := s.authenticate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (s *GRPCTracesServer) traceSourceAuthStreamInterceptor(srv interface{}, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := s.authenticate(ss.Context())
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedServerStream{ServerStream: ss, ctx: ctx})
+}
+
+type authenticatedServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func convertGRPCTelemetry(trace *telemetry.Telemetry) *models.Telemetry {
+	if trace == nil {
+		return nil
+	}
+
+	ret := &models.Telemetry{
+		DestinationAddress:   trace.DestinationAddress,
+		DestinationNamespace: trace.DestinationNamespace,
+		RequestID:            trace.RequestID,
+		Scheme:               trace.Scheme,
+		SourceAddress:        trace.SourceAddress,
+	}
+	if trace.Request != nil {
+		ret.Request = &models.Request{
+			Common: convertGRPCCommon(trace.Request.Common),
+			Host:   trace.Request.Host,
+			Method: trace.Request.Method,
+			Path:   trace.Request.Path,
+		}
+	}
+	if trace.Response != nil {
+		ret.Response = &models.Response{
+			Common:     convertGRPCCommon(trace.Response.Common),
+			StatusCode: trace.Response.StatusCode,
+		}
+	}
+	if trace.Grpc != nil {
+		ret.Grpc = &models.GrpcTelemetry{
+			Method:                trace.Grpc.Method,
+			RequestMessagesCount:  trace.Grpc.RequestMessagesCount,
+			RequestMessagesSize:   trace.Grpc.RequestMessagesSize,
+			ResponseMessagesCount: trace.Grpc.ResponseMessagesCount,
+			ResponseMessagesSize:  trace.Grpc.ResponseMessagesSize,
+			Service:               trace.Grpc.Service,
+			Status:                trace.Grpc.Status,
+			StatusMessage:         trace.Grpc.StatusMessage,
+		}
+	}
+
+	return ret
+}
+
+func convertGRPCCommon(c *telemetry.Common) *models.Common {
+	if c == nil {
+		return nil
+	}
+
+	headers := make([]*models.Header, 0, len(c.Headers))
+	for _, header := range c.Headers {
+		headers = append(headers, &models.Header{
+			Key:   header.GetKey(),
+			Value: header.GetValue(),
+		})
+	}
+
+	return &models.Common{
+		TruncatedBody: c.TruncatedBody,
+		Body:          c.Body,
+		Headers:       headers,
+		Time:          c.Time,
+		Version:       c.Version,
+	}
+}
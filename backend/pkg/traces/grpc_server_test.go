@@ -0,0 +1,194 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"gotest.tools/assert"
+
+	backendmodels "github.com/openclarity/apiclarity/api/server/models"
+	telemetry "github.com/openclarity/apiclarity/plugins/api/grpc_gen/telemetry-service"
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func newTestGRPCTelemetry(requestID string) *telemetry.Telemetry {
+	return &telemetry.Telemetry{
+		RequestID: requestID,
+		Request: &telemetry.Request{
+			Method: "GET",
+			Common: &telemetry.Common{
+				Headers: []*telemetry.Header{{Key: "host", Value: "example.com"}},
+				Body:    []byte("body"),
+			},
+		},
+		Response: &telemetry.Response{
+			StatusCode: "200",
+			Common:     &telemetry.Common{},
+		},
+	}
+}
+
+func startTestGRPCServer(t *testing.T, config *GRPCTracesServerConfig) telemetry.TelemetryServiceClient {
+	t.Helper()
+
+	s, err := CreateGRPCTracesServer(config)
+	assert.NilError(t, err)
+
+	listener := bufconn.Listen(1024 * 1024)
+	go func() {
+		_ = s.server.Serve(listener)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithInsecure())
+	assert.NilError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return telemetry.NewTelemetryServiceClient(conn)
+}
+
+func TestGRPCTracesServer(t *testing.T) {
+	traceSource := &backendmodels.TraceSource{ID: 4}
+
+	var lock sync.Mutex
+	var handled []*models.Telemetry
+	client := startTestGRPCServer(t, &GRPCTracesServerConfig{
+		TraceHandleFunc: func(ctx context.Context, trace *models.Telemetry, ts *backendmodels.TraceSource) error {
+			assert.Equal(t, ts, traceSource)
+			if trace.RequestID == "bad" {
+				return fmt.Errorf("failed to handle")
+			}
+			lock.Lock()
+			defer lock.Unlock()
+			handled = append(handled, trace)
+			return nil
+		},
+		TraceSourceAuthFunc: func(ctx context.Context, token string) (*backendmodels.TraceSource, error) {
+			if token != "token" {
+				return nil, fmt.Errorf("unknown token")
+			}
+			return traceSource, nil
+		},
+	})
+
+	// Not authenticated
+	_, err := client.PostTelemetry(context.Background(), newTestGRPCTelemetry("1"))
+	assert.Equal(t, status.Code(err), codes.Unauthenticated)
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), grpcTraceSourceAuthTokenKey, "token")
+
+	_, err = client.PostTelemetry(ctx, newTestGRPCTelemetry("1"))
+	assert.NilError(t, err)
+	_, err = client.PostTelemetry(ctx, &telemetry.Telemetry{RequestID: "no-request"})
+	assert.Equal(t, status.Code(err), codes.InvalidArgument)
+
+	stream, err := client.StreamTelemetries(ctx)
+	assert.NilError(t, err)
+	for _, trace := range []*telemetry.Telemetry{newTestGRPCTelemetry("2"), newTestGRPCTelemetry("bad"), newTestGRPCTelemetry("3")} {
+		assert.NilError(t, stream.Send(trace))
+	}
+	result, err := stream.CloseAndRecv()
+	assert.NilError(t, err)
+	assert.Equal(t, result.Failed, int64(1))
+	assert.Equal(t, result.Accepted, int64(2))
+	// only the failed telemetries have a result
+	assert.Equal(t, len(result.Results), 1)
+	assert.Equal(t, result.Results[0].RequestID, "bad")
+	assert.Equal(t, result.Results[0].Index, int64(1))
+	assert.Equal(t, result.Results[0].Success, false)
+	assert.Equal(t, result.Results[0].Message, "failed to handle")
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, len(handled), 3)
+	assert.Equal(t, handled[0].RequestID, "1")
+	assert.Equal(t, handled[0].Request.Common.Headers[0].Value, "example.com")
+	assert.Equal(t, string(handled[0].Request.Common.Body), "body")
+	assert.Equal(t, handled[0].Response.StatusCode, "200")
+}
+
+func TestGRPCTracesServer_StreamTelemetries_maxFailedResults(t *testing.T) {
+	var lock sync.Mutex
+	handled := 0
+	client := startTestGRPCServer(t, &GRPCTracesServerConfig{
+		TraceHandleFunc: func(ctx context.Context, trace *models.Telemetry, ts *backendmodels.TraceSource) error {
+			lock.Lock()
+			defer lock.Unlock()
+			handled++
+			return nil
+		},
+	})
+
+	stream, err := client.StreamTelemetries(context.Background())
+	assert.NilError(t, err)
+	assert.NilError(t, stream.Send(newTestGRPCTelemetry("1")))
+	// the stream is closed once the failed results are full, the next sends fail with io.EOF
+	for i := 0; i < 2*maxStreamFailedResults; i++ {
+		if err := stream.Send(&telemetry.Telemetry{RequestID: "invalid"}); err != nil {
+			assert.Equal(t, err, io.EOF)
+			break
+		}
+	}
+	result, err := stream.CloseAndRecv()
+	assert.NilError(t, err)
+	assert.Equal(t, result.Accepted, int64(1))
+	assert.Equal(t, result.Failed, int64(maxStreamFailedResults))
+	assert.Equal(t, len(result.Results), maxStreamFailedResults)
+	assert.Equal(t, result.Results[maxStreamFailedResults-1].Index, int64(maxStreamFailedResults))
+
+	lock.Lock()
+	defer lock.Unlock()
+	assert.Equal(t, handled, 1)
+}
+
+func Test_convertGRPCTelemetry_grpc(t *testing.T) {
+	trace := newTestGRPCTelemetry("1")
+	trace.Grpc = &telemetry.GrpcTelemetry{
+		Service:               "users.v1.Users",
+		Method:                "GetUser",
+		Status:                5,
+		StatusMessage:         "not found",
+		RequestMessagesCount:  1,
+		RequestMessagesSize:   10,
+		ResponseMessagesCount: 2,
+		ResponseMessagesSize:  20,
+	}
+
+	assert.DeepEqual(t, convertGRPCTelemetry(trace).Grpc, &models.GrpcTelemetry{
+		Service:               "users.v1.Users",
+		Method:                "GetUser",
+		Status:                5,
+		StatusMessage:         "not found",
+		RequestMessagesCount:  1,
+		RequestMessagesSize:   10,
+		ResponseMessagesCount: 2,
+		ResponseMessagesSize:  20,
+	})
+	assert.Assert(t, convertGRPCTelemetry(newTestGRPCTelemetry("2")).Grpc == nil)
+}
@@ -0,0 +1,406 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/openclarity/apiclarity/backend/pkg/common"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
+	apiclientmodels "github.com/openclarity/apiclarity/plugins/api/client/models"
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+	"github.com/openclarity/apiclarity/plugins/otel-collector/converter"
+)
+
+const (
+	otlpHTTPTracesPath      = "/v1/traces"
+	otlpContentTypeProtobuf = "application/x-protobuf"
+	otlpContentTypeJSON     = "application/json"
+	otlpReadHeaderTimeout   = 10 * time.Second
+	// maxOTLPBodySize bounds the OTLP/HTTP bodies, before and after decompression, like the default max receive message
+	// size bounds the OTLP/gRPC requests.
+	maxOTLPBodySize = 4 * 1024 * 1024
+)
+
+var errOTLPBodyTooLarge = fmt.Errorf("body larger than %d bytes", maxOTLPBodySize)
+
+// OTLPServer receives OTLP trace exports over gRPC and HTTP, and converts the HTTP client and server spans
+// into telemetries with the same converter as the APIClarity OpenTelemetry Collector exporter.
+// Like the internal traces servers, it doesn't authenticate trace sources and must only be reachable from the cluster.
+type OTLPServer struct {
+	traceHandleFunc HandleTraceFunc
+	converter       *converter.Converter
+	retryAfterSec   int
+
+	grpcServer   *grpc.Server
+	grpcPort     int
+	httpServer   *http.Server
+	httpPort     int
+	enableTLS    bool
+	certFilePath string
+	keyFilePath  string
+}
+
+type OTLPServerConfig struct {
+	EnableTLS             bool
+	GRPCPort              int
+	HTTPPort              int
+	TLSServerCertFilePath string
+	TLSServerKeyFilePath  string
+	PreferHostNames       bool
+	TraceHandleFunc       HandleTraceFunc
+	// RetryAfterSec is the delay suggested to the clients when the spans can't be accepted
+	// because the ingestion queue is full.
+	RetryAfterSec int
+}
+
+func CreateOTLPServer(config *OTLPServerConfig) (*OTLPServer, error) {
+	s := &OTLPServer{
+		traceHandleFunc: config.TraceHandleFunc,
+		converter:       converter.New(createConverterLogger(), config.PreferHostNames),
+		retryAfterSec:   config.RetryAfterSec,
+		grpcPort:        config.GRPCPort,
+		httpPort:        config.HTTPPort,
+		enableTLS:       config.EnableTLS,
+		certFilePath:    config.TLSServerCertFilePath,
+		keyFilePath:     config.TLSServerKeyFilePath,
+	}
+
+	var opts []grpc.ServerOption
+	if config.EnableTLS {
+		creds, err := credentials.NewServerTLSFromFile(config.TLSServerCertFilePath, config.TLSServerKeyFilePath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load TLS credentials: %v", err)
+		}
+		opts = append(opts, grpc.Creds(creds))
+	}
+	s.grpcServer = grpc.NewServer(opts...)
+	ptraceotlp.RegisterServer(s.grpcServer, s)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(otlpHTTPTracesPath, s.handleHTTPTraces)
+	s.httpServer = &http.Server{
+		Addr:              ":" + strconv.Itoa(config.HTTPPort),
+		Handler:           mux,
+		ReadHeaderTimeout: otlpReadHeaderTimeout,
+	}
+
+	return s, nil
+}
+
+// createConverterLogger creates the zap logger used by the converter, following the level of the backend logs.
+// The converter logs every span it converts at info level, so only warnings are kept unless debug is enabled.
+func createConverterLogger() *zap.Logger {
+	zapConfig := zap.NewProductionConfig()
+	zapConfig.Level = zap.NewAtomicLevelAt(zapcore.WarnLevel)
+	if log.IsLevelEnabled(log.DebugLevel) {
+		zapConfig.Level = zap.NewAtomicLevelAt(zapcore.DebugLevel)
+	}
+
+	logger, err := zapConfig.Build()
+	if err != nil {
+		log.Errorf("Failed to create OTLP converter logger: %v", err)
+		return zap.NewNop()
+	}
+	return logger
+}
+
+func (s *OTLPServer) Start(errChan chan struct{}) {
+	log.Infof("Starting OTLP server. gRPC port=%v, HTTP port=%v", s.grpcPort, s.httpPort)
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(s.grpcPort))
+	if err != nil {
+		log.Errorf("OTLP gRPC server failed to listen: %v", err)
+		errChan <- common.Empty
+		return
+	}
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+			log.Errorf("Failed to serve OTLP gRPC server: %v", err)
+			errChan <- common.Empty
+		}
+	}()
+
+	go func() {
+		var err error
+		if s.enableTLS {
+			err = s.httpServer.ListenAndServeTLS(s.certFilePath, s.keyFilePath)
+		} else {
+			err = s.httpServer.ListenAndServe()
+		}
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf("Failed to serve OTLP HTTP server: %v", err)
+			errChan <- common.Empty
+		}
+	}()
+}
+
+func (s *OTLPServer) Stop() {
+	log.Infof("Stopping OTLP server")
+	if s.grpcServer != nil {
+		s.grpcServer.GracefulStop()
+	}
+	if s.httpServer != nil {
+		if err := s.httpServer.Shutdown(context.Background()); err != nil {
+			log.Errorf("Failed to shutdown OTLP HTTP server: %v", err)
+		}
+	}
+}
+
+// Export implements the OTLP gRPC traces service.
+func (s *OTLPServer) Export(ctx context.Context, request ptraceotlp.Request) (ptraceotlp.Response, error) {
+	if err := s.handleTraces(ctx, request.Traces()); err != nil {
+		if errors.Is(err, ingestion.ErrQueueFull) {
+			return ptraceotlp.NewResponse(), status.Error(codes.ResourceExhausted, err.Error())
+		}
+		return ptraceotlp.NewResponse(), status.Error(codes.Internal, err.Error())
+	}
+
+	return ptraceotlp.NewResponse(), nil
+}
+
+func (s *OTLPServer) handleHTTPTraces(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+	if err != nil || (contentType != otlpContentTypeProtobuf && contentType != otlpContentTypeJSON) {
+		http.Error(w, "Unsupported content type", http.StatusUnsupportedMediaType)
+		return
+	}
+
+	body, err := readOTLPBody(r)
+	if err != nil {
+		if errors.Is(err, errOTLPBodyTooLarge) {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	request := ptraceotlp.NewRequest()
+	if contentType == otlpContentTypeJSON {
+		err = request.UnmarshalJSON(body)
+	} else {
+		err = request.UnmarshalProto(body)
+	}
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to decode OTLP request: %v", err), http.StatusBadRequest)
+		return
+	}
+
+	if err := s.handleTraces(r.Context(), request.Traces()); err != nil {
+		if errors.Is(err, ingestion.ErrQueueFull) {
+			w.Header().Set("Retry-After", strconv.Itoa(s.retryAfterSec))
+			http.Error(w, err.Error(), http.StatusTooManyRequests)
+			return
+		}
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	var response []byte
+	if contentType == otlpContentTypeJSON {
+		response, err = ptraceotlp.NewResponse().MarshalJSON()
+	} else {
+		response, err = ptraceotlp.NewResponse().MarshalProto()
+	}
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(response); err != nil {
+		log.Errorf("Failed to write OTLP response: %v", err)
+	}
+}
+
+// readOTLPBody reads the body, decompressed, failing with errOTLPBodyTooLarge past maxOTLPBodySize.
+func readOTLPBody(r *http.Request) ([]byte, error) {
+	if r.ContentLength > maxOTLPBodySize {
+		return nil, errOTLPBodyTooLarge
+	}
+	var reader io.Reader = &limitedReader{reader: r.Body, remaining: maxOTLPBodySize}
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(reader)
+		if err != nil {
+			if errors.Is(err, errOTLPBodyTooLarge) {
+				return nil, err
+			}
+			return nil, fmt.Errorf("failed to read gzip body: %v", err)
+		}
+		defer gzipReader.Close()
+		reader = &limitedReader{reader: gzipReader, remaining: maxOTLPBodySize}
+	}
+
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		if errors.Is(err, errOTLPBodyTooLarge) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("failed to read body: %v", err)
+	}
+	return body, nil
+}
+
+// limitedReader fails with errOTLPBodyTooLarge once more than remaining bytes are read.
+type limitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if l.remaining < 0 {
+		return 0, errOTLPBodyTooLarge
+	}
+	// read one byte more than remaining to detect the larger bodies
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.reader.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, errOTLPBodyTooLarge
+	}
+	return n, err
+}
+
+// handleTraces converts the HTTP spans of the traces and handles the resulting telemetries. Spans that can't be
+// converted are skipped. The traces are rejected with ingestion.ErrQueueFull only if none of the telemetries was accepted.
+func (s *OTLPServer) handleTraces(ctx context.Context, traces ptrace.Traces) error {
+	var accepted, rejected int
+	var lastErr error
+
+	rspans := traces.ResourceSpans()
+	for i := 0; i < rspans.Len(); i++ {
+		rspan := rspans.At(i)
+		sspans := rspan.ScopeSpans()
+		for j := 0; j < sspans.Len(); j++ {
+			sspan := sspans.At(j)
+			spans := sspan.Spans()
+			for k := 0; k < spans.Len(); k++ {
+				span := spans.At(k)
+				if !isHTTPSpan(span) {
+					continue
+				}
+
+				clientTelemetry, err := s.converter.ProcessOTelSpan(rspan.Resource(), sspan.Scope(), span)
+				if err != nil {
+					log.Warnf("Failed to convert span %v (trace id %v): %v", span.Name(), span.TraceID().HexString(), err)
+					continue
+				}
+
+				if err := s.traceHandleFunc(ctx, convertClientTelemetry(clientTelemetry), nil); err != nil {
+					if !errors.Is(err, ingestion.ErrQueueFull) {
+						log.Errorf("Error from trace handling func: %v", err)
+					}
+					rejected++
+					lastErr = err
+					continue
+				}
+				accepted++
+			}
+		}
+	}
+
+	if accepted == 0 && rejected > 0 {
+		return lastErr
+	}
+	return nil
+}
+
+// isHTTPSpan returns true for the spans describing an HTTP request from the client or the server side.
+func isHTTPSpan(span ptrace.Span) bool {
+	switch span.Kind() {
+	case ptrace.SpanKindClient, ptrace.SpanKindServer, ptrace.SpanKindUnspecified:
+	default:
+		return false
+	}
+
+	_, ok := span.Attributes().Get("http.method")
+	return ok
+}
+
+func convertClientTelemetry(t *apiclientmodels.Telemetry) *models.Telemetry {
+	ret := &models.Telemetry{
+		DestinationAddress:   t.DestinationAddress,
+		DestinationNamespace: t.DestinationNamespace,
+		RequestID:            t.RequestID,
+		Scheme:               t.Scheme,
+		SourceAddress:        t.SourceAddress,
+	}
+	if t.Request != nil {
+		ret.Request = &models.Request{
+			Common: convertClientCommon(t.Request.Common),
+			Host:   t.Request.Host,
+			Method: t.Request.Method,
+			Path:   t.Request.Path,
+		}
+	}
+	if t.Response != nil {
+		ret.Response = &models.Response{
+			Common:     convertClientCommon(t.Response.Common),
+			StatusCode: t.Response.StatusCode,
+		}
+	}
+
+	return ret
+}
+
+func convertClientCommon(c *apiclientmodels.Common) *models.Common {
+	if c == nil {
+		return nil
+	}
+
+	headers := make([]*models.Header, 0, len(c.Headers))
+	for _, header := range c.Headers {
+		headers = append(headers, &models.Header{
+			Key:   header.Key,
+			Value: header.Value,
+		})
+	}
+
+	return &models.Common{
+		TruncatedBody: c.TruncatedBody,
+		Body:          c.Body,
+		Headers:       headers,
+		Time:          c.Time,
+		Version:       c.Version,
+	}
+}
@@ -0,0 +1,186 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.opentelemetry.io/collector/pdata/ptrace/ptraceotlp"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gotest.tools/assert"
+
+	backendmodels "github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+func newTestOTLPRequest() ptraceotlp.Request {
+	traces := ptrace.NewTraces()
+	spans := traces.ResourceSpans().AppendEmpty().ScopeSpans().AppendEmpty().Spans()
+
+	server := spans.AppendEmpty()
+	server.SetKind(ptrace.SpanKindServer)
+	server.Attributes().FromRaw(map[string]interface{}{
+		"http.method":      "GET",
+		"http.scheme":      "http",
+		"http.target":      "/api/users/1",
+		"net.host.ip":      "10.0.0.1",
+		"net.host.port":    8080,
+		"http.client_ip":   "10.0.0.2",
+		"http.status_code": 404,
+	})
+
+	// Not an HTTP span, should be ignored.
+	internal := spans.AppendEmpty()
+	internal.SetKind(ptrace.SpanKindInternal)
+	internal.Attributes().FromRaw(map[string]interface{}{"http.method": "GET"})
+	db := spans.AppendEmpty()
+	db.SetKind(ptrace.SpanKindClient)
+	db.Attributes().FromRaw(map[string]interface{}{"db.system": "postgresql"})
+
+	return ptraceotlp.NewRequestFromTraces(traces)
+}
+
+func createTestOTLPServer(t *testing.T, handleTraceFunc HandleTraceFunc) *OTLPServer {
+	t.Helper()
+	s, err := CreateOTLPServer(&OTLPServerConfig{
+		TraceHandleFunc: handleTraceFunc,
+		RetryAfterSec:   3,
+	})
+	assert.NilError(t, err)
+	return s
+}
+
+func TestOTLPServer_HTTP(t *testing.T) {
+	var handled []*models.Telemetry
+	s := createTestOTLPServer(t, func(ctx context.Context, trace *models.Telemetry, traceSource *backendmodels.TraceSource) error {
+		handled = append(handled, trace)
+		return nil
+	})
+
+	protoBody, err := newTestOTLPRequest().MarshalProto()
+	assert.NilError(t, err)
+	jsonBody, err := newTestOTLPRequest().MarshalJSON()
+	assert.NilError(t, err)
+
+	tests := []struct {
+		name        string
+		contentType string
+		body        []byte
+		wantStatus  int
+	}{
+		{name: "protobuf", contentType: otlpContentTypeProtobuf, body: protoBody, wantStatus: http.StatusOK},
+		{name: "json", contentType: otlpContentTypeJSON, body: jsonBody, wantStatus: http.StatusOK},
+		{name: "bad payload", contentType: otlpContentTypeProtobuf, body: []byte("not a proto"), wantStatus: http.StatusBadRequest},
+		{name: "unsupported content type", contentType: "text/plain", body: protoBody, wantStatus: http.StatusUnsupportedMediaType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handled = nil
+			req := httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, bytes.NewReader(tt.body))
+			req.Header.Set("Content-Type", tt.contentType)
+			rec := httptest.NewRecorder()
+
+			s.handleHTTPTraces(rec, req)
+
+			assert.Equal(t, rec.Code, tt.wantStatus)
+			if tt.wantStatus != http.StatusOK {
+				return
+			}
+			assert.Equal(t, rec.Header().Get("Content-Type"), tt.contentType)
+			assert.Equal(t, len(handled), 1)
+			assert.Equal(t, handled[0].Request.Method, "GET")
+			assert.Equal(t, handled[0].Request.Path, "/api/users/1")
+			assert.Equal(t, handled[0].Response.StatusCode, "404")
+			assert.Equal(t, handled[0].DestinationAddress, "10.0.0.1:8080")
+		})
+	}
+}
+
+func TestOTLPServer_HTTP_bodyTooLarge(t *testing.T) {
+	s := createTestOTLPServer(t, func(ctx context.Context, trace *models.Telemetry, traceSource *backendmodels.TraceSource) error {
+		t.Fatal("unexpected trace")
+		return nil
+	})
+
+	large := bytes.Repeat([]byte{0}, maxOTLPBodySize+1)
+	var compressed bytes.Buffer
+	gzipWriter := gzip.NewWriter(&compressed)
+	_, err := gzipWriter.Write(large)
+	assert.NilError(t, err)
+	assert.NilError(t, gzipWriter.Close())
+
+	for name, req := range map[string]*http.Request{
+		"plain": httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, bytes.NewReader(large)),
+		// without content length, like a chunked body
+		"plain unknown length": httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, io.MultiReader(bytes.NewReader(large))),
+		"gzip":                 httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, bytes.NewReader(compressed.Bytes())),
+	} {
+		t.Run(name, func(t *testing.T) {
+			req.Header.Set("Content-Type", otlpContentTypeProtobuf)
+			if name == "gzip" {
+				req.Header.Set("Content-Encoding", "gzip")
+			}
+			rec := httptest.NewRecorder()
+			s.handleHTTPTraces(rec, req)
+			assert.Equal(t, rec.Code, http.StatusRequestEntityTooLarge)
+		})
+	}
+
+	// the bodies up to the max size are read, decompressed
+	body, err := newTestOTLPRequest().MarshalProto()
+	assert.NilError(t, err)
+	var compressedBody bytes.Buffer
+	gzipWriter = gzip.NewWriter(&compressedBody)
+	_, err = gzipWriter.Write(body)
+	assert.NilError(t, err)
+	assert.NilError(t, gzipWriter.Close())
+	req := httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, &compressedBody)
+	req.Header.Set("Content-Encoding", "gzip")
+	read, err := readOTLPBody(req)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, read, body)
+
+	read, err = readOTLPBody(httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, io.MultiReader(bytes.NewReader(large[1:]))))
+	assert.NilError(t, err)
+	assert.Equal(t, len(read), maxOTLPBodySize)
+}
+
+func TestOTLPServer_QueueFull(t *testing.T) {
+	s := createTestOTLPServer(t, func(ctx context.Context, trace *models.Telemetry, traceSource *backendmodels.TraceSource) error {
+		return ingestion.ErrQueueFull
+	})
+
+	_, err := s.Export(context.Background(), newTestOTLPRequest())
+	assert.Equal(t, status.Code(err), codes.ResourceExhausted)
+
+	body, err := newTestOTLPRequest().MarshalProto()
+	assert.NilError(t, err)
+	req := httptest.NewRequest(http.MethodPost, otlpHTTPTracesPath, bytes.NewReader(body))
+	req.Header.Set("Content-Type", otlpContentTypeProtobuf)
+	rec := httptest.NewRecorder()
+	s.handleHTTPTraces(rec, req)
+	assert.Equal(t, rec.Code, http.StatusTooManyRequests)
+	assert.Equal(t, rec.Header().Get("Retry-After"), "3")
+}
@@ -17,8 +17,13 @@ package traces
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"sort"
+	"time"
 
 	"github.com/go-openapi/loads"
 	"github.com/go-openapi/runtime/middleware"
@@ -27,6 +32,7 @@ import (
 
 	backendmodels "github.com/openclarity/apiclarity/api/server/models"
 	"github.com/openclarity/apiclarity/backend/pkg/common"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
 	"github.com/openclarity/apiclarity/backend/pkg/sampling"
 	"github.com/openclarity/apiclarity/plugins/api/server/models"
 	"github.com/openclarity/apiclarity/plugins/api/server/restapi"
@@ -35,6 +41,7 @@ import (
 
 const (
 	traceSourceAuthTokenHeaderName = "X-Trace-Source-Token" //nolint:gosec
+	defaultWatchTimeout            = 30 * time.Second
 )
 
 type (
@@ -47,6 +54,7 @@ type HTTPTracesServer struct {
 	traceHandleFunc             HandleTraceFunc
 	newDiscoveredAPIsHandleFunc HandleNewDiscoveredAPIs
 	traceSourceAuthFunc         TraceSourceAuthFunc
+	retryAfterSec               int64
 
 	server               *restapi.Server
 	TraceSamplingManager *sampling.TraceSamplingManager
@@ -62,6 +70,9 @@ type HTTPTracesServerConfig struct {
 	NewDiscoveredAPIsFunc HandleNewDiscoveredAPIs
 	TraceSourceAuthFunc   TraceSourceAuthFunc
 	TraceSamplingManager  *sampling.TraceSamplingManager
+	// RetryAfterSec is the delay suggested to the clients when the telemetries can't be accepted
+	// because the ingestion queue is full.
+	RetryAfterSec int
 }
 
 func CreateHTTPTracesServer(config *HTTPTracesServerConfig) (*HTTPTracesServer, error) {
@@ -77,9 +88,15 @@ func CreateHTTPTracesServer(config *HTTPTracesServerConfig) (*HTTPTracesServer,
 	api.PostTelemetryHandler = operations.PostTelemetryHandlerFunc(func(params operations.PostTelemetryParams) middleware.Responder {
 		return s.PostTelemetry(params)
 	})
+	api.PostTelemetriesHandler = operations.PostTelemetriesHandlerFunc(func(params operations.PostTelemetriesParams) middleware.Responder {
+		return s.PostTelemetries(params)
+	})
 	api.GetHostsToTraceHandler = operations.GetHostsToTraceHandlerFunc(func(params operations.GetHostsToTraceParams) middleware.Responder {
 		return s.getHostsToTrace(params)
 	})
+	api.GetHostsToTraceWatchHandler = operations.GetHostsToTraceWatchHandlerFunc(func(params operations.GetHostsToTraceWatchParams) middleware.Responder {
+		return s.watchHostsToTrace(params)
+	})
 	api.PostControlNewDiscoveredAPIsHandler = operations.PostControlNewDiscoveredAPIsHandlerFunc(func(params operations.PostControlNewDiscoveredAPIsParams) middleware.Responder {
 		return s.newDiscoveredAPIs(params)
 	})
@@ -87,7 +104,9 @@ func CreateHTTPTracesServer(config *HTTPTracesServerConfig) (*HTTPTracesServer,
 	if config.TraceSourceAuthFunc != nil {
 		s.traceSourceAuthFunc = config.TraceSourceAuthFunc
 		api.AddMiddlewareFor("POST", "/telemetry", s.traceSourceAuthMiddleware)
+		api.AddMiddlewareFor("POST", "/telemetries", s.traceSourceAuthMiddleware)
 		api.AddMiddlewareFor("GET", "/hostsToTrace", s.traceSourceAuthMiddleware)
+		api.AddMiddlewareFor("GET", "/hostsToTrace/watch", s.traceSourceAuthMiddleware)
 		api.AddMiddlewareFor("POST", "/control/newDiscoveredAPIs", s.traceSourceAuthMiddleware)
 	}
 
@@ -115,6 +134,7 @@ func CreateHTTPTracesServer(config *HTTPTracesServerConfig) (*HTTPTracesServer,
 	s.traceHandleFunc = config.TraceHandleFunc
 	s.newDiscoveredAPIsHandleFunc = config.NewDiscoveredAPIsFunc
 	s.TraceSamplingManager = config.TraceSamplingManager
+	s.retryAfterSec = int64(config.RetryAfterSec)
 
 	return s, nil
 }
@@ -177,6 +197,12 @@ func (s *HTTPTracesServer) traceSourceAuthMiddleware(next http.Handler) http.Han
 func (s *HTTPTracesServer) PostTelemetry(params operations.PostTelemetryParams) middleware.Responder {
 	traceSource := TraceSourceFromContext(params.HTTPRequest.Context())
 	if err := s.traceHandleFunc(params.HTTPRequest.Context(), params.Body, traceSource); err != nil {
+		if errors.Is(err, ingestion.ErrQueueFull) {
+			log.Debugf("Rejecting telemetry: %v", err)
+			return operations.NewPostTelemetryTooManyRequests().
+				WithRetryAfter(s.retryAfterSec).
+				WithPayload(&models.APIResponse{Message: err.Error()})
+		}
 		log.Errorf("Error from trace handling func: %v", err)
 		return operations.NewPostTelemetryDefault(http.StatusInternalServerError)
 	}
@@ -184,6 +210,65 @@ func (s *HTTPTracesServer) PostTelemetry(params operations.PostTelemetryParams)
 	return operations.NewPostTelemetryOK()
 }
 
+// PostTelemetries handles each telemetry of the batch independently and reports a result per telemetry,
+// so that the sender is able to retry only the failed ones. If none of the telemetries was accepted because
+// the ingestion queue is full, the whole batch is rejected with 429.
+func (s *HTTPTracesServer) PostTelemetries(params operations.PostTelemetriesParams) middleware.Responder {
+	ctx := params.HTTPRequest.Context()
+	traceSource := TraceSourceFromContext(ctx)
+
+	result := &models.TelemetriesResult{
+		Results: make([]*models.TelemetryResult, 0, len(params.Body)),
+	}
+	queueFull := false
+	for i, trace := range params.Body {
+		telemetryResult := &models.TelemetryResult{
+			Index:   int64(i),
+			Success: true,
+		}
+		if trace != nil {
+			telemetryResult.RequestID = trace.RequestID
+		}
+
+		if err := ValidateTelemetry(trace); err != nil {
+			telemetryResult.Success = false
+			telemetryResult.Message = err.Error()
+		} else if err := s.traceHandleFunc(ctx, trace, traceSource); err != nil {
+			if errors.Is(err, ingestion.ErrQueueFull) {
+				queueFull = true
+			} else {
+				log.Errorf("Error from trace handling func (batch index %v): %v", i, err)
+			}
+			telemetryResult.Success = false
+			telemetryResult.Message = err.Error()
+		}
+
+		if !telemetryResult.Success {
+			result.Failed++
+		}
+		result.Results = append(result.Results, telemetryResult)
+	}
+
+	if queueFull && result.Failed == int64(len(result.Results)) {
+		return operations.NewPostTelemetriesTooManyRequests().
+			WithRetryAfter(s.retryAfterSec).
+			WithPayload(&models.APIResponse{Message: ingestion.ErrQueueFull.Error()})
+	}
+
+	return operations.NewPostTelemetriesOK().WithPayload(result)
+}
+
+// ValidateTelemetry checks that a telemetry has the fields required to be handled by the backend.
+func ValidateTelemetry(trace *models.Telemetry) error {
+	if trace == nil {
+		return fmt.Errorf("empty telemetry")
+	}
+	if trace.Request == nil || trace.Request.Common == nil || trace.Response == nil || trace.Response.Common == nil {
+		return fmt.Errorf("bad format: missing request or response")
+	}
+	return nil
+}
+
 func (s *HTTPTracesServer) getHostsToTrace(params operations.GetHostsToTraceParams) middleware.Responder {
 	if s.TraceSamplingManager == nil {
 		log.Errorf("No trace sampling manager configured")
@@ -191,22 +276,110 @@ func (s *HTTPTracesServer) getHostsToTrace(params operations.GetHostsToTracePara
 			WithPayload(&models.APIResponse{Message: "No trace sampling manager configured"})
 	}
 
-	traceSourceID := common.DefaultTraceSourceID
-	traceSource := TraceSourceFromContext(params.HTTPRequest.Context())
-	if traceSource != nil {
-		traceSourceID = uint(traceSource.ID)
+	hostsToTrace, etag, err := s.hostsToTrace(traceSourceIDFromContext(params.HTTPRequest.Context()))
+	if err != nil {
+		log.Errorf("Failed to get hosts to trace: %v", err)
+		return operations.NewGetHostsToTraceDefault(http.StatusInternalServerError)
 	}
 
+	return operations.NewGetHostsToTraceOK().WithETag(etag).WithPayload(hostsToTrace)
+}
+
+// watchHostsToTrace answers as soon as the hosts to trace of the trace source differ from the ones matching the
+// If-None-Match ETag, or with 304 when they didn't change before the timeout.
+func (s *HTTPTracesServer) watchHostsToTrace(params operations.GetHostsToTraceWatchParams) middleware.Responder {
+	if s.TraceSamplingManager == nil {
+		log.Errorf("No trace sampling manager configured")
+		return operations.NewGetHostsToTraceWatchDefault(http.StatusInternalServerError).
+			WithPayload(&models.APIResponse{Message: "No trace sampling manager configured"})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	traceSourceID := traceSourceIDFromContext(ctx)
+	timeout := defaultWatchTimeout
+	if params.TimeoutSec != nil {
+		timeout = time.Duration(*params.TimeoutSec) * time.Second
+	}
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	for {
+		// Get the changes channel before the hosts to trace, so that no change is missed in between.
+		changes := s.TraceSamplingManager.Changes()
+		hostsToTrace, etag, err := s.hostsToTrace(traceSourceID)
+		if err != nil {
+			log.Errorf("Failed to get hosts to trace: %v", err)
+			return operations.NewGetHostsToTraceWatchDefault(http.StatusInternalServerError)
+		}
+		if params.IfNoneMatch == nil || *params.IfNoneMatch != etag {
+			return operations.NewGetHostsToTraceWatchOK().WithETag(etag).WithPayload(hostsToTrace)
+		}
+
+		select {
+		case <-changes:
+		case <-timer.C:
+			return operations.NewGetHostsToTraceWatchNotModified().WithETag(etag)
+		case <-ctx.Done():
+			return operations.NewGetHostsToTraceWatchNotModified().WithETag(etag)
+		}
+	}
+}
+
+// hostsToTrace returns the hosts to trace of a trace source along with their ETag, which changes only when they do.
+func (s *HTTPTracesServer) hostsToTrace(traceSourceID uint) (*models.HostsToTrace, string, error) {
 	hosts, err := s.TraceSamplingManager.GetHostsToTrace("*", traceSourceID)
 	if err != nil {
-		log.Errorf("Error from trace handling func: %v", err)
-		return operations.NewGetHostsToTraceDefault(http.StatusInternalServerError)
+		return nil, "", fmt.Errorf("failed to get hosts to trace of trace source %v: %v", traceSourceID, err)
 	}
+	sort.Strings(hosts)
+
+	var samplingRates []*models.HostSamplingRate
+	for _, rate := range s.TraceSamplingManager.GetHostSamplingRates(traceSourceID) {
+		samplingRates = append(samplingRates, &models.HostSamplingRate{
+			Host:               rate.Host,
+			Ratio:              rate.Ratio,
+			MaxEventsPerSecond: rate.MaxEventsPerSecond,
+		})
+	}
+	sort.Slice(samplingRates, func(i, j int) bool {
+		return samplingRates[i].Host < samplingRates[j].Host
+	})
 
-	return operations.NewGetHostsToTraceOK().WithPayload(
-		&models.HostsToTrace{
-			Hosts: hosts,
+	var endpoints []*models.HostEndpoint
+	for _, endpoint := range s.TraceSamplingManager.GetHostEndpoints(traceSourceID) {
+		endpoints = append(endpoints, &models.HostEndpoint{
+			Host:   endpoint.Host,
+			Method: endpoint.Method,
+			Path:   endpoint.Path,
 		})
+	}
+	sort.Slice(endpoints, func(i, j int) bool {
+		if endpoints[i].Host != endpoints[j].Host {
+			return endpoints[i].Host < endpoints[j].Host
+		}
+		if endpoints[i].Path != endpoints[j].Path {
+			return endpoints[i].Path < endpoints[j].Path
+		}
+		return endpoints[i].Method < endpoints[j].Method
+	})
+
+	hostsToTrace := &models.HostsToTrace{
+		Hosts:         hosts,
+		SamplingRates: samplingRates,
+		Endpoints:     endpoints,
+	}
+	b, err := json.Marshal(hostsToTrace)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to marshal hosts to trace: %v", err)
+	}
+	return hostsToTrace, fmt.Sprintf("\"%x\"", sha256.Sum256(b)), nil
+}
+
+func traceSourceIDFromContext(ctx context.Context) uint {
+	if traceSource := TraceSourceFromContext(ctx); traceSource != nil {
+		return uint(traceSource.ID)
+	}
+	return common.DefaultTraceSourceID
 }
 
 func (s *HTTPTracesServer) newDiscoveredAPIs(params operations.PostControlNewDiscoveredAPIsParams) middleware.Responder {
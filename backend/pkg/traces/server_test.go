@@ -0,0 +1,175 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package traces
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gotest.tools/assert"
+
+	backendmodels "github.com/openclarity/apiclarity/api/server/models"
+	"github.com/openclarity/apiclarity/backend/pkg/config"
+	"github.com/openclarity/apiclarity/backend/pkg/database"
+	"github.com/openclarity/apiclarity/backend/pkg/ingestion"
+	"github.com/openclarity/apiclarity/backend/pkg/sampling"
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+	"github.com/openclarity/apiclarity/plugins/api/server/restapi/operations"
+)
+
+func newTestTelemetry(requestID string) *models.Telemetry {
+	return &models.Telemetry{
+		RequestID: requestID,
+		Request: &models.Request{
+			Common: &models.Common{},
+		},
+		Response: &models.Response{
+			Common: &models.Common{},
+		},
+	}
+}
+
+func TestHTTPTracesServer_PostTelemetries(t *testing.T) {
+	traceSource := &backendmodels.TraceSource{ID: 3}
+
+	var handled []string
+	s := &HTTPTracesServer{
+		traceHandleFunc: func(ctx context.Context, trace *models.Telemetry, ts *backendmodels.TraceSource) error {
+			assert.Equal(t, ts, traceSource)
+			if trace.RequestID == "bad" {
+				return fmt.Errorf("failed to handle")
+			}
+			handled = append(handled, trace.RequestID)
+			return nil
+		},
+	}
+
+	req := httptest.NewRequest(http.MethodPost, "/api/telemetries", nil)
+	req = req.WithContext(WithTraceSource(req.Context(), traceSource))
+
+	responder := s.PostTelemetries(operations.PostTelemetriesParams{
+		HTTPRequest: req,
+		Body: []*models.Telemetry{
+			newTestTelemetry("1"),
+			newTestTelemetry("bad"),
+			nil,
+			{RequestID: "no-response", Request: &models.Request{Common: &models.Common{}}},
+			newTestTelemetry("2"),
+		},
+	})
+
+	ok, isOK := responder.(*operations.PostTelemetriesOK)
+	assert.Assert(t, isOK)
+	assert.DeepEqual(t, handled, []string{"1", "2"})
+	assert.Equal(t, ok.Payload.Failed, int64(3))
+	assert.Equal(t, len(ok.Payload.Results), 5)
+
+	wantSuccess := []bool{true, false, false, false, true}
+	for i, result := range ok.Payload.Results {
+		assert.Equal(t, result.Index, int64(i))
+		assert.Equal(t, result.Success, wantSuccess[i])
+		assert.Equal(t, result.Message == "", wantSuccess[i])
+	}
+	assert.Equal(t, ok.Payload.Results[1].RequestID, "bad")
+}
+
+func TestHTTPTracesServer_QueueFull(t *testing.T) {
+	s := &HTTPTracesServer{
+		retryAfterSec: 5,
+		traceHandleFunc: func(ctx context.Context, trace *models.Telemetry, ts *backendmodels.TraceSource) error {
+			return ingestion.ErrQueueFull
+		},
+	}
+	req := httptest.NewRequest(http.MethodPost, "/api/telemetry", nil)
+
+	responder := s.PostTelemetry(operations.PostTelemetryParams{
+		HTTPRequest: req,
+		Body:        newTestTelemetry("1"),
+	})
+	tooMany, ok := responder.(*operations.PostTelemetryTooManyRequests)
+	assert.Assert(t, ok)
+	assert.Equal(t, tooMany.RetryAfter, int64(5))
+
+	responder = s.PostTelemetries(operations.PostTelemetriesParams{
+		HTTPRequest: req,
+		Body:        []*models.Telemetry{newTestTelemetry("1"), nil, newTestTelemetry("2")},
+	})
+	batchTooMany, ok := responder.(*operations.PostTelemetriesTooManyRequests)
+	assert.Assert(t, ok)
+	assert.Equal(t, batchTooMany.RetryAfter, int64(5))
+}
+
+func TestHTTPTracesServer_watchHostsToTrace(t *testing.T) {
+	dbHandler := database.Init(&database.DBConfig{
+		DriverType:  database.DBDriverTypeLocal,
+		LocalDBPath: filepath.Join(t.TempDir(), "db.db"),
+	})
+	api := &database.APIInfo{Name: "api.example.com", Port: 8080}
+	_, err := dbHandler.APIInventoryTable().FirstOrCreate(api)
+	assert.NilError(t, err)
+	samplingManager, err := sampling.CreateTraceSamplingManager(dbHandler, &config.Config{TraceSamplingEnabled: true})
+	assert.NilError(t, err)
+	s := &HTTPTracesServer{TraceSamplingManager: samplingManager}
+
+	req := httptest.NewRequest(http.MethodGet, "/api/hostsToTrace", nil)
+	ok, isOK := s.getHostsToTrace(operations.GetHostsToTraceParams{HTTPRequest: req}).(*operations.GetHostsToTraceOK)
+	assert.Assert(t, isOK)
+	assert.Equal(t, len(ok.Payload.Hosts), 0)
+	etag := ok.ETag
+	assert.Assert(t, etag != "")
+
+	watch := func(etag string, timeoutSec int64) middleware.Responder {
+		return s.watchHostsToTrace(operations.GetHostsToTraceWatchParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/hostsToTrace/watch", nil),
+			IfNoneMatch: &etag,
+			TimeoutSec:  &timeoutSec,
+		})
+	}
+
+	// no change until the timeout
+	notModified, isNotModified := watch(etag, 1).(*operations.GetHostsToTraceWatchNotModified)
+	assert.Assert(t, isNotModified)
+	assert.Equal(t, notModified.ETag, etag)
+
+	// a stale version is answered right away
+	watchOK, isOK := watch(`"stale"`, 1).(*operations.GetHostsToTraceWatchOK)
+	assert.Assert(t, isOK)
+	assert.Equal(t, watchOK.ETag, etag)
+
+	// a change is pushed to the pending watch
+	responders := make(chan middleware.Responder)
+	go func() {
+		responders <- watch(etag, 10)
+	}()
+	time.Sleep(100 * time.Millisecond)
+	assert.NilError(t, samplingManager.AddHostToTrace("*", uint32(api.ID), nil))
+
+	select {
+	case responder := <-responders:
+		watchOK, isOK = responder.(*operations.GetHostsToTraceWatchOK)
+		assert.Assert(t, isOK)
+		assert.DeepEqual(t, watchOK.Payload.Hosts, models.HostsList{"api.example.com:8080"})
+		assert.Assert(t, watchOK.ETag != etag)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the change of the hosts to trace was not pushed")
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package speculator
+
+import (
+	"encoding/json"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"net/url"
+	"strings"
+
+	"github.com/openclarity/speculator/pkg/spec"
+	"github.com/openclarity/speculator/pkg/utils"
+)
+
+const (
+	contentTypeHeaderName = "content-type"
+	// maxMultipartMemory is the memory used to read multipart bodies, as the speculator does.
+	maxMultipartMemory = 32 << 20
+)
+
+// BodyDecodingError is returned when a body doesn't match its media type (truncated JSON, broken multipart...)
+// and would fail the speculator on learning or diffing it.
+type BodyDecodingError struct {
+	// Response tells whether the response body is the one which failed to decode, the request body otherwise
+	Response  bool
+	MediaType string
+	Err       error
+}
+
+func (e *BodyDecodingError) Error() string {
+	body := "request"
+	if e.Response {
+		body = "response"
+	}
+	return fmt.Sprintf("failed to decode %s body of media type %q: %v", body, e.MediaType, e.Err)
+}
+
+func (e *BodyDecodingError) Unwrap() error {
+	return e.Err
+}
+
+// CheckBodies decodes the bodies of a telemetry the way the speculator does, a *BodyDecodingError is returned
+// when one of them can't be decoded.
+func CheckBodies(telemetry *spec.Telemetry) error {
+	if err := checkBody(telemetry.Request.Common, false); err != nil {
+		return err
+	}
+	return checkBody(telemetry.Response.Common, true)
+}
+
+func checkBody(common *spec.Common, response bool) error {
+	if common == nil || len(common.Body) == 0 {
+		return nil
+	}
+	contentType, ok := spec.ConvertHeadersToMap(common.Headers)[contentTypeHeaderName]
+	if !ok || contentType == "" {
+		return nil
+	}
+
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return &BodyDecodingError{Response: response, MediaType: contentType, Err: err}
+	}
+	switch {
+	case utils.IsApplicationJSONMediaType(mediaType):
+		var value interface{}
+		err = json.Unmarshal(common.Body, &value)
+	case response:
+		// only JSON response bodies are decoded
+	case mediaType == mediaTypeApplicationForm:
+		_, err = url.ParseQuery(string(common.Body))
+	case mediaType == mediaTypeMultipartFormData:
+		err = checkMultipartBody(string(common.Body), params)
+	}
+	if err != nil {
+		return &BodyDecodingError{Response: response, MediaType: mediaType, Err: err}
+	}
+	return nil
+}
+
+func checkMultipartBody(body string, params map[string]string) error {
+	boundary, ok := params["boundary"]
+	if !ok {
+		return fmt.Errorf("no multipart boundary param in Content-Type")
+	}
+	form, err := multipart.NewReader(strings.NewReader(body), boundary).ReadForm(maxMultipartMemory)
+	if err != nil {
+		return fmt.Errorf("failed to read form: %v", err)
+	}
+	return form.RemoveAll()
+}
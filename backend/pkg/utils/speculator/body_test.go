@@ -0,0 +1,88 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package speculator
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/openclarity/speculator/pkg/spec"
+)
+
+func TestCheckBodies(t *testing.T) {
+	telemetry := func(reqContentType, reqBody, respContentType, respBody string) *spec.Telemetry {
+		return &spec.Telemetry{
+			Request: &spec.Request{
+				Common: &spec.Common{Body: []byte(reqBody), Headers: []*spec.Header{{Key: "Content-Type", Value: reqContentType}}},
+			},
+			Response: &spec.Response{
+				Common: &spec.Common{Body: []byte(respBody), Headers: []*spec.Header{{Key: "Content-Type", Value: respContentType}}},
+			},
+		}
+	}
+	tests := []struct {
+		name         string
+		telemetry    *spec.Telemetry
+		wantErr      bool
+		wantResponse bool
+	}{
+		{
+			name:      "valid bodies",
+			telemetry: telemetry("application/x-www-form-urlencoded", "a=1&b=2", "application/json", `{"a":1}`),
+		},
+		{
+			name:      "XML and text bodies are not decoded",
+			telemetry: telemetry("application/xml", "<a>", "text/plain", "{"),
+		},
+		{
+			name:      "no bodies",
+			telemetry: telemetry("application/json", "", "application/json", ""),
+		},
+		{
+			name:      "truncated JSON request",
+			telemetry: telemetry("application/json", `{"a":`, "application/json", `{}`),
+			wantErr:   true,
+		},
+		{
+			name:         "truncated JSON response",
+			telemetry:    telemetry("application/json", `{}`, "application/problem+json", `{"a":`),
+			wantErr:      true,
+			wantResponse: true,
+		},
+		{
+			name:      "multipart without boundary",
+			telemetry: telemetry("multipart/form-data", "--x\r\n", "application/json", `{}`),
+			wantErr:   true,
+		},
+		{
+			name:      "invalid content type",
+			telemetry: telemetry("application/json; =", `{}`, "application/json", `{}`),
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckBodies(tt.telemetry)
+			var bodyErr *BodyDecodingError
+			if gotErr := errors.As(err, &bodyErr); gotErr != tt.wantErr {
+				t.Fatalf("CheckBodies() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr && bodyErr.Response != tt.wantResponse {
+				t.Errorf("CheckBodies() error = %v, want response %v", err, tt.wantResponse)
+			}
+		})
+	}
+}
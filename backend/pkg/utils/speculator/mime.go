@@ -0,0 +1,39 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package speculator
+
+import (
+	"mime"
+
+	"github.com/openclarity/speculator/pkg/utils"
+)
+
+const (
+	mediaTypeApplicationForm   = "application/x-www-form-urlencoded"
+	mediaTypeMultipartFormData = "multipart/form-data"
+)
+
+// IsLearnableMediaType returns whether the speculator infers a schema for bodies of the given media type.
+// Bodies of other media types (XML, plain text...) are learned without a schema.
+func IsLearnableMediaType(mediaType string) bool {
+	if parsed, _, err := mime.ParseMediaType(mediaType); err == nil {
+		mediaType = parsed
+	}
+
+	return utils.IsApplicationJSONMediaType(mediaType) ||
+		mediaType == mediaTypeApplicationForm ||
+		mediaType == mediaTypeMultipartFormData
+}
@@ -51,6 +51,11 @@ func NewGetHostsToTraceOK() *GetHostsToTraceOK {
 Success
 */
 type GetHostsToTraceOK struct {
+
+	/* Version of the hosts to trace, to be watched for changes
+	 */
+	ETag string
+
 	Payload *models.HostsToTrace
 }
 
@@ -63,6 +68,13 @@ func (o *GetHostsToTraceOK) GetPayload() *models.HostsToTrace {
 
 func (o *GetHostsToTraceOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
 
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
 	o.Payload = new(models.HostsToTrace)
 
 	// response payload
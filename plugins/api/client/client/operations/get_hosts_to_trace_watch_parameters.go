@@ -0,0 +1,225 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetHostsToTraceWatchParams creates a new GetHostsToTraceWatchParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetHostsToTraceWatchParams() *GetHostsToTraceWatchParams {
+	return &GetHostsToTraceWatchParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetHostsToTraceWatchParamsWithTimeout creates a new GetHostsToTraceWatchParams object
+// with the ability to set a timeout on a request.
+func NewGetHostsToTraceWatchParamsWithTimeout(timeout time.Duration) *GetHostsToTraceWatchParams {
+	return &GetHostsToTraceWatchParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetHostsToTraceWatchParamsWithContext creates a new GetHostsToTraceWatchParams object
+// with the ability to set a context for a request.
+func NewGetHostsToTraceWatchParamsWithContext(ctx context.Context) *GetHostsToTraceWatchParams {
+	return &GetHostsToTraceWatchParams{
+		Context: ctx,
+	}
+}
+
+// NewGetHostsToTraceWatchParamsWithHTTPClient creates a new GetHostsToTraceWatchParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetHostsToTraceWatchParamsWithHTTPClient(client *http.Client) *GetHostsToTraceWatchParams {
+	return &GetHostsToTraceWatchParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetHostsToTraceWatchParams contains all the parameters to send to the API endpoint
+   for the get hosts to trace watch operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetHostsToTraceWatchParams struct {
+
+	/* IfNoneMatch.
+
+	   Version (ETag) of the hosts to trace known by the client
+	*/
+	IfNoneMatch *string
+
+	/* XTraceSourceToken.
+
+	   Optional header to authenticate the trace source
+	*/
+	XTraceSourceToken *string
+
+	/* TimeoutSec.
+
+	   Maximum time to wait for a change
+
+	   Default: 30
+	*/
+	TimeoutSec *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get hosts to trace watch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetHostsToTraceWatchParams) WithDefaults() *GetHostsToTraceWatchParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get hosts to trace watch params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetHostsToTraceWatchParams) SetDefaults() {
+	var (
+		timeoutSecDefault = int64(30)
+	)
+
+	val := GetHostsToTraceWatchParams{
+		TimeoutSec: &timeoutSecDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
+}
+
+// WithTimeout adds the timeout to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithTimeout(timeout time.Duration) *GetHostsToTraceWatchParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithContext(ctx context.Context) *GetHostsToTraceWatchParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithHTTPClient(client *http.Client) *GetHostsToTraceWatchParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithIfNoneMatch adds the ifNoneMatch to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithIfNoneMatch(ifNoneMatch *string) *GetHostsToTraceWatchParams {
+	o.SetIfNoneMatch(ifNoneMatch)
+	return o
+}
+
+// SetIfNoneMatch adds the ifNoneMatch to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetIfNoneMatch(ifNoneMatch *string) {
+	o.IfNoneMatch = ifNoneMatch
+}
+
+// WithXTraceSourceToken adds the xTraceSourceToken to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithXTraceSourceToken(xTraceSourceToken *string) *GetHostsToTraceWatchParams {
+	o.SetXTraceSourceToken(xTraceSourceToken)
+	return o
+}
+
+// SetXTraceSourceToken adds the xTraceSourceToken to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetXTraceSourceToken(xTraceSourceToken *string) {
+	o.XTraceSourceToken = xTraceSourceToken
+}
+
+// WithTimeoutSec adds the timeoutSec to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) WithTimeoutSec(timeoutSec *int64) *GetHostsToTraceWatchParams {
+	o.SetTimeoutSec(timeoutSec)
+	return o
+}
+
+// SetTimeoutSec adds the timeoutSec to the get hosts to trace watch params
+func (o *GetHostsToTraceWatchParams) SetTimeoutSec(timeoutSec *int64) {
+	o.TimeoutSec = timeoutSec
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetHostsToTraceWatchParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.IfNoneMatch != nil {
+
+		// header param If-None-Match
+		if err := r.SetHeaderParam("If-None-Match", *o.IfNoneMatch); err != nil {
+			return err
+		}
+	}
+
+	if o.XTraceSourceToken != nil {
+
+		// header param X-Trace-Source-Token
+		if err := r.SetHeaderParam("X-Trace-Source-Token", *o.XTraceSourceToken); err != nil {
+			return err
+		}
+	}
+
+	if o.TimeoutSec != nil {
+
+		// query param timeoutSec
+		var qrTimeoutSec int64
+
+		if o.TimeoutSec != nil {
+			qrTimeoutSec = *o.TimeoutSec
+		}
+		qTimeoutSec := swag.FormatInt64(qrTimeoutSec)
+		if qTimeoutSec != "" {
+
+			if err := r.SetQueryParam("timeoutSec", qTimeoutSec); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
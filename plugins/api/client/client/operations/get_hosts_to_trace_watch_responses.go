@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+// GetHostsToTraceWatchReader is a Reader for the GetHostsToTraceWatch structure.
+type GetHostsToTraceWatchReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetHostsToTraceWatchReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetHostsToTraceWatchOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	case 304:
+		result := NewGetHostsToTraceWatchNotModified()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
+	default:
+		result := NewGetHostsToTraceWatchDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetHostsToTraceWatchOK creates a GetHostsToTraceWatchOK with default headers values
+func NewGetHostsToTraceWatchOK() *GetHostsToTraceWatchOK {
+	return &GetHostsToTraceWatchOK{}
+}
+
+/* GetHostsToTraceWatchOK describes a response with status code 200, with default header values.
+
+Success
+*/
+type GetHostsToTraceWatchOK struct {
+
+	/* Version of the hosts to trace
+	 */
+	ETag string
+
+	Payload *models.HostsToTrace
+}
+
+func (o *GetHostsToTraceWatchOK) Error() string {
+	return fmt.Sprintf("[GET /hostsToTrace/watch][%d] getHostsToTraceWatchOK  %+v", 200, o.Payload)
+}
+func (o *GetHostsToTraceWatchOK) GetPayload() *models.HostsToTrace {
+	return o.Payload
+}
+
+func (o *GetHostsToTraceWatchOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	o.Payload = new(models.HostsToTrace)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetHostsToTraceWatchNotModified creates a GetHostsToTraceWatchNotModified with default headers values
+func NewGetHostsToTraceWatchNotModified() *GetHostsToTraceWatchNotModified {
+	return &GetHostsToTraceWatchNotModified{}
+}
+
+/* GetHostsToTraceWatchNotModified describes a response with status code 304, with default header values.
+
+The hosts to trace did not change
+*/
+type GetHostsToTraceWatchNotModified struct {
+
+	/* Version of the hosts to trace
+	 */
+	ETag string
+}
+
+func (o *GetHostsToTraceWatchNotModified) Error() string {
+	return fmt.Sprintf("[GET /hostsToTrace/watch][%d] getHostsToTraceWatchNotModified ", 304)
+}
+
+func (o *GetHostsToTraceWatchNotModified) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header ETag
+	hdrETag := response.GetHeader("ETag")
+
+	if hdrETag != "" {
+		o.ETag = hdrETag
+	}
+
+	return nil
+}
+
+// NewGetHostsToTraceWatchDefault creates a GetHostsToTraceWatchDefault with default headers values
+func NewGetHostsToTraceWatchDefault(code int) *GetHostsToTraceWatchDefault {
+	return &GetHostsToTraceWatchDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetHostsToTraceWatchDefault describes a response with status code -1, with default header values.
+
+unknown error
+*/
+type GetHostsToTraceWatchDefault struct {
+	_statusCode int
+
+	Payload *models.APIResponse
+}
+
+// Code gets the status code for the get hosts to trace watch default response
+func (o *GetHostsToTraceWatchDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetHostsToTraceWatchDefault) Error() string {
+	return fmt.Sprintf("[GET /hostsToTrace/watch][%d] GetHostsToTraceWatch default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetHostsToTraceWatchDefault) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *GetHostsToTraceWatchDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -30,8 +30,12 @@ type ClientOption func(*runtime.ClientOperation)
 type ClientService interface {
 	GetHostsToTrace(params *GetHostsToTraceParams, opts ...ClientOption) (*GetHostsToTraceOK, error)
 
+	GetHostsToTraceWatch(params *GetHostsToTraceWatchParams, opts ...ClientOption) (*GetHostsToTraceWatchOK, error)
+
 	PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAPIsParams, opts ...ClientOption) (*PostControlNewDiscoveredAPIsOK, error)
 
+	PostTelemetries(params *PostTelemetriesParams, opts ...ClientOption) (*PostTelemetriesOK, error)
+
 	PostTelemetry(params *PostTelemetryParams, opts ...ClientOption) (*PostTelemetryOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
@@ -74,6 +78,45 @@ func (a *Client) GetHostsToTrace(params *GetHostsToTraceParams, opts ...ClientOp
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  GetHostsToTraceWatch waits for a change of the hosts to trace
+
+  Long poll returning the hosts to trace as soon as they differ from the version of the If-None-Match header, or Not Modified once the timeout has elapsed without change.
+*/
+func (a *Client) GetHostsToTraceWatch(params *GetHostsToTraceWatchParams, opts ...ClientOption) (*GetHostsToTraceWatchOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetHostsToTraceWatchParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "GetHostsToTraceWatch",
+		Method:             "GET",
+		PathPattern:        "/hostsToTrace/watch",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetHostsToTraceWatchReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetHostsToTraceWatchOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetHostsToTraceWatchDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   PostControlNewDiscoveredAPIs allows a client to notify API clarity about new a p is
 
@@ -113,6 +156,45 @@ func (a *Client) PostControlNewDiscoveredAPIs(params *PostControlNewDiscoveredAP
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  PostTelemetries posts a batch of http telemetries
+
+  Each telemetry of the batch is handled independently. The response holds a result per telemetry (in the same order as the request) so the client can retry only the telemetries that failed. When none of the telemetries could be accepted because the ingestion queue is full, 429 is returned.
+*/
+func (a *Client) PostTelemetries(params *PostTelemetriesParams, opts ...ClientOption) (*PostTelemetriesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewPostTelemetriesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "PostTelemetries",
+		Method:             "POST",
+		PathPattern:        "/telemetries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &PostTelemetriesReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*PostTelemetriesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*PostTelemetriesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   PostTelemetry posts an http telemetry
 */
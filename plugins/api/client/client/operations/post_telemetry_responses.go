@@ -9,8 +9,10 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 
 	"github.com/openclarity/apiclarity/plugins/api/client/models"
 )
@@ -29,6 +31,12 @@ func (o *PostTelemetryReader) ReadResponse(response runtime.ClientResponse, cons
 			return nil, err
 		}
 		return result, nil
+	case 429:
+		result := NewPostTelemetryTooManyRequests()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return nil, result
 	default:
 		result := NewPostTelemetryDefault(response.Code())
 		if err := result.readResponse(response, consumer, o.formats); err != nil {
@@ -71,6 +79,54 @@ func (o *PostTelemetryOK) readResponse(response runtime.ClientResponse, consumer
 	return nil
 }
 
+// NewPostTelemetryTooManyRequests creates a PostTelemetryTooManyRequests with default headers values
+func NewPostTelemetryTooManyRequests() *PostTelemetryTooManyRequests {
+	return &PostTelemetryTooManyRequests{}
+}
+
+/* PostTelemetryTooManyRequests describes a response with status code 429, with default header values.
+
+The telemetries ingestion queue is full
+*/
+type PostTelemetryTooManyRequests struct {
+
+	/* Number of seconds to wait before retrying
+	 */
+	RetryAfter int64
+
+	Payload *models.APIResponse
+}
+
+func (o *PostTelemetryTooManyRequests) Error() string {
+	return fmt.Sprintf("[POST /telemetry][%d] postTelemetryTooManyRequests  %+v", 429, o.Payload)
+}
+func (o *PostTelemetryTooManyRequests) GetPayload() *models.APIResponse {
+	return o.Payload
+}
+
+func (o *PostTelemetryTooManyRequests) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// hydrates response header Retry-After
+	hdrRetryAfter := response.GetHeader("Retry-After")
+
+	if hdrRetryAfter != "" {
+		valretryAfter, err := swag.ConvertInt64(hdrRetryAfter)
+		if err != nil {
+			return errors.InvalidType("Retry-After", "header", "int64", hdrRetryAfter)
+		}
+		o.RetryAfter = valretryAfter
+	}
+
+	o.Payload = new(models.APIResponse)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
 // NewPostTelemetryDefault creates a PostTelemetryDefault with default headers values
 func NewPostTelemetryDefault(code int) *PostTelemetryDefault {
 	return &PostTelemetryDefault{
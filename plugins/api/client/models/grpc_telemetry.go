@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// GrpcTelemetry gRPC call details, set by the trace sources that capture gRPC traffic
+//
+// swagger:model GrpcTelemetry
+type GrpcTelemetry struct {
+
+	// method
+	Method string `json:"method,omitempty"`
+
+	// request messages count
+	RequestMessagesCount int64 `json:"requestMessagesCount,omitempty"`
+
+	// Total size in bytes of the request messages, without the gRPC framing
+	RequestMessagesSize int64 `json:"requestMessagesSize,omitempty"`
+
+	// response messages count
+	ResponseMessagesCount int64 `json:"responseMessagesCount,omitempty"`
+
+	// Total size in bytes of the response messages, without the gRPC framing
+	ResponseMessagesSize int64 `json:"responseMessagesSize,omitempty"`
+
+	// Fully qualified service name (package.Service)
+	Service string `json:"service,omitempty"`
+
+	// gRPC status code of the call (grpc-status trailer)
+	Status int32 `json:"status,omitempty"`
+
+	// status message
+	StatusMessage string `json:"statusMessage,omitempty"`
+}
+
+// Validate validates this grpc telemetry
+func (m *GrpcTelemetry) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this grpc telemetry based on context it is used
+func (m *GrpcTelemetry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *GrpcTelemetry) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *GrpcTelemetry) UnmarshalBinary(b []byte) error {
+	var res GrpcTelemetry
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
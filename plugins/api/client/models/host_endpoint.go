@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HostEndpoint host endpoint
+//
+// swagger:model HostEndpoint
+type HostEndpoint struct {
+
+	// Host in the form 'host:port' or 'host'
+	Host string `json:"host,omitempty"`
+
+	// HTTP method of the endpoint, all the methods when empty
+	Method string `json:"method,omitempty"`
+
+	// Path template of the endpoint, its parameters (e.g. {id}) match any path segment
+	Path string `json:"path,omitempty"`
+}
+
+// Validate validates this host endpoint
+func (m *HostEndpoint) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this host endpoint based on context it is used
+func (m *HostEndpoint) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostEndpoint) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostEndpoint) UnmarshalBinary(b []byte) error {
+	var res HostEndpoint
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
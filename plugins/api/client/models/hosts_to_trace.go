@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -19,25 +20,63 @@ import (
 // swagger:model HostsToTrace
 type HostsToTrace struct {
 
+	// Endpoints the tracing of the hosts is restricted to, all the endpoints of the hosts without endpoints are traced
+	Endpoints []*HostEndpoint `json:"endpoints"`
+
 	// hosts
 	// Required: true
 	Hosts HostsList `json:"hosts"`
+
+	// Sampling of the traces of the hosts, the traces of the hosts without sampling rate are all kept
+	SamplingRates []*HostSamplingRate `json:"samplingRates"`
 }
 
 // Validate validates this hosts to trace
 func (m *HostsToTrace) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateEndpoints(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateHosts(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSamplingRates(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *HostsToTrace) validateEndpoints(formats strfmt.Registry) error {
+	if swag.IsZero(m.Endpoints) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Endpoints); i++ {
+		if swag.IsZero(m.Endpoints[i]) { // not required
+			continue
+		}
+
+		if m.Endpoints[i] != nil {
+			if err := m.Endpoints[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("endpoints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostsToTrace) validateHosts(formats strfmt.Registry) error {
 
 	if err := validate.Required("hosts", "body", m.Hosts); err != nil {
@@ -54,20 +93,70 @@ func (m *HostsToTrace) validateHosts(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *HostsToTrace) validateSamplingRates(formats strfmt.Registry) error {
+	if swag.IsZero(m.SamplingRates) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.SamplingRates); i++ {
+		if swag.IsZero(m.SamplingRates[i]) { // not required
+			continue
+		}
+
+		if m.SamplingRates[i] != nil {
+			if err := m.SamplingRates[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("samplingRates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // ContextValidate validate this hosts to trace based on the context it is used
 func (m *HostsToTrace) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateEndpoints(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateHosts(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateSamplingRates(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *HostsToTrace) contextValidateEndpoints(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Endpoints); i++ {
+
+		if m.Endpoints[i] != nil {
+			if err := m.Endpoints[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("endpoints" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *HostsToTrace) contextValidateHosts(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Hosts.ContextValidate(ctx, formats); err != nil {
@@ -80,6 +169,24 @@ func (m *HostsToTrace) contextValidateHosts(ctx context.Context, formats strfmt.
 	return nil
 }
 
+func (m *HostsToTrace) contextValidateSamplingRates(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.SamplingRates); i++ {
+
+		if m.SamplingRates[i] != nil {
+			if err := m.SamplingRates[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("samplingRates" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 // MarshalBinary interface implementation
 func (m *HostsToTrace) MarshalBinary() ([]byte, error) {
 	if m == nil {
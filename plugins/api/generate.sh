@@ -10,4 +10,8 @@ goswagger generate client -f swagger.yaml -t ./client
 cp server/restapi/configure_api_clarity_plugins_telemetries_api.go /tmp/configure_api_clarity_plugins_telemetries_api.go
 rm -rf server/*
 goswagger generate server -f swagger.yaml -t ./server
-cp /tmp/configure_api_clarity_plugins_telemetries_api.go server/restapi/configure_api_clarity_plugins_telemetries_api.go
\ No newline at end of file
+cp /tmp/configure_api_clarity_plugins_telemetries_api.go server/restapi/configure_api_clarity_plugins_telemetries_api.go
+
+rm -rf ./grpc_gen/*
+mkdir -p ./grpc_gen/telemetry-service
+(cd ./grpc_proto && go generate .)
@@ -0,0 +1,182 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.2.0
+// - protoc             (unknown)
+// source: telemetry.proto
+
+package telemetry
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+// TelemetryServiceClient is the client API for TelemetryService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type TelemetryServiceClient interface {
+	PostTelemetry(ctx context.Context, in *Telemetry, opts ...grpc.CallOption) (*PostTelemetryResponse, error)
+	// StreamTelemetries handles each telemetry of the stream independently and returns the results of the failed ones
+	// once the client closes the stream. After 1000 failures, the server closes the stream early: the telemetries sent
+	// after the first accepted + failed ones were not handled.
+	StreamTelemetries(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_StreamTelemetriesClient, error)
+}
+
+type telemetryServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTelemetryServiceClient(cc grpc.ClientConnInterface) TelemetryServiceClient {
+	return &telemetryServiceClient{cc}
+}
+
+func (c *telemetryServiceClient) PostTelemetry(ctx context.Context, in *Telemetry, opts ...grpc.CallOption) (*PostTelemetryResponse, error) {
+	out := new(PostTelemetryResponse)
+	err := c.cc.Invoke(ctx, "/telemetry.TelemetryService/PostTelemetry", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *telemetryServiceClient) StreamTelemetries(ctx context.Context, opts ...grpc.CallOption) (TelemetryService_StreamTelemetriesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &TelemetryService_ServiceDesc.Streams[0], "/telemetry.TelemetryService/StreamTelemetries", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &telemetryServiceStreamTelemetriesClient{stream}
+	return x, nil
+}
+
+type TelemetryService_StreamTelemetriesClient interface {
+	Send(*Telemetry) error
+	CloseAndRecv() (*TelemetriesResult, error)
+	grpc.ClientStream
+}
+
+type telemetryServiceStreamTelemetriesClient struct {
+	grpc.ClientStream
+}
+
+func (x *telemetryServiceStreamTelemetriesClient) Send(m *Telemetry) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *telemetryServiceStreamTelemetriesClient) CloseAndRecv() (*TelemetriesResult, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(TelemetriesResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TelemetryServiceServer is the server API for TelemetryService service.
+// All implementations must embed UnimplementedTelemetryServiceServer
+// for forward compatibility
+type TelemetryServiceServer interface {
+	PostTelemetry(context.Context, *Telemetry) (*PostTelemetryResponse, error)
+	// StreamTelemetries handles each telemetry of the stream independently and returns the results of the failed ones
+	// once the client closes the stream. After 1000 failures, the server closes the stream early: the telemetries sent
+	// after the first accepted + failed ones were not handled.
+	StreamTelemetries(TelemetryService_StreamTelemetriesServer) error
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+// UnimplementedTelemetryServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedTelemetryServiceServer struct {
+}
+
+func (UnimplementedTelemetryServiceServer) PostTelemetry(context.Context, *Telemetry) (*PostTelemetryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostTelemetry not implemented")
+}
+func (UnimplementedTelemetryServiceServer) StreamTelemetries(TelemetryService_StreamTelemetriesServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamTelemetries not implemented")
+}
+func (UnimplementedTelemetryServiceServer) mustEmbedUnimplementedTelemetryServiceServer() {}
+
+// UnsafeTelemetryServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TelemetryServiceServer will
+// result in compilation errors.
+type UnsafeTelemetryServiceServer interface {
+	mustEmbedUnimplementedTelemetryServiceServer()
+}
+
+func RegisterTelemetryServiceServer(s grpc.ServiceRegistrar, srv TelemetryServiceServer) {
+	s.RegisterService(&TelemetryService_ServiceDesc, srv)
+}
+
+func _TelemetryService_PostTelemetry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Telemetry)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TelemetryServiceServer).PostTelemetry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/telemetry.TelemetryService/PostTelemetry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TelemetryServiceServer).PostTelemetry(ctx, req.(*Telemetry))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TelemetryService_StreamTelemetries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(TelemetryServiceServer).StreamTelemetries(&telemetryServiceStreamTelemetriesServer{stream})
+}
+
+type TelemetryService_StreamTelemetriesServer interface {
+	SendAndClose(*TelemetriesResult) error
+	Recv() (*Telemetry, error)
+	grpc.ServerStream
+}
+
+type telemetryServiceStreamTelemetriesServer struct {
+	grpc.ServerStream
+}
+
+func (x *telemetryServiceStreamTelemetriesServer) SendAndClose(m *TelemetriesResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *telemetryServiceStreamTelemetriesServer) Recv() (*Telemetry, error) {
+	m := new(Telemetry)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// TelemetryService_ServiceDesc is the grpc.ServiceDesc for TelemetryService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TelemetryService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "telemetry.TelemetryService",
+	HandlerType: (*TelemetryServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PostTelemetry",
+			Handler:    _TelemetryService_PostTelemetry_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamTelemetries",
+			Handler:       _TelemetryService_StreamTelemetries_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "telemetry.proto",
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grpcutils holds the gRPC wire helpers shared by APIClarity and the trace sources, so that they detect
+// the same gRPC calls.
+package grpcutils
+
+import (
+	"encoding/binary"
+	"mime"
+	"strings"
+)
+
+const (
+	// MediaType is the media type of the gRPC requests, gRPC-Web is not included since its framing differs.
+	MediaType = "application/grpc"
+	// MessagePrefixLength is the length of the compressed flag and the message length prefixed to each message.
+	MessagePrefixLength = 5
+
+	pathPartsNum = 2
+)
+
+// IsGRPCMediaType returns whether the content type is a gRPC one (application/grpc, application/grpc+proto...).
+func IsGRPCMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == MediaType || strings.HasPrefix(mediaType, MediaType+"+")
+}
+
+// SplitPath splits a gRPC request path (/package.Service/Method).
+func SplitPath(path string) (service, method string, ok bool) {
+	if !strings.HasPrefix(path, "/") {
+		return "", "", false
+	}
+	parts := strings.Split(path[1:], "/")
+	if len(parts) != pathPartsNum || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
+
+// MessagesCountAndSize walks the length-prefixed messages of a gRPC body. The size of a message cut by a body
+// truncation is taken from its prefix.
+func MessagesCountAndSize(body []byte) (count, size int64) {
+	for len(body) >= MessagePrefixLength {
+		length := binary.BigEndian.Uint32(body[1:MessagePrefixLength])
+		count++
+		size += int64(length)
+		if uint64(len(body)-MessagePrefixLength) < uint64(length) {
+			break
+		}
+		body = body[MessagePrefixLength+int(length):]
+	}
+	return count, size
+}
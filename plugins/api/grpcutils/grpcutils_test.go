@@ -0,0 +1,96 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpcutils
+
+import (
+	"testing"
+)
+
+func frame(message string) []byte {
+	length := len(message)
+	return append([]byte{0, byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}, message...)
+}
+
+func TestIsGRPCMediaType(t *testing.T) {
+	tests := []struct {
+		contentType string
+		want        bool
+	}{
+		{contentType: "application/grpc", want: true},
+		{contentType: "application/grpc+proto", want: true},
+		{contentType: "application/grpc+json", want: true},
+		{contentType: "application/grpc; charset=utf-8", want: true},
+		{contentType: "Application/GRPC", want: true},
+		{contentType: "application/grpc-web", want: false},
+		{contentType: "application/grpc-web+proto", want: false},
+		{contentType: "application/grpcx", want: false},
+		{contentType: "application/json", want: false},
+		{contentType: "application/grpc; =", want: false},
+		{contentType: "", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsGRPCMediaType(tt.contentType); got != tt.want {
+			t.Errorf("IsGRPCMediaType(%q) = %v, want %v", tt.contentType, got, tt.want)
+		}
+	}
+}
+
+func TestSplitPath(t *testing.T) {
+	tests := []struct {
+		path        string
+		wantService string
+		wantMethod  string
+		wantOK      bool
+	}{
+		{path: "/users.v1.Users/GetUser", wantService: "users.v1.Users", wantMethod: "GetUser", wantOK: true},
+		{path: "/users.v1.Users", wantOK: false},
+		{path: "/users/v1/GetUser", wantOK: false},
+		{path: "users.v1.Users/GetUser", wantOK: false},
+		{path: "//GetUser", wantOK: false},
+		{path: "/users.v1.Users/", wantOK: false},
+		{path: "", wantOK: false},
+	}
+	for _, tt := range tests {
+		service, method, ok := SplitPath(tt.path)
+		if service != tt.wantService || method != tt.wantMethod || ok != tt.wantOK {
+			t.Errorf("SplitPath(%q) = %q, %q, %v, want %q, %q, %v", tt.path, service, method, ok, tt.wantService, tt.wantMethod, tt.wantOK)
+		}
+	}
+}
+
+func TestMessagesCountAndSize(t *testing.T) {
+	tests := []struct {
+		name      string
+		body      []byte
+		wantCount int64
+		wantSize  int64
+	}{
+		{name: "empty", body: nil},
+		{name: "single empty message", body: frame(""), wantCount: 1},
+		{name: "single", body: frame("abc"), wantCount: 1, wantSize: 3},
+		{name: "stream", body: append(frame("ab"), frame("cde")...), wantCount: 2, wantSize: 5},
+		{name: "truncated message", body: append(frame("ab"), frame("cdef")[:7]...), wantCount: 2, wantSize: 6},
+		{name: "truncated prefix", body: append(frame("ab"), 0, 0), wantCount: 1, wantSize: 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count, size := MessagesCountAndSize(tt.body)
+			if count != tt.wantCount || size != tt.wantSize {
+				t.Errorf("MessagesCountAndSize() = %v, %v, want %v, %v", count, size, tt.wantCount, tt.wantSize)
+			}
+		})
+	}
+}
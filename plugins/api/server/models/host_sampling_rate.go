@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// HostSamplingRate host sampling rate
+//
+// swagger:model HostSamplingRate
+type HostSamplingRate struct {
+
+	// Host in the form 'host:port' or 'host'
+	Host string `json:"host,omitempty"`
+
+	// Maximum number of traces to keep per second, 0 for no limit
+	MaxEventsPerSecond float64 `json:"maxEventsPerSecond,omitempty"`
+
+	// Ratio of the traces to keep
+	Ratio float64 `json:"ratio"`
+}
+
+// Validate validates this host sampling rate
+func (m *HostSamplingRate) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this host sampling rate based on context it is used
+func (m *HostSamplingRate) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *HostSamplingRate) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *HostSamplingRate) UnmarshalBinary(b []byte) error {
+	var res HostSamplingRate
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
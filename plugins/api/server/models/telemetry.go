@@ -24,6 +24,9 @@ type Telemetry struct {
 	// destination namespace
 	DestinationNamespace string `json:"destinationNamespace,omitempty"`
 
+	// grpc
+	Grpc *GrpcTelemetry `json:"grpc,omitempty"`
+
 	// request
 	Request *Request `json:"request,omitempty"`
 
@@ -44,6 +47,10 @@ type Telemetry struct {
 func (m *Telemetry) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateGrpc(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRequest(formats); err != nil {
 		res = append(res, err)
 	}
@@ -58,6 +65,23 @@ func (m *Telemetry) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Telemetry) validateGrpc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Grpc) { // not required
+		return nil
+	}
+
+	if m.Grpc != nil {
+		if err := m.Grpc.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("grpc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Telemetry) validateRequest(formats strfmt.Registry) error {
 	if swag.IsZero(m.Request) { // not required
 		return nil
@@ -96,6 +120,10 @@ func (m *Telemetry) validateResponse(formats strfmt.Registry) error {
 func (m *Telemetry) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateGrpc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateRequest(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -110,6 +138,20 @@ func (m *Telemetry) ContextValidate(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Telemetry) contextValidateGrpc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Grpc != nil {
+		if err := m.Grpc.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("grpc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Telemetry) contextValidateRequest(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Request != nil {
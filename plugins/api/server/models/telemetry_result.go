@@ -0,0 +1,59 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// TelemetryResult Result of a single telemetry of a batch
+//
+// swagger:model TelemetryResult
+type TelemetryResult struct {
+
+	// Index of the telemetry in the posted batch
+	Index int64 `json:"index"`
+
+	// Failure reason, empty on success
+	Message string `json:"message,omitempty"`
+
+	// request ID
+	RequestID string `json:"requestID,omitempty"`
+
+	// success
+	Success bool `json:"success"`
+}
+
+// Validate validates this telemetry result
+func (m *TelemetryResult) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this telemetry result based on context it is used
+func (m *TelemetryResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *TelemetryResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *TelemetryResult) UnmarshalBinary(b []byte) error {
+	var res TelemetryResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
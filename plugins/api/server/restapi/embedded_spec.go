@@ -90,7 +90,102 @@ func init() {
             "description": "Success",
             "schema": {
               "$ref": "#/definitions/HostsToTrace"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace, to be watched for changes"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/hostsToTrace/watch": {
+      "get": {
+        "description": "Long poll returning the hosts to trace as soon as they differ from the version of the If-None-Match header, or Not Modified once the timeout has elapsed without change.",
+        "summary": "Wait for a change of the hosts to trace",
+        "parameters": [
+          {
+            "$ref": "#/parameters/TraceSourceTokenHeader"
+          },
+          {
+            "type": "string",
+            "description": "Version (ETag) of the hosts to trace known by the client",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "Maximum time to wait for a change",
+            "name": "timeoutSec",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/HostsToTrace"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace"
+              }
+            }
+          },
+          "304": {
+            "description": "The hosts to trace did not change",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace"
+              }
+            }
+          },
+          "default": {
+            "$ref": "#/responses/UnknownError"
+          }
+        }
+      }
+    },
+    "/telemetries": {
+      "post": {
+        "description": "Each telemetry of the batch is handled independently. The response holds a result per telemetry (in the same order as the request) so the client can retry only the telemetries that failed. When none of the telemetries could be accepted because the ingestion queue is full, 429 is returned.",
+        "summary": "Post a batch of http telemetries",
+        "parameters": [
+          {
+            "$ref": "#/parameters/TraceSourceTokenHeader"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "List of telemetries",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Telemetry"
+              }
             }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/TelemetriesResult"
+            }
+          },
+          "429": {
+            "$ref": "#/responses/TooManyRequests"
           },
           "default": {
             "$ref": "#/responses/UnknownError"
@@ -122,6 +217,9 @@ func init() {
               "$ref": "#/responses/Success"
             }
           },
+          "429": {
+            "$ref": "#/responses/TooManyRequests"
+          },
           "default": {
             "$ref": "#/responses/UnknownError"
           }
@@ -165,6 +263,45 @@ func init() {
         }
       }
     },
+    "GrpcTelemetry": {
+      "description": "gRPC call details, set by the trace sources that capture gRPC traffic",
+      "type": "object",
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "requestMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "requestMessagesSize": {
+          "description": "Total size in bytes of the request messages, without the gRPC framing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesSize": {
+          "description": "Total size in bytes of the response messages, without the gRPC framing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "service": {
+          "description": "Fully qualified service name (package.Service)",
+          "type": "string"
+        },
+        "status": {
+          "description": "gRPC status code of the call (grpc-status trailer)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "statusMessage": {
+          "type": "string"
+        }
+      }
+    },
     "Header": {
       "type": "object",
       "properties": {
@@ -176,6 +313,43 @@ func init() {
         }
       }
     },
+    "HostEndpoint": {
+      "type": "object",
+      "properties": {
+        "host": {
+          "description": "Host in the form 'host:port' or 'host'",
+          "type": "string"
+        },
+        "method": {
+          "description": "HTTP method of the endpoint, all the methods when empty",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path template of the endpoint, its parameters (e.g. {id}) match any path segment",
+          "type": "string"
+        }
+      }
+    },
+    "HostSamplingRate": {
+      "type": "object",
+      "properties": {
+        "host": {
+          "description": "Host in the form 'host:port' or 'host'",
+          "type": "string"
+        },
+        "maxEventsPerSecond": {
+          "description": "Maximum number of traces to keep per second, 0 for no limit",
+          "type": "number",
+          "format": "double"
+        },
+        "ratio": {
+          "description": "Ratio of the traces to keep",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        }
+      }
+    },
     "HostsList": {
       "description": "List of hosts",
       "type": "array",
@@ -190,8 +364,22 @@ func init() {
         "hosts"
       ],
       "properties": {
+        "endpoints": {
+          "description": "Endpoints the tracing of the hosts is restricted to, all the endpoints of the hosts without endpoints are traced",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HostEndpoint"
+          }
+        },
         "hosts": {
           "$ref": "#/definitions/HostsList"
+        },
+        "samplingRates": {
+          "description": "Sampling of the traces of the hosts, the traces of the hosts without sampling rate are all kept",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HostSamplingRate"
+          }
         }
       }
     },
@@ -232,6 +420,26 @@ func init() {
         }
       }
     },
+    "TelemetriesResult": {
+      "description": "Result of a batch of telemetries",
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "failed": {
+          "description": "Number of telemetries that failed to be handled",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TelemetryResult"
+          }
+        }
+      }
+    },
     "Telemetry": {
       "type": "object",
       "properties": {
@@ -241,6 +449,9 @@ func init() {
         "destinationNamespace": {
           "type": "string"
         },
+        "grpc": {
+          "$ref": "#/definitions/GrpcTelemetry"
+        },
         "request": {
           "$ref": "#/definitions/Request"
         },
@@ -257,6 +468,28 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "TelemetryResult": {
+      "description": "Result of a single telemetry of a batch",
+      "type": "object",
+      "properties": {
+        "index": {
+          "description": "Index of the telemetry in the posted batch",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "message": {
+          "description": "Failure reason, empty on success",
+          "type": "string"
+        },
+        "requestID": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
     }
   },
   "parameters": {
@@ -274,6 +507,18 @@ func init() {
         "$ref": "#/definitions/SuccessResponse"
       }
     },
+    "TooManyRequests": {
+      "description": "The telemetries ingestion queue is full",
+      "schema": {
+        "$ref": "#/definitions/ApiResponse"
+      },
+      "headers": {
+        "Retry-After": {
+          "type": "integer",
+          "description": "Number of seconds to wait before retrying"
+        }
+      }
+    },
     "UnknownError": {
       "description": "unknown error",
       "schema": {
@@ -367,6 +612,122 @@ func init() {
             "description": "Success",
             "schema": {
               "$ref": "#/definitions/HostsToTrace"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace, to be watched for changes"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/hostsToTrace/watch": {
+      "get": {
+        "description": "Long poll returning the hosts to trace as soon as they differ from the version of the If-None-Match header, or Not Modified once the timeout has elapsed without change.",
+        "summary": "Wait for a change of the hosts to trace",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Optional header to authenticate the trace source",
+            "name": "X-Trace-Source-Token",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "description": "Version (ETag) of the hosts to trace known by the client",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "maximum": 50,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "description": "Maximum time to wait for a change",
+            "name": "timeoutSec",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/HostsToTrace"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace"
+              }
+            }
+          },
+          "304": {
+            "description": "The hosts to trace did not change",
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Version of the hosts to trace"
+              }
+            }
+          },
+          "default": {
+            "description": "unknown error",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            }
+          }
+        }
+      }
+    },
+    "/telemetries": {
+      "post": {
+        "description": "Each telemetry of the batch is handled independently. The response holds a result per telemetry (in the same order as the request) so the client can retry only the telemetries that failed. When none of the telemetries could be accepted because the ingestion queue is full, 429 is returned.",
+        "summary": "Post a batch of http telemetries",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Optional header to authenticate the trace source",
+            "name": "X-Trace-Source-Token",
+            "in": "header"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "description": "List of telemetries",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Telemetry"
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Success",
+            "schema": {
+              "$ref": "#/definitions/TelemetriesResult"
+            }
+          },
+          "429": {
+            "description": "The telemetries ingestion queue is full",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds to wait before retrying"
+              }
             }
           },
           "default": {
@@ -408,6 +769,18 @@ func init() {
               }
             }
           },
+          "429": {
+            "description": "The telemetries ingestion queue is full",
+            "schema": {
+              "$ref": "#/definitions/ApiResponse"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Number of seconds to wait before retrying"
+              }
+            }
+          },
           "default": {
             "description": "unknown error",
             "schema": {
@@ -454,6 +827,45 @@ func init() {
         }
       }
     },
+    "GrpcTelemetry": {
+      "description": "gRPC call details, set by the trace sources that capture gRPC traffic",
+      "type": "object",
+      "properties": {
+        "method": {
+          "type": "string"
+        },
+        "requestMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "requestMessagesSize": {
+          "description": "Total size in bytes of the request messages, without the gRPC framing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "responseMessagesSize": {
+          "description": "Total size in bytes of the response messages, without the gRPC framing",
+          "type": "integer",
+          "format": "int64"
+        },
+        "service": {
+          "description": "Fully qualified service name (package.Service)",
+          "type": "string"
+        },
+        "status": {
+          "description": "gRPC status code of the call (grpc-status trailer)",
+          "type": "integer",
+          "format": "int32"
+        },
+        "statusMessage": {
+          "type": "string"
+        }
+      }
+    },
     "Header": {
       "type": "object",
       "properties": {
@@ -465,6 +877,43 @@ func init() {
         }
       }
     },
+    "HostEndpoint": {
+      "type": "object",
+      "properties": {
+        "host": {
+          "description": "Host in the form 'host:port' or 'host'",
+          "type": "string"
+        },
+        "method": {
+          "description": "HTTP method of the endpoint, all the methods when empty",
+          "type": "string"
+        },
+        "path": {
+          "description": "Path template of the endpoint, its parameters (e.g. {id}) match any path segment",
+          "type": "string"
+        }
+      }
+    },
+    "HostSamplingRate": {
+      "type": "object",
+      "properties": {
+        "host": {
+          "description": "Host in the form 'host:port' or 'host'",
+          "type": "string"
+        },
+        "maxEventsPerSecond": {
+          "description": "Maximum number of traces to keep per second, 0 for no limit",
+          "type": "number",
+          "format": "double"
+        },
+        "ratio": {
+          "description": "Ratio of the traces to keep",
+          "type": "number",
+          "format": "double",
+          "x-omitempty": false
+        }
+      }
+    },
     "HostsList": {
       "description": "List of hosts",
       "type": "array",
@@ -479,8 +928,22 @@ func init() {
         "hosts"
       ],
       "properties": {
+        "endpoints": {
+          "description": "Endpoints the tracing of the hosts is restricted to, all the endpoints of the hosts without endpoints are traced",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HostEndpoint"
+          }
+        },
         "hosts": {
           "$ref": "#/definitions/HostsList"
+        },
+        "samplingRates": {
+          "description": "Sampling of the traces of the hosts, the traces of the hosts without sampling rate are all kept",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/HostSamplingRate"
+          }
         }
       }
     },
@@ -521,6 +984,26 @@ func init() {
         }
       }
     },
+    "TelemetriesResult": {
+      "description": "Result of a batch of telemetries",
+      "type": "object",
+      "required": [
+        "results"
+      ],
+      "properties": {
+        "failed": {
+          "description": "Number of telemetries that failed to be handled",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "results": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/TelemetryResult"
+          }
+        }
+      }
+    },
     "Telemetry": {
       "type": "object",
       "properties": {
@@ -530,6 +1013,9 @@ func init() {
         "destinationNamespace": {
           "type": "string"
         },
+        "grpc": {
+          "$ref": "#/definitions/GrpcTelemetry"
+        },
         "request": {
           "$ref": "#/definitions/Request"
         },
@@ -546,6 +1032,28 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "TelemetryResult": {
+      "description": "Result of a single telemetry of a batch",
+      "type": "object",
+      "properties": {
+        "index": {
+          "description": "Index of the telemetry in the posted batch",
+          "type": "integer",
+          "x-omitempty": false
+        },
+        "message": {
+          "description": "Failure reason, empty on success",
+          "type": "string"
+        },
+        "requestID": {
+          "type": "string"
+        },
+        "success": {
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
     }
   },
   "parameters": {
@@ -563,6 +1071,18 @@ func init() {
         "$ref": "#/definitions/SuccessResponse"
       }
     },
+    "TooManyRequests": {
+      "description": "The telemetries ingestion queue is full",
+      "schema": {
+        "$ref": "#/definitions/ApiResponse"
+      },
+      "headers": {
+        "Retry-After": {
+          "type": "integer",
+          "description": "Number of seconds to wait before retrying"
+        }
+      }
+    },
     "UnknownError": {
       "description": "unknown error",
       "schema": {
@@ -45,9 +45,15 @@ func NewAPIClarityPluginsTelemetriesAPIAPI(spec *loads.Document) *APIClarityPlug
 		GetHostsToTraceHandler: GetHostsToTraceHandlerFunc(func(params GetHostsToTraceParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetHostsToTrace has not yet been implemented")
 		}),
+		GetHostsToTraceWatchHandler: GetHostsToTraceWatchHandlerFunc(func(params GetHostsToTraceWatchParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetHostsToTraceWatch has not yet been implemented")
+		}),
 		PostControlNewDiscoveredAPIsHandler: PostControlNewDiscoveredAPIsHandlerFunc(func(params PostControlNewDiscoveredAPIsParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostControlNewDiscoveredAPIs has not yet been implemented")
 		}),
+		PostTelemetriesHandler: PostTelemetriesHandlerFunc(func(params PostTelemetriesParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostTelemetries has not yet been implemented")
+		}),
 		PostTelemetryHandler: PostTelemetryHandlerFunc(func(params PostTelemetryParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostTelemetry has not yet been implemented")
 		}),
@@ -89,8 +95,12 @@ type APIClarityPluginsTelemetriesAPIAPI struct {
 
 	// GetHostsToTraceHandler sets the operation handler for the get hosts to trace operation
 	GetHostsToTraceHandler GetHostsToTraceHandler
+	// GetHostsToTraceWatchHandler sets the operation handler for the get hosts to trace watch operation
+	GetHostsToTraceWatchHandler GetHostsToTraceWatchHandler
 	// PostControlNewDiscoveredAPIsHandler sets the operation handler for the post control new discovered a p is operation
 	PostControlNewDiscoveredAPIsHandler PostControlNewDiscoveredAPIsHandler
+	// PostTelemetriesHandler sets the operation handler for the post telemetries operation
+	PostTelemetriesHandler PostTelemetriesHandler
 	// PostTelemetryHandler sets the operation handler for the post telemetry operation
 	PostTelemetryHandler PostTelemetryHandler
 
@@ -173,9 +183,15 @@ func (o *APIClarityPluginsTelemetriesAPIAPI) Validate() error {
 	if o.GetHostsToTraceHandler == nil {
 		unregistered = append(unregistered, "GetHostsToTraceHandler")
 	}
+	if o.GetHostsToTraceWatchHandler == nil {
+		unregistered = append(unregistered, "GetHostsToTraceWatchHandler")
+	}
 	if o.PostControlNewDiscoveredAPIsHandler == nil {
 		unregistered = append(unregistered, "PostControlNewDiscoveredAPIsHandler")
 	}
+	if o.PostTelemetriesHandler == nil {
+		unregistered = append(unregistered, "PostTelemetriesHandler")
+	}
 	if o.PostTelemetryHandler == nil {
 		unregistered = append(unregistered, "PostTelemetryHandler")
 	}
@@ -271,6 +287,10 @@ func (o *APIClarityPluginsTelemetriesAPIAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/hostsToTrace"] = NewGetHostsToTrace(o.context, o.GetHostsToTraceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/hostsToTrace/watch"] = NewGetHostsToTraceWatch(o.context, o.GetHostsToTraceWatchHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -278,6 +298,10 @@ func (o *APIClarityPluginsTelemetriesAPIAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/telemetries"] = NewPostTelemetries(o.context, o.PostTelemetriesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/telemetry"] = NewPostTelemetry(o.context, o.PostTelemetryHandler)
 }
 
@@ -21,6 +21,10 @@ const GetHostsToTraceOKCode int = 200
 swagger:response getHostsToTraceOK
 */
 type GetHostsToTraceOK struct {
+	/*Version of the hosts to trace, to be watched for changes
+
+	 */
+	ETag string `json:"ETag"`
 
 	/*
 	  In: Body
@@ -34,6 +38,17 @@ func NewGetHostsToTraceOK() *GetHostsToTraceOK {
 	return &GetHostsToTraceOK{}
 }
 
+// WithETag adds the eTag to the get hosts to trace o k response
+func (o *GetHostsToTraceOK) WithETag(eTag string) *GetHostsToTraceOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get hosts to trace o k response
+func (o *GetHostsToTraceOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
 // WithPayload adds the payload to the get hosts to trace o k response
 func (o *GetHostsToTraceOK) WithPayload(payload *models.HostsToTrace) *GetHostsToTraceOK {
 	o.Payload = payload
@@ -48,6 +63,13 @@ func (o *GetHostsToTraceOK) SetPayload(payload *models.HostsToTrace) {
 // WriteResponse to the client
 func (o *GetHostsToTraceOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
 	rw.WriteHeader(200)
 	if o.Payload != nil {
 		payload := o.Payload
@@ -0,0 +1,58 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetHostsToTraceWatchHandlerFunc turns a function with the right signature into a get hosts to trace watch handler
+type GetHostsToTraceWatchHandlerFunc func(GetHostsToTraceWatchParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetHostsToTraceWatchHandlerFunc) Handle(params GetHostsToTraceWatchParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetHostsToTraceWatchHandler interface for that can handle valid get hosts to trace watch params
+type GetHostsToTraceWatchHandler interface {
+	Handle(GetHostsToTraceWatchParams) middleware.Responder
+}
+
+// NewGetHostsToTraceWatch creates a new http.Handler for the get hosts to trace watch operation
+func NewGetHostsToTraceWatch(ctx *middleware.Context, handler GetHostsToTraceWatchHandler) *GetHostsToTraceWatch {
+	return &GetHostsToTraceWatch{Context: ctx, Handler: handler}
+}
+
+/* GetHostsToTraceWatch swagger:route GET /hostsToTrace/watch getHostsToTraceWatch
+
+Wait for a change of the hosts to trace
+
+Long poll returning the hosts to trace as soon as they differ from the version of the If-None-Match header, or Not Modified once the timeout has elapsed without change.
+
+*/
+type GetHostsToTraceWatch struct {
+	Context *middleware.Context
+	Handler GetHostsToTraceWatchHandler
+}
+
+func (o *GetHostsToTraceWatch) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetHostsToTraceWatchParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
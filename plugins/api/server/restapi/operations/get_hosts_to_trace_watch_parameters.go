@@ -0,0 +1,163 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetHostsToTraceWatchParams creates a new GetHostsToTraceWatchParams object
+// with the default values initialized.
+func NewGetHostsToTraceWatchParams() GetHostsToTraceWatchParams {
+
+	var (
+		// initialize parameters with default values
+
+		timeoutSecDefault = int64(30)
+	)
+
+	return GetHostsToTraceWatchParams{
+		TimeoutSec: &timeoutSecDefault,
+	}
+}
+
+// GetHostsToTraceWatchParams contains all the bound params for the get hosts to trace watch operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters GetHostsToTraceWatch
+type GetHostsToTraceWatchParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Version (ETag) of the hosts to trace known by the client
+	  In: header
+	*/
+	IfNoneMatch *string
+	/*Optional header to authenticate the trace source
+	  In: header
+	*/
+	XTraceSourceToken *string
+	/*Maximum time to wait for a change
+	  Maximum: 50
+	  Minimum: 1
+	  In: query
+	  Default: 30
+	*/
+	TimeoutSec *int64
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetHostsToTraceWatchParams() beforehand.
+func (o *GetHostsToTraceWatchParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	if err := o.bindIfNoneMatch(r.Header[http.CanonicalHeaderKey("If-None-Match")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := o.bindXTraceSourceToken(r.Header[http.CanonicalHeaderKey("X-Trace-Source-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTimeoutSec, qhkTimeoutSec, _ := qs.GetOK("timeoutSec")
+	if err := o.bindTimeoutSec(qTimeoutSec, qhkTimeoutSec, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindIfNoneMatch binds and validates parameter IfNoneMatch from header.
+func (o *GetHostsToTraceWatchParams) bindIfNoneMatch(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.IfNoneMatch = &raw
+
+	return nil
+}
+
+// bindXTraceSourceToken binds and validates parameter XTraceSourceToken from header.
+func (o *GetHostsToTraceWatchParams) bindXTraceSourceToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XTraceSourceToken = &raw
+
+	return nil
+}
+
+// bindTimeoutSec binds and validates parameter TimeoutSec from query.
+func (o *GetHostsToTraceWatchParams) bindTimeoutSec(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetHostsToTraceWatchParams()
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("timeoutSec", "query", "int64", raw)
+	}
+	o.TimeoutSec = &value
+
+	if err := o.validateTimeoutSec(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTimeoutSec carries on validations for parameter TimeoutSec
+func (o *GetHostsToTraceWatchParams) validateTimeoutSec(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("timeoutSec", "query", *o.TimeoutSec, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("timeoutSec", "query", *o.TimeoutSec, 50, false); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// GetHostsToTraceWatchOKCode is the HTTP code returned for type GetHostsToTraceWatchOK
+const GetHostsToTraceWatchOKCode int = 200
+
+/*GetHostsToTraceWatchOK Success
+
+swagger:response getHostsToTraceWatchOK
+*/
+type GetHostsToTraceWatchOK struct {
+	/*Version of the hosts to trace
+
+	 */
+	ETag string `json:"ETag"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.HostsToTrace `json:"body,omitempty"`
+}
+
+// NewGetHostsToTraceWatchOK creates GetHostsToTraceWatchOK with default headers values
+func NewGetHostsToTraceWatchOK() *GetHostsToTraceWatchOK {
+
+	return &GetHostsToTraceWatchOK{}
+}
+
+// WithETag adds the eTag to the get hosts to trace watch o k response
+func (o *GetHostsToTraceWatchOK) WithETag(eTag string) *GetHostsToTraceWatchOK {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get hosts to trace watch o k response
+func (o *GetHostsToTraceWatchOK) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WithPayload adds the payload to the get hosts to trace watch o k response
+func (o *GetHostsToTraceWatchOK) WithPayload(payload *models.HostsToTrace) *GetHostsToTraceWatchOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get hosts to trace watch o k response
+func (o *GetHostsToTraceWatchOK) SetPayload(payload *models.HostsToTrace) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostsToTraceWatchOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetHostsToTraceWatchNotModifiedCode is the HTTP code returned for type GetHostsToTraceWatchNotModified
+const GetHostsToTraceWatchNotModifiedCode int = 304
+
+/*GetHostsToTraceWatchNotModified The hosts to trace did not change
+
+swagger:response getHostsToTraceWatchNotModified
+*/
+type GetHostsToTraceWatchNotModified struct {
+	/*Version of the hosts to trace
+
+	 */
+	ETag string `json:"ETag"`
+}
+
+// NewGetHostsToTraceWatchNotModified creates GetHostsToTraceWatchNotModified with default headers values
+func NewGetHostsToTraceWatchNotModified() *GetHostsToTraceWatchNotModified {
+
+	return &GetHostsToTraceWatchNotModified{}
+}
+
+// WithETag adds the eTag to the get hosts to trace watch not modified response
+func (o *GetHostsToTraceWatchNotModified) WithETag(eTag string) *GetHostsToTraceWatchNotModified {
+	o.ETag = eTag
+	return o
+}
+
+// SetETag sets the eTag to the get hosts to trace watch not modified response
+func (o *GetHostsToTraceWatchNotModified) SetETag(eTag string) {
+	o.ETag = eTag
+}
+
+// WriteResponse to the client
+func (o *GetHostsToTraceWatchNotModified) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header ETag
+
+	eTag := o.ETag
+	if eTag != "" {
+		rw.Header().Set("ETag", eTag)
+	}
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(304)
+}
+
+/*GetHostsToTraceWatchDefault unknown error
+
+swagger:response getHostsToTraceWatchDefault
+*/
+type GetHostsToTraceWatchDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewGetHostsToTraceWatchDefault creates GetHostsToTraceWatchDefault with default headers values
+func NewGetHostsToTraceWatchDefault(code int) *GetHostsToTraceWatchDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetHostsToTraceWatchDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get hosts to trace watch default response
+func (o *GetHostsToTraceWatchDefault) WithStatusCode(code int) *GetHostsToTraceWatchDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get hosts to trace watch default response
+func (o *GetHostsToTraceWatchDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get hosts to trace watch default response
+func (o *GetHostsToTraceWatchDefault) WithPayload(payload *models.APIResponse) *GetHostsToTraceWatchDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get hosts to trace watch default response
+func (o *GetHostsToTraceWatchDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetHostsToTraceWatchDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"io"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// NewPostTelemetriesParams creates a new PostTelemetriesParams object
+//
+// There are no default values defined in the spec.
+func NewPostTelemetriesParams() PostTelemetriesParams {
+
+	return PostTelemetriesParams{}
+}
+
+// PostTelemetriesParams contains all the bound params for the post telemetries operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters PostTelemetries
+type PostTelemetriesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Optional header to authenticate the trace source
+	  In: header
+	*/
+	XTraceSourceToken *string
+	/*
+	  Required: true
+	  In: body
+	*/
+	Body []*models.Telemetry
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewPostTelemetriesParams() beforehand.
+func (o *PostTelemetriesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if err := o.bindXTraceSourceToken(r.Header[http.CanonicalHeaderKey("X-Trace-Source-Token")], true, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body []*models.Telemetry
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			if err == io.EOF {
+				res = append(res, errors.Required("body", "body", ""))
+			} else {
+				res = append(res, errors.NewParseError("body", "body", "", err))
+			}
+		} else {
+
+			// validate array of body objects
+			for i := range body {
+				if body[i] == nil {
+					continue
+				}
+				if err := body[i].Validate(route.Formats); err != nil {
+					res = append(res, err)
+					break
+				}
+			}
+
+			if len(res) == 0 {
+				o.Body = body
+			}
+		}
+	} else {
+		res = append(res, errors.Required("body", "body", ""))
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindXTraceSourceToken binds and validates parameter XTraceSourceToken from header.
+func (o *PostTelemetriesParams) bindXTraceSourceToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.XTraceSourceToken = &raw
+
+	return nil
+}
@@ -0,0 +1,183 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	"github.com/openclarity/apiclarity/plugins/api/server/models"
+)
+
+// PostTelemetriesOKCode is the HTTP code returned for type PostTelemetriesOK
+const PostTelemetriesOKCode int = 200
+
+/*PostTelemetriesOK Success
+
+swagger:response postTelemetriesOK
+*/
+type PostTelemetriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.TelemetriesResult `json:"body,omitempty"`
+}
+
+// NewPostTelemetriesOK creates PostTelemetriesOK with default headers values
+func NewPostTelemetriesOK() *PostTelemetriesOK {
+
+	return &PostTelemetriesOK{}
+}
+
+// WithPayload adds the payload to the post telemetries o k response
+func (o *PostTelemetriesOK) WithPayload(payload *models.TelemetriesResult) *PostTelemetriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post telemetries o k response
+func (o *PostTelemetriesOK) SetPayload(payload *models.TelemetriesResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostTelemetriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// PostTelemetriesTooManyRequestsCode is the HTTP code returned for type PostTelemetriesTooManyRequests
+const PostTelemetriesTooManyRequestsCode int = 429
+
+/*PostTelemetriesTooManyRequests The telemetries ingestion queue is full
+
+swagger:response postTelemetriesTooManyRequests
+*/
+type PostTelemetriesTooManyRequests struct {
+	/*Number of seconds to wait before retrying
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostTelemetriesTooManyRequests creates PostTelemetriesTooManyRequests with default headers values
+func NewPostTelemetriesTooManyRequests() *PostTelemetriesTooManyRequests {
+
+	return &PostTelemetriesTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the post telemetries too many requests response
+func (o *PostTelemetriesTooManyRequests) WithRetryAfter(retryAfter int64) *PostTelemetriesTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the post telemetries too many requests response
+func (o *PostTelemetriesTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the post telemetries too many requests response
+func (o *PostTelemetriesTooManyRequests) WithPayload(payload *models.APIResponse) *PostTelemetriesTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post telemetries too many requests response
+func (o *PostTelemetriesTooManyRequests) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostTelemetriesTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*PostTelemetriesDefault unknown error
+
+swagger:response postTelemetriesDefault
+*/
+type PostTelemetriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostTelemetriesDefault creates PostTelemetriesDefault with default headers values
+func NewPostTelemetriesDefault(code int) *PostTelemetriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &PostTelemetriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the post telemetries default response
+func (o *PostTelemetriesDefault) WithStatusCode(code int) *PostTelemetriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the post telemetries default response
+func (o *PostTelemetriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the post telemetries default response
+func (o *PostTelemetriesDefault) WithPayload(payload *models.APIResponse) *PostTelemetriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post telemetries default response
+func (o *PostTelemetriesDefault) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostTelemetriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
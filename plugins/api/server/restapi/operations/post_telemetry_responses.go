@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/openclarity/apiclarity/plugins/api/server/models"
 )
@@ -55,6 +56,72 @@ func (o *PostTelemetryOK) WriteResponse(rw http.ResponseWriter, producer runtime
 	}
 }
 
+// PostTelemetryTooManyRequestsCode is the HTTP code returned for type PostTelemetryTooManyRequests
+const PostTelemetryTooManyRequestsCode int = 429
+
+/*PostTelemetryTooManyRequests The telemetries ingestion queue is full
+
+swagger:response postTelemetryTooManyRequests
+*/
+type PostTelemetryTooManyRequests struct {
+	/*Number of seconds to wait before retrying
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.APIResponse `json:"body,omitempty"`
+}
+
+// NewPostTelemetryTooManyRequests creates PostTelemetryTooManyRequests with default headers values
+func NewPostTelemetryTooManyRequests() *PostTelemetryTooManyRequests {
+
+	return &PostTelemetryTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the post telemetry too many requests response
+func (o *PostTelemetryTooManyRequests) WithRetryAfter(retryAfter int64) *PostTelemetryTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the post telemetry too many requests response
+func (o *PostTelemetryTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the post telemetry too many requests response
+func (o *PostTelemetryTooManyRequests) WithPayload(payload *models.APIResponse) *PostTelemetryTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post telemetry too many requests response
+func (o *PostTelemetryTooManyRequests) SetPayload(payload *models.APIResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostTelemetryTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 /*PostTelemetryDefault unknown error
 
 swagger:response postTelemetryDefault
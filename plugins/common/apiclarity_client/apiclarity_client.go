@@ -16,6 +16,7 @@
 package apiclarity_client
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
@@ -30,11 +31,15 @@ import (
 
 type Client struct {
 	telemetriesAPI *client.APIClarityPluginsTelemetriesAPI
+	watcher        *common.HostsToTraceWatcher
 	// local cache of hosts to trace
-	HostsToTrace     map[string]bool
-	samplingInterval time.Duration
-	lock             sync.RWMutex
-	token            string
+	HostsToTrace map[string]bool
+	// samplers of the hosts with a sampling rate
+	samplers common.HostSamplers
+	lock     sync.RWMutex
+	token    string
+	// endpoints the tracing of the hosts is restricted to
+	endpoints common.HostEndpoints
 }
 
 const allHosts = "*"
@@ -51,23 +56,19 @@ func Create(enableTLS bool, host string, token string, samplingInterval time.Dur
 		return nil, fmt.Errorf("failed to create new telemetry api client: %v", err)
 	}
 
-	return &Client{
-		telemetriesAPI:   apiClient,
-		samplingInterval: samplingInterval,
-		HostsToTrace:     map[string]bool{},
-		token:            token,
-	}, nil
+	t := &Client{
+		telemetriesAPI: apiClient,
+		HostsToTrace:   map[string]bool{},
+		token:          token,
+	}
+	t.watcher = common.NewHostsToTraceWatcher(apiClient, token, samplingInterval, t.updateHostsToTrace)
+	return t, nil
 }
 
+// Start keeps the hosts to trace up to date, watching their changes and polling them every sampling interval when
+// the traces server doesn't push them.
 func (t *Client) Start() {
-	go func() {
-		for {
-			select {
-			case <-time.After(t.samplingInterval):
-				_ = t.RefreshHostsToTrace()
-			}
-		}
-	}()
+	t.watcher.Start(context.Background())
 }
 
 func (t *Client) ShouldTrace(host, port string) bool {
@@ -96,18 +97,33 @@ func (t *Client) setHostsToTrace(hosts []string) {
 	}
 }
 
-func (t *Client) RefreshHostsToTrace() error {
-	params := operations.NewGetHostsToTraceParams().
-		WithXTraceSourceToken(&t.token)
+// ShouldSample returns whether a trace of the host must be kept according to its sampling rate.
+func (t *Client) ShouldSample(host, port string) bool {
+	if port != "" {
+		host = host + ":" + port
+	}
 
-	response, err := t.telemetriesAPI.Operations.GetHostsToTrace(params)
-	if err != nil {
-		log.Errorf("Failed to get hosts to trace: %v", err)
-		return err
-	} else {
-		t.setHostsToTrace(response.Payload.Hosts)
+	return t.samplers.Sample(host)
+}
+
+// ShouldTraceEndpoint returns whether a request of the host must be traced according to the endpoints the tracing of
+// the host is restricted to, the requests of the hosts without endpoints are all traced.
+func (t *Client) ShouldTraceEndpoint(host, port, method, path string) bool {
+	if port != "" {
+		host = host + ":" + port
 	}
-	return nil
+
+	return t.endpoints.Match(host, method, path)
+}
+
+func (t *Client) RefreshHostsToTrace() error {
+	return t.watcher.Refresh()
+}
+
+func (t *Client) updateHostsToTrace(hostsToTrace *models.HostsToTrace) {
+	t.setHostsToTrace(hostsToTrace.Hosts)
+	t.samplers.Set(hostsToTrace.SamplingRates)
+	t.endpoints.Set(hostsToTrace.Endpoints)
 }
 
 func (t *Client) PostTelemetry(telemetry *models.Telemetry) error {
@@ -123,6 +139,34 @@ func (t *Client) PostTelemetry(telemetry *models.Telemetry) error {
 	return nil
 }
 
+// PostTelemetries sends a batch of telemetries and returns the telemetries that failed to be handled by APIClarity,
+// so that the caller can retry them.
+func (t *Client) PostTelemetries(telemetries []*models.Telemetry) ([]*models.Telemetry, error) {
+	params := operations.NewPostTelemetriesParams().
+		WithBody(telemetries).
+		WithXTraceSourceToken(&t.token)
+
+	response, err := t.telemetriesAPI.Operations.PostTelemetries(params)
+	if err != nil {
+		log.Errorf("Failed to post telemetries: %v", err)
+		return telemetries, err
+	}
+
+	var failed []*models.Telemetry
+	for _, result := range response.Payload.Results {
+		if result.Success {
+			continue
+		}
+		if result.Index < 0 || int(result.Index) >= len(telemetries) {
+			log.Warnf("Got a result for an unknown telemetry index: %v", result.Index)
+			continue
+		}
+		log.Debugf("Telemetry %v failed: %v", result.RequestID, result.Message)
+		failed = append(failed, telemetries[result.Index])
+	}
+	return failed, nil
+}
+
 func (t *Client) PostNewDiscoveredAPIs(hosts []string) error {
 	body := operations.PostControlNewDiscoveredAPIsBody{
 		Hosts: hosts,
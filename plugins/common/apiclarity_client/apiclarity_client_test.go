@@ -0,0 +1,56 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiclarity_client
+
+import (
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+func TestClient_ShouldSample(t *testing.T) {
+	client := &Client{}
+	client.samplers.Set([]*models.HostSamplingRate{
+		{Host: "host1:8080", Ratio: 0},
+		{Host: "host2", Ratio: 1, MaxEventsPerSecond: 10},
+	})
+
+	assert.Equal(t, client.ShouldSample("host1", "8080"), false)
+	assert.Equal(t, client.ShouldSample("host1", "9090"), true)
+	assert.Equal(t, client.ShouldSample("host2", ""), true)
+	assert.Equal(t, client.ShouldSample("host3", ""), true)
+
+	client.samplers.Set([]*models.HostSamplingRate{
+		{Host: "host2", Ratio: 1, MaxEventsPerSecond: 10},
+	})
+	assert.Equal(t, client.ShouldSample("host1", "8080"), true)
+}
+
+func TestClient_ShouldTraceEndpoint(t *testing.T) {
+	client := &Client{}
+	client.endpoints.Set([]*models.HostEndpoint{
+		{Host: "host1:8080", Method: "POST", Path: "/v1/checkout"},
+		{Host: "host1:8080", Path: "/v1/carts/{cartId}"},
+	})
+
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "POST", "/v1/checkout"), true)
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "GET", "/v1/checkout"), false)
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "DELETE", "/v1/carts/42?force=true"), true)
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "GET", "/v1/items"), false)
+	assert.Equal(t, client.ShouldTraceEndpoint("host2", "", "GET", "/v1/items"), true)
+}
@@ -6,9 +6,9 @@ require (
 	github.com/go-openapi/runtime v0.21.0
 	github.com/go-openapi/strfmt v0.21.0
 	github.com/openclarity/apiclarity/plugins/api v0.0.0
-	github.com/openclarity/trace-sampling-manager/api v0.0.0-20220503091058-2730f5ebad2c
 	github.com/satori/go.uuid v1.2.0
 	github.com/sirupsen/logrus v1.4.2
+	golang.org/x/time v0.0.0-20210723032227-1f47c861a9ac
 	gotest.tools v2.2.0+incompatible
 )
 
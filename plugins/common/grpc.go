@@ -0,0 +1,58 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+	"github.com/openclarity/apiclarity/plugins/api/grpcutils"
+)
+
+const (
+	GRPCStatusHeaderKey  = "Grpc-Status"
+	GRPCMessageHeaderKey = "Grpc-Message"
+)
+
+// CreateGRPCTelemetry returns the gRPC details of a request, or nil if it is not a gRPC request.
+// The response headers are expected to include the trailers (grpc-status, grpc-message).
+func CreateGRPCTelemetry(path string, reqHeaders, resHeaders http.Header, reqBody, resBody []byte) *models.GrpcTelemetry {
+	if !grpcutils.IsGRPCMediaType(reqHeaders.Get("Content-Type")) {
+		return nil
+	}
+	service, method, ok := grpcutils.SplitPath(path)
+	if !ok {
+		return nil
+	}
+
+	ret := &models.GrpcTelemetry{
+		Service: service,
+		Method:  method,
+	}
+	if status, err := strconv.ParseInt(resHeaders.Get(GRPCStatusHeaderKey), 10, 32); err == nil {
+		ret.Status = int32(status)
+	}
+	// grpc-message is percent-encoded
+	if message, err := url.PathUnescape(resHeaders.Get(GRPCMessageHeaderKey)); err == nil {
+		ret.StatusMessage = message
+	}
+	ret.RequestMessagesCount, ret.RequestMessagesSize = grpcutils.MessagesCountAndSize(reqBody)
+	ret.ResponseMessagesCount, ret.ResponseMessagesSize = grpcutils.MessagesCountAndSize(resBody)
+
+	return ret
+}
@@ -0,0 +1,56 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+func grpcMessage(payload string) []byte {
+	length := len(payload)
+	return append([]byte{0, byte(length >> 24), byte(length >> 16), byte(length >> 8), byte(length)}, payload...)
+}
+
+func TestCreateGRPCTelemetry(t *testing.T) {
+	reqHeaders := http.Header{}
+	reqHeaders.Set("Content-Type", "application/grpc+proto")
+	resHeaders := http.Header{}
+	resHeaders.Set("Grpc-Status", "5")
+	resHeaders.Set("Grpc-Message", "user%20not%20found")
+
+	assert.DeepEqual(t, CreateGRPCTelemetry("/users.v1.Users/GetUser", reqHeaders, resHeaders, grpcMessage("abc"), nil), &models.GrpcTelemetry{
+		Service:              "users.v1.Users",
+		Method:               "GetUser",
+		Status:               5,
+		StatusMessage:        "user not found",
+		RequestMessagesCount: 1,
+		RequestMessagesSize:  3,
+	})
+
+	assert.Assert(t, CreateGRPCTelemetry("/users.v1.Users", reqHeaders, resHeaders, nil, nil) == nil)
+
+	// the content type is parsed as a media type
+	reqHeaders.Set("Content-Type", "Application/GRPC; charset=utf-8")
+	assert.Equal(t, CreateGRPCTelemetry("/users.v1.Users/GetUser", reqHeaders, resHeaders, nil, nil).Method, "GetUser")
+
+	reqHeaders.Set("Content-Type", "application/json")
+	assert.Assert(t, CreateGRPCTelemetry("/users.v1.Users/GetUser", reqHeaders, resHeaders, nil, nil) == nil)
+}
@@ -0,0 +1,137 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/client"
+	"github.com/openclarity/apiclarity/plugins/api/client/client/operations"
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+const (
+	// HostsToTraceWatchTimeoutSec is how long the traces server holds a watch of the hosts to trace without change.
+	HostsToTraceWatchTimeoutSec = 30
+	// the watch request timeout must leave the server the time to answer once the watch timed out
+	hostsToTraceWatchRequestTimeout = (HostsToTraceWatchTimeoutSec + 10) * time.Second
+)
+
+// HostsToTraceWatcher keeps the hosts to trace of a trace source up to date. It watches the changes of the hosts to
+// trace on the traces server so that they are applied as soon as they change, and falls back to polling them every
+// poll interval when the watch fails (e.g. the traces server doesn't support it).
+type HostsToTraceWatcher struct {
+	telemetriesAPI *client.APIClarityPluginsTelemetriesAPI
+	token          *string
+	pollInterval   time.Duration
+	update         func(hostsToTrace *models.HostsToTrace)
+
+	// version of the last hosts to trace received
+	etag string
+	lock sync.Mutex
+}
+
+func NewHostsToTraceWatcher(telemetriesAPI *client.APIClarityPluginsTelemetriesAPI, token string, pollInterval time.Duration, update func(hostsToTrace *models.HostsToTrace)) *HostsToTraceWatcher {
+	w := &HostsToTraceWatcher{
+		telemetriesAPI: telemetriesAPI,
+		pollInterval:   pollInterval,
+		update:         update,
+	}
+	if token != "" {
+		w.token = &token
+	}
+	return w
+}
+
+// Start watches the hosts to trace until ctx is done.
+func (w *HostsToTraceWatcher) Start(ctx context.Context) {
+	go func() {
+		for {
+			err := w.Refresh()
+			for err == nil && w.getETag() != "" {
+				err = w.watch(ctx)
+			}
+			if err != nil && ctx.Err() == nil {
+				log.Debugf("Polling hosts to trace every %v: %v", w.pollInterval, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(w.pollInterval):
+			}
+		}
+	}()
+}
+
+// Refresh gets the hosts to trace and updates them.
+func (w *HostsToTraceWatcher) Refresh() error {
+	params := operations.NewGetHostsToTraceParams().
+		WithXTraceSourceToken(w.token)
+
+	response, err := w.telemetriesAPI.Operations.GetHostsToTrace(params)
+	if err != nil {
+		log.Errorf("Failed to get hosts to trace: %v", err)
+		return err
+	}
+	w.setETag(response.ETag)
+	w.update(response.Payload)
+	return nil
+}
+
+// watch waits for a change of the hosts to trace and updates them, it returns without error when they didn't change
+// before the watch timed out.
+func (w *HostsToTraceWatcher) watch(ctx context.Context) error {
+	etag := w.getETag()
+	timeoutSec := int64(HostsToTraceWatchTimeoutSec)
+	params := operations.NewGetHostsToTraceWatchParamsWithContext(ctx).
+		WithTimeout(hostsToTraceWatchRequestTimeout).
+		WithXTraceSourceToken(w.token).
+		WithIfNoneMatch(&etag).
+		WithTimeoutSec(&timeoutSec)
+
+	response, err := w.telemetriesAPI.Operations.GetHostsToTraceWatch(params)
+	if err != nil {
+		var notModified *operations.GetHostsToTraceWatchNotModified
+		if errors.As(err, &notModified) {
+			return nil
+		}
+		return fmt.Errorf("failed to watch hosts to trace: %v", err)
+	}
+	w.setETag(response.ETag)
+	w.update(response.Payload)
+	return nil
+}
+
+func (w *HostsToTraceWatcher) getETag() string {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	return w.etag
+}
+
+func (w *HostsToTraceWatcher) setETag(etag string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+
+	w.etag = etag
+}
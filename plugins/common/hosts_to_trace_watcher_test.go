@@ -0,0 +1,119 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+func writeHostsToTrace(w http.ResponseWriter, etag string, hosts ...string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", etag)
+	_ = json.NewEncoder(w).Encode(&models.HostsToTrace{Hosts: hosts})
+}
+
+func startWatcher(t *testing.T, handler http.HandlerFunc, pollInterval time.Duration) <-chan []string {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := NewTelemetryAPIClient(strings.TrimPrefix(server.URL, "http://"), nil)
+	assert.NilError(t, err)
+
+	updates := make(chan []string, 10)
+	watcher := NewHostsToTraceWatcher(apiClient, "", pollInterval, func(hostsToTrace *models.HostsToTrace) {
+		updates <- hostsToTrace.Hosts
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	watcher.Start(ctx)
+
+	return updates
+}
+
+func nextUpdate(t *testing.T, updates <-chan []string) []string {
+	t.Helper()
+
+	select {
+	case hosts := <-updates:
+		return hosts
+	case <-time.After(5 * time.Second):
+		t.Fatal("no update of the hosts to trace")
+		return nil
+	}
+}
+
+func TestHostsToTraceWatcher_watch(t *testing.T) {
+	var watches int32
+	updates := startWatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/hostsToTrace":
+			writeHostsToTrace(w, `"v1"`, "host1")
+		case "/api/hostsToTrace/watch":
+			assert.Equal(t, r.URL.Query().Get("timeoutSec"), "30")
+			switch atomic.AddInt32(&watches, 1) {
+			case 1:
+				assert.Equal(t, r.Header.Get("If-None-Match"), `"v1"`)
+				w.Header().Set("ETag", `"v1"`)
+				w.WriteHeader(http.StatusNotModified)
+			case 2:
+				assert.Equal(t, r.Header.Get("If-None-Match"), `"v1"`)
+				writeHostsToTrace(w, `"v2"`, "host1", "host2")
+			default:
+				assert.Equal(t, r.Header.Get("If-None-Match"), `"v2"`)
+				<-r.Context().Done()
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, time.Hour)
+
+	assert.DeepEqual(t, nextUpdate(t, updates), []string{"host1"})
+	// the change is received through the watch, long before the poll interval
+	assert.DeepEqual(t, nextUpdate(t, updates), []string{"host1", "host2"})
+}
+
+func TestHostsToTraceWatcher_pollingFallback(t *testing.T) {
+	var refreshes int32
+	updates := startWatcher(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/hostsToTrace":
+			if atomic.AddInt32(&refreshes, 1) == 1 {
+				writeHostsToTrace(w, `"v1"`, "host1")
+			} else {
+				writeHostsToTrace(w, `"v2"`, "host2")
+			}
+		default:
+			// traces server without watch support
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}, 10*time.Millisecond)
+
+	assert.DeepEqual(t, nextUpdate(t, updates), []string{"host1"})
+	assert.DeepEqual(t, nextUpdate(t, updates), []string{"host2"})
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+// Sampler keeps a ratio of the traces and caps their rate, following a sampling rate set in APIClarity.
+type Sampler struct {
+	Ratio              float64
+	MaxEventsPerSecond float64
+
+	limiter *rate.Limiter
+	now     func() time.Time
+}
+
+func NewSampler(ratio, maxEventsPerSecond float64) *Sampler {
+	s := &Sampler{
+		Ratio:              ratio,
+		MaxEventsPerSecond: maxEventsPerSecond,
+		now:                time.Now,
+	}
+	if maxEventsPerSecond > 0 {
+		// allow a burst of a second of traces, at least one
+		burst := int(maxEventsPerSecond)
+		if burst < 1 {
+			burst = 1
+		}
+		s.limiter = rate.NewLimiter(rate.Limit(maxEventsPerSecond), burst)
+	}
+	return s
+}
+
+// Sample returns whether the trace must be kept.
+func (s *Sampler) Sample() bool {
+	if s.Ratio < 1 && rand.Float64() >= s.Ratio { //nolint:gosec
+		return false
+	}
+	return s.limiter == nil || s.limiter.AllowN(s.now(), 1)
+}
+
+// HostSamplers samples the traces of the hosts following their sampling rates, the traces of the hosts without
+// a sampling rate are all kept.
+type HostSamplers struct {
+	lock     sync.RWMutex
+	samplers map[string]*Sampler
+}
+
+// Sample returns whether a trace of the host (host or host:port) must be kept.
+func (h *HostSamplers) Sample(host string) bool {
+	h.lock.RLock()
+	sampler, ok := h.samplers[host]
+	h.lock.RUnlock()
+
+	return !ok || sampler.Sample()
+}
+
+// Set replaces the sampling rates of the hosts, the samplers of the unchanged rates keep their state.
+func (h *HostSamplers) Set(rates []*models.HostSamplingRate) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	samplers := make(map[string]*Sampler, len(rates))
+	for _, rate := range rates {
+		if sampler, ok := h.samplers[rate.Host]; ok && sampler.Ratio == rate.Ratio && sampler.MaxEventsPerSecond == rate.MaxEventsPerSecond {
+			samplers[rate.Host] = sampler
+			continue
+		}
+		samplers[rate.Host] = NewSampler(rate.Ratio, rate.MaxEventsPerSecond)
+	}
+	h.samplers = samplers
+}
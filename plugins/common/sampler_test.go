@@ -0,0 +1,89 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package common
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+)
+
+func countSampled(s *Sampler, n int) int {
+	count := 0
+	for i := 0; i < n; i++ {
+		if s.Sample() {
+			count++
+		}
+	}
+	return count
+}
+
+func TestSampler_ratio(t *testing.T) {
+	assert.Equal(t, countSampled(NewSampler(1, 0), 1000), 1000)
+	assert.Equal(t, countSampled(NewSampler(0, 0), 1000), 0)
+
+	sampled := countSampled(NewSampler(0.5, 0), 10000)
+	assert.Assert(t, sampled > 4000 && sampled < 6000, sampled)
+}
+
+func TestSampler_maxEventsPerSecond(t *testing.T) {
+	now := time.Unix(1000, 0)
+	s := NewSampler(1, 10)
+	s.now = func() time.Time { return now }
+
+	// a burst of a second of traces
+	assert.Equal(t, countSampled(s, 100), 10)
+
+	now = now.Add(500 * time.Millisecond)
+	assert.Equal(t, countSampled(s, 100), 5)
+
+	// the bucket doesn't grow over a second of traces
+	now = now.Add(time.Minute)
+	assert.Equal(t, countSampled(s, 100), 10)
+
+	// less than a trace per second
+	s = NewSampler(1, 0.5)
+	s.now = func() time.Time { return now }
+	assert.Equal(t, countSampled(s, 10), 1)
+	now = now.Add(time.Second)
+	assert.Equal(t, countSampled(s, 10), 0)
+	now = now.Add(time.Second)
+	assert.Equal(t, countSampled(s, 10), 1)
+}
+
+func TestHostSamplers(t *testing.T) {
+	samplers := &HostSamplers{}
+	assert.Equal(t, samplers.Sample("host1:8080"), true)
+
+	samplers.Set([]*models.HostSamplingRate{
+		{Host: "host1:8080", Ratio: 0},
+		{Host: "host2", Ratio: 1, MaxEventsPerSecond: 10},
+	})
+	assert.Equal(t, samplers.Sample("host1:8080"), false)
+	assert.Equal(t, samplers.Sample("host1:9090"), true)
+	assert.Equal(t, samplers.Sample("host2"), true)
+
+	// unchanged samplers are kept
+	sampler := samplers.samplers["host2"]
+	samplers.Set([]*models.HostSamplingRate{
+		{Host: "host2", Ratio: 1, MaxEventsPerSecond: 10},
+	})
+	assert.Equal(t, samplers.samplers["host2"], sampler)
+	assert.Equal(t, samplers.Sample("host1:8080"), true)
+}
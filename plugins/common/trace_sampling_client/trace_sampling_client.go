@@ -16,62 +16,44 @@
 package trace_sampling_client
 
 import (
-	"fmt"
+	"context"
 	"sync"
 	"time"
 
-	log "github.com/sirupsen/logrus"
-
+	"github.com/openclarity/apiclarity/plugins/api/client/client"
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
 	"github.com/openclarity/apiclarity/plugins/common"
-	"github.com/openclarity/trace-sampling-manager/api/client/client"
-	"github.com/openclarity/trace-sampling-manager/api/client/client/operations"
 )
 
 type Client struct {
-	TraceSamplingManagerClient *client.TraceSamplingManager
+	watcher *common.HostsToTraceWatcher
 	// local cache of hosts to trace
-	Hosts            map[string]bool
-	samplingInterval time.Duration
-	lock             sync.RWMutex
+	Hosts map[string]bool
+	lock  sync.RWMutex
+	// samplers of the hosts with a sampling rate
+	samplers common.HostSamplers
+	// endpoints the tracing of the hosts is restricted to
+	endpoints common.HostEndpoints
 }
 
 const allHosts = "*"
 
-func Create(enableTLS bool, host string, samplingInterval time.Duration) (*Client, error) {
-	var tlsOptions *common.ClientTLSOptions
-	if enableTLS {
-		tlsOptions = &common.ClientTLSOptions{
-			RootCAFileName: common.CACertFile,
-		}
-	}
-	apiClient, err := common.NewTraceSamplingAPIClient(host, tlsOptions)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create new trace sampling api client: %v", err)
+// Create returns a client of the hosts to trace of the traces server of telemetriesAPI, polled every pollInterval
+// when the traces server doesn't push their changes.
+func Create(telemetriesAPI *client.APIClarityPluginsTelemetriesAPI, pollInterval time.Duration) *Client {
+	t := &Client{
+		Hosts: map[string]bool{},
 	}
-
-	return &Client{
-		TraceSamplingManagerClient: apiClient,
-		samplingInterval:           samplingInterval,
-		Hosts:                      map[string]bool{},
-	}, nil
+	t.watcher = common.NewHostsToTraceWatcher(telemetriesAPI, "", pollInterval, func(hostsToTrace *models.HostsToTrace) {
+		t.setHosts(hostsToTrace.Hosts)
+		t.samplers.Set(hostsToTrace.SamplingRates)
+		t.endpoints.Set(hostsToTrace.Endpoints)
+	})
+	return t
 }
 
 func (t *Client) Start() {
-	go func() {
-		for {
-			select {
-			case <-time.After(t.samplingInterval):
-				params := operations.NewGetHostsToTraceParams()
-
-				response, err := t.TraceSamplingManagerClient.Operations.GetHostsToTrace(params)
-				if err != nil {
-					log.Errorf("Failed to get hosts to trace: %v", err)
-				} else {
-					t.setHosts(response.Payload.Hosts)
-				}
-			}
-		}
-	}()
+	t.watcher.Start(context.Background())
 }
 
 func (t *Client) ShouldTrace(host, port string) bool {
@@ -93,6 +75,25 @@ func (t *Client) ShouldTrace(host, port string) bool {
 	return false
 }
 
+// ShouldSample returns whether a trace of the host must be kept according to its sampling rate.
+func (t *Client) ShouldSample(host, port string) bool {
+	if port != "" {
+		host = host + ":" + port
+	}
+
+	return t.samplers.Sample(host)
+}
+
+// ShouldTraceEndpoint returns whether a request of the host must be traced according to the endpoints the tracing of
+// the host is restricted to, the requests of the hosts without endpoints are all traced.
+func (t *Client) ShouldTraceEndpoint(host, port, method, path string) bool {
+	if port != "" {
+		host = host + ":" + port
+	}
+
+	return t.endpoints.Match(host, method, path)
+}
+
 func (t *Client) setHosts(hosts []string) {
 	t.lock.Lock()
 	defer t.lock.Unlock()
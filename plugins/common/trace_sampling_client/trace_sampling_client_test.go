@@ -16,19 +16,18 @@
 package trace_sampling_client
 
 import (
-	"github.com/openclarity/trace-sampling-manager/api/client/client"
+	"github.com/openclarity/apiclarity/plugins/api/client/models"
+	"github.com/openclarity/apiclarity/plugins/common"
 	"gotest.tools/assert"
 	"sync"
 	"testing"
-	"time"
 )
 
 func TestTraceSamplingManager_setHosts(t1 *testing.T) {
 	type fields struct {
-		TraceSamplingManagerClient *client.TraceSamplingManager
-		Hosts                      map[string]bool
-		samplingInterval           time.Duration
-		lock                       sync.RWMutex
+		watcher *common.HostsToTraceWatcher
+		Hosts   map[string]bool
+		lock    sync.RWMutex
 	}
 	type args struct {
 		hosts []string
@@ -81,10 +80,9 @@ func TestTraceSamplingManager_setHosts(t1 *testing.T) {
 
 func TestTraceSamplingManager_ShouldTrace(t1 *testing.T) {
 	type fields struct {
-		TraceSamplingManagerClient *client.TraceSamplingManager
-		Hosts                      map[string]bool
-		samplingInterval           time.Duration
-		lock                       sync.RWMutex
+		watcher *common.HostsToTraceWatcher
+		Hosts   map[string]bool
+		lock    sync.RWMutex
 	}
 	type args struct {
 		host string
@@ -190,3 +188,27 @@ func TestTraceSamplingManager_ShouldTrace(t1 *testing.T) {
 		})
 	}
 }
+
+func TestTraceSamplingManager_ShouldSample(t *testing.T) {
+	client := &Client{}
+	assert.Equal(t, client.ShouldSample("host1", "8080"), true)
+
+	client.samplers.Set([]*models.HostSamplingRate{
+		{Host: "host1:8080", Ratio: 0},
+		{Host: "host2", Ratio: 1},
+	})
+	assert.Equal(t, client.ShouldSample("host1", "8080"), false)
+	assert.Equal(t, client.ShouldSample("host1", "9090"), true)
+	assert.Equal(t, client.ShouldSample("host2", ""), true)
+}
+
+func TestTraceSamplingManager_ShouldTraceEndpoint(t *testing.T) {
+	client := &Client{}
+	client.endpoints.Set([]*models.HostEndpoint{
+		{Host: "host1:8080", Method: "POST", Path: "/v1/checkout"},
+	})
+
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "POST", "/v1/checkout?id=1"), true)
+	assert.Equal(t, client.ShouldTraceEndpoint("host1", "8080", "GET", "/v1/checkout"), false)
+	assert.Equal(t, client.ShouldTraceEndpoint("host2", "", "GET", "/v1/items"), true)
+}
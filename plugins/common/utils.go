@@ -24,6 +24,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-openapi/runtime"
@@ -32,8 +33,6 @@ import (
 	uuid "github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
 
-	tracesamplingclient "github.com/openclarity/trace-sampling-manager/api/client/client"
-
 	"github.com/openclarity/apiclarity/plugins/api/client/client"
 	"github.com/openclarity/apiclarity/plugins/api/client/models"
 )
@@ -113,23 +112,6 @@ func NewTelemetryAPIClient(host string, tlsOptions *ClientTLSOptions) (*client.A
 	return apiClient, nil
 }
 
-func NewTraceSamplingAPIClient(host string, tlsOptions *ClientTLSOptions) (*tracesamplingclient.TraceSamplingManager, error) {
-	var clientTransport runtime.ClientTransport
-	var err error
-
-	if tlsOptions != nil {
-		clientTransport, err = createClientTransportTLS(host, tlsOptions)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create tls client transport: %v", err)
-		}
-	} else {
-		clientTransport = httptransport.New(host, client.DefaultBasePath, []string{"http"})
-	}
-
-	apiClient := tracesamplingclient.New(clientTransport, strfmt.Default)
-	return apiClient, nil
-}
-
 func createClientTransportTLS(host string, tlsOptions *ClientTLSOptions) (runtime.ClientTransport, error) {
 	// Get the SystemCertPool
 	rootCAs, _ := x509.SystemCertPool()
@@ -213,3 +195,63 @@ func Contains(slice []string, value string) bool {
 	}
 	return false
 }
+
+// MatchPathTemplate returns whether a path (without query) matches a path template of a spec,
+// the parameters of the template (e.g. {id}) match any path segment.
+func MatchPathTemplate(template, path string) bool {
+	templateSegments := strings.Split(strings.TrimSuffix(template, "/"), "/")
+	pathSegments := strings.Split(strings.TrimSuffix(path, "/"), "/")
+	if len(templateSegments) != len(pathSegments) {
+		return false
+	}
+	for i, segment := range templateSegments {
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			if pathSegments[i] == "" {
+				return false
+			}
+			continue
+		}
+		if segment != pathSegments[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// HostEndpoints holds the endpoints the tracing of the hosts is restricted to.
+type HostEndpoints struct {
+	lock      sync.RWMutex
+	endpoints map[string][]*models.HostEndpoint
+}
+
+// Match returns whether a request of the host (host or host:port) must be traced, the requests of the hosts without
+// endpoints are all traced.
+func (h *HostEndpoints) Match(host, method, path string) bool {
+	h.lock.RLock()
+	endpoints := h.endpoints[host]
+	h.lock.RUnlock()
+
+	if len(endpoints) == 0 {
+		return true
+	}
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	for _, endpoint := range endpoints {
+		if (endpoint.Method == "" || strings.EqualFold(endpoint.Method, method)) && MatchPathTemplate(endpoint.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// Set replaces the endpoints of the hosts.
+func (h *HostEndpoints) Set(hostEndpoints []*models.HostEndpoint) {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+
+	h.endpoints = make(map[string][]*models.HostEndpoint)
+	for _, endpoint := range hostEndpoints {
+		h.endpoints[endpoint.Host] = append(h.endpoints[endpoint.Host], endpoint)
+	}
+}
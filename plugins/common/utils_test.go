@@ -252,3 +252,54 @@ func Test_getHostAndPortFromTargetURL(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchPathTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		path     string
+		want     bool
+	}{
+		{template: "/v1/checkout", path: "/v1/checkout", want: true},
+		{template: "/v1/checkout", path: "/v1/checkout/", want: true},
+		{template: "/v1/checkout", path: "/v1/cart", want: false},
+		{template: "/v1/carts/{cartId}/checkout", path: "/v1/carts/42/checkout", want: true},
+		{template: "/v1/carts/{cartId}/checkout", path: "/v1/carts//checkout", want: false},
+		{template: "/v1/carts/{cartId}", path: "/v1/carts/42/checkout", want: false},
+		{template: "/", path: "/", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.template+" "+tt.path, func(t *testing.T) {
+			if got := MatchPathTemplate(tt.template, tt.path); got != tt.want {
+				t.Errorf("MatchPathTemplate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHostEndpoints_Match(t *testing.T) {
+	endpoints := &HostEndpoints{}
+	if !endpoints.Match("host1:8080", "GET", "/v1/items") {
+		t.Errorf("Match() = false without endpoints, want true")
+	}
+
+	endpoints.Set([]*models.HostEndpoint{
+		{Host: "host1:8080", Method: "POST", Path: "/v1/checkout"},
+		{Host: "host1:8080", Path: "/v1/carts/{cartId}"},
+	})
+	tests := []struct {
+		host, method, path string
+		want               bool
+	}{
+		{host: "host1:8080", method: "POST", path: "/v1/checkout", want: true},
+		{host: "host1:8080", method: "post", path: "/v1/checkout", want: true},
+		{host: "host1:8080", method: "GET", path: "/v1/checkout", want: false},
+		{host: "host1:8080", method: "DELETE", path: "/v1/carts/42?force=true", want: true},
+		{host: "host1:8080", method: "GET", path: "/v1/items", want: false},
+		{host: "host2", method: "GET", path: "/v1/items", want: true},
+	}
+	for _, tt := range tests {
+		if got := endpoints.Match(tt.host, tt.method, tt.path); got != tt.want {
+			t.Errorf("Match(%v, %v, %v) = %v, want %v", tt.host, tt.method, tt.path, got, tt.want)
+		}
+	}
+}
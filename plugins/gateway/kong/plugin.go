@@ -146,11 +146,27 @@ func shouldTrace(kong *pdk.PDK) (bool, error) {
 	}
 	host, port, _ := parseKongHost(routedService.Host)
 	// Note: here 'host' contain already the namespace. i.e. for service 'catalog' on namespace 'sock-shop', host will be 'catalog.sock-shop'
-	if apiclarityClient.ShouldTrace(host, port) {
-		return true, nil
+	if !apiclarityClient.ShouldTrace(host, port) {
+		_ = kong.Log.Debug("Ignoring host: %v:%v", host, port)
+		return false, nil
 	}
-	_ = kong.Log.Debug("Ignoring host: %v:%v", host, port)
-	return false, nil
+	method, err := kong.Request.GetMethod()
+	if err != nil {
+		return false, fmt.Errorf("failed to get request method for shouldTrace: %v", err)
+	}
+	path, err := kong.Request.GetPath()
+	if err != nil {
+		return false, fmt.Errorf("failed to get request path for shouldTrace: %v", err)
+	}
+	if !apiclarityClient.ShouldTraceEndpoint(host, port, method, path) {
+		_ = kong.Log.Debug("Ignoring endpoint: %v %v of host: %v:%v", method, path, host, port)
+		return false, nil
+	}
+	if !apiclarityClient.ShouldSample(host, port) {
+		_ = kong.Log.Debug("Sampling out trace of host: %v:%v", host, port)
+		return false, nil
+	}
+	return true, nil
 }
 
 func getHost(kong *pdk.PDK) (string, error) {
@@ -5,7 +5,6 @@ TykGatewayDeploymentNamespace="${TYK_GATEWAY_DEPLOYMENT_NAMESPACE:-default}"
 UpstreamTelemetryHostName="${UPSTREAM_TELEMETRY_HOST_NAME:-apiclarity-apiclarity.apiclarity}"
 UpstreamTelemetryHTTPPort="${UPSTREAM_TELEMETRY_HTTP_PORT:-9000}"
 UpstreamTelemetryTLSPort="${UPSTREAM_TELEMETRY_TLS_PORT:-10443}"
-TraceSamplingHostName="${TRACE_SAMPLING_HOST_NAME:-apiclarity-apiclarity.apiclarity:9990}"
 TraceSamplingEnabled="${TRACE_SAMPLING_ENABLED:-false}"
 EnableTLS="${ENABLE_TLS:-false}"
 RootCertConfigMapName="${ROOT_CERT_CONFIGMAP_NAME:-apiclarity-root-ca.crt}"
@@ -117,9 +117,8 @@ func ResponseSendTelemetry(_ http.ResponseWriter, res *http.Response, req *http.
 		logger.Error("failed to get api definition")
 		return
 	}
+	host, port := common.GetHostAndPortFromURL(apiDefinition.Proxy.TargetURL, gatewayNamespace)
 	if traceSamplingEnabled && apiclarityClient != nil {
-		host, port := common.GetHostAndPortFromURL(apiDefinition.Proxy.TargetURL, gatewayNamespace)
-
 		err := processNewDiscoveredAPI(host, port)
 		if err != nil {
 			logger.Errorf("failed to processNewDiscoveredAPI: '%v'", err)
@@ -129,6 +128,14 @@ func ResponseSendTelemetry(_ http.ResponseWriter, res *http.Response, req *http.
 			logger.Infof("ignored host: %v:%v", host, port)
 			return
 		}
+		if !apiclarityClient.ShouldTraceEndpoint(host, port, req.Method, req.URL.Path) {
+			logger.Debugf("ignored endpoint: %v %v of host: %v:%v", req.Method, req.URL.Path, host, port)
+			return
+		}
+	}
+	if apiclarityClient != nil && !apiclarityClient.ShouldSample(host, port) {
+		logger.Debugf("sampled out trace of host: %v:%v", host, port)
+		return
 	}
 
 	telemetry, err := createTelemetry(res, req, apiDefinition)
@@ -28,6 +28,7 @@ import (
 	apiclient "github.com/openclarity/apiclarity/plugins/api/client/client"
 	apiclientops "github.com/openclarity/apiclarity/plugins/api/client/client/operations"
 	apiclientmodels "github.com/openclarity/apiclarity/plugins/api/client/models"
+	"github.com/openclarity/apiclarity/plugins/otel-collector/converter"
 	"go.uber.org/zap"
 
 	"go.opentelemetry.io/collector/component"
@@ -44,6 +45,7 @@ type exporter struct {
 	userAgent string
 	client    *http.Client
 	service   *apiclient.APIClarityPluginsTelemetriesAPI
+	converter *converter.Converter
 }
 
 // Create new exporter.
@@ -63,6 +65,7 @@ func newExporter(oCfg *Config, set component.ExporterCreateSettings) (*exporter,
 		userAgent: userAgent,
 		settings:  set.TelemetrySettings,
 		service:   nil,
+		converter: converter.New(set.Logger, oCfg.PreferHostNames),
 	}, nil
 }
 
@@ -119,7 +122,7 @@ func (e *exporter) pushTraces(ctx context.Context, td ptrace.Traces) error {
 
 				// TODO: process rest of spans on error, then return errors
 				// generate permanent errors during processing and check here
-				actel, perr := e.processOTelSpan(res, scope, span)
+				actel, perr := e.converter.ProcessOTelSpan(res, scope, span)
 				if perr != nil {
 					return consumererror.NewPermanent(perr)
 				}
@@ -13,7 +13,9 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-package apiclarityexporter
+// Package converter converts OpenTelemetry spans into APIClarity telemetries.
+// It is shared by the APIClarity OpenTelemetry Collector exporter and by the OTLP receiver of the backend.
+package converter
 
 import (
 	"fmt"
@@ -42,6 +44,20 @@ const (
 	ResponseBody         = attribute.Key("response_body")
 )
 
+// Converter converts OpenTelemetry HTTP client and server spans into APIClarity telemetries.
+type Converter struct {
+	logger *zap.Logger
+	// preferHostNames resolves IP addresses to host names when true, and host names to IP addresses otherwise.
+	preferHostNames bool
+}
+
+func New(logger *zap.Logger, preferHostNames bool) *Converter {
+	return &Converter{
+		logger:          logger,
+		preferHostNames: preferHostNames,
+	}
+}
+
 func wrapAttributeError(logger *zap.Logger, msg, attrKey, attrValue string, err error) error {
 	logger.Debug(msg,
 		zap.String("attribute", attrKey),
@@ -51,58 +67,58 @@ func wrapAttributeError(logger *zap.Logger, msg, attrKey, attrValue string, err
 	return fmt.Errorf("%s, attribute: %s, value: %s, error: %w", msg, attrKey, attrValue, err)
 }
 
-func (e *exporter) convertAddr(addr string) string {
+func (c *Converter) convertAddr(addr string) string {
 	//TODO: make it configurable to prefer IP or hostname
 	isIpAddr := net.ParseIP(addr) != nil
-	if isIpAddr && e.config.PreferHostNames {
+	if isIpAddr && c.preferHostNames {
 		if aliases, err := net.LookupAddr(addr); err != nil && len(aliases) > 0 {
-			e.logger.Info("lookup IP to get hostname",
+			c.logger.Info("lookup IP to get hostname",
 				zap.String("address", addr),
 				zap.String("host", aliases[0]),
 			)
 			return aliases[0]
 		} else if err != nil {
-			e.logger.Info("failed lookup IP to get hostname",
+			c.logger.Info("failed lookup IP to get hostname",
 				zap.String("address", addr),
 				zap.Error(err),
 			)
 		}
-	} else if !isIpAddr && !e.config.PreferHostNames {
+	} else if !isIpAddr && !c.preferHostNames {
 		if hosts, err := net.LookupHost(addr); err != nil && len(hosts) > 0 {
-			e.logger.Info("lookup hostname to get IP",
+			c.logger.Info("lookup hostname to get IP",
 				zap.String("address", addr),
 				zap.String("host", hosts[0]),
 			)
 			return hosts[0]
 		} else if err != nil {
-			e.logger.Info("failed lookup hostname to get IP",
+			c.logger.Info("failed lookup hostname to get IP",
 				zap.String("address", addr),
 				zap.Error(err),
 			)
 		}
 	} else {
-		e.logger.Info("address is already in preferred form",
+		c.logger.Info("address is already in preferred form",
 			zap.String("address", addr),
 		)
 	}
 	return addr
 }
 
-func (e *exporter) convertHost(addr string) string {
+func (c *Converter) convertHost(addr string) string {
 	if addr == "" {
 		return addr
 	}
 	//Manage prefs for IP v. hostname for <ip|host>[:port]
 	host, port, err := net.SplitHostPort(addr)
 	if err != nil {
-		e.logger.Error("failed to split host/port",
+		c.logger.Error("failed to split host/port",
 			zap.String("address", addr),
 			zap.Error(err),
 		)
 		return addr
 	}
 	if host != "" {
-		host = e.convertAddr(host)
+		host = c.convertAddr(host)
 	}
 	if host != "" && port != "" {
 		return host + ":" + port
@@ -113,21 +129,21 @@ func (e *exporter) convertHost(addr string) string {
 	}
 }
 
-func (e *exporter) parseResourceServerAttrs(actel *apiclientmodels.Telemetry, resource pcommon.Resource) bool {
+func (c *Converter) parseResourceServerAttrs(actel *apiclientmodels.Telemetry, resource pcommon.Resource) bool {
 	ok := true
 	resAttrs := resource.Attributes()
 	if ipAddr, ok := resAttrs.Get("ip"); ok {
-		actel.DestinationAddress = e.convertAddr(ipAddr.AsString())
+		actel.DestinationAddress = c.convertAddr(ipAddr.AsString())
 		if servicePort, ok := resAttrs.Get("port"); ok {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + servicePort.AsString()
 		}
 	} else if serviceIP, ok := resAttrs.Get(string("ipv4")); ok {
-		actel.DestinationAddress = e.convertAddr(serviceIP.AsString())
+		actel.DestinationAddress = c.convertAddr(serviceIP.AsString())
 		if servicePort, ok := resAttrs.Get("port"); ok {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + servicePort.AsString()
 		}
 	} else if hostName, ok := resAttrs.Get(string(semconv.HostNameKey)); ok {
-		actel.DestinationAddress = e.convertAddr(hostName.AsString())
+		actel.DestinationAddress = c.convertAddr(hostName.AsString())
 		if servicePort, ok := resAttrs.Get("port"); ok {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + servicePort.AsString()
 		}
@@ -139,15 +155,15 @@ func (e *exporter) parseResourceServerAttrs(actel *apiclientmodels.Telemetry, re
 	return ok
 }
 
-func (e *exporter) setTelemetryClientSpan(actel *apiclientmodels.Telemetry, resource pcommon.Resource, attrs pcommon.Map, logger *zap.Logger) error {
+func (c *Converter) setTelemetryClientSpan(actel *apiclientmodels.Telemetry, resource pcommon.Resource, attrs pcommon.Map, logger *zap.Logger) error {
 	//Set destination/server address
 	if peerName, ok := attrs.Get(string(semconv.NetPeerNameKey)); ok {
-		actel.DestinationAddress = e.convertAddr(peerName.AsString())
+		actel.DestinationAddress = c.convertAddr(peerName.AsString())
 		if portAttr, portOk := attrs.Get(string(semconv.NetPeerPortKey)); portOk {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + portAttr.AsString()
 		}
 	} else if peerIP, ok := attrs.Get(string(semconv.NetPeerIPKey)); ok {
-		actel.DestinationAddress = e.convertAddr(peerIP.AsString())
+		actel.DestinationAddress = c.convertAddr(peerIP.AsString())
 		if portAttr, portOk := attrs.Get(string(semconv.NetPeerPortKey)); portOk {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + portAttr.AsString()
 		}
@@ -155,16 +171,16 @@ func (e *exporter) setTelemetryClientSpan(actel *apiclientmodels.Telemetry, reso
 		//Assume this is from URL or Host header...
 		//TODO: split addr/port and convertAddr
 		actel.DestinationAddress = actel.Request.Host
-	} else if ok := e.parseResourceServerAttrs(actel, resource); !ok {
+	} else if ok := c.parseResourceServerAttrs(actel, resource); !ok {
 		//Either HTTPURLKey, HTTPHostKey, NetPeerNameKey or NetPeerIPKey should be defined
 		return wrapAttributeError(logger, "missing attribute", string(semconv.NetPeerIPKey), missingAttrValue, nil)
 	}
 
 	//Set source/client address
 	if hostIpAttr, ok := attrs.Get(string(semconv.NetHostIPKey)); ok {
-		actel.SourceAddress = e.convertAddr(hostIpAttr.AsString())
+		actel.SourceAddress = c.convertAddr(hostIpAttr.AsString())
 	} else if hostNameAttr, ok := attrs.Get(string(semconv.NetHostNameKey)); ok {
-		actel.SourceAddress = e.convertAddr(hostNameAttr.AsString())
+		actel.SourceAddress = c.convertAddr(hostNameAttr.AsString())
 	}
 	if portAttr, portOk := attrs.Get(string(semconv.NetHostPortKey)); portOk {
 		actel.SourceAddress = actel.SourceAddress + ":" + portAttr.AsString()
@@ -173,38 +189,38 @@ func (e *exporter) setTelemetryClientSpan(actel *apiclientmodels.Telemetry, reso
 	return nil
 }
 
-func (e *exporter) setTelemetryServerSpan(actel *apiclientmodels.Telemetry, resource pcommon.Resource, attrs pcommon.Map, logger *zap.Logger) error {
+func (c *Converter) setTelemetryServerSpan(actel *apiclientmodels.Telemetry, resource pcommon.Resource, attrs pcommon.Map, logger *zap.Logger) error {
 	//Set destination/server address
 	if serverNameAttr, ok := attrs.Get(string(semconv.HTTPServerNameKey)); ok {
-		actel.DestinationAddress = e.convertAddr(serverNameAttr.AsString())
+		actel.DestinationAddress = c.convertAddr(serverNameAttr.AsString())
 		if portAttr, portOk := attrs.Get(string(semconv.NetHostPortKey)); portOk {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + portAttr.AsString()
 		}
 	} else if hostNameAttr, ok := attrs.Get(string(semconv.NetHostNameKey)); ok {
-		actel.DestinationAddress = e.convertAddr(hostNameAttr.AsString())
+		actel.DestinationAddress = c.convertAddr(hostNameAttr.AsString())
 		if portAttr, portOk := attrs.Get(string(semconv.NetHostPortKey)); portOk {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + portAttr.AsString()
 		}
 	} else if hostIPAttr, ok := attrs.Get(string(semconv.NetHostIPKey)); ok {
-		actel.DestinationAddress = e.convertAddr(hostIPAttr.AsString())
+		actel.DestinationAddress = c.convertAddr(hostIPAttr.AsString())
 		if portAttr, portOk := attrs.Get(string(semconv.NetHostPortKey)); portOk {
 			actel.DestinationAddress = actel.DestinationAddress + ":" + portAttr.AsString()
 		}
 	} else if actel.Request.Host != "" {
 		//Assume this is from URL or Host header...
 		actel.DestinationAddress = actel.Request.Host
-	} else if ok := e.parseResourceServerAttrs(actel, resource); !ok {
+	} else if ok := c.parseResourceServerAttrs(actel, resource); !ok {
 		//Either HTTPURLKey, HTTPHostKey, HTTPServerNameKey or NetHostNameKey should be defined
 		return wrapAttributeError(logger, "missing attribute", string(semconv.HTTPServerNameKey), missingAttrValue, nil)
 	}
 
 	//Set source/client address
 	if clientIP, ok := attrs.Get(string(semconv.HTTPClientIPKey)); ok {
-		actel.SourceAddress = e.convertAddr(clientIP.AsString())
+		actel.SourceAddress = c.convertAddr(clientIP.AsString())
 	} else if peerName, ok := attrs.Get(string(semconv.NetPeerNameKey)); ok {
-		actel.SourceAddress = e.convertAddr(peerName.AsString())
+		actel.SourceAddress = c.convertAddr(peerName.AsString())
 	} else if peerIP, ok := attrs.Get(string(semconv.NetPeerIPKey)); ok {
-		actel.SourceAddress = e.convertAddr(peerIP.AsString()) //this could be a proxy
+		actel.SourceAddress = c.convertAddr(peerIP.AsString()) //this could be a proxy
 	}
 	if portAttr, portOk := attrs.Get(string(semconv.NetPeerPortKey)); portOk {
 		actel.SourceAddress = actel.SourceAddress + ":" + portAttr.AsString()
@@ -213,18 +229,18 @@ func (e *exporter) setTelemetryServerSpan(actel *apiclientmodels.Telemetry, reso
 	return nil
 }
 
-// Process a single span into APIClarity telemetry
-func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) (*apiclientmodels.Telemetry, error) {
+// ProcessOTelSpan processes a single span into APIClarity telemetry
+func (c *Converter) ProcessOTelSpan(resource pcommon.Resource, _ pcommon.InstrumentationScope, span ptrace.Span) (*apiclientmodels.Telemetry, error) {
 	/*
 		res.Attributes().Range(func(k string, v pcommon.Value) bool {
-			e.logger.Debug("Checking resource attributes",
+			c.logger.Debug("Checking resource attributes",
 				zap.String("key", k),
 				zap.String("value", v.AsString()),
 			)
 			return true
 		})
 	*/
-	e.logger.Info("Converting span",
+	c.logger.Info("Converting span",
 		zap.String("kind", span.Kind().String()),
 		zap.String("name", span.Name()),
 		zap.String("traceid", span.TraceID().HexString()),
@@ -232,7 +248,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	)
 
 	span.Attributes().Range(func(k string, v pcommon.Value) bool {
-		e.logger.Debug("Checking span attributes",
+		c.logger.Debug("Checking span attributes",
 			zap.String("key", k),
 			zap.String("value", v.AsString()),
 		)
@@ -271,10 +287,10 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 		} else {
 			urlInfo, err := url.Parse(urlVal)
 			if err != nil {
-				return nil, wrapAttributeError(e.logger, "cannot parse attribute", string(semconv.HTTPURLKey), urlVal, err)
+				return nil, wrapAttributeError(c.logger, "cannot parse attribute", string(semconv.HTTPURLKey), urlVal, err)
 			}
 			actel.Scheme = urlInfo.Scheme
-			actel.Request.Host = e.convertHost(urlInfo.Host)
+			actel.Request.Host = c.convertHost(urlInfo.Host)
 			actel.Request.Path = urlInfo.Path
 		}
 	}
@@ -283,7 +299,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	} else if !urlOk {
 		//Either HTTPURLKey or HTTPSchemeKey should be defined
 		actel.Scheme = DefaultScheme
-		e.logger.Warn("Using default value for missing attribute",
+		c.logger.Warn("Using default value for missing attribute",
 			zap.String("attribute", string(semconv.HTTPSchemeKey)),
 			zap.String("value", DefaultScheme),
 		)
@@ -296,36 +312,36 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	} else if !urlOk {
 		//Either HTTPURLKey or HTTPTargetKey should be defined
 		actel.Request.Path = DefaultTarget
-		e.logger.Warn("Using default value for missing attribute",
+		c.logger.Warn("Using default value for missing attribute",
 			zap.String("attribute", string(semconv.HTTPTargetKey)),
 			zap.String("value", DefaultTarget),
 		)
 	}
 	//Do not override URL with Host header, but check for use later
 	if hostAttr, hostOk := attrs.Get(string(semconv.HTTPHostKey)); hostOk && actel.Request.Host == "" {
-		actel.Request.Host = e.convertHost(hostAttr.AsString()) // host is Host Header. Is this correct?
+		actel.Request.Host = c.convertHost(hostAttr.AsString()) // host is Host Header. Is this correct?
 	}
 
 	var err error
 	switch span.Kind() {
 	case ptrace.SpanKindClient:
-		err = e.setTelemetryClientSpan(actel, resource, attrs, e.logger)
+		err = c.setTelemetryClientSpan(actel, resource, attrs, c.logger)
 	case ptrace.SpanKindServer:
-		err = e.setTelemetryServerSpan(actel, resource, attrs, e.logger)
+		err = c.setTelemetryServerSpan(actel, resource, attrs, c.logger)
 	case ptrace.SpanKindUnspecified:
-		e.logger.Warn("span kind unspecified, assuming default",
+		c.logger.Warn("span kind unspecified, assuming default",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
 			zap.Int("default", int(DefaultSpanKind)),
 		)
 		if DefaultSpanKind == ptrace.SpanKindClient {
-			err = e.setTelemetryClientSpan(actel, resource, attrs, e.logger)
+			err = c.setTelemetryClientSpan(actel, resource, attrs, c.logger)
 		} else {
-			err = e.setTelemetryServerSpan(actel, resource, attrs, e.logger)
+			err = c.setTelemetryServerSpan(actel, resource, attrs, c.logger)
 		}
 	default:
-		e.logger.Warn("ignoring span that is not client or server",
+		c.logger.Warn("ignoring span that is not client or server",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
@@ -333,7 +349,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	}
 	if err != nil {
 		span.Attributes().Range(func(k string, v pcommon.Value) bool {
-			e.logger.Warn("failing span attribute",
+			c.logger.Warn("failing span attribute",
 				zap.String("key", k),
 				zap.String("value", v.AsString()),
 			)
@@ -349,7 +365,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 		} else if actel.Scheme == "https" {
 			actel.DestinationAddress = actel.DestinationAddress + ":443"
 		} else {
-			e.logger.Warn("Cannot infer destination port, using default 80",
+			c.logger.Warn("Cannot infer destination port, using default 80",
 				zap.String("kind", span.Kind().String()),
 				zap.String("name", span.Name()),
 				zap.String("traceid", span.TraceID().HexString()),
@@ -358,7 +374,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 		}
 	}
 	if actel.SourceAddress == "" {
-		e.logger.Warn("Cannot infer source address, using default",
+		c.logger.Warn("Cannot infer source address, using default",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
@@ -368,7 +384,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	} else if !strings.Contains(actel.SourceAddress, ":") {
 		parts := strings.Split(DefaultSourceAddress, ":")
 		defaultPort := parts[1]
-		e.logger.Warn("Cannot infer source port, using default",
+		c.logger.Warn("Cannot infer source port, using default",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
@@ -378,7 +394,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	}
 	//APIClarity requires a host?
 	if actel.Request.Host == "" {
-		e.logger.Warn("Cannot find host, using destination",
+		c.logger.Warn("Cannot find host, using destination",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
@@ -394,7 +410,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	if statusCode, ok := attrs.Get(string(semconv.HTTPStatusCodeKey)); ok {
 		actel.Response.StatusCode = statusCode.AsString()
 	} else {
-		e.logger.Warn("Cannot find status code, using default",
+		c.logger.Warn("Cannot find status code, using default",
 			zap.String("kind", span.Kind().String()),
 			zap.String("name", span.Name()),
 			zap.String("traceid", span.TraceID().HexString()),
@@ -418,7 +434,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 		} else if reqBody.Type() == pcommon.ValueTypeStr {
 			actel.Request.Common.Body = []byte(reqBody.Str())
 		} else {
-			e.logger.Warn("unknown request body value type",
+			c.logger.Warn("unknown request body value type",
 				zap.String("kind", span.Kind().String()),
 				zap.String("name", span.Name()),
 				zap.String("traceid", span.TraceID().HexString()),
@@ -439,7 +455,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 		} else if respBody.Type() == pcommon.ValueTypeStr {
 			actel.Response.Common.Body = []byte(respBody.Str())
 		} else {
-			e.logger.Warn("unknown response body value type",
+			c.logger.Warn("unknown response body value type",
 				zap.String("kind", span.Kind().String()),
 				zap.String("name", span.Name()),
 				zap.String("traceid", span.TraceID().HexString()),
@@ -456,7 +472,7 @@ func (e *exporter) processOTelSpan(resource pcommon.Resource, _ pcommon.Instrume
 	}
 
 	attrs.Range(func(k string, v pcommon.Value) bool {
-		e.logger.Debug("Converting span attributes",
+		c.logger.Debug("Converting span attributes",
 			zap.String("key", k),
 			zap.String("value", v.AsString()),
 		)
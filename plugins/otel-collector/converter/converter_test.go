@@ -0,0 +1,105 @@
+// Copyright © 2023 Cisco Systems, Inc. and its affiliates.
+// All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package converter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/collector/pdata/pcommon"
+	"go.opentelemetry.io/collector/pdata/ptrace"
+	"go.uber.org/zap"
+)
+
+func newSpan(kind ptrace.SpanKind, attributes map[string]interface{}) ptrace.Span {
+	span := ptrace.NewSpan()
+	span.SetKind(kind)
+	span.Attributes().FromRaw(attributes)
+	return span
+}
+
+func TestConverter_ProcessOTelSpan(t *testing.T) {
+	tests := []struct {
+		name                   string
+		span                   ptrace.Span
+		wantHost               string
+		wantPath               string
+		wantMethod             string
+		wantStatusCode         string
+		wantDestinationAddress string
+		wantSourceAddress      string
+	}{
+		{
+			name: "client span",
+			span: newSpan(ptrace.SpanKindClient, map[string]interface{}{
+				"http.method":      "PUT",
+				"http.url":         "https://192.0.2.5:8443/treasure/chest/100",
+				"net.peer.ip":      "192.0.2.5",
+				"net.peer.port":    8443,
+				"http.status_code": 201,
+			}),
+			wantHost:               "192.0.2.5:8443",
+			wantPath:               "/treasure/chest/100",
+			wantMethod:             "PUT",
+			wantStatusCode:         "201",
+			wantDestinationAddress: "192.0.2.5:8443",
+			wantSourceAddress:      DefaultSourceAddress,
+		},
+		{
+			name: "server span",
+			span: newSpan(ptrace.SpanKindServer, map[string]interface{}{
+				"http.scheme":    "http",
+				"http.method":    "GET",
+				"http.target":    "/catalogue/1",
+				"net.host.ip":    "10.0.0.1",
+				"net.host.port":  8080,
+				"http.client_ip": "10.0.0.2",
+				"net.peer.port":  34567,
+				"http.route":     "/catalogue/:id",
+			}),
+			wantHost:               "10.0.0.1:8080",
+			wantPath:               "/catalogue/:id",
+			wantMethod:             "GET",
+			wantStatusCode:         DefaultStatusCode,
+			wantDestinationAddress: "10.0.0.1:8080",
+			wantSourceAddress:      "10.0.0.2:34567",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := New(zap.NewNop(), true)
+			telemetry, err := c.ProcessOTelSpan(pcommon.NewResource(), pcommon.NewInstrumentationScope(), tt.span)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantHost, telemetry.Request.Host)
+			assert.Equal(t, tt.wantPath, telemetry.Request.Path)
+			assert.Equal(t, tt.wantMethod, telemetry.Request.Method)
+			assert.Equal(t, tt.wantStatusCode, telemetry.Response.StatusCode)
+			assert.Equal(t, tt.wantDestinationAddress, telemetry.DestinationAddress)
+			assert.Equal(t, tt.wantSourceAddress, telemetry.SourceAddress)
+			assert.NotEmpty(t, telemetry.RequestID)
+		})
+	}
+}
+
+func TestConverter_ProcessOTelSpanBadURL(t *testing.T) {
+	c := New(zap.NewNop(), true)
+	_, err := c.ProcessOTelSpan(pcommon.NewResource(), pcommon.NewInstrumentationScope(), newSpan(ptrace.SpanKindServer, map[string]interface{}{
+		"http.method": "GET",
+		"http.url":    "://missing-scheme",
+	}))
+	assert.Error(t, err)
+}
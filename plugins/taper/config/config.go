@@ -21,32 +21,29 @@ import (
 )
 
 const (
-	UpstreamAddressEnv          = "UPSTREAM_TELEMETRY_HOST_NAME"
-	NamespacesToTapEnv          = "NAMESPACES_TO_TAP"
-	TapLogLevelEnv              = "TAP_LOG_LEVEL"
-	EnableTLSEnv                = "ENABLE_TLS"
-	RootCertFilePathEnv         = "ROOT_CERT_FILE_PATH"
-	TraceSamplingManagerAddress = "TRACE_SAMPLING_HOST_NAME"
-	TraceSamplingEnabled        = "TRACE_SAMPLING_ENABLED"
+	UpstreamAddressEnv   = "UPSTREAM_TELEMETRY_HOST_NAME"
+	NamespacesToTapEnv   = "NAMESPACES_TO_TAP"
+	TapLogLevelEnv       = "TAP_LOG_LEVEL"
+	EnableTLSEnv         = "ENABLE_TLS"
+	RootCertFilePathEnv  = "ROOT_CERT_FILE_PATH"
+	TraceSamplingEnabled = "TRACE_SAMPLING_ENABLED"
 )
 
 type Config struct {
-	NamespaceToTap              []string
-	UpstreamAddress             string
-	MizuLogLevel                logging.Level
-	EnableTLS                   bool
-	RootCertFilePath            string
-	TraceSamplingManagerAddress string
-	TraceSamplingEnabled        bool
+	NamespaceToTap       []string
+	UpstreamAddress      string
+	MizuLogLevel         logging.Level
+	EnableTLS            bool
+	RootCertFilePath     string
+	TraceSamplingEnabled bool
 }
 
 func LoadConfig() *Config {
 	return &Config{
-		NamespaceToTap:              viper.GetStringSlice(NamespacesToTapEnv),
-		UpstreamAddress:             viper.GetString(UpstreamAddressEnv),
-		EnableTLS:                   viper.GetBool(EnableTLSEnv),
-		RootCertFilePath:            viper.GetString(RootCertFilePathEnv),
-		TraceSamplingManagerAddress: viper.GetString(TraceSamplingManagerAddress),
-		TraceSamplingEnabled:        viper.GetBool(TraceSamplingEnabled),
+		NamespaceToTap:       viper.GetStringSlice(NamespacesToTapEnv),
+		UpstreamAddress:      viper.GetString(UpstreamAddressEnv),
+		EnableTLS:            viper.GetBool(EnableTLSEnv),
+		RootCertFilePath:     viper.GetString(RootCertFilePathEnv),
+		TraceSamplingEnabled: viper.GetBool(TraceSamplingEnabled),
 	}
 }
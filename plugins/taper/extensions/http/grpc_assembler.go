@@ -20,14 +20,13 @@ package main
 import (
 	"bufio"
 	"bytes"
-	"encoding/base64"
 	"encoding/binary"
 	"errors"
 	"io"
 	"math"
 	"net/http"
 	"net/url"
-	"strings"
+	"strconv"
 
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/hpack"
@@ -129,31 +128,40 @@ func (ga *GrpcAssembler) readMessage() (uint32, interface{}, error) {
 	for _, header := range headers {
 		headersHTTP1.Add(header.Name, header.Value)
 	}
-	dataString := base64.StdEncoding.EncodeToString(data)
 
 	// Use http1 types only because they are expected in http_matcher.
 	// TODO: Create an interface that will be used by http_matcher:registerRequest and http_matcher:registerRequest
 	//       to accept both HTTP/1.x and HTTP/2 requests and responses
+	// The body is kept raw (not base64 encoded) like HTTP/1.x bodies, gRPC messages are decoded by the backend.
 	var messageHTTP1 interface{}
-	if _, ok := headersHTTP1[":method"]; ok {
+	if method := headersHTTP1.Get(":method"); method != "" {
+		reqURL, err := url.ParseRequestURI(headersHTTP1.Get(":path"))
+		if err != nil {
+			reqURL = &url.URL{}
+		}
 		messageHTTP1 = http.Request{
-			URL:           &url.URL{},
-			Method:        "POST",
+			URL:           reqURL,
+			Method:        method,
+			Host:          headersHTTP1.Get(":authority"),
 			Header:        headersHTTP1,
 			Proto:         protoHTTP2,
 			ProtoMajor:    protoMajorHTTP2,
 			ProtoMinor:    protoMinorHTTP2,
-			Body:          io.NopCloser(strings.NewReader(dataString)),
-			ContentLength: int64(len(dataString)),
+			Body:          io.NopCloser(bytes.NewReader(data)),
+			ContentLength: int64(len(data)),
 		}
-	} else if _, ok := headersHTTP1[":status"]; ok {
+	} else if status := headersHTTP1.Get(":status"); status != "" {
+		// the trailers (grpc-status, grpc-message) are merged into the headers
+		statusCode, _ := strconv.Atoi(status)
 		messageHTTP1 = http.Response{
+			Status:        status,
+			StatusCode:    statusCode,
 			Header:        headersHTTP1,
 			Proto:         protoHTTP2,
 			ProtoMajor:    protoMajorHTTP2,
 			ProtoMinor:    protoMinorHTTP2,
-			Body:          io.NopCloser(strings.NewReader(dataString)),
-			ContentLength: int64(len(dataString)),
+			Body:          io.NopCloser(bytes.NewReader(data)),
+			ContentLength: int64(len(data)),
 		}
 	} else {
 		return 0, nil, errors.New("failed to assemble stream: neither a request nor a message")
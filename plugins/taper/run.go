@@ -16,8 +16,10 @@
 package main
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"os"
@@ -82,11 +84,7 @@ func run(c *cli.Context) {
 	}
 
 	if runConfig.TraceSamplingEnabled {
-		TSM, err := trace_sampling_client.Create(false, runConfig.TraceSamplingManagerAddress, common.SamplingInterval)
-		if err != nil {
-			log.Errorf("Failed to create trace sampling client: %v", err)
-			return
-		}
+		TSM := trace_sampling_client.Create(apiClient, common.SamplingInterval)
 		TSM.Start()
 		agent.traceSamplingClient = TSM
 	}
@@ -138,6 +136,14 @@ func (a *Agent) startReadOutputItems(ctx context.Context, outputItems chan *api.
 					log.Infof("Ignoring host: %v", telemetry.Request.Host)
 					return
 				}
+				if !a.shouldTraceEndpoint(telemetry.Request.Host, item.ConnectionInfo.ServerPort, telemetry.Request.Method, telemetry.Request.Path) {
+					log.Debugf("Ignoring endpoint: %v %v %v", telemetry.Request.Host, telemetry.Request.Method, telemetry.Request.Path)
+					return
+				}
+				if !a.shouldSample(telemetry.Request.Host, item.ConnectionInfo.ServerPort) {
+					log.Debugf("Dropping sampled out trace of host: %v", telemetry.Request.Host)
+					return
+				}
 
 				params := operations.NewPostTelemetryParams().WithBody(telemetry)
 
@@ -163,6 +169,22 @@ func (a *Agent) shouldTrace(host, port string) bool {
 	return false
 }
 
+func (a *Agent) shouldTraceEndpoint(host, port, method, path string) bool {
+	if a.traceSamplingClient == nil {
+		return true
+	}
+
+	return a.traceSamplingClient.ShouldTraceEndpoint(host, port, method, path)
+}
+
+func (a *Agent) shouldSample(host, port string) bool {
+	if a.traceSamplingClient == nil {
+		return true
+	}
+
+	return a.traceSamplingClient.ShouldSample(host, port)
+}
+
 // for each connection we will get the pod to pod communication (maybe twice if they are on different nodes) and the pod to service communication.
 // the filtering logic is that we only send to API Clarity telemetries where the client is in a monitored namespace
 // and the destination is not a pod IP (service IP or external IP). Same behaviour as the wasm filter.
@@ -199,16 +221,26 @@ func (a *Agent) createTelemetry(item *api.OutputChannelItem) (*models.Telemetry,
 		return nil, fmt.Errorf("failed in type assertion to http.Response")
 	}
 
-	reqBody, truncatedBodyReq, err := common.ReadBody(request.Body)
+	// the full bodies are needed to get the size of the gRPC messages, even if the bodies are truncated
+	fullReqBody, err := io.ReadAll(request.Body)
 	if err != nil {
 		return nil, fmt.Errorf("failed to read request body: %v", err)
 	}
-	resBody, truncatedBodyRes, err := common.ReadBody(response.Body)
+	reqBody, truncatedBodyReq, err := common.ReadBody(io.NopCloser(bytes.NewReader(fullReqBody)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read request body: %v", err)
+	}
+	fullResBody, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %v", err)
+	}
+	resBody, truncatedBodyRes, err := common.ReadBody(io.NopCloser(bytes.NewReader(fullResBody)))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read response body: %v", err)
 	}
 
 	pathAndQuery := common.GetPathWithQuery(request.URL)
+	grpcTelemetry := common.CreateGRPCTelemetry(request.URL.Path, request.Header, response.Header, fullReqBody, fullResBody)
 
 	clientNamespace := a.podMonitor.GetPodNamespaceByIP(item.ConnectionInfo.ClientIP)
 	host, _ := common.GetHostAndPortFromURL(request.Host, clientNamespace)
@@ -240,6 +272,7 @@ func (a *Agent) createTelemetry(item *api.OutputChannelItem) (*models.Telemetry,
 		},
 		Scheme:        item.Protocol.Name,
 		SourceAddress: item.ConnectionInfo.ClientIP + ":" + item.ConnectionInfo.ClientPort,
+		Grpc:          grpcTelemetry,
 	}, nil
 }
 